@@ -0,0 +1,245 @@
+// Package access enforces operator roles in towns shared by several humans.
+//
+// The roles live in settings/roles.json. Each person (or agent) is mapped to
+// a role by identity: the agent's BD_ACTOR inside its own gt session (see
+// VerifyAgent), otherwise the OS user name. Slack users of the chat commands
+// are "slack:<user ID>". Setting BD_ACTOR by hand changes nothing, so no one
+// can borrow another user's role or the agents role.
+//
+//	{
+//	  "version": 1,
+//	  "users": {"alice": "admin", "bob": "operator"},
+//	  "default": "viewer"
+//	}
+//
+// State-mutating commands (pausing, killing polecats, forcing dispatch,
+// clearing rate limits) check the caller's role before acting. Observers are
+// stricter still: gt runs them in observer mode, refusing every command that
+// changes town state. Without a roles file there is no enforcement.
+package access
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// FileName is the roles file name in the town settings directory.
+const FileName = "roles.json"
+
+// Roles, from least to most privileged.
+const (
+	RoleObserver = "observer" // Observer mode: may not run any state-mutating command
+	RoleViewer   = "viewer"   // Read-only: may not run guarded commands
+	RoleOperator = "operator" // Day-to-day control: pause, kill, dispatch
+	RoleAdmin    = "admin"    // Everything, including clearing limits
+)
+
+// Guarded actions.
+const (
+	ActionPause       = "pause"        // Pause, resume, hold, release, clear or wake work
+	ActionKill        = "kill"         // Kill or nuke polecats
+	ActionDispatch    = "dispatch"     // Force scheduler dispatch
+	ActionClearLimits = "clear-limits" // Clear rate-limited accounts
+	ActionEnqueue     = "enqueue"      // Schedule work from chat (gt ingest serve /slack)
+)
+
+// defaultRequired is the least role each action needs unless the roles file
+// overrides it.
+var defaultRequired = map[string]string{
+	ActionPause:       RoleOperator,
+	ActionKill:        RoleOperator,
+	ActionDispatch:    RoleOperator,
+	ActionClearLimits: RoleAdmin,
+	ActionEnqueue:     RoleOperator,
+}
+
+var roleRank = map[string]int{RoleObserver: -1, RoleViewer: 0, RoleOperator: 1, RoleAdmin: 2}
+
+// Config is the parsed roles file.
+type Config struct {
+	Version int `json:"version"`
+
+	// Users maps identities (OS user names, or agent addresses verified by
+	// VerifyAgent) to roles.
+	Users map[string]string `json:"users,omitempty"`
+
+	// Default is the role of humans not listed in Users. Default viewer.
+	Default string `json:"default,omitempty"`
+
+	// Agents is the role of unlisted agent identities (BD_ACTOR addresses
+	// such as "gastown/witness"), so patrols keep working. Default operator.
+	// It applies only when VerifyAgent confirms the caller runs in that
+	// agent's session; otherwise the identity is treated as a human.
+	Agents string `json:"agents,omitempty"`
+
+	// Actions overrides the least role an action needs, e.g.
+	// {"clear-limits": "operator"}.
+	Actions map[string]string `json:"actions,omitempty"`
+}
+
+// Decision is the result of checking an action.
+type Decision struct {
+	Allowed  bool   `json:"allowed"`
+	Identity string `json:"identity"`
+	Role     string `json:"role"`
+	Action   string `json:"action"`
+	Required string `json:"required"`
+}
+
+// Path returns the roles file path for a town.
+func Path(townRoot string) string {
+	return filepath.Join(townRoot, "settings", FileName)
+}
+
+// Load reads and validates the town's roles file. It returns nil, nil when
+// no roles file exists.
+func Load(townRoot string) (*Config, error) {
+	data, err := os.ReadFile(Path(townRoot)) //nolint:gosec // G304: path is within the town root
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", FileName, err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", FileName, err)
+	}
+	return &c, nil
+}
+
+// Validate checks that every role and action name is known.
+func (c *Config) Validate() error {
+	for id, role := range c.Users {
+		if !isRole(role) {
+			return fmt.Errorf("user %q: unknown role %q (want %s)", id, role, roleList())
+		}
+	}
+	for name, role := range map[string]string{"default": c.Default, "agents": c.Agents} {
+		if role != "" && !isRole(role) {
+			return fmt.Errorf("%s: unknown role %q (want %s)", name, role, roleList())
+		}
+	}
+	for action, role := range c.Actions {
+		if _, ok := defaultRequired[action]; !ok {
+			return fmt.Errorf("unknown action %q (want %s)", action, strings.Join(Actions(), ", "))
+		}
+		if !isRole(role) {
+			return fmt.Errorf("action %q: unknown role %q (want %s)", action, role, roleList())
+		}
+	}
+	return nil
+}
+
+// RoleOf returns identity's role.
+func (c *Config) RoleOf(identity string) string {
+	if role, ok := c.Users[identity]; ok {
+		return role
+	}
+	if IsAgent(identity) && VerifyAgent(identity) {
+		if c.Agents != "" {
+			return c.Agents
+		}
+		return RoleOperator
+	}
+	if c.Default != "" {
+		return c.Default
+	}
+	return RoleViewer
+}
+
+// Required returns the least role action needs.
+func (c *Config) Required(action string) string {
+	if role, ok := c.Actions[action]; ok {
+		return role
+	}
+	if role, ok := defaultRequired[action]; ok {
+		return role
+	}
+	return RoleAdmin
+}
+
+// Check decides whether identity may perform action.
+func (c *Config) Check(identity, action string) Decision {
+	d := Decision{
+		Identity: identity,
+		Role:     c.RoleOf(identity),
+		Action:   action,
+		Required: c.Required(action),
+	}
+	d.Allowed = roleRank[d.Role] >= roleRank[d.Required]
+	return d
+}
+
+// Actions returns the guarded action names, sorted.
+func Actions() []string {
+	actions := make([]string, 0, len(defaultRequired))
+	for a := range defaultRequired {
+		actions = append(actions, a)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// Identity returns who is running gt: BD_ACTOR when VerifyAgent confirms
+// the caller runs in that agent's session, otherwise the OS user name. A
+// BD_ACTOR set by hand is ignored, so it can't claim another user's role.
+func Identity() string {
+	if actor := os.Getenv("BD_ACTOR"); actor != "" && VerifyAgent(actor) {
+		return actor
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	return "unknown"
+}
+
+// VerifyAgent reports whether the caller really is the agent identity names.
+// BD_ACTOR is just an environment variable anyone can set, so it counts only
+// when gt runs inside a tmux session whose own environment (set by gt when it
+// started the agent) names the same BD_ACTOR. Tests replace it.
+var VerifyAgent = verifyAgentSession
+
+func verifyAgentSession(identity string) bool {
+	if os.Getenv("GT_ROLE") == "" {
+		return false
+	}
+	session := tmux.CurrentSessionName()
+	if session == "" {
+		return false
+	}
+	actor, err := tmux.NewTmux().GetEnvironment(session, "BD_ACTOR")
+	return err == nil && actor == identity
+}
+
+// IsAgent reports whether identity is a Gas Town agent address rather than
+// a person.
+func IsAgent(identity string) bool {
+	switch identity {
+	case "mayor", "deacon", "deacon-boot", "dog", "daemon":
+		return true
+	}
+	return strings.Contains(identity, "/")
+}
+
+func isRole(role string) bool {
+	_, ok := roleRank[role]
+	return ok
+}
+
+func roleList() string {
+	return strings.Join([]string{RoleObserver, RoleViewer, RoleOperator, RoleAdmin}, ", ")
+}
@@ -0,0 +1,134 @@
+package access
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	verified := map[string]bool{"gastown/witness": true, "deacon": true}
+	orig := VerifyAgent
+	VerifyAgent = func(identity string) bool { return verified[identity] }
+	t.Cleanup(func() { VerifyAgent = orig })
+
+	c := &Config{
+		Users: map[string]string{
+			"alice":          RoleAdmin,
+			"bob":            RoleOperator,
+			"gastown/crew/x": RoleViewer,
+		},
+		Actions: map[string]string{ActionDispatch: RoleAdmin},
+	}
+	tests := []struct {
+		identity, action string
+		want             bool
+	}{
+		{"alice", ActionClearLimits, true},
+		{"bob", ActionKill, true},
+		{"bob", ActionClearLimits, false},
+		{"bob", ActionDispatch, false}, // overridden to admin
+		{"carol", ActionPause, false},  // unlisted humans default to viewer
+		{"gastown/witness", ActionKill, true},
+		{"gastown/witness", ActionClearLimits, false},
+		{"gastown/crew/x", ActionPause, false},   // listed agents use their entry
+		{"gastown/refinery", ActionPause, false}, // unverified agent identity is a human
+	}
+	for _, tt := range tests {
+		if d := c.Check(tt.identity, tt.action); d.Allowed != tt.want {
+			t.Errorf("Check(%q, %q) = %+v, want allowed=%v", tt.identity, tt.action, d, tt.want)
+		}
+	}
+
+	c.Users["dana"] = RoleObserver
+	for _, action := range Actions() {
+		if c.Check("dana", action).Allowed {
+			t.Errorf("observer allowed %s", action)
+		}
+	}
+
+	c.Default, c.Agents = RoleOperator, RoleViewer
+	if !c.Check("carol", ActionPause).Allowed {
+		t.Error("default operator should allow pause")
+	}
+	if c.Check("deacon", ActionKill).Allowed {
+		t.Error("agents viewer should deny kill")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	townRoot := t.TempDir()
+	if c, err := Load(townRoot); c != nil || err != nil {
+		t.Fatalf("Load without file = %v, %v; want nil, nil", c, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(townRoot, "settings"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	write := func(s string) {
+		t.Helper()
+		if err := os.WriteFile(Path(townRoot), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(`{"version": 1, "users": {"alice": "admin"}, "default": "operator"}`)
+	c, err := Load(townRoot)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if c.RoleOf("alice") != RoleAdmin || c.RoleOf("bob") != RoleOperator {
+		t.Errorf("roles = %q, %q; want admin, operator", c.RoleOf("alice"), c.RoleOf("bob"))
+	}
+
+	for _, bad := range []string{
+		`{"users": {"alice": "root"}}`,
+		`{"default": "owner"}`,
+		`{"actions": {"deploy": "admin"}}`,
+		`{"actions": {"kill": "nobody"}}`,
+		`not json`,
+	} {
+		write(bad)
+		if _, err := Load(townRoot); err == nil || !strings.Contains(err.Error(), FileName) {
+			t.Errorf("Load(%s) error = %v, want an error naming %s", bad, err, FileName)
+		}
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	orig := VerifyAgent
+	VerifyAgent = func(identity string) bool { return identity == "gastown/polecats/nux" }
+	t.Cleanup(func() { VerifyAgent = orig })
+
+	t.Setenv("BD_ACTOR", "gastown/polecats/nux")
+	if got := Identity(); got != "gastown/polecats/nux" {
+		t.Errorf("Identity() = %q, want the verified BD_ACTOR", got)
+	}
+	t.Setenv("BD_ACTOR", "")
+	if got := Identity(); got == "" || got == "gastown/polecats/nux" {
+		t.Errorf("Identity() without BD_ACTOR = %q, want the OS user", got)
+	}
+}
+
+func TestIdentitySpoofedActorDoesNotElevate(t *testing.T) {
+	orig := VerifyAgent
+	VerifyAgent = func(string) bool { return false }
+	t.Cleanup(func() { VerifyAgent = orig })
+
+	t.Setenv("BD_ACTOR", "")
+	self := Identity()
+	c := &Config{Users: map[string]string{"alice": RoleAdmin, "gastown/witness": RoleAdmin}}
+	for _, actor := range []string{"alice", "gastown/witness"} {
+		if self == actor {
+			continue
+		}
+		t.Setenv("BD_ACTOR", actor)
+		if got := Identity(); got != self {
+			t.Errorf("BD_ACTOR=%s outside its session: Identity() = %q, want the OS user %q", actor, got, self)
+		}
+		if d := c.Check(Identity(), ActionClearLimits); d.Allowed {
+			t.Errorf("BD_ACTOR=%s outside its session was allowed %s: %+v", actor, ActionClearLimits, d)
+		}
+	}
+}
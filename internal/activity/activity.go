@@ -3,6 +3,8 @@ package activity
 
 import (
 	"time"
+
+	"github.com/steveyegge/gastown/internal/humanize"
 )
 
 // Color class constants for activity status.
@@ -55,7 +57,7 @@ func Calculate(lastActivity time.Time) Info {
 	}
 
 	// Format age string
-	info.FormattedAge = formatAge(info.Duration)
+	info.FormattedAge = humanize.Short(info.Duration)
 
 	// Determine color class
 	info.ColorClass = colorForDuration(info.Duration)
@@ -63,53 +65,6 @@ func Calculate(lastActivity time.Time) Info {
 	return info
 }
 
-// formatAge formats a duration as a short human-readable string.
-// Examples: "<1m", "5m", "2h", "1d"
-func formatAge(d time.Duration) string {
-	if d < time.Minute {
-		return "<1m"
-	}
-	if d < time.Hour {
-		return formatMinutes(d)
-	}
-	if d < 24*time.Hour {
-		return formatHours(d)
-	}
-	return formatDays(d)
-}
-
-func formatMinutes(d time.Duration) string {
-	mins := int(d.Minutes())
-	return formatInt(mins) + "m"
-}
-
-func formatHours(d time.Duration) string {
-	hours := int(d.Hours())
-	return formatInt(hours) + "h"
-}
-
-func formatDays(d time.Duration) string {
-	days := int(d.Hours() / 24)
-	return formatInt(days) + "d"
-}
-
-// formatInt converts a non-negative integer to its decimal string representation.
-// For single digits (0-9), it uses direct rune conversion for efficiency.
-// For larger numbers, it extracts digits iteratively from least to most significant.
-// This avoids importing strconv for simple integer formatting in the activity package.
-func formatInt(n int) string {
-	if n < 10 {
-		return string(rune('0'+n))
-	}
-	// For larger numbers, use standard conversion
-	result := ""
-	for n > 0 {
-		result = string(rune('0'+n%10)) + result
-		n /= 10
-	}
-	return result
-}
-
 // colorForDuration returns the color class for a given duration.
 func colorForDuration(d time.Duration) string {
 	switch {
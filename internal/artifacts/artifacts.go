@@ -0,0 +1,200 @@
+// Package artifacts manages per-bead result artifacts: test reports, built
+// binaries, screenshots, and anything else a formula wants to keep after the
+// polecat that produced it is gone.
+//
+// Each bead gets <town>/.runtime/artifacts/<bead-id>/. Polecat sessions see
+// it as GT_ARTIFACTS_DIR; files dropped there are listed by
+// 'gt bead artifacts' and linked from convoy reports. The daemon removes
+// directories per the town's artifacts retention policy (see GC).
+package artifacts
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// EnvVar is the session environment variable naming the bead's artifact dir.
+const EnvVar = "GT_ARTIFACTS_DIR"
+
+// Root returns the directory holding every bead's artifacts.
+func Root(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "artifacts")
+}
+
+// Dir returns the artifact directory for beadID.
+func Dir(townRoot, beadID string) string {
+	return filepath.Join(Root(townRoot), beadID)
+}
+
+// Artifact is one file in a bead's artifact directory.
+type Artifact struct {
+	Path    string    `json:"path"` // Relative to the bead's artifact dir, slash-separated
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// List returns the files under beadID's artifact directory, sorted by path.
+// A bead with no artifact directory has no artifacts.
+func List(townRoot, beadID string) ([]Artifact, error) {
+	dir := Dir(townRoot, beadID)
+	var out []Artifact
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir && os.IsNotExist(err) {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		out = append(out, Artifact{Path: filepath.ToSlash(rel), Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing artifacts for %s: %w", beadID, err)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out, nil
+}
+
+// Policy bounds how long and how much artifact data is kept.
+type Policy struct {
+	MaxAge   time.Duration // Remove a bead's dir this long after its last change (0 = no limit)
+	MaxBytes int64         // Remove least recently changed dirs beyond this total (0 = no limit)
+}
+
+// LoadPolicy returns the retention policy from town settings or defaults.
+func LoadPolicy(townRoot string) Policy {
+	cfg := config.DefaultArtifactsConfig()
+	days, mb := cfg.MaxAgeDays, cfg.MaxSizeMB
+	if ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil && ts.Artifacts != nil {
+		if ts.Artifacts.MaxAgeDays > 0 {
+			days = ts.Artifacts.MaxAgeDays
+		}
+		if ts.Artifacts.MaxSizeMB > 0 {
+			mb = ts.Artifacts.MaxSizeMB
+		}
+	}
+	return Policy{
+		MaxAge:   time.Duration(days) * 24 * time.Hour,
+		MaxBytes: int64(mb) * 1024 * 1024,
+	}
+}
+
+// Removed describes one bead artifact directory removed (or, in a dry run,
+// due for removal) by GC.
+type Removed struct {
+	BeadID  string
+	Size    int64
+	ModTime time.Time // Newest file in the directory
+	Reason  string    // "expired" or "over size budget"
+}
+
+// GCResult reports what GC removed.
+type GCResult struct {
+	Removed []Removed
+	Kept    int
+	Errors  []error
+}
+
+// beadDir is one bead's artifact directory, summarized for GC.
+type beadDir struct {
+	id      string
+	size    int64
+	modTime time.Time
+}
+
+// GC applies policy to every bead's artifact directory: directories whose
+// newest file is older than MaxAge are removed, then the least recently
+// changed are removed until the rest fit in MaxBytes. With dryRun nothing is
+// deleted; the result lists what would be.
+func GC(townRoot string, policy Policy, now time.Time, dryRun bool) *GCResult {
+	result := &GCResult{}
+	entries, err := os.ReadDir(Root(townRoot))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			result.Errors = append(result.Errors, err)
+		}
+		return result
+	}
+
+	var dirs []beadDir
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		d, err := summarize(filepath.Join(Root(townRoot), e.Name()))
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+		d.id = e.Name()
+		dirs = append(dirs, d)
+	}
+	// Oldest first, so the size budget evicts least recently changed.
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i].modTime.Before(dirs[j].modTime) })
+
+	var total int64
+	for _, d := range dirs {
+		total += d.size
+	}
+	remove := func(d beadDir, reason string) {
+		if !dryRun {
+			if err := os.RemoveAll(Dir(townRoot, d.id)); err != nil {
+				result.Errors = append(result.Errors, err)
+				return
+			}
+		}
+		total -= d.size
+		result.Removed = append(result.Removed, Removed{BeadID: d.id, Size: d.size, ModTime: d.modTime, Reason: reason})
+	}
+	for _, d := range dirs {
+		switch {
+		case policy.MaxAge > 0 && now.Sub(d.modTime) > policy.MaxAge:
+			remove(d, "expired")
+		case policy.MaxBytes > 0 && total > policy.MaxBytes:
+			remove(d, "over size budget")
+		default:
+			result.Kept++
+		}
+	}
+	return result
+}
+
+// summarize totals the size of dir and finds its newest modification time
+// (the directory's own, for an empty one).
+func summarize(dir string) (beadDir, error) {
+	var d beadDir
+	err := filepath.WalkDir(dir, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := e.Info()
+		if err != nil {
+			return err
+		}
+		if !e.IsDir() {
+			d.size += info.Size()
+		}
+		if info.ModTime().After(d.modTime) {
+			d.modTime = info.ModTime()
+		}
+		return nil
+	})
+	return d, err
+}
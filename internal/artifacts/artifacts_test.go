@@ -0,0 +1,84 @@
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeArtifact(t *testing.T, townRoot, beadID, rel string, size int, mtime time.Time) {
+	t.Helper()
+	path := filepath.Join(Dir(townRoot, beadID), rel)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, make([]byte, size), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for p := path; p != Root(townRoot); p = filepath.Dir(p) {
+		if err := os.Chtimes(p, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	town := t.TempDir()
+	now := time.Now()
+	writeArtifact(t, town, "gt-abc", "report.xml", 10, now)
+	writeArtifact(t, town, "gt-abc", "screens/home.png", 20, now)
+
+	got, err := List(town, "gt-abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Path != "report.xml" || got[1].Path != "screens/home.png" || got[1].Size != 20 {
+		t.Errorf("List = %+v", got)
+	}
+
+	none, err := List(town, "gt-missing")
+	if err != nil || len(none) != 0 {
+		t.Errorf("List(missing) = %v, %v; want empty", none, err)
+	}
+}
+
+func TestGC(t *testing.T) {
+	town := t.TempDir()
+	now := time.Now()
+	writeArtifact(t, town, "gt-old", "a.bin", 100, now.Add(-40*24*time.Hour))
+	writeArtifact(t, town, "gt-mid", "a.bin", 300, now.Add(-2*24*time.Hour))
+	writeArtifact(t, town, "gt-new", "a.bin", 300, now.Add(-time.Hour))
+
+	policy := Policy{MaxAge: 30 * 24 * time.Hour, MaxBytes: 400}
+
+	dry := GC(town, policy, now, true)
+	if len(dry.Removed) != 2 {
+		t.Fatalf("dry run removed %+v, want gt-old and gt-mid", dry.Removed)
+	}
+	if _, err := os.Stat(Dir(town, "gt-old")); err != nil {
+		t.Errorf("dry run deleted gt-old: %v", err)
+	}
+
+	res := GC(town, policy, now, false)
+	if len(res.Errors) > 0 {
+		t.Fatalf("GC errors: %v", res.Errors)
+	}
+	if len(res.Removed) != 2 || res.Removed[0].BeadID != "gt-old" || res.Removed[0].Reason != "expired" ||
+		res.Removed[1].BeadID != "gt-mid" || res.Removed[1].Reason != "over size budget" || res.Kept != 1 {
+		t.Errorf("GC = %+v", res)
+	}
+	for id, want := range map[string]bool{"gt-old": false, "gt-mid": false, "gt-new": true} {
+		_, err := os.Stat(Dir(town, id))
+		if exists := err == nil; exists != want {
+			t.Errorf("%s exists = %v, want %v", id, exists, want)
+		}
+	}
+}
+
+func TestGC_NoArtifactsDir(t *testing.T) {
+	res := GC(t.TempDir(), Policy{MaxAge: time.Hour}, time.Now(), false)
+	if len(res.Removed) != 0 || len(res.Errors) != 0 {
+		t.Errorf("GC on empty town = %+v", res)
+	}
+}
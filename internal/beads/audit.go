@@ -68,11 +68,11 @@ func (b *Beads) DetachMoleculeWithAudit(pinnedBeadID string, opts DetachOptions)
 		fmt.Fprintf(os.Stderr, "Warning: failed to write audit log: %v\n", err)
 	}
 
-	// Clear attachment fields by passing nil
-	newDesc := SetAttachmentFields(issue, nil)
-
-	// Update the issue
-	if err := b.Update(pinnedBeadID, UpdateOptions{Description: &newDesc}); err != nil {
+	// Clear attachment fields by passing nil, redoing the edit on top of any
+	// concurrent change
+	if err := b.UpdateDescription(pinnedBeadID, func(current *Issue) (string, error) {
+		return SetAttachmentFields(current, nil), nil
+	}); err != nil {
 		return nil, fmt.Errorf("updating pinned bead: %w", err)
 	}
 
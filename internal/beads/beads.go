@@ -432,6 +432,12 @@ func (b *Beads) run(args ...string) (_ []byte, retErr error) {
 	runEnv := append(b.buildRunEnv(), "BEADS_DIR="+beadsDir)
 	fullArgs := MaybePrependAllowStaleWithEnv(runEnv, args)
 
+	// Hold a town-wide bd slot (see limiter.go) across the call and its
+	// --flat retry. Reported latency excludes the wait for the slot.
+	release := AcquireBdSlot(b.getTownRoot())
+	defer release()
+	start = time.Now()
+
 	// Always explicitly set BEADS_DIR to prevent inherited env vars from
 	// causing prefix mismatches. Use explicit beadsDir if set, otherwise
 	// resolve from working directory.
@@ -497,6 +503,10 @@ func (b *Beads) runWithRouting(args ...string) (_ []byte, retErr error) { //noli
 	runEnv := b.buildRoutingEnv()
 	fullArgs := MaybePrependAllowStaleWithEnv(runEnv, args)
 
+	// Hold a town-wide bd slot like run does.
+	release := AcquireBdSlot(b.getTownRoot())
+	defer release()
+
 	cmd := exec.Command("bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
 	util.SetDetachedProcessGroup(cmd)
 	cmd.Dir = b.workDir
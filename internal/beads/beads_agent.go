@@ -384,28 +384,21 @@ func (b *Beads) ResetAgentBeadForReuse(id, reason string) error {
 		target = NewWithBeadsDir(filepath.Dir(targetDir), targetDir)
 	}
 
-	// Get current issue to preserve immutable fields (title, role_type, rig)
-	issue, err := target.Show(id)
-	if err != nil {
-		return err
-	}
-
-	// Parse existing fields and clear mutable ones
-	fields := ParseAgentFields(issue.Description)
-	fields.HookBead = ""      // Clear hook_bead
-	fields.ActiveMR = ""      // Clear active_mr
-	fields.CleanupStatus = "" // Clear cleanup_status
-	fields.AgentState = string(AgentStateNuked)
-	// Clear completion metadata (gt-x7t9)
-	fields.ExitType = ""
-	fields.MRID = ""
-	fields.Branch = ""
-	fields.MRFailed = false
-	fields.CompletionTime = ""
-
-	// Update description with cleared fields
-	description := FormatAgentDescription(issue.Title, fields)
-	if err := target.Update(id, UpdateOptions{Description: &description}); err != nil {
+	// Preserve immutable fields (title, role_type, rig); clear mutable ones.
+	if err := target.UpdateDescription(id, func(issue *Issue) (string, error) {
+		fields := ParseAgentFields(issue.Description)
+		fields.HookBead = ""      // Clear hook_bead
+		fields.ActiveMR = ""      // Clear active_mr
+		fields.CleanupStatus = "" // Clear cleanup_status
+		fields.AgentState = string(AgentStateNuked)
+		// Clear completion metadata (gt-x7t9)
+		fields.ExitType = ""
+		fields.MRID = ""
+		fields.Branch = ""
+		fields.MRFailed = false
+		fields.CompletionTime = ""
+		return FormatAgentDescription(issue.Title, fields), nil
+	}); err != nil {
 		return fmt.Errorf("resetting agent bead fields: %w", err)
 	}
 
@@ -477,13 +470,17 @@ func (b *Beads) UpdateAgentDescriptionFields(id string, updates AgentFieldUpdate
 	}
 	defer func() { _ = fl.Unlock() }()
 
-	issue, err := b.Show(id)
-	if err != nil {
-		return err
-	}
-
-	fields := ParseAgentFields(issue.Description)
+	// The lock serializes gt's own writers; UpdateDescription also guards
+	// against edits made outside gt.
+	return b.UpdateDescription(id, func(issue *Issue) (string, error) {
+		fields := ParseAgentFields(issue.Description)
+		updates.apply(fields)
+		return FormatAgentDescription(issue.Title, fields), nil
+	})
+}
 
+// apply copies the fields set in updates onto fields.
+func (updates AgentFieldUpdates) apply(fields *AgentFields) {
 	if updates.AgentState != nil {
 		fields.AgentState = *updates.AgentState
 	}
@@ -521,9 +518,6 @@ func (b *Beads) UpdateAgentDescriptionFields(id string, updates AgentFieldUpdate
 	if updates.CompletionTime != nil {
 		fields.CompletionTime = *updates.CompletionTime
 	}
-
-	description := FormatAgentDescription(issue.Title, fields)
-	return b.Update(id, UpdateOptions{Description: &description})
 }
 
 // UpdateAgentCleanupStatus updates the cleanup_status field in an agent bead.
@@ -220,7 +220,7 @@ func (b *Beads) GetChannelByID(id string) (*Issue, *ChannelFields, error) {
 
 // UpdateChannelSubscribers updates the subscribers list for a channel.
 func (b *Beads) UpdateChannelSubscribers(name string, subscribers []string) error {
-	issue, fields, err := b.GetChannelBead(name)
+	issue, _, err := b.GetChannelBead(name)
 	if err != nil {
 		return err
 	}
@@ -228,15 +228,16 @@ func (b *Beads) UpdateChannelSubscribers(name string, subscribers []string) erro
 		return fmt.Errorf("channel %q not found", name)
 	}
 
-	fields.Subscribers = subscribers
-	description := FormatChannelDescription(issue.Title, fields)
-
-	return b.Update(issue.ID, UpdateOptions{Description: &description})
+	return b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseChannelFields(current.Description)
+		fields.Subscribers = subscribers
+		return FormatChannelDescription(current.Title, fields), nil
+	})
 }
 
 // SubscribeToChannel adds a subscriber to a channel if not already subscribed.
 func (b *Beads) SubscribeToChannel(name string, subscriber string) error {
-	issue, fields, err := b.GetChannelBead(name)
+	issue, _, err := b.GetChannelBead(name)
 	if err != nil {
 		return err
 	}
@@ -244,22 +245,21 @@ func (b *Beads) SubscribeToChannel(name string, subscriber string) error {
 		return fmt.Errorf("channel %q not found", name)
 	}
 
-	// Check if already subscribed
-	for _, s := range fields.Subscribers {
-		if s == subscriber {
-			return nil // Already subscribed
+	return b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseChannelFields(current.Description)
+		for _, s := range fields.Subscribers {
+			if s == subscriber {
+				return current.Description, nil // Already subscribed
+			}
 		}
-	}
-
-	fields.Subscribers = append(fields.Subscribers, subscriber)
-	description := FormatChannelDescription(issue.Title, fields)
-
-	return b.Update(issue.ID, UpdateOptions{Description: &description})
+		fields.Subscribers = append(fields.Subscribers, subscriber)
+		return FormatChannelDescription(current.Title, fields), nil
+	})
 }
 
 // UnsubscribeFromChannel removes a subscriber from a channel.
 func (b *Beads) UnsubscribeFromChannel(name string, subscriber string) error {
-	issue, fields, err := b.GetChannelBead(name)
+	issue, _, err := b.GetChannelBead(name)
 	if err != nil {
 		return err
 	}
@@ -267,23 +267,23 @@ func (b *Beads) UnsubscribeFromChannel(name string, subscriber string) error {
 		return fmt.Errorf("channel %q not found", name)
 	}
 
-	// Filter out the subscriber
-	var newSubscribers []string
-	for _, s := range fields.Subscribers {
-		if s != subscriber {
-			newSubscribers = append(newSubscribers, s)
+	return b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseChannelFields(current.Description)
+		// Filter out the subscriber
+		var newSubscribers []string
+		for _, s := range fields.Subscribers {
+			if s != subscriber {
+				newSubscribers = append(newSubscribers, s)
+			}
 		}
-	}
-
-	fields.Subscribers = newSubscribers
-	description := FormatChannelDescription(issue.Title, fields)
-
-	return b.Update(issue.ID, UpdateOptions{Description: &description})
+		fields.Subscribers = newSubscribers
+		return FormatChannelDescription(current.Title, fields), nil
+	})
 }
 
 // UpdateChannelRetention updates the retention policy for a channel.
 func (b *Beads) UpdateChannelRetention(name string, retentionCount, retentionHours int) error {
-	issue, fields, err := b.GetChannelBead(name)
+	issue, _, err := b.GetChannelBead(name)
 	if err != nil {
 		return err
 	}
@@ -291,11 +291,12 @@ func (b *Beads) UpdateChannelRetention(name string, retentionCount, retentionHou
 		return fmt.Errorf("channel %q not found", name)
 	}
 
-	fields.RetentionCount = retentionCount
-	fields.RetentionHours = retentionHours
-	description := FormatChannelDescription(issue.Title, fields)
-
-	return b.Update(issue.ID, UpdateOptions{Description: &description})
+	return b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseChannelFields(current.Description)
+		fields.RetentionCount = retentionCount
+		fields.RetentionHours = retentionHours
+		return FormatChannelDescription(current.Title, fields), nil
+	})
 }
 
 // UpdateChannelStatus updates the status of a channel bead.
@@ -305,7 +306,7 @@ func (b *Beads) UpdateChannelStatus(name, status string) error {
 		return fmt.Errorf("invalid channel status %q: must be active or closed", status)
 	}
 
-	issue, fields, err := b.GetChannelBead(name)
+	issue, _, err := b.GetChannelBead(name)
 	if err != nil {
 		return err
 	}
@@ -313,10 +314,11 @@ func (b *Beads) UpdateChannelStatus(name, status string) error {
 		return fmt.Errorf("channel %q not found", name)
 	}
 
-	fields.Status = status
-	description := FormatChannelDescription(issue.Title, fields)
-
-	return b.Update(issue.ID, UpdateOptions{Description: &description})
+	return b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseChannelFields(current.Description)
+		fields.Status = status
+		return FormatChannelDescription(current.Title, fields), nil
+	})
 }
 
 // DeleteChannelBead permanently deletes a channel bead.
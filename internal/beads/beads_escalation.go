@@ -204,63 +204,44 @@ func (b *Beads) CreateEscalationBead(title string, fields *EscalationFields) (*I
 // AckEscalation acknowledges an escalation bead.
 // Sets acked_by and acked_at fields, adds "acked" label.
 func (b *Beads) AckEscalation(id, ackedBy string) error {
-	// First get current issue to preserve other fields
-	issue, err := b.Show(id)
-	if err != nil {
+	if err := b.UpdateDescription(id, func(issue *Issue) (string, error) {
+		// Verify it's an escalation
+		if !HasLabel(issue, "gt:escalation") {
+			return "", fmt.Errorf("issue %s is not an escalation bead (missing gt:escalation label)", id)
+		}
+		fields := ParseEscalationFields(issue.Description)
+		fields.AckedBy = ackedBy
+		fields.AckedAt = time.Now().Format(time.RFC3339)
+		return FormatEscalationDescription(issue.Title, fields), nil
+	}); err != nil {
 		return err
 	}
 
-	// Verify it's an escalation
-	if !HasLabel(issue, "gt:escalation") {
-		return fmt.Errorf("issue %s is not an escalation bead (missing gt:escalation label)", id)
-	}
-
-	// Parse existing fields
-	fields := ParseEscalationFields(issue.Description)
-	fields.AckedBy = ackedBy
-	fields.AckedAt = time.Now().Format(time.RFC3339)
-
-	// Format new description
-	description := FormatEscalationDescription(issue.Title, fields)
-
-	return b.Update(id, UpdateOptions{
-		Description: &description,
-		AddLabels:   []string{"acked"},
-	})
+	return b.Update(id, UpdateOptions{AddLabels: []string{"acked"}})
 }
 
 // CloseEscalation closes an escalation bead with a resolution reason.
 // Sets closed_by and closed_reason fields, closes the issue.
 func (b *Beads) CloseEscalation(id, closedBy, reason string) error {
-	// First get current issue to preserve other fields
-	issue, err := b.Show(id)
-	if err != nil {
-		return err
-	}
-
-	// Verify it's an escalation
-	if !HasLabel(issue, "gt:escalation") {
-		return fmt.Errorf("issue %s is not an escalation bead (missing gt:escalation label)", id)
-	}
-
-	// Parse existing fields
-	fields := ParseEscalationFields(issue.Description)
-	fields.ClosedBy = closedBy
-	fields.ClosedReason = reason
-
-	// Format new description
-	description := FormatEscalationDescription(issue.Title, fields)
-
 	// Update description first
-	if err := b.Update(id, UpdateOptions{
-		Description: &description,
-		AddLabels:   []string{"resolved"},
+	if err := b.UpdateDescription(id, func(issue *Issue) (string, error) {
+		// Verify it's an escalation
+		if !HasLabel(issue, "gt:escalation") {
+			return "", fmt.Errorf("issue %s is not an escalation bead (missing gt:escalation label)", id)
+		}
+		fields := ParseEscalationFields(issue.Description)
+		fields.ClosedBy = closedBy
+		fields.ClosedReason = reason
+		return FormatEscalationDescription(issue.Title, fields), nil
 	}); err != nil {
 		return err
 	}
+	if err := b.Update(id, UpdateOptions{AddLabels: []string{"resolved"}}); err != nil {
+		return err
+	}
 
 	// Close the issue
-	_, err = b.run("close", id, "--reason="+reason)
+	_, err := b.run("close", id, "--reason="+reason)
 	return err
 }
 
@@ -225,7 +225,7 @@ func (b *Beads) GetGroupByID(id string) (*Issue, *GroupFields, error) {
 
 // UpdateGroupMembers updates the members list for a group.
 func (b *Beads) UpdateGroupMembers(name string, members []string) (*Issue, error) {
-	issue, fields, err := b.GetGroupByName(name)
+	issue, _, err := b.GetGroupByName(name)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return nil, fmt.Errorf("group %q not found", name)
@@ -233,10 +233,11 @@ func (b *Beads) UpdateGroupMembers(name string, members []string) (*Issue, error
 		return nil, err
 	}
 
-	fields.Members = members
-	description := FormatGroupDescription(issue.Title, fields)
-
-	if err := b.Update(issue.ID, UpdateOptions{Description: &description}); err != nil {
+	if err := b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseGroupFields(current.Description)
+		fields.Members = members
+		return FormatGroupDescription(current.Title, fields), nil
+	}); err != nil {
 		return nil, err
 	}
 
@@ -249,7 +250,7 @@ func (b *Beads) UpdateGroupMembers(name string, members []string) (*Issue, error
 
 // AddGroupMember adds a member to a group if not already present.
 func (b *Beads) AddGroupMember(name string, member string) (*Issue, error) {
-	issue, fields, err := b.GetGroupByName(name)
+	issue, _, err := b.GetGroupByName(name)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return nil, fmt.Errorf("group %q not found", name)
@@ -257,17 +258,16 @@ func (b *Beads) AddGroupMember(name string, member string) (*Issue, error) {
 		return nil, err
 	}
 
-	// Check if already a member
-	for _, m := range fields.Members {
-		if m == member {
-			return issue, nil // Already a member
+	if err := b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseGroupFields(current.Description)
+		for _, m := range fields.Members {
+			if m == member {
+				return current.Description, nil // Already a member
+			}
 		}
-	}
-
-	fields.Members = append(fields.Members, member)
-	description := FormatGroupDescription(issue.Title, fields)
-
-	if err := b.Update(issue.ID, UpdateOptions{Description: &description}); err != nil {
+		fields.Members = append(fields.Members, member)
+		return FormatGroupDescription(current.Title, fields), nil
+	}); err != nil {
 		return nil, err
 	}
 
@@ -280,7 +280,7 @@ func (b *Beads) AddGroupMember(name string, member string) (*Issue, error) {
 
 // RemoveGroupMember removes a member from a group.
 func (b *Beads) RemoveGroupMember(name string, member string) (*Issue, error) {
-	issue, fields, err := b.GetGroupByName(name)
+	issue, _, err := b.GetGroupByName(name)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return nil, fmt.Errorf("group %q not found", name)
@@ -288,18 +288,18 @@ func (b *Beads) RemoveGroupMember(name string, member string) (*Issue, error) {
 		return nil, err
 	}
 
-	// Filter out the member
-	var newMembers []string
-	for _, m := range fields.Members {
-		if m != member {
-			newMembers = append(newMembers, m)
+	if err := b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		fields := ParseGroupFields(current.Description)
+		// Filter out the member
+		var newMembers []string
+		for _, m := range fields.Members {
+			if m != member {
+				newMembers = append(newMembers, m)
+			}
 		}
-	}
-
-	fields.Members = newMembers
-	description := FormatGroupDescription(issue.Title, fields)
-
-	if err := b.Update(issue.ID, UpdateOptions{Description: &description}); err != nil {
+		fields.Members = newMembers
+		return FormatGroupDescription(current.Title, fields), nil
+	}); err != nil {
 		return nil, err
 	}
 
@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 )
 
 // MergeSlotStatus represents the result of checking a merge slot.
@@ -110,56 +111,42 @@ func (b *Beads) MergeSlotAcquire(holder string, addWaiter bool) (*MergeSlotStatu
 		return nil, fmt.Errorf("acquiring merge slot: %w", err)
 	}
 
-	data := parseMergeSlotData(issue)
+	var status *MergeSlotStatus
+	err = b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		data := parseMergeSlotData(current)
 
-	if data.Holder != "" && data.Holder != holder {
-		// Slot is held by someone else.
-		if addWaiter {
-			// Add to waiters list if not already present.
-			alreadyWaiting := false
-			for _, w := range data.Waiters {
-				if w == holder {
-					alreadyWaiting = true
-					break
-				}
-			}
-			if !alreadyWaiting {
-				data.Waiters = append(data.Waiters, holder)
-				newDesc, _ := json.Marshal(data)
-				desc := string(newDesc)
-				_ = b.Update(issue.ID, UpdateOptions{Description: &desc})
+		if data.Holder != "" && data.Holder != holder {
+			// Slot is held by someone else. Add to waiters list if not
+			// already present.
+			status = &MergeSlotStatus{ID: current.ID, Holder: data.Holder, Waiters: data.Waiters}
+			if !addWaiter || slices.Contains(data.Waiters, holder) {
+				return current.Description, nil
 			}
+			data.Waiters = append(data.Waiters, holder)
+			status.Waiters = data.Waiters
+			return formatMergeSlotData(data), nil
 		}
-		return &MergeSlotStatus{
-			ID:      issue.ID,
-			Holder:  data.Holder,
-			Waiters: data.Waiters,
-		}, nil
-	}
 
-	// Slot is available or we already hold it — acquire.
-	data.Holder = holder
-	// Remove from waiters if present.
-	filtered := data.Waiters[:0]
-	for _, w := range data.Waiters {
-		if w != holder {
-			filtered = append(filtered, w)
+		// Slot is available or we already hold it — acquire.
+		data.Holder = holder
+		// Remove from waiters if present.
+		filtered := data.Waiters[:0]
+		for _, w := range data.Waiters {
+			if w != holder {
+				filtered = append(filtered, w)
+			}
+		}
+		data.Waiters = filtered
+		status = &MergeSlotStatus{ID: current.ID, Holder: holder, Waiters: data.Waiters}
+		return formatMergeSlotData(data), nil
+	})
+	if err != nil {
+		if status != nil && status.Holder != holder {
+			return status, nil // The waiters list is informational
 		}
-	}
-	data.Waiters = filtered
-
-	newDesc, _ := json.Marshal(data)
-	desc := string(newDesc)
-	if err := b.Update(issue.ID, UpdateOptions{Description: &desc}); err != nil {
 		return nil, fmt.Errorf("acquiring merge slot: %w", err)
 	}
-
-	return &MergeSlotStatus{
-		ID:        issue.ID,
-		Available: false,
-		Holder:    holder,
-		Waiters:   data.Waiters,
-	}, nil
+	return status, nil
 }
 
 // MergeSlotRelease releases the merge slot after conflict resolution completes.
@@ -173,32 +160,35 @@ func (b *Beads) MergeSlotRelease(holder string) error {
 		return fmt.Errorf("releasing merge slot: %w", err)
 	}
 
-	data := parseMergeSlotData(issue)
-
-	if data.Holder == "" {
-		return nil // Already available
-	}
-	if holder != "" && data.Holder != holder {
-		return fmt.Errorf("slot release failed: held by %q, not %q", data.Holder, holder)
-	}
-
-	// Clear holder; promote first waiter if any.
-	var newHolder string
-	var remainingWaiters []string
-	if len(data.Waiters) > 0 {
-		newHolder = data.Waiters[0]
-		remainingWaiters = data.Waiters[1:]
-	}
-
-	newData := mergeSlotData{Holder: newHolder, Waiters: remainingWaiters}
-	newDesc, _ := json.Marshal(newData)
-	desc := string(newDesc)
+	var held error
+	err = b.UpdateDescription(issue.ID, func(current *Issue) (string, error) {
+		data := parseMergeSlotData(current)
+		if data.Holder == "" {
+			return current.Description, nil // Already available
+		}
+		if holder != "" && data.Holder != holder {
+			held = fmt.Errorf("slot release failed: held by %q, not %q", data.Holder, holder)
+			return "", held
+		}
 
-	if err := b.Update(issue.ID, UpdateOptions{Description: &desc}); err != nil {
+		// Clear holder; promote first waiter if any.
+		var newData mergeSlotData
+		if len(data.Waiters) > 0 {
+			newData.Holder = data.Waiters[0]
+			newData.Waiters = data.Waiters[1:]
+		}
+		return formatMergeSlotData(newData), nil
+	})
+	if err != nil && !errors.Is(err, held) {
 		return fmt.Errorf("releasing merge slot: %w", err)
 	}
+	return err
+}
 
-	return nil
+// formatMergeSlotData encodes the merge slot state for the bead's Description.
+func formatMergeSlotData(data mergeSlotData) string {
+	desc, _ := json.Marshal(data)
+	return string(desc)
 }
 
 // MergeSlotEnsureExists creates the merge slot if it doesn't exist.
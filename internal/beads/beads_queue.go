@@ -223,32 +223,32 @@ func (b *Beads) GetQueueBead(id string) (*Issue, *QueueFields, error) {
 
 // UpdateQueueFields updates the fields of a queue bead.
 func (b *Beads) UpdateQueueFields(id string, fields *QueueFields) error {
-	issue, err := b.Show(id)
-	if err != nil {
-		return err
-	}
+	return b.UpdateDescription(id, func(issue *Issue) (string, error) {
+		return FormatQueueDescription(issue.Title, fields), nil
+	})
+}
 
-	description := FormatQueueDescription(issue.Title, fields)
-	return b.Update(id, UpdateOptions{Description: &description})
+// updateQueueBead applies update to a queue bead's current fields.
+func (b *Beads) updateQueueBead(id string, update func(fields *QueueFields)) error {
+	return b.UpdateDescription(id, func(issue *Issue) (string, error) {
+		if !HasLabel(issue, "gt:queue") {
+			return "", fmt.Errorf("issue %s is not a queue bead (missing gt:queue label)", id)
+		}
+		fields := ParseQueueFields(issue.Description)
+		update(fields)
+		return FormatQueueDescription(issue.Title, fields), nil
+	})
 }
 
 // UpdateQueueCounts updates the count fields of a queue bead.
 // This is a convenience method for incrementing/decrementing counts.
 func (b *Beads) UpdateQueueCounts(id string, available, processing, completed, failed int) error {
-	issue, currentFields, err := b.GetQueueBead(id)
-	if err != nil {
-		return err
-	}
-	if issue == nil {
-		return ErrNotFound
-	}
-
-	currentFields.AvailableCount = available
-	currentFields.ProcessingCount = processing
-	currentFields.CompletedCount = completed
-	currentFields.FailedCount = failed
-
-	return b.UpdateQueueFields(id, currentFields)
+	return b.updateQueueBead(id, func(fields *QueueFields) {
+		fields.AvailableCount = available
+		fields.ProcessingCount = processing
+		fields.CompletedCount = completed
+		fields.FailedCount = failed
+	})
 }
 
 // UpdateQueueStatus updates the status of a queue bead.
@@ -258,16 +258,9 @@ func (b *Beads) UpdateQueueStatus(id, status string) error {
 		return fmt.Errorf("invalid queue status %q: must be active, paused, or closed", status)
 	}
 
-	issue, currentFields, err := b.GetQueueBead(id)
-	if err != nil {
-		return err
-	}
-	if issue == nil {
-		return ErrNotFound
-	}
-
-	currentFields.Status = status
-	return b.UpdateQueueFields(id, currentFields)
+	return b.updateQueueBead(id, func(fields *QueueFields) {
+		fields.Status = status
+	})
 }
 
 // ListQueueBeads returns all queue beads.
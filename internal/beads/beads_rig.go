@@ -228,9 +228,9 @@ func (b *Beads) UpdateRigBead(name string, fields *RigFields) (*Issue, error) {
 		return nil, err
 	}
 
-	description := FormatRigDescription(name, fields)
-
-	if err := b.Update(issue.ID, UpdateOptions{Description: &description}); err != nil {
+	if err := b.UpdateDescription(issue.ID, func(*Issue) (string, error) {
+		return FormatRigDescription(name, fields), nil
+	}); err != nil {
 		return nil, err
 	}
 
@@ -127,8 +127,22 @@ func (b *Beads) CloseSlingContext(contextID, reason string) error {
 	return err
 }
 
-// UpdateSlingContextFields updates the description (fields) of a sling context bead.
-func (b *Beads) UpdateSlingContextFields(contextID string, fields *capacity.SlingContextFields) error {
-	description := FormatSlingContextDescription(fields)
-	return b.Update(contextID, UpdateOptions{Description: &description})
+// MutateSlingContextFields applies mutate to a sling context bead's current
+// fields and writes them back, redoing the read if the bead changes in the
+// meantime (see UpdateDescription). Returns the fields as written.
+func (b *Beads) MutateSlingContextFields(contextID string, mutate func(fields *capacity.SlingContextFields)) (*capacity.SlingContextFields, error) {
+	var result *capacity.SlingContextFields
+	err := b.UpdateDescription(contextID, func(issue *Issue) (string, error) {
+		fields := ParseSlingContextFields(issue.Description)
+		if fields == nil {
+			return "", fmt.Errorf("sling context %s has no valid fields", contextID)
+		}
+		mutate(fields)
+		result = fields
+		return FormatSlingContextDescription(fields), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
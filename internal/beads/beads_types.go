@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -143,9 +142,9 @@ func EnsureCustomTypes(beadsDir string) error {
 	if dbEnv := DatabaseEnv(beadsDir); dbEnv != "" {
 		bdEnv = append(bdEnv, dbEnv)
 	}
-	cmd := exec.Command("bd", "config", "set", "types.custom", typesList)
+	cmd := Command("config", "set", "types.custom", typesList)
 	cmd.Dir = beadsDir
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	// Set BEADS_DIR and BEADS_DOLT_SERVER_DATABASE explicitly to ensure bd
 	// operates on the correct database. Strip inherited values first —
 	// getenv() returns the first match (gt-uygpe).
@@ -160,10 +159,10 @@ func EnsureCustomTypes(beadsDir string) error {
 	// database (redirect mismatch, stale metadata, server not running).
 	// Without this check, the sentinel file below would cache a lie,
 	// causing all future EnsureCustomTypes calls to skip re-configuration.
-	verifyCmd := exec.Command("bd", "config", "get", "types.custom")
+	verifyCmd := Command("config", "get", "types.custom")
 	verifyCmd.Dir = beadsDir
 	verifyCmd.Env = bdEnv
-	util.SetDetachedProcessGroup(verifyCmd)
+	util.SetDetachedProcessGroup(verifyCmd.Cmd)
 	if verifyOutput, err := verifyCmd.Output(); err != nil || !strings.Contains(string(verifyOutput), "agent") {
 		return fmt.Errorf("types.custom not persisted in %s after bd config set (verify returned %q): db may be misconfigured",
 			beadsDir, strings.TrimSpace(string(verifyOutput)))
@@ -222,9 +221,9 @@ func EnsureCustomStatuses(beadsDir string) error {
 	}
 
 	// Read current custom statuses and merge with required ones
-	getCmd := exec.Command("bd", "config", "get", "status.custom")
+	getCmd := Command("config", "get", "status.custom")
 	getCmd.Dir = beadsDir
-	util.SetDetachedProcessGroup(getCmd)
+	util.SetDetachedProcessGroup(getCmd.Cmd)
 	getEnv := append(stripEnvPrefixes(os.Environ(), "BEADS_DIR=", "BEADS_DB=", "BEADS_DOLT_SERVER_DATABASE="), "BEADS_DIR="+beadsDir)
 	if dbEnv := DatabaseEnv(beadsDir); dbEnv != "" {
 		getEnv = append(getEnv, dbEnv)
@@ -255,9 +254,9 @@ func EnsureCustomStatuses(beadsDir string) error {
 	mergedStr := strings.Join(merged, ",")
 
 	// Configure custom statuses via bd CLI
-	cmd := exec.Command("bd", "config", "set", "status.custom", mergedStr)
+	cmd := Command("config", "set", "status.custom", mergedStr)
 	cmd.Dir = beadsDir
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	setEnv := append(stripEnvPrefixes(os.Environ(), "BEADS_DIR=", "BEADS_DB=", "BEADS_DOLT_SERVER_DATABASE="), "BEADS_DIR="+beadsDir)
 	if dbEnv := DatabaseEnv(beadsDir); dbEnv != "" {
 		setEnv = append(setEnv, dbEnv)
@@ -342,9 +341,9 @@ func ensureDatabaseInitialized(beadsDir string) error {
 		initArgs = append(initArgs, "--prefix", prefix)
 	}
 	initArgs = append(initArgs, "--server")
-	cmd := exec.Command("bd", initArgs...)
+	cmd := Command(initArgs...)
 	cmd.Dir = parentDir
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	initEnv := append(stripEnvPrefixes(os.Environ(), "BEADS_DIR=", "BEADS_DB=", "BEADS_DOLT_SERVER_DATABASE="), "BEADS_DIR="+beadsDir)
 	if dbEnv := DatabaseEnv(beadsDir); dbEnv != "" {
 		initEnv = append(initEnv, dbEnv)
@@ -364,9 +363,9 @@ func ensureDatabaseInitialized(beadsDir string) error {
 	// Explicitly set issue_prefix — bd init --prefix may not persist it
 	// in newer versions (see rig/manager.go InitBeads).
 	if prefix != "" {
-		pfxCmd := exec.Command("bd", "config", "set", "issue_prefix", prefix)
+		pfxCmd := Command("config", "set", "issue_prefix", prefix)
 		pfxCmd.Dir = parentDir
-		util.SetDetachedProcessGroup(pfxCmd)
+		util.SetDetachedProcessGroup(pfxCmd.Cmd)
 		pfxEnv := append(stripEnvPrefixes(os.Environ(), "BEADS_DIR=", "BEADS_DB=", "BEADS_DOLT_SERVER_DATABASE="), "BEADS_DIR="+beadsDir)
 		if dbEnv := DatabaseEnv(beadsDir); dbEnv != "" {
 			pfxEnv = append(pfxEnv, dbEnv)
@@ -386,18 +385,18 @@ func ensureDatabaseInitialized(beadsDir string) error {
 	if dbEnv := DatabaseEnv(beadsDir); dbEnv != "" {
 		migrateEnv = append(migrateEnv, dbEnv)
 	}
-	migrateCmd := exec.Command("bd", "migrate", "--yes")
+	migrateCmd := Command("migrate", "--yes")
 	migrateCmd.Dir = parentDir
 	migrateCmd.Env = migrateEnv
-	util.SetDetachedProcessGroup(migrateCmd)
+	util.SetDetachedProcessGroup(migrateCmd.Cmd)
 	if _, err := migrateCmd.CombinedOutput(); err != nil {
 		// First attempt failed — server may not have registered the database yet.
 		// Wait briefly and retry once.
 		time.Sleep(500 * time.Millisecond)
-		retryCmd := exec.Command("bd", "migrate", "--yes")
+		retryCmd := Command("migrate", "--yes")
 		retryCmd.Dir = parentDir
 		retryCmd.Env = migrateEnv
-		util.SetDetachedProcessGroup(retryCmd)
+		util.SetDetachedProcessGroup(retryCmd.Cmd)
 		_, _ = retryCmd.CombinedOutput() // Best effort on retry — CreateAgentBead fallback handles failure
 	}
 
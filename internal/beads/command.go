@@ -0,0 +1,95 @@
+package beads
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Cmd is a bd invocation that goes through the town's bd limiter (see
+// limiter.go). It embeds the *exec.Cmd so callers set Dir, Env, Stdin,
+// Stdout and Stderr as usual; Run, Output, CombinedOutput and Start/Wait
+// take a rate token and hold a bd slot while bd runs. Use it for every bd
+// call that doesn't go through Beads or gt's BdCmd builder.
+type Cmd struct {
+	*exec.Cmd
+	release func()
+}
+
+// Command returns a limited bd command with the given arguments.
+func Command(args ...string) *Cmd {
+	return &Cmd{Cmd: exec.Command("bd", args...)} //nolint:gosec // G204: bd is a trusted internal tool
+}
+
+// CommandContext is Command with a context that kills bd when done.
+func CommandContext(ctx context.Context, args ...string) *Cmd {
+	return &Cmd{Cmd: exec.CommandContext(ctx, "bd", args...)} //nolint:gosec // G204: bd is a trusted internal tool
+}
+
+// townRoot returns the town whose limits apply: GT_ROOT in the command's
+// environment if set, else the town containing its working directory.
+func (c *Cmd) townRoot() string {
+	env := c.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	for i := len(env) - 1; i >= 0; i-- {
+		if v, ok := strings.CutPrefix(env[i], "GT_ROOT="); ok && v != "" {
+			return v
+		}
+	}
+	dir := c.Dir
+	if dir == "" {
+		dir, _ = os.Getwd()
+	}
+	return FindTownRoot(dir)
+}
+
+// Run starts bd and waits for it, holding a bd slot throughout.
+func (c *Cmd) Run() error {
+	defer AcquireBdSlot(c.townRoot())()
+	return c.Cmd.Run()
+}
+
+// Output runs bd and returns its stdout, holding a bd slot throughout.
+func (c *Cmd) Output() ([]byte, error) {
+	defer AcquireBdSlot(c.townRoot())()
+	return c.Cmd.Output()
+}
+
+// CombinedOutput runs bd and returns its stdout and stderr, holding a bd
+// slot throughout.
+func (c *Cmd) CombinedOutput() ([]byte, error) {
+	defer AcquireBdSlot(c.townRoot())()
+	return c.Cmd.CombinedOutput()
+}
+
+// Start takes a bd slot and starts bd. Wait releases the slot.
+func (c *Cmd) Start() error {
+	c.release = AcquireBdSlot(c.townRoot())
+	if err := c.Cmd.Start(); err != nil {
+		c.done()
+		return err
+	}
+	return nil
+}
+
+// Wait waits for bd to exit and releases the slot Start took.
+func (c *Cmd) Wait() error {
+	defer c.done()
+	return c.Cmd.Wait()
+}
+
+func (c *Cmd) done() {
+	if c.release != nil {
+		c.release()
+		c.release = nil
+	}
+}
+
+// Limit wraps cmd, which runs bd by some other path (such as the daemon's
+// resolved bdPath), so it goes through the town's bd limiter like Command.
+func Limit(cmd *exec.Cmd) *Cmd {
+	return &Cmd{Cmd: cmd}
+}
@@ -0,0 +1,49 @@
+package beads
+
+import "strings"
+
+// Markers delimiting the compaction snapshot section of a work bead's
+// description. HTML comments keep the markers out of rendered markdown.
+const (
+	compactSnapshotStart = "<!-- gt:compact-snapshot -->"
+	compactSnapshotEnd   = "<!-- /gt:compact-snapshot -->"
+)
+
+// CompactSnapshot returns the compaction snapshot stored in a description,
+// or "" if there is none.
+func CompactSnapshot(description string) string {
+	start := strings.Index(description, compactSnapshotStart)
+	if start == -1 {
+		return ""
+	}
+	body := description[start+len(compactSnapshotStart):]
+	end := strings.Index(body, compactSnapshotEnd)
+	if end == -1 {
+		return ""
+	}
+	return strings.TrimSpace(body[:end])
+}
+
+// SetCompactSnapshot returns description with its compaction snapshot
+// replaced by snapshot, appending the section if there is none yet. An
+// empty snapshot removes the section. The rest of the description is left
+// untouched, so attachment fields and human edits survive.
+func SetCompactSnapshot(description, snapshot string) string {
+	rest := description
+	if start := strings.Index(description, compactSnapshotStart); start != -1 {
+		if end := strings.Index(description[start:], compactSnapshotEnd); end != -1 {
+			rest = strings.TrimRight(description[:start], "\n") + description[start+end+len(compactSnapshotEnd):]
+		}
+	}
+	rest = strings.TrimRight(rest, "\n")
+	snapshot = strings.TrimSpace(snapshot)
+	if snapshot == "" {
+		return rest
+	}
+
+	section := compactSnapshotStart + "\n" + snapshot + "\n" + compactSnapshotEnd
+	if rest == "" {
+		return section
+	}
+	return rest + "\n\n" + section
+}
@@ -0,0 +1,41 @@
+package beads
+
+import "testing"
+
+func TestSetCompactSnapshot(t *testing.T) {
+	const fields = "Fix the login bug\n\nattached_molecule: gt-wisp-1"
+
+	desc := SetCompactSnapshot(fields, "Current step: write tests")
+	if got := CompactSnapshot(desc); got != "Current step: write tests" {
+		t.Fatalf("CompactSnapshot = %q", got)
+	}
+	if f := ParseAttachmentFields(&Issue{Description: desc}); f == nil || f.AttachedMolecule != "gt-wisp-1" {
+		t.Errorf("attachment fields lost: %+v", f)
+	}
+
+	// A newer snapshot replaces the old one rather than piling up.
+	desc = SetCompactSnapshot(desc+"\n\nHuman note added later", "Current step: open MR")
+	if got := CompactSnapshot(desc); got != "Current step: open MR" {
+		t.Errorf("CompactSnapshot after replace = %q", got)
+	}
+	want := fields + "\n\nHuman note added later\n\n" + compactSnapshotStart + "\nCurrent step: open MR\n" + compactSnapshotEnd
+	if desc != want {
+		t.Errorf("description =\n%s\nwant\n%s", desc, want)
+	}
+
+	// An empty snapshot removes the section.
+	if got := SetCompactSnapshot(desc, ""); got != fields+"\n\nHuman note added later" {
+		t.Errorf("cleared description = %q", got)
+	}
+}
+
+func TestCompactSnapshot_None(t *testing.T) {
+	for _, desc := range []string{"", "plain description", compactSnapshotStart + "\nunterminated"} {
+		if got := CompactSnapshot(desc); got != "" {
+			t.Errorf("CompactSnapshot(%q) = %q, want empty", desc, got)
+		}
+	}
+	if got := SetCompactSnapshot("", "snap"); got != compactSnapshotStart+"\nsnap\n"+compactSnapshotEnd {
+		t.Errorf("SetCompactSnapshot on empty description = %q", got)
+	}
+}
@@ -0,0 +1,63 @@
+package beads
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDescriptionConflict is returned when a description kept changing
+// underneath a read-modify-write and the update was given up.
+var ErrDescriptionConflict = errors.New("description changed concurrently")
+
+// descriptionUpdateAttempts bounds the retries of ApplyDescriptionUpdate.
+const descriptionUpdateAttempts = 3
+
+// ApplyDescriptionUpdate rewrites a bead's description with optimistic
+// concurrency: mutate derives the new description from a fresh read, and the
+// write only goes ahead if the description is still what mutate saw.
+// If someone else (often a human editing the bead) changed it in between,
+// the read and mutate are redone on top of their edit.
+//
+// bd has no conditional update, so this narrows the window in which a
+// concurrent edit can be clobbered to the gap between the check and the
+// write; it does not close it. mutate may run more than once and must
+// derive everything from the issue it is given. Returning the description
+// unchanged skips the write.
+func ApplyDescriptionUpdate(read func() (*Issue, error), write func(description string) error, mutate func(issue *Issue) (string, error)) error {
+	for attempt := 0; attempt < descriptionUpdateAttempts; attempt++ {
+		issue, err := read()
+		if err != nil {
+			return err
+		}
+		base := issue.Description
+		description, err := mutate(issue)
+		if err != nil {
+			return err
+		}
+		if description == base {
+			return nil
+		}
+
+		current, err := read()
+		if err != nil {
+			return err
+		}
+		if current.Description != base {
+			continue
+		}
+		return write(description)
+	}
+	return fmt.Errorf("%w after %d attempts", ErrDescriptionConflict, descriptionUpdateAttempts)
+}
+
+// UpdateDescription applies mutate to the bead's current description and
+// writes the result (see ApplyDescriptionUpdate). All read-modify-write
+// updates of a description should go through here rather than pairing Show
+// with Update.
+func (b *Beads) UpdateDescription(id string, mutate func(issue *Issue) (string, error)) error {
+	return ApplyDescriptionUpdate(
+		func() (*Issue, error) { return b.Show(id) },
+		func(description string) error { return b.Update(id, UpdateOptions{Description: &description}) },
+		mutate,
+	)
+}
@@ -0,0 +1,87 @@
+package beads
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// fakeDescriptionStore is a bead whose description a "human" may edit
+// between reads, via edits consumed one per read.
+type fakeDescriptionStore struct {
+	description string
+	edits       []string // applied before each read, in order; "" = no edit
+	writes      int
+}
+
+func (f *fakeDescriptionStore) read() (*Issue, error) {
+	if len(f.edits) > 0 {
+		if f.edits[0] != "" {
+			f.description = f.edits[0]
+		}
+		f.edits = f.edits[1:]
+	}
+	return &Issue{ID: "gt-abc", Description: f.description}, nil
+}
+
+func (f *fakeDescriptionStore) write(description string) error {
+	f.writes++
+	f.description = description
+	return nil
+}
+
+func appendLine(line string) func(*Issue) (string, error) {
+	return func(issue *Issue) (string, error) {
+		return issue.Description + "\n" + line, nil
+	}
+}
+
+func TestApplyDescriptionUpdate(t *testing.T) {
+	f := &fakeDescriptionStore{description: "original"}
+	if err := ApplyDescriptionUpdate(f.read, f.write, appendLine("dispatched_by: mayor")); err != nil {
+		t.Fatalf("ApplyDescriptionUpdate: %v", err)
+	}
+	if f.description != "original\ndispatched_by: mayor" || f.writes != 1 {
+		t.Errorf("description = %q after %d writes", f.description, f.writes)
+	}
+}
+
+func TestApplyDescriptionUpdate_RedoesOnConcurrentEdit(t *testing.T) {
+	// The human edits the bead between our read and our check.
+	f := &fakeDescriptionStore{description: "original", edits: []string{"", "edited by human"}}
+	if err := ApplyDescriptionUpdate(f.read, f.write, appendLine("dispatched_by: mayor")); err != nil {
+		t.Fatalf("ApplyDescriptionUpdate: %v", err)
+	}
+	if f.description != "edited by human\ndispatched_by: mayor" {
+		t.Errorf("description = %q, want the human's edit kept", f.description)
+	}
+	if f.writes != 1 {
+		t.Errorf("writes = %d, want 1", f.writes)
+	}
+}
+
+func TestApplyDescriptionUpdate_GivesUp(t *testing.T) {
+	var edits []string
+	for i := 0; i < 2*descriptionUpdateAttempts; i++ {
+		edits = append(edits, strings.Repeat("x", i+1))
+	}
+	f := &fakeDescriptionStore{description: "original", edits: edits}
+	err := ApplyDescriptionUpdate(f.read, f.write, appendLine("dispatched_by: mayor"))
+	if !errors.Is(err, ErrDescriptionConflict) {
+		t.Fatalf("err = %v, want ErrDescriptionConflict", err)
+	}
+	if f.writes != 0 {
+		t.Errorf("writes = %d, want none", f.writes)
+	}
+}
+
+func TestApplyDescriptionUpdate_NoChangeSkipsWrite(t *testing.T) {
+	f := &fakeDescriptionStore{description: "original"}
+	unchanged := func(issue *Issue) (string, error) { return issue.Description, nil }
+	if err := ApplyDescriptionUpdate(f.read, f.write, unchanged); err != nil {
+		t.Fatalf("ApplyDescriptionUpdate: %v", err)
+	}
+	if f.writes != 0 {
+		t.Errorf("writes = %d, want 0 for an unchanged description", f.writes)
+	}
+}
@@ -26,6 +26,8 @@ type AttachmentFields struct {
 	MergeStrategy    string // Convoy merge strategy: "direct", "mr", "local", or "" (default = mr)
 	ConvoyOwned      bool   // If true, convoy has gt:owned label (caller-managed lifecycle)
 	FormulaVars      string // Newline-separated key=value pairs for formula template substitution
+	BatchBeads       []string // Beads batched onto this one's polecat (closed with it on merge)
+	CorrelationID    string // Ties this work's events together, from enqueue to merge
 }
 
 // ParseAttachmentFields extracts attachment fields from an issue's description.
@@ -97,6 +99,12 @@ func ParseAttachmentFields(issue *Issue) *AttachmentFields {
 		case "formula_vars", "formula-vars", "formulavars":
 			fields.FormulaVars = value
 			hasFields = true
+		case "batch_beads", "batch-beads", "batchbeads":
+			fields.BatchBeads = splitBatchBeads(value)
+			hasFields = true
+		case "correlation_id", "correlation-id", "correlationid":
+			fields.CorrelationID = value
+			hasFields = true
 		}
 	}
 
@@ -154,6 +162,12 @@ func FormatAttachmentFields(fields *AttachmentFields) string {
 	if fields.FormulaVars != "" {
 		lines = append(lines, "formula_vars: "+fields.FormulaVars)
 	}
+	if len(fields.BatchBeads) > 0 {
+		lines = append(lines, "batch_beads: "+strings.Join(fields.BatchBeads, ","))
+	}
+	if fields.CorrelationID != "" {
+		lines = append(lines, "correlation_id: "+fields.CorrelationID)
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -202,6 +216,12 @@ func SetAttachmentFields(issue *Issue, fields *AttachmentFields) string {
 		"formula_vars":      true,
 		"formula-vars":      true,
 		"formulavars":       true,
+		"batch_beads":       true,
+		"batch-beads":       true,
+		"batchbeads":        true,
+		"correlation_id":    true,
+		"correlation-id":    true,
+		"correlationid":     true,
 	}
 
 	// Collect non-attachment lines from existing description
@@ -262,6 +282,7 @@ type ConvoyFields struct {
 	BaseBranch    string // Target branch for polecats (e.g., "feat/extraction-review")
 	Watchers      string // Comma-separated mail notification addresses (added via gt convoy watch)
 	NudgeWatchers string // Comma-separated nudge notification addresses (added via gt convoy watch --nudge)
+	MergeSequence string // Comma-separated tracked issues whose MRs merge in this order (gt convoy create --sequential)
 }
 
 // ParseConvoyFields extracts convoy fields from an issue's description.
@@ -313,6 +334,9 @@ func ParseConvoyFields(issue *Issue) *ConvoyFields {
 		case "nudge_watchers", "nudge-watchers", "nudgewatchers":
 			fields.NudgeWatchers = value
 			hasFields = true
+		case "merge_sequence", "merge-sequence", "mergesequence":
+			fields.MergeSequence = value
+			hasFields = true
 		}
 	}
 
@@ -425,6 +449,15 @@ func (f *ConvoyFields) RemoveNudgeWatcher(addr string) bool {
 	return found
 }
 
+// MergeSequenceIDs returns the tracked issues whose MRs must merge in order,
+// or nil when the convoy's MRs merge independently.
+func (f *ConvoyFields) MergeSequenceIDs() []string {
+	if f == nil {
+		return nil
+	}
+	return splitWatchers(f.MergeSequence)
+}
+
 // splitWatchers splits a comma-separated watcher string into trimmed, non-empty addresses.
 func splitWatchers(s string) []string {
 	if s == "" {
@@ -470,6 +503,9 @@ func FormatConvoyFields(fields *ConvoyFields) string {
 	if fields.NudgeWatchers != "" {
 		lines = append(lines, "nudge_watchers: "+fields.NudgeWatchers)
 	}
+	if fields.MergeSequence != "" {
+		lines = append(lines, "merge_sequence: "+fields.MergeSequence)
+	}
 
 	return strings.Join(lines, "\n")
 }
@@ -485,6 +521,17 @@ func formatAttachedVars(vars []string) string {
 	return string(encoded)
 }
 
+// splitBatchBeads parses a comma-separated batch_beads value.
+func splitBatchBeads(raw string) []string {
+	var ids []string
+	for _, id := range strings.Split(raw, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
 func parseAttachedVars(raw string) []string {
 	if raw == "" {
 		return nil
@@ -519,6 +566,9 @@ func SetConvoyFields(issue *Issue, fields *ConvoyFields) string {
 		"nudge_watchers":  true,
 		"nudge-watchers":  true,
 		"nudgewatchers":   true,
+		"merge_sequence":  true,
+		"merge-sequence":  true,
+		"mergesequence":   true,
 	}
 
 	// Collect non-convoy lines from existing description
@@ -584,6 +634,10 @@ type MRFields struct {
 	LastConflictSHA string // SHA of main when conflict occurred
 	ConflictTaskID  string // Link to conflict-resolution task (if any)
 
+	// CorrelationID ties the MR's merge events to the rest of the source
+	// issue's events (copied from its correlation_id at submission).
+	CorrelationID string
+
 	// Convoy tracking (for priority scoring - convoy starvation prevention)
 	ConvoyID        string // Parent convoy ID if part of a convoy
 	ConvoyCreatedAt string // Convoy creation time (ISO 8601) for starvation prevention
@@ -654,6 +708,9 @@ func ParseMRFields(issue *Issue) *MRFields {
 		case "agent_bead", "agent-bead", "agentbead":
 			fields.AgentBead = value
 			hasFields = true
+		case "correlation_id", "correlation-id", "correlationid":
+			fields.CorrelationID = value
+			hasFields = true
 		case "retry_count", "retry-count", "retrycount":
 			if n, err := parseIntField(value); err == nil {
 				fields.RetryCount = n
@@ -732,6 +789,9 @@ func FormatMRFields(fields *MRFields) string {
 	if fields.AgentBead != "" {
 		lines = append(lines, "agent_bead: "+fields.AgentBead)
 	}
+	if fields.CorrelationID != "" {
+		lines = append(lines, "correlation_id: "+fields.CorrelationID)
+	}
 	if fields.RetryCount > 0 {
 		lines = append(lines, fmt.Sprintf("retry_count: %d", fields.RetryCount))
 	}
@@ -786,6 +846,9 @@ func SetMRFields(issue *Issue, fields *MRFields) string {
 		"agent_bead":         true,
 		"agent-bead":         true,
 		"agentbead":          true,
+		"correlation_id":     true,
+		"correlation-id":     true,
+		"correlationid":      true,
 		"retry_count":        true,
 		"retry-count":        true,
 		"retrycount":         true,
@@ -61,6 +61,33 @@ func TestAttachmentFieldsModeRoundTrip(t *testing.T) {
 	}
 }
 
+func TestAttachmentFieldsBatchBeadsRoundTrip(t *testing.T) {
+	original := &AttachmentFields{
+		AttachedFormula: "mol-polecat-batch",
+		BatchBeads:      []string{"gt-b2", "gt-c3"},
+	}
+
+	formatted := FormatAttachmentFields(original)
+	if !strings.Contains(formatted, "batch_beads: gt-b2,gt-c3") {
+		t.Errorf("FormatAttachmentFields missing batch_beads field, got:\n%s", formatted)
+	}
+
+	issue := &Issue{Description: "Fix the typo\n\n" + formatted}
+	parsed := ParseAttachmentFields(issue)
+	if parsed == nil {
+		t.Fatal("round-trip parse returned nil")
+	}
+	if len(parsed.BatchBeads) != 2 || parsed.BatchBeads[0] != "gt-b2" || parsed.BatchBeads[1] != "gt-c3" {
+		t.Errorf("BatchBeads: got %v, want [gt-b2 gt-c3]", parsed.BatchBeads)
+	}
+
+	// Rewriting replaces the line rather than duplicating it.
+	issue.Description = SetAttachmentFields(issue, parsed)
+	if n := strings.Count(issue.Description, "batch_beads:"); n != 1 {
+		t.Errorf("batch_beads appears %d times after SetAttachmentFields:\n%s", n, issue.Description)
+	}
+}
+
 func TestSetAttachmentFieldsPreservesMode(t *testing.T) {
 	issue := &Issue{
 		Description: "mode: ralph\nattached_molecule: gt-wisp-old\nSome other content",
@@ -372,6 +399,21 @@ func TestConvoyFieldsParseFormatRoundTrip(t *testing.T) {
 	}
 }
 
+func TestConvoyFieldsMergeSequence(t *testing.T) {
+	issue := &Issue{Description: "Convoy tracking 3 issues\nOwner: mayor/"}
+	desc := SetConvoyFields(issue, &ConvoyFields{Owner: "mayor/", MergeSequence: "gt-a,gt-b,gt-c"})
+	parsed := ParseConvoyFields(&Issue{Description: desc})
+	if got := parsed.MergeSequenceIDs(); len(got) != 3 || got[0] != "gt-a" || got[2] != "gt-c" {
+		t.Errorf("MergeSequenceIDs() = %v, want [gt-a gt-b gt-c]", got)
+	}
+	if strings.Count(SetConvoyFields(&Issue{Description: desc}, parsed), "merge_sequence:") != 1 {
+		t.Error("SetConvoyFields should replace the existing merge_sequence line")
+	}
+	if got := (&ConvoyFields{Owner: "mayor/"}).MergeSequenceIDs(); got != nil {
+		t.Errorf("MergeSequenceIDs() without a sequence = %v, want nil", got)
+	}
+}
+
 func TestSetConvoyFieldsWithMixedContent(t *testing.T) {
 	issue := &Issue{Description: "Convoy tracking 3 issues\nOwner: old/\nSome prose line\nMerge: local\nAnother line"}
 	fields := &ConvoyFields{Owner: "new/", Merge: "direct", Molecule: "gt-mol-xyz"}
@@ -109,7 +109,9 @@ func (b *Beads) UpdateHandoffContent(role, content string) error {
 		return err
 	}
 
-	return b.Update(issue.ID, UpdateOptions{Description: &content})
+	return b.UpdateDescription(issue.ID, func(*Issue) (string, error) {
+		return content, nil
+	})
 }
 
 // ClearHandoffContent clears the handoff bead's description.
@@ -122,8 +124,9 @@ func (b *Beads) ClearHandoffContent(role string) error {
 		return nil // Nothing to clear
 	}
 
-	empty := ""
-	return b.Update(issue.ID, UpdateOptions{Description: &empty})
+	return b.UpdateDescription(issue.ID, func(*Issue) (string, error) {
+		return "", nil
+	})
 }
 
 // ClearMailResult contains statistics from a ClearMail operation.
@@ -228,11 +231,11 @@ func (b *Beads) AttachMolecule(pinnedBeadID, moleculeID string) (*Issue, error)
 		AttachedAt:       currentTimestamp(),
 	}
 
-	// Update description with attachment fields
-	newDesc := SetAttachmentFields(issue, fields)
-
-	// Update the issue
-	if err := b.Update(pinnedBeadID, UpdateOptions{Description: &newDesc}); err != nil {
+	// Update description with attachment fields, redoing the edit on top of
+	// any concurrent change
+	if err := b.UpdateDescription(pinnedBeadID, func(current *Issue) (string, error) {
+		return SetAttachmentFields(current, fields), nil
+	}); err != nil {
 		return nil, fmt.Errorf("updating pinned bead: %w", err)
 	}
 
@@ -262,11 +265,11 @@ func (b *Beads) DetachMolecule(pinnedBeadID string) (*Issue, error) {
 		return issue, nil // Nothing to detach
 	}
 
-	// Clear attachment fields by passing nil
-	newDesc := SetAttachmentFields(issue, nil)
-
-	// Update the issue
-	if err := b.Update(pinnedBeadID, UpdateOptions{Description: &newDesc}); err != nil {
+	// Clear attachment fields by passing nil, redoing the edit on top of any
+	// concurrent change
+	if err := b.UpdateDescription(pinnedBeadID, func(current *Issue) (string, error) {
+		return SetAttachmentFields(current, nil), nil
+	}); err != nil {
 		return nil, fmt.Errorf("updating pinned bead: %w", err)
 	}
 
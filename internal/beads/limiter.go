@@ -0,0 +1,308 @@
+package beads
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/telemetry"
+)
+
+// Town-wide bd rate and concurrency limits.
+//
+// Batch enqueues and multi-rig dispatch can start dozens of bd processes at
+// once, and Dolt answers with lock errors. Every bd call made through this
+// package, its Command runner, or gt's BdCmd builder goes through the town's
+// limiter, shared by all gt processes in the town:
+//
+//   - operational.dolt.bd_rate_limit paces how many calls start per second,
+//     as a token bucket holding up to bd_rate_burst tokens. The bucket is
+//     .runtime/bd-slots/bucket.json, updated under an flock.
+//   - operational.dolt.bd_max_concurrent caps calls running at once. A slot
+//     is an flock on .runtime/bd-slots/slot-N.lock, so slots held by a
+//     crashed process are released by the kernel.
+//
+// A call that cannot get a token and a slot within bd_queue_timeout runs
+// anyway rather than failing.
+
+// bdLimiterReload is how long a process trusts its cached limiter settings.
+const bdLimiterReload = time.Minute
+
+// Slot polling backoff bounds.
+const (
+	bdSlotPollMin = 5 * time.Millisecond
+	bdSlotPollMax = 250 * time.Millisecond
+)
+
+// BdSlotsDir returns the directory holding the town's bd slot locks and
+// queueing stats.
+func BdSlotsDir(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "bd-slots")
+}
+
+// bdLimiter is one town's bd token bucket and slot pool as seen by this
+// process.
+type bdLimiter struct {
+	dir     string
+	slots   int
+	rate    float64 // Tokens per second; 0 = no rate limit
+	burst   int
+	timeout time.Duration
+	loaded  time.Time
+}
+
+var (
+	bdLimitersMu sync.Mutex
+	bdLimiters   = map[string]*bdLimiter{}
+)
+
+// bdLimiterFor returns the limiter for townRoot, reloading its settings
+// once they are older than bdLimiterReload.
+func bdLimiterFor(townRoot string) *bdLimiter {
+	bdLimitersMu.Lock()
+	defer bdLimitersMu.Unlock()
+	l := bdLimiters[townRoot]
+	if l == nil || time.Since(l.loaded) > bdLimiterReload {
+		dolt := config.LoadOperationalConfig(townRoot).GetDoltConfig()
+		l = &bdLimiter{
+			dir:     BdSlotsDir(townRoot),
+			slots:   dolt.BdMaxConcurrentV(),
+			rate:    dolt.BdRateLimitV(),
+			burst:   dolt.BdRateBurstV(),
+			timeout: dolt.BdQueueTimeoutD(),
+			loaded:  time.Now(),
+		}
+		bdLimiters[townRoot] = l
+	}
+	return l
+}
+
+// AcquireBdSlot waits for a rate token and a bd slot in townRoot and
+// returns the function that releases the slot. It returns immediately when
+// the town has no limit configured or townRoot is empty.
+func AcquireBdSlot(townRoot string) (release func()) {
+	if townRoot == "" {
+		return func() {}
+	}
+	l := bdLimiterFor(townRoot)
+	if l.slots <= 0 && l.rate <= 0 {
+		return func() {}
+	}
+	release = func() {}
+	waited, ok := time.Duration(0), true
+	if l.rate > 0 {
+		waited, ok = l.takeToken(time.Now().Add(l.timeout))
+	}
+	if l.slots > 0 {
+		slotLimiter := *l
+		slotLimiter.timeout = max(0, l.timeout-waited)
+		var slotWait time.Duration
+		var slotOK bool
+		release, slotWait, slotOK = slotLimiter.acquire()
+		waited += slotWait
+		ok = ok && slotOK
+	}
+	if waited > 0 || !ok {
+		outcome := "acquired"
+		if !ok {
+			outcome = "timeout"
+		}
+		telemetry.RecordBDQueueWait(context.Background(), float64(waited.Milliseconds()), outcome)
+		recordBdQueueStats(l.dir, waited, !ok)
+	}
+	return release
+}
+
+// acquire takes a free slot, polling with jittered backoff until one frees
+// up or the timeout passes. ok is false when it gave up; release is then a
+// no-op.
+func (l *bdLimiter) acquire() (release func(), waited time.Duration, ok bool) {
+	if err := os.MkdirAll(l.dir, 0755); err != nil {
+		return func() {}, 0, false
+	}
+	start := time.Now()
+	first := rand.IntN(l.slots) // Spread callers across slots
+	poll := bdSlotPollMin
+	for {
+		for i := 0; i < l.slots; i++ {
+			path := filepath.Join(l.dir, fmt.Sprintf("slot-%d.lock", (first+i)%l.slots))
+			unlock, got, err := lock.FlockTryAcquire(path)
+			if err != nil {
+				// Can't use the slot files at all: don't block bd on it.
+				return func() {}, time.Since(start), false
+			}
+			if got {
+				if waited == 0 {
+					return unlock, 0, true // Free on the first pass: no queueing
+				}
+				return unlock, time.Since(start), true
+			}
+		}
+		waited = time.Since(start)
+		if waited >= l.timeout {
+			return func() {}, waited, false
+		}
+		time.Sleep(poll/2 + rand.N(poll))
+		poll = min(poll*2, bdSlotPollMax)
+	}
+}
+
+// bdBucket is the persisted state of a town's bd token bucket.
+type bdBucket struct {
+	Tokens float64   `json:"tokens"`
+	At     time.Time `json:"at"` // When Tokens was last computed
+}
+
+// takeToken takes one token from the town's bucket, sleeping until the
+// bucket refills enough. ok is false when the next token would only come
+// after deadline, or the bucket can't be used at all.
+func (l *bdLimiter) takeToken(deadline time.Time) (waited time.Duration, ok bool) {
+	if err := os.MkdirAll(l.dir, 0755); err != nil {
+		return 0, false
+	}
+	start := time.Now()
+	for {
+		wait, err := l.tryTakeToken(time.Now())
+		if err != nil {
+			return time.Since(start), false
+		}
+		if wait == 0 {
+			return time.Since(start), true
+		}
+		// Jitter so callers sleeping on the same refill don't all wake at once.
+		wait += rand.N(wait/4 + time.Millisecond)
+		if time.Now().Add(wait).After(deadline) {
+			return time.Since(start), false
+		}
+		time.Sleep(wait)
+	}
+}
+
+// tryTakeToken refills the bucket for the time since it was last computed
+// and takes a token if one is there. Otherwise it returns how long until
+// the next token.
+func (l *bdLimiter) tryTakeToken(now time.Time) (time.Duration, error) {
+	unlock, err := lock.FlockAcquire(filepath.Join(l.dir, "bucket.lock"))
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	path := filepath.Join(l.dir, "bucket.json")
+	b := bdBucket{Tokens: float64(l.burst), At: now}
+	if data, err := os.ReadFile(path); err == nil {
+		var cur bdBucket
+		if json.Unmarshal(data, &cur) == nil && !cur.At.IsZero() {
+			elapsed := max(0, now.Sub(cur.At).Seconds())
+			b.Tokens = min(float64(l.burst), cur.Tokens+elapsed*l.rate)
+		}
+	}
+
+	var wait time.Duration
+	if b.Tokens >= 1 {
+		b.Tokens--
+	} else {
+		wait = time.Duration((1 - b.Tokens) / l.rate * float64(time.Second))
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		return 0, err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return 0, err
+	}
+	return wait, os.Rename(tmp, path)
+}
+
+// BdQueueStats are cumulative queueing stats for a town's bd limiter,
+// covering only calls that had to wait.
+type BdQueueStats struct {
+	Waits       int64     `json:"waits"`         // Calls that waited for a token or found every slot busy
+	Timeouts    int64     `json:"timeouts"`      // Of those, calls that gave up and ran anyway
+	TotalWaitMs int64     `json:"total_wait_ms"` // Summed wait across Waits
+	MaxWaitMs   int64     `json:"max_wait_ms"`
+	LastWaitAt  time.Time `json:"last_wait_at,omitempty"`
+}
+
+// BdLimiterStatus describes a town's bd limiter at one moment.
+type BdLimiterStatus struct {
+	RateLimit     float64       `json:"rate_limit"` // Calls per second; 0 = unlimited
+	RateBurst     int           `json:"rate_burst"`
+	MaxConcurrent int           `json:"max_concurrent"` // 0 = unlimited
+	QueueTimeout  time.Duration `json:"queue_timeout"`
+	InUse         int           `json:"in_use"` // Slots held right now
+	Stats         BdQueueStats  `json:"stats"`
+}
+
+// GetBdLimiterStatus reports the town's bd limits, the slots in use, and
+// the queueing stats recorded so far.
+func GetBdLimiterStatus(townRoot string) BdLimiterStatus {
+	dolt := config.LoadOperationalConfig(townRoot).GetDoltConfig()
+	dir := BdSlotsDir(townRoot)
+	st := BdLimiterStatus{
+		MaxConcurrent: dolt.BdMaxConcurrentV(),
+		QueueTimeout:  dolt.BdQueueTimeoutD(),
+	}
+	if rate := dolt.BdRateLimitV(); rate > 0 {
+		st.RateLimit, st.RateBurst = rate, dolt.BdRateBurstV()
+	}
+	for i := 0; i < st.MaxConcurrent; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("slot-%d.lock", i))
+		if _, err := os.Stat(path); err != nil {
+			continue // Never used
+		}
+		unlock, got, err := lock.FlockTryAcquire(path)
+		if err != nil {
+			continue
+		}
+		if got {
+			unlock()
+		} else {
+			st.InUse++
+		}
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "stats.json")); err == nil {
+		_ = json.Unmarshal(data, &st.Stats)
+	}
+	return st
+}
+
+// recordBdQueueStats adds one wait to the town's queueing stats. Best-effort:
+// stats are dropped rather than delaying bd further.
+func recordBdQueueStats(dir string, waited time.Duration, timedOut bool) {
+	unlock, err := lock.FlockAcquire(filepath.Join(dir, "stats.lock"))
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	path := filepath.Join(dir, "stats.json")
+	var stats BdQueueStats
+	if data, err := os.ReadFile(path); err == nil {
+		_ = json.Unmarshal(data, &stats)
+	}
+	ms := waited.Milliseconds()
+	stats.Waits++
+	stats.TotalWaitMs += ms
+	stats.MaxWaitMs = max(stats.MaxWaitMs, ms)
+	stats.LastWaitAt = time.Now()
+	if timedOut {
+		stats.Timeouts++
+	}
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err == nil {
+		_ = os.Rename(tmp, path)
+	}
+}
@@ -0,0 +1,102 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBdLimiterAcquire(t *testing.T) {
+	dir := t.TempDir()
+	l := &bdLimiter{dir: dir, slots: 1, timeout: 50 * time.Millisecond}
+
+	release, waited, ok := l.acquire()
+	if !ok || waited != 0 {
+		t.Fatalf("first acquire = waited %v, ok %v; want immediate slot", waited, ok)
+	}
+
+	// The only slot is held: the next caller waits out the timeout and
+	// proceeds without one.
+	_, waited, ok = l.acquire()
+	if ok || waited < l.timeout {
+		t.Errorf("acquire on full pool = waited %v, ok %v; want timeout", waited, ok)
+	}
+
+	release()
+	release2, waited, ok := l.acquire()
+	if !ok || waited != 0 {
+		t.Errorf("acquire after release = waited %v, ok %v; want immediate slot", waited, ok)
+	}
+	release2()
+}
+
+func TestBdLimiterStatus(t *testing.T) {
+	town := t.TempDir()
+	dir := BdSlotsDir(town)
+	l := &bdLimiter{dir: dir, slots: 2, timeout: time.Second}
+	release, _, _ := l.acquire()
+	defer release()
+
+	recordBdQueueStats(dir, 30*time.Millisecond, false)
+	recordBdQueueStats(dir, 10*time.Millisecond, true)
+
+	// No limit configured: slots are not probed, stats still read.
+	st := GetBdLimiterStatus(town)
+	if st.MaxConcurrent != 0 || st.InUse != 0 {
+		t.Errorf("status without config = %+v", st)
+	}
+	if st.Stats.Waits != 2 {
+		t.Errorf("stats without config = %+v", st.Stats)
+	}
+
+	settings := filepath.Join(town, "settings", "config.json")
+	if err := os.MkdirAll(filepath.Dir(settings), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(settings, []byte(`{"type":"town-settings","version":1,"operational":{"dolt":{"bd_max_concurrent":2}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	st = GetBdLimiterStatus(town)
+	if st.MaxConcurrent != 2 || st.InUse != 1 {
+		t.Errorf("status = %+v, want 1 of 2 slots in use", st)
+	}
+	if q := st.Stats; q.Waits != 2 || q.Timeouts != 1 || q.TotalWaitMs != 40 || q.MaxWaitMs != 30 {
+		t.Errorf("stats = %+v", q)
+	}
+}
+
+func TestAcquireBdSlot_NoTown(t *testing.T) {
+	release := AcquireBdSlot("")
+	release()
+}
+
+func TestBdLimiterTakeToken(t *testing.T) {
+	l := &bdLimiter{dir: t.TempDir(), rate: 20, burst: 2}
+
+	// A full bucket lets a burst through without waiting.
+	for i := 0; i < l.burst; i++ {
+		if waited, ok := l.takeToken(time.Now().Add(time.Second)); !ok || waited > 20*time.Millisecond {
+			t.Fatalf("burst call %d = waited %v, ok %v", i, waited, ok)
+		}
+	}
+
+	// The bucket is empty: a caller that can't wait for the refill gives up.
+	if _, ok := l.takeToken(time.Now()); ok {
+		t.Error("took a token from an empty bucket")
+	}
+
+	// The next token arrives at the configured rate (1/20s).
+	waited, ok := l.takeToken(time.Now().Add(time.Second))
+	if !ok || waited < 20*time.Millisecond {
+		t.Errorf("paced call = waited %v, ok %v; want a ~50ms wait", waited, ok)
+	}
+}
+
+func TestCmdTownRoot(t *testing.T) {
+	cmd := Command("version")
+	cmd.Env = []string{"GT_ROOT=/elsewhere", "GT_ROOT=/town"}
+	if got := cmd.townRoot(); got != "/town" {
+		t.Errorf("townRoot() = %q, want the last GT_ROOT", got)
+	}
+}
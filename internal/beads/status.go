@@ -21,6 +21,8 @@ const (
 	AgentStateRunning      AgentState = "running"
 	AgentStateNuked        AgentState = "nuked"
 	AgentStateAwaitingGate AgentState = "awaiting-gate"
+	AgentStatePaused       AgentState = "paused"
+	AgentStateNeedsInput   AgentState = "needs-input"
 )
 
 // ResolveAgentState returns the agent state Gastown should act on.
@@ -37,10 +39,11 @@ func ResolveAgentState(description, structured string) string {
 
 // ProtectsFromCleanup returns true if this agent state indicates an intentional
 // pause that should prevent the polecat from being cleaned up as stale.
-// States like "stuck" and "awaiting-gate" mean the polecat is paused on purpose.
+// States like "stuck", "awaiting-gate", "paused", and "needs-input" mean the
+// polecat is paused on purpose.
 func (s AgentState) ProtectsFromCleanup() bool {
 	switch s {
-	case AgentStateStuck, AgentStateAwaitingGate:
+	case AgentStateStuck, AgentStateAwaitingGate, AgentStatePaused, AgentStateNeedsInput:
 		return true
 	default:
 		return false
@@ -10,6 +10,8 @@ func TestAgentStateProtectsFromCleanup(t *testing.T) {
 	}{
 		{AgentStateStuck, true},
 		{AgentStateAwaitingGate, true},
+		{AgentStatePaused, true},
+		{AgentStateNeedsInput, true},
 		{AgentStateWorking, false},
 		{AgentStateIdle, false},
 		{AgentStateDone, false},
@@ -38,6 +40,7 @@ func TestAgentStateIsActive(t *testing.T) {
 		{AgentStateIdle, false},
 		{AgentStateDone, false},
 		{AgentStateStuck, false},
+		{AgentStatePaused, false},
 		{AgentStateNuked, false},
 	}
 	for _, tt := range tests {
@@ -119,6 +122,7 @@ func TestAgentStateConstants(t *testing.T) {
 		AgentStateRunning:      "running",
 		AgentStateNuked:        "nuked",
 		AgentStateAwaitingGate: "awaiting-gate",
+		AgentStatePaused:       "paused",
 	}
 	for state, expected := range states {
 		if string(state) != expected {
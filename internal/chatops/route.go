@@ -0,0 +1,87 @@
+package chatops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/access"
+)
+
+// Commands the slash command understands.
+const (
+	CmdQueueStatus = "queue status"
+	CmdLimits      = "limits"
+	CmdEnqueue     = "enqueue"
+	CmdPause       = "pause"
+	CmdResume      = "resume"
+	CmdHelp        = "help"
+)
+
+// command describes one slash command.
+type command struct {
+	name    string
+	aliases []string
+	args    string // Usage of the arguments
+	minArgs int
+	maxArgs int
+	action  string // access action it needs; "" = anyone
+	help    string
+}
+
+var commands = []command{
+	{name: CmdQueueStatus, aliases: []string{"queue", "status"}, help: "Scheduler state and queued beads"},
+	{name: CmdLimits, aliases: []string{"quota"}, help: "Usage limits per account and provider"},
+	{name: CmdEnqueue, args: "<bead> [rig] [formula]", minArgs: 1, maxArgs: 3, action: access.ActionEnqueue, help: "Schedule a bead for dispatch"},
+	{name: CmdPause, action: access.ActionPause, help: "Pause scheduler dispatch"},
+	{name: CmdResume, action: access.ActionPause, help: "Resume scheduler dispatch"},
+	{name: CmdHelp, help: "Show this help"},
+}
+
+// Route is command text resolved to a known command.
+type Route struct {
+	Command string   // One of the Cmd* constants
+	Args    []string // Arguments after the command name
+	Action  string   // access action the caller needs; "" = none
+}
+
+// ParseRoute resolves slash command text such as "enqueue gt-abc gastown".
+// Empty text is help.
+func ParseRoute(text string) (Route, error) {
+	words := strings.Fields(strings.ToLower(text))
+	orig := strings.Fields(text)
+	if len(words) == 0 {
+		return Route{Command: CmdHelp}, nil
+	}
+	for _, c := range commands {
+		for _, name := range append([]string{c.name}, c.aliases...) {
+			n := len(strings.Fields(name))
+			if len(words) < n || strings.Join(words[:n], " ") != name {
+				continue
+			}
+			args := orig[n:]
+			if len(args) < c.minArgs || len(args) > c.maxArgs {
+				return Route{}, fmt.Errorf("usage: %s %s", c.name, c.args)
+			}
+			return Route{Command: c.name, Args: args, Action: c.action}, nil
+		}
+	}
+	return Route{}, fmt.Errorf("unknown command %q", words[0])
+}
+
+// Usage lists the commands for slash (e.g. "/gt").
+func Usage(slash string) string {
+	if slash == "" {
+		slash = "/gt"
+	}
+	var b strings.Builder
+	b.WriteString("Commands:\n")
+	for _, c := range commands {
+		usage := strings.TrimSpace(slash + " " + c.name + " " + c.args)
+		fmt.Fprintf(&b, "• `%s` — %s", usage, c.help)
+		if c.action != "" {
+			fmt.Fprintf(&b, " _(%s)_", c.action)
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,201 @@
+// Package chatops lets a team manage the town from Slack with a /gt slash
+// command: check the queue and limits, enqueue work, pause and resume the
+// scheduler.
+//
+// This package verifies and parses Slack's requests and routes the command
+// text; the caller runs the commands and decides who may run them.
+package chatops
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBodyBytes caps a slash command request; Slack's are well under 4KB.
+const maxBodyBytes = 64 << 10
+
+// maxSkew is how old a request's timestamp may be before it is treated as a
+// replay, per Slack's guidance.
+const maxSkew = 5 * time.Minute
+
+// ErrBadSignature is returned for requests not signed with the app's secret.
+var ErrBadSignature = errors.New("invalid Slack signature")
+
+// SlashCommand is one invocation of the slash command.
+type SlashCommand struct {
+	TeamID      string
+	UserID      string
+	UserName    string
+	ChannelID   string
+	Command     string // e.g. "/gt"
+	Text        string // Everything after the command
+	ResponseURL string // Where to post a delayed reply
+}
+
+// Identity is the user's identity for role checks: "slack:<user ID>".
+func (c *SlashCommand) Identity() string {
+	return "slack:" + c.UserID
+}
+
+// Response is a slash command reply.
+type Response struct {
+	ResponseType string `json:"response_type"` // "ephemeral" or "in_channel"
+	Text         string `json:"text"`
+}
+
+// Reply is a reply only the invoking user sees.
+func Reply(text string) Response {
+	return Response{ResponseType: "ephemeral", Text: text}
+}
+
+// Announce is a reply the whole channel sees, for commands that change the
+// town (so the team knows who paused the scheduler).
+func Announce(text string) Response {
+	return Response{ResponseType: "in_channel", Text: text}
+}
+
+// VerifySignature checks Slack's X-Slack-Signature for body: an HMAC-SHA256
+// over "v0:<timestamp>:<body>" keyed with the app's signing secret.
+func VerifySignature(secret string, h http.Header, body []byte, now time.Time) error {
+	if secret == "" {
+		return ErrBadSignature
+	}
+	ts := h.Get("X-Slack-Request-Timestamp")
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: missing timestamp", ErrBadSignature)
+	}
+	if skew := now.Sub(time.Unix(sec, 0)); skew > maxSkew || skew < -maxSkew {
+		return fmt.Errorf("%w: stale timestamp", ErrBadSignature)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:", ts)
+	mac.Write(body)
+	want := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(want), []byte(h.Get("X-Slack-Signature"))) {
+		return ErrBadSignature
+	}
+	return nil
+}
+
+// ParseSlashCommand decodes a slash command's form body.
+func ParseSlashCommand(body []byte) (*SlashCommand, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing form: %w", err)
+	}
+	c := &SlashCommand{
+		TeamID:      form.Get("team_id"),
+		UserID:      form.Get("user_id"),
+		UserName:    form.Get("user_name"),
+		ChannelID:   form.Get("channel_id"),
+		Command:     form.Get("command"),
+		Text:        strings.TrimSpace(form.Get("text")),
+		ResponseURL: form.Get("response_url"),
+	}
+	if c.UserID == "" {
+		return nil, errors.New("missing user_id")
+	}
+	return c, nil
+}
+
+// RunFunc runs a routed command and returns the reply.
+type RunFunc func(*SlashCommand, Route) Response
+
+// Handler serves the slash command's request URL.
+type Handler struct {
+	// SigningSecret is the Slack app's signing secret. Required: an empty
+	// secret rejects every request.
+	SigningSecret string
+
+	// Run executes a routed command. Handler acknowledges Slack at once and
+	// posts Run's reply to the command's response URL, since Slack gives up
+	// on a request after three seconds.
+	Run RunFunc
+
+	// Post delivers a delayed reply. Defaults to PostResponse.
+	Post func(responseURL string, r Response) error
+
+	// now is overridden in tests.
+	now func() time.Time
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "POST required", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+	now := time.Now
+	if h.now != nil {
+		now = h.now
+	}
+	if err := VerifySignature(h.SigningSecret, r.Header, body, now()); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	cmd, err := ParseSlashCommand(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	route, err := ParseRoute(cmd.Text)
+	if err != nil {
+		writeResponse(w, Reply(err.Error()+"\n\n"+Usage(cmd.Command)))
+		return
+	}
+	if cmd.ResponseURL == "" {
+		writeResponse(w, h.Run(cmd, route))
+		return
+	}
+
+	post := h.Post
+	if post == nil {
+		post = PostResponse
+	}
+	go func() {
+		_ = post(cmd.ResponseURL, h.Run(cmd, route))
+	}()
+	writeResponse(w, Reply(fmt.Sprintf("Running `%s %s`...", cmd.Command, cmd.Text)))
+}
+
+// PostResponse posts a delayed reply to a slash command's response URL.
+func PostResponse(responseURL string, r Response) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(responseURL, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("posting reply: %s", resp.Status)
+	}
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, r Response) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(r)
+}
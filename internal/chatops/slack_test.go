@@ -0,0 +1,116 @@
+package chatops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Unix(1700000000, 0)
+
+func signedRequest(secret string, ts time.Time, form url.Values) *http.Request {
+	body := form.Encode()
+	stamp := fmt.Sprint(ts.Unix())
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "v0:%s:%s", stamp, body)
+	r := httptest.NewRequest(http.MethodPost, "/slack", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("X-Slack-Request-Timestamp", stamp)
+	r.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return r
+}
+
+func TestHandler(t *testing.T) {
+	form := url.Values{"user_id": {"U1"}, "command": {"/gt"}, "text": {"enqueue gt-abc gastown"}}
+	var ran []Route
+	h := &Handler{
+		SigningSecret: "shh",
+		Run: func(c *SlashCommand, r Route) Response {
+			ran = append(ran, r)
+			return Announce(c.Identity() + " ok")
+		},
+		now: func() time.Time { return testNow },
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, signedRequest("shh", testNow, form))
+		var resp Response
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || resp.Text != "slack:U1 ok" || resp.ResponseType != "in_channel" {
+			t.Fatalf("response = %d %s", w.Code, w.Body.String())
+		}
+		if len(ran) != 1 || ran[0].Command != CmdEnqueue || strings.Join(ran[0].Args, " ") != "gt-abc gastown" {
+			t.Errorf("routed %+v", ran)
+		}
+	})
+
+	for name, r := range map[string]*http.Request{
+		"wrong secret": signedRequest("nope", testNow, form),
+		"replayed":     signedRequest("shh", testNow.Add(-10*time.Minute), form),
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want 401", w.Code)
+			}
+		})
+	}
+
+	t.Run("delayed reply", func(t *testing.T) {
+		posted := make(chan Response, 1)
+		h.Post = func(u string, r Response) error {
+			posted <- r
+			return nil
+		}
+		f := url.Values{"user_id": {"U2"}, "command": {"/gt"}, "text": {"pause"}, "response_url": {"https://hooks.slack.test/x"}}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, signedRequest("shh", testNow, f))
+		if !strings.Contains(w.Body.String(), "Running") {
+			t.Errorf("ack = %s", w.Body.String())
+		}
+		select {
+		case r := <-posted:
+			if r.Text != "slack:U2 ok" {
+				t.Errorf("posted %+v", r)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("reply never posted")
+		}
+	})
+}
+
+func TestParseRoute(t *testing.T) {
+	tests := []struct {
+		text, want string
+		args       int
+		wantErr    bool
+	}{
+		{"", CmdHelp, 0, false},
+		{"queue status", CmdQueueStatus, 0, false},
+		{"Status", CmdQueueStatus, 0, false},
+		{"limits", CmdLimits, 0, false},
+		{"enqueue gt-ABC", CmdEnqueue, 1, false},
+		{"enqueue gt-abc gastown mol-polecat-work", CmdEnqueue, 3, false},
+		{"enqueue", "", 0, true},
+		{"pause now", "", 0, true},
+		{"nuke everything", "", 0, true},
+	}
+	for _, tt := range tests {
+		r, err := ParseRoute(tt.text)
+		if (err != nil) != tt.wantErr || r.Command != tt.want || len(r.Args) != tt.args {
+			t.Errorf("ParseRoute(%q) = %+v, %v", tt.text, r, err)
+		}
+	}
+	if r, _ := ParseRoute("enqueue gt-ABC"); r.Args[0] != "gt-ABC" {
+		t.Errorf("args should keep their case, got %v", r.Args)
+	}
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var accessJSON bool
+
+// accessReport is gt access output.
+type accessReport struct {
+	Identity  string            `json:"identity"`
+	Role      string            `json:"role,omitempty"`
+	Enforced  bool              `json:"enforced"`
+	Observer  bool              `json:"observer"` // Observer mode: mutating commands refused
+	Decisions []access.Decision `json:"decisions,omitempty"`
+}
+
+var accessCmd = &cobra.Command{
+	Use:     "access",
+	GroupID: GroupConfig,
+	Short:   "Show your role and what it allows in a shared town",
+	Long: `Show who gt thinks you are, your role, and which guarded commands you
+may run.
+
+When several people share a town, settings/roles.json maps each of them to a
+role. Identity is the OS user name. Inside an agent's own gt tmux session it
+is that agent's BD_ACTOR instead; a BD_ACTOR set by hand anywhere else is
+ignored, so it can't borrow another user's role.
+
+  {
+    "version": 1,
+    "users": {"alice": "admin", "bob": "operator"},
+    "default": "viewer"
+  }
+
+Roles:
+  observer   Observer mode: every state-mutating command is refused
+  viewer     Read-only (default for people not listed)
+  operator   pause, kill, dispatch (default for agents not listed)
+  admin      Everything, including clear-limits
+
+Guarded commands:
+  pause          gt polecat pause/resume, gt scheduler pause/resume/hold/release/clear,
+                 gt mountain pause/resume, gt estop/thaw, gt daemon wake,
+                 gt daemon clear-backoff/clear-crash-loop, gt takeover/handback,
+                 gt quota snooze
+  kill           gt polecat kill/nuke, gt takeover --kill
+  dispatch       gt daemon dispatch, gt scheduler run/replay
+  clear-limits   gt quota clear
+
+"agents" sets the role of unlisted agents, and "actions" changes the role an
+action needs, e.g. {"clear-limits": "operator"}. Denied attempts are logged
+to the audit trail as access_denied events. Without a roles file nothing is
+enforced.
+
+Observer mode can also be turned on for one command with --observer, or for
+a shell (say, on a shared screen) with GT_OBSERVER=1. It allows read-only
+commands (list, status, show, feed, dashboard, ...) and --dry-run previews,
+and refuses the rest.`,
+	Args: cobra.NoArgs,
+	RunE: runAccess,
+}
+
+func init() {
+	accessCmd.Flags().BoolVar(&accessJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(accessCmd)
+}
+
+func runAccess(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	roles, err := access.Load(townRoot)
+	if err != nil {
+		return err
+	}
+	identity := access.Identity()
+	observer, _ := observerMode(townRoot)
+	if roles == nil && !accessJSON {
+		if observer {
+			fmt.Printf("%s Observer mode: commands that change town state are refused\n", style.Warning.Render("👁"))
+			return nil
+		}
+		fmt.Printf("%s No roles file (%s); all commands allowed\n", style.Dim.Render("○"), access.Path(townRoot))
+		return nil
+	}
+
+	report := accessReport{Identity: identity, Enforced: roles != nil, Observer: observer}
+	if roles != nil {
+		report.Role = roles.RoleOf(identity)
+		for _, action := range access.Actions() {
+			report.Decisions = append(report.Decisions, roles.Check(identity, action))
+		}
+	}
+	if accessJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Printf("%s as %s\n", style.Bold.Render(identity), report.Role)
+	if observer {
+		fmt.Printf("  %s Observer mode: commands that change town state are refused\n", style.Warning.Render("👁"))
+	}
+	for _, d := range report.Decisions {
+		if d.Allowed {
+			fmt.Printf("  %s %s\n", style.SuccessPrefix, d.Action)
+		} else {
+			fmt.Printf("  %s %s %s\n", style.Error.Render("✗"), d.Action, style.Dim.Render("(needs "+d.Required+")"))
+		}
+	}
+	return nil
+}
+
+// requireAccess refuses cmd unless the caller's role allows action (see
+// gt access). Denials are logged to the audit trail. Outside a town, or in a
+// town without a roles file, everything is allowed; a broken roles file
+// blocks guarded commands until it is fixed.
+func requireAccess(cmd *cobra.Command, action string) error {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+	roles, err := access.Load(townRoot)
+	if err != nil {
+		return fmt.Errorf("checking access: %w", err)
+	}
+	if roles == nil {
+		return nil
+	}
+	d := roles.Check(access.Identity(), action)
+	if d.Allowed {
+		return nil
+	}
+	_ = events.LogAudit(events.TypeAccessDenied, d.Identity,
+		events.AccessDeniedPayload(cmd.CommandPath(), d.Action, d.Role, d.Required))
+	return fmt.Errorf("%s (%s) may not run %s: needs %s or higher (see %s)",
+		d.Identity, d.Role, cmd.CommandPath(), d.Required, access.Path(townRoot))
+}
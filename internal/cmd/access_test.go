@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// trustBDActor makes access.Identity take BD_ACTOR as given, as it does
+// inside that agent's gt session, so tests can pick the caller's identity.
+func trustBDActor(t *testing.T) {
+	t.Helper()
+	orig := access.VerifyAgent
+	access.VerifyAgent = func(string) bool { return true }
+	t.Cleanup(func() { access.VerifyAgent = orig })
+}
+
+func TestRequireAccess(t *testing.T) {
+	trustBDActor(t)
+	townRoot := setupTestTownForConfig(t)
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	cmd := &cobra.Command{Use: "kill"}
+
+	t.Setenv("BD_ACTOR", "bob")
+	if err := requireAccess(cmd, access.ActionKill); err != nil {
+		t.Fatalf("without roles file: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(townRoot, "settings"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	roles := `{"version": 1, "users": {"alice": "admin", "bob": "operator"}}`
+	if err := os.WriteFile(access.Path(townRoot), []byte(roles), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := requireAccess(cmd, access.ActionKill); err != nil {
+		t.Errorf("operator kill: %v", err)
+	}
+	err := requireAccess(cmd, access.ActionClearLimits)
+	if err == nil || !strings.Contains(err.Error(), "needs admin") {
+		t.Errorf("operator clear-limits error = %v, want needs admin", err)
+	}
+	t.Setenv("BD_ACTOR", "carol")
+	if err := requireAccess(cmd, access.ActionPause); err == nil {
+		t.Error("unlisted viewer pause allowed")
+	}
+
+	data, err := os.ReadFile(filepath.Join(townRoot, events.EventsFile))
+	if err != nil {
+		t.Fatalf("reading events: %v", err)
+	}
+	if n := strings.Count(string(data), events.TypeAccessDenied); n != 2 {
+		t.Errorf("got %d access_denied events, want 2:\n%s", n, data)
+	}
+}
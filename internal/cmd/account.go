@@ -6,11 +6,15 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/quota"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -19,10 +23,13 @@ var (
 	accountJSON        bool
 	accountEmail       string
 	accountDescription string
+	accountProvider    string
+	accountPriority    int
 )
 
 var accountCmd = &cobra.Command{
 	Use:     "account",
+	Aliases: []string{"accounts"},
 	GroupID: GroupConfig,
 	Short:   "Manage Claude Code accounts",
 	RunE:    requireSubcommand,
@@ -31,11 +38,16 @@ var accountCmd = &cobra.Command{
 This enables switching between accounts (e.g., personal vs work) with
 easy account selection per spawn or globally.
 
+The registry lives in mayor/accounts.json. Each account has a handle, a
+provider, a config directory, and a priority (lower is preferred when quota
+rotation picks a replacement account).
+
 Commands:
   gt account list              List registered accounts
   gt account add <handle>      Add a new account
+  gt account login <handle>    Log an account in under its config directory
   gt account default <handle>  Set the default account
-  gt account status            Show current account info`,
+  gt account status            Show limit state and sessions per account`,
 }
 
 var accountListCmd = &cobra.Command{
@@ -43,7 +55,8 @@ var accountListCmd = &cobra.Command{
 	Short: "List registered accounts",
 	Long: `List all registered Claude Code accounts.
 
-Shows account handles, emails, and which is the default.
+Shows account handles, providers, priorities, emails, and which is the
+default, in priority order.
 
 Examples:
   gt account list           # Text output
@@ -57,13 +70,13 @@ var accountAddCmd = &cobra.Command{
 	Long: `Add a new Claude Code account.
 
 Creates a config directory at ~/.claude-accounts/<handle> and registers
-the account. You'll need to run 'claude' with CLAUDE_CONFIG_DIR set to
-that directory to complete the login.
+the account. Run 'gt account login <handle>' afterwards to log it in.
 
 Examples:
   gt account add work
   gt account add work --email steve@company.com
-  gt account add work --email steve@company.com --desc "Work account"`,
+  gt account add work --email steve@company.com --desc "Work account"
+  gt account add backup --priority 10`,
 	Args: cobra.ExactArgs(1),
 	RunE: runAccountAdd,
 }
@@ -90,6 +103,8 @@ type AccountListItem struct {
 	Description string `json:"description,omitempty"`
 	ConfigDir   string `json:"config_dir"`
 	IsDefault   bool   `json:"is_default"`
+	Provider    string `json:"provider"`
+	Priority    int    `json:"priority"`
 }
 
 func runAccountList(cmd *cobra.Command, args []string) error {
@@ -115,23 +130,21 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Build list items
+	// Build list items in priority order
 	var items []AccountListItem
-	for handle, acct := range cfg.Accounts {
+	for _, handle := range cfg.Handles() {
+		acct := cfg.Accounts[handle]
 		items = append(items, AccountListItem{
 			Handle:      handle,
 			Email:       acct.Email,
 			Description: acct.Description,
 			ConfigDir:   acct.ConfigDir,
 			IsDefault:   handle == cfg.Default,
+			Provider:    acct.GetProvider(),
+			Priority:    acct.Priority,
 		})
 	}
 
-	// Sort by handle for consistent output
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].Handle < items[j].Handle
-	})
-
 	if accountJSON {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -150,6 +163,7 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 		if item.Email != "" {
 			fmt.Printf("  %s", item.Email)
 		}
+		fmt.Printf("  %s", style.Dim.Render(fmt.Sprintf("[%s, priority %d]", item.Provider, item.Priority)))
 		if item.IsDefault {
 			fmt.Printf("  %s", style.Dim.Render("(default)"))
 		}
@@ -165,6 +179,9 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 
 func runAccountAdd(cmd *cobra.Command, args []string) error {
 	handle := args[0]
+	if accountPriority < 0 {
+		return fmt.Errorf("invalid --priority %d: must be >= 0", accountPriority)
+	}
 
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
@@ -207,6 +224,8 @@ func runAccountAdd(cmd *cobra.Command, args []string) error {
 		Email:       accountEmail,
 		Description: accountDescription,
 		ConfigDir:   configDir,
+		Provider:    accountProvider,
+		Priority:    accountPriority,
 	}
 
 	// If this is the first account, make it default
@@ -223,8 +242,7 @@ func runAccountAdd(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Config directory: %s\n", configDir)
 	fmt.Println()
 	fmt.Println("To complete login, run:")
-	fmt.Printf("  CLAUDE_CONFIG_DIR=%s claude\n", configDir)
-	fmt.Println("Then use /login to authenticate.")
+	fmt.Printf("  gt account login %s\n", handle)
 
 	return nil
 }
@@ -262,15 +280,18 @@ func runAccountDefault(cmd *cobra.Command, args []string) error {
 
 var accountStatusCmd = &cobra.Command{
 	Use:   "status",
-	Short: "Show current account info",
-	Long: `Show which Claude Code account would be used for new sessions.
+	Short: "Show current account, limit state, and sessions",
+	Long: `Show which Claude Code account would be used for new sessions, then
+every registered account's limit state (from gt quota) and the Gas Town
+sessions currently running on it.
 
-Displays the currently resolved account based on:
+The current account is resolved from:
 1. GT_ACCOUNT environment variable (highest priority)
 2. Default account from config
 
 Examples:
-  gt account status           # Show current account
+  gt account status           # Show current account and all accounts
+  gt account status --json    # Machine-readable per-account status
   GT_ACCOUNT=work gt account status  # Show with env override`,
 	RunE: runAccountStatus,
 }
@@ -329,6 +350,13 @@ func runAccountStatus(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("account '%s' not found", handle)
 	}
 
+	items := accountStatusItems(townRoot, cfg, handle)
+	if accountJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	}
+
 	fmt.Printf("%s\n\n", style.Bold.Render("Current Account"))
 	fmt.Printf("Handle:     %s\n", style.Bold.Render(handle))
 	if acct.Email != "" {
@@ -345,6 +373,124 @@ func runAccountStatus(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\n%s\n", style.Dim.Render("(default account)"))
 	}
 
+	fmt.Printf("\n%s\n\n", style.Bold.Render("Accounts"))
+	for _, item := range items {
+		marker := " "
+		if item.Current {
+			marker = "*"
+		}
+		var badge string
+		switch config.AccountQuotaStatus(item.Status) {
+		case config.QuotaStatusLimited:
+			badge = style.Error.Render(item.Status)
+			if item.Resets != "" {
+				badge += style.Dim.Render(" (" + item.Resets + ")")
+			}
+		case config.QuotaStatusCooldown:
+			badge = style.Warning.Render(item.Status)
+		default:
+			badge = style.Success.Render(item.Status)
+		}
+		if !item.LoggedIn {
+			badge += " " + style.Warning.Render("not logged in")
+		}
+		fmt.Printf(" %s %-12s %-8s %s  %s\n", marker, item.Handle, item.Provider, badge,
+			style.Dim.Render(fmt.Sprintf("%d session(s)", len(item.Sessions))))
+		for _, sess := range item.Sessions {
+			fmt.Printf("     %s\n", style.Dim.Render(sess))
+		}
+	}
+
+	return nil
+}
+
+// AccountStatusItem is one account in gt account status output.
+type AccountStatusItem struct {
+	Handle   string   `json:"handle"`
+	Provider string   `json:"provider"`
+	Priority int      `json:"priority"`
+	Current  bool     `json:"current"`
+	LoggedIn bool     `json:"logged_in"`
+	Status   string   `json:"status"`           // Quota status: available, limited, cooldown
+	Resets   string   `json:"resets,omitempty"` // When a limited account's windows reset
+	Sessions []string `json:"sessions"`         // Gas Town sessions running on the account
+}
+
+// accountStatusItems combines the registry, quota state, and live sessions
+// into one status entry per account, in priority order. Quota state and
+// sessions are best-effort: either may be missing without failing status.
+func accountStatusItems(townRoot string, cfg *config.AccountsConfig, current string) []AccountStatusItem {
+	var state *config.QuotaState
+	if qs, err := quota.NewManager(townRoot).Load(); err == nil {
+		state = qs
+	}
+
+	sessions := make(map[string][]string)
+	if scanner, err := quota.NewScanner(tmux.NewTmux(), nil, cfg); err == nil {
+		if results, err := scanner.ScanAll(); err == nil {
+			for _, r := range results {
+				if r.AccountHandle != "" {
+					sessions[r.AccountHandle] = append(sessions[r.AccountHandle], r.Session)
+				}
+			}
+		}
+	}
+
+	var items []AccountStatusItem
+	for _, handle := range cfg.Handles() {
+		acct := cfg.Accounts[handle]
+		item := AccountStatusItem{
+			Handle:   handle,
+			Provider: acct.GetProvider(),
+			Priority: acct.Priority,
+			Current:  handle == current,
+			LoggedIn: config.ClaudeLoggedIn(util.ExpandHome(acct.ConfigDir)),
+			Status:   string(config.QuotaStatusAvailable),
+			Sessions: sessions[handle],
+		}
+		if state != nil {
+			if qs, ok := state.Accounts[handle]; ok && qs.Status != "" {
+				item.Status = string(qs.Status)
+				if qs.Status == config.QuotaStatusLimited {
+					item.Resets = quotaResetsLabel(qs)
+				}
+			}
+		}
+		if item.Sessions == nil {
+			item.Sessions = []string{}
+		}
+		sort.Strings(item.Sessions)
+		items = append(items, item)
+	}
+	return items
+}
+
+// prepareAccountConfigDir makes sure an account's config dir is usable before
+// a session starts in it: the directory exists and has the shared commands.
+// A dir that was never logged in is only warned about — the session would sit
+// at the login prompt, but the check is a heuristic and must not block spawns.
+func prepareAccountConfigDir(handle, configDir string) {
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		style.PrintWarning("creating config dir for account %s: %v", handle, err)
+		return
+	}
+	if err := ensureSharedCommandsSymlink(configDir); err != nil {
+		style.PrintWarning("could not symlink global commands for account %s: %v", handle, err)
+	}
+	if !config.ClaudeLoggedIn(configDir) {
+		style.PrintWarning("account %s has not been logged in (run: gt account login %s)", handle, handle)
+	}
+}
+
+// validateAccountHandle returns an error unless handle is a registered account.
+func validateAccountHandle(townRoot, handle string) error {
+	cfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil || len(cfg.Accounts) == 0 {
+		return fmt.Errorf("unknown account '%s': no accounts registered (gt account add <handle>)", handle)
+	}
+	if cfg.GetAccount(handle) == nil {
+		return fmt.Errorf("unknown account '%s' (registered: %s)", handle, strings.Join(cfg.Handles(), ", "))
+	}
 	return nil
 }
 
@@ -509,6 +655,10 @@ func init() {
 
 	accountAddCmd.Flags().StringVar(&accountEmail, "email", "", "Account email address")
 	accountAddCmd.Flags().StringVar(&accountDescription, "desc", "", "Account description")
+	accountAddCmd.Flags().StringVar(&accountProvider, "provider", "", "Account provider (default \"claude\")")
+	accountAddCmd.Flags().IntVar(&accountPriority, "priority", 0, "Rotation priority, lower is preferred")
+
+	accountStatusCmd.Flags().BoolVar(&accountJSON, "json", false, "Output as JSON")
 
 	// Add subcommands
 	accountCmd.AddCommand(accountListCmd)
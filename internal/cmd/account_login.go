@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var accountLoginPrint bool
+
+var accountLoginCmd = &cobra.Command{
+	Use:   "login <handle>",
+	Short: "Log an account in under its own config directory",
+	Long: `Bootstrap an account's isolated config directory and log it in.
+
+Each account runs with its own CLAUDE_CONFIG_DIR, so sessions on different
+accounts can run side by side: polecats spawned with --account (or the
+default account) get the account's directory in their environment, and
+limit detection reads their transcripts from it.
+
+This command creates the directory if needed, links the shared commands,
+and starts claude with CLAUDE_CONFIG_DIR set. Run /login in it, then exit.
+
+Examples:
+  gt account login work
+  gt account login work --print   # Only print the command to run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountLogin,
+}
+
+func init() {
+	accountLoginCmd.Flags().BoolVar(&accountLoginPrint, "print", false, "Print the login command instead of running it")
+	accountCmd.AddCommand(accountLoginCmd)
+}
+
+func runAccountLogin(cmd *cobra.Command, args []string) error {
+	handle := args[0]
+
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+	cfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading accounts config: %w", err)
+	}
+	acct := cfg.GetAccount(handle)
+	if acct == nil {
+		return fmt.Errorf("account '%s' not found", handle)
+	}
+	if p := acct.GetProvider(); p != config.DefaultAccountProvider {
+		return fmt.Errorf("account '%s' uses provider %q; login bootstrap supports only %q", handle, p, config.DefaultAccountProvider)
+	}
+
+	configDir := util.ExpandHome(acct.ConfigDir)
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return fmt.Errorf("creating config directory: %w", err)
+	}
+	if err := ensureSharedCommandsSymlink(configDir); err != nil {
+		style.PrintWarning("could not symlink global commands: %v", err)
+	}
+
+	if accountLoginPrint {
+		fmt.Printf("CLAUDE_CONFIG_DIR=%s claude\n", configDir)
+		return nil
+	}
+	if config.ClaudeLoggedIn(configDir) {
+		fmt.Printf("%s Account '%s' is already logged in %s\n", style.Bold.Render("✓"), handle, style.Dim.Render("("+configDir+")"))
+		return nil
+	}
+
+	claudePath, err := exec.LookPath("claude")
+	if err != nil {
+		return fmt.Errorf("claude not found in PATH; run manually: CLAUDE_CONFIG_DIR=%s claude", configDir)
+	}
+	fmt.Printf("Starting claude for account '%s' (CLAUDE_CONFIG_DIR=%s)\n", handle, configDir)
+	fmt.Println("Run /login, complete the browser flow, then exit claude.")
+
+	c := exec.Command(claudePath) //nolint:gosec // G204: fixed binary resolved from PATH
+	c.Env = append(os.Environ(), "CLAUDE_CONFIG_DIR="+configDir)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("running claude: %w", err)
+	}
+
+	if !config.ClaudeLoggedIn(configDir) {
+		style.PrintWarning("no login found in %s; run 'gt account login %s' again", configDir, handle)
+		return NewSilentExit(1)
+	}
+	fmt.Printf("%s Account '%s' logged in\n", style.Bold.Render("✓"), handle)
+	return nil
+}
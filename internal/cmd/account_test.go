@@ -320,3 +320,27 @@ func TestAccountSwitch(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateAccountHandle(t *testing.T) {
+	townRoot, accountsDir := setupTestTownForAccount(t)
+
+	if err := validateAccountHandle(townRoot, "work"); err == nil || !strings.Contains(err.Error(), "no accounts registered") {
+		t.Errorf("no registry: err = %v, want 'no accounts registered'", err)
+	}
+
+	cfg := config.NewAccountsConfig()
+	cfg.Accounts["work"] = config.Account{ConfigDir: filepath.Join(accountsDir, "work")}
+	cfg.Accounts["personal"] = config.Account{ConfigDir: filepath.Join(accountsDir, "personal"), Priority: 1}
+	cfg.Default = "work"
+	if err := config.SaveAccountsConfig(filepath.Join(townRoot, "mayor", "accounts.json"), cfg); err != nil {
+		t.Fatalf("save accounts: %v", err)
+	}
+
+	if err := validateAccountHandle(townRoot, "work"); err != nil {
+		t.Errorf("registered account rejected: %v", err)
+	}
+	err := validateAccountHandle(townRoot, "wrok")
+	if err == nil || !strings.Contains(err.Error(), "registered: work, personal") {
+		t.Errorf("unknown account: err = %v, want registered list in priority order", err)
+	}
+}
@@ -34,7 +34,8 @@ var activityCmd = &cobra.Command{
 Events are written to ~/gt/.events.jsonl and can be viewed with 'gt feed'.
 
 Subcommands:
-  emit    Emit an activity event`,
+  emit    Emit an activity event
+  trace   Show every event in one bead's pipeline`,
 }
 
 var activityEmitCmd = &cobra.Command{
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var activityTraceJSON bool
+
+var activityTraceCmd = &cobra.Command{
+	Use:   "trace <bead-id | correlation-id>",
+	Short: "Show every event in one bead's pipeline",
+	Long: `Show the events for one piece of work, oldest first.
+
+Scheduling a bead mints a correlation ID (cor-...) that the scheduler,
+sling, polecat spawn, gt done and the refinery merge all put on their
+events, and that the polecat's session carries as GT_CORRELATION_ID. Given
+a bead ID, trace finds the bead's correlation IDs and shows everything
+tagged with them, plus any untagged events about the bead itself.
+
+The ID is also a plain string in .events.jsonl, so this works too:
+  grep cor-3f9a1c02b7de ~/gt/.events.jsonl
+
+Examples:
+  gt activity trace gt-abc12
+  gt activity trace cor-3f9a1c02b7de --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runActivityTrace,
+}
+
+func init() {
+	activityTraceCmd.Flags().BoolVar(&activityTraceJSON, "json", false, "Output events as JSON lines")
+	activityCmd.AddCommand(activityTraceCmd)
+}
+
+func runActivityTrace(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	trace, err := traceEvents(townRoot, args[0])
+	if err != nil {
+		return err
+	}
+	if len(trace) == 0 {
+		return fmt.Errorf("no events found for %s", args[0])
+	}
+
+	if activityTraceJSON {
+		enc := json.NewEncoder(os.Stdout)
+		for _, e := range trace {
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, e := range trace {
+		ts := e.Timestamp
+		if t, err := time.Parse(time.RFC3339, e.Timestamp); err == nil {
+			ts = t.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%s  %s %-28s %s\n",
+			style.Dim.Render(ts), style.Bold.Render(fmt.Sprintf("%-20s", e.Type)), e.Actor, tracePayloadSummary(e.Payload))
+	}
+	return nil
+}
+
+// traceEvents returns the events for id, oldest first. A correlation ID
+// matches its tagged events; a bead ID matches events about the bead and
+// every event sharing a correlation ID with one of them, so a bead
+// redispatched under a new ID shows all its attempts.
+func traceEvents(townRoot, id string) ([]events.Event, error) {
+	var all []events.Event
+	err := events.ReadRange(townRoot, time.Time{}, time.Time{}, func(e events.Event) bool {
+		all = append(all, e)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading events: %w", err)
+	}
+	return traceFromEvents(all, id), nil
+}
+
+// traceFromEvents picks the events for id out of all (see traceEvents).
+func traceFromEvents(all []events.Event, id string) []events.Event {
+	corrIDs := make(map[string]bool)
+	if strings.HasPrefix(id, "cor-") {
+		corrIDs[id] = true
+	}
+	for _, e := range all {
+		if eventBead(e) == id {
+			if c := e.CorrelationID(); c != "" {
+				corrIDs[c] = true
+			}
+		}
+	}
+
+	var trace []events.Event
+	for _, e := range all {
+		if corrIDs[e.CorrelationID()] || eventBead(e) == id {
+			trace = append(trace, e)
+		}
+	}
+	return trace
+}
+
+// eventBead returns the bead an event is about, or "".
+func eventBead(e events.Event) string {
+	for _, key := range []string{"bead", "issue"} {
+		if v, ok := e.Payload[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// tracePayloadSummary renders a payload as sorted key=value pairs, leaving
+// out the correlation ID every line of a trace shares.
+func tracePayloadSummary(payload map[string]interface{}) string {
+	keys := make([]string, 0, len(payload))
+	for k := range payload {
+		if k != events.CorrelationKey {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", k, payload[k]))
+	}
+	return strings.Join(parts, " ")
+}
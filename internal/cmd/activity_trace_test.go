@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestTraceEvents(t *testing.T) {
+	town := t.TempDir()
+	var lines []byte
+	for _, e := range []events.Event{
+		{Timestamp: "2026-03-01T10:00:00Z", Type: events.TypeSchedulerEnqueue, Payload: map[string]interface{}{"bead": "gt-a", "correlation_id": "cor-1"}},
+		{Timestamp: "2026-03-01T10:01:00Z", Type: events.TypeSchedulerEnqueue, Payload: map[string]interface{}{"bead": "gt-b", "correlation_id": "cor-2"}},
+		{Timestamp: "2026-03-01T10:02:00Z", Type: events.TypeSpawn, Payload: map[string]interface{}{"rig": "gastown", "polecat": "toast", "correlation_id": "cor-1"}},
+		{Timestamp: "2026-03-01T10:03:00Z", Type: events.TypeHook, Payload: map[string]interface{}{"bead": "gt-a"}},
+		{Timestamp: "2026-03-01T11:00:00Z", Type: events.TypeMerged, Payload: map[string]interface{}{"mr": "gt-mr1", "correlation_id": "cor-1"}},
+	} {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(append(lines, data...), '\n')
+	}
+	if err := os.WriteFile(filepath.Join(town, events.EventsFile), lines, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	types := func(trace []events.Event) []string {
+		var out []string
+		for _, e := range trace {
+			out = append(out, e.Type)
+		}
+		return out
+	}
+
+	// A bead ID pulls in the untagged hook and the correlated spawn/merge.
+	trace, err := traceEvents(town, "gt-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{events.TypeSchedulerEnqueue, events.TypeSpawn, events.TypeHook, events.TypeMerged}
+	if got := types(trace); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] || got[3] != want[3] {
+		t.Errorf("trace(gt-a) = %v, want %v", got, want)
+	}
+
+	// A correlation ID matches only its tagged events.
+	trace, err = traceEvents(town, "cor-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := types(trace); len(got) != 1 || trace[0].Payload["bead"] != "gt-b" {
+		t.Errorf("trace(cor-2) = %v, want the gt-b enqueue only", got)
+	}
+}
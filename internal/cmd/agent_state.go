@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 	"time"
@@ -219,7 +218,7 @@ func modifyAgentState(agentBead, beadsDir string, hasIncr bool) error {
 	}
 
 	// Execute bd update
-	cmd := exec.Command("bd", args...)
+	cmd := beads.Command(args...)
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stderr bytes.Buffer
@@ -272,7 +271,7 @@ func getAllAgentLabels(agentBead, beadsDir string) ([]string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), bdCallTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stdout, stderr bytes.Buffer
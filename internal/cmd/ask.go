@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// labelNeedsInput marks a bead parked by gt ask until gt answer.
+const labelNeedsInput = "gt:needs-input"
+
+// polecatNeedsInputEnv is set in a polecat's tmux session to the bead it is
+// waiting on an answer for. Such polecats don't count toward scheduler
+// capacity (see countPolecatSlots).
+const polecatNeedsInputEnv = "GT_NEEDS_INPUT"
+
+var (
+	askBead   string
+	answerRig string
+)
+
+var askCmd = &cobra.Command{
+	Use:     "ask <question>",
+	GroupID: GroupComm,
+	Short:   "Ask the overseer a question and wait for the answer",
+	Long: `Ask the human overseer for a decision about your hooked bead.
+
+For polecats (and formula steps) that cannot continue without a human call.
+gt ask:
+  1. Labels the bead gt:needs-input and records the question on it
+  2. Marks this polecat needs-input, so it is not cleaned up as stale and
+     does not count toward scheduler capacity
+  3. Mails the question to the overseer
+
+After asking, stop and wait. The answer arrives in this session as a
+message when the overseer runs gt answer.
+
+Examples:
+  gt ask "Drop support for the v1 config format, or migrate it?"
+  gt ask --bead gt-abc123 "Which region should the new bucket live in?"`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runAsk,
+}
+
+var answerCmd = &cobra.Command{
+	Use:     "answer <bead-id> <answer>",
+	GroupID: GroupComm,
+	Short:   "Answer a question a polecat asked with gt ask",
+	Long: `Answer a question a polecat asked with gt ask, and resume it.
+
+Records the answer on the bead, clears its gt:needs-input label, and sends
+the answer into the session of the polecat working the bead, which then
+counts toward scheduler capacity again.
+
+Find waiting beads with: bd list --label gt:needs-input
+
+Examples:
+  gt answer gt-abc123 "Migrate it; v1 users still exist"
+  gt answer gt-abc123 --rig greenplace "us-east-1"`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runAnswer,
+}
+
+func init() {
+	askCmd.Flags().StringVar(&askBead, "bead", "", "Bead the question is about (default: your hooked bead)")
+	answerCmd.Flags().StringVar(&answerRig, "rig", "", "Rig of the bead (default: from the bead prefix)")
+	rootCmd.AddCommand(askCmd)
+	rootCmd.AddCommand(answerCmd)
+}
+
+func runAsk(cmd *cobra.Command, args []string) error {
+	question := strings.Join(args, " ")
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	rigName, polecatName := os.Getenv("GT_RIG"), os.Getenv("GT_POLECAT")
+	if rigName == "" || polecatName == "" {
+		return fmt.Errorf("gt ask must run in a polecat session (GT_RIG and GT_POLECAT not set)")
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	beadID := askBead
+	if beadID == "" {
+		info, err := mgr.Get(polecatName)
+		if err != nil {
+			return fmt.Errorf("looking up %s/%s: %w", rigName, polecatName, err)
+		}
+		if info.Issue == "" {
+			return fmt.Errorf("no hooked bead; pass --bead")
+		}
+		beadID = info.Issue
+	}
+
+	b := beads.New(r.BeadsPath())
+	if err := b.Update(beadID, beads.UpdateOptions{AddLabels: []string{labelNeedsInput}}); err != nil {
+		return fmt.Errorf("marking %s needs-input: %w", beadID, err)
+	}
+	if err := addBeadNote(beadID, "Question: "+question); err != nil {
+		style.PrintWarning("could not record question on %s: %v", beadID, err)
+	}
+
+	address := rigName + "/" + polecatName
+	t := tmux.NewTmux()
+	sessionName := polecat.NewSessionManager(t, r).SessionName(polecatName)
+	if err := t.SetEnvironment(sessionName, polecatNeedsInputEnv, beadID); err != nil {
+		style.PrintWarning("could not release capacity slot for %s: %v", address, err)
+	}
+	if err := mgr.SetAgentState(polecatName, string(beads.AgentStateNeedsInput)); err != nil {
+		style.PrintWarning("could not set agent_state for %s: %v", address, err)
+	}
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	msg := &mail.Message{
+		From:     address,
+		To:       "overseer",
+		Subject:  askSubject(beadID, question),
+		Body:     fmt.Sprintf("%s asks about %s:\n\n%s\n\nAnswer with: gt answer %s \"<answer>\"", address, beadID, question, beadID),
+		Priority: mail.PriorityHigh,
+	}
+	if err := router.Send(msg); err != nil {
+		style.PrintWarning("could not notify overseer: %v", err)
+	}
+
+	fmt.Printf("%s Asked the overseer about %s\n\n", style.Bold.Render("✓"), beadID)
+	fmt.Println("Stop here and wait. Do not continue work on this bead until the answer")
+	fmt.Println("arrives in this session.")
+	return nil
+}
+
+func runAnswer(cmd *cobra.Command, args []string) error {
+	beadID, answer := args[0], strings.Join(args[1:], " ")
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	rigName := answerRig
+	if rigName == "" {
+		rigName = beads.GetRigNameForPrefix(townRoot, beads.ExtractPrefix(beadID))
+		if rigName == "" {
+			return fmt.Errorf("cannot resolve rig for %s; pass --rig", beadID)
+		}
+	}
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	b := beads.New(r.BeadsPath())
+	issue, err := b.Show(beadID)
+	if err != nil {
+		return fmt.Errorf("loading %s: %w", beadID, err)
+	}
+	if !beads.HasLabel(issue, labelNeedsInput) {
+		return fmt.Errorf("%s is not waiting for input", beadID)
+	}
+	if err := b.Update(beadID, beads.UpdateOptions{RemoveLabels: []string{labelNeedsInput}}); err != nil {
+		return fmt.Errorf("clearing needs-input on %s: %w", beadID, err)
+	}
+	if err := addBeadNote(beadID, "Answer: "+answer); err != nil {
+		style.PrintWarning("could not record answer on %s: %v", beadID, err)
+	}
+
+	polecats, err := mgr.List()
+	if err != nil {
+		return fmt.Errorf("listing polecats: %w", err)
+	}
+	t := tmux.NewTmux()
+	for _, p := range polecats {
+		if p.Issue != beadID {
+			continue
+		}
+		address := rigName + "/" + p.Name
+		sessionName := polecat.NewSessionManager(t, r).SessionName(p.Name)
+		if err := t.SetEnvironment(sessionName, polecatNeedsInputEnv, ""); err != nil {
+			style.PrintWarning("could not clear needs-input on %s: %v", address, err)
+		}
+		if err := mgr.SetAgentState(p.Name, string(beads.AgentStateWorking)); err != nil {
+			style.PrintWarning("could not restore agent_state for %s: %v", address, err)
+		}
+		if err := t.NudgeSession(sessionName, answerNudge(beadID, answer)); err != nil {
+			return fmt.Errorf("delivering answer to %s: %w", address, err)
+		}
+		fmt.Printf("%s Answered %s; resumed %s\n", style.Bold.Render("✓"), beadID, address)
+		return nil
+	}
+
+	fmt.Printf("%s Answered %s\n", style.Bold.Render("✓"), beadID)
+	style.PrintWarning("no polecat is working %s; the answer is recorded on the bead", beadID)
+	return nil
+}
+
+// askSubject builds the overseer mail subject for a question, shortened to
+// fit an inbox line.
+func askSubject(beadID, question string) string {
+	const max = 60
+	q := strings.Join(strings.Fields(question), " ")
+	if r := []rune(q); len(r) > max {
+		q = string(r[:max-3]) + "..."
+	}
+	return fmt.Sprintf("[QUESTION] %s: %s", beadID, q)
+}
+
+// answerNudge is the message delivered to a polecat waiting in gt ask.
+func answerNudge(beadID, answer string) string {
+	return fmt.Sprintf("Answer from the overseer to your question on %s: %s\n"+
+		"Continue work on your hooked bead with this decision.", beadID, answer)
+}
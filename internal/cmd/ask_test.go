@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAskSubject(t *testing.T) {
+	if got := askSubject("gt-abc", "Keep  the\nv1 format?"); got != "[QUESTION] gt-abc: Keep the v1 format?" {
+		t.Errorf("askSubject = %q", got)
+	}
+	long := askSubject("gt-abc", strings.Repeat("x", 100))
+	if !strings.HasSuffix(long, "...") || len(long) != len("[QUESTION] gt-abc: ")+60 {
+		t.Errorf("long askSubject = %q (%d)", long, len(long))
+	}
+	wide := askSubject("gt-abc", strings.Repeat("é", 100))
+	if want := "[QUESTION] gt-abc: " + strings.Repeat("é", 57) + "..."; wide != want {
+		t.Errorf("multibyte askSubject = %q, want %q", wide, want)
+	}
+}
+
+func TestAnswerNudge(t *testing.T) {
+	got := answerNudge("gt-abc", "Migrate it")
+	if !strings.Contains(got, "gt-abc") || !strings.Contains(got, "Migrate it") {
+		t.Errorf("answerNudge = %q", got)
+	}
+}
@@ -2,12 +2,10 @@ package cmd
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"sort"
 	"strings"
 	"time"
 
@@ -23,7 +21,7 @@ import (
 var (
 	auditActor string
 	auditSince string
-	auditLimit int
+	auditPage  listFlags
 	auditJSON  bool
 )
 
@@ -46,6 +44,7 @@ Examples:
   gt audit --actor=mayor                  # Show mayor's activity
   gt audit --since=24h                    # Show all activity in last 24h
   gt audit --actor=joe --since=1h         # Combined filters
+  gt audit --limit=50 --offset=50         # Second page
   gt audit --json                         # Output as JSON`,
 	RunE: runAudit,
 }
@@ -53,7 +52,7 @@ Examples:
 func init() {
 	auditCmd.Flags().StringVar(&auditActor, "actor", "", "Filter by actor (agent address or partial match)")
 	auditCmd.Flags().StringVar(&auditSince, "since", "", "Show events since duration (e.g., 1h, 24h, 7d)")
-	auditCmd.Flags().IntVarP(&auditLimit, "limit", "n", 50, "Maximum number of entries to show")
+	auditListing.register(auditCmd, &auditPage, 50)
 	auditCmd.Flags().BoolVar(&auditJSON, "json", false, "Output as JSON")
 
 	rootCmd.AddCommand(auditCmd)
@@ -118,17 +117,19 @@ func runAudit(cmd *cobra.Command, args []string) error {
 	}
 	allEntries = append(allEntries, feedEntries...)
 
-	// Sort by timestamp (newest first)
-	sort.Slice(allEntries, func(i, j int) bool {
-		return allEntries[i].Timestamp.After(allEntries[j].Timestamp)
-	})
+	// Sort (newest first by default) and page
+	page, err := auditListing.apply(allEntries, auditPage)
+	if err != nil {
+		return err
+	}
+	allEntries = page.Items
 
-	// Apply limit
-	if auditLimit > 0 && len(allEntries) > auditLimit {
-		allEntries = allEntries[:auditLimit]
+	// Output
+	if auditJSON {
+		return printPageJSON(page, allEntries)
 	}
 
-	if len(allEntries) == 0 {
+	if page.Total == 0 {
 		if auditActor != "" {
 			fmt.Printf("%s No activity found for actor %q\n", style.Dim.Render("○"), auditActor)
 		} else {
@@ -137,11 +138,26 @@ func runAudit(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Output
-	if auditJSON {
-		return outputAuditJSON(allEntries)
+	if err := outputAuditText(allEntries); err != nil {
+		return err
 	}
-	return outputAuditText(allEntries)
+	printPageFooter(page)
+	return nil
+}
+
+// auditListing sorts gt audit entries, newest first.
+var auditListing = listing[AuditEntry]{
+	fields: []listField[AuditEntry]{
+		{"time", func(e AuditEntry) any { return e.Timestamp }},
+		{"actor", func(e AuditEntry) any { return e.Actor }},
+		{"source", func(e AuditEntry) any { return e.Source }},
+		{"type", func(e AuditEntry) any { return e.Type }},
+	},
+	defaultSort: "-time",
+	// Entries have no single unique key; this one is stable across runs.
+	id: func(e AuditEntry) string {
+		return strings.Join([]string{e.Source, e.Type, e.ID, e.Actor, e.Summary}, "\x00")
+	},
 }
 
 // parseDuration parses a duration string with support for days (d).
@@ -401,40 +417,17 @@ func formatTownlogSummary(e townlog.Event) string {
 	}
 }
 
-// collectFeedEvents queries the activity feed for events.
+// collectFeedEvents queries the activity feed for events, including
+// rotated segments.
 func collectFeedEvents(townRoot, actor string, since time.Time) ([]AuditEntry, error) {
 	var entries []AuditEntry
-
-	eventsPath := filepath.Join(townRoot, events.EventsFile)
-	file, err := os.Open(eventsPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil // No events file yet
-		}
-		return nil, err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		var e events.Event
-		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
-			continue // Skip malformed lines
-		}
-
+	err := events.ReadRange(townRoot, since, time.Time{}, func(e events.Event) bool {
 		// Apply actor filter
 		if actor != "" && !matchesActor(e.Actor, actor) {
-			continue
+			return true
 		}
 
-		// Parse timestamp
 		ts, _ := time.Parse(time.RFC3339, e.Timestamp)
-
-		// Apply since filter
-		if !since.IsZero() && ts.Before(since) {
-			continue
-		}
-
 		entries = append(entries, AuditEntry{
 			Timestamp: ts,
 			Source:    "events",
@@ -442,9 +435,9 @@ func collectFeedEvents(townRoot, actor string, since time.Time) ([]AuditEntry, e
 			Actor:     e.Actor,
 			Summary:   formatFeedSummary(e),
 		})
-	}
-
-	return entries, nil
+		return true
+	})
+	return entries, err
 }
 
 // formatFeedSummary creates a readable summary from a feed event.
@@ -482,12 +475,6 @@ func formatFeedSummary(e events.Event) string {
 	}
 }
 
-func outputAuditJSON(entries []AuditEntry) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "  ")
-	return enc.Encode(entries)
-}
-
 func outputAuditText(entries []AuditEntry) error {
 	// Group by date for readability
 	var currentDate string
@@ -3,7 +3,6 @@ package cmd
 import (
 	"io"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/beads"
@@ -128,11 +127,11 @@ func (b *bdCmd) buildEnv() []string {
 	return env
 }
 
-// Build returns the configured exec.Cmd.
-// This allows callers to further customize the command before execution.
-func (b *bdCmd) Build() *exec.Cmd {
-	args := b.resolvedArgs()
-	cmd := exec.Command("bd", args...)
+// Build returns the configured command, limited by the town's bd limiter
+// (see beads.Cmd). This allows callers to further customize the command
+// before execution.
+func (b *bdCmd) Build() *beads.Cmd {
+	cmd := beads.Command(b.resolvedArgs()...)
 	cmd.Dir = b.dir
 	cmd.Env = b.buildEnv()
 	cmd.Stderr = b.stderr
@@ -154,7 +153,8 @@ func (b *bdCmd) resolvedArgs() []string {
 }
 
 // Run builds and runs the command, returning any error.
-// This is a convenience method equivalent to Build().Run().
+// This is a convenience method equivalent to Build().Run(); like every
+// beads.Cmd it waits for the town's bd limiter.
 func (b *bdCmd) Run() error {
 	return b.Build().Run()
 }
@@ -171,9 +171,7 @@ func (b *bdCmd) Output() ([]byte, error) {
 // This overrides the configured Stderr writer to capture both streams.
 // Useful for including command output in error messages.
 func (b *bdCmd) CombinedOutput() ([]byte, error) {
-	args := b.resolvedArgs()
-	cmd := exec.Command("bd", args...)
-	cmd.Dir = b.dir
-	cmd.Env = b.buildEnv()
+	cmd := b.Build()
+	cmd.Stderr = nil
 	return cmd.CombinedOutput()
 }
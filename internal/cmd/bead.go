@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -26,7 +25,10 @@ prefix-based routing.
 Subcommands:
   move    Move a bead from one repository to another
   show    Show details of a bead (routes by prefix)
-  read    Alias for show`,
+  read    Alias for show
+  note    Append a progress note to a bead
+  transcript  Show the archived agent transcript for a bead
+  artifacts   List result artifacts saved for a bead`,
 }
 
 var beadMoveCmd = &cobra.Command{
@@ -180,7 +182,7 @@ func runBeadMove(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create the new bead
-	createCmd := exec.Command("bd", createArgs...)
+	createCmd := beads.Command(createArgs...)
 	createCmd.Stderr = os.Stderr
 	newIDBytes, err := createCmd.Output()
 	if err != nil {
@@ -192,12 +194,12 @@ func runBeadMove(cmd *cobra.Command, args []string) error {
 
 	// Close the source bead with reference
 	closeReason := fmt.Sprintf("Moved to %s", newID)
-	closeCmd := exec.Command("bd", "close", sourceID, "--reason", closeReason)
+	closeCmd := beads.Command("close", sourceID, "--reason", closeReason)
 	closeCmd.Stderr = os.Stderr
 	if err := closeCmd.Run(); err != nil {
 		// Clean up the new bead since we couldn't close the source
 		fmt.Fprintf(os.Stderr, "Warning: failed to close source bead: %v\n", err)
-		cleanupCmd := exec.Command("bd", "close", newID, "--reason", "Cleanup: source bead close failed during move")
+		cleanupCmd := beads.Command("close", newID, "--reason", "Cleanup: source bead close failed during move")
 		if cleanupErr := cleanupCmd.Run(); cleanupErr != nil {
 			fmt.Fprintf(os.Stderr, "Warning: also failed to clean up new bead %s: %v\n", newID, cleanupErr)
 			fmt.Fprintf(os.Stderr, "Both %s and %s remain open - manual cleanup needed\n", sourceID, newID)
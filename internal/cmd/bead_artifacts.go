@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/artifacts"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	beadArtifactsPath bool
+	beadArtifactsJSON bool
+	artifactsGCDryRun bool
+)
+
+var beadArtifactsCmd = &cobra.Command{
+	Use:   "artifacts <bead-id>",
+	Short: "List result artifacts saved for a bead",
+	Long: `List the result artifacts (test reports, built binaries, screenshots)
+saved for a bead.
+
+Each bead has an artifact directory at <town>/.runtime/artifacts/<bead-id>/.
+Polecat sessions working a bead get its path in GT_ARTIFACTS_DIR, so formula
+steps can drop files there with, for example:
+
+  cp coverage.html "$GT_ARTIFACTS_DIR/"
+
+Artifacts outlive the polecat's worktree and are linked from
+'gt convoy report'. The daemon removes a bead's artifacts once they are
+older than artifacts.max_age_days (default 30), and the least recently
+changed beyond artifacts.max_size_mb in total (default 2048); run
+'gt bead artifacts gc' to apply the policy now.
+
+Examples:
+  gt bead artifacts gt-abc123          # List artifacts
+  gt bead artifacts gt-abc123 --path   # Print the artifact directory
+  gt bead artifacts gc --dry-run       # Show what retention would remove`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBeadArtifacts,
+}
+
+var beadArtifactsGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Apply the artifact retention policy now",
+	Long: `Remove bead artifact directories outside the retention policy.
+
+A directory is removed once nothing in it has changed for
+artifacts.max_age_days; then the least recently changed are removed until
+the total fits in artifacts.max_size_mb. The daemon runs this hourly.`,
+	Args: cobra.NoArgs,
+	RunE: runBeadArtifactsGC,
+}
+
+func init() {
+	beadArtifactsCmd.Flags().BoolVar(&beadArtifactsPath, "path", false, "Print the artifact directory instead of listing it")
+	beadArtifactsCmd.Flags().BoolVar(&beadArtifactsJSON, "json", false, "Output as JSON")
+	beadArtifactsGCCmd.Flags().BoolVarP(&artifactsGCDryRun, "dry-run", "n", false, "Show what would be removed without removing it")
+	beadArtifactsCmd.AddCommand(beadArtifactsGCCmd)
+	beadCmd.AddCommand(beadArtifactsCmd)
+}
+
+func runBeadArtifacts(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	if beadArtifactsPath {
+		fmt.Println(artifacts.Dir(townRoot, beadID))
+		return nil
+	}
+
+	list, err := artifacts.List(townRoot, beadID)
+	if err != nil {
+		return err
+	}
+	if beadArtifactsJSON {
+		if list == nil {
+			list = []artifacts.Artifact{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(list)
+	}
+	if len(list) == 0 {
+		fmt.Printf("No artifacts for %s\n", beadID)
+		return nil
+	}
+
+	var total int64
+	for _, a := range list {
+		total += a.Size
+		fmt.Printf("%s  %8s  %s\n", a.ModTime.Local().Format("2006-01-02 15:04"), formatBytes(a.Size), a.Path)
+	}
+	fmt.Printf("\n%d artifact(s), %s in %s\n", len(list), formatBytes(total), artifacts.Dir(townRoot, beadID))
+	return nil
+}
+
+func runBeadArtifactsGC(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	result := artifacts.GC(townRoot, artifacts.LoadPolicy(townRoot), time.Now(), artifactsGCDryRun)
+	var freed int64
+	for _, r := range result.Removed {
+		freed += r.Size
+		fmt.Printf("  %s %s  %s  %s\n", style.Dim.Render("-"), r.BeadID, formatBytes(r.Size), style.Dim.Render(r.Reason))
+	}
+	for _, err := range result.Errors {
+		style.PrintWarning("%v", err)
+	}
+
+	verb := "Removed"
+	if artifactsGCDryRun {
+		verb = "Would remove"
+	}
+	fmt.Printf("%s %s %d bead artifact dir(s) (%s), kept %d\n",
+		style.Bold.Render("✓"), verb, len(result.Removed), formatBytes(freed), result.Kept)
+	if len(result.Errors) > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
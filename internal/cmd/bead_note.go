@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// beadNoteCommentPrefix marks bd comments written by gt bead note, so notes
+// stand out from other comments in bd show.
+const beadNoteCommentPrefix = "📝 "
+
+// maxBeadNoteLen keeps notes to a one-line narrative; details belong in commits.
+const maxBeadNoteLen = 500
+
+var beadNoteCmd = &cobra.Command{
+	Use:   "note <bead-id> <message>",
+	Short: "Append a progress note to a bead",
+	Long: `Append a short progress note to a bead.
+
+Agents and formulas use notes to leave a running narrative of their work
+("reproduced the bug", "tests green, writing docs") so humans can follow
+along without reading transcripts.
+
+Each note is stored as a bd comment on the bead and logged to the activity
+feed. The latest note for each tracked issue is shown by 'gt convoy status'
+and in the dashboard's convoy drill-down.
+
+Examples:
+  gt bead note gt-abc123 "Reproduced the crash with an empty config"
+  gt bead note gt-abc123 Tests passing, opening MR`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runBeadNote,
+}
+
+func init() {
+	beadCmd.AddCommand(beadNoteCmd)
+}
+
+func runBeadNote(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	message := strings.Join(strings.Fields(strings.Join(args[1:], " ")), " ")
+	if message == "" {
+		return fmt.Errorf("note message is empty")
+	}
+	if r := []rune(message); len(r) > maxBeadNoteLen {
+		return fmt.Errorf("note is %d characters; keep notes under %d", len(r), maxBeadNoteLen)
+	}
+
+	if err := addBeadNote(beadID, message); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Noted on %s\n", style.Bold.Render("✓"), beadID)
+	return nil
+}
+
+// addBeadNote stores message as a note comment on beadID and logs it to the
+// activity feed.
+func addBeadNote(beadID, message string) error {
+	if err := BdCmd("comments", "add", beadID, beadNoteCommentPrefix+message).
+		Dir(resolveBeadDir(beadID)).
+		StripBeadsDir().
+		Run(); err != nil {
+		return fmt.Errorf("adding note to %s: %w", beadID, err)
+	}
+	_ = events.LogFeed(events.TypeBeadNote, detectActor(), events.BeadNotePayload(beadID, message))
+	return nil
+}
+
+// beadNote is the most recent progress note recorded for a bead.
+type beadNote struct {
+	Message   string `json:"message"`
+	Actor     string `json:"actor,omitempty"`
+	Timestamp string `json:"ts,omitempty"`
+}
+
+// latestBeadNotes scans the town events log, rotated segments included, for
+// the newest note on each of ids.
+func latestBeadNotes(townRoot string, ids []string) map[string]beadNote {
+	result := make(map[string]beadNote)
+	if len(ids) == 0 {
+		return result
+	}
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	_ = events.ReadRange(townRoot, time.Time{}, time.Time{}, func(e events.Event) bool {
+		if e.Type != events.TypeBeadNote {
+			return true
+		}
+		beadID, _ := e.Payload["bead"].(string)
+		if !wanted[beadID] {
+			return true
+		}
+		msg, _ := e.Payload["message"].(string)
+		// Events are read oldest first, so later notes overwrite earlier ones.
+		result[beadID] = beadNote{Message: msg, Actor: e.Actor, Timestamp: e.Timestamp}
+		return true
+	})
+	return result
+}
+
+// attachLatestNotes fills LatestNote on tracked issues from the events log.
+func attachLatestNotes(townRoot string, tracked []trackedIssueInfo) {
+	ids := make([]string, len(tracked))
+	for i, t := range tracked {
+		ids[i] = t.ID
+	}
+	notes := latestBeadNotes(townRoot, ids)
+	for i := range tracked {
+		if n, ok := notes[tracked[i].ID]; ok {
+			tracked[i].LatestNote = n.Message
+		}
+	}
+}
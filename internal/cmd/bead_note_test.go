@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestLatestBeadNotes(t *testing.T) {
+	townRoot := t.TempDir()
+	lines := []string{
+		`{"ts":"2026-01-01T10:00:00Z","type":"bead_note","actor":"gastown/polecats/nux","payload":{"bead":"gt-a","message":"reproduced"}}`,
+		`{"ts":"2026-01-01T10:05:00Z","type":"sling","actor":"mayor","payload":{"bead":"gt-a","target":"gastown"}}`,
+		`not json`,
+		`{"ts":"2026-01-01T10:10:00Z","type":"bead_note","actor":"gastown/polecats/nux","payload":{"bead":"gt-a","message":"tests green"}}`,
+		`{"ts":"2026-01-01T10:11:00Z","type":"bead_note","actor":"gastown/polecats/ace","payload":{"bead":"gt-other","message":"ignored"}}`,
+	}
+	path := filepath.Join(townRoot, events.EventsFile)
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	notes := latestBeadNotes(townRoot, []string{"gt-a", "gt-b"})
+	if len(notes) != 1 {
+		t.Fatalf("got %d notes, want 1: %+v", len(notes), notes)
+	}
+	if got := notes["gt-a"]; got.Message != "tests green" || got.Actor != "gastown/polecats/nux" {
+		t.Errorf("latest note = %+v, want the newest one", got)
+	}
+
+	tracked := []trackedIssueInfo{{ID: "gt-a"}, {ID: "gt-b"}}
+	attachLatestNotes(townRoot, tracked)
+	if tracked[0].LatestNote != "tests green" || tracked[1].LatestNote != "" {
+		t.Errorf("attachLatestNotes = %+v", tracked)
+	}
+}
+
+func TestLatestBeadNotes_MissingLog(t *testing.T) {
+	if notes := latestBeadNotes(t.TempDir(), []string{"gt-a"}); len(notes) != 0 {
+		t.Errorf("expected no notes, got %+v", notes)
+	}
+}
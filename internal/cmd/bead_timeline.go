@@ -0,0 +1,349 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var beadTimelineJSON bool
+
+// Timeline entry kinds, one per stage of a bead's life.
+const (
+	timelineCreated  = "created"
+	timelineQueue    = "queue"    // Scheduled, waiting for capacity
+	timelineDispatch = "dispatch" // Dispatch attempts, slings and spawns
+	timelineSession  = "session"  // Polecat sessions starting and dying
+	timelineLimit    = "limit"    // Rate-limit interruptions
+	timelineNote     = "note"     // Progress notes
+	timelineDone     = "done"
+	timelineMerge    = "merge"
+	timelineHuman    = "human" // Takeovers and handbacks
+	timelineClosed   = "closed"
+	timelineEvent    = "event" // Anything else correlated with the bead
+)
+
+// beadTimelineEntry is one moment in a bead's history.
+type beadTimelineEntry struct {
+	Time          string `json:"time"`
+	Kind          string `json:"kind"`
+	Actor         string `json:"actor,omitempty"`
+	Summary       string `json:"summary"`
+	Event         string `json:"event,omitempty"` // Source event type, if any
+	CorrelationID string `json:"correlation_id,omitempty"`
+
+	at time.Time
+}
+
+// beadTimeline is everything known about a bead, oldest first.
+type beadTimeline struct {
+	Bead    string              `json:"bead"`
+	Title   string              `json:"title,omitempty"`
+	Status  string              `json:"status,omitempty"`
+	Entries []beadTimelineEntry `json:"entries"`
+}
+
+var beadTimelineCmd = &cobra.Command{
+	Use:   "timeline <bead-id>",
+	Short: "Show a bead's history across queue, polecats and merge",
+	Long: `Show everything known about one bead over time, oldest first: when it
+was created and scheduled, each dispatch attempt, the polecat sessions that
+worked on it and what they were spawned with, progress notes, rate-limit
+interruptions, gt done, the merge, and when it closed.
+
+Pipeline events come from the bead's correlation IDs (see gt activity
+trace). Polecat sessions are matched by polecat and time: a session of a
+polecat the bead was dispatched to counts from the dispatch until that
+polecat's gt done, so a polecat name reused for later work isn't mixed in.
+
+Examples:
+  gt bead timeline gt-abc12
+  gt bead timeline gt-abc12 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBeadTimeline,
+}
+
+func init() {
+	beadTimelineCmd.Flags().BoolVar(&beadTimelineJSON, "json", false, "Output as JSON")
+	beadCmd.AddCommand(beadTimelineCmd)
+}
+
+func runBeadTimeline(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	var all []events.Event
+	if err := events.ReadRange(townRoot, time.Time{}, time.Time{}, func(e events.Event) bool {
+		all = append(all, e)
+		return true
+	}); err != nil {
+		return fmt.Errorf("reading events: %w", err)
+	}
+	trace := traceFromEvents(all, beadID)
+
+	// The bead itself may be gone (or bd unavailable); its events still tell
+	// most of the story.
+	issue, _ := beads.New(resolveBeadDir(beadID)).Show(beadID)
+	if issue == nil && len(trace) == 0 {
+		return fmt.Errorf("no bead or events found for %s", beadID)
+	}
+
+	timeline := buildBeadTimeline(beadID, issue, trace, polecatSessionEvents(trace, all, issue), beadSpawnRecords(beadID))
+	if beadTimelineJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(timeline)
+	}
+	printBeadTimeline(timeline)
+	return nil
+}
+
+// beadSpawnRecords returns the spawn records of polecats last slung the
+// bead, in any rig.
+func beadSpawnRecords(beadID string) []*polecat.SpawnRecord {
+	rigs, err := getAllRigs()
+	if err != nil {
+		return nil
+	}
+	var recs []*polecat.SpawnRecord
+	for _, r := range rigs {
+		for _, rec := range polecat.ListSpawnRecords(r.Path) {
+			if rec.Bead == beadID {
+				recs = append(recs, rec)
+			}
+		}
+	}
+	return recs
+}
+
+// buildBeadTimeline merges the bead's own timestamps, its traced events,
+// its polecats' session events and their spawn records into one
+// chronological timeline. issue may be nil.
+func buildBeadTimeline(beadID string, issue *beads.Issue, trace, sessions []events.Event, spawns []*polecat.SpawnRecord) beadTimeline {
+	timeline := beadTimeline{Bead: beadID, Entries: []beadTimelineEntry{}}
+	add := func(entry beadTimelineEntry) {
+		t, err := time.Parse(time.RFC3339, entry.Time)
+		if err != nil {
+			return // Can't place it in time
+		}
+		entry.at = t
+		timeline.Entries = append(timeline.Entries, entry)
+	}
+
+	if issue != nil {
+		timeline.Title = issue.Title
+		timeline.Status = issue.Status
+		if issue.CreatedAt != "" {
+			add(beadTimelineEntry{Time: issue.CreatedAt, Kind: timelineCreated, Actor: issue.CreatedBy, Summary: "Created: " + issue.Title})
+		}
+		if issue.ClosedAt != "" {
+			add(beadTimelineEntry{Time: issue.ClosedAt, Kind: timelineClosed, Summary: "Closed"})
+		}
+	}
+	for _, e := range trace {
+		kind, summary := timelineEventSummary(e)
+		add(beadTimelineEntry{Time: e.Timestamp, Kind: kind, Actor: e.Actor, Summary: summary, Event: e.Type, CorrelationID: e.CorrelationID()})
+	}
+	for _, e := range sessions {
+		kind, summary := timelineEventSummary(e)
+		add(beadTimelineEntry{Time: e.Timestamp, Kind: kind, Actor: e.Actor, Summary: summary, Event: e.Type})
+	}
+
+	for _, rec := range spawns {
+		if rec.SlungAt.IsZero() {
+			continue
+		}
+		add(beadTimelineEntry{Time: rec.SlungAt.Format(time.RFC3339), Kind: timelineDispatch, Summary: spawnRecordSummary(rec), CorrelationID: rec.CorrelationID})
+	}
+
+	// Stable, so same-second entries keep the order they were logged in.
+	sort.SliceStable(timeline.Entries, func(i, j int) bool {
+		return timeline.Entries[i].at.Before(timeline.Entries[j].at)
+	})
+	return timeline
+}
+
+// polecatSessionEvents returns the session events of the polecats the bead
+// was dispatched to, from each polecat's first dispatch until its gt done
+// (or the bead's close). Session events carry no bead, so they are matched
+// by polecat and time.
+func polecatSessionEvents(trace, all []events.Event, issue *beads.Issue) []events.Event {
+	type window struct{ start, end time.Time }
+	windows := make(map[string]*window) // agent address → window
+
+	for _, e := range trace {
+		t, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil {
+			continue
+		}
+		switch e.Type {
+		case events.TypeSchedulerDispatch, events.TypeSpawn, events.TypeLimitWake:
+			rig, _ := e.Payload["rig"].(string)
+			name, _ := e.Payload["polecat"].(string)
+			if rig == "" || name == "" {
+				continue
+			}
+			agent := rig + "/polecats/" + name
+			if w := windows[agent]; w == nil {
+				windows[agent] = &window{start: t}
+			} else if t.Before(w.start) {
+				w.start = t
+			}
+		}
+	}
+	if len(windows) == 0 {
+		return nil
+	}
+	var closed time.Time
+	if issue != nil && issue.ClosedAt != "" {
+		closed, _ = time.Parse(time.RFC3339, issue.ClosedAt)
+	}
+	for agent, w := range windows {
+		w.end = closed
+		for _, e := range trace {
+			if e.Type != events.TypeDone || e.Actor != agent {
+				continue
+			}
+			if t, err := time.Parse(time.RFC3339, e.Timestamp); err == nil && !t.Before(w.start) && (w.end.IsZero() || t.Before(w.end)) {
+				w.end = t
+			}
+		}
+	}
+
+	var sessions []events.Event
+	for _, e := range all {
+		var agent string
+		switch e.Type {
+		case events.TypeSessionStart, events.TypeSessionEnd:
+			agent = e.Actor
+		case events.TypeSessionDeath:
+			agent, _ = e.Payload["agent"].(string)
+		default:
+			continue
+		}
+		w := windows[agent]
+		if w == nil {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil || t.Before(w.start) || (!w.end.IsZero() && t.After(w.end)) {
+			continue
+		}
+		sessions = append(sessions, e)
+	}
+	return sessions
+}
+
+// spawnRecordSummary describes what a polecat was spawned with.
+func spawnRecordSummary(rec *polecat.SpawnRecord) string {
+	var with []string
+	if rec.Agent != "" {
+		with = append(with, "agent "+rec.Agent)
+	} else if rec.AgentOverride != "" {
+		with = append(with, "agent "+rec.AgentOverride)
+	}
+	if rec.Formula != "" {
+		with = append(with, "formula "+rec.Formula)
+	}
+	if rec.BaseBranch != "" {
+		base := "base " + rec.BaseBranch
+		if rec.BaseSHA != "" {
+			base += "@" + shortHash(rec.BaseSHA)
+		}
+		with = append(with, base)
+	}
+	summary := fmt.Sprintf("Spawned %s/%s", rec.Rig, rec.Polecat)
+	if len(with) > 0 {
+		summary += " (" + strings.Join(with, ", ") + ")"
+	}
+	return summary
+}
+
+// timelineEventSummary returns the timeline kind of an event and a short
+// human description of it.
+func timelineEventSummary(e events.Event) (string, string) {
+	switch e.Type {
+	case events.TypeSchedulerEnqueue:
+		return timelineQueue, fmt.Sprintf("Scheduled on %s, waiting for capacity", watchPayloadString(e, "rig"))
+	case events.TypeSchedulerDispatch:
+		return timelineDispatch, "Dispatched to " + watchPolecatAddress(e)
+	case events.TypeSchedulerDispatchFailed:
+		return timelineDispatch, fmt.Sprintf("Dispatch attempt %v failed: %s", e.Payload["attempt"], watchPayloadString(e, "error"))
+	case events.TypeSling:
+		return timelineDispatch, "Slung to " + watchPayloadString(e, "target")
+	case events.TypeSpawn:
+		return timelineDispatch, "Polecat " + watchPolecatAddress(e) + " spawned"
+	case events.TypeHook:
+		return timelineDispatch, "Hooked by " + e.Actor
+	case events.TypeUnhook:
+		return timelineDispatch, "Unhooked by " + e.Actor
+	case events.TypeSessionStart:
+		return timelineSession, "Session started: " + e.Actor
+	case events.TypeSessionEnd:
+		return timelineSession, "Session ended: " + e.Actor
+	case events.TypeSessionDeath:
+		return timelineSession, fmt.Sprintf("Session %s died: %s", watchPayloadString(e, "session"), watchPayloadString(e, "reason"))
+	case events.TypeLimitWake:
+		stalled := "?"
+		if ms, ok := e.Payload["stalled_ms"].(float64); ok {
+			stalled = (time.Duration(ms) * time.Millisecond).Round(time.Second).String()
+		}
+		return timelineLimit, fmt.Sprintf("Resumed %s after rate limit (stalled %s)", watchPolecatAddress(e), stalled)
+	case events.TypeBeadNote:
+		return timelineNote, "Note: " + watchPayloadString(e, "message")
+	case events.TypeDone:
+		return timelineDone, fmt.Sprintf("gt done: %s on %s", watchPayloadString(e, "exit_type"), watchPayloadString(e, "branch"))
+	case events.TypeMergeStarted:
+		return timelineMerge, "Refinery merging " + watchPayloadString(e, "branch")
+	case events.TypeMerged:
+		return timelineMerge, "Merged " + watchPayloadString(e, "branch")
+	case events.TypeMergeFailed:
+		return timelineMerge, fmt.Sprintf("Merge of %s failed: %s", watchPayloadString(e, "branch"), watchPayloadString(e, "reason"))
+	case events.TypeMergeSkipped:
+		return timelineMerge, fmt.Sprintf("Merge of %s skipped: %s", watchPayloadString(e, "branch"), watchPayloadString(e, "reason"))
+	case events.TypeTakeover:
+		return timelineHuman, fmt.Sprintf("Taken over by %s from %s", watchPayloadString(e, "to"), watchPayloadString(e, "from"))
+	case events.TypeHandback:
+		return timelineHuman, fmt.Sprintf("Handed back by %s to %s", watchPayloadString(e, "from"), watchPayloadString(e, "to"))
+	}
+	return timelineEvent, strings.TrimSpace(e.Type + " " + tracePayloadSummary(e.Payload))
+}
+
+func printBeadTimeline(timeline beadTimeline) {
+	header := style.Bold.Render(timeline.Bead)
+	if timeline.Title != "" {
+		header += " " + timeline.Title
+	}
+	if timeline.Status != "" {
+		header += " " + style.Dim.Render("("+timeline.Status+")")
+	}
+	fmt.Println(header)
+	if len(timeline.Entries) == 0 {
+		fmt.Println(style.Dim.Render("  (no history recorded)"))
+		return
+	}
+
+	start := timeline.Entries[0].at
+	for _, entry := range timeline.Entries {
+		ts := entry.at.Local().Format("2006-01-02 15:04:05")
+		elapsed := fmt.Sprintf("+%s", entry.at.Sub(start).Round(time.Second))
+		line := fmt.Sprintf("  %s %s  %-8s %s", style.Dim.Render(ts), style.Dim.Render(fmt.Sprintf("%-9s", elapsed)), entry.Kind, entry.Summary)
+		if entry.Actor != "" && !strings.Contains(entry.Summary, entry.Actor) {
+			line += "  " + style.Dim.Render(entry.Actor)
+		}
+		fmt.Println(line)
+	}
+}
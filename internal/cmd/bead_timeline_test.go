@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestBuildBeadTimeline(t *testing.T) {
+	const toast = "gastown/polecats/toast"
+	all := []events.Event{
+		{Timestamp: "2026-03-01T10:00:00Z", Type: events.TypeSchedulerEnqueue, Payload: map[string]interface{}{"bead": "gt-a", "rig": "gastown", "correlation_id": "cor-1"}},
+		{Timestamp: "2026-03-01T09:00:00Z", Type: events.TypeSessionStart, Actor: toast}, // Before dispatch: other work
+		{Timestamp: "2026-03-01T10:05:00Z", Type: events.TypeSchedulerDispatchFailed, Payload: map[string]interface{}{"bead": "gt-a", "attempt": 1, "error": "spawn failed", "correlation_id": "cor-1"}},
+		{Timestamp: "2026-03-01T10:10:00Z", Type: events.TypeSchedulerDispatch, Payload: map[string]interface{}{"bead": "gt-a", "rig": "gastown", "polecat": "toast", "correlation_id": "cor-1"}},
+		{Timestamp: "2026-03-01T10:11:00Z", Type: events.TypeSessionStart, Actor: toast},
+		{Timestamp: "2026-03-01T10:11:00Z", Type: events.TypeSessionStart, Actor: "gastown/polecats/nux"}, // Another polecat
+		{Timestamp: "2026-03-01T10:20:00Z", Type: events.TypeBeadNote, Actor: toast, Payload: map[string]interface{}{"bead": "gt-a", "message": "tests passing"}},
+		{Timestamp: "2026-03-01T10:30:00Z", Type: events.TypeLimitWake, Payload: map[string]interface{}{"bead": "gt-a", "rig": "gastown", "polecat": "toast", "stalled_ms": float64(600000)}},
+		{Timestamp: "2026-03-01T10:40:00Z", Type: events.TypeSessionDeath, Payload: map[string]interface{}{"session": "gt-toast", "agent": toast, "reason": "crash"}},
+		{Timestamp: "2026-03-01T10:50:00Z", Type: events.TypeDone, Actor: toast, Payload: map[string]interface{}{"bead": "gt-a", "exit_type": "COMPLETED", "branch": "polecat/toast", "correlation_id": "cor-1"}},
+		{Timestamp: "2026-03-01T11:00:00Z", Type: events.TypeMerged, Payload: map[string]interface{}{"mr": "gt-mr1", "branch": "polecat/toast", "correlation_id": "cor-1"}},
+		{Timestamp: "2026-03-01T12:00:00Z", Type: events.TypeSessionStart, Actor: toast}, // Name reused for later work
+	}
+	issue := &beads.Issue{ID: "gt-a", Title: "Fix login", Status: "closed", CreatedAt: "2026-03-01T09:30:00Z", ClosedAt: "2026-03-01T11:01:00Z"}
+
+	trace := traceFromEvents(all, "gt-a")
+	timeline := buildBeadTimeline("gt-a", issue, trace, polecatSessionEvents(trace, all, issue), nil)
+
+	var kinds []string
+	for _, entry := range timeline.Entries {
+		kinds = append(kinds, entry.Kind)
+	}
+	want := []string{
+		timelineCreated, timelineQueue, timelineDispatch, timelineDispatch, timelineSession,
+		timelineNote, timelineLimit, timelineSession, timelineDone, timelineMerge, timelineClosed,
+	}
+	if strings.Join(kinds, ",") != strings.Join(want, ",") {
+		t.Fatalf("kinds = %v, want %v", kinds, want)
+	}
+	if timeline.Title != "Fix login" || timeline.Status != "closed" {
+		t.Errorf("header = %q (%s), want Fix login (closed)", timeline.Title, timeline.Status)
+	}
+	if got := timeline.Entries[6].Summary; got != "Resumed gastown/toast after rate limit (stalled 10m0s)" {
+		t.Errorf("limit summary = %q", got)
+	}
+	if got := timeline.Entries[3].CorrelationID; got != "cor-1" {
+		t.Errorf("dispatch correlation = %q, want cor-1", got)
+	}
+}
+
+func TestBuildBeadTimeline_NoBead(t *testing.T) {
+	trace := []events.Event{
+		{Timestamp: "2026-03-01T10:00:00Z", Type: events.TypeSling, Payload: map[string]interface{}{"bead": "gt-a", "target": "gastown"}},
+		{Timestamp: "not a time", Type: events.TypeHook, Payload: map[string]interface{}{"bead": "gt-a"}},
+	}
+	spawns := []*polecat.SpawnRecord{
+		{Rig: "gastown", Polecat: "toast", SlungAt: time.Date(2026, 3, 1, 10, 0, 5, 0, time.UTC), Agent: "codex", Formula: "mol-polecat-work", BaseBranch: "main", BaseSHA: "0123456789abcdef"},
+		{Rig: "gastown", Polecat: "nux"}, // Never slung: not placed
+	}
+	timeline := buildBeadTimeline("gt-a", nil, trace, polecatSessionEvents(trace, trace, nil), spawns)
+	if len(timeline.Entries) != 2 || timeline.Entries[0].Summary != "Slung to gastown" {
+		t.Fatalf("entries = %+v, want the sling and one spawn", timeline.Entries)
+	}
+	if got := timeline.Entries[1].Summary; got != "Spawned gastown/toast (agent codex, formula mol-polecat-work, base main@01234567)" {
+		t.Errorf("spawn summary = %q", got)
+	}
+}
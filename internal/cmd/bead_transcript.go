@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	beadTranscriptPath bool
+	beadTranscriptList bool
+)
+
+var beadTranscriptCmd = &cobra.Command{
+	Use:   "transcript <bead-id>",
+	Short: "Show the archived agent transcript for a bead",
+	Long: `Show the Claude transcript archived when a polecat finished work on a bead.
+
+gt done copies the polecat's session transcript into
+<town>/.runtime/archives/<bead-id>/ and records it in index.json there.
+Transcripts under ~/.claude/projects are keyed by worktree path, so they are
+hard to find once the polecat's worktree has been reused or removed.
+
+By default the most recent transcript is shown through the pager.
+
+Examples:
+  gt bead transcript gt-abc123          # Page the latest transcript
+  gt bead transcript gt-abc123 --path   # Print the archived file path
+  gt bead transcript gt-abc123 --list   # List every archived session`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBeadTranscript,
+}
+
+func init() {
+	beadTranscriptCmd.Flags().BoolVar(&beadTranscriptPath, "path", false, "Print the transcript path instead of its contents")
+	beadTranscriptCmd.Flags().BoolVar(&beadTranscriptList, "list", false, "List all archived transcripts for the bead")
+	beadCmd.AddCommand(beadTranscriptCmd)
+}
+
+// transcriptArchiveEntry records one archived session transcript for a bead.
+type transcriptArchiveEntry struct {
+	File       string    `json:"file"`
+	SessionID  string    `json:"session_id"`
+	Agent      string    `json:"agent,omitempty"`
+	Source     string    `json:"source"`
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// transcriptArchiveIndex is the index.json stored alongside archived transcripts.
+type transcriptArchiveIndex struct {
+	BeadID      string                   `json:"bead_id"`
+	Transcripts []transcriptArchiveEntry `json:"transcripts"`
+}
+
+// transcriptArchiveDir returns <town>/.runtime/archives/<bead-id>.
+func transcriptArchiveDir(townRoot, beadID string) string {
+	return filepath.Join(townRoot, ".runtime", "archives", beadID)
+}
+
+// loadTranscriptIndex reads a bead's archive index. A missing index yields an
+// empty index rather than an error.
+func loadTranscriptIndex(dir, beadID string) (*transcriptArchiveIndex, error) {
+	idx := &transcriptArchiveIndex{BeadID: beadID}
+	data, err := os.ReadFile(filepath.Join(dir, "index.json"))
+	if os.IsNotExist(err) {
+		return idx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, idx); err != nil {
+		return nil, fmt.Errorf("parsing transcript index: %w", err)
+	}
+	return idx, nil
+}
+
+// saveTranscriptIndex writes the index atomically (temp file + rename).
+func saveTranscriptIndex(dir string, idx *transcriptArchiveIndex) error {
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, "index.json")
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// findTranscriptForWorkDir locates the newest Claude transcript for workDir.
+// gt done may run from a subdirectory of the worktree Claude was started in,
+// so parent directories are tried up to (but not including) townRoot.
+func findTranscriptForWorkDir(townRoot, workDir string) (string, error) {
+	for dir := workDir; dir != "" && dir != townRoot; {
+		projectDir, err := getClaudeProjectDir(dir)
+		if err != nil {
+			return "", err
+		}
+		if _, err := os.Stat(projectDir); err == nil {
+			return findLatestTranscript(projectDir)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	return "", fmt.Errorf("no Claude project directory found for %s", workDir)
+}
+
+// archiveTranscript copies the transcript at src into the bead's archive
+// directory and records it in the index. Re-archiving the same session
+// replaces the earlier copy, so repeated gt done runs keep the latest content.
+func archiveTranscript(townRoot, beadID, agent, src string) (string, error) {
+	dir := transcriptArchiveDir(townRoot, beadID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating archive dir: %w", err)
+	}
+
+	sessionID := strings.TrimSuffix(filepath.Base(src), ".jsonl")
+	file := sessionID + ".jsonl"
+	dst := filepath.Join(dir, file)
+	if err := copyTranscriptFile(src, dst); err != nil {
+		return "", err
+	}
+
+	idx, err := loadTranscriptIndex(dir, beadID)
+	if err != nil {
+		return "", err
+	}
+	entry := transcriptArchiveEntry{
+		File:       file,
+		SessionID:  sessionID,
+		Agent:      agent,
+		Source:     src,
+		ArchivedAt: time.Now().UTC(),
+	}
+	replaced := false
+	for i := range idx.Transcripts {
+		if idx.Transcripts[i].SessionID == sessionID {
+			idx.Transcripts[i] = entry
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		idx.Transcripts = append(idx.Transcripts, entry)
+	}
+	if err := saveTranscriptIndex(dir, idx); err != nil {
+		return "", fmt.Errorf("writing transcript index: %w", err)
+	}
+	return dst, nil
+}
+
+func copyTranscriptFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := dst + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		_ = os.Remove(tmp)
+		return fmt.Errorf("copying transcript: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
+
+// archivePolecatTranscript archives the current polecat's transcript for
+// issueID. Failures are non-fatal: a missing transcript must never block gt done.
+func archivePolecatTranscript(townRoot, workDir, issueID, agent string) {
+	if issueID == "" || workDir == "" {
+		return
+	}
+	src, err := findTranscriptForWorkDir(townRoot, workDir)
+	if err != nil {
+		style.PrintWarning("could not locate session transcript: %v", err)
+		return
+	}
+	dst, err := archiveTranscript(townRoot, issueID, agent, src)
+	if err != nil {
+		style.PrintWarning("could not archive session transcript: %v", err)
+		return
+	}
+	fmt.Printf("%s Transcript archived to %s\n", style.Bold.Render("✓"), dst)
+}
+
+func runBeadTranscript(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	dir := transcriptArchiveDir(townRoot, beadID)
+	idx, err := loadTranscriptIndex(dir, beadID)
+	if err != nil {
+		return err
+	}
+	if len(idx.Transcripts) == 0 {
+		return fmt.Errorf("no archived transcripts for %s", beadID)
+	}
+
+	entries := append([]transcriptArchiveEntry(nil), idx.Transcripts...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].ArchivedAt.Before(entries[j].ArchivedAt)
+	})
+
+	if beadTranscriptList {
+		for _, e := range entries {
+			agent := e.Agent
+			if agent == "" {
+				agent = "-"
+			}
+			fmt.Printf("%s  %s  %s\n", e.ArchivedAt.Local().Format("2006-01-02 15:04"),
+				agent, filepath.Join(dir, e.File))
+		}
+		return nil
+	}
+
+	latest := filepath.Join(dir, entries[len(entries)-1].File)
+	if beadTranscriptPath {
+		fmt.Println(latest)
+		return nil
+	}
+
+	data, err := os.ReadFile(latest)
+	if err != nil {
+		return fmt.Errorf("reading transcript: %w", err)
+	}
+	return ui.ToPager(string(data), ui.PagerOptions{})
+}
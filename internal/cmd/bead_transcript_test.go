@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArchiveTranscript(t *testing.T) {
+	townRoot := t.TempDir()
+	src := filepath.Join(t.TempDir(), "sess-1.jsonl")
+	if err := os.WriteFile(src, []byte(`{"type":"user"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dst, err := archiveTranscript(townRoot, "gt-abc", "gastown/polecats/Toast", src)
+	if err != nil {
+		t.Fatalf("archiveTranscript: %v", err)
+	}
+	if want := filepath.Join(townRoot, ".runtime", "archives", "gt-abc", "sess-1.jsonl"); dst != want {
+		t.Errorf("dst = %q, want %q", dst, want)
+	}
+
+	// Re-archiving the same session replaces the copy instead of adding an entry.
+	updated := `{"type":"user"}` + "\n" + `{"type":"assistant"}` + "\n"
+	if err := os.WriteFile(src, []byte(updated), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := archiveTranscript(townRoot, "gt-abc", "gastown/polecats/Toast", src); err != nil {
+		t.Fatalf("archiveTranscript (again): %v", err)
+	}
+
+	idx, err := loadTranscriptIndex(transcriptArchiveDir(townRoot, "gt-abc"), "gt-abc")
+	if err != nil {
+		t.Fatalf("loadTranscriptIndex: %v", err)
+	}
+	if len(idx.Transcripts) != 1 {
+		t.Fatalf("got %d index entries, want 1", len(idx.Transcripts))
+	}
+	e := idx.Transcripts[0]
+	if e.SessionID != "sess-1" || e.Agent != "gastown/polecats/Toast" || e.Source != src {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+	if data, _ := os.ReadFile(dst); string(data) != updated {
+		t.Errorf("archived copy not refreshed: %q", data)
+	}
+}
+
+func TestLoadTranscriptIndexMissing(t *testing.T) {
+	idx, err := loadTranscriptIndex(t.TempDir(), "gt-none")
+	if err != nil {
+		t.Fatalf("loadTranscriptIndex: %v", err)
+	}
+	if idx.BeadID != "gt-none" || len(idx.Transcripts) != 0 {
+		t.Errorf("expected empty index, got %+v", idx)
+	}
+}
+
+// TestFindTranscriptForWorkDir verifies the lookup walks up from a
+// subdirectory to the worktree where Claude was started.
+func TestFindTranscriptForWorkDir(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("CLAUDE_CONFIG_DIR", configDir)
+
+	townRoot := t.TempDir()
+	worktree := filepath.Join(townRoot, "gastown", "polecats", "toast")
+	subdir := filepath.Join(worktree, "internal", "cmd")
+
+	projectDir, err := getClaudeProjectDir(worktree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	want := filepath.Join(projectDir, "sess-9.jsonl")
+	if err := os.WriteFile(want, []byte("{}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := findTranscriptForWorkDir(townRoot, subdir)
+	if err != nil {
+		t.Fatalf("findTranscriptForWorkDir: %v", err)
+	}
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if _, err := findTranscriptForWorkDir(townRoot, filepath.Join(townRoot, "other")); err == nil {
+		t.Error("expected error for a directory with no transcripts")
+	}
+}
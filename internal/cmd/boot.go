@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/boot"
 	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -165,9 +166,9 @@ func runBootStatus(cmd *cobra.Command, args []string) error {
 	} else {
 		if !status.CompletedAt.IsZero() {
 			duration := status.CompletedAt.Sub(status.StartedAt)
-			fmt.Printf("  Completed: %s (%s ago)\n",
+			fmt.Printf("  Completed: %s (%s)\n",
 				status.CompletedAt.Format("15:04:05"),
-				formatDurationAgo(time.Since(status.CompletedAt)))
+				humanize.Ago(status.CompletedAt))
 			fmt.Printf("  Duration:  %s\n", duration.Round(time.Millisecond))
 		} else {
 			fmt.Printf("  Started: %s\n", status.StartedAt.Format("15:04:05"))
@@ -369,29 +370,3 @@ func executeWarrants(warrantDir string, tm *tmux.Tmux) {
 		}
 	}
 }
-
-// formatDurationAgo formats a duration for human display.
-func formatDurationAgo(d time.Duration) string {
-	switch {
-	case d < time.Minute:
-		return "just now"
-	case d < time.Hour:
-		mins := int(d.Minutes())
-		if mins == 1 {
-			return "1 min"
-		}
-		return fmt.Sprintf("%d min", mins)
-	case d < 24*time.Hour:
-		hours := int(d.Hours())
-		if hours == 1 {
-			return "1 hour"
-		}
-		return fmt.Sprintf("%d hours", hours)
-	default:
-		days := int(d.Hours() / 24)
-		if days == 1 {
-			return "1 day"
-		}
-		return fmt.Sprintf("%d days", days)
-	}
-}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var capacityJSON bool
+
+var capacityCmd = &cobra.Command{
+	Use:     "capacity",
+	GroupID: GroupWork,
+	Short:   "Show whether the town has room for more work",
+	Long: `Show the town's dispatch capacity: active and paused polecats against
+scheduler.max_polecats, slots already claimed by the scheduled queue, and any
+limit holding dispatch back (scheduler pause, limits snooze, rate-limited
+accounts).
+
+External systems (CI, chatops) can poll 'gt capacity --json' and push work
+only while "accepting" is true. "available" is the number of free slots left
+once the queued beads have been dispatched; "reasons" explains a false
+"accepting".
+
+The exit code is 0 either way; read "accepting" rather than the status.
+
+Examples:
+  gt capacity
+  gt capacity --json | jq '.accepting'`,
+	Args: cobra.NoArgs,
+	RunE: runCapacity,
+}
+
+func init() {
+	capacityCmd.Flags().BoolVar(&capacityJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(capacityCmd)
+}
+
+func runCapacity(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	usage, err := gatherCapacityUsage(townRoot, time.Now())
+	if err != nil {
+		return err
+	}
+	snap := capacity.Calculate(usage, time.Now())
+
+	if capacityJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(snap)
+	}
+
+	fmt.Printf("%s\n\n", style.Bold.Render("Capacity"))
+	if snap.Mode == "deferred" {
+		fmt.Printf("  Polecats:  %d/%d active", snap.Active, snap.MaxPolecats)
+	} else {
+		fmt.Printf("  Polecats:  %d active %s", snap.Active, style.Dim.Render("(direct dispatch, no cap)"))
+	}
+	if snap.Paused > 0 {
+		fmt.Printf(", %d paused (%d holding a slot)", snap.Paused, snap.PausedHolding)
+	}
+	if snap.NeedsInput > 0 {
+		fmt.Printf(", %d waiting for input", snap.NeedsInput)
+	}
+	fmt.Println()
+	if snap.LimitCap != nil {
+		fmt.Printf("  Limit cap: %d polecats %s\n", snap.LimitCap.Cap, style.Dim.Render("— "+snap.LimitCap.Reason))
+	}
+	if snap.Mode == "deferred" {
+		fmt.Printf("  Queued:    %d ready (%d of %d free slot(s) reserved)\n", snap.Queued, snap.Reserved, snap.Free)
+		fmt.Printf("  Available: %d\n", snap.Available)
+	}
+	if len(snap.HeldRigs) > 0 {
+		fmt.Printf("  Held:      %s\n", style.Warning.Render(strings.Join(snap.HeldRigs, ", ")))
+	}
+	if snap.Accounts > 0 {
+		fmt.Printf("  Accounts:  %d/%d rate-limited\n", snap.LimitedAccounts, snap.Accounts)
+	}
+	if len(snap.LimitedProviders) > 0 {
+		fmt.Printf("  Limited:   %s %s\n", style.Warning.Render(strings.Join(snap.LimitedProviders, ", ")),
+			style.Dim.Render("(their beads wait; other providers dispatch)"))
+	}
+	fmt.Println()
+
+	if snap.Accepting {
+		fmt.Printf("%s Accepting work\n", style.Bold.Render("✓"))
+		return nil
+	}
+	fmt.Printf("%s Not accepting work\n", style.Warning.Render("⚠"))
+	for _, r := range snap.Reasons {
+		fmt.Printf("  - %s\n", r)
+	}
+	return nil
+}
+
+// gatherCapacityUsage collects the inputs to capacity.Calculate. Polecat and
+// queue counts are best-effort, matching what the dispatcher itself sees.
+func gatherCapacityUsage(townRoot string, now time.Time) (capacity.Usage, error) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return capacity.Usage{}, fmt.Errorf("loading town settings: %w", err)
+	}
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		return capacity.Usage{}, fmt.Errorf("loading scheduler state: %w", err)
+	}
+
+	slots := countPolecatSlots(townRoot)
+	u := capacity.Usage{
+		MaxPolecats:     settings.Scheduler.GetMaxPolecats(),
+		Active:          slots.Working,
+		Paused:          slots.Paused,
+		PausedHolding:   slots.PausedHolding,
+		NeedsInput:      slots.NeedsInput,
+		SchedulerPaused: state.Paused,
+		PausedBy:        state.PausedBy,
+		HeldRigs:        state.HeldRigNames(),
+	}
+
+	if u.MaxPolecats > 0 {
+		if c := schedulerLimitCap(townRoot, settings.Scheduler, now); c != nil && c.Cap < u.MaxPolecats {
+			u.MaxPolecats, u.LimitCap = c.Cap, c
+		}
+		for _, b := range listScheduledBeads(townRoot) {
+			if !b.Blocked && !state.IsRigHeld(b.TargetRig) {
+				u.Queued++
+			}
+		}
+	}
+
+	if qs, err := quota.NewManager(townRoot).Load(); err == nil {
+		if until, ok := quota.SnoozedUntil(qs, now); ok {
+			u.SnoozedUntil = &until
+		}
+		for _, acct := range qs.Accounts {
+			u.Accounts++
+			if acct.Status == config.QuotaStatusLimited || acct.Status == config.QuotaStatusCooldown {
+				u.LimitedAccounts++
+			}
+		}
+		acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+		if err != nil {
+			acctCfg = nil
+		}
+		u.LimitedProviders = quota.LimitedProviders(qs, acctCfg, now)
+	}
+	return u, nil
+}
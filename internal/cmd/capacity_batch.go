@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// dispatchBatch claims the members of a batch, then dispatches the leader
+// with the batch formula listing only the claimed beads. The polecat works
+// them sequentially on one branch and the refinery closes them with the
+// leader when its MR merges.
+//
+// Returns the sling result and the batch members that were claimed. Members
+// that could not be claimed are left alone and dispatched normally on a
+// later cycle; if none could be, the leader is dispatched on its own.
+func dispatchBatch(b capacity.PendingBead, townRoot, actor string) (*SlingResult, []capacity.PendingBead, error) {
+	if b.Context == nil {
+		return nil, nil, fmt.Errorf("missing sling context for %s", b.ID)
+	}
+
+	// Claim members for the dispatcher first, so the batch the polecat is
+	// given holds only beads nobody else can take in the meantime.
+	var claimed []capacity.PendingBead
+	for _, m := range b.Batch {
+		if err := claimBatchMember(townRoot, m, b.WorkBeadID, actor); err != nil {
+			fmt.Printf("  %s Could not batch %s with %s: %v (will dispatch on its own)\n",
+				style.Warning.Render("⚠"), m.WorkBeadID, b.WorkBeadID, err)
+			continue
+		}
+		claimed = append(claimed, m)
+	}
+	if len(claimed) == 0 {
+		result, err := dispatchSingleBead(b, townRoot, actor)
+		return result, nil, err
+	}
+
+	b.Batch = claimed
+	ids := capacity.BatchWorkBeadIDs(b)
+	params := dispatchSlingParams(b.Context, townRoot)
+	params.FormulaName = capacity.BatchFormula
+	params.Vars = append(params.Vars, "batch="+strings.Join(ids, " "))
+	fmt.Printf("  Dispatching batch %s → %s...\n", strings.Join(ids, ", "), b.TargetRig)
+	result, err := executeSling(params)
+	if err != nil {
+		releaseBatchMembers(townRoot, claimed)
+		return nil, nil, fmt.Errorf("sling failed: %w", err)
+	}
+	if result == nil || result.SpawnInfo == nil {
+		releaseBatchMembers(townRoot, claimed)
+		return result, nil, nil
+	}
+
+	agent := result.SpawnInfo.AgentID()
+	var batched []capacity.PendingBead
+	var batchedIDs []string
+	for _, m := range claimed {
+		if err := assignBatchMember(townRoot, m.WorkBeadID, agent, result.SpawnInfo.ClonePath); err != nil {
+			fmt.Printf("  %s Could not hand %s to %s: %v (will dispatch on its own)\n",
+				style.Warning.Render("⚠"), m.WorkBeadID, result.PolecatName, err)
+			releaseBatchMembers(townRoot, []capacity.PendingBead{m})
+			continue
+		}
+		batched = append(batched, m)
+		batchedIDs = append(batchedIDs, m.WorkBeadID)
+	}
+
+	if len(batchedIDs) > 0 {
+		if err := storeFieldsInBead(b.WorkBeadID, beadFieldUpdates{BatchBeads: batchedIDs}); err != nil {
+			fmt.Printf("  %s Could not record batch on %s: %v\n", style.Dim.Render("Warning:"), b.WorkBeadID, err)
+		}
+		fmt.Printf("  %s Batched %s onto %s\n", style.Bold.Render("✓"), strings.Join(batchedIDs, ", "), result.PolecatName)
+	}
+	return result, batched, nil
+}
+
+// claimBatchMember claims an open, unassigned batch member for the
+// dispatcher and marks its sling context batched_with the leader. The
+// context stays open: the member is skipped while it is assigned and
+// dispatches normally once released.
+func claimBatchMember(townRoot string, m capacity.PendingBead, leader, actor string) error {
+	info, err := getBeadInfo(m.WorkBeadID)
+	if err != nil {
+		return err
+	}
+	if info.Status != "open" || info.Assignee != "" {
+		return fmt.Errorf("bead is %s (assignee %q)", info.Status, info.Assignee)
+	}
+	if _, err := beadsForContext(townRoot, m.Context).MutateSlingContextFields(m.ID, func(f *capacity.SlingContextFields) {
+		f.BatchedWith = leader
+	}); err != nil {
+		return fmt.Errorf("marking context %s: %w", m.ID, err)
+	}
+	return assignBatchMember(townRoot, m.WorkBeadID, actor, "")
+}
+
+// assignBatchMember assigns a batch member to agent. The bead stays open
+// (only the leader is hooked, so gt prime finds the right work); the
+// polecat marks each member in_progress as it starts it.
+func assignBatchMember(townRoot, beadID, agent, workDir string) error {
+	return BdCmd("update", beadID, "--assignee="+agent).
+		Dir(beads.ResolveHookDir(townRoot, beadID, workDir)).
+		Run()
+}
+
+// releaseBatchMembers unassigns claimed members that did not make it into a
+// dispatched batch, so their contexts dispatch them on their own.
+func releaseBatchMembers(townRoot string, members []capacity.PendingBead) {
+	for _, m := range members {
+		if err := assignBatchMember(townRoot, m.WorkBeadID, "", ""); err != nil {
+			fmt.Printf("  %s Could not release %s: %v\n", style.Warning.Render("⚠"), m.WorkBeadID, err)
+		}
+	}
+}
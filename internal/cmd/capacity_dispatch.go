@@ -5,25 +5,60 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/gofrs/flock"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/quota"
 	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/util"
 )
 
 // maxDispatchFailures is the maximum number of consecutive dispatch failures
-// before a sling context is closed as circuit-broken.
+// before a sling context is closed as circuit-broken. A bead scheduled with
+// --max-retries carries its own limit.
 const maxDispatchFailures = 3
 
+// triggerScheduledDispatch starts 'gt scheduler run' in the background when
+// deferred dispatch has ready work, so a slot released by gt done is filled
+// now instead of on the daemon's next heartbeat. Best-effort: the heartbeat
+// dispatches anyway.
+func triggerScheduledDispatch(townRoot string) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil || settings.Scheduler.GetMaxPolecats() <= 0 {
+		return
+	}
+	if ready, err := getReadySlingContexts(townRoot); err != nil || len(ready) == 0 {
+		return
+	}
+	gtPath, err := os.Executable()
+	if err != nil {
+		return
+	}
+	cmd := exec.Command(gtPath, "scheduler", "run")
+	cmd.Dir = townRoot
+	util.SetDetachedProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return
+	}
+	_ = cmd.Process.Release()
+	fmt.Printf("%s Triggered scheduler dispatch\n", style.Bold.Render("✓"))
+}
+
 // dispatchScheduledWork is the main dispatch loop for the capacity scheduler.
-// Called by both `gt scheduler run` and the daemon heartbeat.
-func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun bool) (int, error) {
+// Called by both `gt scheduler run` and the daemon heartbeat. sel narrows the
+// cycle to matching beads; the zero Selector dispatches from the whole queue.
+func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun bool, sel capacity.Selector) (int, error) {
 	// Acquire exclusive lock to prevent concurrent dispatch
 	runtimeDir := filepath.Join(townRoot, ".runtime")
 	_ = os.MkdirAll(runtimeDir, 0755)
@@ -84,6 +119,12 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 		batchSize = batchOverride
 	}
 	spawnDelay := schedulerCfg.GetSpawnDelay()
+	if schedulerCfg.Adaptive && batchOverride <= 0 {
+		batchSize, spawnDelay = state.Throttle.Effective(batchSize, spawnDelay)
+	}
+	// Stay below the concurrency at which usage limits have been hitting.
+	limitCap := schedulerLimitCap(townRoot, schedulerCfg, time.Now())
+	maxPolecats, batchSize = limitCap.Apply(maxPolecats, batchSize)
 
 	// Clean up invalid/stale contexts before querying for ready beads.
 	// Skip during dry-run to avoid mutating state.
@@ -95,6 +136,20 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 	successfulRigs := make(map[string]bool)
 	// Track polecat names from dispatch results, keyed by context bead ID.
 	polecatNames := make(map[string]string)
+	// Dispatch start times, for the duration of failed attempts.
+	dispatchStarted := make(map[string]time.Time)
+	// Sling latency and system failures this cycle, for the adaptive throttle.
+	var throttle throttleStats
+	// Providers whose beads wait for a rate limit to reset.
+	var limitHolds []limitHold
+	// Beads in the same conflict group dispatch one at a time; holds record
+	// the dispatched bead each group waits on, and are saved after the cycle.
+	conflictGroups := schedulerCfg.ConflictGroups
+	conflictsReleased := false
+	// Beads left queued this cycle and why, and the beads offered to the
+	// planner (the ones it doesn't take wait for capacity).
+	var skipped []capacity.SkippedBead
+	var queued []capacity.PendingBead
 	cycle := &capacity.DispatchCycle{
 		AvailableCapacity: func() (int, error) {
 			active := countWorkingPolecats()
@@ -105,13 +160,38 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 			return cap, nil
 		},
 		QueryPending: func() ([]capacity.PendingBead, error) {
-			return getReadySlingContexts(townRoot)
+			pending, notReady, err := getReadySlingContextsWithSkips(townRoot)
+			if err != nil {
+				return nil, err
+			}
+			if sel.Empty() {
+				skipped = notReady // A selection's skips would mostly be beads it excluded
+			}
+			pending = sel.Apply(pending)
+			var limited []capacity.SkippedBead
+			pending, limitHolds, limited = holdLimitedProviders(townRoot, pending)
+			skipped = append(skipped, limited...)
+			var conflicts []capacity.SkippedBead
+			pending, conflicts, conflictsReleased = serializeConflictGroups(townRoot, state, pending, conflictGroups)
+			skipped = append(skipped, conflicts...)
+			// Small gt:batchable beads share a polecat (and a capacity slot).
+			queued = capacity.GroupBatches(pending, schedulerCfg.GetMaxBatchedBeads())
+			return queued, nil
 		},
 		Execute: func(b capacity.PendingBead) error {
-			result, err := dispatchSingleBead(b, townRoot, actor)
+			dispatchStarted[b.ID] = time.Now()
+			var result *SlingResult
+			var batched []capacity.PendingBead
+			var err error
+			if len(b.Batch) > 0 {
+				result, batched, err = dispatchBatch(b, townRoot, actor)
+			} else {
+				result, err = dispatchSingleBead(b, townRoot, actor)
+			}
 			if err != nil {
 				return err
 			}
+			throttle.recordSuccess(time.Since(dispatchStarted[b.ID]))
 			// Track side effects here (Execute runs exactly once, never retried).
 			if result != nil && result.PolecatName != "" {
 				polecatNames[b.ID] = result.PolecatName
@@ -119,8 +199,15 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 			if b.TargetRig != "" {
 				successfulRigs[b.TargetRig] = true
 			}
-			_ = events.LogFeed(events.TypeSchedulerDispatch, actor,
-				events.SchedulerDispatchPayload(b.WorkBeadID, b.TargetRig, polecatNames[b.ID]))
+			state.HoldConflicts(b, conflictGroups, time.Now())
+			_ = events.LogFeed(events.TypeSchedulerDispatch, actor, schedulerDispatchPayload(b, polecatNames[b.ID]))
+			// Batch members rode along with the leader. Their contexts stay
+			// open (batched_with the leader) until the member closes, so one
+			// the polecat releases or never reaches is dispatched again.
+			for _, m := range batched {
+				_ = events.LogFeed(events.TypeSchedulerDispatch, actor, schedulerDispatchPayload(m, polecatNames[b.ID]))
+				state.HoldConflicts(m, conflictGroups, time.Now())
+			}
 			return nil
 		},
 		OnSuccess: func(b capacity.PendingBead) error {
@@ -150,10 +237,11 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 					return
 				}
 			} else {
+				throttle.recordFailure(err)
 				_ = events.LogFeed(events.TypeSchedulerDispatchFailed, actor,
-					events.SchedulerDispatchFailedPayload(b.WorkBeadID, b.TargetRig, err.Error()))
+					events.SchedulerDispatchFailedPayload(newDispatchFailure(b, err, dispatchStarted[b.ID])))
 			}
-			recordDispatchFailure(beadsForContext(townRoot, b.Context), b, err)
+			recordDispatchFailure(townRoot, beadsForContext(townRoot, b.Context), b, err)
 		},
 		BatchSize:  batchSize,
 		SpawnDelay: spawnDelay,
@@ -165,6 +253,20 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 			return 0, fmt.Errorf("planning dispatch: %w", planErr)
 		}
 		printDryRunPlan(plan, maxPolecats, batchSize)
+		if limitCap != nil && limitCap.Cap < schedulerCfg.GetMaxPolecats() {
+			fmt.Printf("  Limit cap: %d polecats %s\n", limitCap.Cap, style.Dim.Render("— "+limitCap.Reason))
+		}
+		if held := state.HeldRigNames(); len(held) > 0 {
+			fmt.Printf("  Held rigs (not dispatched): %s\n", strings.Join(held, ", "))
+		}
+		for _, h := range limitHolds {
+			fmt.Printf("  Usage limit (not dispatched): %s\n", h)
+		}
+		if held := conflictHoldLabels(state); len(held) > 0 {
+			fmt.Printf("  Conflict groups held: %s\n", strings.Join(held, ", "))
+		}
+		skipped = append(skipped, capacitySkips(queued, len(queued)-len(plan.ToDispatch))...)
+		printSkippedBeads(skipped)
 		return 0, nil
 	}
 
@@ -172,22 +274,37 @@ func dispatchScheduledWork(townRoot, actor string, batchOverride int, dryRun boo
 	if err != nil {
 		return 0, fmt.Errorf("dispatch cycle failed: %w", err)
 	}
+	for _, h := range limitHolds {
+		fmt.Printf("%s %s\n", style.Dim.Render("⏸"), h)
+	}
+	skipped = append(skipped, capacitySkips(queued, report.Skipped)...)
+	if sel.Empty() {
+		recordSkippedBeads(townRoot, actor, skipped)
+		recordPassedOver(townRoot, actor, queued, report, schedulerCfg.GetStarvationCycles())
+	}
 
 	// Wake rig agents for each unique rig that had successful dispatches.
 	for rig := range successfulRigs {
 		wakeRigAgents(rig)
 	}
 
-	// Update runtime state with fresh read to avoid clobbering concurrent pause.
-	if report.Dispatched > 0 {
-		freshState, err := capacity.LoadState(townRoot)
-		if err != nil {
-			fmt.Printf("%s Could not reload scheduler state: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
-			freshState.RecordDispatch(report.Dispatched)
-			if err := capacity.SaveState(townRoot, freshState); err != nil {
-				fmt.Printf("%s Could not save scheduler state: %v\n", style.Dim.Render("Warning:"), err)
+	// Update runtime state under the state lock to avoid clobbering a concurrent pause.
+	adapt := schedulerCfg.Adaptive && batchOverride <= 0
+	if report.Dispatched > 0 || (adapt && report.Failed > 0) || conflictsReleased {
+		if _, err := capacity.UpdateState(townRoot, func(freshState *capacity.SchedulerState) (bool, error) {
+			if report.Dispatched > 0 {
+				freshState.RecordDispatch(report.Dispatched)
+			}
+			if adapt {
+				freshState.Throttle = capacity.AdjustThrottle(freshState.Throttle, throttle.stats(),
+					schedulerCfg.GetBatchSize(), schedulerCfg.GetSpawnDelay(), time.Now())
 			}
+			if len(conflictGroups) > 0 || conflictsReleased {
+				freshState.ConflictHolds = state.ConflictHolds
+			}
+			return true, nil
+		}); err != nil {
+			fmt.Printf("%s Could not save scheduler state: %v\n", style.Dim.Render("Warning:"), err)
 		}
 	}
 
@@ -228,6 +345,10 @@ func printDryRunPlan(plan capacity.DispatchPlan, maxPolecats, batchSize int) {
 	fmt.Printf("%s Would dispatch %d bead(s) (capacity: %s, batch: %d, ready: %d, reason: %s)\n",
 		style.Bold.Render("📋"), len(plan.ToDispatch), capStr, batchSize, totalReady, plan.Reason)
 	for _, b := range plan.ToDispatch {
+		if len(b.Batch) > 0 {
+			fmt.Printf("  Would dispatch batch: %s → %s\n", strings.Join(capacity.BatchWorkBeadIDs(b), ", "), b.TargetRig)
+			continue
+		}
 		fmt.Printf("  Would dispatch: %s → %s\n", b.WorkBeadID, b.TargetRig)
 	}
 }
@@ -263,9 +384,9 @@ func cleanupStaleContexts(townRoot string) {
 			_ = b.CloseSlingContext(ctx.ID, "invalid-context")
 			continue
 		}
-		if fields.DispatchFailures >= maxDispatchFailures {
+		if fields.CircuitBroken(maxDispatchFailures) {
 			b := beadsForContext(townRoot, fields)
-			_ = b.CloseSlingContext(ctx.ID, "circuit-broken")
+			_ = closeSlingContextForGood(townRoot, b, ctx.ID, fields, "circuit-broken")
 			continue
 		}
 		staleCheckContexts = append(staleCheckContexts, ctx)
@@ -302,11 +423,12 @@ func cleanupStaleContexts(townRoot string) {
 
 // beadStatusInfo holds batch-fetched bead status and title.
 type beadStatusInfo struct {
-	Status string
-	Title  string
+	Status   string
+	Title    string
+	Assignee string
 }
 
-// batchFetchBeadInfoByIDs returns a map of bead ID → status+title for specific beads.
+// batchFetchBeadInfoByIDs returns a map of bead ID → status, title and assignee for specific beads.
 // Uses `bd show` with multiple IDs per rig directory instead of fetching all beads.
 // This avoids the O(minutes) latency of `bd list --all --json --limit=0` on large repos.
 func batchFetchBeadInfoByIDs(townRoot string, ids []string) map[string]beadStatusInfo {
@@ -330,13 +452,14 @@ func batchFetchBeadInfoByIDs(townRoot string, ids []string) map[string]beadStatu
 			continue
 		}
 		var items []struct {
-			ID     string `json:"id"`
-			Status string `json:"status"`
-			Title  string `json:"title"`
+			ID       string `json:"id"`
+			Status   string `json:"status"`
+			Title    string `json:"title"`
+			Assignee string `json:"assignee"`
 		}
 		if err := json.Unmarshal(out, &items); err == nil {
 			for _, item := range items {
-				result[item.ID] = beadStatusInfo{Status: item.Status, Title: item.Title}
+				result[item.ID] = beadStatusInfo{Status: item.Status, Title: item.Title, Assignee: item.Assignee}
 			}
 		}
 	}
@@ -349,19 +472,28 @@ func batchFetchBeadInfoByIDs(townRoot string, ids []string) map[string]beadStatu
 //
 // Sling contexts are queried from HQ only (authoritative). Work bead readiness
 // is checked across all rig dirs since work beads live in rig-local DBs.
+// Beads targeting a held rig are excluded so other rigs keep dispatching, as
+// are beads tracked by a convoy still waiting on another convoy.
 func getReadySlingContexts(townRoot string) ([]capacity.PendingBead, error) {
+	ready, _, err := getReadySlingContextsWithSkips(townRoot)
+	return ready, err
+}
+
+// getReadySlingContextsWithSkips is getReadySlingContexts, also returning
+// the scheduled beads it left out and why.
+func getReadySlingContextsWithSkips(townRoot string) ([]capacity.PendingBead, []capacity.SkippedBead, error) {
 	// 1. List all open sling context beads from HQ (authoritative)
 	allContexts := listAllSlingContexts(townRoot)
 
 	if len(allContexts) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
 	// 2. Build readyWorkIDs set from bd ready across all dirs
 	// (work beads live in rig-local DBs, so we need to check all dirs)
 	readyWorkIDs, readyErr := listReadyWorkBeadIDsWithError(townRoot)
 	if readyErr != nil {
-		return nil, readyErr
+		return nil, nil, readyErr
 	}
 
 	// 3. Build PendingBead list — pure filtering, no mutations.
@@ -380,42 +512,172 @@ func getReadySlingContexts(townRoot string) ([]capacity.PendingBead, error) {
 		return allContexts[i].ID < allContexts[j].ID // deterministic tiebreaker
 	})
 
-	seenWork := make(map[string]bool)
+	batchInfo := fetchBatchMemberInfo(townRoot, allContexts)
+
+	now := time.Now()
+	seenWork := make(map[string]string) // work bead ID → context that schedules it
 	var result []capacity.PendingBead
+	var skipped []capacity.SkippedBead
 	for _, ctx := range allContexts {
 		fields := beads.ParseSlingContextFields(ctx.Description)
 		if fields == nil {
-			continue // Skip invalid — cleanupStaleContexts handles these
+			// cleanupStaleContexts closes these
+			skipped = append(skipped, capacity.SkippedBead{ID: ctx.ID, Reason: capacity.SkipInvalidContext})
+			continue
+		}
+		b := capacity.PendingBead{
+			ID:          ctx.ID,
+			WorkBeadID:  fields.WorkBeadID,
+			Title:       ctx.Title,
+			TargetRig:   fields.TargetRig,
+			Description: ctx.Description,
+			Labels:      ctx.Labels,
+			Context:     fields,
 		}
 
 		// Circuit breaker filter
-		if fields.DispatchFailures >= maxDispatchFailures {
+		if fields.CircuitBroken(maxDispatchFailures) {
+			skipped = append(skipped, capacity.SkipBead(b, capacity.SkipCircuitBroken,
+				fmt.Sprintf("%d failed dispatches", fields.DispatchFailures)))
+			continue
+		}
+
+		// Still backing off after a dispatch failure (--retry-backoff)
+		if retryAt := fields.RetryAt(); now.Before(retryAt) {
+			skipped = append(skipped, capacity.SkipBead(b, capacity.SkipBackoff,
+				"retry "+humanize.Relative(retryAt, now)))
+			continue
+		}
+
+		// Claimed by a batch polecat: dispatched with its leader
+		if fields.BatchedWith != "" && batchClaimed(batchInfo, fields.WorkBeadID) {
+			skipped = append(skipped, capacity.SkipBead(b, capacity.SkipBatched, "with "+fields.BatchedWith))
 			continue
 		}
 
 		// Only include if work bead is ready (unblocked)
 		if !readyWorkIDs[fields.WorkBeadID] {
+			skipped = append(skipped, capacity.SkipBead(b, capacity.SkipBlocked, ""))
 			continue
 		}
 
 		// Deduplicate: one dispatch per work bead (oldest context wins)
-		if seenWork[fields.WorkBeadID] {
+		if first, ok := seenWork[fields.WorkBeadID]; ok {
+			skipped = append(skipped, capacity.SkipBead(b, capacity.SkipDuplicate, "also scheduled by "+first))
 			continue
 		}
-		seenWork[fields.WorkBeadID] = true
+		seenWork[fields.WorkBeadID] = ctx.ID
 
-		result = append(result, capacity.PendingBead{
-			ID:          ctx.ID,
-			WorkBeadID:  fields.WorkBeadID,
-			Title:       ctx.Title,
-			TargetRig:   fields.TargetRig,
-			Description: ctx.Description,
-			Labels:      ctx.Labels,
-			Context:     fields,
-		})
+		result = append(result, b)
 	}
 
-	return result, nil
+	// 4. Higher-priority beads dispatch first; equal priority keeps enqueue
+	// order. Beads starved by higher-priority work go ahead of everything.
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading scheduler state: %w", err)
+	}
+	capacity.SortByPriority(result)
+	capacity.BoostStarved(result, state.Starved)
+
+	// 5. Drop beads targeting held rigs (gt scheduler hold <rig>).
+	kept, _ := capacity.FilterHeldRigs(result, state.HeldRigs)
+	skipped = append(skipped, capacity.SkippedBy(result, kept, capacity.SkipHeldRig, func(b capacity.PendingBead) string {
+		return "held by " + state.HeldRigs[b.TargetRig]
+	})...)
+	result = kept
+
+	// 6. Drop beads whose convoy waits on an unfinished convoy (gt convoy depend).
+	// Like a failed bd ready, a failed lookup shouldn't stall every rig's
+	// dispatch: warn and dispatch ungated this cycle.
+	if len(result) > 0 {
+		gated, err := gatedConvoyWork(townRoot)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s Warning: convoy dependency check failed, dispatching without it: %v\n",
+				style.Dim.Render("⚠"), err)
+		}
+		kept, _ := capacity.FilterGatedWork(result, gated)
+		skipped = append(skipped, capacity.SkippedBy(result, kept, capacity.SkipConvoyGate, func(b capacity.PendingBead) string {
+			return "waits on convoy " + gated[b.WorkBeadID]
+		})...)
+		result = kept
+	}
+
+	return result, skipped, nil
+}
+
+// limitHold is a provider whose scheduled beads wait for a rate limit to
+// reset instead of spawning polecats that would stall immediately.
+type limitHold struct {
+	Provider string
+	Beads    int
+	ResetAt  time.Time // zero when the reset time is unknown
+}
+
+// String describes the hold for dispatch output, e.g.
+// "3 bead(s) held: claude limited, resets in 2h".
+func (h limitHold) String() string {
+	reason := h.Provider + " limited"
+	if !h.ResetAt.IsZero() {
+		reason += ", resets " + humanize.Relative(h.ResetAt, time.Now())
+	}
+	return fmt.Sprintf("%d bead(s) held: %s", h.Beads, reason)
+}
+
+// holdLimitedProviders leaves beads queued while the provider they would run
+// on is rate-limited, returning the beads to dispatch, one hold per limited
+// provider and the held beads. Only the bead's own provider counts: a Gemini
+// limit doesn't hold Claude work, or the other way round.
+func holdLimitedProviders(townRoot string, pending []capacity.PendingBead) ([]capacity.PendingBead, []limitHold, []capacity.SkippedBead) {
+	state, err := quota.NewManager(townRoot).Load()
+	if err != nil {
+		return pending, nil, nil // No readable quota state: nothing known to be limited
+	}
+	acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil {
+		acctCfg = nil
+	}
+	now := time.Now()
+	limited := make(map[string]bool)
+	for _, p := range quota.LimitedProviders(state, acctCfg, now) {
+		limited[p] = true
+	}
+	providers := make(map[string]string) // context bead ID → provider
+	kept, held := capacity.FilterLimitedProviders(pending, func(b capacity.PendingBead) string {
+		providers[b.ID] = pendingBeadProvider(townRoot, b, acctCfg)
+		return providers[b.ID]
+	}, limited)
+	holds := make([]limitHold, 0, len(held))
+	for p, n := range held {
+		h := limitHold{Provider: p, Beads: n}
+		if reset, ok := quota.ProviderResetAt(state, acctCfg, p, now); ok {
+			h.ResetAt = reset
+		}
+		holds = append(holds, h)
+	}
+	sort.Slice(holds, func(i, j int) bool { return holds[i].Provider < holds[j].Provider })
+	skipped := capacity.SkippedBy(pending, kept, capacity.SkipUsageLimit, func(b capacity.PendingBead) string {
+		return providers[b.ID] + " limited"
+	})
+	return kept, holds, skipped
+}
+
+// pendingBeadProvider returns the provider a scheduled bead will run on: its
+// account's when slung with --account, otherwise its agent's (the rig's
+// polecat agent by default).
+func pendingBeadProvider(townRoot string, b capacity.PendingBead, acctCfg *config.AccountsConfig) string {
+	var account, agent string
+	if b.Context != nil {
+		account, agent = b.Context.Account, b.Context.Agent
+	}
+	if account != "" {
+		return quota.ProviderOf(acctCfg, account)
+	}
+	var rigPath string
+	if b.TargetRig != "" {
+		rigPath = filepath.Join(townRoot, b.TargetRig)
+	}
+	return config.ResolveAgentProvider(agent, townRoot, rigPath)
 }
 
 // dispatchSingleBead dispatches one scheduled bead via executeSling.
@@ -426,8 +688,21 @@ func dispatchSingleBead(b capacity.PendingBead, townRoot, _ string) (*SlingResul
 		return nil, fmt.Errorf("missing sling context for %s", b.ID)
 	}
 
-	dp := capacity.ReconstructFromContext(b.Context)
-	params := SlingParams{
+	params := dispatchSlingParams(b.Context, townRoot)
+	fmt.Printf("  Dispatching %s → %s...\n", b.WorkBeadID, b.TargetRig)
+	result, err := executeSling(params)
+	if err != nil {
+		return nil, fmt.Errorf("sling failed: %w", err)
+	}
+
+	return result, nil
+}
+
+// dispatchSlingParams reconstructs the SlingParams scheduler dispatch passes
+// to executeSling for a sling context.
+func dispatchSlingParams(fields *capacity.SlingContextFields, townRoot string) SlingParams {
+	dp := capacity.ReconstructFromContext(fields)
+	return SlingParams{
 		BeadID:           dp.BeadID,
 		RigName:          dp.RigName,
 		FormulaName:      dp.FormulaName,
@@ -441,6 +716,7 @@ func dispatchSingleBead(b capacity.PendingBead, townRoot, _ string) (*SlingResul
 		Agent:            dp.Agent,
 		HookRawBead:      dp.HookRawBead,
 		Mode:             dp.Mode,
+		CorrelationID:    fields.CorrelationID,
 		FormulaFailFatal: true,
 		CallerContext:    "scheduler-dispatch",
 		NoConvoy:         true,
@@ -448,14 +724,6 @@ func dispatchSingleBead(b capacity.PendingBead, townRoot, _ string) (*SlingResul
 		TownRoot:         townRoot,
 		BeadsDir:         filepath.Join(townRoot, ".beads"),
 	}
-
-	fmt.Printf("  Dispatching %s → %s...\n", b.WorkBeadID, b.TargetRig)
-	result, err := executeSling(params)
-	if err != nil {
-		return nil, fmt.Errorf("sling failed: %w", err)
-	}
-
-	return result, nil
 }
 
 // isDaemonDispatch returns true when dispatch is triggered by the daemon heartbeat.
@@ -464,27 +732,164 @@ func isDaemonDispatch() bool {
 }
 
 // recordDispatchFailure increments the dispatch failure counter on the sling context bead.
-func recordDispatchFailure(townBeads *beads.Beads, b capacity.PendingBead, dispatchErr error) {
+func recordDispatchFailure(townRoot string, townBeads *beads.Beads, b capacity.PendingBead, dispatchErr error) {
 	if b.Context == nil {
 		return
 	}
 
-	b.Context.DispatchFailures++
-	b.Context.LastFailure = dispatchErr.Error()
-
-	if err := townBeads.UpdateSlingContextFields(b.ID, b.Context); err != nil {
+	failure, failedAt := dispatchErr.Error(), time.Now().UTC().Format(time.RFC3339)
+	record := func(f *capacity.SlingContextFields) {
+		f.DispatchFailures++
+		f.LastFailure = failure
+		f.LastFailureAt = failedAt
+	}
+	// Count the failure on the bead's current fields, not the copy read at
+	// the start of the cycle, so concurrent edits to the context survive.
+	if updated, err := townBeads.MutateSlingContextFields(b.ID, record); err != nil {
 		fmt.Printf("  %s Failed to record dispatch failure for %s: %v\n",
 			style.Warning.Render("⚠"), b.ID, err)
+		record(b.Context)
+	} else {
+		b.Context = updated
 	}
 
-	if b.Context.DispatchFailures >= maxDispatchFailures {
-		if err := townBeads.CloseSlingContext(b.ID, "circuit-broken"); err != nil {
+	if b.Context.CircuitBroken(maxDispatchFailures) {
+		if err := closeSlingContextForGood(townRoot, townBeads, b.ID, b.Context, "circuit-broken"); err != nil {
 			fmt.Printf("  %s Failed to close circuit-broken context %s: %v\n",
 				style.Warning.Render("⚠"), b.ID, err)
 		}
 		fmt.Printf("  %s Context %s (work: %s) failed %d times, circuit-broken\n",
 			style.Warning.Render("⚠"), b.ID, b.WorkBeadID, b.Context.DispatchFailures)
+	} else if retryAt := b.Context.RetryAt(); !retryAt.IsZero() {
+		fmt.Printf("  %s Retrying %s %s (attempt %d of %d)\n",
+			style.Dim.Render("○"), b.WorkBeadID, humanize.Relative(retryAt, time.Now()),
+			b.Context.DispatchFailures+1, b.Context.FailureLimit(maxDispatchFailures))
+	}
+}
+
+// Dispatch failure kinds, recorded as failure_kind on scheduler_dispatch_failed
+// events so failures can be aggregated by cause.
+const (
+	dispatchFailContext        = "context"         // Sling context missing or unreadable
+	dispatchFailRigUnavailable = "rig_unavailable" // Rig parked, docked, or unknown
+	dispatchFailBeadState      = "bead_state"      // Bead closed, already hooked, or deferred
+	dispatchFailBeadLookup     = "bead_lookup"     // Could not read the work bead
+	dispatchFailSpawn          = "spawn"           // Polecat could not be allocated
+	dispatchFailBootstrap      = "bootstrap"       // Rig bootstrap failed in the new worktree
+	dispatchFailFormula        = "formula"         // Formula cook/instantiate failed
+	dispatchFailHook           = "hook"            // Hooking the bead failed
+	dispatchFailSession        = "session"         // Polecat session did not start
+	dispatchFailUnknown        = "unknown"
+)
+
+// classifyDispatchFailure maps a dispatch error to a failure kind. It keys
+// off the messages dispatchSingleBead and executeSling wrap their errors in.
+func classifyDispatchFailure(err error) string {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "missing sling context"):
+		return dispatchFailContext
+	case strings.Contains(msg, "cannot sling to"):
+		return dispatchFailRigUnavailable
+	case strings.Contains(msg, "work already completed"),
+		strings.Contains(msg, "use --force"):
+		return dispatchFailBeadState
+	case strings.Contains(msg, "could not get bead info"):
+		return dispatchFailBeadLookup
+	case strings.Contains(msg, "rig bootstrap failed"):
+		return dispatchFailBootstrap
+	case strings.Contains(msg, "failed to spawn polecat"),
+		strings.Contains(msg, "burning stale molecules"):
+		return dispatchFailSpawn
+	case strings.Contains(msg, "cooking formula"),
+		strings.Contains(msg, "instantiating formula"):
+		return dispatchFailFormula
+	case strings.Contains(msg, "failed to hook bead"),
+		strings.Contains(msg, "serializing hook write"):
+		return dispatchFailHook
+	case strings.Contains(msg, "starting polecat session"):
+		return dispatchFailSession
+	default:
+		return dispatchFailUnknown
+	}
+}
+
+// throttleStats accumulates one dispatch cycle's outcomes for the adaptive
+// throttle (scheduler.adaptive).
+type throttleStats struct {
+	dispatched int
+	failed     int
+	contention int
+	latency    time.Duration // Total over successful dispatches
+}
+
+func (t *throttleStats) recordSuccess(d time.Duration) {
+	t.dispatched++
+	t.latency += d
+}
+
+// recordFailure counts failures that point at an overloaded system: Dolt
+// lock contention, or a polecat that could not be spawned, hooked, or
+// started. Failures caused by the bead itself (bad state, missing formula,
+// unavailable rig) say nothing about load and are ignored.
+func (t *throttleStats) recordFailure(err error) {
+	if doltserver.IsLockContention(err) ||
+		doltserver.IsLockContention(errors.New(dispatchStderrExcerpt(err))) {
+		t.failed++
+		t.contention++
+		return
+	}
+	switch classifyDispatchFailure(err) {
+	case dispatchFailSpawn, dispatchFailHook, dispatchFailSession, dispatchFailUnknown:
+		t.failed++
+	}
+}
+
+func (t *throttleStats) stats() capacity.CycleStats {
+	s := capacity.CycleStats{Dispatched: t.dispatched, Failed: t.failed, Contention: t.contention}
+	if t.dispatched > 0 {
+		s.Latency = t.latency / time.Duration(t.dispatched)
+	}
+	return s
+}
+
+// maxDispatchStderrExcerpt bounds the stderr kept on a failure event.
+const maxDispatchStderrExcerpt = 1024
+
+// dispatchStderrExcerpt returns the tail of the stderr of a failed
+// subprocess in err's chain, or "" if there is none.
+func dispatchStderrExcerpt(err error) string {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return ""
 	}
+	stderr := strings.TrimSpace(string(exitErr.Stderr))
+	if len(stderr) > maxDispatchStderrExcerpt {
+		stderr = "…" + stderr[len(stderr)-maxDispatchStderrExcerpt:]
+	}
+	return stderr
+}
+
+// newDispatchFailure builds the structured failure record for a failed
+// dispatch of b that started at started.
+func newDispatchFailure(b capacity.PendingBead, err error, started time.Time) events.DispatchFailure {
+	f := events.DispatchFailure{
+		BeadID:  b.WorkBeadID,
+		Rig:     b.TargetRig,
+		Kind:    classifyDispatchFailure(err),
+		Attempt: 1,
+		Error:   err.Error(),
+		Stderr:  dispatchStderrExcerpt(err),
+	}
+	if b.Context != nil {
+		f.Formula = b.Context.Formula
+		f.Attempt = b.Context.DispatchFailures + 1 // Not yet incremented by recordDispatchFailure
+		f.CorrelationID = b.Context.CorrelationID
+	}
+	if !started.IsZero() {
+		f.Duration = time.Since(started)
+	}
+	return f
 }
 
 // listAllSlingContexts returns all open sling context beads across all rig
@@ -497,13 +902,23 @@ func recordDispatchFailure(townBeads *beads.Beads, b capacity.PendingBead, dispa
 // underlying beads DB (e.g., when a rig's top-level .beads is a redirect to
 // mayor/rig/.beads), and both paths would otherwise return the same contexts.
 func listAllSlingContexts(townRoot string) []*beads.Issue {
+	all, _ := listAllSlingContextsWithError(townRoot) // Partial failure is acceptable — skip unavailable dirs
+	return all
+}
+
+// listAllSlingContextsWithError is listAllSlingContexts for callers that must
+// not mistake an unreadable rig for an empty queue. It returns the contexts
+// it could read along with an error naming each dir that failed.
+func listAllSlingContextsWithError(townRoot string) ([]*beads.Issue, error) {
 	var all []*beads.Issue
+	var errs []error
 	seen := make(map[string]bool)
 	for _, dir := range beadsSearchDirs(townRoot) {
 		b := beads.NewWithBeadsDir(dir, beads.ResolveBeadsDir(dir))
 		contexts, err := b.ListOpenSlingContexts()
 		if err != nil {
-			continue // Partial failure is acceptable — skip unavailable dirs
+			errs = append(errs, fmt.Errorf("%s: %w", dir, err))
+			continue
 		}
 		for _, ctx := range contexts {
 			if seen[ctx.ID] {
@@ -513,7 +928,7 @@ func listAllSlingContexts(townRoot string) []*beads.Issue {
 			all = append(all, ctx)
 		}
 	}
-	return all
+	return all, errors.Join(errs...)
 }
 
 // listReadyWorkBeadIDsWithError returns a set of work bead IDs that are unblocked.
@@ -552,6 +967,30 @@ func listReadyWorkBeadIDsWithError(townRoot string) (map[string]bool, error) {
 	return readyIDs, nil
 }
 
+// fetchBatchMemberInfo fetches the work beads of contexts batched with a
+// leader, to tell whether the batch polecat still holds them. Returns nil
+// when there are none.
+func fetchBatchMemberInfo(townRoot string, contexts []*beads.Issue) map[string]beadStatusInfo {
+	var ids []string
+	for _, ctx := range contexts {
+		if f := beads.ParseSlingContextFields(ctx.Description); f != nil && f.BatchedWith != "" {
+			ids = append(ids, f.WorkBeadID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return batchFetchBeadInfoByIDs(townRoot, ids)
+}
+
+// batchClaimed reports whether a batched work bead is still claimed: it is
+// assigned, or its lookup failed (a failed lookup must not dispatch work a
+// polecat may already have).
+func batchClaimed(infos map[string]beadStatusInfo, workBeadID string) bool {
+	info, ok := infos[workBeadID]
+	return !ok || info.Assignee != ""
+}
+
 // listReadyWorkBeadIDs returns a set of work bead IDs that are unblocked.
 // Convenience wrapper that ignores errors (used by listScheduledBeads for display).
 func listReadyWorkBeadIDs(townRoot string) map[string]bool {
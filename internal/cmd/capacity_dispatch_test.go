@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestClassifyDispatchFailure(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{fmt.Errorf("missing sling context for gt-ctx"), dispatchFailContext},
+		{fmt.Errorf("sling failed: %w", fmt.Errorf("cannot sling to parked rig %q", "gastown")), dispatchFailRigUnavailable},
+		{fmt.Errorf("sling failed: bead gt-1 is closed (work already completed)"), dispatchFailBeadState},
+		{fmt.Errorf("sling failed: already hooked (use --force to re-sling)"), dispatchFailBeadState},
+		{fmt.Errorf("sling failed: failed to spawn polecat: %w", errors.New("no capacity")), dispatchFailSpawn},
+		{fmt.Errorf("sling failed: failed to spawn polecat: preparing worktree: rig bootstrap failed (bootstrap.sh): exit status 1"), dispatchFailBootstrap},
+		{fmt.Errorf("sling failed: cooking formula mol-x: bad step"), dispatchFailFormula},
+		{fmt.Errorf("sling failed: failed to hook bead: locked"), dispatchFailHook},
+		{fmt.Errorf("sling failed: starting polecat session: tmux gone"), dispatchFailSession},
+		{errors.New("something else"), dispatchFailUnknown},
+	}
+	for _, tt := range tests {
+		if got := classifyDispatchFailure(tt.err); got != tt.want {
+			t.Errorf("classifyDispatchFailure(%q) = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestNewDispatchFailure(t *testing.T) {
+	b := capacity.PendingBead{
+		ID:         "gt-ctx",
+		WorkBeadID: "gt-work",
+		TargetRig:  "gastown",
+		Context:    &capacity.SlingContextFields{Formula: "mol-polecat-work", DispatchFailures: 1},
+	}
+	err := fmt.Errorf("sling failed: failed to spawn polecat: %w", errors.New("boom"))
+
+	f := newDispatchFailure(b, err, time.Now().Add(-2*time.Second))
+	if f.BeadID != "gt-work" || f.Rig != "gastown" || f.Formula != "mol-polecat-work" {
+		t.Errorf("identity = %+v", f)
+	}
+	if f.Kind != dispatchFailSpawn || f.Attempt != 2 || f.Duration < 2*time.Second {
+		t.Errorf("failure = %+v, want spawn, attempt 2, >=2s", f)
+	}
+
+	if f := newDispatchFailure(capacity.PendingBead{WorkBeadID: "gt-work"}, err, time.Time{}); f.Attempt != 1 || f.Duration != 0 {
+		t.Errorf("without context or start time = %+v, want attempt 1, zero duration", f)
+	}
+}
+
+func TestDispatchStderrExcerpt(t *testing.T) {
+	if got := dispatchStderrExcerpt(errors.New("plain")); got != "" {
+		t.Errorf("non-exec error excerpt = %q, want empty", got)
+	}
+
+	long := strings.Repeat("x", maxDispatchStderrExcerpt) + "tail"
+	exitErr := &exec.ExitError{Stderr: []byte(long + "\n")}
+	got := dispatchStderrExcerpt(fmt.Errorf("sling failed: %w", exitErr))
+	if !strings.HasSuffix(got, "tail") || !strings.HasPrefix(got, "…") {
+		t.Errorf("excerpt should keep the tail of long stderr, got %d bytes", len(got))
+	}
+}
+
+func TestThrottleStats(t *testing.T) {
+	var ts throttleStats
+	ts.recordSuccess(10 * time.Second)
+	ts.recordSuccess(30 * time.Second)
+	ts.recordFailure(fmt.Errorf("failed to hook bead: Error 1105: cannot update manifest: database is read only"))
+	ts.recordFailure(fmt.Errorf("failed to spawn polecat: no names left"))
+	ts.recordFailure(fmt.Errorf("work already completed"))
+
+	got := ts.stats()
+	want := capacity.CycleStats{Dispatched: 2, Failed: 2, Contention: 1, Latency: 20 * time.Second}
+	if got != want {
+		t.Errorf("stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLimitHoldString(t *testing.T) {
+	h := limitHold{Provider: "claude", Beads: 3}
+	if got, want := h.String(), "3 bead(s) held: claude limited"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	h.ResetAt = time.Now().Add(2*time.Hour + time.Minute)
+	if got, want := h.String(), "3 bead(s) held: claude limited, resets in 2h"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestCapacitySkips(t *testing.T) {
+	queued := []capacity.PendingBead{
+		{ID: "ctx-1", WorkBeadID: "gt-a"},
+		{ID: "ctx-2", WorkBeadID: "gt-b"},
+		{ID: "ctx-3", WorkBeadID: "gt-c"},
+	}
+	// PlanDispatch takes a prefix, so the beads left are the tail.
+	plan := capacity.PlanDispatch(1, 3, queued)
+	skipped := capacitySkips(queued, plan.Skipped)
+	if len(skipped) != 2 || skipped[0].WorkBeadID != "gt-b" || skipped[1].WorkBeadID != "gt-c" {
+		t.Fatalf("capacitySkips = %+v, want gt-b and gt-c", skipped)
+	}
+	for _, s := range skipped {
+		if s.Reason != capacity.SkipCapacity {
+			t.Errorf("%s reason = %q, want %q", s.WorkBeadID, s.Reason, capacity.SkipCapacity)
+		}
+	}
+	if s := capacitySkips(queued, 0); s != nil {
+		t.Errorf("capacitySkips(0) = %+v, want nil", s)
+	}
+}
+
+func TestBatchClaimed(t *testing.T) {
+	infos := map[string]beadStatusInfo{
+		"gt-held":     {Status: "open", Assignee: "gastown/polecats/Toast"},
+		"gt-released": {Status: "open"},
+	}
+	if !batchClaimed(infos, "gt-held") {
+		t.Error("assigned member should stay with its batch")
+	}
+	if batchClaimed(infos, "gt-released") {
+		t.Error("released member should dispatch on its own")
+	}
+	if !batchClaimed(infos, "gt-unknown") {
+		t.Error("failed lookup should keep the member held")
+	}
+}
@@ -344,7 +344,7 @@ func createCompactReportBead(report *compactReport, markdown string) (string, er
 		"--silent",
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd := beads.Command(bdArgs...)
 	output, err := bdCmd.CombinedOutput()
 	if err != nil {
 		return "", fmt.Errorf("creating report bead: %w\nOutput: %s", err, string(output))
@@ -353,7 +353,7 @@ func createCompactReportBead(report *compactReport, markdown string) (string, er
 	beadID := strings.TrimSpace(string(output))
 
 	// Auto-close (audit record, not work)
-	closeCmd := exec.Command("bd", "close", beadID, "--reason=daily compaction report")
+	closeCmd := beads.Command("close", beadID, "--reason=daily compaction report")
 	_ = closeCmd.Run()
 
 	return beadID, nil
@@ -454,7 +454,7 @@ func runWeeklyRollup() error {
 
 // queryCompactionReports queries compaction report event beads in a date range.
 func queryCompactionReports(startDate, endDate string) ([]*compactReport, error) {
-	listCmd := exec.Command("bd", "list",
+	listCmd := beads.Command("list",
 		"--type=event",
 		"--json",
 		"--limit=0",
@@ -562,7 +562,7 @@ func formatWeeklyRollup(rollup *weeklyRollup) string {
 func findExistingCompactReport(dateStr string) (string, error) {
 	expectedTitle := fmt.Sprintf("Compaction Report %s", dateStr)
 
-	listCmd := exec.Command("bd", "list",
+	listCmd := beads.Command("list",
 		"--type=event",
 		"--status=closed",
 		"--json",
@@ -594,7 +594,7 @@ func findExistingCompactReport(dateStr string) (string, error) {
 func findExistingWeeklyRollup(weekStart, weekEnd string) (string, error) {
 	expectedTitle := fmt.Sprintf("Weekly Compaction Rollup %s to %s", weekStart, weekEnd)
 
-	listCmd := exec.Command("bd", "list",
+	listCmd := beads.Command("list",
 		"--type=event",
 		"--json",
 		"--limit=20",
@@ -648,7 +648,7 @@ func createWeeklyRollupBead(rollup *weeklyRollup, markdown string) (string, erro
 		"--silent",
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd := beads.Command(bdArgs...)
 	output, err := bdCmd.CombinedOutput()
 	if err != nil {
 		return "", fmt.Errorf("creating weekly rollup bead: %w\nOutput: %s", err, string(output))
@@ -657,7 +657,7 @@ func createWeeklyRollupBead(rollup *weeklyRollup, markdown string) (string, erro
 	beadID := strings.TrimSpace(string(output))
 
 	// Auto-close (audit record, not work)
-	closeCmd := exec.Command("bd", "close", beadID, "--reason=weekly compaction rollup")
+	closeCmd := beads.Command("close", beadID, "--reason=weekly compaction rollup")
 	_ = closeCmd.Run()
 
 	return beadID, nil
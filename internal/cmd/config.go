@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -29,6 +30,7 @@ This command allows you to view and modify configuration settings
 for your Gas Town workspace, including agent aliases and defaults.
 
 Commands:
+  gt config validate                Check settings for typos and bad values
   gt config agent list              List all agents (built-in and custom)
   gt config agent get <name>         Show agent configuration
   gt config agent set <name> <cmd>   Set custom agent command
@@ -619,6 +621,12 @@ var configSetCmd = &cobra.Command{
 Supported keys:
   convoy.notify_on_complete   Push notification to Mayor session on convoy
                               completion (true/false, default: false)
+  convoy.auto_close_empty     Sweep closes convoys tracking no issues
+                              (true/false, default: true)
+  convoy.auto_close_complete  Sweep closes convoys whose issues are all closed
+                              (true/false, default: true)
+  convoy.stale_ttl            Sweep closes idle convoys not updated for this
+                              long (e.g. 336h; "" = never, default)
   cli_theme                   CLI color scheme ("dark", "light", "auto")
   default_agent               Default agent preset name
   dolt.port                   Dolt SQL server port (default: 3307). Set this when
@@ -627,9 +635,44 @@ Supported keys:
   scheduler.max_polecats      Dispatch mode: -1 = direct (default), N > 0 = deferred
   scheduler.batch_size        Beads per heartbeat (default: 1)
   scheduler.spawn_delay       Delay between spawns (default: 0s)
+  scheduler.auto_enqueue      Enqueue beads matching scheduler.rules each
+                              heartbeat (true/false, default: false)
+  scheduler.max_batched_beads Max gt:batchable beads per polecat
+                              (default: 3, 1 = no batching)
+  scheduler.adaptive          Tune batch size and spawn delay from sling
+                              latency and Dolt lock contention (true/false,
+                              default: false). batch_size is the ceiling,
+                              spawn_delay the floor.
+  scheduler.max_age           Flag beads queued longer than this gt:queue-stale
+                              (days, e.g. "14d", or a duration; "" = no limit)
+  scheduler.cancel_stale      Remove beads queued longer than max_age from the
+                              queue and notify the overseer (true/false,
+                              default: false)
+  scheduler.queue_notes       Comment each queued bead's position, dispatch
+                              ETA and target rig on the bead, updated on
+                              significant changes (true/false, default: false)
+  scheduler.starvation_cycles Dispatch cycles a ready bead may be passed over
+                              for other work before it is boosted to the
+                              front of the queue (default: 20, 0 = off)
+  scheduler.limit_cap         Keep polecats below the concurrency at which
+                              usage limits recently hit: off (default), low,
+                              medium or high (how far below)
+  scheduler.conflict_groups.<name>
+                              Comma-separated path globs (e.g. "migrations/**");
+                              beads predicted to touch the same group dispatch
+                              one at a time ("" removes the group)
   maintenance.window          Maintenance window start time in HH:MM (e.g., "03:00")
   maintenance.interval        How often: "daily", "weekly", "monthly", or duration
   maintenance.threshold       Commit count threshold (default: 1000)
+  energy_saver.window         Daemon deep-sleep quiet period in HH:MM-HH:MM
+                              (e.g., "02:00-07:00"), or "off"
+  confirm.enqueue_beads       Ask before slinging or scheduling more than N
+                              beads at once (default: 20)
+  confirm.kill_polecats       Ask before killing or pausing more than N
+                              polecats (default: 1)
+  confirm.clear_limits        Ask before clearing limits on more than N
+                              accounts (default: 1)
+                              For confirm.*, 0 always asks, -1 never asks.
 
   Lifecycle (Dolt data maintenance):
   lifecycle.reaper.enabled     Enable/disable wisp reaper (true/false)
@@ -645,12 +688,16 @@ Supported keys:
 
 Examples:
   gt config set convoy.notify_on_complete true
+  gt config set convoy.stale_ttl 336h
   gt config set cli_theme dark
   gt config set default_agent claude
   gt config set dolt.port 3308
   gt config set scheduler.max_polecats 5
+  gt config set scheduler.conflict_groups.migrations "migrations/**,db/schema.sql"
   gt config set maintenance.window 03:00
   gt config set maintenance.interval daily
+  gt config set energy_saver.window 02:00-07:00
+  gt config set confirm.kill_polecats 3
   gt config set lifecycle.reaper.delete_age 336h
   gt config set lifecycle.compactor.threshold 1000`,
 	Args: cobra.ExactArgs(2),
@@ -666,14 +713,30 @@ var configGetCmd = &cobra.Command{
 Supported keys:
   convoy.notify_on_complete   Push notification to Mayor session on convoy
                               completion (true/false, default: false)
+  convoy.auto_close_empty     Sweep closes empty convoys (true/false)
+  convoy.auto_close_complete  Sweep closes completed convoys (true/false)
+  convoy.stale_ttl            Idle time before sweep closes a convoy
   cli_theme                   CLI color scheme
   default_agent               Default agent preset name
   scheduler.max_polecats      Dispatch mode (-1 = direct, N > 0 = deferred)
   scheduler.batch_size        Beads per heartbeat
   scheduler.spawn_delay       Delay between spawns
+  scheduler.auto_enqueue      Auto-enqueue beads matching routing rules
+  scheduler.max_batched_beads Max gt:batchable beads per polecat
+  scheduler.adaptive          Adaptive batch size and spawn delay
+  scheduler.max_age           Queue age that flags a bead stale (e.g. 14d)
+  scheduler.cancel_stale      Cancel stale queued beads instead of flagging
+  scheduler.queue_notes       Comment queue position and ETA on queued beads
+  scheduler.starvation_cycles Cycles passed over before a bead is boosted
+  scheduler.limit_cap         Cap polecats below limit concurrency (off/low/medium/high)
+  scheduler.conflict_groups.<name> Path globs of a conflict group
   maintenance.window          Maintenance window start time (HH:MM)
   maintenance.interval        How often: daily, weekly, monthly, or duration
   maintenance.threshold       Commit count threshold
+  energy_saver.window         Daemon deep-sleep quiet period (HH:MM-HH:MM)
+  confirm.enqueue_beads       Bead count that needs confirmation to enqueue
+  confirm.kill_polecats       Polecat count that needs confirmation to kill
+  confirm.clear_limits        Account count that needs confirmation to clear
 
   Lifecycle (Dolt data maintenance):
   lifecycle.reaper.enabled     Wisp reaper enabled (true/false)
@@ -710,6 +773,10 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("loading town settings: %w", err)
 	}
+	if err := config.LoadAgentRegistry(config.DefaultAgentRegistryPath(townRoot)); err != nil {
+		return fmt.Errorf("loading agent registry: %w", err)
+	}
+	issuesBefore := config.ValidateTownSettings(townSettings)
 
 	switch key {
 	case "convoy.notify_on_complete":
@@ -722,6 +789,31 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		}
 		townSettings.Convoy.NotifyOnComplete = b
 
+	case "convoy.auto_close_empty", "convoy.auto_close_complete":
+		b, err := parseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w (expected true/false)", key, err)
+		}
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		if key == "convoy.auto_close_empty" {
+			townSettings.Convoy.AutoCloseEmpty = &b
+		} else {
+			townSettings.Convoy.AutoCloseComplete = &b
+		}
+
+	case "convoy.stale_ttl":
+		if value != "" {
+			if _, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("invalid value for %s: %w (expected Go duration, e.g. 336h, or \"\" to disable)", key, err)
+			}
+		}
+		if townSettings.Convoy == nil {
+			townSettings.Convoy = &config.ConvoyConfig{}
+		}
+		townSettings.Convoy.StaleTTL = value
+
 	case "cli_theme":
 		switch value {
 		case "dark", "light", "auto":
@@ -746,6 +838,23 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		}
 		townSettings.Scheduler.MaxPolecats = &n
 
+	case "confirm.enqueue_beads", "confirm.kill_polecats", "confirm.clear_limits":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < -1 {
+			return fmt.Errorf("invalid value for %s: expected integer >= -1 (-1 = never ask)", key)
+		}
+		if townSettings.Confirm == nil {
+			townSettings.Confirm = &config.ConfirmConfig{}
+		}
+		switch key {
+		case "confirm.enqueue_beads":
+			townSettings.Confirm.EnqueueBeads = &n
+		case "confirm.kill_polecats":
+			townSettings.Confirm.KillPolecats = &n
+		default:
+			townSettings.Confirm.ClearLimits = &n
+		}
+
 	case "scheduler.batch_size":
 		n, err := strconv.Atoi(value)
 		if err != nil || n < 1 {
@@ -756,6 +865,16 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		}
 		townSettings.Scheduler.BatchSize = &n
 
+	case "scheduler.max_batched_beads":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid value for %s: expected positive integer (1 = no batching)", key)
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		townSettings.Scheduler.MaxBatchedBeads = &n
+
 	case "scheduler.spawn_delay":
 		// Validate it parses as a duration
 		_, err := time.ParseDuration(value)
@@ -767,9 +886,85 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		}
 		townSettings.Scheduler.SpawnDelay = value
 
+	case "scheduler.adaptive":
+		b, err := parseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w (expected true/false)", key, err)
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		townSettings.Scheduler.Adaptive = b
+
+	case "scheduler.max_age":
+		if _, err := capacity.ParseMaxAge(value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w (or \"\" for no limit)", key, err)
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		townSettings.Scheduler.MaxAge = value
+
+	case "scheduler.cancel_stale":
+		b, err := parseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w (expected true/false)", key, err)
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		townSettings.Scheduler.CancelStale = b
+
+	case "scheduler.queue_notes":
+		b, err := parseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w (expected true/false)", key, err)
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		townSettings.Scheduler.QueueNotes = b
+
+	case "scheduler.starvation_cycles":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid value for %s: expected non-negative integer (0 = off)", key)
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		townSettings.Scheduler.StarvationCycles = &n
+
+	case "scheduler.limit_cap":
+		if !slices.Contains(capacity.LimitCapLevels, value) {
+			return fmt.Errorf("invalid value for %s: expected one of %s", key, strings.Join(capacity.LimitCapLevels, ", "))
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		townSettings.Scheduler.LimitCap = value
+
+	case "scheduler.auto_enqueue":
+		b, err := parseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w (expected true/false)", key, err)
+		}
+		if townSettings.Scheduler == nil {
+			townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+		}
+		if b {
+			if err := capacity.ValidateRoutingRules(townSettings.Scheduler.Rules); err != nil {
+				return err
+			}
+		}
+		townSettings.Scheduler.AutoEnqueue = b
+
 	case "maintenance.window", "maintenance.interval", "maintenance.threshold":
 		return setMaintenanceConfig(townRoot, key, value)
 
+	case "energy_saver.window":
+		return setEnergySaverConfig(townRoot, value)
+
 	case "dolt.port":
 		port, err := strconv.Atoi(value)
 		if err != nil || port < 1024 || port > 65535 {
@@ -794,9 +989,21 @@ func runConfigSet(cmd *cobra.Command, args []string) error {
 		if strings.HasPrefix(key, "lifecycle.") {
 			return setLifecycleConfig(townRoot, key, value)
 		}
-		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.spawn_delay\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
+		if name, ok := strings.CutPrefix(key, "scheduler.conflict_groups."); ok && name != "" {
+			if townSettings.Scheduler == nil {
+				townSettings.Scheduler = capacity.DefaultSchedulerConfig()
+			}
+			if err := setConflictGroup(townSettings.Scheduler, name, value); err != nil {
+				return fmt.Errorf("invalid value for %s: %w", key, err)
+			}
+			break
+		}
+		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  convoy.auto_close_empty\n  convoy.auto_close_complete\n  convoy.stale_ttl\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.max_batched_beads\n  scheduler.spawn_delay\n  scheduler.auto_enqueue\n  scheduler.adaptive\n  scheduler.max_age\n  scheduler.cancel_stale\n  scheduler.queue_notes\n  scheduler.starvation_cycles\n  scheduler.limit_cap\n  scheduler.conflict_groups.<name>\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  energy_saver.window\n  confirm.enqueue_beads\n  confirm.kill_polecats\n  confirm.clear_limits\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
 	}
 
+	if err := checkSettingsChange(issuesBefore, townSettings); err != nil {
+		return err
+	}
 	if err := config.SaveTownSettings(settingsPath, townSettings); err != nil {
 		return fmt.Errorf("saving town settings: %w", err)
 	}
@@ -828,6 +1035,17 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 			value = "false"
 		}
 
+	case "convoy.auto_close_empty":
+		value = strconv.FormatBool(townSettings.Convoy.IsAutoCloseEmptyEnabled())
+
+	case "convoy.auto_close_complete":
+		value = strconv.FormatBool(townSettings.Convoy.IsAutoCloseCompleteEnabled())
+
+	case "convoy.stale_ttl":
+		if townSettings.Convoy != nil {
+			value = townSettings.Convoy.StaleTTL
+		}
+
 	case "cli_theme":
 		value = townSettings.CLITheme
 		if value == "" {
@@ -840,6 +1058,15 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 			value = "claude"
 		}
 
+	case "confirm.enqueue_beads":
+		value = strconv.Itoa(townSettings.Confirm.GetEnqueueBeads())
+
+	case "confirm.kill_polecats":
+		value = strconv.Itoa(townSettings.Confirm.GetKillPolecats())
+
+	case "confirm.clear_limits":
+		value = strconv.Itoa(townSettings.Confirm.GetClearLimits())
+
 	case "scheduler.max_polecats":
 		scfg := townSettings.Scheduler
 		if scfg == nil {
@@ -854,6 +1081,9 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 		}
 		value = strconv.Itoa(scfg.GetBatchSize())
 
+	case "scheduler.max_batched_beads":
+		value = strconv.Itoa(townSettings.Scheduler.GetMaxBatchedBeads())
+
 	case "scheduler.spawn_delay":
 		scfg := townSettings.Scheduler
 		if scfg == nil {
@@ -861,9 +1091,35 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 		}
 		value = scfg.GetSpawnDelay().String()
 
+	case "scheduler.auto_enqueue":
+		value = strconv.FormatBool(townSettings.Scheduler != nil && townSettings.Scheduler.AutoEnqueue)
+
+	case "scheduler.adaptive":
+		value = strconv.FormatBool(townSettings.Scheduler != nil && townSettings.Scheduler.Adaptive)
+
+	case "scheduler.max_age":
+		if townSettings.Scheduler != nil {
+			value = townSettings.Scheduler.MaxAge
+		}
+
+	case "scheduler.cancel_stale":
+		value = strconv.FormatBool(townSettings.Scheduler != nil && townSettings.Scheduler.CancelStale)
+
+	case "scheduler.queue_notes":
+		value = strconv.FormatBool(townSettings.Scheduler != nil && townSettings.Scheduler.QueueNotes)
+
+	case "scheduler.starvation_cycles":
+		value = strconv.Itoa(townSettings.Scheduler.GetStarvationCycles())
+
+	case "scheduler.limit_cap":
+		value = townSettings.Scheduler.GetLimitCap()
+
 	case "maintenance.window", "maintenance.interval", "maintenance.threshold":
 		return getMaintenanceConfig(townRoot, key)
 
+	case "energy_saver.window":
+		return getEnergySaverConfig(townRoot)
+
 	case "dolt.port":
 		patrolCfg := daemon.LoadPatrolConfig(townRoot)
 		if patrolCfg != nil {
@@ -879,7 +1135,13 @@ func runConfigGet(cmd *cobra.Command, args []string) error {
 		if strings.HasPrefix(key, "lifecycle.") {
 			return getLifecycleConfig(townRoot, key)
 		}
-		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.spawn_delay\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
+		if name, ok := strings.CutPrefix(key, "scheduler.conflict_groups."); ok && name != "" {
+			if townSettings.Scheduler != nil {
+				value = strings.Join(townSettings.Scheduler.ConflictGroups[name], ",")
+			}
+			break
+		}
+		return fmt.Errorf("unknown config key: %q\n\nSupported keys:\n  convoy.notify_on_complete\n  convoy.auto_close_empty\n  convoy.auto_close_complete\n  convoy.stale_ttl\n  cli_theme\n  default_agent\n  dolt.port\n  scheduler.max_polecats\n  scheduler.batch_size\n  scheduler.max_batched_beads\n  scheduler.spawn_delay\n  scheduler.auto_enqueue\n  scheduler.adaptive\n  scheduler.max_age\n  scheduler.cancel_stale\n  scheduler.queue_notes\n  scheduler.starvation_cycles\n  scheduler.limit_cap\n  scheduler.conflict_groups.<name>\n  maintenance.window\n  maintenance.interval\n  maintenance.threshold\n  energy_saver.window\n  confirm.enqueue_beads\n  confirm.kill_polecats\n  confirm.clear_limits\n  lifecycle.reaper.*\n  lifecycle.compactor.*\n  lifecycle.doctor.*\n  lifecycle.backup.*", key)
 	}
 
 	fmt.Println(value)
@@ -993,6 +1255,56 @@ func getMaintenanceConfig(townRoot, key string) error {
 	return nil
 }
 
+// setEnergySaverConfig sets energy_saver.window in daemon.json. "off"
+// disables the quiet period.
+func setEnergySaverConfig(townRoot, value string) error {
+	patrolConfig := daemon.LoadPatrolConfig(townRoot)
+	if patrolConfig == nil {
+		patrolConfig = &daemon.DaemonPatrolConfig{
+			Type:    "daemon-patrol-config",
+			Version: 1,
+		}
+	}
+	if patrolConfig.EnergySaver == nil {
+		patrolConfig.EnergySaver = &daemon.EnergySaverConfig{}
+	}
+	es := patrolConfig.EnergySaver
+
+	if value == "off" {
+		es.Enabled = false
+	} else {
+		start, end, err := daemon.ParseQuietWindow(value)
+		if err != nil {
+			return fmt.Errorf("%w (e.g., 02:00-07:00)", err)
+		}
+		es.Window = fmt.Sprintf("%02d:%02d-%02d:%02d", start/60, start%60, end/60, end%60)
+		es.Enabled = true // Setting window enables energy saver
+	}
+
+	if err := daemon.SavePatrolConfig(townRoot, patrolConfig); err != nil {
+		return fmt.Errorf("saving daemon config: %w", err)
+	}
+
+	fmt.Printf("Set %s = %s\n", style.Bold.Render("energy_saver.window"), value)
+	if es.Enabled {
+		fmt.Printf("Energy saver enabled: the daemon sleeps and stops Dolt %s (wake early with: gt daemon wake)\n", es.Window)
+	} else {
+		fmt.Println("Energy saver disabled")
+	}
+	return nil
+}
+
+// getEnergySaverConfig gets energy_saver.window from daemon.json.
+func getEnergySaverConfig(townRoot string) error {
+	patrolConfig := daemon.LoadPatrolConfig(townRoot)
+	value := "off"
+	if patrolConfig != nil && patrolConfig.EnergySaver != nil && patrolConfig.EnergySaver.Enabled {
+		value = patrolConfig.EnergySaver.Window
+	}
+	fmt.Println(value)
+	return nil
+}
+
 // setLifecycleConfig sets a lifecycle.* key in daemon.json.
 func setLifecycleConfig(townRoot, key, value string) error {
 	patrolConfig := daemon.LoadPatrolConfig(townRoot)
@@ -9,6 +9,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 )
 
 // setupTestTown creates a minimal Gas Town workspace for testing.
@@ -872,6 +874,52 @@ func TestConfigSetGet(t *testing.T) {
 			t.Errorf("error = %v, want 'invalid value'", err)
 		}
 	})
+
+	t.Run("set scheduler.auto_enqueue validates rules", func(t *testing.T) {
+		townRoot := setupTestTownForConfig(t)
+		settingsPath := config.TownSettingsPath(townRoot)
+
+		originalWd, _ := os.Getwd()
+		defer os.Chdir(originalWd)
+		if err := os.Chdir(townRoot); err != nil {
+			t.Fatalf("chdir: %v", err)
+		}
+
+		// A rule with no matcher would enqueue nothing; enabling must fail.
+		settings, err := config.LoadOrCreateTownSettings(settingsPath)
+		if err != nil {
+			t.Fatalf("load settings: %v", err)
+		}
+		settings.Scheduler = capacity.DefaultSchedulerConfig()
+		settings.Scheduler.Rules = []capacity.RoutingRule{{Name: "empty"}}
+		if err := config.SaveTownSettings(settingsPath, settings); err != nil {
+			t.Fatalf("save settings: %v", err)
+		}
+
+		cmd := &cobra.Command{}
+		if err := runConfigSet(cmd, []string{"scheduler.auto_enqueue", "true"}); err == nil {
+			t.Fatal("expected error for invalid routing rule")
+		}
+
+		settings.Scheduler.Rules = []capacity.RoutingRule{{Name: "docs", Labels: []string{"docs"}}}
+		if err := config.SaveTownSettings(settingsPath, settings); err != nil {
+			t.Fatalf("save settings: %v", err)
+		}
+		if err := runConfigSet(cmd, []string{"scheduler.auto_enqueue", "true"}); err != nil {
+			t.Fatalf("runConfigSet failed: %v", err)
+		}
+
+		loaded, err := config.LoadOrCreateTownSettings(settingsPath)
+		if err != nil {
+			t.Fatalf("load settings: %v", err)
+		}
+		if !loaded.Scheduler.AutoEnqueue {
+			t.Error("AutoEnqueue should be true")
+		}
+		if len(loaded.Scheduler.Rules) != 1 {
+			t.Errorf("rules not preserved: %+v", loaded.Scheduler.Rules)
+		}
+	})
 }
 
 func TestConfigMaintenanceSetGet(t *testing.T) {
@@ -915,6 +963,69 @@ func TestConfigMaintenanceSetGet(t *testing.T) {
 		}
 	})
 
+	t.Run("set energy_saver.window", func(t *testing.T) {
+		townRoot := setupTestTownForConfig(t)
+
+		if err := setEnergySaverConfig(townRoot, "2:00-7:30"); err != nil {
+			t.Fatalf("setEnergySaverConfig failed: %v", err)
+		}
+		es := daemon.LoadPatrolConfig(townRoot).EnergySaver
+		if es == nil || !es.Enabled || es.Window != "02:00-07:30" {
+			t.Errorf("EnergySaver = %+v, want enabled 02:00-07:30", es)
+		}
+
+		if err := setEnergySaverConfig(townRoot, "off"); err != nil {
+			t.Fatalf("setEnergySaverConfig(off) failed: %v", err)
+		}
+		if es := daemon.LoadPatrolConfig(townRoot).EnergySaver; es.Enabled {
+			t.Error("energy saver should be disabled")
+		}
+
+		for _, w := range []string{"02:00", "02:00-24:00", "abc"} {
+			if err := setEnergySaverConfig(townRoot, w); err == nil {
+				t.Errorf("setEnergySaverConfig(%q) expected error", w)
+			}
+		}
+	})
+
+	t.Run("set confirm thresholds", func(t *testing.T) {
+		townRoot := setupTestTownForConfig(t)
+		settingsPath := config.TownSettingsPath(townRoot)
+
+		originalWd, _ := os.Getwd()
+		defer os.Chdir(originalWd)
+		if err := os.Chdir(townRoot); err != nil {
+			t.Fatalf("chdir: %v", err)
+		}
+
+		cmd := &cobra.Command{}
+		if err := runConfigSet(cmd, []string{"confirm.kill_polecats", "3"}); err != nil {
+			t.Fatalf("runConfigSet failed: %v", err)
+		}
+		if err := runConfigSet(cmd, []string{"confirm.clear_limits", "-1"}); err != nil {
+			t.Fatalf("runConfigSet failed: %v", err)
+		}
+		loaded, err := config.LoadOrCreateTownSettings(settingsPath)
+		if err != nil {
+			t.Fatalf("load settings: %v", err)
+		}
+		if got := loaded.Confirm.GetKillPolecats(); got != 3 {
+			t.Errorf("kill_polecats = %d, want 3", got)
+		}
+		if got := loaded.Confirm.GetClearLimits(); got != -1 {
+			t.Errorf("clear_limits = %d, want -1", got)
+		}
+		if got := loaded.Confirm.GetEnqueueBeads(); got != config.DefaultConfirmEnqueueBeads {
+			t.Errorf("enqueue_beads = %d, want default %d", got, config.DefaultConfirmEnqueueBeads)
+		}
+
+		for _, v := range []string{"-2", "many"} {
+			if err := runConfigSet(cmd, []string{"confirm.enqueue_beads", v}); err == nil {
+				t.Errorf("confirm.enqueue_beads %q expected error", v)
+			}
+		}
+	})
+
 	t.Run("set and get maintenance.interval", func(t *testing.T) {
 		townRoot := setupTestTownForConfig(t)
 
@@ -1002,3 +1113,21 @@ func TestParseBool(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckSettingsChange(t *testing.T) {
+	settings := config.NewTownSettings()
+	settings.CLITheme = "neon" // Pre-existing error: doesn't block other changes
+	before := config.ValidateTownSettings(settings)
+
+	settings.Scheduler = capacity.DefaultSchedulerConfig()
+	n := 5
+	settings.Scheduler.MaxPolecats = &n
+	if err := checkSettingsChange(before, settings); err != nil {
+		t.Errorf("valid change refused: %v", err)
+	}
+
+	settings.Scheduler.SpawnDelay = "soon"
+	if err := checkSettingsChange(before, settings); err == nil || !strings.Contains(err.Error(), "scheduler.spawn_delay") {
+		t.Errorf("invalid change allowed: %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var configValidateJSON bool
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check town settings for unknown keys and invalid values",
+	Long: `Check settings/config.json against the town settings schema.
+
+Reports:
+  - Unknown keys (typos are ignored on load, silently disabling the setting)
+  - Duration settings that don't parse (e.g. "5 min" instead of "5m")
+  - Out-of-range values (scheduler.batch_size < 1, unknown agents, ...)
+  - Conflicting options (web_timeouts.default_run_timeout above
+    max_run_timeout, worker_status.stale_threshold >= stuck_threshold)
+
+Keys starting with "_" (e.g. "_comment") are annotations and are not
+checked. Warnings flag settings that are valid but have no effect as
+configured.
+Exits non-zero if any errors are found.
+
+gt config set runs the same checks and refuses a value that would add an
+error.
+
+Examples:
+  gt config validate
+  gt config validate --json`,
+	Args:         cobra.NoArgs,
+	RunE:         runConfigValidate,
+	SilenceUsage: true,
+}
+
+func init() {
+	configValidateCmd.Flags().BoolVar(&configValidateJSON, "json", false, "Output as JSON")
+	configCmd.AddCommand(configValidateCmd)
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+	if err := config.LoadAgentRegistry(config.DefaultAgentRegistryPath(townRoot)); err != nil {
+		return fmt.Errorf("loading agent registry: %w", err)
+	}
+
+	settingsPath := config.TownSettingsPath(townRoot)
+	issues, err := config.ValidateTownSettingsFile(settingsPath)
+	if err != nil {
+		return fmt.Errorf("reading town settings: %w", err)
+	}
+
+	if configValidateJSON {
+		if issues == nil {
+			issues = []config.SettingsIssue{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(issues); err != nil {
+			return err
+		}
+	} else if len(issues) == 0 {
+		fmt.Printf("%s %s is valid\n", style.Bold.Render("✓"), settingsPath)
+	} else {
+		for _, issue := range issues {
+			if issue.Warning {
+				fmt.Printf("%s %s\n", style.Warning.Render("⚠"), issue)
+			} else {
+				fmt.Printf("%s %s\n", style.Error.Render("✗"), issue)
+			}
+		}
+	}
+
+	if config.HasErrors(issues) {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// checkSettingsChange refuses a change to town settings that introduces an
+// error not present in before (the issues found before the change), so a
+// pre-existing problem elsewhere in the file doesn't block fixing others.
+// New warnings are printed but allowed.
+func checkSettingsChange(before []config.SettingsIssue, after *config.TownSettings) error {
+	existing := make(map[string]bool, len(before))
+	for _, issue := range before {
+		existing[issue.String()] = true
+	}
+
+	var errs []string
+	for _, issue := range config.ValidateTownSettings(after) {
+		if existing[issue.String()] {
+			continue
+		}
+		if issue.Warning {
+			fmt.Printf("%s %s\n", style.Warning.Render("⚠"), issue)
+			continue
+		}
+		errs = append(errs, issue.String())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("refusing to save invalid settings:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
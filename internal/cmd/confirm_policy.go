@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// confirmListLimit caps how many items a bulk confirmation lists.
+const confirmListLimit = 10
+
+// promptBulkAction is the confirmation prompt for bulk actions (overridable
+// in tests).
+var promptBulkAction = promptYesNo
+
+// loadConfirmConfig returns the town's confirmation thresholds
+// (confirm.* in settings/config.json). Nil, meaning the defaults, outside a
+// town or when the settings can't be read.
+func loadConfirmConfig() *config.ConfirmConfig {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return nil
+	}
+	return settings.Confirm
+}
+
+// confirmBulkAction asks before applying verb to more items than threshold.
+// --yes, a negative threshold, or few enough items proceed without asking;
+// without a terminal the action is refused with an error, rather than
+// silently applied to everything or skipped with a zero exit status that
+// scripts would take for success. A declined prompt returns false.
+func confirmBulkAction(verb, noun string, items []string, threshold int, yes bool) (bool, error) {
+	if yes || threshold < 0 || len(items) <= threshold {
+		return true, nil
+	}
+	fmt.Printf("About to %s %d %s:\n", verb, len(items), noun)
+	for i, item := range items {
+		if i == confirmListLimit {
+			fmt.Printf("  ... and %d more\n", len(items)-confirmListLimit)
+			break
+		}
+		fmt.Printf("  %s\n", item)
+	}
+	if !isStdinTerminal() {
+		return false, fmt.Errorf("refusing to %s %d %s without confirmation: not a terminal; re-run with --yes", verb, len(items), noun)
+	}
+	fmt.Println()
+	return promptBulkAction(fmt.Sprintf("%s %d %s?", strings.ToUpper(verb[:1])+verb[1:], len(items), noun)), nil
+}
+
+// confirmEnqueueBeads asks before slinging or scheduling more beads than the
+// town's confirm.enqueue_beads threshold (default 20).
+func confirmEnqueueBeads(verb string, beadIDs []string, yes bool) (bool, error) {
+	return confirmBulkAction(verb, "bead(s)", beadIDs, loadConfirmConfig().GetEnqueueBeads(), yes)
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestConfirmBulkAction_Threshold(t *testing.T) {
+	oldIsTTY, oldPrompt := isStdinTerminal, promptBulkAction
+	t.Cleanup(func() { isStdinTerminal, promptBulkAction = oldIsTTY, oldPrompt })
+
+	prompts := 0
+	isStdinTerminal = func() bool { return true }
+	promptBulkAction = func(string) bool { prompts++; return false }
+
+	beads := make([]string, 25)
+	for i := range beads {
+		beads[i] = fmt.Sprintf("gt-%d", i)
+	}
+
+	confirm := func(verb string, items []string, threshold int, yes bool) bool {
+		t.Helper()
+		ok, err := confirmBulkAction(verb, "bead(s)", items, threshold, yes)
+		if err != nil {
+			t.Fatalf("confirmBulkAction: %v", err)
+		}
+		return ok
+	}
+
+	if !confirm("sling", beads[:20], 20, false) || prompts != 0 {
+		t.Error("a count at the threshold should proceed without prompting")
+	}
+	if confirm("sling", beads, 20, false) || prompts != 1 {
+		t.Error("a count over the threshold should prompt, and a declined prompt should cancel")
+	}
+	if !confirm("sling", beads, -1, false) || prompts != 1 {
+		t.Error("a negative threshold should never prompt")
+	}
+	if confirm("clear limits on", beads[:1], 0, false) || prompts != 2 {
+		t.Error("a zero threshold should always prompt")
+	}
+	if !confirm("sling", beads, 20, true) || prompts != 2 {
+		t.Error("--yes should proceed without prompting")
+	}
+}
+
+func TestConfirmBulkAction_NoTerminal(t *testing.T) {
+	oldIsTTY := isStdinTerminal
+	t.Cleanup(func() { isStdinTerminal = oldIsTTY })
+	isStdinTerminal = func() bool { return false }
+
+	ok, err := confirmBulkAction("sling", "bead(s)", []string{"gt-1", "gt-2"}, 1, false)
+	if ok || err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("confirmBulkAction() = %v, %v; want an error asking for --yes", ok, err)
+	}
+}
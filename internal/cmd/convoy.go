@@ -19,6 +19,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	convoyops "github.com/steveyegge/gastown/internal/convoy"
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -77,11 +78,13 @@ var (
 	convoyOwned        bool
 	convoyMerge        string
 	convoyBaseBranch   string
+	convoySequential   bool
 	convoyStatusJSON   bool
 	convoyListJSON     bool
 	convoyListStatus   string
 	convoyListAll      bool
 	convoyListTree     bool
+	convoyListPage     listFlags
 	convoyInteractive  bool
 	convoyStrandedJSON bool
 	convoyCloseReason  string
@@ -198,10 +201,13 @@ TRACKING SEMANTICS:
 COMMANDS:
   create    Create a convoy tracking specified issues
   add       Add issues to an existing convoy (reopens if closed)
+  depend    Hold a convoy's issues until another convoy completes
   close     Close a convoy (verifies all items done, or use --force)
   land      Land an owned convoy (cleanup worktrees, close convoy)
+  sweep     Close empty, completed, or stale convoys per lifecycle policy
   status    Show convoy progress, tracked issues, and active workers
   list      List convoys (the dashboard view)
+  report    Generate a post-mortem report (Markdown or HTML)
   watch     Subscribe to convoy completion notifications
   unwatch   Unsubscribe from convoy completion notifications`,
 }
@@ -223,6 +229,10 @@ The --merge flag sets the merge strategy for all work in the convoy:
   mr      Create merge-request bead, refinery processes (default)
   local   Keep on feature branch (for upstream PRs, human review)
 
+The --sequential flag makes the refinery merge the convoy's MRs in the order
+the issues are listed: an issue's MR waits in the queue until the MRs of
+every issue before it have merged, so each lands on top of the last.
+
 Examples:
   gt convoy create "Deploy v2.0" gt-abc bd-xyz
   gt convoy create "Release prep" gt-abc --notify           # defaults to mayor/
@@ -231,6 +241,7 @@ Examples:
   gt convoy create "Feature rollout" gt-a gt-b gt-c --molecule mol-release
   gt convoy create --owned "Manual deploy" gt-abc           # caller-managed lifecycle
   gt convoy create "Quick fix" gt-abc --merge=direct        # bypass refinery
+  gt convoy create "Schema change" gt-a gt-b --sequential   # merge gt-a, then gt-b
 
   # Auto-discover issues from an epic's children:
   gt convoy create --from-epic gt-epic-abc
@@ -262,6 +273,7 @@ Examples:
   gt convoy list --all        # All convoys (open + closed)
   gt convoy list --status=closed  # Recently landed
   gt convoy list --tree       # Show convoy + child status tree
+  gt convoy list --sort=-created --limit=10   # Ten newest
   gt convoy list --json`,
 	SilenceUsage: true,
 	RunE:         runConvoyList,
@@ -381,6 +393,7 @@ func init() {
 	convoyCreateCmd.Flags().BoolVar(&convoyOwned, "owned", false, "Mark convoy as caller-managed lifecycle (no automatic witness/refinery registration)")
 	convoyCreateCmd.Flags().StringVar(&convoyMerge, "merge", "", "Merge strategy: direct (push to main), mr (merge queue, default), local (keep on branch)")
 	convoyCreateCmd.Flags().StringVar(&convoyBaseBranch, "base-branch", "", "Target branch for polecats (e.g., 'feat/extraction-review')")
+	convoyCreateCmd.Flags().BoolVar(&convoySequential, "sequential", false, "Merge the tracked issues' MRs one after another, in the order given")
 	convoyCreateCmd.Flags().StringVar(&convoyFromEpic, "from-epic", "", "Auto-discover tracked issues from an epic's slingable children")
 
 	// Status flags
@@ -391,6 +404,7 @@ func init() {
 	convoyListCmd.Flags().StringVar(&convoyListStatus, "status", "", "Filter by status (open, closed)")
 	convoyListCmd.Flags().BoolVar(&convoyListAll, "all", false, "Show all convoys (open and closed)")
 	convoyListCmd.Flags().BoolVar(&convoyListTree, "tree", false, "Show convoy + child status tree")
+	convoyListing.register(convoyListCmd, &convoyListPage, 0)
 
 	// Interactive TUI flag (on parent command)
 	convoyCmd.Flags().BoolVarP(&convoyInteractive, "interactive", "i", false, "Interactive tree view")
@@ -442,32 +456,17 @@ func getTownBeadsDir() (string, error) {
 // "exit status 1". BEADS_DIR is stripped from the subprocess environment to
 // prevent stale overrides from interfering with bd's workspace detection.
 func runBdJSON(dir string, args ...string) ([]byte, error) {
-	// Strip --allow-stale if bd doesn't support it (version mismatch).
-	if !beads.BdSupportsAllowStale() {
-		filtered := make([]string, 0, len(args))
-		for _, a := range args {
-			if a != "--allow-stale" {
-				filtered = append(filtered, a)
-			}
-		}
-		args = filtered
-	}
-	cmd := exec.Command("bd", args...)
-	cmd.Dir = dir
-	// Strip BEADS_DIR so bd discovers the correct database from cmd.Dir
+	// BdCmd drops --allow-stale if bd doesn't support it. Strip BEADS_DIR so bd discovers the correct database from dir
 	// rather than using an inherited (possibly wrong) override.
-	cmd.Env = stripEnvKey(os.Environ(), "BEADS_DIR")
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
+	var stderr bytes.Buffer
+	stdout, err := BdCmd(args...).Dir(dir).StripBeadsDir().Stderr(&stderr).Output()
+	if err != nil {
 		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
 			return nil, fmt.Errorf("bd %s: %s", args[0], errMsg)
 		}
 		return nil, fmt.Errorf("bd %s: %w", args[0], err)
 	}
-	return stdout.Bytes(), nil
+	return stdout, nil
 }
 
 // bdDepListRawIDs queries the raw dependencies table via bd sql to get
@@ -602,6 +601,9 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("invalid --merge value %q: must be direct, mr, or local", convoyMerge)
 		}
 	}
+	if convoySequential && convoyMerge != "" && convoyMerge != "mr" {
+		return fmt.Errorf("--sequential orders merges through the refinery and needs --merge=mr, not %q", convoyMerge)
+	}
 
 	var name string
 	var trackedIssues []string
@@ -683,6 +685,9 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 		Molecule:   convoyMolecule,
 		BaseBranch: convoyBaseBranch,
 	}
+	if convoySequential {
+		convoyFieldValues.MergeSequence = strings.Join(trackedIssues, ",")
+	}
 	description = beads.SetConvoyFields(&beads.Issue{Description: description}, convoyFieldValues)
 
 	// Guard against flag-like convoy names (gt-e0kx5)
@@ -754,6 +759,9 @@ func runConvoyCreate(cmd *cobra.Command, args []string) error {
 	if convoyBaseBranch != "" {
 		fmt.Printf("  Base:     %s\n", convoyBaseBranch)
 	}
+	if convoySequential {
+		fmt.Printf("  Order:    %s\n", strings.Join(trackedIssues, " → "))
+	}
 	if convoyOwned {
 		fmt.Printf("  Owned:    %s\n", style.Warning.Render("caller-managed lifecycle"))
 	}
@@ -912,10 +920,7 @@ func closeConvoyIfComplete(townBeads, convoyID, title string, tracked []trackedI
 
 	reason := "All tracked issues completed"
 	closeArgs := []string{"close", convoyID, "-r", reason}
-	closeCmd := exec.Command("bd", closeArgs...)
-	closeCmd.Dir = townBeads
-
-	if err := closeCmd.Run(); err != nil {
+	if err := BdCmd(closeArgs...).Dir(townBeads).Stderr(io.Discard).Run(); err != nil {
 		return false, fmt.Errorf("closing convoy: %w", err)
 	}
 
@@ -928,12 +933,8 @@ func closeConvoyIfComplete(townBeads, convoyID, title string, tracked []trackedI
 func checkSingleConvoy(townBeads, convoyID string, dryRun bool) error {
 	// Get convoy details
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
-	showCmd.Dir = townBeads
-	var stdout bytes.Buffer
-	showCmd.Stdout = &stdout
-
-	if err := showCmd.Run(); err != nil {
+	stdout, err := BdCmd(showArgs...).Dir(townBeads).Stderr(io.Discard).Output()
+	if err != nil {
 		return fmt.Errorf("convoy '%s' not found", convoyID)
 	}
 
@@ -944,7 +945,7 @@ func checkSingleConvoy(townBeads, convoyID string, dryRun bool) error {
 		Type        string `json:"issue_type"`
 		Description string `json:"description"`
 	}
-	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil {
+	if err := json.Unmarshal(stdout, &convoys); err != nil {
 		return fmt.Errorf("parsing convoy data: %w", err)
 	}
 
@@ -988,12 +989,8 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 
 	// Get convoy details
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
-	showCmd.Dir = townBeads
-	var stdout bytes.Buffer
-	showCmd.Stdout = &stdout
-
-	if err := showCmd.Run(); err != nil {
+	stdout, err := BdCmd(showArgs...).Dir(townBeads).Stderr(io.Discard).Output()
+	if err != nil {
 		return fmt.Errorf("convoy '%s' not found", convoyID)
 	}
 
@@ -1004,7 +1001,7 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 		Type        string `json:"issue_type"`
 		Description string `json:"description"`
 	}
-	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil {
+	if err := json.Unmarshal(stdout, &convoys); err != nil {
 		return fmt.Errorf("parsing convoy data: %w", err)
 	}
 
@@ -1075,10 +1072,7 @@ func runConvoyClose(cmd *cobra.Command, args []string) error {
 
 	// Close the convoy
 	closeArgs := []string{"close", convoyID, "-r", reason}
-	closeCmd := exec.Command("bd", closeArgs...)
-	closeCmd.Dir = townBeads
-
-	if err := closeCmd.Run(); err != nil {
+	if err := BdCmd(closeArgs...).Dir(townBeads).Stderr(io.Discard).Run(); err != nil {
 		return fmt.Errorf("closing convoy: %w", err)
 	}
 
@@ -1146,12 +1140,8 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 
 	// Get convoy details
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
-	showCmd.Dir = townBeads
-	var stdout bytes.Buffer
-	showCmd.Stdout = &stdout
-
-	if err := showCmd.Run(); err != nil {
+	stdout, err := BdCmd(showArgs...).Dir(townBeads).Stderr(io.Discard).Output()
+	if err != nil {
 		return fmt.Errorf("convoy '%s' not found", convoyID)
 	}
 
@@ -1163,7 +1153,7 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 		Description string   `json:"description"`
 		Labels      []string `json:"labels,omitempty"`
 	}
-	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil {
+	if err := json.Unmarshal(stdout, &convoys); err != nil {
 		return fmt.Errorf("parsing convoy data: %w", err)
 	}
 
@@ -1257,10 +1247,7 @@ func runConvoyLand(cmd *cobra.Command, args []string) error {
 	// Phase 2: Close the convoy
 	reason := "Landed by owner"
 	closeArgs := []string{"close", convoyID, "-r", reason}
-	closeCmd := exec.Command("bd", closeArgs...)
-	closeCmd.Dir = townBeads
-
-	if err := closeCmd.Run(); err != nil {
+	if err := BdCmd(closeArgs...).Dir(townBeads).Stderr(io.Discard).Run(); err != nil {
 		return fmt.Errorf("closing convoy: %w", err)
 	}
 
@@ -1483,8 +1470,21 @@ func findStrandedConvoys(townBeads string) ([]strandedConvoyInfo, error) {
 		return nil, fmt.Errorf("parsing convoy list: %w", err)
 	}
 
+	// Convoys waiting on an unfinished convoy (gt convoy depend) are held
+	// on purpose, not stranded.
+	var waiting map[string][]string
+	if deps, err := listConvoyDependencies(townBeads); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠ Warning: %v\n", err)
+	} else {
+		waiting = waitingConvoys(deps)
+	}
+
 	// Check each convoy for stranded state
 	for _, convoy := range convoys {
+		if len(waiting[convoy.ID]) > 0 {
+			continue
+		}
+
 		// Extract base_branch from convoy description fields
 		var baseBranch string
 		if cf := beads.ParseConvoyFields(&beads.Issue{Description: convoy.Description}); cf != nil {
@@ -1680,19 +1680,15 @@ func checkAndCloseCompletedConvoys(townBeads string, dryRun bool) ([]struct{ ID,
 func notifyConvoyCompletion(townBeads, convoyID, title string) {
 	// Get convoy description to find owner and notify addresses
 	showArgs := []string{"show", convoyID, "--json"}
-	showCmd := exec.Command("bd", showArgs...)
-	showCmd.Dir = townBeads
-	var stdout bytes.Buffer
-	showCmd.Stdout = &stdout
-
-	if err := showCmd.Run(); err != nil {
+	stdout, err := BdCmd(showArgs...).Dir(townBeads).Stderr(io.Discard).Output()
+	if err != nil {
 		return
 	}
 
 	var convoys []struct {
 		Description string `json:"description"`
 	}
-	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil || len(convoys) == 0 {
+	if err := json.Unmarshal(stdout, &convoys); err != nil || len(convoys) == 0 {
 		return
 	}
 
@@ -1740,6 +1736,20 @@ func notifyMayorSession(townBeads, convoyID, title string) {
 	}
 }
 
+// convoyStatusOutput is the --json output of gt convoy status.
+type convoyStatusOutput struct {
+	ID            string             `json:"id"`
+	Title         string             `json:"title"`
+	Status        string             `json:"status"`
+	Owned         bool               `json:"owned"`
+	Lifecycle     string             `json:"lifecycle"`
+	MergeStrategy string             `json:"merge_strategy,omitempty"`
+	WaitsOn       []string           `json:"waits_on,omitempty"`
+	Tracked       []trackedIssueInfo `json:"tracked"`
+	Completed     int                `json:"completed"`
+	Total         int                `json:"total"`
+}
+
 func runConvoyStatus(cmd *cobra.Command, args []string) error {
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
@@ -1796,6 +1806,12 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("getting tracked issues for %s: %w", convoyID, err)
 	}
+	attachLatestNotes(townBeads, tracked)
+
+	var waitsOn []string
+	if deps, err := listConvoyDependencies(townBeads); err == nil {
+		waitsOn = waitingConvoys(deps)[convoyID]
+	}
 
 	// Count completed
 	completed := 0
@@ -1810,24 +1826,14 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 		if isOwned {
 			lifecycle = "caller-managed"
 		}
-		type jsonStatus struct {
-			ID            string             `json:"id"`
-			Title         string             `json:"title"`
-			Status        string             `json:"status"`
-			Owned         bool               `json:"owned"`
-			Lifecycle     string             `json:"lifecycle"`
-			MergeStrategy string             `json:"merge_strategy,omitempty"`
-			Tracked       []trackedIssueInfo `json:"tracked"`
-			Completed     int                `json:"completed"`
-			Total         int                `json:"total"`
-		}
-		out := jsonStatus{
+		out := convoyStatusOutput{
 			ID:            convoy.ID,
 			Title:         convoy.Title,
 			Status:        convoy.Status,
 			Owned:         isOwned,
 			Lifecycle:     lifecycle,
 			MergeStrategy: convoyMergeFromFields(convoy.Description),
+			WaitsOn:       waitsOn,
 			Tracked:       tracked,
 			Completed:     completed,
 			Total:         len(tracked),
@@ -1850,6 +1856,9 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 	if merge != "" {
 		fmt.Printf("  Merge:     %s\n", merge)
 	}
+	if len(waitsOn) > 0 {
+		fmt.Printf("  Waits on:  %s\n", style.Warning.Render(strings.Join(waitsOn, ", ")))
+	}
 	fmt.Printf("  Progress:  %d/%d completed\n", completed, len(tracked))
 	fmt.Printf("  Created:   %s\n", convoy.CreatedAt)
 	if convoy.ClosedAt != "" {
@@ -1886,6 +1895,9 @@ func runConvoyStatus(cmd *cobra.Command, args []string) error {
 				line += fmt.Sprintf("  %s", style.Dim.Render(workerDisplay))
 			}
 			fmt.Println(line)
+			if t.LatestNote != "" && t.Status != "closed" {
+				fmt.Printf("        %s\n", style.Dim.Render(beadNoteCommentPrefix+t.LatestNote))
+			}
 		}
 	}
 
@@ -1939,6 +1951,18 @@ func showAllConvoyStatus(townBeads string) error {
 	return nil
 }
 
+// convoyListEntry is one convoy in the --json output of gt convoy list,
+// enriched with its tracked issues and completion counts.
+type convoyListEntry struct {
+	ID        string             `json:"id"`
+	Title     string             `json:"title"`
+	Status    string             `json:"status"`
+	CreatedAt string             `json:"created_at"`
+	Tracked   []trackedIssueInfo `json:"tracked"`
+	Completed int                `json:"completed"`
+	Total     int                `json:"total"`
+}
+
 func runConvoyList(cmd *cobra.Command, args []string) error {
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
@@ -1959,28 +1983,18 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("listing convoys: %w", err)
 	}
 
-	var convoys []struct {
-		ID        string   `json:"id"`
-		Title     string   `json:"title"`
-		Status    string   `json:"status"`
-		CreatedAt string   `json:"created_at"`
-		Labels    []string `json:"labels"`
-	}
-	if err := json.Unmarshal(out, &convoys); err != nil {
+	var all []convoyListItem
+	if err := json.Unmarshal(out, &all); err != nil {
 		return fmt.Errorf("parsing convoy list: %w", err)
 	}
+	page, err := convoyListing.apply(all, convoyListPage)
+	if err != nil {
+		return err
+	}
+	convoys := page.Items
 
 	if convoyListJSON {
 		// Enrich each convoy with tracked issues and completion counts
-		type convoyListEntry struct {
-			ID        string             `json:"id"`
-			Title     string             `json:"title"`
-			Status    string             `json:"status"`
-			CreatedAt string             `json:"created_at"`
-			Tracked   []trackedIssueInfo `json:"tracked"`
-			Completed int                `json:"completed"`
-			Total     int                `json:"total"`
-		}
 		enriched := make([]convoyListEntry, 0, len(convoys))
 		for _, c := range convoys {
 			tracked, err := getTrackedIssues(townBeads, c.ID)
@@ -2007,12 +2021,10 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 				Total:     len(tracked),
 			})
 		}
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(enriched)
+		return printPageJSON(page, enriched)
 	}
 
-	if len(convoys) == 0 {
+	if len(all) == 0 {
 		fmt.Println("No convoys found.")
 		fmt.Println("Create a convoy with: gt convoy create <name> [issues...]")
 		return nil
@@ -2020,9 +2032,16 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 
 	// Tree view: show convoys with their child issues
 	if convoyListTree {
-		return printConvoyTree(townBeads, convoys)
+		if err := printConvoyTree(townBeads, convoys); err != nil {
+			return err
+		}
+		printPageFooter(page)
+		return nil
 	}
 
+	// Numbers match gt convoy status <n>, which counts in the default order.
+	numbered := convoyListPage.Sort == "" || convoyListPage.Sort == convoyListing.defaultSort
+
 	fmt.Printf("%s\n\n", style.Bold.Render("Convoys"))
 	for i, c := range convoys {
 		status := formatConvoyStatus(c.Status)
@@ -2030,21 +2049,50 @@ func runConvoyList(cmd *cobra.Command, args []string) error {
 		if hasLabel(c.Labels, "gt:owned") {
 			ownedTag = " " + style.Warning.Render("[owned]")
 		}
-		fmt.Printf("  %d. 🚚 %s: %s %s%s\n", i+1, c.ID, c.Title, status, ownedTag)
+		if numbered {
+			fmt.Printf("  %d. 🚚 %s: %s %s%s\n", page.Start+i+1, c.ID, c.Title, status, ownedTag)
+		} else {
+			fmt.Printf("  🚚 %s: %s %s%s\n", c.ID, c.Title, status, ownedTag)
+		}
+	}
+	printPageFooter(page)
+	if numbered {
+		fmt.Printf("\nUse 'gt convoy status <id>' or 'gt convoy status <n>' for detailed view.\n")
+	} else {
+		fmt.Printf("\nUse 'gt convoy status <id>' for detailed view.\n")
 	}
-	fmt.Printf("\nUse 'gt convoy status <id>' or 'gt convoy status <n>' for detailed view.\n")
 
 	return nil
 }
 
-// printConvoyTree displays convoys with their child issues in a tree format.
-func printConvoyTree(townBeads string, convoys []struct {
+// convoyListItem is a convoy as listed by bd.
+type convoyListItem struct {
 	ID        string   `json:"id"`
 	Title     string   `json:"title"`
 	Status    string   `json:"status"`
 	CreatedAt string   `json:"created_at"`
 	Labels    []string `json:"labels"`
-}) error {
+}
+
+// convoyListing sorts gt convoy list, oldest first.
+var convoyListing = listing[convoyListItem]{
+	fields: []listField[convoyListItem]{
+		{"created", func(c convoyListItem) any {
+			if t, err := time.Parse(time.RFC3339, c.CreatedAt); err == nil {
+				return t
+			}
+			return c.CreatedAt
+		}},
+		{"id", func(c convoyListItem) any { return c.ID }},
+		{"title", func(c convoyListItem) any { return c.Title }},
+		{"status", func(c convoyListItem) any { return c.Status }},
+	},
+	defaultSort: "created",
+	id:          func(c convoyListItem) string { return c.ID },
+}
+
+// printConvoyTree displays convoys with their child issues in a tree format.
+func printConvoyTree(townBeads string, convoys []convoyListItem) error {
 	for _, c := range convoys {
 		// Get tracked issues for this convoy
 		tracked, err := getTrackedIssues(townBeads, c.ID)
@@ -2145,16 +2193,17 @@ func formatConvoyStatus(status string) string {
 
 // trackedIssueInfo holds info about an issue being tracked by a convoy.
 type trackedIssueInfo struct {
-	ID        string   `json:"id"`
-	Title     string   `json:"title"`
-	Status    string   `json:"status"`
-	Type      string   `json:"dependency_type"`
-	IssueType string   `json:"issue_type"`
-	Blocked   bool     `json:"blocked,omitempty"`    // True if issue currently has blockers
-	Assignee  string   `json:"assignee,omitempty"`   // Assigned agent (e.g., gastown/polecats/goose)
-	Labels    []string `json:"labels,omitempty"`     // Bead labels (propagated from trackedDependency)
-	Worker    string   `json:"worker,omitempty"`     // Worker currently assigned (e.g., gastown/nux)
-	WorkerAge string   `json:"worker_age,omitempty"` // How long worker has been on this issue
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	Status     string   `json:"status"`
+	Type       string   `json:"dependency_type"`
+	IssueType  string   `json:"issue_type"`
+	Blocked    bool     `json:"blocked,omitempty"`     // True if issue currently has blockers
+	Assignee   string   `json:"assignee,omitempty"`    // Assigned agent (e.g., gastown/polecats/goose)
+	Labels     []string `json:"labels,omitempty"`      // Bead labels (propagated from trackedDependency)
+	Worker     string   `json:"worker,omitempty"`      // Worker currently assigned (e.g., gastown/nux)
+	WorkerAge  string   `json:"worker_age,omitempty"`  // How long worker has been on this issue
+	LatestNote string   `json:"latest_note,omitempty"` // Most recent gt bead note
 }
 
 // trackedDependency is dep-list data enriched with fresh issue details.
@@ -2386,20 +2435,19 @@ func getExternalIssueDetails(townBeads, rigName, issueID string) *issueDetails {
 
 	// Query the rig database by running bd show from the rig directory
 	showArgs := beads.MaybePrependAllowStale([]string{"show", issueID, "--json"})
-	showCmd := exec.Command("bd", showArgs...)
-	showCmd.Dir = rigDir // Set working directory to rig directory
-	var stdout bytes.Buffer
-	showCmd.Stdout = &stdout
-
-	if err := showCmd.Run(); err != nil {
+	stdout, err := BdCmd(showArgs...).
+		Dir(rigDir). // Run from the rig directory
+		Stderr(io.Discard).
+		Output()
+	if err != nil {
 		return nil
 	}
-	if stdout.Len() == 0 {
+	if len(stdout) == 0 {
 		return nil
 	}
 
 	var issues []issueDetailsJSON
-	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+	if err := json.Unmarshal(stdout, &issues); err != nil {
 		return nil
 	}
 	if len(issues) == 0 {
@@ -2452,15 +2500,12 @@ func getIssueDetailsBatch(issueIDs []string) map[string]*issueDetails {
 	// Run from town root so bd's prefix routing (routes.jsonl) can dispatch
 	// to the correct rig database for cross-rig bead lookups. (GH#2960)
 	townRoot, _ := workspace.FindFromCwdOrError()
-	showCmd := exec.Command("bd", args...)
+	showCmd := BdCmd(args...).Stderr(io.Discard)
 	if townRoot != "" {
-		showCmd.Dir = townRoot
-		showCmd.Env = stripEnvKey(os.Environ(), "BEADS_DIR")
+		showCmd = showCmd.Dir(townRoot).StripBeadsDir()
 	}
-	var stdout bytes.Buffer
-	showCmd.Stdout = &stdout
-
-	if err := showCmd.Run(); err != nil {
+	stdout, err := showCmd.Output()
+	if err != nil {
 		// Batch failed - fall back to individual lookups for robustness
 		// This handles cases where some IDs are invalid/missing
 		for _, id := range issueIDs {
@@ -2472,7 +2517,7 @@ func getIssueDetailsBatch(issueIDs []string) map[string]*issueDetails {
 	}
 
 	var issues []issueDetailsJSON
-	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+	if err := json.Unmarshal(stdout, &issues); err != nil {
 		return result
 	}
 
@@ -2491,24 +2536,21 @@ func getIssueDetails(issueID string) *issueDetails {
 	// Without Dir + StripBeadsDir, bd inherits CWD/BEADS_DIR which may
 	// point to a rig that doesn't contain the target bead. (GH#2960)
 	townRoot, _ := workspace.FindFromCwdOrError()
-	showCmd := exec.Command("bd", "show", issueID, "--json")
+	showCmd := BdCmd("show", issueID, "--json").Stderr(io.Discard)
 	if townRoot != "" {
-		showCmd.Dir = townRoot
-		showCmd.Env = stripEnvKey(os.Environ(), "BEADS_DIR")
+		showCmd = showCmd.Dir(townRoot).StripBeadsDir()
 	}
-	var stdout bytes.Buffer
-	showCmd.Stdout = &stdout
-
-	if err := showCmd.Run(); err != nil {
+	stdout, err := showCmd.Output()
+	if err != nil {
 		return nil
 	}
 	// Handle bd exit 0 bug: empty stdout means not found
-	if stdout.Len() == 0 {
+	if len(stdout) == 0 {
 		return nil
 	}
 
 	var issues []issueDetailsJSON
-	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil || len(issues) == 0 {
+	if err := json.Unmarshal(stdout, &issues); err != nil || len(issues) == 0 {
 		return nil
 	}
 
@@ -2576,17 +2618,17 @@ func getWorkersForIssues(issueIDs []string) map[string]*workerInfo {
 		go func(beadsDir string) {
 			defer wg.Done()
 
-			cmd := exec.Command("bd", "list", "--label=gt:agent", "--status=open", "--json", "--limit=0")
-			cmd.Dir = beadsDir
-			var stdout bytes.Buffer
-			cmd.Stdout = &stdout
-			if err := cmd.Run(); err != nil {
+			stdout, err := BdCmd("list", "--label=gt:agent", "--status=open", "--json", "--limit=0").
+				Dir(beadsDir).
+				Stderr(io.Discard).
+				Output()
+			if err != nil {
 				resultChan <- rigResult{}
 				return
 			}
 
 			var rr rigResult
-			if err := json.Unmarshal(stdout.Bytes(), &rr.agents); err != nil {
+			if err := json.Unmarshal(stdout, &rr.agents); err != nil {
 				resultChan <- rigResult{}
 				return
 			}
@@ -2623,7 +2665,7 @@ func getWorkersForIssues(issueIDs []string) map[string]*workerInfo {
 			age := ""
 			if agent.LastActivity != "" {
 				if t, err := time.Parse(time.RFC3339, agent.LastActivity); err == nil {
-					age = formatWorkerAge(time.Since(t))
+					age = humanize.Short(time.Since(t))
 				}
 			}
 
@@ -2660,20 +2702,6 @@ func parseWorkerFromAgentBead(agentID string) string {
 	return rig + "/" + role
 }
 
-// formatWorkerAge formats a duration as a short string (e.g., "5m", "2h", "1d")
-func formatWorkerAge(d time.Duration) string {
-	if d < time.Minute {
-		return "<1m"
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd", int(d.Hours()/24))
-}
-
 // runConvoyTUI launches the interactive convoy TUI.
 func runConvoyTUI() error {
 	townBeads, err := getTownBeadsDir()
@@ -2696,12 +2724,15 @@ func resolveConvoyNumber(townBeads string, n int) (string, error) {
 		return "", fmt.Errorf("listing convoys: %w", err)
 	}
 
-	var convoys []struct {
-		ID string `json:"id"`
-	}
-	if err := json.Unmarshal(out, &convoys); err != nil {
+	var all []convoyListItem
+	if err := json.Unmarshal(out, &all); err != nil {
 		return "", fmt.Errorf("parsing convoy list: %w", err)
 	}
+	page, err := convoyListing.apply(all, listFlags{})
+	if err != nil {
+		return "", err
+	}
+	convoys := page.Items
 
 	if n < 1 || n > len(convoys) {
 		return "", fmt.Errorf("convoy %d not found (have %d convoys)", n, len(convoys))
@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var convoyDependRemove bool
+
+var convoyDependCmd = &cobra.Command{
+	Use:   "depend <convoy-id> [depends-on-convoy-id]",
+	Short: "Make a convoy wait for another convoy to complete",
+	Long: `Chain convoys into phases: the convoy's issues are not dispatched until
+the convoy it depends on has closed.
+
+The dependency is a 'blocks' dependency between the two convoy beads. It is
+enforced when work becomes dispatchable, so the issues in the later convoy
+need no bead dependencies of their own:
+  - the scheduler leaves their scheduled beads queued
+  - convoy feeding and 'gt convoy stranded' skip the convoy
+  - the stale sweep leaves it open while it waits
+
+When the earlier convoy lands, the later one is fed straight away.
+Dispatching a bead directly with gt sling is not affected.
+
+With only a convoy ID, lists what the convoy waits on.
+
+Examples:
+  gt convoy depend hq-cv-phase2 hq-cv-phase1            # phase2 waits for phase1
+  gt convoy depend hq-cv-phase2                         # show what phase2 waits on
+  gt convoy depend hq-cv-phase2 hq-cv-phase1 --remove   # drop the dependency`,
+	Args:         cobra.RangeArgs(1, 2),
+	SilenceUsage: true,
+	RunE:         runConvoyDepend,
+}
+
+func init() {
+	convoyDependCmd.Flags().BoolVar(&convoyDependRemove, "remove", false, "Remove the dependency instead of adding it")
+	convoyCmd.AddCommand(convoyDependCmd)
+}
+
+// convoyDependency is a 'blocks' dependency between two convoys: Convoy
+// waits for DependsOn to close.
+type convoyDependency struct {
+	Convoy          string
+	DependsOn       string
+	DependsOnStatus string
+}
+
+// done reports whether the convoy being waited on has finished.
+func (d convoyDependency) done() bool {
+	return d.DependsOnStatus == "closed" || d.DependsOnStatus == "tombstone"
+}
+
+func runConvoyDepend(cmd *cobra.Command, args []string) error {
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+	convoyID := args[0]
+	if _, err := showConvoyBead(townBeads, convoyID); err != nil {
+		return err
+	}
+	deps, err := listConvoyDependencies(townBeads)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		if convoyDependRemove {
+			return fmt.Errorf("--remove needs the convoy to stop waiting on")
+		}
+		printConvoyWaits(convoyID, deps)
+		return nil
+	}
+
+	onID := args[1]
+	if onID == convoyID {
+		return fmt.Errorf("a convoy can't depend on itself")
+	}
+	on, err := showConvoyBead(townBeads, onID)
+	if err != nil {
+		return err
+	}
+
+	if convoyDependRemove {
+		if err := BdCmd("dep", "remove", convoyID, onID).
+			Dir(townBeads).
+			WithAutoCommit().
+			Run(); err != nil {
+			return fmt.Errorf("removing dependency: %w", err)
+		}
+		fmt.Printf("%s 🚚 %s no longer waits on %s\n", style.Bold.Render("✓"), convoyID, onID)
+		return nil
+	}
+
+	for _, d := range deps {
+		if d.Convoy == convoyID && d.DependsOn == onID {
+			fmt.Printf("%s 🚚 %s already waits on %s\n", style.Dim.Render("○"), convoyID, onID)
+			return nil
+		}
+	}
+	if convoyReaches(deps, onID, convoyID) {
+		return fmt.Errorf("%s already waits (directly or indirectly) on %s; the dependency would be a cycle", onID, convoyID)
+	}
+
+	if out, err := BdCmd("dep", "add", convoyID, onID, "--type=blocks").
+		Dir(townBeads).
+		WithAutoCommit().
+		CombinedOutput(); err != nil {
+		return fmt.Errorf("adding dependency: %w\noutput: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	fmt.Printf("%s 🚚 %s now waits on %s: %s\n", style.Bold.Render("✓"), convoyID, onID, on.Title)
+	if on.Status == "closed" {
+		fmt.Printf("  %s\n", style.Dim.Render(onID+" is already closed, so nothing is held"))
+	} else {
+		fmt.Printf("  %s\n", style.Dim.Render("Its issues are held from dispatch until "+onID+" closes"))
+	}
+	return nil
+}
+
+// printConvoyWaits lists the convoys convoyID depends on.
+func printConvoyWaits(convoyID string, deps []convoyDependency) {
+	var waits []convoyDependency
+	for _, d := range deps {
+		if d.Convoy == convoyID {
+			waits = append(waits, d)
+		}
+	}
+	if len(waits) == 0 {
+		fmt.Printf("🚚 %s does not depend on other convoys.\n", convoyID)
+		return
+	}
+	fmt.Printf("🚚 %s waits on:\n", convoyID)
+	for _, d := range waits {
+		mark := style.Warning.Render("○")
+		if d.done() {
+			mark = style.Bold.Render("✓")
+		}
+		fmt.Printf("  %s %s (%s)\n", mark, d.DependsOn, d.DependsOnStatus)
+	}
+}
+
+// showConvoyBead looks up a convoy in town beads, failing if the bead is
+// missing or is not a convoy.
+func showConvoyBead(townBeads, convoyID string) (*bdShowResult, error) {
+	out, err := runBdJSON(townBeads, "show", convoyID, "--json")
+	if err != nil {
+		return nil, fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+	var results []bdShowResult
+	if err := json.Unmarshal(out, &results); err != nil {
+		return nil, fmt.Errorf("parsing convoy data: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+	if results[0].IssueType != "convoy" {
+		return nil, fmt.Errorf("'%s' is not a convoy (type: %s)", convoyID, results[0].IssueType)
+	}
+	return &results[0], nil
+}
+
+// convoyDependencyQuery lists convoy-to-convoy 'blocks' dependencies of
+// convoys that are still open (or staged), with the blocker's status.
+const convoyDependencyQuery = `SELECT d.issue_id AS convoy, d.depends_on_id AS depends_on, p.status AS status ` +
+	`FROM dependencies d ` +
+	`JOIN issues c ON c.id = d.issue_id ` +
+	`JOIN issues p ON p.id = d.depends_on_id ` +
+	`WHERE d.type = 'blocks' AND c.issue_type = 'convoy' AND p.issue_type = 'convoy' AND c.status <> 'closed'`
+
+// listConvoyDependencies returns every dependency between unclosed convoys
+// and the convoys they wait on, in one query against town beads.
+func listConvoyDependencies(townBeads string) ([]convoyDependency, error) {
+	out, err := runBdJSON(townBeads, "sql", convoyDependencyQuery, "--json")
+	if err != nil {
+		return nil, fmt.Errorf("listing convoy dependencies: %w", err)
+	}
+	var rows []map[string]string
+	if err := json.Unmarshal(out, &rows); err != nil {
+		return nil, fmt.Errorf("parsing convoy dependencies: %w", err)
+	}
+	deps := make([]convoyDependency, 0, len(rows))
+	for _, row := range rows {
+		deps = append(deps, convoyDependency{
+			Convoy:          row["convoy"],
+			DependsOn:       row["depends_on"],
+			DependsOnStatus: row["status"],
+		})
+	}
+	return deps, nil
+}
+
+// waitingConvoys maps each convoy that waits on an unfinished convoy to the
+// convoys it waits on (sorted).
+func waitingConvoys(deps []convoyDependency) map[string][]string {
+	waiting := make(map[string][]string)
+	for _, d := range deps {
+		if !d.done() {
+			waiting[d.Convoy] = append(waiting[d.Convoy], d.DependsOn)
+		}
+	}
+	for _, on := range waiting {
+		sort.Strings(on)
+	}
+	return waiting
+}
+
+// convoyReaches reports whether from depends on to through any chain of
+// convoy dependencies, finished or not.
+func convoyReaches(deps []convoyDependency, from, to string) bool {
+	edges := make(map[string][]string)
+	for _, d := range deps {
+		edges[d.Convoy] = append(edges[d.Convoy], d.DependsOn)
+	}
+	seen := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == to {
+			return true
+		}
+		for _, next := range edges[id] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// gatedConvoyWork maps the issues tracked by convoys that wait on an
+// unfinished convoy to the convoy they wait on, so dispatch can hold them.
+// Returns nil when no convoy is waiting, the common case, after one query.
+func gatedConvoyWork(townBeads string) (map[string]string, error) {
+	deps, err := listConvoyDependencies(townBeads)
+	if err != nil {
+		return nil, err
+	}
+	waiting := waitingConvoys(deps)
+	if len(waiting) == 0 {
+		return nil, nil
+	}
+	gated := make(map[string]string)
+	for convoyID, on := range waiting {
+		tracked, err := bdDepListRawIDs(townBeads, convoyID, "down", "tracks")
+		if err != nil {
+			return nil, fmt.Errorf("listing issues of waiting convoy %s: %w", convoyID, err)
+		}
+		for _, id := range tracked {
+			gated[id] = on[0]
+		}
+	}
+	return gated, nil
+}
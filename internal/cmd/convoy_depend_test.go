@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWaitingConvoys(t *testing.T) {
+	deps := []convoyDependency{
+		{Convoy: "hq-cv-3", DependsOn: "hq-cv-2", DependsOnStatus: "open"},
+		{Convoy: "hq-cv-3", DependsOn: "hq-cv-1", DependsOnStatus: "staged_ready"},
+		{Convoy: "hq-cv-2", DependsOn: "hq-cv-1", DependsOnStatus: "closed"},
+		{Convoy: "hq-cv-4", DependsOn: "hq-cv-0", DependsOnStatus: "tombstone"},
+	}
+	got := waitingConvoys(deps)
+	want := map[string][]string{"hq-cv-3": {"hq-cv-1", "hq-cv-2"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("waitingConvoys() = %v, want %v", got, want)
+	}
+
+	if got := waitingConvoys(nil); len(got) != 0 {
+		t.Errorf("waitingConvoys(nil) = %v, want empty", got)
+	}
+}
+
+func TestConvoyReaches(t *testing.T) {
+	// phase3 → phase2 → phase1, plus a closed edge that still counts.
+	deps := []convoyDependency{
+		{Convoy: "hq-cv-p3", DependsOn: "hq-cv-p2", DependsOnStatus: "open"},
+		{Convoy: "hq-cv-p2", DependsOn: "hq-cv-p1", DependsOnStatus: "closed"},
+	}
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"hq-cv-p3", "hq-cv-p2", true},
+		{"hq-cv-p3", "hq-cv-p1", true},
+		{"hq-cv-p1", "hq-cv-p3", false},
+		{"hq-cv-p2", "hq-cv-p3", false},
+		{"hq-cv-x", "hq-cv-p1", false},
+	}
+	for _, tt := range tests {
+		if got := convoyReaches(deps, tt.from, tt.to); got != tt.want {
+			t.Errorf("convoyReaches(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -94,7 +95,7 @@ func bdUpdateStatus(beadID, status string) error {
 	if err != nil {
 		return err
 	}
-	cmd := exec.Command("bd", "update", beadID, "--status="+status)
+	cmd := beads.Command("update", beadID, "--status="+status)
 	cmd.Dir = townBeads
 	if out, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("bd update %s --status=%s: %w\noutput: %s", beadID, status, err, out)
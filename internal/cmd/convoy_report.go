@@ -0,0 +1,567 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/artifacts"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	convoyReportFormat string
+	convoyReportOutput string
+)
+
+var convoyReportCmd = &cobra.Command{
+	Use:   "report <convoy-id>",
+	Short: "Generate a post-mortem report for a convoy",
+	Long: `Assemble a post-mortem report for a convoy, suitable for stakeholder
+updates and retros.
+
+The report covers:
+  - Beads completed, with time from creation to close
+  - Branches merged by the refinery (and merge failures)
+  - Cost per bead, computed from archived polecat transcripts
+  - Dispatch failures and re-slings (retries)
+  - Notable agent decisions extracted from archived transcripts
+  - Links to result artifacts saved for each bead (see 'gt bead artifacts')
+
+Transcript-derived sections require transcripts archived by gt done
+(see 'gt bead transcript').
+
+Examples:
+  gt convoy report hq-cv-abc                    # Markdown to stdout
+  gt convoy report hq-cv-abc --format html -o report.html
+  gt convoy report 1                            # Numeric shortcut`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConvoyReport,
+}
+
+func init() {
+	convoyReportCmd.Flags().StringVar(&convoyReportFormat, "format", "markdown", "Output format: markdown or html")
+	convoyReportCmd.Flags().StringVarP(&convoyReportOutput, "output", "o", "", "Write the report to a file instead of stdout")
+	convoyCmd.AddCommand(convoyReportCmd)
+}
+
+// maxReportDecisions caps the decisions quoted per bead to keep reports skimmable.
+const maxReportDecisions = 3
+
+// decisionMarkers are phrases that tend to introduce an agent's judgment call.
+var decisionMarkers = []string{
+	"i decided", "i'll go with", "i chose", "decided to", "instead of",
+	"trade-off", "tradeoff", "rather than", "opted to",
+}
+
+// convoyReport is the assembled post-mortem for a convoy.
+type convoyReport struct {
+	ID          string
+	Title       string
+	Status      string
+	CreatedAt   string
+	ClosedAt    string
+	Beads       []convoyReportBead
+	Completed   int
+	TotalCost   float64
+	Failures    int
+	Retries     int
+	GeneratedAt time.Time
+}
+
+// convoyReportBead is the per-bead section of a convoy report.
+type convoyReportBead struct {
+	ID            string
+	Title         string
+	Status        string
+	Assignee      string
+	Duration      time.Duration
+	Cost          float64
+	Branches      []string
+	Merged        []string
+	MergeFailed   []string
+	Failures      []string
+	Retries       int
+	Decisions     []string
+	HasTranscript bool
+
+	// ArtifactDir holds the bead's Artifacts (gt bead artifacts).
+	ArtifactDir string
+	Artifacts   []artifacts.Artifact
+}
+
+// beadTimes holds the timestamps used to compute bead durations.
+type beadTimes struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"created_at"`
+	ClosedAt  string `json:"closed_at,omitempty"`
+}
+
+// beadEventHistory summarises the events.jsonl activity for one bead.
+type beadEventHistory struct {
+	slings      int
+	failures    []string
+	branches    []string
+	merged      []string
+	mergeFailed []string
+}
+
+func runConvoyReport(cmd *cobra.Command, args []string) error {
+	if convoyReportFormat != "markdown" && convoyReportFormat != "html" {
+		return fmt.Errorf("invalid --format %q (want markdown or html)", convoyReportFormat)
+	}
+
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	convoyID := args[0]
+	if n, err := strconv.Atoi(convoyID); err == nil && n > 0 {
+		resolved, err := resolveConvoyNumber(townBeads, n)
+		if err != nil {
+			return err
+		}
+		convoyID = resolved
+	}
+
+	showOut, err := runBdJSON(townBeads, "show", convoyID, "--json")
+	if err != nil {
+		return fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+	var convoys []struct {
+		ID        string `json:"id"`
+		Title     string `json:"title"`
+		Status    string `json:"status"`
+		CreatedAt string `json:"created_at"`
+		ClosedAt  string `json:"closed_at,omitempty"`
+	}
+	if err := json.Unmarshal(showOut, &convoys); err != nil {
+		return fmt.Errorf("parsing convoy data: %w", err)
+	}
+	if len(convoys) == 0 {
+		return fmt.Errorf("convoy '%s' not found", convoyID)
+	}
+	convoy := convoys[0]
+
+	tracked, err := getTrackedIssues(townBeads, convoyID)
+	if err != nil {
+		return fmt.Errorf("getting tracked issues for %s: %w", convoyID, err)
+	}
+
+	ids := make([]string, 0, len(tracked))
+	for _, t := range tracked {
+		ids = append(ids, t.ID)
+	}
+	times := fetchBeadTimes(townRoot, ids)
+	history := collectBeadEventHistory(townRoot, ids)
+
+	report := &convoyReport{
+		ID:          convoy.ID,
+		Title:       convoy.Title,
+		Status:      convoy.Status,
+		CreatedAt:   convoy.CreatedAt,
+		ClosedAt:    convoy.ClosedAt,
+		GeneratedAt: time.Now(),
+	}
+	for _, t := range tracked {
+		b := convoyReportBead{
+			ID:       t.ID,
+			Title:    t.Title,
+			Status:   t.Status,
+			Assignee: t.Assignee,
+		}
+		if bt, ok := times[t.ID]; ok && bt.ClosedAt != "" {
+			created := parseBeadsTimestamp(bt.CreatedAt)
+			closed := parseBeadsTimestamp(bt.ClosedAt)
+			if !created.IsZero() && closed.After(created) {
+				b.Duration = closed.Sub(created)
+			}
+		}
+		if h := history[t.ID]; h != nil {
+			b.Branches = h.branches
+			b.Merged = h.merged
+			b.MergeFailed = h.mergeFailed
+			b.Failures = h.failures
+			if h.slings > 1 {
+				b.Retries = h.slings - 1
+			}
+		}
+		b.Cost, b.Decisions, b.HasTranscript = summariseArchivedTranscripts(townRoot, t.ID)
+		if list, err := artifacts.List(townRoot, t.ID); err == nil && len(list) > 0 {
+			b.ArtifactDir, b.Artifacts = artifacts.Dir(townRoot, t.ID), list
+		}
+
+		if b.Status == "closed" {
+			report.Completed++
+		}
+		report.TotalCost += b.Cost
+		report.Failures += len(b.Failures) + len(b.MergeFailed)
+		report.Retries += b.Retries
+		report.Beads = append(report.Beads, b)
+	}
+
+	var out bytes.Buffer
+	if convoyReportFormat == "html" {
+		if err := renderConvoyReportHTML(&out, report); err != nil {
+			return err
+		}
+	} else {
+		renderConvoyReportMarkdown(&out, report)
+	}
+
+	if convoyReportOutput == "" {
+		_, err := os.Stdout.Write(out.Bytes())
+		return err
+	}
+	if err := os.WriteFile(convoyReportOutput, out.Bytes(), 0644); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	fmt.Printf("%s Report written to %s\n", style.Bold.Render("✓"), convoyReportOutput)
+	return nil
+}
+
+// fetchBeadTimes batch-fetches created/closed timestamps for beads, running
+// from the town root so prefix routing reaches every rig database.
+func fetchBeadTimes(townRoot string, ids []string) map[string]beadTimes {
+	result := make(map[string]beadTimes)
+	if len(ids) == 0 {
+		return result
+	}
+	args := append([]string{"show"}, ids...)
+	args = append(args, "--json")
+	showCmd := beads.Command(args...)
+	showCmd.Dir = townRoot
+	showCmd.Env = stripEnvKey(os.Environ(), "BEADS_DIR")
+	var stdout bytes.Buffer
+	showCmd.Stdout = &stdout
+	if err := showCmd.Run(); err != nil {
+		return result
+	}
+	var issues []beadTimes
+	if err := json.Unmarshal(stdout.Bytes(), &issues); err != nil {
+		return result
+	}
+	for _, issue := range issues {
+		result[issue.ID] = issue
+	}
+	return result
+}
+
+// collectBeadEventHistory scans the town events log, rotated segments
+// included, for slings, dispatch failures, completions and merges involving
+// the given beads. Merge events carry a branch rather than a bead, so they
+// are matched via the branch recorded on each bead's done event.
+func collectBeadEventHistory(townRoot string, ids []string) map[string]*beadEventHistory {
+	result := make(map[string]*beadEventHistory)
+	for _, id := range ids {
+		result[id] = &beadEventHistory{}
+	}
+
+	branchToBead := make(map[string]string)
+	var merges []events.Event
+
+	_ = events.ReadRange(townRoot, time.Time{}, time.Time{}, func(e events.Event) bool {
+		if e.Type == events.TypeMerged || e.Type == events.TypeMergeFailed {
+			merges = append(merges, e)
+			return true
+		}
+		beadID, _ := e.Payload["bead"].(string)
+		h := result[beadID]
+		if h == nil {
+			return true
+		}
+		switch e.Type {
+		case events.TypeSling:
+			h.slings++
+		case events.TypeSchedulerDispatchFailed:
+			msg, _ := e.Payload["error"].(string)
+			h.failures = append(h.failures, msg)
+		case events.TypeDone:
+			if branch, _ := e.Payload["branch"].(string); branch != "" {
+				branchToBead[branch] = beadID
+				h.branches = appendUnique(h.branches, branch)
+			}
+		}
+		return true
+	})
+
+	for _, e := range merges {
+		branch, _ := e.Payload["branch"].(string)
+		h := result[branchToBead[branch]]
+		if h == nil {
+			continue
+		}
+		if e.Type == events.TypeMerged {
+			h.merged = appendUnique(h.merged, branch)
+		} else {
+			reason, _ := e.Payload["reason"].(string)
+			h.mergeFailed = append(h.mergeFailed, strings.TrimSpace(branch+": "+reason))
+		}
+	}
+	return result
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, existing := range list {
+		if existing == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// summariseArchivedTranscripts totals cost and extracts notable decisions from
+// every transcript archived for a bead.
+func summariseArchivedTranscripts(townRoot, beadID string) (float64, []string, bool) {
+	dir := transcriptArchiveDir(townRoot, beadID)
+	idx, err := loadTranscriptIndex(dir, beadID)
+	if err != nil || len(idx.Transcripts) == 0 {
+		return 0, nil, false
+	}
+	var cost float64
+	var decisions []string
+	for _, e := range idx.Transcripts {
+		path := filepath.Join(dir, e.File)
+		if usage, err := parseTranscriptUsage(path); err == nil {
+			cost += calculateCost(usage)
+		}
+		for _, d := range extractTranscriptDecisions(path) {
+			if len(decisions) >= maxReportDecisions {
+				break
+			}
+			decisions = append(decisions, d)
+		}
+	}
+	return cost, decisions, true
+}
+
+// extractTranscriptDecisions returns assistant sentences that read like a
+// judgment call (see decisionMarkers).
+func extractTranscriptDecisions(path string) []string {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var decisions []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 256*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var msg struct {
+			Type    string `json:"type"`
+			Message struct {
+				Content json.RawMessage `json:"content"`
+			} `json:"message"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil || msg.Type != "assistant" {
+			continue
+		}
+		for _, text := range transcriptTextBlocks(msg.Message.Content) {
+			for _, sentence := range splitSentences(text) {
+				if isDecisionSentence(sentence) {
+					decisions = append(decisions, sentence)
+				}
+			}
+		}
+	}
+	return decisions
+}
+
+// transcriptTextBlocks returns the text parts of a message content field,
+// which is either a plain string or a list of typed content blocks.
+func transcriptTextBlocks(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return []string{s}
+	}
+	var blocks []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(raw, &blocks); err != nil {
+		return nil
+	}
+	var texts []string
+	for _, b := range blocks {
+		if b.Type == "text" && b.Text != "" {
+			texts = append(texts, b.Text)
+		}
+	}
+	return texts
+}
+
+func splitSentences(text string) []string {
+	var sentences []string
+	for _, line := range strings.Split(text, "\n") {
+		for _, s := range strings.SplitAfter(line, ". ") {
+			if s = strings.TrimSpace(s); s != "" {
+				sentences = append(sentences, s)
+			}
+		}
+	}
+	return sentences
+}
+
+func isDecisionSentence(s string) bool {
+	lower := strings.ToLower(s)
+	for _, marker := range decisionMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatReportDuration(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return humanize.Duration(d.Round(time.Minute))
+}
+
+// renderConvoyReportMarkdown writes the report as Markdown.
+func renderConvoyReportMarkdown(w *bytes.Buffer, r *convoyReport) {
+	fmt.Fprintf(w, "# Convoy report: %s\n\n", r.Title)
+	fmt.Fprintf(w, "- **Convoy:** %s (%s)\n", r.ID, r.Status)
+	fmt.Fprintf(w, "- **Created:** %s\n", r.CreatedAt)
+	if r.ClosedAt != "" {
+		fmt.Fprintf(w, "- **Closed:** %s\n", r.ClosedAt)
+	}
+	fmt.Fprintf(w, "- **Completed:** %d/%d beads\n", r.Completed, len(r.Beads))
+	fmt.Fprintf(w, "- **Cost:** $%.2f\n", r.TotalCost)
+	fmt.Fprintf(w, "- **Failures:** %d, **Retries:** %d\n", r.Failures, r.Retries)
+	fmt.Fprintf(w, "- **Generated:** %s\n", r.GeneratedAt.Format(time.RFC3339))
+
+	fmt.Fprintf(w, "\n## Beads\n\n")
+	fmt.Fprintf(w, "| Bead | Title | Status | Duration | Cost | Merged |\n")
+	fmt.Fprintf(w, "|------|-------|--------|----------|------|--------|\n")
+	for _, b := range r.Beads {
+		cost := "-"
+		if b.HasTranscript {
+			cost = fmt.Sprintf("$%.2f", b.Cost)
+		}
+		merged := "-"
+		if len(b.Merged) > 0 {
+			merged = strings.Join(b.Merged, ", ")
+		}
+		fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s |\n",
+			b.ID, strings.ReplaceAll(b.Title, "|", "\\|"), b.Status,
+			formatReportDuration(b.Duration), cost, merged)
+	}
+
+	var troubled []convoyReportBead
+	for _, b := range r.Beads {
+		if len(b.Failures) > 0 || len(b.MergeFailed) > 0 || b.Retries > 0 {
+			troubled = append(troubled, b)
+		}
+	}
+	if len(troubled) > 0 {
+		fmt.Fprintf(w, "\n## Failures and retries\n\n")
+		for _, b := range troubled {
+			fmt.Fprintf(w, "- **%s**: %d retries\n", b.ID, b.Retries)
+			for _, f := range b.Failures {
+				fmt.Fprintf(w, "  - dispatch failed: %s\n", f)
+			}
+			for _, f := range b.MergeFailed {
+				fmt.Fprintf(w, "  - merge failed: %s\n", f)
+			}
+		}
+	}
+
+	var withArtifacts []convoyReportBead
+	for _, b := range r.Beads {
+		if len(b.Artifacts) > 0 {
+			withArtifacts = append(withArtifacts, b)
+		}
+	}
+	if len(withArtifacts) > 0 {
+		fmt.Fprintf(w, "\n## Artifacts\n\n")
+		for _, b := range withArtifacts {
+			fmt.Fprintf(w, "- **%s**:\n", b.ID)
+			for _, a := range b.Artifacts {
+				fmt.Fprintf(w, "  - [%s](%s) (%s)\n", a.Path, artifactLink(b.ArtifactDir, a), formatBytes(a.Size))
+			}
+		}
+	}
+
+	var decided []convoyReportBead
+	for _, b := range r.Beads {
+		if len(b.Decisions) > 0 {
+			decided = append(decided, b)
+		}
+	}
+	if len(decided) > 0 {
+		fmt.Fprintf(w, "\n## Notable decisions\n\n")
+		for _, b := range decided {
+			fmt.Fprintf(w, "### %s: %s\n\n", b.ID, b.Title)
+			for _, d := range b.Decisions {
+				fmt.Fprintf(w, "> %s\n\n", d)
+			}
+		}
+	}
+}
+
+var convoyReportHTMLTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"duration": formatReportDuration,
+	"join":     strings.Join,
+	"link":     artifactLink,
+	"bytes":    formatBytes,
+}).Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Convoy report: {{.Title}}</title>
+<style>body{font-family:sans-serif;max-width:60em;margin:2em auto}table{border-collapse:collapse}td,th{border:1px solid #ccc;padding:.3em .6em;text-align:left}blockquote{color:#555}</style>
+</head><body>
+<h1>Convoy report: {{.Title}}</h1>
+<ul>
+<li><b>Convoy:</b> {{.ID}} ({{.Status}})</li>
+<li><b>Created:</b> {{.CreatedAt}}</li>
+{{if .ClosedAt}}<li><b>Closed:</b> {{.ClosedAt}}</li>{{end}}
+<li><b>Completed:</b> {{.Completed}}/{{len .Beads}} beads</li>
+<li><b>Cost:</b> ${{printf "%.2f" .TotalCost}}</li>
+<li><b>Failures:</b> {{.Failures}}, <b>Retries:</b> {{.Retries}}</li>
+</ul>
+<h2>Beads</h2>
+<table><tr><th>Bead</th><th>Title</th><th>Status</th><th>Duration</th><th>Cost</th><th>Merged</th></tr>
+{{range .Beads}}<tr><td>{{.ID}}</td><td>{{.Title}}</td><td>{{.Status}}</td><td>{{duration .Duration}}</td><td>{{if .HasTranscript}}${{printf "%.2f" .Cost}}{{else}}-{{end}}</td><td>{{join .Merged ", "}}</td></tr>
+{{end}}</table>
+{{range .Beads}}{{if or .Failures .MergeFailed .Retries}}<h3>{{.ID}}: {{.Retries}} retries</h3><ul>
+{{range .Failures}}<li>dispatch failed: {{.}}</li>{{end}}{{range .MergeFailed}}<li>merge failed: {{.}}</li>{{end}}
+</ul>{{end}}{{end}}
+{{range $b := .Beads}}{{if .Artifacts}}<h3>Artifacts — {{.ID}}</h3><ul>
+{{range .Artifacts}}<li><a href="{{link $b.ArtifactDir .}}">{{.Path}}</a> ({{bytes .Size}})</li>{{end}}
+</ul>{{end}}{{end}}
+{{range .Beads}}{{if .Decisions}}<h3>Decisions — {{.ID}}: {{.Title}}</h3>
+{{range .Decisions}}<blockquote>{{.}}</blockquote>{{end}}{{end}}{{end}}
+</body></html>
+`))
+
+// artifactLink returns the link target for an artifact in dir: its absolute
+// path, slash-separated so it works as a URL path.
+func artifactLink(dir string, a artifacts.Artifact) string {
+	return filepath.ToSlash(filepath.Join(dir, filepath.FromSlash(a.Path)))
+}
+
+// renderConvoyReportHTML writes the report as a standalone HTML page.
+func renderConvoyReportHTML(w *bytes.Buffer, r *convoyReport) error {
+	return convoyReportHTMLTemplate.Execute(w, r)
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/artifacts"
+)
+
+func TestCollectBeadEventHistory(t *testing.T) {
+	townRoot := t.TempDir()
+	path := filepath.Join(townRoot, ".events.jsonl")
+	lines := []string{
+		`{"ts":"2026-03-01T12:00:00Z","type":"sling","payload":{"bead":"gt-a","target":"gastown"}}`,
+		`{"ts":"2026-03-01T12:00:00Z","type":"scheduler_dispatch_failed","payload":{"bead":"gt-a","rig":"gastown","error":"no capacity"}}`,
+		`{"ts":"2026-03-01T12:00:00Z","type":"sling","payload":{"bead":"gt-a","target":"gastown"}}`,
+		`{"ts":"2026-03-01T12:00:00Z","type":"done","payload":{"bead":"gt-a","branch":"polecat/toast/gt-a"}}`,
+		`{"ts":"2026-03-01T12:00:00Z","type":"merged","payload":{"mr":"gt-mr1","branch":"polecat/toast/gt-a"}}`,
+		`{"ts":"2026-03-01T12:00:00Z","type":"done","payload":{"bead":"gt-b","branch":"polecat/nux/gt-b"}}`,
+		`{"ts":"2026-03-01T12:00:00Z","type":"merge_failed","payload":{"mr":"gt-mr2","branch":"polecat/nux/gt-b","reason":"conflict"}}`,
+		`{"ts":"2026-03-01T12:00:00Z","type":"sling","payload":{"bead":"gt-other","target":"gastown"}}`,
+		`not json`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	history := collectBeadEventHistory(townRoot, []string{"gt-a", "gt-b"})
+
+	a := history["gt-a"]
+	if a.slings != 2 {
+		t.Errorf("gt-a slings = %d, want 2", a.slings)
+	}
+	if len(a.failures) != 1 || a.failures[0] != "no capacity" {
+		t.Errorf("gt-a failures = %v", a.failures)
+	}
+	if len(a.merged) != 1 || a.merged[0] != "polecat/toast/gt-a" {
+		t.Errorf("gt-a merged = %v", a.merged)
+	}
+
+	b := history["gt-b"]
+	if len(b.merged) != 0 {
+		t.Errorf("gt-b merged = %v, want none", b.merged)
+	}
+	if len(b.mergeFailed) != 1 || b.mergeFailed[0] != "polecat/nux/gt-b: conflict" {
+		t.Errorf("gt-b mergeFailed = %v", b.mergeFailed)
+	}
+	if _, ok := history["gt-other"]; ok {
+		t.Error("untracked bead should not appear in history")
+	}
+}
+
+func TestExtractTranscriptDecisions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sess.jsonl")
+	lines := []string{
+		`{"type":"user","message":{"content":"I decided nothing, I am the user."}}`,
+		`{"type":"assistant","message":{"content":[{"type":"text","text":"Looking at the code. I decided to reuse the existing lock rather than add a new one."}]}}`,
+		`{"type":"assistant","message":{"content":[{"type":"tool_use","name":"Bash"}]}}`,
+		`{"type":"assistant","message":{"content":"Tests pass."}}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got := extractTranscriptDecisions(path)
+	want := "I decided to reuse the existing lock rather than add a new one."
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("decisions = %q, want [%q]", got, want)
+	}
+}
+
+func TestRenderConvoyReport(t *testing.T) {
+	r := &convoyReport{
+		ID:        "hq-cv-1",
+		Title:     "Auth <rework>",
+		Status:    "closed",
+		CreatedAt: "2026-01-01T00:00:00Z",
+		Completed: 1,
+		Retries:   1,
+		Beads: []convoyReportBead{{
+			ID:            "gt-a",
+			Title:         "Fix | login",
+			Status:        "closed",
+			Duration:      90 * time.Minute,
+			Cost:          1.5,
+			HasTranscript: true,
+			Merged:        []string{"polecat/toast/gt-a"},
+			Retries:       1,
+			Decisions:     []string{"Chose X rather than Y."},
+			ArtifactDir:   "/town/.runtime/artifacts/gt-a",
+			Artifacts:     []artifacts.Artifact{{Path: "reports/junit.xml", Size: 2048}},
+		}},
+	}
+
+	var md bytes.Buffer
+	renderConvoyReportMarkdown(&md, r)
+	for _, want := range []string{
+		"# Convoy report: Auth <rework>",
+		"| gt-a | Fix \\| login | closed | 1h 30m | $1.50 | polecat/toast/gt-a |",
+		"## Failures and retries",
+		"> Chose X rather than Y.",
+		"  - [reports/junit.xml](/town/.runtime/artifacts/gt-a/reports/junit.xml) (2.0 KB)",
+	} {
+		if !strings.Contains(md.String(), want) {
+			t.Errorf("markdown missing %q\n%s", want, md.String())
+		}
+	}
+
+	var html bytes.Buffer
+	if err := renderConvoyReportHTML(&html, r); err != nil {
+		t.Fatalf("renderConvoyReportHTML: %v", err)
+	}
+	if !strings.Contains(html.String(), "Auth &lt;rework&gt;") {
+		t.Error("HTML output should escape titles")
+	}
+	if !strings.Contains(html.String(), `<a href="/town/.runtime/artifacts/gt-a/reports/junit.xml">reports/junit.xml</a>`) {
+		t.Errorf("HTML output should link artifacts\n%s", html.String())
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"sort"
 	"strings"
 	"time"
@@ -1437,7 +1436,7 @@ type bdDepResult struct {
 // bdShow runs `bd show <id> --json` and returns the parsed bead info.
 // Returns error if bd exits non-zero or returns no results.
 func bdShow(beadID string) (*bdShowResult, error) {
-	cmd := exec.Command("bd", "show", beadID, "--json")
+	cmd := beads.Command("show", beadID, "--json")
 	// Route to the correct rig database via prefix resolution.
 	if dir := resolveBeadDir(beadID); dir != "" && dir != "." {
 		cmd.Dir = dir
@@ -1463,7 +1462,7 @@ func bdShow(beadID string) (*bdShowResult, error) {
 // bd dep list returns the beads that <id> depends on. Each result's
 // DependsOnID is the dependency target; IssueID is set to <id> by this func.
 func bdDepList(beadID string) ([]bdDepResult, error) {
-	cmd := exec.Command("bd", "dep", "list", beadID, "--json")
+	cmd := beads.Command("dep", "list", beadID, "--json")
 	// Route to the correct rig database via prefix resolution.
 	if dir := resolveBeadDir(beadID); dir != "" && dir != "." {
 		cmd.Dir = dir
@@ -1492,7 +1491,7 @@ func bdDepList(beadID string) ([]bdDepResult, error) {
 // directory. We resolve the correct .beads directory from the bead's prefix via
 // routes.jsonl so this works regardless of the caller's working directory.
 func bdListChildren(parentID string) ([]bdShowResult, error) {
-	cmd := exec.Command("bd", "list", "--parent="+parentID, "--json")
+	cmd := beads.Command("list", "--parent="+parentID, "--json")
 	// Route to the correct rig database via prefix resolution.
 	// resolveBeadDir returns the parent of .beads (the working directory bd
 	// expects), unlike beadsDirForID which returns the .beads directory itself.
@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// convoySweepGracePeriod is how long after creation an empty convoy is left
+// alone, so a sling's bd dep add has time to become visible. See GH#2303.
+const convoySweepGracePeriod = 5 * time.Minute
+
+var (
+	convoySweepDryRun bool
+	convoySweepJSON   bool
+)
+
+var convoySweepCmd = &cobra.Command{
+	Use:   "sweep",
+	Short: "Close convoys per the lifecycle policy (empty, complete, stale)",
+	Long: `Apply the convoy lifecycle policy to all open convoys.
+
+A convoy is closed when it is:
+  empty     tracking no issues, and older than 5m    convoy.auto_close_empty (default on)
+  complete  all tracked issues closed                convoy.auto_close_complete (default on)
+  stale     no tracked issue in progress, not        convoy.stale_ttl (default off)
+            updated for stale_ttl, and not waiting
+            on another convoy (gt convoy depend)
+
+Owned convoys (created with --owned) are never swept; their caller lands
+them with gt convoy land. Staged convoys are not open and are not swept.
+
+The daemon runs a sweep periodically, so auto-created convoys don't linger
+after their work lands.
+
+Examples:
+  gt convoy sweep --dry-run              # Show what would close
+  gt convoy sweep
+  gt config set convoy.stale_ttl 336h    # Also close convoys idle for 2 weeks`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runConvoySweep,
+}
+
+func init() {
+	convoySweepCmd.Flags().BoolVar(&convoySweepDryRun, "dry-run", false, "Show what would close without acting")
+	convoySweepCmd.Flags().BoolVar(&convoySweepJSON, "json", false, "Output as JSON")
+	convoyCmd.AddCommand(convoySweepCmd)
+}
+
+// convoySweepCandidate is an open convoy as seen by the sweep.
+type convoySweepCandidate struct {
+	ID        string
+	Title     string
+	Owned     bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Tracked   []trackedIssueInfo
+	Waiting   bool // Waits on an unfinished convoy (gt convoy depend)
+}
+
+// convoySweepAction is a convoy the sweep closes (or would close).
+type convoySweepAction struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Reason string `json:"reason"`
+	Closed bool   `json:"closed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// convoySweepReason returns why policy closes c at now, or "" to keep it.
+func convoySweepReason(c convoySweepCandidate, policy *config.ConvoyConfig, now time.Time) string {
+	if c.Owned {
+		return ""
+	}
+
+	if len(c.Tracked) == 0 {
+		if policy.IsAutoCloseEmptyEnabled() && !c.CreatedAt.IsZero() && now.Sub(c.CreatedAt) >= convoySweepGracePeriod {
+			return "Empty convoy (no tracked issues)"
+		}
+		return ""
+	}
+
+	open, active := 0, false
+	for _, t := range c.Tracked {
+		switch t.Status {
+		case "closed", "tombstone":
+			continue
+		case "in_progress", "hooked":
+			active = true
+		}
+		open++
+	}
+	if open == 0 {
+		if policy.IsAutoCloseCompleteEnabled() {
+			return "All tracked issues completed"
+		}
+		return ""
+	}
+
+	if ttl := policy.GetStaleTTL(); ttl > 0 && !active && !c.Waiting && !c.UpdatedAt.IsZero() && now.Sub(c.UpdatedAt) >= ttl {
+		return fmt.Sprintf("Stale: no activity for %s", ttl)
+	}
+	return ""
+}
+
+func runConvoySweep(cmd *cobra.Command, args []string) error {
+	townBeads, err := getTownBeadsDir()
+	if err != nil {
+		return err
+	}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townBeads))
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+
+	candidates, err := listConvoySweepCandidates(townBeads)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	actions := []convoySweepAction{}
+	for _, c := range candidates {
+		reason := convoySweepReason(c, settings.Convoy, now)
+		if reason == "" {
+			continue
+		}
+		action := convoySweepAction{ID: c.ID, Title: c.Title, Reason: reason}
+		if !convoySweepDryRun {
+			closeCmd := beads.Command("close", c.ID, "-r", reason)
+			closeCmd.Dir = townBeads
+			if err := closeCmd.Run(); err != nil {
+				action.Error = err.Error()
+			} else {
+				action.Closed = true
+				if len(c.Tracked) > 0 {
+					notifyConvoyCompletion(townBeads, c.ID, c.Title)
+				}
+			}
+		}
+		actions = append(actions, action)
+	}
+
+	if convoySweepJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(actions)
+	}
+
+	if len(actions) == 0 {
+		fmt.Println("No convoys to sweep.")
+		return nil
+	}
+	for _, a := range actions {
+		switch {
+		case convoySweepDryRun:
+			fmt.Printf("%s Would close 🚚 %s: %s\n", style.Warning.Render("⚠"), a.ID, a.Title)
+		case a.Error != "":
+			fmt.Printf("%s Couldn't close 🚚 %s: %s\n", style.Error.Render("✗"), a.ID, a.Error)
+			continue
+		default:
+			fmt.Printf("%s Closed 🚚 %s: %s\n", style.Bold.Render("✓"), a.ID, a.Title)
+		}
+		fmt.Printf("  %s\n", style.Dim.Render(a.Reason))
+	}
+	return nil
+}
+
+// listConvoySweepCandidates returns all open convoys with their tracked
+// issues. Convoys whose tracked issues can't be resolved are skipped: an
+// unresolved convoy would otherwise look empty.
+func listConvoySweepCandidates(townBeads string) ([]convoySweepCandidate, error) {
+	out, err := runBdJSON(townBeads, "list", "--type=convoy", "--status=open", "--json")
+	if err != nil {
+		return nil, fmt.Errorf("listing convoys: %w", err)
+	}
+
+	var convoys []struct {
+		ID        string   `json:"id"`
+		Title     string   `json:"title"`
+		Labels    []string `json:"labels"`
+		CreatedAt string   `json:"created_at"`
+		UpdatedAt string   `json:"updated_at"`
+	}
+	if err := json.Unmarshal(out, &convoys); err != nil {
+		return nil, fmt.Errorf("parsing convoy list: %w", err)
+	}
+
+	var waiting map[string][]string
+	if deps, err := listConvoyDependencies(townBeads); err != nil {
+		style.PrintWarning("%v", err)
+	} else {
+		waiting = waitingConvoys(deps)
+	}
+
+	var candidates []convoySweepCandidate
+	for _, c := range convoys {
+		tracked, err := getTrackedIssues(townBeads, c.ID)
+		if err != nil {
+			style.PrintWarning("skipping convoy %s: %v", c.ID, err)
+			continue
+		}
+		created, _ := time.Parse(time.RFC3339, c.CreatedAt)
+		updated, _ := time.Parse(time.RFC3339, c.UpdatedAt)
+		candidates = append(candidates, convoySweepCandidate{
+			ID:        c.ID,
+			Title:     c.Title,
+			Owned:     hasLabel(c.Labels, "gt:owned"),
+			CreatedAt: created,
+			UpdatedAt: updated,
+			Tracked:   tracked,
+			Waiting:   len(waiting[c.ID]) > 0,
+		})
+	}
+	return candidates, nil
+}
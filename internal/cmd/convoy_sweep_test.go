@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestConvoySweepReason(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-30 * 24 * time.Hour)
+	off := false
+
+	closed := []trackedIssueInfo{{ID: "gt-a", Status: "closed"}, {ID: "gt-b", Status: "tombstone"}}
+	idle := []trackedIssueInfo{{ID: "gt-a", Status: "closed"}, {ID: "gt-b", Status: "open"}}
+	working := []trackedIssueInfo{{ID: "gt-a", Status: "hooked"}}
+
+	tests := []struct {
+		name   string
+		c      convoySweepCandidate
+		policy *config.ConvoyConfig
+		want   string // substring of the reason; "" = kept
+	}{
+		{"empty past grace", convoySweepCandidate{CreatedAt: old}, nil, "Empty convoy"},
+		{"empty within grace", convoySweepCandidate{CreatedAt: now.Add(-time.Minute)}, nil, ""},
+		{"empty disabled", convoySweepCandidate{CreatedAt: old}, &config.ConvoyConfig{AutoCloseEmpty: &off}, ""},
+		{"complete", convoySweepCandidate{CreatedAt: old, Tracked: closed}, nil, "All tracked issues completed"},
+		{"complete disabled", convoySweepCandidate{CreatedAt: old, Tracked: closed}, &config.ConvoyConfig{AutoCloseComplete: &off}, ""},
+		{"owned complete", convoySweepCandidate{Owned: true, CreatedAt: old, Tracked: closed}, nil, ""},
+		{"owned empty", convoySweepCandidate{Owned: true, CreatedAt: old}, nil, ""},
+		{"idle without ttl", convoySweepCandidate{CreatedAt: old, UpdatedAt: old, Tracked: idle}, nil, ""},
+		{"idle past ttl", convoySweepCandidate{CreatedAt: old, UpdatedAt: old, Tracked: idle}, &config.ConvoyConfig{StaleTTL: "336h"}, "Stale"},
+		{"idle within ttl", convoySweepCandidate{CreatedAt: old, UpdatedAt: now.Add(-time.Hour), Tracked: idle}, &config.ConvoyConfig{StaleTTL: "336h"}, ""},
+		{"waiting past ttl", convoySweepCandidate{CreatedAt: old, UpdatedAt: old, Tracked: idle, Waiting: true}, &config.ConvoyConfig{StaleTTL: "336h"}, ""},
+		{"working past ttl", convoySweepCandidate{CreatedAt: old, UpdatedAt: old, Tracked: working}, &config.ConvoyConfig{StaleTTL: "336h"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convoySweepReason(tt.c, tt.policy, now)
+			if tt.want == "" {
+				if got != "" {
+					t.Errorf("got %q, want kept", got)
+				}
+				return
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os/exec"
 	"strconv"
 	"strings"
 
@@ -241,7 +240,7 @@ type convoyForWatch struct {
 
 // getConvoyForWatch fetches and validates a convoy for watch/unwatch operations.
 func getConvoyForWatch(townBeads, convoyID string) (*convoyForWatch, error) {
-	showCmd := exec.Command("bd", "show", convoyID, "--json")
+	showCmd := beads.Command("show", convoyID, "--json")
 	showCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	showCmd.Stdout = &stdout
@@ -281,7 +280,7 @@ func getConvoyForWatch(townBeads, convoyID string) (*convoyForWatch, error) {
 
 // updateConvoyDescription updates a convoy's description via bd update.
 func updateConvoyDescription(townBeads, convoyID, newDesc string) error {
-	updateCmd := exec.Command("bd", "update", convoyID, "--description", newDesc)
+	updateCmd := beads.Command("update", convoyID, "--description", newDesc)
 	updateCmd.Dir = townBeads
 	var stderr bytes.Buffer
 	updateCmd.Stderr = &stderr
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -15,11 +14,13 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/util"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -253,8 +254,9 @@ func runLiveCosts() error {
 			continue
 		}
 
-		// Extract cost from Claude transcript
-		cost, err := extractCostFromWorkDir(workDir)
+		// Extract cost from the Claude transcript under the session's own
+		// config dir: sessions on other accounts keep transcripts elsewhere.
+		cost, err := extractCostFromProjectDir(config.ClaudeProjectDir(sessionClaudeConfigDir(sess), workDir))
 		if err != nil {
 			if costsVerbose {
 				fmt.Fprintf(os.Stderr, "[costs] could not extract cost for %s: %v\n", sess, err)
@@ -465,7 +467,7 @@ func querySessionEventsFromLocation(location string) ([]CostEntry, error) {
 		"--json",
 	}
 
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd := beads.Command(listArgs...)
 	listCmd.Dir = location
 	listOutput, err := listCmd.Output()
 	if err != nil {
@@ -489,7 +491,7 @@ func querySessionEventsFromLocation(location string) ([]CostEntry, error) {
 		showArgs = append(showArgs, item.ID)
 	}
 
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd := beads.Command(showArgs...)
 	showCmd.Dir = location
 	showOutput, err := showCmd.Output()
 	if err != nil {
@@ -549,7 +551,7 @@ func queryDigestBeads(days int) ([]CostEntry, error) {
 		"--json",
 	}
 
-	listCmd := exec.Command("bd", listArgs...)
+	listCmd := beads.Command(listArgs...)
 	listOutput, err := listCmd.Output()
 	if err != nil {
 		return nil, nil
@@ -570,7 +572,7 @@ func queryDigestBeads(days int) ([]CostEntry, error) {
 		showArgs = append(showArgs, item.ID)
 	}
 
-	showCmd := exec.Command("bd", showArgs...)
+	showCmd := beads.Command(showArgs...)
 	showOutput, err := showCmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("showing events: %w", err)
@@ -688,13 +690,7 @@ func getClaudeProjectDir(workDir string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-
-	// Convert path to Claude's directory naming: replace / and _ with -
-	// Claude Code encodes both path separators and underscores as hyphens.
-	// Keep leading slash - it becomes a leading dash in Claude's encoding.
-	projectName := strings.ReplaceAll(workDir, "/", "-")
-	projectName = strings.ReplaceAll(projectName, "_", "-")
-	return filepath.Join(configDir, "projects", projectName), nil
+	return config.ClaudeProjectDir(configDir, workDir), nil
 }
 
 // findLatestTranscript finds the most recently modified .jsonl file in a directory.
@@ -809,7 +805,12 @@ func extractCostFromWorkDir(workDir string) (float64, error) {
 	if err != nil {
 		return 0, fmt.Errorf("getting project dir: %w", err)
 	}
+	return extractCostFromProjectDir(projectDir)
+}
 
+// extractCostFromProjectDir extracts cost from the most recent transcript in
+// a Claude Code project directory.
+func extractCostFromProjectDir(projectDir string) (float64, error) {
 	transcriptPath, err := findLatestTranscript(projectDir)
 	if err != nil {
 		return 0, fmt.Errorf("finding transcript: %w", err)
@@ -823,6 +824,16 @@ func extractCostFromWorkDir(workDir string) (float64, error) {
 	return calculateCost(usage), nil
 }
 
+// sessionClaudeConfigDir returns the CLAUDE_CONFIG_DIR a tmux session runs
+// with, falling back to this process's config dir when the session has none.
+func sessionClaudeConfigDir(session string) string {
+	if dir, err := tmux.NewTmux().GetEnvironment(session, "CLAUDE_CONFIG_DIR"); err == nil && strings.TrimSpace(dir) != "" {
+		return util.ExpandHome(strings.TrimSpace(dir))
+	}
+	dir, _ := config.ClaudeConfigDir()
+	return dir
+}
+
 // getTmuxSessionWorkDir gets the current working directory of a tmux session.
 func getTmuxSessionWorkDir(session string) (string, error) {
 	cmd := tmux.BuildCommand("display-message", "-t", session, "-p", "#{pane_current_path}")
@@ -1331,7 +1342,7 @@ func createCostDigestBead(digest CostDigest) (string, error) {
 		"--silent",
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd := beads.Command(bdArgs...)
 	output, err := bdCmd.CombinedOutput()
 	if err != nil {
 		return "", fmt.Errorf("creating digest bead: %w\nOutput: %s", err, string(output))
@@ -1340,7 +1351,7 @@ func createCostDigestBead(digest CostDigest) (string, error) {
 	digestID := strings.TrimSpace(string(output))
 
 	// Auto-close the digest (it's an audit record, not work)
-	closeCmd := exec.Command("bd", "close", digestID, "--reason=daily cost digest")
+	closeCmd := beads.Command("close", digestID, "--reason=daily cost digest")
 	_ = closeCmd.Run() // Best effort
 
 	return digestID, nil
@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -10,8 +12,11 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
 	agentconfig "github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/templates"
 	"github.com/steveyegge/gastown/internal/util"
@@ -90,7 +95,11 @@ var daemonRunCmd = &cobra.Command{
 
 This is called internally by the daemon start process and supervisor
 services (launchd/systemd). Use 'gt daemon start' to start the daemon
-normally in the background.`,
+normally in the background.
+
+Only one daemon leads a town at a time, recorded in daemon/leader.json. A
+daemon that finds another leader exits; with --standby it waits as an
+observer and takes over once the leader stops renewing its lease.`,
 	Hidden: true,
 	RunE:   runDaemonRun,
 }
@@ -127,6 +136,39 @@ Examples:
 
 var daemonRotateLogsForce bool
 
+var daemonDispatchCmd = &cobra.Command{
+	Use:   "dispatch",
+	Short: "Dispatch scheduled work now",
+	Long: `Ask the running daemon to dispatch scheduled work immediately instead
+of waiting for the next heartbeat.
+
+Dispatch still honors scheduler pause, rig holds, capacity, and system
+pressure. Goes through the daemon's control socket; if the daemon isn't
+running, runs 'gt scheduler run' directly.
+
+Examples:
+  gt daemon dispatch`,
+	RunE: runDaemonDispatch,
+}
+
+var daemonWakeCmd = &cobra.Command{
+	Use:   "wake",
+	Short: "Process lifecycle requests and wake stalled agents now",
+	Long: `Ask the running daemon to act on pending lifecycle requests (cycle,
+restart, shutdown) and nudge polecats whose rate limit has reset,
+without waiting for the next heartbeat.
+
+If the daemon is not running, polecats whose rate limit has reset are
+nudged directly; lifecycle requests wait until the daemon starts.
+
+During the energy saver quiet period (energy_saver.window), this also
+wakes the daemon, which then stays awake until the period ends.
+
+Examples:
+  gt daemon wake`,
+	RunE: runDaemonWake,
+}
+
 var daemonClearBackoffCmd = &cobra.Command{
 	Use:   "clear-backoff <agent>",
 	Short: "Clear crash loop backoff for an agent",
@@ -144,9 +186,31 @@ Examples:
 	RunE: runDaemonClearBackoff,
 }
 
+var daemonClearCrashLoopCmd = &cobra.Command{
+	Use:   "clear-crash-loop",
+	Short: "Let a daemon stopped by a crash loop start again",
+	Long: `Clear the daemon's own crash loop state.
+
+When the daemon restarts too often without exiting cleanly (by default 5
+times in 10 minutes, see operational.daemon.crash_loop_count and
+crash_loop_window), it stops itself: it writes DAEMON-CRASH-LOOP.md in the
+town root, pauses the scheduler, mails the overseer, and refuses to start
+until the incident is cleared.
+
+Fix the cause first (gt daemon logs), then clear the incident and start the
+daemon. The scheduler stays paused until you resume it.
+
+Examples:
+  gt daemon clear-crash-loop && gt daemon start
+  gt scheduler resume`,
+	Args: cobra.NoArgs,
+	RunE: runDaemonClearCrashLoop,
+}
+
 var (
 	daemonLogLines  int
 	daemonLogFollow bool
+	daemonStandby   bool
 )
 
 func init() {
@@ -157,11 +221,15 @@ func init() {
 	daemonCmd.AddCommand(daemonRunCmd)
 	daemonCmd.AddCommand(daemonEnableSupervisorCmd)
 	daemonCmd.AddCommand(daemonClearBackoffCmd)
+	daemonCmd.AddCommand(daemonClearCrashLoopCmd)
 	daemonCmd.AddCommand(daemonRotateLogsCmd)
+	daemonCmd.AddCommand(daemonDispatchCmd)
+	daemonCmd.AddCommand(daemonWakeCmd)
 
 	daemonLogsCmd.Flags().IntVarP(&daemonLogLines, "lines", "n", 50, "Number of lines to show")
 	daemonLogsCmd.Flags().BoolVarP(&daemonLogFollow, "follow", "f", false, "Follow log output")
 	daemonRotateLogsCmd.Flags().BoolVar(&daemonRotateLogsForce, "force", false, "Rotate all logs regardless of size")
+	daemonRunCmd.Flags().BoolVar(&daemonStandby, "standby", false, "Wait as an observer while another daemon leads, instead of exiting")
 
 	rootCmd.AddCommand(daemonCmd)
 }
@@ -274,13 +342,15 @@ func runDaemonStatus(cmd *cobra.Command, args []string) error {
 			pid)
 		fmt.Printf("  Town: %s\n", townRoot)
 
-		// Load state for more details
-		state, err := daemon.LoadState(townRoot)
+		// Load state for more details, live from the daemon when reachable
+		state, schedPaused, err := loadDaemonStatusState(townRoot)
 		if err == nil && !state.StartedAt.IsZero() {
-			fmt.Printf("  Started: %s\n", state.StartedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("  Started: %s (up %s)\n", state.StartedAt.Format("2006-01-02 15:04:05"),
+				humanize.Duration(time.Since(state.StartedAt).Round(time.Minute)))
 			if !state.LastHeartbeat.IsZero() {
-				fmt.Printf("  Last heartbeat: %s (#%d)\n",
+				fmt.Printf("  Last heartbeat: %s, %s (#%d)\n",
 					state.LastHeartbeat.Format("15:04:05"),
+					humanize.Ago(state.LastHeartbeat),
 					state.HeartbeatCount)
 			}
 
@@ -294,16 +364,63 @@ func runDaemonStatus(cmd *cobra.Command, args []string) error {
 				}
 			}
 		}
+		if schedPaused != "" {
+			fmt.Printf("  Scheduler: %s (by %s)\n", style.Warning.Render("paused"), schedPaused)
+		}
+		if lease, err := daemon.ReadLease(townRoot); err == nil && lease != nil {
+			leader := lease.String()
+			if lease.Expired(time.Now()) {
+				leader = style.Warning.Render(leader + " — expired")
+			}
+			fmt.Printf("  Leader: %s\n", leader)
+		}
+	} else if _, err := os.Stat(daemon.CrashLoopIncidentPath(townRoot)); err == nil {
+		fmt.Printf("%s Daemon is %s\n",
+			style.Warning.Render("⚠"),
+			style.Warning.Render("stopped by a crash loop"))
+		fmt.Printf("  Incident: %s\n", daemon.CrashLoopIncidentPath(townRoot))
+		fmt.Printf("\nFix the cause (%s), then: %s\n",
+			style.Dim.Render("gt daemon logs"), style.Dim.Render("gt daemon clear-crash-loop && gt daemon start"))
 	} else {
 		fmt.Printf("%s Daemon is %s\n",
 			style.Dim.Render("○"),
 			"not running")
 		fmt.Printf("\nStart with: %s\n", style.Dim.Render("gt daemon start"))
 	}
+	if s, err := daemon.LoadSelfMonitor(townRoot); err == nil && (s.UncleanExits > 0 || s.HeartbeatFailures > 0) {
+		fmt.Printf("  Restarts: %d unclean of %d starts, %d heartbeat failure(s)\n",
+			s.UncleanExits, s.Starts, s.HeartbeatFailures)
+	}
 
 	return nil
 }
 
+// loadDaemonStatusState returns the daemon state and, if the scheduler is
+// paused, who paused it. It asks the daemon over its control socket and
+// falls back to the state files when the socket is unavailable.
+func loadDaemonStatusState(townRoot string) (*daemon.State, string, error) {
+	if c, err := daemon.DialControl(townRoot); err == nil {
+		defer c.Close()
+		if reply, err := c.GetState(); err == nil {
+			pausedBy := ""
+			if reply.SchedulerPaused {
+				pausedBy = reply.PausedBy
+			}
+			return &reply.State, pausedBy, nil
+		}
+	}
+
+	state, err := daemon.LoadState(townRoot)
+	if err != nil {
+		return nil, "", err
+	}
+	pausedBy := ""
+	if sched, err := capacity.LoadState(townRoot); err == nil && sched.Paused {
+		pausedBy = sched.PausedBy
+	}
+	return state, pausedBy, nil
+}
+
 // getBinaryModTime returns the modification time of the current executable
 func getBinaryModTime() (time.Time, error) {
 	exePath, err := os.Executable()
@@ -379,12 +496,21 @@ func runDaemonRun(cmd *cobra.Command, args []string) error {
 	os.Setenv("BD_ACTOR", "daemon")
 
 	config := daemon.DefaultConfig(townRoot)
+	config.Standby = daemonStandby
 	d, err := daemon.New(config)
 	if err != nil {
 		return fmt.Errorf("creating daemon: %w", err)
 	}
 
-	return d.Run()
+	if err := d.Run(); err != nil {
+		if errors.Is(err, daemon.ErrCrashLoop) {
+			// Exit cleanly so launchd/systemd stop restarting the daemon.
+			fmt.Fprintf(os.Stderr, "%s %v\n", style.Warning.Render("⚠"), err)
+			return nil
+		}
+		return err
+	}
+	return nil
 }
 
 func runDaemonEnableSupervisor(cmd *cobra.Command, args []string) error {
@@ -413,6 +539,9 @@ func runDaemonEnableSupervisor(cmd *cobra.Command, args []string) error {
 }
 
 func runDaemonClearBackoff(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	agentID := args[0]
 
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -447,6 +576,32 @@ func runDaemonClearBackoff(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runDaemonClearCrashLoop(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	incident := daemon.CrashLoopIncidentPath(townRoot)
+	_, statErr := os.Stat(incident)
+	if err := daemon.ClearCrashLoop(townRoot); err != nil {
+		return fmt.Errorf("clearing crash loop: %w", err)
+	}
+	if statErr != nil {
+		fmt.Printf("%s No crash loop incident open; reset recent restart count\n", style.Dim.Render("○"))
+		return nil
+	}
+	fmt.Printf("%s Cleared daemon crash loop (removed %s)\n", style.Bold.Render("✓"), incident)
+	fmt.Printf("  Start the daemon with: gt daemon start\n")
+	if sched, err := capacity.LoadState(townRoot); err == nil && sched.Paused {
+		fmt.Printf("  Resume dispatch with:  gt scheduler resume\n")
+	}
+	return nil
+}
+
 func runDaemonRotateLogs(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -476,3 +631,65 @@ func runDaemonRotateLogs(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runDaemonDispatch(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionDispatch); err != nil {
+		return err
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	c, err := daemon.DialControl(townRoot)
+	if err != nil {
+		fmt.Printf("%s Daemon not reachable, dispatching directly\n", style.Dim.Render("○"))
+		return runSchedulerRun(cmd, nil)
+	}
+	defer c.Close()
+
+	reply, err := c.DispatchNow()
+	if err != nil {
+		return fmt.Errorf("dispatching via daemon: %w", err)
+	}
+	if reply.Deferred != "" {
+		fmt.Printf("%s Dispatch deferred: %s\n", style.Warning.Render("⚠"), reply.Deferred)
+		return nil
+	}
+	fmt.Printf("%s Dispatch complete (see %s for details)\n", style.Bold.Render("✓"), style.Dim.Render("gt daemon logs"))
+	return nil
+}
+
+func runDaemonWake(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	c, err := daemon.DialControl(townRoot)
+	if err != nil {
+		// Without a daemon there is no deep sleep to end, and lifecycle
+		// requests wait in the deacon inbox until it starts. Stalled
+		// polecats can still be woken from here.
+		fmt.Printf("%s Daemon not reachable, waking limit-stalled polecats directly\n", style.Dim.Render("○"))
+		woken := daemon.WakeLimitStalledPolecats(townRoot, log.New(os.Stderr, "", 0))
+		fmt.Printf("%s Woke %d limit-stalled polecat(s); lifecycle requests wait for %s\n",
+			style.Bold.Render("✓"), woken, style.Dim.Render("gt daemon start"))
+		return nil
+	}
+	defer c.Close()
+
+	reply, err := c.WakeAgents()
+	if err != nil {
+		return fmt.Errorf("waking agents via daemon: %w", err)
+	}
+	if reply.SleepEnded {
+		fmt.Printf("%s Woke daemon from energy saver deep sleep\n", style.Bold.Render("✓"))
+	}
+	fmt.Printf("%s Processed lifecycle requests; woke %d limit-stalled polecat(s)\n",
+		style.Bold.Render("✓"), reply.LimitWoken)
+	return nil
+}
@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/lock"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	deaconReportLast int
+	deaconReportJSON bool
+)
+
+var deaconReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Summarize findings from recent patrols",
+	Long: `Summarize the structured findings recorded by the last N deacon patrols.
+
+Each deacon patrol ('gt patrol report') writes a findings file under
+deacon/findings/ covering:
+  stale_lock       agent.lock held by a dead process with no session
+  orphan_session   Gas Town tmux session with no valid rig or role
+  sla_breach       bead hooked with no update for longer than
+                   operational.deacon.hooked_sla (default 4h)
+  disk             low free space on the town filesystem
+
+The report shows per-kind counts with their trend against the previous
+patrol, findings that have recurred across consecutive patrols (nobody is
+acting on them), and the latest patrol's findings with a suggested fix.
+
+Examples:
+  gt deacon report
+  gt deacon report --last 50
+  gt deacon report --json`,
+	Args: cobra.NoArgs,
+	RunE: runDeaconReport,
+}
+
+func init() {
+	deaconReportCmd.Flags().IntVarP(&deaconReportLast, "last", "n", 10, "Number of recent patrols to summarize")
+	deaconReportCmd.Flags().BoolVar(&deaconReportJSON, "json", false, "Output as JSON")
+	deaconCmd.AddCommand(deaconReportCmd)
+}
+
+func runDeaconReport(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	patrols, err := deacon.LoadRecentFindings(townRoot, deaconReportLast)
+	if err != nil {
+		return err
+	}
+	summary := deacon.SummarizeFindings(patrols)
+
+	if deaconReportJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(summary)
+	}
+
+	if summary.Patrols == 0 {
+		fmt.Printf("%s No patrol findings recorded yet\n", style.Dim.Render("○"))
+		fmt.Println(style.Dim.Render("  Findings are written by each deacon patrol (gt patrol report)."))
+		return nil
+	}
+
+	fmt.Printf("%s\n", style.Bold.Render("Deacon Patrol Report"))
+	fmt.Printf("  %d patrol(s), %s → %s\n\n", summary.Patrols,
+		summary.From.Local().Format("Jan 2 15:04"), summary.To.Local().Format("Jan 2 15:04"))
+
+	for _, k := range summary.Kinds {
+		fmt.Printf("  %-15s %3d %s  %s\n", k.Kind, k.Latest, trendArrow(k.Trend),
+			style.Dim.Render(fmt.Sprintf("(prev %d, avg %.1f)", k.Previous, k.Average)))
+	}
+
+	if len(summary.Recurring) > 0 {
+		fmt.Printf("\n%s\n", style.Bold.Render("Recurring"))
+		for _, r := range summary.Recurring {
+			fmt.Printf("  %s %s %s %s\n", style.Warning.Render("⚠"), r.Kind, r.Subject,
+				style.Dim.Render(fmt.Sprintf("(last %d patrols)", r.Patrols)))
+		}
+	}
+
+	latest := patrols[len(patrols)-1]
+	fmt.Printf("\n%s\n", style.Bold.Render("Latest patrol"))
+	if len(latest.Findings) == 0 {
+		fmt.Printf("  %s No findings\n", style.Bold.Render("✓"))
+	}
+	for _, f := range latest.Findings {
+		icon := style.Warning.Render("⚠")
+		if f.Severity == "critical" {
+			icon = style.Error.Render("✗")
+		}
+		fmt.Printf("  %s %s %s\n", icon, f.Kind, f.Subject)
+		if f.Detail != "" {
+			fmt.Printf("      %s\n", f.Detail)
+		}
+		if f.Action != "" {
+			fmt.Printf("      %s\n", style.Dim.Render("→ "+f.Action))
+		}
+	}
+	for _, e := range latest.Errors {
+		fmt.Printf("  %s %s\n", style.Dim.Render("○"), style.Dim.Render("check skipped: "+e))
+	}
+	return nil
+}
+
+func trendArrow(trend string) string {
+	switch trend {
+	case "up":
+		return style.Warning.Render("↑")
+	case "down":
+		return style.Success.Render("↓")
+	default:
+		return style.Dim.Render("→")
+	}
+}
+
+// collectPatrolFindings runs the deacon's inspections for one patrol. A
+// check that fails is recorded in Errors rather than failing the patrol.
+func collectPatrolFindings(townRoot string, now time.Time) *deacon.PatrolFindings {
+	pf := &deacon.PatrolFindings{Timestamp: now.UTC(), Findings: []deacon.Finding{}}
+
+	if stale, err := lock.FindStaleLocks(townRoot); err != nil {
+		pf.Errors = append(pf.Errors, "stale locks: "+err.Error())
+	} else {
+		dirs := make([]string, 0, len(stale))
+		for dir := range stale {
+			dirs = append(dirs, dir)
+		}
+		sort.Strings(dirs)
+		for _, dir := range dirs {
+			subject := dir
+			if rel, err := filepath.Rel(townRoot, dir); err == nil {
+				subject = rel
+			}
+			info := stale[dir]
+			pf.Findings = append(pf.Findings, deacon.Finding{
+				Kind:     deacon.FindingStaleLock,
+				Subject:  subject,
+				Detail:   fmt.Sprintf("pid %d is dead, session %q is gone", info.PID, info.SessionID),
+				Severity: "warning",
+				Action:   "gt agents fix",
+			})
+		}
+	}
+
+	orphanCheck := doctor.NewOrphanSessionCheck()
+	if res := orphanCheck.Run(&doctor.CheckContext{TownRoot: townRoot}); res.Status == doctor.StatusWarning && len(orphanCheck.Orphans()) == 0 {
+		pf.Errors = append(pf.Errors, "orphan sessions: "+res.Message)
+	}
+	for _, sess := range orphanCheck.Orphans() {
+		pf.Findings = append(pf.Findings, deacon.Finding{
+			Kind:     deacon.FindingOrphanSession,
+			Subject:  sess,
+			Detail:   "tmux session has no matching rig or role",
+			Severity: "warning",
+			Action:   "gt doctor --fix",
+		})
+	}
+
+	if breaches, err := deacon.HookedSLABreaches(townRoot, now); err != nil {
+		pf.Errors = append(pf.Errors, "SLA: "+err.Error())
+	} else {
+		pf.Findings = append(pf.Findings, breaches...)
+	}
+
+	if level, msg, err := util.CheckDiskSpace(townRoot); err != nil {
+		pf.Errors = append(pf.Errors, "disk: "+err.Error())
+	} else if level != util.DiskSpaceOK {
+		pf.Findings = append(pf.Findings, deacon.Finding{
+			Kind:     deacon.FindingDisk,
+			Subject:  townRoot,
+			Detail:   msg,
+			Severity: level.String(),
+			Action:   "gt doctor",
+		})
+	}
+
+	return pf
+}
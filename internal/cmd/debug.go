@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/debugbundle"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	debugBundleOutput     string
+	debugBundleLogLines   int
+	debugBundleEventLines int
+	debugBundleNoProfiles bool
+
+	daemonProfileOutput  string
+	daemonProfileSeconds int
+	daemonProfileDebug   int
+)
+
+func init() {
+	debugBundleCmd.Flags().StringVarP(&debugBundleOutput, "output", "o", "", "Write the bundle here (default: gt-debug-<town>-<time>.tar.gz; - for stdout)")
+	debugBundleCmd.Flags().IntVar(&debugBundleLogLines, "log-lines", debugbundle.DefaultLogLines, "Lines to keep from the end of each log")
+	debugBundleCmd.Flags().IntVar(&debugBundleEventLines, "event-lines", debugbundle.DefaultEventLines, "Recent events to include")
+	debugBundleCmd.Flags().BoolVar(&debugBundleNoProfiles, "no-profiles", false, "Don't capture profiles from the running daemon")
+	debugCmd.AddCommand(debugBundleCmd)
+	rootCmd.AddCommand(debugCmd)
+
+	daemonProfileCmd.Flags().StringVarP(&daemonProfileOutput, "output", "o", "", "Write the profile here (default: <name>.pprof, or stdout for text)")
+	daemonProfileCmd.Flags().IntVar(&daemonProfileSeconds, "seconds", 10, "How long a cpu profile samples")
+	daemonProfileCmd.Flags().IntVar(&daemonProfileDebug, "debug", 0, "Output format: 0 for go tool pprof, 1 or 2 for text")
+	daemonCmd.AddCommand(daemonProfileCmd)
+}
+
+var debugCmd = &cobra.Command{
+	Use:     "debug",
+	GroupID: GroupDiag,
+	Short:   "Collect diagnostics for issue reports",
+	RunE:    requireSubcommand,
+}
+
+var debugBundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Collect logs, state and daemon profiles into a tarball",
+	Long: `Collect what a maintainer needs to diagnose a problem into one
+gzipped tarball to attach to an issue report.
+
+A bundle holds:
+  manifest.json       gt and Go versions, platform, and what couldn't be collected
+  logs/               The last --log-lines of daemon/*.log and logs/*.log
+  state/              daemon/*.json and .runtime/*.json (daemon, scheduler, self-monitor)
+  events/             The last --event-lines of .events.jsonl
+  config/             settings/, mayor/ and config/ JSON, redacted
+  profiles/           Goroutine stacks and a heap profile of the running daemon
+
+Values of keys that name a secret (token, secret, password, api key,
+webhook, url, dsn, ...) and credentials in URLs are replaced with
+[REDACTED], in logs, state, events and config alike; a log line that isn't
+JSON is dropped when it names one. Beads, mail and transcripts are never
+captured. Look the bundle over before sharing it.
+
+Examples:
+  gt debug bundle
+  gt debug bundle -o /tmp/gt-debug.tar.gz
+  gt debug bundle --no-profiles      # Don't contact the daemon`,
+	Args: cobra.NoArgs,
+	RunE: runDebugBundle,
+}
+
+var daemonProfileCmd = &cobra.Command{
+	Use:   "profile <goroutine|heap|allocs|block|mutex|threadcreate|cpu>",
+	Short: "Capture a pprof profile from the running daemon",
+	Long: `Capture a pprof profile from the running daemon over its control socket.
+
+The binary format (--debug 0) is read by go tool pprof; --debug 1 or 2 prints
+text, and --debug 2 with goroutine gives full stacks, the quickest way to see
+where a stuck daemon is waiting. A cpu profile samples for --seconds.
+
+Examples:
+  gt daemon profile goroutine --debug 2       # Print all goroutine stacks
+  gt daemon profile heap -o heap.pprof && go tool pprof heap.pprof
+  gt daemon profile cpu --seconds 30`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDaemonProfile,
+}
+
+func runDebugBundle(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	opts := debugbundle.Options{
+		Version:    versionLine(),
+		LogLines:   debugBundleLogLines,
+		EventLines: debugBundleEventLines,
+	}
+	if !debugBundleNoProfiles {
+		if c, err := daemon.DialControl(townRoot); err != nil {
+			opts.Profile = func(debugbundle.Profile) ([]byte, error) { return nil, err }
+		} else {
+			defer c.Close()
+			opts.Profile = func(p debugbundle.Profile) ([]byte, error) {
+				return c.Profile(p.Name, 0, p.Debug)
+			}
+		}
+	}
+
+	now := time.Now()
+	data, manifest, err := debugbundle.Bytes(townRoot, opts, now)
+	if err != nil {
+		return fmt.Errorf("collecting debug bundle: %w", err)
+	}
+	if debugBundleOutput == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	out := debugBundleOutput
+	if out == "" {
+		out = fmt.Sprintf("gt-debug-%s-%s.tar.gz", filepath.Base(townRoot), now.Format("20060102-150405"))
+	}
+	if err := atomicfile.WriteFile(out, data, 0600); err != nil {
+		return fmt.Errorf("writing %s: %w", out, err)
+	}
+	fmt.Printf("%s Wrote debug bundle to %s (%s)\n", style.Bold.Render("✓"), out, manifest.Summary())
+	for _, n := range manifest.Notes {
+		fmt.Printf("  %s %s\n", style.Dim.Render("○"), n)
+	}
+	fmt.Printf("  Secrets are redacted; look it over before attaching it to an issue.\n")
+	return nil
+}
+
+func runDaemonProfile(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	c, err := daemon.DialControl(townRoot)
+	if err != nil {
+		return fmt.Errorf("daemon not reachable (is it running? gt daemon status): %w", err)
+	}
+	defer c.Close()
+
+	name := args[0]
+	if name == "cpu" {
+		fmt.Fprintf(os.Stderr, "Sampling cpu for %ds...\n", daemonProfileSeconds)
+	}
+	data, err := c.Profile(name, daemonProfileSeconds, daemonProfileDebug)
+	if err != nil {
+		return fmt.Errorf("capturing %s profile: %w", name, err)
+	}
+
+	out := daemonProfileOutput
+	if out == "" && (daemonProfileDebug == 0 || name == "cpu") {
+		out = name + ".pprof"
+	}
+	if out == "" || out == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if err := atomicfile.WriteFile(out, data, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", out, err)
+	}
+	fmt.Printf("%s Wrote %s profile to %s\n", style.Bold.Render("✓"), name, out)
+	if daemonProfileDebug == 0 || name == "cpu" {
+		fmt.Printf("  View it with: go tool pprof %s\n", out)
+	}
+	return nil
+}
@@ -438,6 +438,16 @@ func runDogRemove(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// DogListItem is one dog in the --json output of gt dog list.
+type DogListItem struct {
+	Name          string            `json:"name"`
+	State         dog.State         `json:"state"`
+	Work          string            `json:"work,omitempty"`
+	WorkStartedAt *time.Time        `json:"work_started_at,omitempty"`
+	LastActive    time.Time         `json:"last_active"`
+	Worktrees     map[string]string `json:"worktrees,omitempty"`
+}
+
 func runDogList(cmd *cobra.Command, args []string) error {
 	mgr, err := getDogManager()
 	if err != nil {
@@ -459,15 +469,6 @@ func runDogList(cmd *cobra.Command, args []string) error {
 	}
 
 	if dogListJSON {
-		type DogListItem struct {
-			Name          string            `json:"name"`
-			State         dog.State         `json:"state"`
-			Work          string            `json:"work,omitempty"`
-			WorkStartedAt *time.Time        `json:"work_started_at,omitempty"`
-			LastActive    time.Time         `json:"last_active"`
-			Worktrees     map[string]string `json:"worktrees,omitempty"`
-		}
-
 		var items []DogListItem
 		for _, d := range dogs {
 			item := DogListItem{
@@ -922,6 +923,12 @@ func dogFormatTimeAgo(t time.Time) string {
 	}
 }
 
+// dogHealthReport is the --json output of gt dog health-check.
+type dogHealthReport struct {
+	Dogs           []dog.DogHealthResult `json:"dogs"`
+	NeedsAttention int                   `json:"needs_attention"`
+}
+
 func runDogHealthCheck(cmd *cobra.Command, args []string) error {
 	mgr, err := getDogManager()
 	if err != nil {
@@ -952,13 +959,9 @@ func runDogHealthCheck(cmd *cobra.Command, args []string) error {
 	attention := dog.NeedsAttentionCount(results)
 
 	if dogHealthJSON {
-		type HealthReport struct {
-			Dogs           []dog.DogHealthResult `json:"dogs"`
-			NeedsAttention int                   `json:"needs_attention"`
-		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		if err := enc.Encode(HealthReport{Dogs: results, NeedsAttention: attention}); err != nil {
+		if err := enc.Encode(dogHealthReport{Dogs: results, NeedsAttention: attention}); err != nil {
 			return err
 		}
 	} else {
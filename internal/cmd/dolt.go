@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/doltserver"
 	"github.com/steveyegge/gastown/internal/style"
@@ -597,6 +598,7 @@ func runDoltStatus(cmd *cobra.Command, args []string) error {
 			fmt.Printf("    Query latency: %v\n", metrics.QueryLatency.Round(time.Millisecond))
 			fmt.Printf("    Connections:   %d / %d (%.0f%%)\n",
 				metrics.Connections, metrics.MaxConnections, metrics.ConnectionPct)
+			printBdLimiterStatus(townRoot, "    ")
 			if metrics.ReadOnly {
 				fmt.Printf("\n  %s %s\n",
 					style.Bold.Render("!!!"),
@@ -639,6 +641,7 @@ func runDoltStatus(cmd *cobra.Command, args []string) error {
 		fmt.Printf("    Connections:   %d / %d (%.0f%%)\n",
 			metrics.Connections, metrics.MaxConnections, metrics.ConnectionPct)
 		fmt.Printf("    Disk usage:    %s\n", metrics.DiskUsageHuman)
+		printBdLimiterStatus(townRoot, "    ")
 		if metrics.ReadOnly {
 			fmt.Printf("\n  %s %s\n",
 				style.Bold.Render("!!!"),
@@ -704,6 +707,27 @@ func runDoltStatus(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// printBdLimiterStatus prints the town's bd rate and concurrency limits and
+// queueing stats, if a limit is configured (operational.dolt.bd_rate_limit
+// or bd_max_concurrent).
+func printBdLimiterStatus(townRoot, indent string) {
+	st := beads.GetBdLimiterStatus(townRoot)
+	if st.MaxConcurrent <= 0 && st.RateLimit <= 0 {
+		return
+	}
+	if st.RateLimit > 0 {
+		fmt.Printf("%sbd rate:       %g/s (burst %d)\n", indent, st.RateLimit, st.RateBurst)
+	}
+	if st.MaxConcurrent > 0 {
+		fmt.Printf("%sbd slots:      %d / %d in use\n", indent, st.InUse, st.MaxConcurrent)
+	}
+	if q := st.Stats; q.Waits > 0 {
+		fmt.Printf("%sbd queueing:   %d wait(s), avg %v, max %v, %d timeout(s); last %s\n", indent,
+			q.Waits, time.Duration(q.TotalWaitMs/q.Waits)*time.Millisecond, time.Duration(q.MaxWaitMs)*time.Millisecond,
+			q.Timeouts, q.LastWaitAt.Local().Format("2006-01-02 15:04:05"))
+	}
+}
+
 func runDoltLogs(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -1366,7 +1390,7 @@ func runDoltRollback(cmd *cobra.Command, args []string) error {
 
 	// Validate restored state
 	fmt.Println("\nValidating restored state...")
-	validateCmd := exec.Command("bd", "list", "--limit", "5")
+	validateCmd := beads.Command("list", "--limit", "5")
 	validateCmd.Dir = townRoot
 	output, validateErr := validateCmd.CombinedOutput()
 	if validateErr != nil {
@@ -29,7 +29,7 @@ import (
 )
 
 var doneCmd = &cobra.Command{
-	Use:         "done",
+	Use:         "done [bead-id]",
 	GroupID:     GroupWork,
 	Annotations: map[string]string{AnnotationPolecatSafe: "true"},
 	Short:       "Signal work ready for merge queue",
@@ -41,6 +41,13 @@ This is a convenience command for polecats that:
 3. Notifies the Witness with the exit outcome
 4. Syncs worktree to main and transitions polecat to IDLE
    (sandbox preserved, session stays alive for reuse)
+5. Checks convoy progress for a closed bead and triggers scheduler dispatch,
+   so the freed capacity is used without waiting for the daemon's next poll
+
+Formulas can call it as a completion hook with an explicit bead and result:
+  gt done <bead-id> --result success|failure|blocked|deferred
+where success is COMPLETED, failure and blocked are ESCALATED, and deferred
+is DEFERRED.
 
 Exit statuses:
   COMPLETED      - Work done, MR submitted (default)
@@ -54,7 +61,9 @@ Examples:
   gt done --pre-verified --target feat/contract-review  # Pre-verified with explicit target
   gt done --issue gt-abc               # Explicit issue ID
   gt done --status ESCALATED           # Signal blocker, skip MR
-  gt done --status DEFERRED            # Pause work, skip MR`,
+  gt done --status DEFERRED            # Pause work, skip MR
+  gt done gt-abc --result success      # Completion hook form`,
+	Args:         cobra.MaximumNArgs(1),
 	RunE:         runDone,
 	SilenceUsage: true, // Don't print usage on operational errors (confuses agents)
 }
@@ -67,6 +76,7 @@ var (
 	doneResume        bool
 	donePreVerified   bool
 	doneTarget        string
+	doneResult        string
 )
 
 // Valid exit types for gt done
@@ -84,6 +94,8 @@ func init() {
 	doneCmd.Flags().BoolVar(&doneResume, "resume", false, "Resume from last checkpoint (auto-detected, for Witness recovery)")
 	doneCmd.Flags().BoolVar(&donePreVerified, "pre-verified", false, "Mark MR as pre-verified (polecat ran gates after rebasing onto target)")
 	doneCmd.Flags().StringVar(&doneTarget, "target", "", "Explicit MR target branch (overrides formula_vars and auto-detection)")
+	doneCmd.Flags().StringVar(&doneResult, "result", "", "Completion result: success, failure, blocked, or deferred (alternative to --status)")
+	doneCmd.MarkFlagsMutuallyExclusive("status", "result")
 
 	rootCmd.AddCommand(doneCmd)
 }
@@ -99,6 +111,21 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 		return fmt.Errorf("gt done is for polecats only (you are %s)\nPolecat sessions end with gt done — the session is cleaned up, but identity persists.\nOther roles persist across tasks and don't use gt done.", actor)
 	}
 
+	// Completion-hook form: gt done <bead-id> --result <result>
+	if len(args) == 1 {
+		if doneIssue != "" && doneIssue != args[0] {
+			return fmt.Errorf("bead %s conflicts with --issue %s", args[0], doneIssue)
+		}
+		doneIssue = args[0]
+	}
+	if doneResult != "" {
+		status, err := exitTypeForResult(doneResult)
+		if err != nil {
+			return err
+		}
+		doneStatus = status
+	}
+
 	// Validate exit status
 	exitType := strings.ToUpper(doneStatus)
 	if exitType != ExitCompleted && exitType != ExitEscalated && exitType != ExitDeferred {
@@ -1037,6 +1064,9 @@ func runDone(cmd *cobra.Command, args []string) (retErr error) {
 			if agentBeadID != "" {
 				description += fmt.Sprintf("\nagent_bead: %s", agentBeadID)
 			}
+			if corrID := doneCorrelationID(sourceIssueForNoMerge); corrID != "" {
+				description += fmt.Sprintf("\ncorrelation_id: %s", corrID)
+			}
 
 			// Add conflict resolution tracking fields (initialized, updated by Refinery)
 			description += "\nretry_count: 0"
@@ -1221,13 +1251,24 @@ notifyWitness:
 	if err := LogDone(townRoot, sender, issueID); err != nil {
 		style.PrintWarning("could not log done event: %v", err)
 	}
-	if err := events.LogFeed(events.TypeDone, sender, events.DonePayload(issueID, branch)); err != nil {
+	donePayload := events.DonePayload(issueID, branch)
+	donePayload["exit_type"] = exitType
+	if err := events.LogFeed(events.TypeDone, sender, donePayload); err != nil {
 		style.PrintWarning("could not log feed event: %v", err)
 	}
 
 	// Update agent bead state (ZFC: self-report completion)
 	updateAgentStateOnDone(cwd, townRoot, exitType, issueID)
 
+	// Propagate completion now rather than on the next daemon poll: convoy
+	// progress for a bead closed above, and dispatch into the freed slot.
+	if exitType == ExitCompleted && issueID != "" && cwdAvailable {
+		if issue, err := beads.New(cwd).Show(issueID); err == nil && beads.IssueStatus(issue.Status).IsTerminal() {
+			checkConvoyCompletion([]string{issueID})
+		}
+	}
+	triggerScheduledDispatch(townRoot)
+
 	// Persistent polecat model (gt-hdf8): polecats transition to IDLE after completion.
 	// Session stays alive, sandbox preserved, worktree synced to main for reuse.
 	// "done means idle" - not "done means dead".
@@ -1237,6 +1278,12 @@ notifyWitness:
 
 		fmt.Printf("%s Sandbox preserved for reuse (persistent polecat)\n", style.Bold.Render("✓"))
 
+		// Archive the session transcript under the town before the worktree
+		// is synced or reused — ~/.claude keys transcripts by worktree path.
+		if cwdAvailable {
+			archivePolecatTranscript(townRoot, cwd, issueID, sender)
+		}
+
 		if pushFailed || mrFailed {
 			fmt.Printf("%s Work needs recovery (push or MR failed) — session preserved\n", style.Bold.Render("⚠"))
 		}
@@ -1675,6 +1722,15 @@ func findHookedBeadForAgent(bd *beads.Beads, agentID string) string {
 	return hookedBeads[0].ID
 }
 
+// doneCorrelationID returns the correlation ID to carry onto the MR: the one
+// sling stored on the source issue, else the session's GT_CORRELATION_ID.
+func doneCorrelationID(source *beads.Issue) string {
+	if af := beads.ParseAttachmentFields(source); af != nil && af.CorrelationID != "" {
+		return af.CorrelationID
+	}
+	return os.Getenv(events.EnvCorrelationID)
+}
+
 // parseCleanupStatus converts a string flag value to a CleanupStatus.
 // ZFC: Agent observes git state and passes the appropriate status.
 func parseCleanupStatus(s string) polecat.CleanupStatus {
@@ -1744,6 +1800,19 @@ func selfNukePolecat(roleInfo RoleInfo, _ string) error {
 	return nil
 }
 
+// exitTypeForResult maps a completion-hook --result to a gt done exit status.
+func exitTypeForResult(result string) (string, error) {
+	switch strings.ToLower(result) {
+	case "success":
+		return ExitCompleted, nil
+	case "failure", "blocked":
+		return ExitEscalated, nil
+	case "deferred":
+		return ExitDeferred, nil
+	}
+	return "", fmt.Errorf("invalid result '%s': must be success, failure, blocked, or deferred", result)
+}
+
 // isPolecatActor checks if a BD_ACTOR value represents a polecat.
 // Polecat actors have format: rigname/polecats/polecatname
 // Non-polecat actors have formats like: gastown/crew/name, rigname/witness, etc.
@@ -1534,3 +1534,20 @@ func testRunGit(t *testing.T, dir string, args ...string) {
 	}
 }
 
+
+func TestExitTypeForResult(t *testing.T) {
+	for result, want := range map[string]string{
+		"success":  ExitCompleted,
+		"SUCCESS":  ExitCompleted,
+		"failure":  ExitEscalated,
+		"blocked":  ExitEscalated,
+		"deferred": ExitDeferred,
+	} {
+		if got, err := exitTypeForResult(result); err != nil || got != want {
+			t.Errorf("exitTypeForResult(%q) = %q, %v; want %q", result, got, err, want)
+		}
+	}
+	if _, err := exitTypeForResult("done"); err == nil {
+		t.Error("exitTypeForResult(done) should fail")
+	}
+}
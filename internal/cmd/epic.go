@@ -0,0 +1,438 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	epicStatusJSON   bool
+	epicTreeJSON     bool
+	epicCloseCascade bool
+	epicCloseForce   bool
+	epicCloseReason  string
+)
+
+var epicCmd = &cobra.Command{
+	Use:     "epic",
+	GroupID: GroupWork,
+	Short:   "Navigate and close epics",
+	Long: `Work with epics as a unit: their children, progress, and closure.
+
+Children are the beads an epic depends on (the same set gt sling <epic>
+schedules). Each child is annotated with its queue/dispatch state:
+
+  done      closed
+  working   hooked or in progress on an agent
+  queued    scheduled, waiting for scheduler dispatch
+  blocked   open, waiting on an unfinished blocker (tree only)
+  ready     open and not scheduled
+
+Subcommands:
+  gt epic status <id>            Progress summary and per-child state
+  gt epic tree <id>              Hierarchy with blockers and state
+  gt epic close <id> --cascade   Close the epic and its open children
+
+To schedule or dispatch an epic's children, sling the epic: gt sling <id>`,
+	RunE: requireSubcommand,
+}
+
+var epicStatusCmd = &cobra.Command{
+	Use:   "status <epic-id>",
+	Short: "Show an epic's progress and each child's state",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runEpicStatus,
+}
+
+var epicTreeCmd = &cobra.Command{
+	Use:   "tree <epic-id>",
+	Short: "Render an epic's tree with blockers and queue/dispatch state",
+	Long: `Render an epic's parent-child hierarchy, annotating each bead with its
+blockers and queue/dispatch state (see gt epic --help).`,
+	Args: cobra.ExactArgs(1),
+	RunE: runEpicTree,
+}
+
+var epicCloseCmd = &cobra.Command{
+	Use:   "close <epic-id>",
+	Short: "Close an epic, optionally with all its open children",
+	Long: `Close an epic.
+
+Without --cascade, the epic is closed only if all its children are closed.
+With --cascade, open children are closed first, recursing into child epics.
+Only parent-child children are closed; beads the epic merely depends on
+are left alone.
+Children being worked by an agent are left alone (and the epic stays open)
+unless --force is given.
+
+Scheduled children whose bead is closed are dropped from the scheduler on
+its next cycle.
+
+Examples:
+  gt epic close gt-epic-auth
+  gt epic close gt-epic-auth --cascade --reason "descoped"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runEpicClose,
+}
+
+func init() {
+	epicStatusCmd.Flags().BoolVar(&epicStatusJSON, "json", false, "Output as JSON")
+	epicTreeCmd.Flags().BoolVar(&epicTreeJSON, "json", false, "Output as JSON")
+	epicCloseCmd.Flags().BoolVar(&epicCloseCascade, "cascade", false, "Close open children first")
+	epicCloseCmd.Flags().BoolVar(&epicCloseForce, "force", false, "With --cascade, also close children being worked")
+	epicCloseCmd.Flags().StringVar(&epicCloseReason, "reason", "", "Close reason (default: \"epic closed\")")
+
+	epicCmd.AddCommand(epicStatusCmd)
+	epicCmd.AddCommand(epicTreeCmd)
+	epicCmd.AddCommand(epicCloseCmd)
+	rootCmd.AddCommand(epicCmd)
+}
+
+// Epic child states, in display order.
+const (
+	epicStateDone    = "done"
+	epicStateWorking = "working"
+	epicStateQueued  = "queued"
+	epicStateBlocked = "blocked"
+	epicStateReady   = "ready"
+)
+
+var epicStateOrder = []string{epicStateDone, epicStateWorking, epicStateQueued, epicStateBlocked, epicStateReady}
+
+// epicChildState classifies a child bead for display.
+func epicChildState(status string, scheduled, blocked bool) string {
+	switch status {
+	case "closed", "tombstone":
+		return epicStateDone
+	case "hooked", "in_progress", "pinned":
+		return epicStateWorking
+	}
+	if scheduled {
+		return epicStateQueued
+	}
+	if blocked {
+		return epicStateBlocked
+	}
+	return epicStateReady
+}
+
+// epicStateStyle renders a state label in its display style.
+func epicStateStyle(state string) string {
+	switch state {
+	case epicStateDone:
+		return style.Success.Render(state)
+	case epicStateWorking:
+		return style.Bold.Render(state)
+	case epicStateBlocked:
+		return style.Warning.Render(state)
+	default:
+		return style.Dim.Render(state)
+	}
+}
+
+// epicStatusChild is one row of gt epic status.
+type epicStatusChild struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Status   string `json:"status"`
+	State    string `json:"state"`
+	Assignee string `json:"assignee,omitempty"`
+}
+
+// epicStatusOutput is the --json output of gt epic status.
+type epicStatusOutput struct {
+	ID       string            `json:"id"`
+	Title    string            `json:"title"`
+	Status   string            `json:"status"`
+	Total    int               `json:"total"`
+	Counts   map[string]int    `json:"counts"`
+	Children []epicStatusChild `json:"children"`
+}
+
+func runEpicStatus(cmd *cobra.Command, args []string) error {
+	epicID := args[0]
+	epic, err := bdShow(epicID)
+	if err != nil {
+		return fmt.Errorf("epic '%s' not found", epicID)
+	}
+	children, err := getEpicChildren(epicID)
+	if err != nil {
+		return fmt.Errorf("listing children of %s: %w", epicID, err)
+	}
+
+	ids := make([]string, 0, len(children))
+	for _, c := range children {
+		ids = append(ids, c.ID)
+	}
+	scheduled := areScheduled(ids)
+
+	rows := make([]epicStatusChild, 0, len(children))
+	counts := make(map[string]int)
+	for _, c := range children {
+		state := epicChildState(c.Status, scheduled[c.ID], false)
+		counts[state]++
+		rows = append(rows, epicStatusChild{ID: c.ID, Title: c.Title, Status: c.Status, State: state, Assignee: c.Assignee})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return epicStateRank(rows[i].State) < epicStateRank(rows[j].State)
+	})
+
+	if epicStatusJSON {
+		out := epicStatusOutput{epicID, epic.Title, epic.Status, len(rows), counts, rows}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+
+	fmt.Printf("%s %s [%s]\n", style.Bold.Render(epicID), epic.Title, epic.Status)
+	if len(rows) == 0 {
+		fmt.Println("  No child issues.")
+		return nil
+	}
+	var summary []string
+	for _, state := range epicStateOrder {
+		if counts[state] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", counts[state], state))
+		}
+	}
+	fmt.Printf("  %d/%d done (%s)\n\n", counts[epicStateDone], len(rows), strings.Join(summary, ", "))
+	for _, r := range rows {
+		line := fmt.Sprintf("  %-8s %s: %s", epicStateStyle(r.State), r.ID, r.Title)
+		if r.Assignee != "" && r.State == epicStateWorking {
+			line += style.Dim.Render(" → " + r.Assignee)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+func epicStateRank(state string) int {
+	for i, s := range epicStateOrder {
+		if s == state {
+			return i
+		}
+	}
+	return len(epicStateOrder)
+}
+
+// epicTreeNodeJSON is gt epic tree --json output.
+type epicTreeNodeJSON struct {
+	ID        string             `json:"id"`
+	Title     string             `json:"title"`
+	Type      string             `json:"type"`
+	Status    string             `json:"status"`
+	State     string             `json:"state"`
+	BlockedBy []string           `json:"blocked_by,omitempty"`
+	Children  []epicTreeNodeJSON `json:"children,omitempty"`
+}
+
+func runEpicTree(cmd *cobra.Command, args []string) error {
+	epicID := args[0]
+	beadList, deps, err := collectEpicBeads(epicID)
+	if err != nil {
+		return err
+	}
+	dag := buildConvoyDAG(beadList, deps)
+	states := epicTreeStates(dag, areScheduled(sortedNodeIDs(dag)))
+
+	if epicTreeJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(buildEpicTreeJSON(dag, epicID, states))
+	}
+	fmt.Print(renderEpicTree(dag, epicID, states))
+	return nil
+}
+
+// epicTreeStates classifies every node in dag. A node is blocked while any
+// of its blockers is not done.
+func epicTreeStates(dag *ConvoyDAG, scheduled map[string]bool) map[string]string {
+	states := make(map[string]string, len(dag.Nodes))
+	for id, node := range dag.Nodes {
+		blocked := false
+		for _, b := range node.BlockedBy {
+			if blocker := dag.Nodes[b]; blocker != nil && blocker.Status != "closed" && blocker.Status != "tombstone" {
+				blocked = true
+				break
+			}
+		}
+		states[id] = epicChildState(node.Status, scheduled[id], blocked)
+	}
+	return states
+}
+
+// renderEpicTree renders the hierarchy under rootID with each node's state
+// and open blockers.
+func renderEpicTree(dag *ConvoyDAG, rootID string, states map[string]string) string {
+	root := dag.Nodes[rootID]
+	if root == nil {
+		return ""
+	}
+	var buf strings.Builder
+	buf.WriteString(epicTreeLine(dag, root, states))
+	buf.WriteString("\n")
+	children := sortedChildren(dag, rootID)
+	for i, childID := range children {
+		renderEpicTreeNode(dag, childID, "", i == len(children)-1, states, &buf)
+	}
+	return buf.String()
+}
+
+func renderEpicTreeNode(dag *ConvoyDAG, nodeID, prefix string, isLast bool, states map[string]string, buf *strings.Builder) {
+	node := dag.Nodes[nodeID]
+	if node == nil {
+		return
+	}
+	connector, childPrefix := "├── ", prefix+"│   "
+	if isLast {
+		connector, childPrefix = "└── ", prefix+"    "
+	}
+	buf.WriteString(prefix + connector + epicTreeLine(dag, node, states) + "\n")
+
+	children := sortedChildren(dag, nodeID)
+	for i, childID := range children {
+		renderEpicTreeNode(dag, childID, childPrefix, i == len(children)-1, states, buf)
+	}
+}
+
+// epicTreeLine formats a node as: <id> <title> [<state>] ← blocked by: <open blockers>
+func epicTreeLine(dag *ConvoyDAG, node *ConvoyDAGNode, states map[string]string) string {
+	line := fmt.Sprintf("%s %s [%s]", node.ID, node.Title, epicStateStyle(states[node.ID]))
+	var open []string
+	for _, b := range node.BlockedBy {
+		if blocker := dag.Nodes[b]; blocker != nil && states[b] != epicStateDone {
+			open = append(open, b)
+		}
+	}
+	if len(open) > 0 {
+		sort.Strings(open)
+		line += style.Dim.Render(" ← blocked by: " + strings.Join(open, ", "))
+	}
+	return line
+}
+
+func buildEpicTreeJSON(dag *ConvoyDAG, nodeID string, states map[string]string) epicTreeNodeJSON {
+	node := dag.Nodes[nodeID]
+	if node == nil {
+		return epicTreeNodeJSON{ID: nodeID}
+	}
+	out := epicTreeNodeJSON{
+		ID:        node.ID,
+		Title:     node.Title,
+		Type:      node.Type,
+		Status:    node.Status,
+		State:     states[node.ID],
+		BlockedBy: node.BlockedBy,
+	}
+	for _, childID := range sortedChildren(dag, nodeID) {
+		out.Children = append(out.Children, buildEpicTreeJSON(dag, childID, states))
+	}
+	return out
+}
+
+func runEpicClose(cmd *cobra.Command, args []string) error {
+	epicID := args[0]
+	epic, err := bdShow(epicID)
+	if err != nil {
+		return fmt.Errorf("epic '%s' not found", epicID)
+	}
+	if epic.Status == "closed" {
+		fmt.Printf("%s Epic %s is already closed\n", style.Dim.Render("○"), epicID)
+		return nil
+	}
+	reason := epicCloseReason
+	if reason == "" {
+		reason = "epic closed"
+	}
+
+	closed, remaining, err := closeEpicChildren(epicID, reason, epicCloseCascade, epicCloseForce, map[string]bool{epicID: true})
+	if err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		sort.Strings(remaining)
+		hint := "use --cascade to close them"
+		if epicCloseCascade {
+			hint = "use --force to close children being worked"
+		}
+		return fmt.Errorf("epic %s has %d open child(ren): %s\n%s", epicID, len(remaining), strings.Join(remaining, ", "), hint)
+	}
+
+	if err := beads.New(resolveBeadDir(epicID)).CloseWithReason(reason, epicID); err != nil {
+		return fmt.Errorf("closing %s: %w", epicID, err)
+	}
+	if closed > 0 {
+		fmt.Printf("%s Closed epic %s and %d child(ren)\n", style.Bold.Render("✓"), epicID, closed)
+	} else {
+		fmt.Printf("%s Closed epic %s\n", style.Bold.Render("✓"), epicID)
+	}
+	return nil
+}
+
+// Seams for tests.
+var (
+	listEpicCloseChildrenFn = bdListChildren
+	closeEpicBeadFn         = func(reason, id string) error {
+		return beads.New(resolveBeadDir(id)).CloseWithReason(reason, id)
+	}
+)
+
+// closeEpicChildren closes the open parent-child children of epicID when
+// cascade is set, recursing only into child epics (depth-first, so a child
+// epic's own children close before it). Beads linked to the epic only by a
+// dependency are never closed. Returns how many beads it closed and the IDs
+// left open: every open child without cascade, or children being worked
+// without force.
+func closeEpicChildren(epicID, reason string, cascade, force bool, visited map[string]bool) (int, []string, error) {
+	children, err := listEpicCloseChildrenFn(epicID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("listing children of %s: %w", epicID, err)
+	}
+
+	closed := 0
+	var remaining []string
+	for _, c := range children {
+		if visited[c.ID] {
+			continue
+		}
+		visited[c.ID] = true
+
+		state := epicChildState(c.Status, false, false)
+		if state == epicStateDone {
+			continue
+		}
+		if !cascade || (state == epicStateWorking && !force) {
+			remaining = append(remaining, c.ID)
+			continue
+		}
+
+		if c.IssueType == "epic" {
+			n, left, err := closeEpicChildren(c.ID, reason, cascade, force, visited)
+			closed += n
+			if err != nil {
+				return closed, remaining, err
+			}
+			if len(left) > 0 {
+				remaining = append(remaining, left...)
+				remaining = append(remaining, c.ID)
+				continue
+			}
+		}
+
+		if err := closeEpicBeadFn(reason, c.ID); err != nil {
+			fmt.Printf("  %s %s: %v\n", style.Error.Render("✗"), c.ID, err)
+			remaining = append(remaining, c.ID)
+			continue
+		}
+		fmt.Printf("  %s Closed %s: %s\n", style.Dim.Render("○"), c.ID, c.Title)
+		closed++
+	}
+	return closed, remaining, nil
+}
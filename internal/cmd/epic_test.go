@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEpicChildState(t *testing.T) {
+	tests := []struct {
+		status    string
+		scheduled bool
+		blocked   bool
+		want      string
+	}{
+		{"closed", true, false, epicStateDone},
+		{"hooked", false, false, epicStateWorking},
+		{"in_progress", true, true, epicStateWorking},
+		{"open", true, true, epicStateQueued},
+		{"open", false, true, epicStateBlocked},
+		{"open", false, false, epicStateReady},
+	}
+	for _, tt := range tests {
+		if got := epicChildState(tt.status, tt.scheduled, tt.blocked); got != tt.want {
+			t.Errorf("epicChildState(%q, %v, %v) = %q, want %q", tt.status, tt.scheduled, tt.blocked, got, tt.want)
+		}
+	}
+}
+
+func TestRenderEpicTree(t *testing.T) {
+	dag := buildConvoyDAG(
+		[]BeadInfo{
+			{ID: "gt-epic", Title: "Auth", Type: "epic", Status: "open"},
+			{ID: "gt-a", Title: "Schema", Type: "task", Status: "closed"},
+			{ID: "gt-b", Title: "API", Type: "task", Status: "open"},
+			{ID: "gt-c", Title: "UI", Type: "task", Status: "open"},
+			{ID: "gt-d", Title: "Docs", Type: "task", Status: "hooked"},
+		},
+		[]DepInfo{
+			{IssueID: "gt-a", DependsOnID: "gt-epic", Type: "parent-child"},
+			{IssueID: "gt-b", DependsOnID: "gt-epic", Type: "parent-child"},
+			{IssueID: "gt-c", DependsOnID: "gt-epic", Type: "parent-child"},
+			{IssueID: "gt-d", DependsOnID: "gt-epic", Type: "parent-child"},
+			{IssueID: "gt-b", DependsOnID: "gt-a", Type: "blocks"},
+			{IssueID: "gt-c", DependsOnID: "gt-b", Type: "blocks"},
+		},
+	)
+	states := epicTreeStates(dag, map[string]bool{"gt-b": true})
+
+	want := map[string]string{
+		"gt-a": epicStateDone,
+		"gt-b": epicStateQueued,
+		"gt-c": epicStateBlocked,
+		"gt-d": epicStateWorking,
+	}
+	for id, state := range want {
+		if states[id] != state {
+			t.Errorf("state[%s] = %q, want %q", id, states[id], state)
+		}
+	}
+
+	out := renderEpicTree(dag, "gt-epic", states)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d lines, want 5:\n%s", len(lines), out)
+	}
+	if !strings.HasPrefix(lines[0], "gt-epic Auth") {
+		t.Errorf("root line = %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[4], "└── ") {
+		t.Errorf("last child line = %q", lines[4])
+	}
+	for _, line := range lines {
+		if strings.Contains(line, "gt-b API") && strings.Contains(line, "blocked by") {
+			t.Errorf("closed blocker still shown: %q", line)
+		}
+		if strings.Contains(line, "gt-c UI") && !strings.Contains(line, "blocked by: gt-b") {
+			t.Errorf("open blocker not shown: %q", line)
+		}
+	}
+}
+
+func TestCloseEpicChildren_CascadeOnlyIntoEpics(t *testing.T) {
+	children := map[string][]bdShowResult{
+		"gt-epic": {
+			{ID: "gt-sub", Title: "Sub", IssueType: "epic", Status: "open"},
+			{ID: "gt-task", Title: "Task", IssueType: "task", Status: "open"},
+			{ID: "gt-busy", Title: "Busy", IssueType: "task", Status: "hooked"},
+		},
+		"gt-sub":  {{ID: "gt-leaf", Title: "Leaf", IssueType: "task", Status: "open"}},
+		"gt-task": {{ID: "gt-outside", Title: "Outside", IssueType: "task", Status: "open"}},
+	}
+	var listed, closedIDs []string
+	origList, origClose := listEpicCloseChildrenFn, closeEpicBeadFn
+	t.Cleanup(func() { listEpicCloseChildrenFn, closeEpicBeadFn = origList, origClose })
+	listEpicCloseChildrenFn = func(id string) ([]bdShowResult, error) {
+		listed = append(listed, id)
+		return children[id], nil
+	}
+	closeEpicBeadFn = func(_, id string) error {
+		closedIDs = append(closedIDs, id)
+		return nil
+	}
+
+	n, remaining, err := closeEpicChildren("gt-epic", "done", true, false, map[string]bool{"gt-epic": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(listed, ","); got != "gt-epic,gt-sub" {
+		t.Errorf("listed children of %s, want only gt-epic and gt-sub", got)
+	}
+	if got := strings.Join(closedIDs, ","); got != "gt-leaf,gt-sub,gt-task" {
+		t.Errorf("closed %s, want gt-leaf,gt-sub,gt-task", got)
+	}
+	if n != 3 || len(remaining) != 1 || remaining[0] != "gt-busy" {
+		t.Errorf("closed=%d remaining=%v, want 3 and [gt-busy]", n, remaining)
+	}
+}
@@ -13,6 +13,7 @@ var (
 	escalateJSON        bool
 	escalateListJSON    bool
 	escalateListAll     bool
+	escalateListPage    listFlags
 	escalateStaleJSON   bool
 	escalateDryRun      bool
 	escalateCloseReason string
@@ -149,6 +150,7 @@ func init() {
 	// List subcommand flags
 	escalateListCmd.Flags().BoolVar(&escalateListJSON, "json", false, "Output as JSON")
 	escalateListCmd.Flags().BoolVar(&escalateListAll, "all", false, "Include closed escalations")
+	escalationListing.register(escalateListCmd, &escalateListPage, 0)
 
 	// Close subcommand flags
 	escalateCloseCmd.Flags().StringVar(&escalateCloseReason, "reason", "", "Resolution reason")
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/smtp"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -270,15 +272,18 @@ func runEscalateList(cmd *cobra.Command, args []string) error {
 		}
 		live = append(live, issue)
 	}
-	issues = live
+
+	page, err := escalationListing.apply(live, escalateListPage)
+	if err != nil {
+		return err
+	}
+	issues = page.Items
 
 	if escalateListJSON {
-		out, _ := json.MarshalIndent(issues, "", "  ")
-		fmt.Println(string(out))
-		return nil
+		return printPageJSON(page, issues)
 	}
 
-	if len(issues) == 0 {
+	if page.Total == 0 {
 		if phantomCount > 0 {
 			fmt.Printf("No escalations found (%d phantom entr%s skipped — bead IDs no longer exist in live Dolt)\n",
 				phantomCount, map[bool]string{true: "y", false: "ies"}[phantomCount == 1])
@@ -288,7 +293,7 @@ func runEscalateList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	fmt.Printf("Escalations (%d):\n\n", len(issues))
+	fmt.Printf("Escalations (%d):\n\n", page.Total)
 	for _, issue := range issues {
 		fields := beads.ParseEscalationFields(issue.Description)
 		emoji := severityEmoji(fields.Severity)
@@ -306,10 +311,29 @@ func runEscalateList(cmd *cobra.Command, args []string) error {
 		}
 		fmt.Println()
 	}
+	printPageFooter(page)
 
 	return nil
 }
 
+// escalationListing sorts gt escalate list entries, oldest first.
+var escalationListing = listing[*beads.Issue]{
+	fields: []listField[*beads.Issue]{
+		{"created", func(i *beads.Issue) any {
+			if t, err := time.Parse(time.RFC3339, i.CreatedAt); err == nil {
+				return t
+			}
+			return i.CreatedAt
+		}},
+		{"severity", func(i *beads.Issue) any {
+			return slices.Index(config.ValidSeverities(), beads.ParseEscalationFields(i.Description).Severity)
+		}},
+		{"status", func(i *beads.Issue) any { return i.Status }},
+	},
+	defaultSort: "created",
+	id:          func(i *beads.Issue) string { return i.ID },
+}
+
 func runEscalateAck(cmd *cobra.Command, args []string) error {
 	escalationID := args[0]
 
@@ -863,30 +887,7 @@ func formatRelativeTime(timestamp string) string {
 	if err != nil {
 		return timestamp
 	}
-
-	duration := time.Since(t)
-	if duration < time.Minute {
-		return "just now"
-	}
-	if duration < time.Hour {
-		mins := int(duration.Minutes())
-		if mins == 1 {
-			return "1 minute ago"
-		}
-		return fmt.Sprintf("%d minutes ago", mins)
-	}
-	if duration < 24*time.Hour {
-		hours := int(duration.Hours())
-		if hours == 1 {
-			return "1 hour ago"
-		}
-		return fmt.Sprintf("%d hours ago", hours)
-	}
-	days := int(duration.Hours() / 24)
-	if days == 1 {
-		return "1 day ago"
-	}
-	return fmt.Sprintf("%d days ago", days)
+	return humanize.Ago(t)
 }
 
 // detectSender is defined in mail_send.go - we reuse it here
@@ -197,32 +197,32 @@ func TestFormatRelativeTime(t *testing.T) {
 		{
 			name:      "1 minute ago",
 			timestamp: now.Add(-1 * time.Minute).Format(time.RFC3339),
-			want:      "1 minute ago",
+			want:      "1m ago",
 		},
 		{
 			name:      "multiple minutes ago",
 			timestamp: now.Add(-15 * time.Minute).Format(time.RFC3339),
-			want:      "15 minutes ago",
+			want:      "15m ago",
 		},
 		{
 			name:      "1 hour ago",
 			timestamp: now.Add(-1 * time.Hour).Format(time.RFC3339),
-			want:      "1 hour ago",
+			want:      "1h ago",
 		},
 		{
 			name:      "multiple hours ago",
 			timestamp: now.Add(-5 * time.Hour).Format(time.RFC3339),
-			want:      "5 hours ago",
+			want:      "5h ago",
 		},
 		{
 			name:      "1 day ago",
 			timestamp: now.Add(-25 * time.Hour).Format(time.RFC3339),
-			want:      "1 day ago",
+			want:      "1d ago",
 		},
 		{
 			name:      "multiple days ago",
 			timestamp: now.Add(-72 * time.Hour).Format(time.RFC3339),
-			want:      "3 days ago",
+			want:      "3d ago",
 		},
 		{
 			name:      "invalid timestamp returns raw",
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
 	"github.com/steveyegge/gastown/internal/estop"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -73,6 +74,9 @@ func init() {
 }
 
 func runEstop(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
@@ -154,6 +158,9 @@ func runEstopRig(townRoot, rigName string) error {
 }
 
 func runThaw(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
@@ -16,7 +16,7 @@ import (
 
 var (
 	feedFollow   bool
-	feedLimit    int
+	feedPage     listFlags
 	feedSince    string
 	feedMol      string
 	feedType     string
@@ -32,7 +32,7 @@ func init() {
 
 	feedCmd.Flags().BoolVarP(&feedFollow, "follow", "f", false, "Stream events in real-time (default when no other flags)")
 	feedCmd.Flags().BoolVar(&feedNoFollow, "no-follow", false, "Show events once and exit")
-	feedCmd.Flags().IntVarP(&feedLimit, "limit", "n", 100, "Maximum number of events to show")
+	feedEventListing.register(feedCmd, &feedPage, 100)
 	feedCmd.Flags().StringVar(&feedSince, "since", "", "Show events since duration (e.g., 5m, 1h, 30s)")
 	feedCmd.Flags().StringVar(&feedMol, "mol", "", "Filter by molecule/issue ID prefix")
 	feedCmd.Flags().StringVar(&feedType, "type", "", "Filter by event type (create, update, delete, comment)")
@@ -180,8 +180,17 @@ func buildFeedArgs() []string {
 		args = append(args, "--follow")
 	}
 
-	if feedLimit != 100 {
-		args = append(args, "--limit", fmt.Sprintf("%d", feedLimit))
+	if feedPage.Limit != 100 {
+		args = append(args, "--limit", fmt.Sprintf("%d", feedPage.Limit))
+	}
+	if feedPage.Sort != feedEventListing.defaultSort {
+		args = append(args, "--sort", feedPage.Sort)
+	}
+	if feedPage.Offset != 0 {
+		args = append(args, "--offset", fmt.Sprintf("%d", feedPage.Offset))
+	}
+	if feedPage.Cursor != "" {
+		args = append(args, "--cursor", feedPage.Cursor)
 	}
 
 	if feedSince != "" {
@@ -217,8 +226,14 @@ func runFeedDirect(townRoot string) error {
 		shouldFollow = term.IsTerminal(int(os.Stdout.Fd()))
 	}
 
+	// The initial batch is paged like any list, then printed oldest first.
+	var page listPage[feed.Event]
 	opts := feed.PrintOptions{
-		Limit:  feedLimit,
+		Page: func(events []feed.Event) ([]feed.Event, error) {
+			var err error
+			page, err = feedEventListing.apply(events, feedPage)
+			return page.Items, err
+		},
 		Follow: shouldFollow,
 		Since:  feedSince,
 		Mol:    feedMol,
@@ -226,7 +241,26 @@ func runFeedDirect(townRoot string) error {
 		Rig:    feedRig,
 	}
 
-	return feed.PrintGtEvents(townRoot, opts)
+	if err := feed.PrintGtEvents(townRoot, opts); err != nil {
+		return err
+	}
+	if !shouldFollow {
+		printPageFooter(page)
+	}
+	return nil
+}
+
+// feedEventListing sorts gt feed --plain events, newest first.
+var feedEventListing = listing[feed.Event]{
+	fields: []listField[feed.Event]{
+		{"time", func(e feed.Event) any { return e.Time }},
+		{"type", func(e feed.Event) any { return e.Type }},
+		{"actor", func(e feed.Event) any { return e.Actor }},
+		{"rig", func(e feed.Event) any { return e.Rig }},
+	},
+	defaultSort: "-time",
+	// Events have no single unique key; the raw log line is stable across runs.
+	id: func(e feed.Event) string { return e.Raw },
 }
 
 // runFeedTUI runs the interactive TUI feed.
@@ -53,6 +53,7 @@ Commands:
   show    Display formula details (steps, variables, composition)
   run     Execute a formula (pour and dispatch)
   create  Create a new formula template
+  test    Cook a formula against a fixture bead and check its assertions
 
 Search paths (in order):
   1. .beads/formulas/ (project)
@@ -193,7 +194,7 @@ func runFormulaList(cmd *cobra.Command, args []string) error {
 		bdArgs = append(bdArgs, "--json")
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd := beads.Command(bdArgs...)
 	bdCmd.Stdout = os.Stdout
 	bdCmd.Stderr = os.Stderr
 	return bdCmd.Run()
@@ -207,7 +208,7 @@ func runFormulaShow(cmd *cobra.Command, args []string) error {
 		bdArgs = append(bdArgs, "--json")
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd := beads.Command(bdArgs...)
 	bdCmd.Stdout = os.Stdout
 	bdCmd.Stderr = os.Stderr
 	return bdCmd.Run()
@@ -492,7 +493,7 @@ func executeConvoyFormula(f *formula.Formula, formulaName, targetRig string) err
 		createArgs = append(createArgs, "--force")
 	}
 
-	createCmd := exec.Command("bd", createArgs...)
+	createCmd := beads.Command(createArgs...)
 	createCmd.Dir = townBeads
 	createCmd.Stderr = os.Stderr
 	if err := createCmd.Run(); err != nil {
@@ -707,7 +708,7 @@ func executeConvoyFormula(f *formula.Formula, formulaName, targetRig string) err
 				style.Dim.Render("Warning:"), leg.ID, err)
 			// Add comment to bead about failure
 			commentArgs := []string{"comment", legBeadID, fmt.Sprintf("Failed to sling: %v", err)}
-			commentCmd := exec.Command("bd", commentArgs...)
+			commentCmd := beads.Command(commentArgs...)
 			commentCmd.Dir = townBeads
 			_ = commentCmd.Run()
 			continue
@@ -0,0 +1,357 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/formula"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+	"github.com/steveyegge/gastown/internal/worktree"
+)
+
+var (
+	formulaTestFixture string
+	formulaTestRig     string
+	formulaTestVars    []string
+	formulaTestKeep    bool
+	formulaTestJSON    bool
+)
+
+// formulaTestDir is where gt formula test writes the cooked formula in the
+// throwaway worktree.
+const formulaTestDir = ".formula-test"
+
+func init() {
+	formulaTestCmd.Flags().StringVar(&formulaTestFixture, "fixture", "", "Fixture bead JSON to cook the formula against (required)")
+	formulaTestCmd.Flags().StringVar(&formulaTestRig, "rig", "", "Rig whose repo the worktree is taken from (default: fixture's rig, else the current repo)")
+	formulaTestCmd.Flags().StringArrayVar(&formulaTestVars, "var", nil, "Extra variable as key=value, as gt sling --var (repeatable)")
+	formulaTestCmd.Flags().BoolVar(&formulaTestKeep, "keep", false, "Keep the throwaway worktree for inspection")
+	formulaTestCmd.Flags().BoolVar(&formulaTestJSON, "json", false, "Output as JSON")
+	_ = formulaTestCmd.MarkFlagRequired("fixture")
+	formulaCmd.AddCommand(formulaTestCmd)
+}
+
+var formulaTestCmd = &cobra.Command{
+	Use:   "test <name|path>",
+	Short: "Cook a formula against a fixture bead and check its assertions",
+	Long: `Cook a formula against a fixture bead in a throwaway worktree and run
+the assertions the formula declares, without queueing real work.
+
+The formula is cooked with bd cook, as gt sling cooks it, with the vars
+gt sling would pass for the fixture bead: formula defaults, then the rig's
+command vars, then feature and issue from the bead, then the fixture's
+"vars" and --var flags. The cooked steps are written to .formula-test/ in a
+detached worktree of the rig's repo (or of the current repo), at base_branch
+when it exists.
+
+Every run checks that no {{var}} is left unset. Further assertions live in
+the formula's [test] table:
+
+  [test]
+  files = ["go.mod", "docs/{{issue}}.md"]   # Must exist in the worktree
+
+  [[test.prompts]]
+  step = "implement"                        # Omit for every step
+  contains = ["{{issue}}", "go test ./..."] # Cooked prompt must contain
+  vars = ["issue", "test_command"]          # Prompt must use, and show the value
+
+A fixture is a bead as bd show --json prints it, plus optional "rig" and
+"vars":
+
+  {"id": "gt-abc", "title": "Fix login", "description": "...",
+   "rig": "gastown", "vars": {"test_command": "go test ./..."}}
+
+Exits non-zero when an assertion fails.
+
+Examples:
+  gt formula test mol-polecat-work --fixture fixtures/bead.json
+  gt formula test ./my.formula.toml --fixture bead.json --var base_branch=dev
+  gt formula test shiny --fixture bead.json --keep   # Inspect .formula-test/`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFormulaTest,
+}
+
+// formulaTestReport is gt formula test --json output.
+type formulaTestReport struct {
+	Formula  string               `json:"formula"`
+	Fixture  string               `json:"fixture"`
+	Worktree string               `json:"worktree,omitempty"`
+	Vars     map[string]string    `json:"vars"`
+	Steps    []formula.CookedStep `json:"steps"`
+	Results  []formula.TestResult `json:"results"`
+	Passed   bool                 `json:"passed"`
+}
+
+func runFormulaTest(cmd *cobra.Command, args []string) error {
+	f, searchPaths, err := loadFormulaForTest(args[0])
+	if err != nil {
+		return err
+	}
+	if f, err = formula.Resolve(f, searchPaths); err != nil {
+		return fmt.Errorf("resolving formula: %w", err)
+	}
+	fx, err := formula.LoadFixture(formulaTestFixture)
+	if err != nil {
+		return fmt.Errorf("loading fixture: %w", err)
+	}
+
+	townRoot, _ := workspace.FindFromCwd()
+	rigName := formulaTestRig
+	if rigName == "" {
+		rigName = fx.Rig
+	}
+	base := make(map[string]string)
+	for _, kv := range loadRigCommandVars(townRoot, rigName) {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			base[k] = v
+		}
+	}
+	vars := f.FixtureVars(fx, base)
+	for _, kv := range formulaTestVars {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return fmt.Errorf("invalid --var %q: want key=value", kv)
+		}
+		vars[k] = v
+	}
+
+	dir, cleanup, err := formulaTestWorktree(townRoot, rigName, vars["base_branch"])
+	if err != nil {
+		return err
+	}
+	if !formulaTestKeep {
+		defer cleanup()
+	}
+
+	ref := args[0]
+	if strings.HasSuffix(ref, ".toml") {
+		if ref, err = filepath.Abs(ref); err != nil {
+			return err
+		}
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	out, err := cookFormulaForTest(ref, cwd, townRoot, vars)
+	if err != nil {
+		return err
+	}
+	cooked, err := formula.ParseCooked(out)
+	if err != nil {
+		return err
+	}
+	steps, missing := f.CookedSteps(cooked)
+	if err := writeCookedFormula(dir, f.Name, steps); err != nil {
+		return fmt.Errorf("writing cooked formula: %w", err)
+	}
+	results := f.RunTest(steps, missing, vars, dir)
+
+	failed := 0
+	for _, r := range results {
+		if !r.Pass {
+			failed++
+		}
+	}
+
+	if formulaTestJSON {
+		report := formulaTestReport{
+			Formula: f.Name, Fixture: formulaTestFixture, Vars: vars,
+			Steps: steps, Results: results, Passed: failed == 0,
+		}
+		if formulaTestKeep {
+			report.Worktree = dir
+		}
+		if err := outputJSON(report); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("%s Cooked %s against %s (%d steps)\n\n", style.Bold.Render("🧪"), f.Name, fixtureLabel(fx), len(steps))
+		for _, r := range results {
+			if r.Pass {
+				fmt.Printf("  %s %s\n", style.Bold.Render("✓"), r.Name)
+				continue
+			}
+			fmt.Printf("  %s %s", style.Error.Render("✗"), r.Name)
+			if r.Detail != "" {
+				fmt.Printf(" %s", style.Dim.Render("("+r.Detail+")"))
+			}
+			fmt.Println()
+		}
+		if f.Test == nil {
+			fmt.Printf("\n  %s No [test] table in the formula; only variables were checked.\n", style.Dim.Render("○"))
+		}
+		if formulaTestKeep {
+			fmt.Printf("\n  Worktree kept at %s (cooked steps in %s/)\n", dir, formulaTestDir)
+		}
+		fmt.Println()
+	}
+
+	if failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d assertions failed", failed, len(results))
+	}
+	if !formulaTestJSON {
+		fmt.Printf("%s All %d assertions passed\n", style.Bold.Render("✓"), len(results))
+	}
+	return nil
+}
+
+// cookFormulaForTest cooks a formula with vars through bd cook, the path
+// gt sling cooks formulas through, retrying with the embedded formula as
+// CookFormula does. It returns bd's JSON rendering of the cooked formula.
+var cookFormulaForTest = func(formulaRef, workDir, townRoot string, vars map[string]string) ([]byte, error) {
+	names := make([]string, 0, len(vars))
+	for k := range vars {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	cook := func(ref string) ([]byte, error) {
+		args := []string{"cook", ref, "--mode=runtime"}
+		for _, k := range names {
+			args = append(args, "--var", k+"="+vars[k])
+		}
+		return BdCmd(args...).Dir(workDir).WithGTRoot(townRoot).Output()
+	}
+
+	out, err := cook(formulaRef)
+	if err == nil {
+		return out, nil
+	}
+	resolved, cleanup := resolveFormulaToTempFile(formulaRef)
+	if cleanup != nil {
+		defer cleanup()
+	}
+	if resolved == formulaRef {
+		return nil, fmt.Errorf("cooking formula %s: %w", formulaRef, err)
+	}
+	if out, err = cook(resolved); err != nil {
+		return nil, fmt.Errorf("cooking formula %s: %w", formulaRef, err)
+	}
+	return out, nil
+}
+
+// loadFormulaForTest loads a formula by path, or by name from the formula
+// search paths and then the embedded formulas. It returns the search paths
+// for resolving extends.
+func loadFormulaForTest(nameOrPath string) (*formula.Formula, []string, error) {
+	var searchPaths []string
+	if cwd, err := os.Getwd(); err == nil {
+		searchPaths = append(searchPaths, filepath.Join(cwd, ".beads", "formulas"))
+	}
+	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+		searchPaths = append(searchPaths, filepath.Join(townRoot, ".beads", "formulas"))
+	}
+
+	if strings.HasSuffix(nameOrPath, ".toml") {
+		f, err := formula.ParseFile(nameOrPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing formula: %w", err)
+		}
+		return f, append([]string{filepath.Dir(nameOrPath)}, searchPaths...), nil
+	}
+	if path, err := findFormulaFile(nameOrPath); err == nil {
+		f, err := parseFormulaFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing formula: %w", err)
+		}
+		return f, searchPaths, nil
+	}
+	data, err := formula.GetEmbeddedFormulaContent(nameOrPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("formula %q not found", nameOrPath)
+	}
+	f, err := formula.Parse(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing formula: %w", err)
+	}
+	return f, searchPaths, nil
+}
+
+// formulaTestWorktree creates the throwaway worktree: a detached worktree
+// of the rig's repo, or of the repo containing the current directory, at
+// baseBranch when it exists. Outside any repo it is an empty directory.
+func formulaTestWorktree(townRoot, rigName, baseBranch string) (dir string, cleanup func(), err error) {
+	dir, err = os.MkdirTemp("", "gt-formula-test-")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup = func() { _ = os.RemoveAll(dir) }
+
+	repo := ""
+	if townRoot != "" && rigName != "" {
+		repo = filepath.Join(townRoot, rigName, "mayor", "rig")
+	} else if cwd, err := os.Getwd(); err == nil {
+		repo = cwd
+	}
+	if repo == "" {
+		return dir, cleanup, nil
+	}
+	g := git.NewGit(repo)
+	if _, err := g.Rev("HEAD"); err != nil {
+		if rigName != "" {
+			cleanup()
+			return "", nil, fmt.Errorf("rig %s has no repo at %s", rigName, repo)
+		}
+		return dir, cleanup, nil // Not in a repo: file assertions run against an empty dir
+	}
+
+	ref := "HEAD"
+	for _, candidate := range []string{"origin/" + baseBranch, baseBranch} {
+		if baseBranch == "" {
+			break
+		}
+		if ok, _ := g.RefExists(candidate); ok {
+			ref = candidate
+			break
+		}
+	}
+	// git worktree add wants to create the directory itself.
+	_ = os.Remove(dir)
+	worktrees := worktree.NewManager(g)
+	if err := worktrees.AddDetached(dir, ref); err != nil {
+		return "", nil, fmt.Errorf("creating worktree of %s at %s: %w", repo, ref, err)
+	}
+	return dir, func() {
+		if err := worktrees.Remove(dir, true); err != nil {
+			_ = os.RemoveAll(dir)
+			_ = worktrees.Prune()
+		}
+	}, nil
+}
+
+// writeCookedFormula writes the cooked steps to .formula-test/ in dir, one
+// file per step, as an agent would be given them.
+func writeCookedFormula(dir, name string, steps []formula.CookedStep) error {
+	out := filepath.Join(dir, formulaTestDir)
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+	var index strings.Builder
+	fmt.Fprintf(&index, "# %s (cooked)\n\n", name)
+	for i, s := range steps {
+		file := fmt.Sprintf("%02d-%s.md", i+1, s.ID)
+		fmt.Fprintf(&index, "%d. [%s](%s)\n", i+1, s.Title, file)
+		if err := os.WriteFile(filepath.Join(out, file), []byte(s.Prompt+"\n"), 0644); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(out, "README.md"), []byte(index.String()), 0644)
+}
+
+// fixtureLabel names a fixture bead for output.
+func fixtureLabel(fx *formula.Fixture) string {
+	if fx.ID == "" {
+		return "fixture"
+	}
+	if fx.Title == "" {
+		return fx.ID
+	}
+	return fx.ID + " (" + fx.Title + ")"
+}
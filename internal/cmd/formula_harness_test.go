@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/formula"
+)
+
+func TestFormulaTestWorktree(t *testing.T) {
+	townRoot := t.TempDir()
+	repo := filepath.Join(townRoot, "gastown", "mayor", "rig")
+	if err := os.MkdirAll(repo, 0755); err != nil {
+		t.Fatal(err)
+	}
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	run("init", "--initial-branch=main")
+	run("config", "user.email", "test@test.com")
+	run("config", "user.name", "Test User")
+	if err := os.WriteFile(filepath.Join(repo, "README.md"), []byte("# main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-m", "main")
+	run("checkout", "-b", "dev")
+	if err := os.WriteFile(filepath.Join(repo, "go.mod"), []byte("module x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-m", "dev")
+	run("checkout", "main")
+
+	dir, cleanup, err := formulaTestWorktree(townRoot, "gastown", "dev")
+	if err != nil {
+		t.Fatalf("formulaTestWorktree: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err != nil {
+		t.Errorf("worktree not at base_branch dev: %v", err)
+	}
+
+	steps := []formula.CookedStep{{ID: "load", Title: "Load gt-1", Prompt: "Load gt-1\n\nRead it"}}
+	if err := writeCookedFormula(dir, "demo", steps); err != nil {
+		t.Fatalf("writeCookedFormula: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, formulaTestDir, "01-load.md"))
+	if err != nil || !strings.Contains(string(data), "Read it") {
+		t.Errorf("cooked step = %q, %v", data, err)
+	}
+
+	cleanup()
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("worktree %s survived cleanup", dir)
+	}
+
+	if _, _, err := formulaTestWorktree(townRoot, "nosuchrig", ""); err == nil {
+		t.Error("a rig without a repo should fail")
+	}
+}
+
+func TestRunFormulaTest_CooksThroughBd(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "demo.formula.toml")
+	src := `formula = "demo"
+type = "workflow"
+
+[[steps]]
+id = "load"
+title = "Load {{issue}}"
+description = "Read {{feature}}"
+`
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fixture := filepath.Join(dir, "bead.json")
+	if err := os.WriteFile(fixture, []byte(`{"id":"gt-1","title":"Fix login"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	var gotRef string
+	var gotVars map[string]string
+	oldCook := cookFormulaForTest
+	t.Cleanup(func() { cookFormulaForTest = oldCook })
+	cookFormulaForTest = func(ref, workDir, townRoot string, vars map[string]string) ([]byte, error) {
+		gotRef, gotVars = ref, vars
+		return []byte(`{"formula":"demo","steps":[{"id":"load","title":"Load gt-1","description":"Read Fix login"}]}`), nil
+	}
+	oldFixture, oldJSON := formulaTestFixture, formulaTestJSON
+	t.Cleanup(func() { formulaTestFixture, formulaTestJSON = oldFixture, oldJSON })
+	formulaTestFixture, formulaTestJSON = fixture, true
+
+	out := captureStdout(t, func() {
+		if err := runFormulaTest(formulaTestCmd, []string{"demo.formula.toml"}); err != nil {
+			t.Errorf("runFormulaTest: %v", err)
+		}
+	})
+	if gotRef != path || gotVars["issue"] != "gt-1" || gotVars["feature"] != "Fix login" {
+		t.Errorf("bd cook called with %q, %v", gotRef, gotVars)
+	}
+	if !strings.Contains(out, `"prompt": "Load gt-1\n\nRead Fix login"`) {
+		t.Errorf("report should carry bd's cooked prompt:\n%s", out)
+	}
+}
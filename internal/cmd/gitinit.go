@@ -68,6 +68,8 @@ const HQGitignore = `# Gas Town HQ .gitignore
 **/heartbeat.json
 **/activity.json
 .events.jsonl
+.events-*.jsonl
+.events.index.json
 .feed.jsonl
 **/audit.log
 **/last-touched
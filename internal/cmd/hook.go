@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -317,7 +316,7 @@ func runHook(_ *cobra.Command, args []string) error {
 					if sessionID := runtime.SessionIDFromEnv(); sessionID != "" {
 						closeArgs = append(closeArgs, "--session="+sessionID)
 					}
-					closeCmd := exec.Command("bd", closeArgs...)
+					closeCmd := beads.Command(closeArgs...)
 					closeCmd.Stderr = os.Stderr
 					if err := closeCmd.Run(); err != nil {
 						return fmt.Errorf("closing completed bead %s: %w", existing.ID, err)
@@ -457,6 +456,14 @@ func checkPinnedBeadComplete(b *beads.Beads, issue *beads.Issue) (isComplete boo
 	return progress.Complete, true
 }
 
+// hookShowInfo is the --json output of gt hook show.
+type hookShowInfo struct {
+	Agent  string `json:"agent"`
+	BeadID string `json:"bead_id,omitempty"`
+	Title  string `json:"title,omitempty"`
+	Status string `json:"status"`
+}
+
 // runHookShow displays another agent's hook in compact one-line format.
 func runHookShow(cmd *cobra.Command, args []string) error {
 	var target string
@@ -537,13 +544,7 @@ func runHookShow(cmd *cobra.Command, args []string) error {
 
 	// JSON output
 	if moleculeJSON {
-		type compactInfo struct {
-			Agent  string `json:"agent"`
-			BeadID string `json:"bead_id,omitempty"`
-			Title  string `json:"title,omitempty"`
-			Status string `json:"status"`
-		}
-		info := compactInfo{Agent: target}
+		info := hookShowInfo{Agent: target}
 		if len(hookedBeads) > 0 {
 			info.BeadID = hookedBeads[0].ID
 			info.Title = hookedBeads[0].Title
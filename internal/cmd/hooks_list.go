@@ -120,14 +120,15 @@ func buildTargetInfo(target hooks.Target) listTargetInfo {
 	}
 }
 
-func outputListJSON(infos []listTargetInfo) error {
-	type listOutput struct {
-		Targets      []listTargetInfo `json:"targets"`
-		BasePath     string           `json:"base_path"`
-		OverridesDir string           `json:"overrides_dir"`
-	}
+// hooksListOutput is the --json output of gt hooks list.
+type hooksListOutput struct {
+	Targets      []listTargetInfo `json:"targets"`
+	BasePath     string           `json:"base_path"`
+	OverridesDir string           `json:"overrides_dir"`
+}
 
-	output := listOutput{
+func outputListJSON(infos []listTargetInfo) error {
+	output := hooksListOutput{
 		Targets:      infos,
 		BasePath:     hooks.BasePath(),
 		OverridesDir: hooks.OverridesDir(),
@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/chatops"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/ingest"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var ingestListen string
+
+var ingestCmd = &cobra.Command{
+	Use:     "ingest",
+	GroupID: GroupServices,
+	Short:   "Turn inbound emails and web forms into beads",
+	RunE:    requireSubcommand,
+	Long: `Bridge email and web forms into beads so non-technical stakeholders can
+file work that agents pick up.
+
+Point an email provider's inbound webhook (Mailgun routes, SendGrid Inbound
+Parse, Postmark, CloudMailin, an IMAP-to-webhook forwarder) or a form service
+at 'gt ingest serve'. Each POST becomes a bead: subject → title, body →
+description, sender recorded in the description.
+
+Configure in settings/config.json:
+
+  "ingest": {
+    "listen": "127.0.0.1:8095",
+    "rig": "gastown",
+    "labels": ["from-email", "triage"],
+    "allowed_senders": ["example.com", "pm@partner.io"],
+    "auto_enqueue": false
+  }
+
+The shared secret is read from GT_INGEST_TOKEN and must be sent as
+"Authorization: Bearer <token>" or ?token=<token>.
+
+With auto_enqueue=true each bead is scheduled on the configured rig at once.
+Otherwise, scheduler routing rules (gt scheduler auto) can match the labels.
+
+The same server takes scheduler requests from other systems (CI failure
+handlers, alerting pipelines) on /enqueue, and Slack slash commands on
+/slack; see 'gt ingest serve --help'.`,
+}
+
+var ingestServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run the inbound webhook that creates beads",
+	Long: `Run an HTTP endpoint that converts POSTed emails/forms into beads.
+
+Accepts JSON, urlencoded and multipart bodies. Recognised fields include
+subject/title, from/sender, and stripped-text/body-plain/text/body.
+
+  GT_INGEST_TOKEN=s3cret gt ingest serve
+  GT_INGEST_TOKEN=s3cret gt ingest serve --listen 0.0.0.0:8095
+
+  curl -H "Authorization: Bearer s3cret" -d subject="Broken link" \
+       -d text="The pricing page 404s" http://127.0.0.1:8095/
+
+POST /enqueue schedules work for deferred dispatch (scheduler.max_polecats
+> 0). Send JSON with either an existing bead or a title (and description)
+for a new one; rig defaults to ingest.rig, formula to the rig's default:
+
+  curl -H "Authorization: Bearer s3cret" -H "Content-Type: application/json" \
+       -d '{"title":"CI failed on main","description":"...","rig":"gastown",
+            "formula":"mol-polecat-work","vars":{"base_branch":"main"},
+            "labels":["ci"],"priority":1,"max_retries":5,"retry_backoff":"10m"}' \
+       http://127.0.0.1:8095/enqueue
+
+  → 202 {"bead":"gt-abc12","rig":"gastown","created":true}
+
+POST /slack serves a Slack slash command (e.g. /gt) when
+GT_SLACK_SIGNING_SECRET holds the Slack app's signing secret. Point the
+command's request URL at https://<host>/slack. It supports:
+
+  /gt queue status              scheduler state and queued beads
+  /gt limits                    usage limits (gt quota status)
+  /gt enqueue <bead> [rig] [formula]
+  /gt pause | /gt resume
+
+Chat users are "slack:<user ID>" in settings/roles.json (gt access):
+enqueue needs the enqueue action, pause and resume the pause action.
+Without a roles file, chat is read-only: enqueue, pause and resume are refused.`,
+	RunE: runIngestServe,
+}
+
+func init() {
+	ingestServeCmd.Flags().StringVar(&ingestListen, "listen", "", "Listen address (default: ingest.listen or "+config.DefaultIngestListen+")")
+	ingestCmd.AddCommand(ingestServeCmd)
+	rootCmd.AddCommand(ingestCmd)
+}
+
+func runIngestServe(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	token := os.Getenv("GT_INGEST_TOKEN")
+	if token == "" {
+		return fmt.Errorf("GT_INGEST_TOKEN is not set (required to authenticate webhook calls)")
+	}
+
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+	cfg := settings.Ingest
+	if cfg == nil {
+		cfg = &config.IngestConfig{}
+	}
+	if cfg.Rig != "" {
+		if _, isRig := IsRigName(cfg.Rig); !isRig {
+			return fmt.Errorf("ingest.rig %q is not a known rig", cfg.Rig)
+		}
+	}
+	if cfg.AutoEnqueue {
+		if cfg.Rig == "" {
+			return fmt.Errorf("ingest.auto_enqueue requires ingest.rig")
+		}
+		if !settings.Scheduler.IsDeferred() {
+			return fmt.Errorf("ingest.auto_enqueue requires deferred dispatch (gt config set scheduler.max_polecats N)")
+		}
+	}
+
+	listen := ingestListen
+	if listen == "" {
+		listen = cfg.GetListen()
+	}
+
+	deferred := settings.Scheduler.IsDeferred()
+	var enqueueMu sync.Mutex // One scheduling at a time: each runs several bd writes
+	enqueue := func(req *ingest.EnqueueRequest) (*ingest.EnqueueResult, error) {
+		if !deferred {
+			return nil, fmt.Errorf("deferred dispatch is off (gt config set scheduler.max_polecats N)")
+		}
+		enqueueMu.Lock()
+		defer enqueueMu.Unlock()
+		return enqueueIngestRequest(townRoot, cfg, req)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/enqueue", &ingest.EnqueueHandler{Token: token, Enqueue: enqueue})
+	slackSecret := os.Getenv("GT_SLACK_SIGNING_SECRET")
+	if slackSecret != "" {
+		mux.Handle("/slack", &chatops.Handler{
+			SigningSecret: slackSecret,
+			Run:           slackCommandRunner(townRoot, enqueue),
+		})
+	}
+	mux.Handle("/", &ingest.Handler{
+		Token:          token,
+		AllowedSenders: cfg.AllowedSenders,
+		Create: func(msg *ingest.Message) (string, error) {
+			return createIngestedBead(townRoot, cfg, msg)
+		},
+	})
+
+	fmt.Printf("%s Ingest webhook listening on %s", style.Bold.Render("✓"), listen)
+	if cfg.Rig != "" {
+		fmt.Printf(" → %s", cfg.Rig)
+	}
+	fmt.Println()
+	if slackSecret != "" {
+		fmt.Printf("%s Slack commands on /slack\n", style.Bold.Render("✓"))
+	}
+
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      60 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+// createIngestedBead files a bead for an inbound message and, when configured,
+// schedules it on the ingest rig.
+func createIngestedBead(townRoot string, cfg *config.IngestConfig, msg *ingest.Message) (string, error) {
+	issue, err := beads.New(townRoot).Create(beads.CreateOptions{
+		Title:       msg.Subject,
+		Description: msg.Description(),
+		Labels:      cfg.Labels,
+		Priority:    2,
+		Actor:       "ingest",
+		Rig:         cfg.Rig,
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating bead: %w", err)
+	}
+	fmt.Printf("%s %s: %s (from %s)\n", style.Bold.Render("→"), issue.ID, msg.Subject, msg.From)
+
+	if cfg.AutoEnqueue {
+		opts := ScheduleOptions{Formula: resolveFormula("", false, townRoot, cfg.Rig)}
+		if err := scheduleBead(issue.ID, cfg.Rig, opts); err != nil {
+			// The bead exists; report the scheduling failure without failing the request.
+			style.PrintWarning("created %s but could not schedule it: %v", issue.ID, err)
+		}
+	}
+	return issue.ID, nil
+}
+
+// enqueueIngestRequest schedules an /enqueue request: files a bead first when
+// the request has a title, then schedules it like gt sling would.
+func enqueueIngestRequest(townRoot string, cfg *config.IngestConfig, req *ingest.EnqueueRequest) (*ingest.EnqueueResult, error) {
+	// The bead ID is passed to bd as an argument; one starting with '-'
+	// would be read as a flag.
+	if req.Bead != "" && !looksLikeBeadID(req.Bead) {
+		return nil, fmt.Errorf("%w: %q is not a bead ID", ingest.ErrMalformedEnqueue, req.Bead)
+	}
+	rigName := req.Rig
+	if rigName == "" {
+		rigName = cfg.Rig
+	}
+	if rigName == "" {
+		return nil, fmt.Errorf("%w: rig required (no ingest.rig default)", ingest.ErrInvalidEnqueue)
+	}
+	if _, isRig := IsRigName(rigName); !isRig {
+		return nil, fmt.Errorf("%w: %q is not a known rig", ingest.ErrInvalidEnqueue, rigName)
+	}
+
+	result := &ingest.EnqueueResult{Bead: req.Bead, Rig: rigName}
+	if req.Bead == "" {
+		priority := 2
+		if req.Priority != nil {
+			priority = *req.Priority
+		}
+		issue, err := beads.New(townRoot).Create(beads.CreateOptions{
+			Title:       req.Title,
+			Description: req.Description,
+			Labels:      append(append([]string(nil), cfg.Labels...), req.Labels...),
+			Priority:    priority,
+			Actor:       "ingest",
+			Rig:         rigName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating bead: %w", err)
+		}
+		result.Bead, result.Created = issue.ID, true
+		fmt.Printf("%s %s: %s (via /enqueue)\n", style.Bold.Render("→"), issue.ID, req.Title)
+	} else if err := verifyBeadExists(req.Bead); err != nil {
+		return nil, fmt.Errorf("%w: bead %q not found", ingest.ErrInvalidEnqueue, req.Bead)
+	}
+
+	opts := ScheduleOptions{
+		Formula:  resolveFormula(req.Formula, false, townRoot, rigName),
+		Vars:     req.VarList(),
+		Priority: req.Priority,
+
+		MaxRetries:   req.MaxRetries,
+		RetryBackoff: req.RetryBackoff,
+	}
+	if err := scheduleBead(result.Bead, rigName, opts); err != nil {
+		if result.Created {
+			return nil, fmt.Errorf("created %s but could not schedule it: %w", result.Bead, err)
+		}
+		return nil, fmt.Errorf("%w: %v", ingest.ErrInvalidEnqueue, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/chatops"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/ingest"
+)
+
+// slackCommandTimeout bounds each gt subprocess a chat command runs.
+const slackCommandTimeout = 2 * time.Minute
+
+// slackReplyLimit keeps command output within a readable Slack message.
+const slackReplyLimit = 3000
+
+// slackCommandRunner runs routed /gt commands for gt ingest serve, checking
+// the Slack user's role first. Read-only commands run the matching gt
+// command and reply with its output. Pause and resume run in-process so the
+// audit trail records the Slack identity that was checked; enqueue goes
+// through the same path as the /enqueue endpoint.
+func slackCommandRunner(townRoot string, enqueue ingest.EnqueueFunc) chatops.RunFunc {
+	return func(c *chatops.SlashCommand, route chatops.Route) chatops.Response {
+		identity := c.Identity()
+		if route.Action != "" {
+			roles, err := access.Load(townRoot)
+			if err != nil {
+				return chatops.Reply(fmt.Sprintf("Checking access failed: %v", err))
+			}
+			// Anyone in the workspace can type a slash command, so without a
+			// roles file granting it, chat may only read.
+			if roles == nil {
+				_ = events.LogAudit(events.TypeAccessDenied, identity,
+					events.AccessDeniedPayload(c.Command+" "+route.Command, route.Action, "", ""))
+				return chatops.Reply(fmt.Sprintf("%s is disabled from chat: this town has no roles file (%s) granting it.",
+					route.Command, access.FileName))
+			}
+			if d := roles.Check(identity, route.Action); !d.Allowed {
+				_ = events.LogAudit(events.TypeAccessDenied, identity,
+					events.AccessDeniedPayload(c.Command+" "+route.Command, d.Action, d.Role, d.Required))
+				return chatops.Reply(fmt.Sprintf("You (%s, %s) may not run %s: needs %s or higher.",
+					identity, d.Role, route.Command, d.Required))
+			}
+		}
+
+		switch route.Command {
+		case chatops.CmdQueueStatus:
+			return chatops.Reply(slackGtOutput(townRoot, "scheduler", "status"))
+		case chatops.CmdLimits:
+			return chatops.Reply(slackGtOutput(townRoot, "quota", "status"))
+		case chatops.CmdPause, chatops.CmdResume:
+			paused := route.Command == chatops.CmdPause
+			changed, pausedBy, err := setSchedulerPaused(townRoot, paused, identity)
+			if err != nil {
+				return chatops.Reply(fmt.Sprintf("Could not %s the scheduler: %v", route.Command, err))
+			}
+			switch {
+			case !changed && paused:
+				return chatops.Reply(fmt.Sprintf("Scheduler is already paused (by %s)", pausedBy))
+			case !changed:
+				return chatops.Reply("Scheduler is not paused")
+			case paused:
+				return chatops.Announce(fmt.Sprintf("<@%s> paused the scheduler", c.UserID))
+			default:
+				return chatops.Announce(fmt.Sprintf("<@%s> resumed the scheduler", c.UserID))
+			}
+		case chatops.CmdEnqueue:
+			req := &ingest.EnqueueRequest{Bead: route.Args[0]}
+			if len(route.Args) > 1 {
+				req.Rig = route.Args[1]
+			}
+			if len(route.Args) > 2 {
+				req.Formula = route.Args[2]
+			}
+			res, err := enqueue(req)
+			if err != nil {
+				return chatops.Reply(fmt.Sprintf("Could not enqueue %s: %v", req.Bead, err))
+			}
+			return chatops.Announce(fmt.Sprintf("<@%s> scheduled %s → %s", c.UserID, res.Bead, res.Rig))
+		default:
+			return chatops.Reply(chatops.Usage(c.Command))
+		}
+	}
+}
+
+// slackGtOutput runs a read-only gt command and formats its output as a code
+// block. The child runs as the server's OS user, so it must not be used for
+// commands that need the Slack user's identity.
+func slackGtOutput(townRoot string, args ...string) string {
+	gtPath, err := os.Executable()
+	if err != nil {
+		gtPath = "gt"
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), slackCommandTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, gtPath, args...)
+	cmd.Dir = townRoot
+	cmd.Env = append(os.Environ(), "NO_COLOR=1")
+	out, err := cmd.CombinedOutput()
+
+	text := strings.TrimSpace(string(out))
+	if len(text) > slackReplyLimit {
+		text = strings.ToValidUTF8(text[:slackReplyLimit], "") + "\n…"
+	}
+	if text == "" && err != nil {
+		text = err.Error()
+	}
+	return "```\n" + text + "\n```"
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/chatops"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// TestSlackPauseAuditsSlackIdentity verifies that a chat pause is recorded
+// against the Slack user whose role was checked, not the server's OS user.
+func TestSlackPauseAuditsSlackIdentity(t *testing.T) {
+	townRoot := setupTestTownForConfig(t)
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+	if err := os.Chdir(townRoot); err != nil {
+		t.Fatalf("chdir: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(townRoot, "settings"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	roles := `{"version": 1, "users": {"slack:U1": "operator"}}`
+	if err := os.WriteFile(access.Path(townRoot), []byte(roles), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	run := slackCommandRunner(townRoot, nil)
+	c := &chatops.SlashCommand{UserID: "U1", Command: "/gt"}
+	run(c, chatops.Route{Command: chatops.CmdPause, Action: access.ActionPause})
+
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		t.Fatalf("loading scheduler state: %v", err)
+	}
+	if !state.Paused || state.PausedBy != "slack:U1" {
+		t.Errorf("state paused=%v by %q, want paused by slack:U1", state.Paused, state.PausedBy)
+	}
+
+	f, err := os.Open(filepath.Join(townRoot, events.EventsFile))
+	if err != nil {
+		t.Fatalf("opening events: %v", err)
+	}
+	defer f.Close()
+	var found bool
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e events.Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("parsing event: %v", err)
+		}
+		if e.Type != events.TypeSchedulerPause {
+			continue
+		}
+		found = true
+		if e.Actor != "slack:U1" {
+			t.Errorf("scheduler_pause actor = %q, want slack:U1", e.Actor)
+		}
+	}
+	if !found {
+		t.Error("no scheduler_pause event recorded")
+	}
+}
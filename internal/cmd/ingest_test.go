@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/ingest"
+)
+
+func TestEnqueueIngestRequest_RejectsMalformedBead(t *testing.T) {
+	cfg := &config.IngestConfig{Rig: "gastown"}
+	for _, bead := range []string{"--help", "-x", "gt abc", "GT-ABC", "not a bead"} {
+		_, err := enqueueIngestRequest(t.TempDir(), cfg, &ingest.EnqueueRequest{Bead: bead})
+		if !errors.Is(err, ingest.ErrMalformedEnqueue) {
+			t.Errorf("bead %q: err = %v, want ErrMalformedEnqueue", bead, err)
+		}
+	}
+}
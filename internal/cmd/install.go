@@ -398,7 +398,7 @@ func runInstall(cmd *cobra.Command, args []string) error {
 		}
 
 		// Set beads routing mode to explicit (required by gt doctor).
-		routingCmd := exec.Command("bd", "config", "set", "routing.mode", "explicit")
+		routingCmd := beads.Command("config", "set", "routing.mode", "explicit")
 		routingCmd.Dir = absPath
 		routingCmd.Env = withBeadsDirEnv(filepath.Join(absPath, ".beads"))
 		if out, err := routingCmd.CombinedOutput(); err != nil {
@@ -481,6 +481,9 @@ func runInstall(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Register the new town so hooks run outside it can find it.
+	_ = workspace.TouchRegistry(absPath)
+
 	fmt.Printf("\n%s HQ created successfully!\n", style.Bold.Render("✓"))
 	fmt.Println()
 	fmt.Println("Next steps:")
@@ -599,7 +602,7 @@ func initTownBeads(townPath string) error {
 	// Forward GT_DOLT_PORT so bd connects to the correct server when a
 	// non-default port is configured (e.g., ephemeral test servers in CI).
 	bdInitArgs := buildBdInitArgs(townPath)
-	cmd := exec.Command("bd", bdInitArgs...)
+	cmd := beads.Command(bdInitArgs...)
 	cmd.Dir = townPath
 	cmd.Env = withBeadsDirEnv(filepath.Join(townPath, ".beads"))
 
@@ -634,7 +637,7 @@ func initTownBeads(townPath string) error {
 
 	// Set beads.role to maintainer (town-level beads are always maintainer-owned).
 	// Without this, bd doctor warns about missing role configuration.
-	roleSetCmd := exec.Command("bd", "config", "set", "beads.role", "maintainer")
+	roleSetCmd := beads.Command("config", "set", "beads.role", "maintainer")
 	roleSetCmd.Dir = townPath
 	roleSetCmd.Env = beadsEnv
 	if roleOutput, roleErr := roleSetCmd.CombinedOutput(); roleErr != nil {
@@ -642,7 +645,7 @@ func initTownBeads(townPath string) error {
 	}
 
 	// Explicitly set issue_prefix config (bd init --prefix may not persist it in newer versions).
-	prefixSetCmd := exec.Command("bd", "config", "set", "issue_prefix", "hq")
+	prefixSetCmd := beads.Command("config", "set", "issue_prefix", "hq")
 	prefixSetCmd.Dir = townPath
 	prefixSetCmd.Env = beadsEnv
 	if prefixOutput, prefixErr := prefixSetCmd.CombinedOutput(); prefixErr != nil {
@@ -657,7 +660,7 @@ func initTownBeads(townPath string) error {
 
 	// Configure allowed_prefixes for convoy beads (hq-cv-* IDs).
 	// This allows bd create --id=hq-cv-xxx to pass prefix validation.
-	prefixCmd := exec.Command("bd", "config", "set", "allowed_prefixes", "hq,hq-cv")
+	prefixCmd := beads.Command("config", "set", "allowed_prefixes", "hq,hq-cv")
 	prefixCmd.Dir = townPath
 	prefixCmd.Env = beadsEnv
 	if prefixOutput, prefixErr := prefixCmd.CombinedOutput(); prefixErr != nil {
@@ -712,7 +715,7 @@ func withBeadsDirEnv(beadsDir string) []string {
 // Gas Town needs custom types: agent, role, rig, convoy, slot.
 // This is idempotent - safe to call multiple times.
 func ensureCustomTypes(beadsPath string) error {
-	cmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+	cmd := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
 	cmd.Dir = beadsPath
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -803,7 +806,7 @@ func ensureBeadsCustomTypes(workDir string, types []string) error {
 		return nil
 	}
 
-	cmd := exec.Command("bd", "config", "set", "types.custom", strings.Join(types, ","))
+	cmd := beads.Command("config", "set", "types.custom", strings.Join(types, ","))
 	cmd.Dir = workDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/jira"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var jiraSyncDryRun bool
+
+var jiraCmd = &cobra.Command{
+	Use:     "jira",
+	GroupID: GroupWork,
+	Short:   "Sync Jira issues with rig beads",
+	RunE:    requireSubcommand,
+	Long: `Mirror selected Jira issues into a rig's beads and push status back.
+
+Configure per rig in <rig>/settings/config.json:
+
+  "jira": {
+    "base_url": "https://example.atlassian.net",
+    "email": "bot@example.com",
+    "jql": "project = WEB AND labels = agents",
+    "labels": ["from-jira"],
+    "transitions": {
+      "scheduled": "Selected for Development",
+      "in_progress": "In Progress",
+      "closed": "Done"
+    }
+  }
+
+The API token is read from JIRA_API_TOKEN (and the email from JIRA_EMAIL if
+not set in config). Without an email, the token is sent as a bearer personal
+access token (Jira Server/Data Center).
+
+Jira epics become epic beads and their child issues are parented to them, so
+'gt convoy create --from-epic' can track an epic's work as a convoy.
+
+Commands:
+  sync    Pull matching issues into beads and push status transitions`,
+}
+
+var jiraSyncCmd = &cobra.Command{
+	Use:   "sync <rig>",
+	Short: "Mirror Jira issues into beads and push status back",
+	Long: `Run one bidirectional sync pass for a rig.
+
+Jira → beads:
+  - Each issue matching the rig's JQL is created as a bead labelled jira:<KEY>,
+    or has its title refreshed if it already exists.
+  - Epics become epic beads; issues in an epic are parented to its bead.
+  - Issues resolved in Jira close their open bead.
+
+Beads → Jira:
+  - Each mirrored bead's status (open, hooked, in_progress, closed, or
+    "scheduled" while waiting in the scheduler) is mapped through
+    jira.transitions and applied once per status change.
+
+Examples:
+  gt jira sync gastown
+  gt jira sync gastown --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJiraSync,
+}
+
+func init() {
+	jiraSyncCmd.Flags().BoolVar(&jiraSyncDryRun, "dry-run", false, "Show what would change without writing beads or Jira")
+	jiraCmd.AddCommand(jiraSyncCmd)
+	rootCmd.AddCommand(jiraCmd)
+}
+
+// jiraStatePath returns the file recording which statuses were pushed to Jira.
+func jiraStatePath(townRoot, rigName string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "jira-sync-"+rigName+".json")
+}
+
+func runJiraSync(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	townRoot, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	settings, err := config.LoadRigSettings(config.RigSettingsPath(r.Path))
+	if err != nil {
+		return fmt.Errorf("loading rig settings: %w", err)
+	}
+	cfg := settings.Jira
+	if cfg == nil || cfg.BaseURL == "" || cfg.JQL == "" {
+		return fmt.Errorf("rig %s has no jira.base_url/jira.jql in settings/config.json", rigName)
+	}
+
+	var opts []jira.Option
+	if cfg.Email != "" {
+		opts = append(opts, jira.WithEmail(cfg.Email))
+	}
+	client, err := jira.NewClient(cfg.BaseURL, opts...)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	issues, err := client.Search(ctx, cfg.JQL)
+	if err != nil {
+		return err
+	}
+
+	bd := beads.New(r.BeadsPath())
+	existing, err := bd.List(beads.ListOptions{Status: "all", Label: jira.MirrorLabel, Priority: -1})
+	if err != nil {
+		return fmt.Errorf("listing mirrored beads: %w", err)
+	}
+	byKey := make(map[string]*beads.Issue, len(existing))
+	for _, b := range existing {
+		if key := jira.KeyFromLabels(b.Labels); key != "" {
+			byKey[key] = b
+		}
+	}
+
+	prefix := ""
+	if jiraSyncDryRun {
+		prefix = style.Dim.Render("[dry-run] ")
+	}
+
+	// Pull: epics first so children can be parented to their bead.
+	var created, updated, closed int
+	ordered := make([]jira.Issue, 0, len(issues))
+	for _, is := range issues {
+		if is.IsEpic() {
+			ordered = append(ordered, is)
+		}
+	}
+	for _, is := range issues {
+		if !is.IsEpic() {
+			ordered = append(ordered, is)
+		}
+	}
+	for i := range ordered {
+		is := &ordered[i]
+		b, ok := byKey[is.Key]
+		if !ok {
+			if is.IsDone() {
+				continue // Don't import work that is already finished.
+			}
+			parent := ""
+			if p, ok := byKey[is.ParentKey()]; ok {
+				parent = p.ID
+			}
+			fmt.Printf("%s%s %s %s\n", prefix, style.Bold.Render("+"), is.Key, is.Fields.Summary)
+			created++
+			if jiraSyncDryRun {
+				byKey[is.Key] = &beads.Issue{ID: is.Key, Status: "open"}
+				continue
+			}
+			nb, err := bd.Create(beads.CreateOptions{
+				Title:       is.BeadTitle(),
+				IssueType:   is.BeadType(),
+				Labels:      append([]string{jira.MirrorLabel, jira.KeyLabel(is.Key)}, cfg.Labels...),
+				Priority:    is.BeadPriority(),
+				Description: is.BeadDescription(cfg.BaseURL),
+				Parent:      parent,
+				Actor:       "jira",
+			})
+			if err != nil {
+				return fmt.Errorf("creating bead for %s: %w", is.Key, err)
+			}
+			byKey[is.Key] = nb
+			continue
+		}
+
+		if is.IsDone() && b.Status != "closed" {
+			fmt.Printf("%s%s %s → closed (resolved in Jira)\n", prefix, style.Bold.Render("✓"), b.ID)
+			closed++
+			if !jiraSyncDryRun {
+				if err := bd.Close(b.ID); err != nil {
+					return fmt.Errorf("closing %s: %w", b.ID, err)
+				}
+				b.Status = "closed"
+			}
+			continue
+		}
+		if title := is.BeadTitle(); b.Title != title {
+			fmt.Printf("%s%s %s title updated\n", prefix, style.Bold.Render("~"), b.ID)
+			updated++
+			if !jiraSyncDryRun {
+				if err := bd.Update(b.ID, beads.UpdateOptions{Title: &title}); err != nil {
+					return fmt.Errorf("updating %s: %w", b.ID, err)
+				}
+			}
+		}
+	}
+
+	// Push: map each mirrored bead's status through the configured transitions.
+	state, err := jira.LoadState(jiraStatePath(townRoot, rigName))
+	if err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(byKey))
+	for _, b := range byKey {
+		ids = append(ids, b.ID)
+	}
+	scheduled := areScheduled(ids)
+
+	var pushed int
+	for key, b := range byKey {
+		status := jiraGasTownStatus(b, scheduled[b.ID])
+		target := state.PendingTransition(key, status, cfg.Transitions)
+		if target == "" {
+			continue
+		}
+		if jiraSyncDryRun {
+			fmt.Printf("%s%s %s → %s\n", prefix, style.Bold.Render("↑"), key, target)
+			pushed++
+			continue
+		}
+		ok, err := client.TransitionTo(ctx, key, target)
+		if err != nil {
+			style.PrintWarning("could not transition %s to %q: %v", key, target, err)
+			continue
+		}
+		if !ok {
+			style.PrintWarning("%s has no transition %q available from its current status", key, target)
+		} else {
+			fmt.Printf("%s %s → %s\n", style.Bold.Render("↑"), key, target)
+			pushed++
+		}
+		// Record the status either way so an unavailable transition isn't retried every pass.
+		state.Pushed[key] = status
+	}
+
+	if !jiraSyncDryRun {
+		if err := state.Save(jiraStatePath(townRoot, rigName)); err != nil {
+			return fmt.Errorf("saving jira sync state: %w", err)
+		}
+	}
+
+	fmt.Printf("%sJira sync for %s: %d issue(s), %d created, %d updated, %d closed, %d transition(s) pushed\n",
+		prefix, rigName, len(issues), created, updated, closed, pushed)
+	return nil
+}
+
+// jiraGasTownStatus returns the status key used to look up jira.transitions
+// for a mirrored bead. Open beads waiting in the scheduler report "scheduled".
+func jiraGasTownStatus(b *beads.Issue, scheduled bool) string {
+	if b.Status == "open" && scheduled {
+		return "scheduled"
+	}
+	return b.Status
+}
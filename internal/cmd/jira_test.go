@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestJiraGasTownStatus(t *testing.T) {
+	tests := []struct {
+		status    string
+		scheduled bool
+		want      string
+	}{
+		{"open", false, "open"},
+		{"open", true, "scheduled"},
+		{"in_progress", true, "in_progress"},
+		{"closed", false, "closed"},
+	}
+	for _, tt := range tests {
+		if got := jiraGasTownStatus(&beads.Issue{Status: tt.status}, tt.scheduled); got != tt.want {
+			t.Errorf("jiraGasTownStatus(%q, %v) = %q, want %q", tt.status, tt.scheduled, got, tt.want)
+		}
+	}
+}
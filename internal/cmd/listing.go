@@ -0,0 +1,314 @@
+package cmd
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Shared --sort, --limit, --offset and --cursor handling for list commands.
+//
+// Every list sorts deterministically: by the --sort fields, then by the
+// item's ID, so two runs over the same data print the same order and
+// scripted consumers can page through a list reliably. --offset skips items
+// by position; --cursor resumes after the last item of the previous page by
+// its sort key, so items added or removed meanwhile don't shift the page.
+
+// listFlags holds a list command's paging flags.
+type listFlags struct {
+	Sort   string
+	Limit  int
+	Offset int
+	Cursor string
+
+	limitSet bool // --limit was given on the command line
+}
+
+// paging reports whether the caller asked for a page rather than the
+// command's default listing.
+func (f listFlags) paging() bool {
+	return f.limitSet || f.Offset > 0 || f.Cursor != ""
+}
+
+// limitValue is the --limit flag. It records whether it was set, so a
+// default limit doesn't count as a paging request.
+type limitValue struct{ f *listFlags }
+
+func (v limitValue) String() string { return strconv.Itoa(v.f.Limit) }
+func (v limitValue) Type() string   { return "int" }
+
+func (v limitValue) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	v.f.Limit, v.f.limitSet = n, true
+	return nil
+}
+
+// listField is a field a list can be sorted by. value returns a string,
+// bool, integer, float or time.Time.
+type listField[T any] struct {
+	name  string
+	value func(T) any
+}
+
+// listing describes how a list command sorts and pages its items.
+type listing[T any] struct {
+	fields      []listField[T]
+	defaultSort string         // e.g. "enqueued" or "-score"
+	id          func(T) string // Unique key, the final tie-breaker
+}
+
+// listPage is one page of a sorted list.
+type listPage[T any] struct {
+	Items      []T
+	Total      int    // Items before paging
+	Start      int    // Position of the first item in the sorted list
+	NextCursor string // Resumes after this page; empty on the last page
+	Paged      bool   // Paging flags were given; --json prints an envelope
+}
+
+// register adds the paging flags to cmd. limit is the default --limit; 0
+// shows everything.
+func (l listing[T]) register(cmd *cobra.Command, f *listFlags, limit int) {
+	names := make([]string, len(l.fields))
+	for i, field := range l.fields {
+		names[i] = field.name
+	}
+	cmd.Flags().StringVar(&f.Sort, "sort", l.defaultSort,
+		fmt.Sprintf("Sort by field, - prefix for descending, comma-separated (%s)", strings.Join(names, ", ")))
+	f.Limit = limit
+	cmd.Flags().VarP(limitValue{f}, "limit", "n", "Show at most N items (0 = all)")
+	cmd.Flags().IntVar(&f.Offset, "offset", 0, "Skip the first N items")
+	cmd.Flags().StringVar(&f.Cursor, "cursor", "", "Resume after the page that printed this cursor")
+}
+
+// sortKey is one parsed --sort field.
+type sortKey[T any] struct {
+	field listField[T]
+	desc  bool
+}
+
+// parseSort parses a --sort value, falling back to the default.
+func (l listing[T]) parseSort(spec string) ([]sortKey[T], error) {
+	if spec == "" {
+		spec = l.defaultSort
+	}
+	var keys []sortKey[T]
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		desc := strings.HasPrefix(part, "-")
+		name := strings.TrimPrefix(part, "-")
+		i := slices.IndexFunc(l.fields, func(f listField[T]) bool { return f.name == name })
+		if i < 0 {
+			names := make([]string, len(l.fields))
+			for j, f := range l.fields {
+				names[j] = f.name
+			}
+			return nil, fmt.Errorf("unknown sort field %q (valid: %s)", name, strings.Join(names, ", "))
+		}
+		keys = append(keys, sortKey[T]{field: l.fields[i], desc: desc})
+	}
+	return keys, nil
+}
+
+// listCursor is the decoded form of a --cursor: the sort it was issued for
+// and the sort key of the last item on the page.
+type listCursor struct {
+	Sort string `json:"s"`
+	Key  []any  `json:"k"`
+	ID   string `json:"id"`
+}
+
+// apply sorts items and returns the page f asks for.
+func (l listing[T]) apply(items []T, f listFlags) (listPage[T], error) {
+	if f.Offset < 0 || f.Limit < 0 {
+		return listPage[T]{}, errors.New("--offset and --limit must not be negative")
+	}
+	if f.Offset > 0 && f.Cursor != "" {
+		return listPage[T]{}, errors.New("use --offset or --cursor, not both")
+	}
+	keys, err := l.parseSort(f.Sort)
+	if err != nil {
+		return listPage[T]{}, err
+	}
+	spec := f.Sort
+	if spec == "" {
+		spec = l.defaultSort
+	}
+
+	keyOf := func(item T) []any {
+		k := make([]any, len(keys))
+		for i, sk := range keys {
+			k[i] = normalizeSortValue(sk.field.value(item))
+		}
+		return k
+	}
+	compare := func(ak []any, aid string, bk []any, bid string) int {
+		for i, sk := range keys {
+			if c := compareSortValues(ak[i], bk[i]); c != 0 {
+				if sk.desc {
+					return -c
+				}
+				return c
+			}
+		}
+		return strings.Compare(aid, bid)
+	}
+
+	sorted := append(make([]T, 0, len(items)), items...)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return compare(keyOf(a), l.id(a), keyOf(b), l.id(b))
+	})
+
+	start := f.Offset
+	if f.Cursor != "" {
+		c, err := decodeListCursor(f.Cursor)
+		if err != nil {
+			return listPage[T]{}, err
+		}
+		if c.Sort != spec {
+			return listPage[T]{}, fmt.Errorf("cursor is for --sort %s, not %s", c.Sort, spec)
+		}
+		if len(c.Key) != len(keys) {
+			return listPage[T]{}, errors.New("invalid cursor")
+		}
+		start = len(sorted)
+		for i, item := range sorted {
+			if compare(keyOf(item), l.id(item), c.Key, c.ID) > 0 {
+				start = i
+				break
+			}
+		}
+	}
+	start = min(start, len(sorted))
+	end := len(sorted)
+	if f.Limit > 0 {
+		end = min(start+f.Limit, len(sorted))
+	}
+
+	page := listPage[T]{Items: sorted[start:end], Total: len(sorted), Start: start, Paged: f.paging()}
+	if end < len(sorted) && end > start {
+		last := sorted[end-1]
+		page.NextCursor = encodeListCursor(listCursor{Sort: spec, Key: keyOf(last), ID: l.id(last)})
+	}
+	return page, nil
+}
+
+// sortTimeLayout formats times as fixed-width UTC strings, so they compare
+// correctly as strings after a round trip through a cursor.
+const sortTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// normalizeSortValue reduces a field value to a string or float64, the
+// forms that survive a JSON round trip through a cursor unchanged.
+func normalizeSortValue(v any) any {
+	switch t := v.(type) {
+	case string:
+		return t
+	case bool:
+		if t {
+			return float64(1)
+		}
+		return float64(0)
+	case int:
+		return float64(t)
+	case int64:
+		return float64(t)
+	case float64:
+		return t
+	case time.Time:
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(sortTimeLayout)
+	case time.Duration:
+		return float64(t)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// compareSortValues compares two normalized sort values. Numbers sort
+// before strings.
+func compareSortValues(a, b any) int {
+	af, aNum := a.(float64)
+	bf, bNum := b.(float64)
+	switch {
+	case aNum && bNum:
+		return cmp.Compare(af, bf)
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+	as, _ := a.(string)
+	bs, _ := b.(string)
+	return strings.Compare(as, bs)
+}
+
+func encodeListCursor(c listCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeListCursor(s string) (listCursor, error) {
+	var c listCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errors.New("invalid cursor")
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, errors.New("invalid cursor")
+	}
+	return c, nil
+}
+
+// listJSON is the --json form of a requested page: its items and where they
+// sit in the list, so scripts can page with next_cursor without parsing
+// stderr.
+type listJSON struct {
+	Items      any    `json:"items"`
+	Total      int    `json:"total"`
+	Offset     int    `json:"offset"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// printPageJSON writes items, the JSON form of page.Items, to stdout: as a
+// bare array, or in a listJSON envelope when paging flags were given. items
+// must not be a nil slice.
+func printPageJSON[T any](page listPage[T], items any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if !page.Paged {
+		return enc.Encode(items)
+	}
+	return enc.Encode(listJSON{Items: items, Total: page.Total, Offset: page.Start, NextCursor: page.NextCursor})
+}
+
+// printPageFooter tells the reader where the page sits in the list and how
+// to get the next one.
+func printPageFooter[T any](page listPage[T]) {
+	if len(page.Items) == page.Total {
+		return
+	}
+	footer := fmt.Sprintf("Showing %d-%d of %d", page.Start+1, page.Start+len(page.Items), page.Total)
+	if len(page.Items) == 0 {
+		footer = fmt.Sprintf("No items past position %d of %d", page.Start, page.Total)
+	}
+	if page.NextCursor != "" {
+		footer += "; next page: --cursor " + page.NextCursor
+	}
+	fmt.Println(style.Dim.Render(footer))
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+type listingTestItem struct {
+	ID    string
+	Rig   string
+	Score float64
+	At    time.Time
+}
+
+var listingTest = listing[listingTestItem]{
+	fields: []listField[listingTestItem]{
+		{"rig", func(i listingTestItem) any { return i.Rig }},
+		{"score", func(i listingTestItem) any { return i.Score }},
+		{"at", func(i listingTestItem) any { return i.At }},
+	},
+	defaultSort: "-score",
+	id:          func(i listingTestItem) string { return i.ID },
+}
+
+func listingIDs(items []listingTestItem) string {
+	ids := make([]string, len(items))
+	for i, it := range items {
+		ids[i] = it.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestListingSortIsDeterministic(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := []listingTestItem{
+		{"d", "gt", 1, t0.Add(3 * time.Second)},
+		{"b", "bd", 2, t0.Add(time.Second)},
+		{"c", "gt", 2, t0},
+		{"a", "gt", 1, t0.Add(2 * time.Second)},
+	}
+	tests := []struct {
+		sort string
+		want string
+	}{
+		{"", "b,c,a,d"},           // -score, ties by ID
+		{"score", "a,d,b,c"},      // ascending
+		{"rig,-score", "b,c,a,d"}, // bd first, then gt by score desc
+		{"at", "c,b,a,d"},
+		{"-at", "d,a,b,c"},
+	}
+	for _, tt := range tests {
+		page, err := listingTest.apply(items, listFlags{Sort: tt.sort})
+		if err != nil {
+			t.Fatalf("apply(%q): %v", tt.sort, err)
+		}
+		if got := listingIDs(page.Items); got != tt.want {
+			t.Errorf("sort %q = %s, want %s", tt.sort, got, tt.want)
+		}
+	}
+	if listingIDs(items) != "d,b,c,a" {
+		t.Error("apply reordered its input")
+	}
+	if _, err := listingTest.apply(items, listFlags{Sort: "nope"}); err == nil {
+		t.Error("unknown sort field should fail")
+	}
+}
+
+func TestListingPaging(t *testing.T) {
+	var items []listingTestItem
+	for _, id := range []string{"e", "c", "a", "d", "b"} {
+		items = append(items, listingTestItem{ID: id, Score: 1})
+	}
+
+	page, err := listingTest.apply(items, listFlags{Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := listingIDs(page.Items); got != "b,c" || page.Start != 1 || page.Total != 5 {
+		t.Errorf("offset page = %s (start %d of %d)", got, page.Start, page.Total)
+	}
+
+	// Walk the list by cursor. Removing the last item seen and adding one
+	// before it between pages doesn't shift the pages that follow.
+	var pages []string
+	f := listFlags{Limit: 2}
+	for len(pages) < 5 {
+		page, err := listingTest.apply(items, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, listingIDs(page.Items))
+		if page.NextCursor == "" {
+			break
+		}
+		f.Cursor = page.NextCursor
+		if len(pages) == 1 {
+			items = append(items[:4], listingTestItem{ID: "ab", Score: 1}) // Drops "b"
+		}
+	}
+	if got := strings.Join(pages, "|"); got != "a,b|c,d|e" {
+		t.Errorf("pages by cursor = %s, want a,b|c,d|e", got)
+	}
+
+	if _, err := listingTest.apply(items, listFlags{Offset: 1, Cursor: "x"}); err == nil {
+		t.Error("--offset with --cursor should fail")
+	}
+	if _, err := listingTest.apply(items, listFlags{Cursor: "not a cursor"}); err == nil {
+		t.Error("garbage cursor should fail")
+	}
+	page, _ = listingTest.apply(items, listFlags{Limit: 1})
+	if _, err := listingTest.apply(items, listFlags{Sort: "rig", Cursor: page.NextCursor}); err == nil {
+		t.Error("cursor from another sort should fail")
+	}
+}
+
+func TestPrintPageJSON(t *testing.T) {
+	items := []listingTestItem{{ID: "a", Score: 3}, {ID: "b", Score: 2}, {ID: "c", Score: 1}}
+
+	for _, tc := range []struct {
+		f          listFlags
+		wantIDs    string
+		wantCursor bool
+	}{
+		{listFlags{Limit: 2, limitSet: true}, "a,b", true},
+		{listFlags{Limit: 2, limitSet: true, Offset: 2}, "c", false},
+		{listFlags{Offset: 3}, "", false},
+	} {
+		page, err := listingTest.apply(items, tc.f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := captureStdout(t, func() {
+			if err := printPageJSON(page, page.Items); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		var got struct {
+			Items      []listingTestItem `json:"items"`
+			Total      int               `json:"total"`
+			Offset     int               `json:"offset"`
+			NextCursor *string           `json:"next_cursor"`
+		}
+		if err := json.Unmarshal([]byte(out), &got); err != nil {
+			t.Fatalf("%+v: output is not a JSON envelope: %v\n%s", tc.f, err, out)
+		}
+		if !strings.Contains(out, `"items": [`) {
+			t.Errorf("%+v: items should be an array, even when empty:\n%s", tc.f, out)
+		}
+		if ids := listingIDs(got.Items); ids != tc.wantIDs || got.Total != 3 || got.Offset != tc.f.Offset {
+			t.Errorf("%+v: envelope = %s (offset %d of %d)", tc.f, ids, got.Offset, got.Total)
+		}
+		if (got.NextCursor != nil) != tc.wantCursor {
+			t.Errorf("%+v: next_cursor present = %v, want %v", tc.f, got.NextCursor != nil, tc.wantCursor)
+		}
+	}
+}
+
+func TestPrintPageJSON_BareArrayWithoutPaging(t *testing.T) {
+	items := []listingTestItem{{ID: "a", Score: 3}, {ID: "b", Score: 2}, {ID: "c", Score: 1}}
+
+	// A command's default --limit is not a paging request: scripts keep
+	// getting a bare array.
+	for _, tc := range []struct {
+		f       listFlags
+		wantIDs string
+	}{
+		{listFlags{}, "a,b,c"},
+		{listFlags{Limit: 2}, "a,b"},
+	} {
+		page, err := listingTest.apply(items, tc.f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := captureStdout(t, func() {
+			if err := printPageJSON(page, page.Items); err != nil {
+				t.Fatal(err)
+			}
+		})
+		var got []listingTestItem
+		if err := json.Unmarshal([]byte(out), &got); err != nil {
+			t.Fatalf("%+v: output is not a bare array: %v\n%s", tc.f, err, out)
+		}
+		if ids := listingIDs(got); ids != tc.wantIDs {
+			t.Errorf("%+v: items = %s, want %s", tc.f, ids, tc.wantIDs)
+		}
+	}
+}
+
+func TestListingLimitFlagRequestsPaging(t *testing.T) {
+	var f listFlags
+	cmd := &cobra.Command{Use: "test"}
+	listingTest.register(cmd, &f, 50)
+	if f.Limit != 50 || f.paging() {
+		t.Fatalf("default: Limit = %d, paging = %v; want 50, false", f.Limit, f.paging())
+	}
+	if err := cmd.Flags().Parse([]string{"--limit", "5"}); err != nil {
+		t.Fatal(err)
+	}
+	if f.Limit != 5 || !f.paging() {
+		t.Errorf("--limit 5: Limit = %d, paging = %v; want 5, true", f.Limit, f.paging())
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"sort"
 	"strings"
 	"time"
@@ -182,7 +181,7 @@ func listUnclaimedQueueMessages(beadsDir, queueName string) ([]queueMessage, err
 		"--limit", "0",
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd := beads.Command(args...)
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stdout, stderr bytes.Buffer
@@ -263,7 +262,7 @@ func claimQueueMessage(beadsDir, messageID, claimant string) error {
 		"claimed-at:" + now,
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd := beads.Command(args...)
 	cmd.Env = append(os.Environ(),
 		"BEADS_DIR="+beadsDir,
 		"BD_ACTOR="+claimant,
@@ -343,7 +342,7 @@ type queueMessageInfo struct {
 func getQueueMessageInfo(beadsDir, messageID string) (*queueMessageInfo, error) {
 	args := []string{"show", messageID, "--json"}
 
-	cmd := exec.Command("bd", args...)
+	cmd := beads.Command(args...)
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	var stdout, stderr bytes.Buffer
@@ -425,7 +424,7 @@ func releaseQueueMessage(beadsDir, messageID, actor string) error {
 
 	// Remove all claim labels in a single bd command
 	args := append([]string{"label", "remove", messageID}, labelsToRemove...)
-	cmd := exec.Command("bd", args...)
+	cmd := beads.Command(args...)
 	cmd.Env = append(os.Environ(),
 		"BEADS_DIR="+beadsDir,
 		"BD_ACTOR="+actor,
@@ -449,8 +448,26 @@ func releaseQueueMessage(beadsDir, messageID, actor string) error {
 var (
 	mailQueueClaimers string
 	mailQueueJSON     bool
+	mailQueueListPage listFlags
 )
 
+// mailQueueListItem is a queue in gt mail queue list.
+type mailQueueListItem struct {
+	ID     string
+	Fields *beads.QueueFields
+}
+
+// mailQueueListing sorts gt mail queue list by name.
+var mailQueueListing = listing[mailQueueListItem]{
+	fields: []listField[mailQueueListItem]{
+		{"name", func(q mailQueueListItem) any { return q.Fields.Name }},
+		{"id", func(q mailQueueListItem) any { return q.ID }},
+		{"status", func(q mailQueueListItem) any { return q.Fields.Status }},
+	},
+	defaultSort: "name",
+	id:          func(q mailQueueListItem) string { return q.ID },
+}
+
 var mailQueueCmd = &cobra.Command{
 	Use:   "queue",
 	Short: "Manage mail queues",
@@ -512,6 +529,7 @@ Shows queue names, claim patterns, and status.
 
 Examples:
   gt mail queue list
+  gt mail queue list --sort=-name --limit=10
   gt mail queue list --json`,
 	RunE: runMailQueueList,
 }
@@ -537,6 +555,7 @@ func init() {
 	// Queue show/list flags
 	mailQueueShowCmd.Flags().BoolVar(&mailQueueJSON, "json", false, "Output as JSON")
 	mailQueueListCmd.Flags().BoolVar(&mailQueueJSON, "json", false, "Output as JSON")
+	mailQueueListing.register(mailQueueListCmd, &mailQueueListPage, 0)
 
 	// Add queue subcommands
 	mailQueueCmd.AddCommand(mailQueueCreateCmd)
@@ -683,33 +702,36 @@ func runMailQueueList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	items := make([]mailQueueListItem, 0, len(queues))
+	for _, issue := range queues {
+		items = append(items, mailQueueListItem{ID: issue.ID, Fields: beads.ParseQueueFields(issue.Description)})
+	}
+	page, err := mailQueueListing.apply(items, mailQueueListPage)
+	if err != nil {
+		return err
+	}
+
 	if mailQueueJSON {
-		var output []map[string]interface{}
-		for _, issue := range queues {
-			fields := beads.ParseQueueFields(issue.Description)
+		output := make([]map[string]interface{}, 0, len(page.Items))
+		for _, q := range page.Items {
 			output = append(output, map[string]interface{}{
-				"id":            issue.ID,
-				"name":          fields.Name,
-				"claim_pattern": fields.ClaimPattern,
-				"status":        fields.Status,
+				"id":            q.ID,
+				"name":          q.Fields.Name,
+				"claim_pattern": q.Fields.ClaimPattern,
+				"status":        q.Fields.Status,
 			})
 		}
-		jsonBytes, err := json.MarshalIndent(output, "", "  ")
-		if err != nil {
-			return fmt.Errorf("marshaling JSON: %w", err)
-		}
-		fmt.Println(string(jsonBytes))
-		return nil
+		return printPageJSON(page, output)
 	}
 
 	// Human-readable output
 	fmt.Printf("%s Queues (%d)\n\n", style.Bold.Render("📬"), len(queues))
-	for _, issue := range queues {
-		fields := beads.ParseQueueFields(issue.Description)
-		fmt.Printf("  %s\n", style.Bold.Render(fields.Name))
-		fmt.Printf("    Claimers: %s\n", fields.ClaimPattern)
-		fmt.Printf("    Status: %s\n", fields.Status)
+	for _, q := range page.Items {
+		fmt.Printf("  %s\n", style.Bold.Render(q.Fields.Name))
+		fmt.Printf("    Claimers: %s\n", q.Fields.ClaimPattern)
+		fmt.Printf("    Status: %s\n", q.Fields.Status)
 	}
+	printPageFooter(page)
 
 	return nil
 }
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	maintenanceRig        string
+	maintenanceScope      string
+	maintenancePriority   int
+	maintenanceDryRun     bool
+	maintenanceNoSchedule bool
+)
+
+// maintenanceKind is a recurring chore that gt maintenance enqueue can file.
+type maintenanceKind struct {
+	Formula     string // Workflow formula the polecat runs
+	Title       string // Bead title; the scope is appended when given
+	Description string // Bead description, before the scope line
+}
+
+// maintenanceKinds maps kind name to its template. Each formula is an embedded
+// mol-polecat-work variant whose implement step is expanded for the chore.
+var maintenanceKinds = map[string]maintenanceKind{
+	"dep-bump": {
+		Formula: "mol-polecat-dep-bump",
+		Title:   "Bump outdated dependencies",
+		Description: `Routine dependency maintenance.
+
+Survey outdated dependencies, apply patch and minor bumps with the ecosystem's
+tooling, and verify the full build and test suite. Major version bumps are out
+of scope: note them on this bead for follow-up instead.`,
+	},
+	"flaky-tests": {
+		Formula: "mol-polecat-flaky-tests",
+		Title:   "Hunt flaky tests",
+		Description: `Routine flaky test hunt.
+
+Find tests that fail intermittently, reproduce them with repeated runs, fix the
+root cause (not with retries or longer sleeps), and prove the fix by re-running
+the reproduction.`,
+	},
+	"todo-triage": {
+		Formula: "mol-polecat-todo-triage",
+		Title:   "Triage TODO and FIXME comments",
+		Description: `Routine TODO triage.
+
+Inventory TODO/FIXME/XXX/HACK markers, remove obsolete ones, make quick fixes,
+and file a bead for every remaining marker, referencing it in the comment.`,
+	},
+}
+
+// maintenanceKindNames returns the kind names, sorted.
+func maintenanceKindNames() []string {
+	names := make([]string, 0, len(maintenanceKinds))
+	for name := range maintenanceKinds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// maintenanceBead returns the create options for a kind's bead in rig.
+func maintenanceBead(kind string, k maintenanceKind, rig, scope string, priority int) beads.CreateOptions {
+	title, desc := k.Title, k.Description
+	if scope != "" {
+		title += " (" + scope + ")"
+		desc += "\n\nScope: " + scope
+	}
+	return beads.CreateOptions{
+		Title:       title,
+		Description: desc,
+		Labels:      []string{"maintenance", "maintenance:" + kind},
+		Priority:    priority,
+		Actor:       detectActor(),
+		Rig:         rig,
+	}
+}
+
+var maintenanceCmd = &cobra.Command{
+	Use:     "maintenance",
+	GroupID: GroupWork,
+	Short:   "File recurring maintenance chores as beads",
+	RunE:    requireSubcommand,
+	Long: `File well-formed beads for recurring maintenance chores and queue them
+for a rig's polecats.
+
+Each kind creates a bead labeled "maintenance" and "maintenance:<kind>" and
+schedules it with a built-in formula that walks the polecat through the chore:
+
+  dep-bump      mol-polecat-dep-bump      survey, bump, verify dependencies
+  flaky-tests   mol-polecat-flaky-tests   reproduce, diagnose, fix flaky tests
+  todo-triage   mol-polecat-todo-triage   inventory, triage, resolve TODOs
+
+Run 'gt maintenance list' to see them.`,
+}
+
+var maintenanceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List maintenance kinds and their formulas",
+	Args:  cobra.NoArgs,
+	RunE:  runMaintenanceList,
+}
+
+var maintenanceEnqueueCmd = &cobra.Command{
+	Use:   "enqueue <kind>",
+	Short: "Create a maintenance bead and schedule it on a rig",
+	Long: `Create a maintenance bead in the rig and schedule it for dispatch with
+the kind's formula. Requires deferred dispatch
+(gt config set scheduler.max_polecats N) unless --no-schedule is given.
+
+--scope narrows the chore (a package, directory, or ecosystem) and is added
+to the bead's title and description.
+
+Examples:
+  gt maintenance enqueue dep-bump --rig gastown
+  gt maintenance enqueue flaky-tests --rig gastown --scope internal/daemon
+  gt maintenance enqueue todo-triage --rig beads --priority 4 --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMaintenanceEnqueue,
+}
+
+func init() {
+	maintenanceEnqueueCmd.Flags().StringVar(&maintenanceRig, "rig", "", "Rig to file and schedule the bead in (required)")
+	maintenanceEnqueueCmd.Flags().StringVar(&maintenanceScope, "scope", "", "Narrow the chore to a package, directory, or ecosystem")
+	maintenanceEnqueueCmd.Flags().IntVar(&maintenancePriority, "priority", 3, "Bead priority (0-4)")
+	maintenanceEnqueueCmd.Flags().BoolVarP(&maintenanceDryRun, "dry-run", "n", false, "Show the bead that would be created")
+	maintenanceEnqueueCmd.Flags().BoolVar(&maintenanceNoSchedule, "no-schedule", false, "Create the bead without scheduling it")
+	_ = maintenanceEnqueueCmd.MarkFlagRequired("rig")
+
+	maintenanceCmd.AddCommand(maintenanceListCmd)
+	maintenanceCmd.AddCommand(maintenanceEnqueueCmd)
+	rootCmd.AddCommand(maintenanceCmd)
+}
+
+func runMaintenanceList(cmd *cobra.Command, args []string) error {
+	for _, name := range maintenanceKindNames() {
+		k := maintenanceKinds[name]
+		fmt.Printf("  %-12s %s %s\n", style.Bold.Render(name), k.Title, style.Dim.Render("("+k.Formula+")"))
+	}
+	return nil
+}
+
+func runMaintenanceEnqueue(cmd *cobra.Command, args []string) error {
+	kind := args[0]
+	k, ok := maintenanceKinds[kind]
+	if !ok {
+		return fmt.Errorf("unknown maintenance kind %q (valid: %s)", kind, strings.Join(maintenanceKindNames(), ", "))
+	}
+	if maintenancePriority < 0 || maintenancePriority > 4 {
+		return fmt.Errorf("invalid --priority %d: must be 0-4", maintenancePriority)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if _, isRig := IsRigName(maintenanceRig); !isRig {
+		return fmt.Errorf("'%s' is not a known rig", maintenanceRig)
+	}
+	if !maintenanceNoSchedule {
+		settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+		if err != nil {
+			return fmt.Errorf("loading town settings: %w", err)
+		}
+		if !settings.Scheduler.IsDeferred() {
+			return fmt.Errorf("scheduling requires deferred dispatch (gt config set scheduler.max_polecats N); use --no-schedule to only create the bead")
+		}
+	}
+
+	opts := maintenanceBead(kind, k, maintenanceRig, maintenanceScope, maintenancePriority)
+	if maintenanceDryRun {
+		fmt.Printf("Would create in %s: %s\n", maintenanceRig, opts.Title)
+		fmt.Printf("  Labels:   %s\n", strings.Join(opts.Labels, ", "))
+		fmt.Printf("  Priority: P%d\n", opts.Priority)
+		if !maintenanceNoSchedule {
+			fmt.Printf("  Schedule: %s with formula %s\n", maintenanceRig, k.Formula)
+		}
+		return nil
+	}
+
+	issue, err := beads.New(townRoot).Create(opts)
+	if err != nil {
+		return fmt.Errorf("creating bead: %w", err)
+	}
+	fmt.Printf("%s Created %s: %s\n", style.Bold.Render("✓"), issue.ID, opts.Title)
+
+	if maintenanceNoSchedule {
+		fmt.Printf("  Dispatch with: gt sling %s %s --formula %s\n", issue.ID, maintenanceRig, k.Formula)
+		return nil
+	}
+	if err := scheduleBead(issue.ID, maintenanceRig, ScheduleOptions{Formula: k.Formula}); err != nil {
+		return fmt.Errorf("created %s but could not schedule it: %w", issue.ID, err)
+	}
+	return nil
+}
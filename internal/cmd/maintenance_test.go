@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/formula"
+)
+
+func TestMaintenanceKinds_FormulasEmbedded(t *testing.T) {
+	for _, name := range maintenanceKindNames() {
+		k := maintenanceKinds[name]
+		if _, err := formula.GetEmbeddedFormulaContent(k.Formula); err != nil {
+			t.Errorf("%s: formula %q is not embedded: %v", name, k.Formula, err)
+		}
+		if k.Title == "" || k.Description == "" {
+			t.Errorf("%s: missing title or description", name)
+		}
+	}
+}
+
+func TestMaintenanceBead(t *testing.T) {
+	k := maintenanceKinds["flaky-tests"]
+
+	opts := maintenanceBead("flaky-tests", k, "gastown", "", 3)
+	if opts.Title != k.Title || opts.Rig != "gastown" || opts.Priority != 3 {
+		t.Errorf("unexpected options: %+v", opts)
+	}
+	if len(opts.Labels) != 2 || opts.Labels[0] != "maintenance" || opts.Labels[1] != "maintenance:flaky-tests" {
+		t.Errorf("Labels = %v", opts.Labels)
+	}
+
+	scoped := maintenanceBead("flaky-tests", k, "gastown", "internal/daemon", 3)
+	if scoped.Title != k.Title+" (internal/daemon)" {
+		t.Errorf("scoped Title = %q", scoped.Title)
+	}
+	if !strings.HasSuffix(scoped.Description, "Scope: internal/daemon") {
+		t.Errorf("scoped Description missing scope: %q", scoped.Description)
+	}
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	migrateDryRun bool
+	migrateLimit  int
+)
+
+var migrateCmd = &cobra.Command{
+	Use:     "migrate",
+	GroupID: GroupWorkspace,
+	Short:   "Migrate town data to current conventions",
+	RunE:    requireSubcommand,
+	Long: `Rewrite beads written under older gt conventions in one controlled pass.
+
+Each migration checks every affected bead, rewrites only the ones still in
+the old form, and prints progress as it goes. Migrated beads are stamped, so
+an interrupted run resumes where it stopped when run again.
+
+Migrations:
+  queue-v2   Upgrade scheduler sling contexts to schema version 2`,
+}
+
+var migrateQueueV2Cmd = &cobra.Command{
+	Use:   "queue-v2",
+	Short: "Upgrade scheduler sling contexts to schema version 2",
+	Long: `Upgrade open scheduler sling contexts to schema version 2.
+
+Version 2 contexts record the work bead's labels and gt:batchable flag at
+schedule time; the scheduler uses them for batching and for duration
+estimates. Contexts scheduled by older gt versions lack them, so they are
+never batched and estimate from formula alone. The migration fills them in
+from each work bead's current labels and stamps the context version 2.
+
+Contexts whose work bead cannot be read are left for the next run; invalid
+contexts are skipped (see gt scheduler audit).
+
+Examples:
+  gt migrate queue-v2 --dry-run    # Show what would change
+  gt migrate queue-v2              # Migrate every context
+  gt migrate queue-v2 --limit 50   # Migrate at most 50, then stop`,
+	Args: cobra.NoArgs,
+	RunE: runMigrateQueueV2,
+}
+
+func init() {
+	migrateQueueV2Cmd.Flags().BoolVarP(&migrateDryRun, "dry-run", "n", false, "Show what would change without writing")
+	migrateQueueV2Cmd.Flags().IntVar(&migrateLimit, "limit", 0, "Migrate at most N contexts this run (0 = all)")
+	migrateCmd.AddCommand(migrateQueueV2Cmd)
+	rootCmd.AddCommand(migrateCmd)
+}
+
+// queueV2Item is a sling context that still needs the queue-v2 migration.
+type queueV2Item struct {
+	ctx    *beads.Issue
+	fields *capacity.SlingContextFields
+}
+
+// pendingQueueV2 returns the contexts that need migrating, in ID order so
+// progress is stable across runs, and the number of invalid contexts skipped.
+func pendingQueueV2(contexts []*beads.Issue) (pending []queueV2Item, invalid int) {
+	for _, ctx := range contexts {
+		fields := beads.ParseSlingContextFields(ctx.Description)
+		if fields == nil || fields.WorkBeadID == "" {
+			invalid++
+			continue
+		}
+		if fields.NeedsMigration() {
+			pending = append(pending, queueV2Item{ctx, fields})
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].ctx.ID < pending[j].ctx.ID })
+	return pending, invalid
+}
+
+func runMigrateQueueV2(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	contexts := listAllSlingContexts(townRoot)
+	pending, invalid := pendingQueueV2(contexts)
+	if invalid > 0 {
+		style.PrintWarning("skipping %d invalid context(s); run 'gt scheduler audit'", invalid)
+	}
+	if len(pending) == 0 {
+		fmt.Printf("%s All %d sling context(s) are at version %d\n",
+			style.Bold.Render("✓"), len(contexts)-invalid, capacity.SlingContextVersion)
+		return nil
+	}
+
+	batch := pending
+	if migrateLimit > 0 && migrateLimit < len(batch) {
+		batch = batch[:migrateLimit]
+	}
+	fmt.Printf("Migrating %d of %d sling context(s) to version %d", len(batch), len(pending), capacity.SlingContextVersion)
+	if migrateDryRun {
+		fmt.Print(style.Dim.Render(" (dry run)"))
+	}
+	fmt.Println()
+
+	migrated, failed := 0, 0
+	for i, item := range batch {
+		progress := style.Dim.Render(fmt.Sprintf("[%d/%d]", i+1, len(batch)))
+		info, err := getBeadInfo(item.fields.WorkBeadID)
+		if err != nil {
+			failed++
+			fmt.Printf("  %s %s %s → %s: %v\n", progress, style.Error.Render("✗"), item.ctx.ID, item.fields.WorkBeadID, err)
+			continue
+		}
+		capacity.MigrateSlingContext(item.fields, info.Labels)
+		if !migrateDryRun {
+			b := beadsForContext(townRoot, item.fields)
+			if _, err := b.MutateSlingContextFields(item.ctx.ID, func(f *capacity.SlingContextFields) {
+				capacity.MigrateSlingContext(f, info.Labels)
+			}); err != nil {
+				failed++
+				fmt.Printf("  %s %s %s → %s: %v\n", progress, style.Error.Render("✗"), item.ctx.ID, item.fields.WorkBeadID, err)
+				continue
+			}
+		}
+		migrated++
+		detail := ""
+		if item.fields.Batchable {
+			detail = style.Dim.Render(" (batchable)")
+		}
+		fmt.Printf("  %s %s %s → %s%s\n", progress, style.Bold.Render("✓"), item.ctx.ID, item.fields.WorkBeadID, detail)
+	}
+
+	verb := "Migrated"
+	if migrateDryRun {
+		verb = "Would migrate"
+	}
+	fmt.Printf("\n%s %d context(s)", verb, migrated)
+	if failed > 0 {
+		fmt.Printf(", %d failed", failed)
+	}
+	fmt.Println()
+	if remaining := len(pending) - migrated; remaining > 0 && !migrateDryRun {
+		fmt.Printf("%d context(s) left; run 'gt migrate queue-v2' again to resume.\n", remaining)
+	}
+	if failed > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
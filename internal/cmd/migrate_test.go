@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestPendingQueueV2(t *testing.T) {
+	ctx := func(id string, fields *capacity.SlingContextFields) *beads.Issue {
+		if fields == nil {
+			return &beads.Issue{ID: id, Description: "garbage"}
+		}
+		return &beads.Issue{ID: id, Description: beads.FormatSlingContextDescription(fields)}
+	}
+	contexts := []*beads.Issue{
+		ctx("ctx-c", &capacity.SlingContextFields{Version: 1, WorkBeadID: "gt-c"}),
+		ctx("ctx-a", &capacity.SlingContextFields{WorkBeadID: "gt-a"}),
+		ctx("ctx-b", &capacity.SlingContextFields{Version: capacity.SlingContextVersion, WorkBeadID: "gt-b"}),
+		ctx("ctx-x", nil),
+	}
+
+	pending, invalid := pendingQueueV2(contexts)
+	if invalid != 1 {
+		t.Errorf("invalid = %d, want 1", invalid)
+	}
+	var ids []string
+	for _, p := range pending {
+		ids = append(ids, p.ctx.ID)
+	}
+	if len(ids) != 2 || ids[0] != "ctx-a" || ids[1] != "ctx-c" {
+		t.Errorf("pending = %v, want [ctx-a ctx-c]", ids)
+	}
+}
@@ -123,6 +123,15 @@ func runMoleculeDetach(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// attachmentOutput is the --json output of gt mol attachment.
+type attachmentOutput struct {
+	IssueID          string `json:"issue_id"`
+	IssueTitle       string `json:"issue_title"`
+	Status           string `json:"status"`
+	AttachedMolecule string `json:"attached_molecule,omitempty"`
+	AttachedAt       string `json:"attached_at,omitempty"`
+}
+
 func runMoleculeAttachment(cmd *cobra.Command, args []string) error {
 	pinnedBeadID := args[0]
 
@@ -142,13 +151,6 @@ func runMoleculeAttachment(cmd *cobra.Command, args []string) error {
 	attachment := beads.ParseAttachmentFields(issue)
 
 	if moleculeJSON {
-		type attachmentOutput struct {
-			IssueID          string `json:"issue_id"`
-			IssueTitle       string `json:"issue_title"`
-			Status           string `json:"status"`
-			AttachedMolecule string `json:"attached_molecule,omitempty"`
-			AttachedAt       string `json:"attached_at,omitempty"`
-		}
 		out := attachmentOutput{
 			IssueID:    issue.ID,
 			IssueTitle: issue.Title,
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -368,15 +367,17 @@ func waitForActivitySignal(ctx context.Context, townRoot string) (*AwaitSignalRe
 	return waitForEventsFile(ctx, filepath.Join(townRoot, events.EventsFile))
 }
 
-// waitForEventsFile tails the events file for new lines.
+// waitForEventsFile tails the events file for new lines, following it to the
+// fresh log when it is rotated or rewritten (events.Reopen).
 // This replaces the former bd activity --follow subprocess approach.
 func waitForEventsFile(ctx context.Context, eventsPath string) (*AwaitSignalResult, error) {
+	townRoot := filepath.Dir(eventsPath)
 
 	f, err := os.OpenFile(eventsPath, os.O_RDONLY|os.O_CREATE, 0644)
 	if err != nil {
 		return nil, fmt.Errorf("opening events file %s: %w", eventsPath, err)
 	}
-	defer f.Close()
+	defer func() { _ = f.Close() }()
 
 	// Seek to end — we only want new events, not historical ones
 	if _, err := f.Seek(0, 2); err != nil {
@@ -408,6 +409,13 @@ func waitForEventsFile(ctx context.Context, eventsPath string) (*AwaitSignalResu
 			if err != nil && err != io.EOF {
 				return nil, fmt.Errorf("reading events file: %w", err)
 			}
+
+			// Follow the log across rotation and KRC rewrites.
+			if nf, err := events.Reopen(townRoot, f); err == nil && nf != nil {
+				_ = f.Close()
+				f = nf
+				reader = bufio.NewReader(f)
+			}
 		}
 	}
 }
@@ -455,7 +463,7 @@ func updateAgentHeartbeat(agentBead, beadsDir string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), bdCallTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 	return cmd.Run()
 }
@@ -491,7 +499,7 @@ func setAgentIdleCycles(agentBead, beadsDir string, cycles int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), bdCallTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 
 	if err := cmd.Run(); err != nil {
@@ -527,7 +535,7 @@ func setAgentBackoffUntil(agentBead, beadsDir string, until time.Time) error {
 	ctx, cancel := context.WithTimeout(context.Background(), bdCallTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("setting backoff-until label: %w", err)
@@ -569,7 +577,7 @@ func clearAgentBackoffUntil(agentBead, beadsDir string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), bdCallTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
 	if err := cmd.Run(); err != nil {
 		return fmt.Errorf("clearing backoff-until label: %w", err)
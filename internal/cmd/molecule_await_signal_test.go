@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -204,6 +205,44 @@ func TestWaitForEventsFile_Signal(t *testing.T) {
 	}
 }
 
+func TestWaitForEventsFile_FollowsRotation(t *testing.T) {
+	// An event written to the fresh log after the old one is rotated away
+	// must still wake the waiter.
+	townRoot := t.TempDir()
+	eventsPath := filepath.Join(townRoot, ".events.jsonl")
+	if err := os.WriteFile(eventsPath, []byte(`{"ts":"old","type":"ignore"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		if err := os.Rename(eventsPath, filepath.Join(townRoot, ".events-20260101T000000Z.jsonl")); err != nil {
+			return
+		}
+		if err := os.WriteFile(eventsPath, nil, 0644); err != nil {
+			return
+		}
+		time.Sleep(500 * time.Millisecond) // Let the waiter reopen the fresh log
+		f, err := os.OpenFile(eventsPath, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return
+		}
+		defer f.Close()
+		_, _ = f.WriteString(`{"ts":"new","type":"sling","actor":"test"}` + "\n")
+	}()
+
+	result, err := waitForEventsFile(ctx, eventsPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Reason != "signal" || !strings.Contains(result.Signal, "sling") {
+		t.Errorf("result = %+v, want the sling event from the rotated-in log", result)
+	}
+}
+
 func TestWaitForActivitySignal_PathWiring(t *testing.T) {
 	// Verify waitForActivitySignal constructs the correct events path from
 	// townRoot. The events file should be at <townRoot>/.events.jsonl.
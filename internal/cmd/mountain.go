@@ -4,11 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -275,7 +276,7 @@ func bdAddLabelTown(beadID, label string) error {
 	if err != nil {
 		return err
 	}
-	cmd := exec.Command("bd", "update", beadID, "--add-label="+label)
+	cmd := beads.Command("update", beadID, "--add-label="+label)
 	cmd.Dir = townBeads
 	if out, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("bd update %s --add-label=%s: %w\noutput: %s", beadID, label, err, out)
@@ -289,7 +290,7 @@ func bdRemoveLabelTown(beadID, label string) error {
 	if err != nil {
 		return err
 	}
-	cmd := exec.Command("bd", "update", beadID, "--remove-label="+label)
+	cmd := beads.Command("update", beadID, "--remove-label="+label)
 	cmd.Dir = townBeads
 	if out, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("bd update %s --remove-label=%s: %w\noutput: %s", beadID, label, err, out)
@@ -641,6 +642,9 @@ func renderProgressBar(pct, width int) string {
 
 // runMountainPause pauses an active mountain by setting the convoy to paused status.
 func runMountainPause(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
 		return err
@@ -664,6 +668,9 @@ func runMountainPause(cmd *cobra.Command, args []string) error {
 
 // runMountainResume resumes a paused mountain.
 func runMountainResume(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	townBeads, err := getTownBeadsDir()
 	if err != nil {
 		return err
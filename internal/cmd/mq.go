@@ -40,6 +40,7 @@ var (
 	mqListWorker  string
 	mqListEpic    string
 	mqListJSON    bool
+	mqListPage    listFlags
 	mqListVerify  bool
 
 	// Status command flags
@@ -145,7 +146,8 @@ Examples:
   gt mq list greenplace
   gt mq list greenplace --ready
   gt mq list greenplace --status=open
-  gt mq list greenplace --worker=Nux`,
+  gt mq list greenplace --worker=Nux
+  gt mq list greenplace --sort=created --limit=20`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMQList,
 }
@@ -322,6 +324,7 @@ func init() {
 	mqListCmd.Flags().StringVar(&mqListWorker, "worker", "", "Filter by worker name")
 	mqListCmd.Flags().StringVar(&mqListEpic, "epic", "", "Show MRs targeting integration/<epic>")
 	mqListCmd.Flags().BoolVar(&mqListJSON, "json", false, "Output as JSON")
+	mqListing.register(mqListCmd, &mqListPage, 0)
 	mqListCmd.Flags().BoolVar(&mqListVerify, "verify", false, "Verify branches exist in git (shows MISSING for deleted branches)")
 
 	// Reject flags
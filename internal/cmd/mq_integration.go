@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"github.com/steveyegge/gastown/internal/worktree"
 )
 
 // defaultIntegrationBranchTemplate is kept for local backward compat references.
@@ -126,23 +127,23 @@ func createLandWorktree(rigPath, startBranch string) (*git.Git, func(), error) {
 		_ = fl.Unlock()
 		return nil, noop, fmt.Errorf("bare repo not found at %s: %w", bareRepoPath, err)
 	}
-	bareGit := git.NewGitWithDir(bareRepoPath, "")
+	worktrees := worktree.NewManager(git.NewGitWithDir(bareRepoPath, ""))
 
 	// Clean up any stale worktree from a previous failed run
 	if _, err := os.Stat(landPath); err == nil {
-		_ = bareGit.WorktreeRemove(landPath, true)
+		_ = worktrees.Remove(landPath, true)
 		_ = os.RemoveAll(landPath)
 	}
 
 	// Create worktree checked out to the target branch.
 	// Use --force because the branch may already be checked out in refinery/rig.
-	if err := bareGit.WorktreeAddExistingForce(landPath, startBranch); err != nil {
+	if err := worktrees.AddExistingForce(landPath, startBranch); err != nil {
 		_ = fl.Unlock()
 		return nil, noop, fmt.Errorf("creating land worktree: %w", err)
 	}
 
 	cleanup := func() {
-		_ = bareGit.WorktreeRemove(landPath, true)
+		_ = worktrees.Remove(landPath, true)
 		_ = os.RemoveAll(landPath)
 		_ = fl.Unlock()
 	}
@@ -403,14 +404,13 @@ func runMqIntegrationCreate(cmd *cobra.Command, args []string) error {
 
 	// 4. Store integration branch info in epic metadata
 	// Update the epic's description to include the integration branch info
-	newDesc := addIntegrationBranchField(epic.Description, branchName)
-	// Always store base_branch so land knows where to merge back
-	newDesc = beads.AddBaseBranchField(newDesc, baseBranchDisplay)
-	if newDesc != epic.Description {
-		if err := bd.Update(epicID, beads.UpdateOptions{Description: &newDesc}); err != nil {
-			// Non-fatal - branch was created, just metadata update failed
-			fmt.Printf("  %s\n", style.Dim.Render("(warning: could not update epic metadata)"))
-		}
+	if err := bd.UpdateDescription(epicID, func(current *beads.Issue) (string, error) {
+		newDesc := addIntegrationBranchField(current.Description, branchName)
+		// Always store base_branch so land knows where to merge back
+		return beads.AddBaseBranchField(newDesc, baseBranchDisplay), nil
+	}); err != nil {
+		// Non-fatal - branch was created, just metadata update failed
+		fmt.Printf("  %s\n", style.Dim.Render("(warning: could not update epic metadata)"))
 	}
 
 	// Success output
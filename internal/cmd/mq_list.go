@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 	"time"
 
@@ -16,6 +15,14 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 )
 
+// verifiedMRIssue is an MR in the --json output of gt mq list --verify,
+// extended with whether its branch exists.
+type verifiedMRIssue struct {
+	*beads.Issue
+	BranchExists *bool `json:"branch_exists,omitempty"`
+	VerifyError  bool  `json:"verify_error,omitempty"`
+}
+
 func runMQList(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
@@ -78,14 +85,7 @@ func runMQList(cmd *cobra.Command, args []string) error {
 
 	// Apply additional filters and calculate scores
 	now := time.Now()
-	type scoredIssue struct {
-		issue           *beads.Issue
-		fields          *beads.MRFields
-		score           float64
-		branchMissing   bool // true if branch doesn't exist in git (when --verify is set)
-		branchVerifyErr bool // true if git check errored (corrupt repo, permission, etc.)
-	}
-	var scored []scoredIssue
+	var scored []mqListItem
 
 	for _, issue := range issues {
 		// Manual status filtering as workaround for bd list not respecting --status filter
@@ -141,16 +141,18 @@ func runMQList(cmd *cobra.Command, args []string) error {
 
 		// Calculate priority score
 		score := calculateMRScore(issue, fields, now)
-		scored = append(scored, scoredIssue{issue: issue, fields: fields, score: score, branchMissing: branchMissing, branchVerifyErr: branchVerifyErr})
+		scored = append(scored, mqListItem{issue: issue, fields: fields, score: score, branchMissing: branchMissing, branchVerifyErr: branchVerifyErr})
 	}
 
-	// Sort by score descending (highest priority first)
-	sort.Slice(scored, func(i, j int) bool {
-		return scored[i].score > scored[j].score
-	})
+	// Sort by score descending (highest priority first) unless --sort says otherwise
+	page, err := mqListing.apply(scored, mqListPage)
+	if err != nil {
+		return err
+	}
+	scored = page.Items
 
 	// Extract filtered issues for JSON output compatibility
-	var filtered []*beads.Issue
+	filtered := make([]*beads.Issue, 0, len(scored))
 	for _, s := range scored {
 		filtered = append(filtered, s.issue)
 	}
@@ -159,14 +161,9 @@ func runMQList(cmd *cobra.Command, args []string) error {
 	if mqListJSON {
 		if mqListVerify {
 			// Extend JSON with verification results
-			type verifiedIssue struct {
-				*beads.Issue
-				BranchExists *bool `json:"branch_exists,omitempty"`
-				VerifyError  bool  `json:"verify_error,omitempty"`
-			}
-			var verified []verifiedIssue
+			verified := make([]verifiedMRIssue, 0, len(scored))
 			for _, s := range scored {
-				vi := verifiedIssue{Issue: s.issue}
+				vi := verifiedMRIssue{Issue: s.issue}
 				if s.fields != nil && s.fields.Branch != "" {
 					if s.branchVerifyErr {
 						vi.VerifyError = true
@@ -177,15 +174,15 @@ func runMQList(cmd *cobra.Command, args []string) error {
 				}
 				verified = append(verified, vi)
 			}
-			return outputJSON(verified)
+			return printPageJSON(page, verified)
 		}
-		return outputJSON(filtered)
+		return printPageJSON(page, filtered)
 	}
 
 	// Human-readable output
 	fmt.Printf("%s Merge queue for '%s':\n\n", style.Bold.Render("📋"), rigName)
 
-	if len(filtered) == 0 {
+	if page.Total == 0 {
 		fmt.Printf("  %s\n", style.Dim.Render("(empty)"))
 		return nil
 	}
@@ -203,6 +200,8 @@ func runMQList(cmd *cobra.Command, args []string) error {
 		if issue.Status == "open" {
 			if len(issue.BlockedBy) > 0 || issue.BlockedByCount > 0 {
 				displayStatus = "blocked"
+			} else if beads.HasLabel(issue, labelReviewApproved) {
+				displayStatus = "approved"
 			} else {
 				displayStatus = "ready"
 			}
@@ -213,6 +212,8 @@ func runMQList(cmd *cobra.Command, args []string) error {
 		switch displayStatus {
 		case "ready":
 			styledStatus = style.Success.Render("ready")
+		case "approved":
+			styledStatus = style.Success.Render("approved")
 		case "in_progress":
 			styledStatus = style.Warning.Render("active")
 		case "blocked":
@@ -285,6 +286,7 @@ func runMQList(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Print(table.Render())
+	printPageFooter(page)
 
 	// Show summary of missing branches when --verify is set
 	if mqListVerify {
@@ -321,6 +323,40 @@ func runMQList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// mqListItem is a merge request in gt mq list, with its priority score.
+type mqListItem struct {
+	issue           *beads.Issue
+	fields          *beads.MRFields
+	score           float64
+	branchMissing   bool // true if branch doesn't exist in git (when --verify is set)
+	branchVerifyErr bool // true if git check errored (corrupt repo, permission, etc.)
+}
+
+// mqListing sorts gt mq list, highest score first.
+var mqListing = listing[mqListItem]{
+	fields: []listField[mqListItem]{
+		{"score", func(m mqListItem) any { return m.score }},
+		{"priority", func(m mqListItem) any { return m.issue.Priority }},
+		{"created", func(m mqListItem) any { return m.issue.CreatedAt }},
+		{"id", func(m mqListItem) any { return m.issue.ID }},
+		{"status", func(m mqListItem) any { return m.issue.Status }},
+		{"branch", func(m mqListItem) any {
+			if m.fields == nil {
+				return ""
+			}
+			return m.fields.Branch
+		}},
+		{"worker", func(m mqListItem) any {
+			if m.fields == nil {
+				return ""
+			}
+			return m.fields.Worker
+		}},
+	},
+	defaultSort: "-score",
+	id:          func(m mqListItem) string { return m.issue.ID },
+}
+
 // formatMRAge formats the age of an MR from its created_at timestamp.
 func formatMRAge(createdAt string) string {
 	t, err := time.Parse(time.RFC3339, createdAt)
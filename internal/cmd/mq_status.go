@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -285,17 +286,7 @@ func formatTimeAgo(timestamp string) string {
 		return style.Dim.Render("(in the future)")
 	}
 
-	var ago string
-	if d < time.Minute {
-		ago = fmt.Sprintf("%ds ago", int(d.Seconds()))
-	} else if d < time.Hour {
-		ago = fmt.Sprintf("%dm ago", int(d.Minutes()))
-	} else if d < 24*time.Hour {
-		ago = fmt.Sprintf("%dh ago", int(d.Hours()))
-	} else {
-		ago = fmt.Sprintf("%dd ago", int(d.Hours()/24))
-	}
-
+	ago := humanize.Relative(t.UTC(), time.Now().UTC())
 	return style.Dim.Render("(" + ago + ")")
 }
 
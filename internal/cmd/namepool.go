@@ -14,9 +14,10 @@ import (
 )
 
 var (
-	namepoolListFlag     bool
-	namepoolThemeFlag    string
-	namepoolFromFileFlag string
+	namepoolListFlag        bool
+	namepoolThemeFlag       string
+	namepoolFromFileFlag    string
+	namepoolPrefixClearFlag bool
 )
 
 var namepoolCmd = &cobra.Command{
@@ -34,6 +35,8 @@ Examples:
   gt namepool themes       # Show theme names
   gt namepool set minerals # Set theme to 'minerals'
   gt namepool add ember    # Add custom name to pool
+  gt namepool prefix api   # Name polecats api-furiosa, api-nux, ...
+  gt namepool from-bead on # Name polecats after their bead (session gt-abc12)
   gt namepool reset        # Reset pool state`,
 	RunE: runNamepool,
 }
@@ -107,6 +110,41 @@ theme, a warning is shown but deletion proceeds.`,
 	RunE: runNamepoolDelete,
 }
 
+var namepoolPrefixCmd = &cobra.Command{
+	Use:   "prefix [<prefix>]",
+	Short: "Set a prefix for this rig's polecat names",
+	Long: `Prefix every new polecat name in this rig, so tmux sessions show which
+project they belong to at a glance: with prefix "api", polecats are named
+api-furiosa, api-nux, ... and their sessions gt-api-furiosa, and so on.
+
+Without arguments, shows the current prefix. Existing polecats keep their
+names.
+
+Examples:
+  gt namepool prefix api
+  gt namepool prefix --clear`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runNamepoolPrefix,
+}
+
+var namepoolFromBeadCmd = &cobra.Command{
+	Use:   "from-bead [on|off]",
+	Short: "Name polecats after the bead they work on",
+	Long: `Name each new polecat after the bead it is spawned for instead of drawing
+from the theme. The rig's own bead prefix is dropped, so a polecat slung
+gt-abc12 in a gt rig is named abc12 and its session is gt-abc12 - the
+bead ID. The rig's name prefix, if any, still applies.
+
+Idle polecats are not reused in this mode, since they carry another bead's
+name; nuke finished polecats to keep the rig tidy. Polecats created without
+a bead still get theme names.
+
+Without arguments, shows whether the mode is on.`,
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: []string{"on", "off"},
+	RunE:      runNamepoolFromBead,
+}
+
 func init() {
 	rootCmd.AddCommand(namepoolCmd)
 	namepoolCmd.AddCommand(namepoolThemesCmd)
@@ -115,6 +153,9 @@ func init() {
 	namepoolCmd.AddCommand(namepoolResetCmd)
 	namepoolCmd.AddCommand(namepoolCreateCmd)
 	namepoolCmd.AddCommand(namepoolDeleteCmd)
+	namepoolCmd.AddCommand(namepoolPrefixCmd)
+	namepoolCmd.AddCommand(namepoolFromBeadCmd)
+	namepoolPrefixCmd.Flags().BoolVar(&namepoolPrefixClearFlag, "clear", false, "Remove the prefix")
 	namepoolCmd.Flags().BoolVarP(&namepoolListFlag, "list", "l", false, "List available themes")
 	namepoolCreateCmd.Flags().StringVar(&namepoolFromFileFlag, "from-file", "", "Read names from file instead of arguments")
 }
@@ -145,6 +186,7 @@ func runNamepool(cmd *cobra.Command, args []string) error {
 			settings.Namepool.Names,
 			settings.Namepool.MaxBeforeNumbering,
 		)
+		pool.SetNaming(settings.Namepool.Prefix, settings.Namepool.FromBead)
 	} else {
 		// Use defaults
 		pool = polecat.NewNamePool(rigPath, rigName)
@@ -167,6 +209,12 @@ func runNamepool(cmd *cobra.Command, args []string) error {
 	} else {
 		fmt.Printf("Theme: %s (custom)\n", theme)
 	}
+	if pool.Prefix != "" {
+		fmt.Printf("Prefix: %s\n", pool.Prefix)
+	}
+	if pool.NamesFromBead() {
+		fmt.Printf("Naming: from bead ID (theme names when there is no bead)\n")
+	}
 	fmt.Printf("Polecats: %d\n", pool.ActiveCount())
 
 	activeNames := pool.ActiveNames()
@@ -505,11 +553,16 @@ func saveRigNamepoolConfig(rigPath, theme string, customNames []string) error {
 		}
 	}
 
-	// Set namepool
-	settings.Namepool = &config.NamepoolConfig{
+	// Set namepool, keeping the naming options set by prefix and from-bead
+	next := &config.NamepoolConfig{
 		Style: theme,
 		Names: customNames,
 	}
+	if settings.Namepool != nil {
+		next.Prefix = settings.Namepool.Prefix
+		next.FromBead = settings.Namepool.FromBead
+	}
+	settings.Namepool = next
 
 	// Save (creates directory if needed)
 	if err := config.SaveRigSettings(settingsPath, settings); err != nil {
@@ -518,3 +571,98 @@ func saveRigNamepoolConfig(rigPath, theme string, customNames []string) error {
 
 	return nil
 }
+
+func runNamepoolPrefix(cmd *cobra.Command, args []string) error {
+	rigName, rigPath := detectCurrentRigWithPath()
+	if rigName == "" {
+		return fmt.Errorf("not in a rig directory")
+	}
+	if len(args) == 0 && !namepoolPrefixClearFlag {
+		settings, err := config.LoadRigSettings(filepath.Join(rigPath, "settings", "config.json"))
+		if err != nil || settings.Namepool == nil || settings.Namepool.Prefix == "" {
+			fmt.Printf("No name prefix for rig '%s'\n", rigName)
+			return nil
+		}
+		fmt.Printf("Name prefix for rig '%s': %s\n", rigName, settings.Namepool.Prefix)
+		return nil
+	}
+
+	prefix := ""
+	if !namepoolPrefixClearFlag {
+		if len(args) == 0 {
+			return fmt.Errorf("give a prefix or --clear")
+		}
+		prefix = strings.ToLower(args[0])
+		if err := polecat.ValidateNamePrefix(prefix); err != nil {
+			return err
+		}
+	}
+
+	if err := updateRigNamepoolConfig(rigPath, func(np *config.NamepoolConfig) { np.Prefix = prefix }); err != nil {
+		return err
+	}
+	if prefix == "" {
+		fmt.Printf("Name prefix cleared for rig '%s'\n", rigName)
+	} else {
+		fmt.Printf("New polecats in rig '%s' will be named %s-<name>\n", rigName, prefix)
+	}
+	return nil
+}
+
+func runNamepoolFromBead(cmd *cobra.Command, args []string) error {
+	rigName, rigPath := detectCurrentRigWithPath()
+	if rigName == "" {
+		return fmt.Errorf("not in a rig directory")
+	}
+	if len(args) == 0 {
+		settings, err := config.LoadRigSettings(filepath.Join(rigPath, "settings", "config.json"))
+		if err == nil && settings.Namepool != nil && settings.Namepool.FromBead {
+			fmt.Printf("Bead naming for rig '%s': on\n", rigName)
+		} else {
+			fmt.Printf("Bead naming for rig '%s': off\n", rigName)
+		}
+		return nil
+	}
+
+	var on bool
+	switch args[0] {
+	case "on":
+		on = true
+	case "off":
+	default:
+		return fmt.Errorf("expected on or off, got %q", args[0])
+	}
+	if err := updateRigNamepoolConfig(rigPath, func(np *config.NamepoolConfig) { np.FromBead = on }); err != nil {
+		return err
+	}
+	if on {
+		fmt.Printf("New polecats in rig '%s' will be named after their bead\n", rigName)
+	} else {
+		fmt.Printf("New polecats in rig '%s' will get theme names\n", rigName)
+	}
+	return nil
+}
+
+// updateRigNamepoolConfig applies update to the rig's namepool settings,
+// creating them if the rig has none yet. New settings keep the theme the rig
+// was already getting by default, so setting a naming option doesn't rename
+// the pool.
+func updateRigNamepoolConfig(rigPath string, update func(np *config.NamepoolConfig)) error {
+	settingsPath := filepath.Join(rigPath, "settings", "config.json")
+	settings, err := config.LoadRigSettings(settingsPath)
+	if err != nil {
+		if os.IsNotExist(err) || strings.Contains(err.Error(), "not found") {
+			settings = config.NewRigSettings()
+		} else {
+			return fmt.Errorf("loading settings: %w", err)
+		}
+	}
+	if settings.Namepool == nil {
+		settings.Namepool = &config.NamepoolConfig{Style: polecat.ThemeForRig(filepath.Base(rigPath))}
+	}
+	update(settings.Namepool)
+	if err := config.SaveRigSettings(settingsPath, settings); err != nil {
+		return fmt.Errorf("saving settings: %w", err)
+	}
+	return nil
+}
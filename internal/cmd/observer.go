@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// observerEnv turns on observer mode. gt sets it for its own children, so
+// commands run from an observer's dashboard or feed are refused too.
+const observerEnv = "GT_OBSERVER"
+
+// observerFlag is the global --observer flag.
+var observerFlag bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&observerFlag, "observer", false,
+		"Read-only observer mode: refuse commands that change town state (also "+observerEnv+"=1)")
+}
+
+// observerReadOnlyCommands are the commands observers may run, by path
+// below the root. Anything not listed may change town state and is refused,
+// so a new command stays refused until it is added here.
+var observerReadOnlyCommands = commandSet(
+	"", // bare gt prints help
+	"__complete",
+	"__completeNoDesc",
+	"access",
+	"account list", "account status",
+	"agents list",
+	"audit",
+	"bead show", "bead timeline", "bead transcript",
+	"boot status",
+	"changelog",
+	"completion", "completion bash", "completion fish",
+	"completion powershell", "completion zsh",
+	"config agent get", "config agent list",
+	"config default-agent list", "config get",
+	"config validate",
+	"convoy list", "convoy status",
+	"costs",
+	"crew list", "crew status",
+	"daemon logs", "daemon profile", "daemon status",
+	"dashboard", // Its actions run gt in observer mode and are refused
+	"deacon feed-stranded-state", "deacon health-state",
+	"deacon redispatch-state", "deacon status",
+	"debug bundle",
+	"directive list", "directive show",
+	"doctor", // Refused with --fix
+	"dog list", "dog status",
+	"dolt list", "dolt logs", "dolt status",
+	"epic status", "epic tree",
+	"escalate list", "escalate show",
+	"feed",
+	"formula list", "formula overlay list",
+	"formula overlay show", "formula show",
+	"help",
+	"hook show", "hook status",
+	"hooks diff", "hooks list",
+	"info",
+	"issue show",
+	"krc auto-prune-status", "krc stats",
+	"log",
+	"mail channel list", "mail channel show",
+	"mail channel subscribers", "mail directory",
+	"mail group list", "mail group show", "mail inbox",
+	"mail peek", "mail queue list", "mail queue show",
+	"mail search", "mail thread",
+	"maintenance list",
+	"mayor status",
+	"metrics",
+	"mol current", "mol dag", "mol progress",
+	"mol status",
+	"mountain status",
+	"mq integration status", "mq list", "mq status",
+	"namepool themes",
+	"orphans procs list",
+	"peek",
+	"plugin history", "plugin list", "plugin show",
+	"polecat env", "polecat git-state",
+	"polecat identity list", "polecat identity show",
+	"polecat list", "polecat stale", "polecat status",
+	"polecat worktree-pool status",
+	"policy denials",
+	"quota calendar", "quota export", "quota predict",
+	"quota status",
+	"ready",
+	"refinery blocked", "refinery ready", "refinery status",
+	"refinery unclaimed",
+	"rig cache status", "rig config show", "rig detect",
+	"rig list", "rig settings show", "rig status",
+	"role def", "role detect", "role env", "role home",
+	"role list", "role show",
+	"scheduler audit", "scheduler estimate", "scheduler list",
+	"scheduler preview", "scheduler status",
+	"schema",
+	"session capture", "session list", "session status",
+	"shell status",
+	"show",
+	"stale",
+	"stats",
+	"status",
+	"synthesis status",
+	"tap list",
+	"town template export",
+	"trail", "trail beads", "trail commits",
+	"trail hooks",
+	"version",
+	"vitals",
+	"warrant list",
+	"whoami",
+	"witness status",
+	"wl show",
+	"worktree list",
+)
+
+// commandSet builds a set of command paths.
+func commandSet(paths ...string) map[string]bool {
+	set := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		set[p] = true
+	}
+	return set
+}
+
+// observerWriteFlags are flags that turn a listed read-only command into one
+// that changes state (gt doctor --fix, gt polecat stale --cleanup).
+var observerWriteFlags = []string{"fix", "cleanup"}
+
+// observerMode reports whether gt runs in observer mode, and why: the
+// --observer flag, GT_OBSERVER, or the observer role in the town's roles
+// file. role is set only when the role is the reason.
+func observerMode(townRoot string) (on bool, role string) {
+	if observerFlag || isTruthy(os.Getenv(observerEnv)) {
+		return true, ""
+	}
+	if townRoot == "" {
+		return false, ""
+	}
+	roles, err := access.Load(townRoot)
+	if err != nil || roles == nil {
+		return false, "" // A broken roles file is reported by guarded commands
+	}
+	if roles.RoleOf(access.Identity()) == access.RoleObserver {
+		return true, access.RoleObserver
+	}
+	return false, ""
+}
+
+func isTruthy(v string) bool {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "1", "true", "yes", "on":
+		return true
+	}
+	return false
+}
+
+// observerAllows reports whether cmd may run in observer mode: read-only
+// commands, and anything run with --dry-run.
+func observerAllows(cmd *cobra.Command) bool {
+	if flagSet(cmd, "dry-run") {
+		return true
+	}
+	for _, name := range observerWriteFlags {
+		if flagSet(cmd, name) {
+			return false
+		}
+	}
+	path := strings.TrimPrefix(buildCommandPath(cmd), cmd.Root().Name())
+	return observerReadOnlyCommands[strings.TrimSpace(path)]
+}
+
+// flagSet reports whether cmd has a boolean flag name set to true.
+func flagSet(cmd *cobra.Command, name string) bool {
+	f := cmd.Flags().Lookup(name)
+	return f != nil && f.Value.Type() == "bool" && f.Value.String() == "true"
+}
+
+// checkObserverMode refuses cmd when gt is in observer mode and cmd may
+// change town state. Observer mode is exported to the environment so gt
+// processes started from this one (dashboard actions, feed keys) inherit it.
+func checkObserverMode(cmd *cobra.Command, townRoot string) error {
+	on, role := observerMode(townRoot)
+	if !on {
+		return nil
+	}
+	_ = os.Setenv(observerEnv, "1")
+	if observerAllows(cmd) {
+		return nil
+	}
+	cmd.SilenceUsage = true // A refusal, not a usage mistake
+	if role != "" && townRoot != "" {
+		identity := access.Identity()
+		_ = events.LogAudit(events.TypeAccessDenied, identity,
+			events.AccessDeniedPayload(cmd.CommandPath(), "write", role, access.RoleViewer))
+		return fmt.Errorf("%s is an observer and may not run %s: observers are read-only (see %s)",
+			identity, cmd.CommandPath(), access.Path(townRoot))
+	}
+	return fmt.Errorf("observer mode: %s may change town state and is refused (unset %s or drop --observer; --dry-run previews are allowed)",
+		cmd.CommandPath(), observerEnv)
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestObserverAllows(t *testing.T) {
+	root := &cobra.Command{Use: "gt"}
+	sched := &cobra.Command{Use: "scheduler"}
+	list := &cobra.Command{Use: "list"}
+	pause := &cobra.Command{Use: "pause"}
+	run := &cobra.Command{Use: "run"}
+	run.Flags().Bool("dry-run", false, "")
+	replay := &cobra.Command{Use: "replay"}
+	escalate := &cobra.Command{Use: "escalate"}
+	stale := &cobra.Command{Use: "stale"}
+	escalate.AddCommand(stale)
+	polecat := &cobra.Command{Use: "polecat"}
+	polecatStale := &cobra.Command{Use: "stale"}
+	polecatStale.Flags().Bool("cleanup", false, "")
+	polecat.AddCommand(polecatStale)
+	doctor := &cobra.Command{Use: "doctor"}
+	doctor.Flags().Bool("fix", false, "")
+	feed := &cobra.Command{Use: "feed"}
+	debug := &cobra.Command{Use: "debug"}
+	bundle := &cobra.Command{Use: "bundle"}
+	debug.AddCommand(bundle)
+	sched.AddCommand(list, pause, run, replay)
+	root.AddCommand(sched, doctor, feed, debug, escalate, polecat)
+
+	tests := []struct {
+		cmd   *cobra.Command
+		flags []string
+		want  bool
+	}{
+		{list, nil, true},
+		{pause, nil, false},
+		{run, nil, false},
+		{run, []string{"--dry-run"}, true},
+		{doctor, nil, true},
+		{doctor, []string{"--fix"}, false},
+		{feed, nil, true},
+		{bundle, nil, true},
+		{root, nil, true},
+		// A read-only name elsewhere in the tree doesn't make these read-only.
+		{replay, nil, false},
+		{stale, nil, false},
+		{polecatStale, nil, true},
+		{polecatStale, []string{"--cleanup"}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.cmd.Flags().Parse(tt.flags); err != nil {
+			t.Fatal(err)
+		}
+		if got := observerAllows(tt.cmd); got != tt.want {
+			t.Errorf("observerAllows(%s %v) = %v, want %v", tt.cmd.CommandPath(), tt.flags, got, tt.want)
+		}
+	}
+}
+
+func TestObserverReadOnlyCommandsExist(t *testing.T) {
+	paths := map[string]bool{}
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		paths[strings.TrimSpace(strings.TrimPrefix(c.CommandPath(), rootCmd.Name()))] = true
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+	for p := range observerReadOnlyCommands {
+		// cobra adds help and completion commands when gt runs.
+		if first, _, _ := strings.Cut(p, " "); first == "help" || first == "completion" || strings.HasPrefix(first, "__") {
+			continue
+		}
+		if !paths[p] {
+			t.Errorf("observer allowlist names %q, which is not a command", p)
+		}
+	}
+}
+
+func TestObserverMode(t *testing.T) {
+	trustBDActor(t)
+	t.Setenv(observerEnv, "")
+	t.Setenv("BD_ACTOR", "stakeholder")
+	town := t.TempDir()
+	if on, _ := observerMode(town); on {
+		t.Fatal("observer mode on without flag, env or roles file")
+	}
+
+	t.Setenv(observerEnv, "1")
+	if on, role := observerMode(town); !on || role != "" {
+		t.Errorf("GT_OBSERVER=1: on=%v role=%q, want on without role", on, role)
+	}
+	t.Setenv(observerEnv, "")
+
+	if err := os.MkdirAll(filepath.Join(town, "settings"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	roles := `{"version":1,"users":{"stakeholder":"observer","alice":"admin"}}`
+	if err := os.WriteFile(filepath.Join(town, "settings", "roles.json"), []byte(roles), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if on, role := observerMode(town); !on || role != "observer" {
+		t.Errorf("observer role: on=%v role=%q, want on via observer", on, role)
+	}
+	t.Setenv("BD_ACTOR", "alice")
+	if on, _ := observerMode(town); on {
+		t.Error("admin should not be in observer mode")
+	}
+}
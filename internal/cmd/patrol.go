@@ -5,12 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -186,7 +186,7 @@ func runPatrolDigest(cmd *cobra.Command, args []string) error {
 func queryPatrolDigests(targetDate time.Time) ([]PatrolCycleEntry, error) {
 	// List closed issues with "digest" label that are ephemeral
 	// Patrol digests have titles like "Digest: mol-deacon-patrol", "Digest: mol-witness-patrol"
-	listCmd := exec.Command("bd", "list",
+	listCmd := beads.Command("list",
 		"--status=closed",
 		"--label=digest",
 		"--json",
@@ -308,7 +308,7 @@ func createPatrolDigestBead(digest PatrolDigest) (string, error) {
 		"--silent",
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
+	bdCmd := beads.Command(bdArgs...)
 	output, err := bdCmd.CombinedOutput()
 	if err != nil {
 		return "", fmt.Errorf("creating digest bead: %w\nOutput: %s", err, string(output))
@@ -317,7 +317,7 @@ func createPatrolDigestBead(digest PatrolDigest) (string, error) {
 	digestID := strings.TrimSpace(string(output))
 
 	// Auto-close the digest (it's an audit record, not work)
-	closeCmd := exec.Command("bd", "close", digestID, "--reason=daily patrol digest")
+	closeCmd := beads.Command("close", digestID, "--reason=daily patrol digest")
 	_ = closeCmd.Run() // Best effort
 
 	return digestID, nil
@@ -329,7 +329,7 @@ func findExistingPatrolDigest(dateStr string) (string, error) {
 	expectedTitle := fmt.Sprintf("Patrol Report %s", dateStr)
 
 	// Query event beads with patrol.digest category
-	listCmd := exec.Command("bd", "list",
+	listCmd := beads.Command("list",
 		"--type=event",
 		"--json",
 		"--limit=50", // Recent events only
@@ -377,7 +377,7 @@ func deletePatrolDigests(targetDate time.Time) (int, error) {
 
 	// Delete in batch
 	deleteArgs := append([]string{"delete", "--force"}, idsToDelete...)
-	deleteCmd := exec.Command("bd", deleteArgs...)
+	deleteCmd := beads.Command(deleteArgs...)
 	if err := deleteCmd.Run(); err != nil {
 		return 0, fmt.Errorf("deleting patrol digests: %w", err)
 	}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/deacon"
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/style"
 )
@@ -107,6 +109,18 @@ func runPatrolReport(cmd *cobra.Command, args []string) error {
 	// Print the step audit for visibility
 	fmt.Println(stepAudit)
 
+	// Record the deacon's structured findings for gt deacon report.
+	if roleInfo.Role == RoleDeacon {
+		findings := collectPatrolFindings(roleInfo.TownRoot, time.Now())
+		findings.PatrolID = patrolID
+		findings.Summary = patrolReportSummary
+		if err := deacon.WriteFindings(roleInfo.TownRoot, findings); err != nil {
+			style.PrintWarning("could not write patrol findings: %v", err)
+		} else if n := len(findings.Findings); n > 0 {
+			fmt.Printf("%s %d finding(s) recorded (gt deacon report)\n", style.Warning.Render("⚠"), n)
+		}
+	}
+
 	// Close all descendant wisps first (recursive), then the patrol root.
 	// Without this, every patrol cycle leaks ~10 orphan wisps into the DB.
 	// If descendants can't be closed, abort so patrol retries next cycle (gt-7lx3).
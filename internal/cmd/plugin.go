@@ -18,15 +18,15 @@ import (
 
 // Plugin command flags
 var (
-	pluginListJSON     bool
-	pluginShowJSON     bool
-	pluginRunForce     bool
-	pluginRunDryRun    bool
-	pluginHistoryJSON  bool
-	pluginHistoryLimit int
-	pluginSyncSource   string
-	pluginSyncClean    bool
-	pluginSyncDryRun   bool
+	pluginListJSON    bool
+	pluginShowJSON    bool
+	pluginRunForce    bool
+	pluginRunDryRun   bool
+	pluginHistoryJSON bool
+	pluginHistoryPage listFlags
+	pluginSyncSource  string
+	pluginSyncClean   bool
+	pluginSyncDryRun  bool
 )
 
 var pluginCmd = &cobra.Command{
@@ -148,7 +148,7 @@ func init() {
 
 	// History subcommand flags
 	pluginHistoryCmd.Flags().BoolVar(&pluginHistoryJSON, "json", false, "Output as JSON")
-	pluginHistoryCmd.Flags().IntVar(&pluginHistoryLimit, "limit", 10, "Maximum number of runs to show")
+	pluginRunListing.register(pluginHistoryCmd, &pluginHistoryPage, 10)
 
 	// Sync subcommand flags
 	pluginSyncCmd.Flags().StringVar(&pluginSyncSource, "source", "", "Source plugins directory (auto-detected if omitted)")
@@ -587,27 +587,23 @@ func runPluginHistory(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("querying history: %w", err)
 	}
 
-	if runs == nil {
-		runs = []*plugin.PluginRunBead{}
-	}
-
-	// Apply limit
-	if pluginHistoryLimit > 0 && len(runs) > pluginHistoryLimit {
-		runs = runs[:pluginHistoryLimit]
+	// Newest first by default, then page.
+	page, err := pluginRunListing.apply(runs, pluginHistoryPage)
+	if err != nil {
+		return err
 	}
+	runs = page.Items
 
 	if pluginHistoryJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(runs)
+		return printPageJSON(page, runs)
 	}
 
-	if len(runs) == 0 {
+	if page.Total == 0 {
 		fmt.Printf("%s No execution history for plugin: %s\n", style.Dim.Render("○"), name)
 		return nil
 	}
 
-	fmt.Printf("%s Execution history for %s (%d runs)\n\n", style.Success.Render("●"), name, len(runs))
+	fmt.Printf("%s Execution history for %s (%d runs)\n\n", style.Success.Render("●"), name, page.Total)
 
 	for _, run := range runs {
 		resultStyle := style.Success
@@ -625,6 +621,17 @@ func runPluginHistory(cmd *cobra.Command, args []string) error {
 			run.CreatedAt.Format("2006-01-02 15:04"),
 			style.Dim.Render(run.ID))
 	}
+	printPageFooter(page)
 
 	return nil
 }
+
+// pluginRunListing sorts gt plugin history runs, newest first.
+var pluginRunListing = listing[*plugin.PluginRunBead]{
+	fields: []listField[*plugin.PluginRunBead]{
+		{"created", func(r *plugin.PluginRunBead) any { return r.CreatedAt }},
+		{"result", func(r *plugin.PluginRunBead) any { return string(r.Result) }},
+	},
+	defaultSort: "-created",
+	id:          func(r *plugin.PluginRunBead) string { return r.ID },
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
@@ -73,7 +74,8 @@ all polecats with their states:
 Examples:
   gt polecat list greenplace
   gt polecat list --all
-  gt polecat list greenplace --json`,
+  gt polecat list greenplace --json
+  gt polecat list --all --json      # Machine-readable, for bulk scripting`,
 	RunE: runPolecatList,
 }
 
@@ -386,6 +388,7 @@ type PolecatListItem struct {
 	Issue          string        `json:"issue,omitempty"`
 	SessionRunning bool          `json:"session_running"`
 	Zombie         bool          `json:"zombie,omitempty"`
+	Paused         bool          `json:"paused,omitempty"`
 	SessionName    string        `json:"session_name,omitempty"`
 }
 
@@ -470,6 +473,7 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 				State:          p.State,
 				Issue:          p.Issue,
 				SessionRunning: running,
+				Paused:         running && isPolecatPaused(t, polecatMgr.SessionName(p.Name)),
 			})
 			knownNames[p.Name] = true
 		}
@@ -537,6 +541,10 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 			stateStr = style.Dim.Render(stateStr)
 		}
 
+		if p.Paused {
+			stateStr += " " + style.Warning.Render("(paused)")
+		}
+
 		fmt.Printf("  %s %s/%s  %s\n", sessionStatus, p.Rig, p.Name, stateStr)
 		if p.Issue != "" {
 			fmt.Printf("    %s\n", style.Dim.Render(p.Issue))
@@ -652,6 +660,8 @@ type PolecatStatus struct {
 	Windows        int           `json:"windows,omitempty"`
 	CreatedAt      string        `json:"created_at,omitempty"`
 	LastActivity   string        `json:"last_activity,omitempty"`
+
+	Spawn *polecat.SpawnRecord `json:"spawn,omitempty"`
 }
 
 func runPolecatStatus(cmd *cobra.Command, args []string) error {
@@ -683,6 +693,9 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Spawn record (absent for polecats spawned before records existed)
+	spawn, _ := polecat.LoadSpawnRecord(r.Path, polecatName)
+
 	// JSON output
 	if polecatStatusJSON {
 		status := PolecatStatus{
@@ -696,6 +709,7 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 			SessionID:      sessInfo.SessionID,
 			Attached:       sessInfo.Attached,
 			Windows:        sessInfo.Windows,
+			Spawn:          spawn,
 		}
 		if !sessInfo.Created.IsZero() {
 			status.CreatedAt = sessInfo.Created.Format("2006-01-02 15:04:05")
@@ -738,6 +752,10 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Clone:         %s\n", style.Dim.Render(p.ClonePath))
 	fmt.Printf("  Branch:        %s\n", style.Dim.Render(p.Branch))
 
+	if spawn != nil {
+		printPolecatSpawnSummary(spawn)
+	}
+
 	// Session info
 	fmt.Println()
 	fmt.Printf("%s\n", style.Bold.Render("Session"))
@@ -1172,6 +1190,9 @@ func splitLines(s string) []string {
 }
 
 func runPolecatNuke(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionKill); err != nil {
+		return err
+	}
 	targets, err := resolvePolecatTargets(args, polecatNukeAll)
 	if err != nil {
 		return err
@@ -1530,6 +1551,11 @@ func runPolecatStale(cmd *cobra.Command, args []string) error {
 			fmt.Printf("    Behind main: %s\n", behindStyle.Render(fmt.Sprintf("%d commits", info.CommitsBehind)))
 		}
 
+		// What it was last slung, from its spawn record
+		if !info.SlungAt.IsZero() {
+			fmt.Printf("    Slung: %s %s\n", info.Bead, style.Dim.Render("("+formatActivityTime(info.SlungAt)+")"))
+		}
+
 		// Agent state
 		if info.AgentState != "" {
 			fmt.Printf("    Agent state: %s\n", info.AgentState)
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var polecatEnvJSON bool
+
+var polecatEnvCmd = &cobra.Command{
+	Use:   "env <rig>/<polecat>",
+	Short: "Show the environment a polecat was spawned with",
+	Long: `Show the effective environment and parameters a polecat was spawned
+with: the gt sling options, agent and command, account, bead, formula and
+vars, base branch and commit, worktree, and every environment variable
+injected into its session.
+
+This is reconstructed from the polecat's spawn record
+(<rig>/.runtime/polecats/<polecat>/spawn.json), written by gt sling and
+completed when the session starts, so it works after the session has exited
+or the polecat was nuked. Each sling replaces the record. Values of
+variables that name a secret (tokens, keys, passwords) are redacted.
+
+Use it to answer "why did this agent behave differently": compare two
+polecats' output with diff.
+
+Examples:
+  gt polecat env greenplace/Toast
+  gt polecat env Toast                    # Rig inferred from cwd
+  gt polecat env greenplace/Toast --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPolecatEnv,
+}
+
+func init() {
+	polecatEnvCmd.Flags().BoolVar(&polecatEnvJSON, "json", false, "Output as JSON")
+	polecatCmd.AddCommand(polecatEnvCmd)
+}
+
+func runPolecatEnv(cmd *cobra.Command, args []string) error {
+	rigName, polecatName, err := parseAddress(args[0])
+	if err != nil {
+		return err
+	}
+	_, r, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+	rec, err := polecat.LoadSpawnRecord(r.Path, polecatName)
+	if err != nil {
+		return err
+	}
+
+	if polecatEnvJSON {
+		return outputJSON(rec)
+	}
+	printSpawnRecord(os.Stdout, rec)
+	return nil
+}
+
+// printSpawnRecord writes a spawn record as gt polecat env prints it.
+func printSpawnRecord(w io.Writer, rec *polecat.SpawnRecord) {
+	fmt.Fprintf(w, "%s\n", style.Bold.Render(fmt.Sprintf("Polecat: %s/%s", rec.Rig, rec.Polecat)))
+	field := func(label, value string) {
+		if value == "" {
+			value = style.Dim.Render("(none)")
+		}
+		fmt.Fprintf(w, "  %-14s %s\n", label+":", value)
+	}
+	when := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Local().Format("2006-01-02 15:04:05") + " " + style.Dim.Render("("+formatActivityTime(t)+")")
+	}
+	field("Session", rec.Session)
+	field("Slung", when(rec.SlungAt))
+	field("Started", when(rec.StartedAt))
+	fmt.Fprintln(w)
+
+	agent := rec.Agent
+	if rec.AgentOverride != "" && rec.AgentOverride != rec.Agent {
+		agent += style.Dim.Render(" (--agent " + rec.AgentOverride + ")")
+	} else if rec.AgentOverride == "" && agent != "" {
+		agent += style.Dim.Render(" (role default)")
+	}
+	field("Agent", agent)
+	field("Command", strings.TrimSpace(rec.Command+" "+strings.Join(rec.Args, " ")))
+	account := rec.Account
+	if rec.ConfigDir != "" {
+		account = strings.TrimSpace(account + " " + style.Dim.Render("("+rec.ConfigDir+")"))
+	}
+	field("Account", account)
+	field("Bead", rec.Bead)
+	field("Formula", rec.Formula)
+	field("Base", spawnBase(rec))
+	field("Branch", rec.Branch)
+	field("Worktree", rec.Worktree)
+	field("Run", rec.RunID)
+	if rec.CorrelationID != "" {
+		field("Correlation", rec.CorrelationID)
+	}
+
+	if rec.Sling != nil {
+		fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Sling"))
+		for _, line := range slingOptionLines(rec.Sling) {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+
+	if len(rec.FormulaVars) > 0 {
+		fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Formula vars"))
+		for _, kv := range rec.FormulaVars {
+			fmt.Fprintf(w, "  %s\n", kv)
+		}
+	}
+
+	fmt.Fprintf(w, "\n%s\n", style.Bold.Render("Environment"))
+	keys := make([]string, 0, len(rec.Env))
+	for k := range rec.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "  %s=%s\n", k, rec.Env[k])
+	}
+}
+
+// spawnBase renders the base branch and commit a polecat was spawned from.
+func spawnBase(rec *polecat.SpawnRecord) string {
+	if rec.BaseSHA == "" {
+		return rec.BaseBranch
+	}
+	return strings.TrimSpace(rec.BaseBranch + " " + style.Dim.Render("@ "+shortHash(rec.BaseSHA)))
+}
+
+// slingOptionLines renders the sling options that were set, one per line.
+func slingOptionLines(o *polecat.SlingOptions) []string {
+	var lines []string
+	if o.Caller != "" {
+		lines = append(lines, "via "+o.Caller)
+	}
+	if o.Args != "" {
+		lines = append(lines, fmt.Sprintf("--args %q", o.Args))
+	}
+	for _, kv := range o.Vars {
+		lines = append(lines, "--var "+kv)
+	}
+	if o.Merge != "" {
+		lines = append(lines, "--merge "+o.Merge)
+	}
+	for _, flag := range []struct {
+		set  bool
+		name string
+	}{
+		{o.Mode == "ralph", "--ralph"},
+		{o.NoMerge, "--no-merge"},
+		{o.ReviewOnly, "--review-only"},
+		{o.Owned, "--owned"},
+		{o.NoConvoy, "--no-convoy"},
+		{o.HookRawBead, "--hook-raw-bead"},
+		{o.Force, "--force"},
+	} {
+		if flag.set {
+			lines = append(lines, flag.name)
+		}
+	}
+	return lines
+}
+
+// printPolecatSpawnSummary prints the Spawn section of gt polecat status:
+// the parts of the spawn record that explain what the polecat is doing.
+func printPolecatSpawnSummary(rec *polecat.SpawnRecord) {
+	fmt.Println()
+	fmt.Printf("%s\n", style.Bold.Render("Spawn"))
+	if !rec.SlungAt.IsZero() {
+		via := ""
+		if rec.Sling != nil && rec.Sling.Caller != "" {
+			via = " via " + rec.Sling.Caller
+		}
+		fmt.Printf("  Slung:         %s%s\n", style.Dim.Render(formatActivityTime(rec.SlungAt)), via)
+	}
+	if rec.Bead != "" {
+		fmt.Printf("  Bead:          %s\n", rec.Bead)
+	}
+	if rec.Formula != "" {
+		fmt.Printf("  Formula:       %s\n", rec.Formula)
+	}
+	if rec.Agent != "" {
+		fmt.Printf("  Agent:         %s\n", rec.Agent)
+	}
+	if base := spawnBase(rec); base != "" {
+		fmt.Printf("  Base:          %s\n", base)
+	}
+	fmt.Printf("  %s\n", style.Dim.Render("Full record: gt polecat env "+rec.Rig+"/"+rec.Polecat))
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestPrintSpawnRecord(t *testing.T) {
+	rec := &polecat.SpawnRecord{
+		Rig:           "gastown",
+		Polecat:       "Toast",
+		Session:       "gt-gastown-p-Toast",
+		SlungAt:       time.Now().Add(-3 * time.Hour),
+		StartedAt:     time.Now().Add(-2 * time.Hour),
+		Agent:         "codex",
+		AgentOverride: "codex",
+		Command:       "codex",
+		Args:          []string{"--yolo"},
+		Bead:          "gt-abc",
+		FormulaVars:   []string{"base_branch=dev"},
+		Sling:         &polecat.SlingOptions{Vars: []string{"x=1"}, NoMerge: true, Caller: "batch-sling"},
+		BaseBranch:    "dev",
+		BaseSHA:       "0123456789abcdef",
+		Env:           map[string]string{"GT_RIG": "gastown", "BD_DOLT_AUTO_COMMIT": "off"},
+	}
+	var buf bytes.Buffer
+	printSpawnRecord(&buf, rec)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Polecat: gastown/Toast",
+		"3 hours ago",
+		"2 hours ago",
+		"Base:          dev @ 01234567",
+		"  via batch-sling\n  --var x=1\n  --no-merge\n",
+		"Command:       codex --yolo",
+		"Bead:          gt-abc",
+		"Formula:       (none)",
+		"  base_branch=dev",
+		"  BD_DOLT_AUTO_COMMIT=off\n  GT_RIG=gastown\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Correlation") {
+		t.Errorf("empty correlation ID printed:\n%s", out)
+	}
+}
@@ -81,6 +81,59 @@ func resolvePolecatTargets(args []string, useAll bool) ([]polecatTarget, error)
 	return targets, nil
 }
 
+// resolveBulkPolecatTargets resolves targets for commands that can act on many
+// polecats at once. Without all, args are rig/polecat addresses. With all, every
+// polecat in rigFilter is returned; with no rigFilter, every polecat in the rigs
+// named by args, or in every rig when args is empty.
+func resolveBulkPolecatTargets(args []string, all bool, rigFilter string) ([]polecatTarget, error) {
+	if !all {
+		if rigFilter != "" {
+			return nil, fmt.Errorf("--rig requires --all")
+		}
+		if len(args) == 0 {
+			return nil, fmt.Errorf("provide <rig>/<polecat> addresses, or use --all")
+		}
+		return resolvePolecatTargets(args, false)
+	}
+
+	rigNames := args
+	if rigFilter != "" {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("--rig and positional rig names are mutually exclusive")
+		}
+		rigNames = []string{rigFilter}
+	}
+	if len(rigNames) == 0 {
+		rigs, err := getAllRigs()
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rigs {
+			rigNames = append(rigNames, r.Name)
+		}
+	}
+
+	var targets []polecatTarget
+	for _, rigName := range rigNames {
+		rigTargets, err := resolvePolecatTargets([]string{rigName}, true)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, rigTargets...)
+	}
+	return targets, nil
+}
+
+// confirmPolecatBulkAction asks before acting on more polecats than the
+// town's confirm.kill_polecats threshold (default 1, so any bulk action).
+func confirmPolecatBulkAction(verb string, targets []polecatTarget, yes bool) (bool, error) {
+	names := make([]string, len(targets))
+	for i, p := range targets {
+		names[i] = p.rigName + "/" + p.polecatName
+	}
+	return confirmBulkAction(verb, "polecat(s)", names, loadConfirmConfig().GetKillPolecats(), yes)
+}
+
 // SafetyCheckResult holds the result of safety checks for a polecat.
 type SafetyCheckResult struct {
 	Polecat       string
@@ -11,7 +11,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
@@ -374,6 +373,16 @@ func runPolecatIdentityList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// identityShowOutput is the --json output of gt polecat identity show:
+// the identity's details and CV.
+type identityShowOutput struct {
+	IdentityInfo
+	Title     string     `json:"title"`
+	CreatedAt string     `json:"created_at,omitempty"`
+	UpdatedAt string     `json:"updated_at,omitempty"`
+	CV        *CVSummary `json:"cv,omitempty"`
+}
+
 func runPolecatIdentityShow(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 	polecatName := args[1]
@@ -413,13 +422,7 @@ func runPolecatIdentityShow(cmd *cobra.Command, args []string) error {
 
 	// JSON output - include both identity details and CV
 	if polecatIdentityShowJSON {
-		output := struct {
-			IdentityInfo
-			Title     string     `json:"title"`
-			CreatedAt string     `json:"created_at,omitempty"`
-			UpdatedAt string     `json:"updated_at,omitempty"`
-			CV        *CVSummary `json:"cv,omitempty"`
-		}{
+		output := identityShowOutput{
 			IdentityInfo: IdentityInfo{
 				Rig:            rigName,
 				Name:           polecatName,
@@ -809,7 +812,7 @@ func queryAssignedIssues(rigPath, assignee, status string) ([]IssueInfo, error)
 		args = append(args, "--status="+status)
 	}
 
-	cmd := exec.Command("bd", args...)
+	cmd := beads.Command(args...)
 	cmd.Dir = rigPath
 	out, err := cmd.Output()
 	if err != nil {
@@ -992,8 +995,8 @@ func formatRelativeTimeCV(timestamp string) string {
 	}
 }
 
-// formatCountStyled formats a count with appropriate styling using lipgloss.Style.
-func formatCountStyled(count int, s lipgloss.Style) string {
+// formatCountStyled formats a count with appropriate styling using style.Style.
+func formatCountStyled(count int, s style.Style) string {
 	if count == 0 {
 		return style.Dim.Render("0")
 	}
@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// WIP handling policies for gt polecat kill.
+const (
+	killWIPCommit  = "commit"
+	killWIPStash   = "stash"
+	killWIPDiscard = "discard"
+)
+
+var (
+	polecatKillRequeue      bool
+	polecatKillAbandon      bool
+	polecatKillWIP          string
+	polecatKillKeepWorktree bool
+	polecatKillReason       string
+	polecatKillDryRun       bool
+	polecatKillAll          bool
+	polecatKillRig          string
+	polecatKillYes          bool
+)
+
+var polecatKillCmd = &cobra.Command{
+	Use:   "kill <rig>/<polecat>... | --all [--rig <rig>]",
+	Short: "Stop a polecat and release its hooked work cleanly",
+	Long: `Stop a polecat mid-work without leaving its bead hooked forever.
+
+Killing the tmux session by hand strands the polecat's bead in 'hooked'
+status with no one working it. This command instead:
+  1. Kills the polecat's session
+  2. Saves uncommitted work per --wip (commit and push, stash, or discard)
+  3. Releases the hooked bead: back to open, or requeued with --requeue
+  4. Removes the worktree and resets the agent bead (unless --keep-worktree,
+     or the WIP could not be saved elsewhere: a failed commit, stash or push
+     keeps the worktree and branch, and the bead comment says where). A kept
+     polecat is marked stuck so it is not reused until nuked
+  5. Records a comment on the bead and kill/unhook events in the feed
+
+With --all, every polecat in the town is killed (or every polecat in one rig
+with --rig). Killing more than one polecat asks for confirmation unless
+--yes is given.
+
+Bead handling:
+  (default)    Unhook; the bead returns to open and unassigned
+  --requeue    Unhook and schedule the bead for dispatch to a fresh polecat
+  --abandon    Unhook and drop the attempt (implies --wip=discard)
+
+WIP policies:
+  commit   Commit all changes on the polecat branch and push it (default)
+  stash    Stash changes (stashes survive worktree removal)
+  discard  Leave changes uncommitted; lost when the worktree is removed
+
+Examples:
+  gt polecat kill greenplace/Toast
+  gt polecat kill greenplace/Toast --requeue
+  gt polecat kill greenplace/Toast --abandon --reason "wrong approach"
+  gt polecat kill greenplace/Toast --wip stash --keep-worktree
+  gt polecat kill --all --rig greenplace --requeue`,
+	RunE: runPolecatKill,
+}
+
+func init() {
+	polecatKillCmd.Flags().BoolVar(&polecatKillRequeue, "requeue", false, "Schedule the bead for dispatch to a fresh polecat")
+	polecatKillCmd.Flags().BoolVar(&polecatKillAbandon, "abandon", false, "Drop this attempt: discard WIP and release the bead")
+	polecatKillCmd.Flags().StringVar(&polecatKillWIP, "wip", "", "Uncommitted work policy: commit, stash, or discard (default commit)")
+	polecatKillCmd.Flags().BoolVar(&polecatKillKeepWorktree, "keep-worktree", false, "Keep the polecat's worktree and branch")
+	polecatKillCmd.Flags().StringVar(&polecatKillReason, "reason", "", "Why the polecat was killed (recorded on the bead)")
+	polecatKillCmd.Flags().BoolVarP(&polecatKillDryRun, "dry-run", "n", false, "Show what would be done")
+	polecatKillCmd.Flags().BoolVar(&polecatKillAll, "all", false, "Kill all polecats (in every rig, or in --rig)")
+	polecatKillCmd.Flags().StringVar(&polecatKillRig, "rig", "", "With --all, only kill polecats in this rig")
+	polecatKillCmd.Flags().BoolVarP(&polecatKillYes, "yes", "y", false, "Skip confirmation prompt")
+	polecatCmd.AddCommand(polecatKillCmd)
+}
+
+// polecatKillWIPPolicy validates the flag combination and returns the WIP policy.
+func polecatKillWIPPolicy(wip string, requeue, abandon bool) (string, error) {
+	if requeue && abandon {
+		return "", fmt.Errorf("--requeue and --abandon are mutually exclusive")
+	}
+	switch wip {
+	case "":
+		if abandon {
+			return killWIPDiscard, nil
+		}
+		return killWIPCommit, nil
+	case killWIPCommit, killWIPStash, killWIPDiscard:
+		return wip, nil
+	default:
+		return "", fmt.Errorf("invalid --wip %q: use commit, stash, or discard", wip)
+	}
+}
+
+// polecatKillAction describes what happens to the hooked bead.
+func polecatKillAction(requeue, abandon bool) string {
+	switch {
+	case requeue:
+		return "requeued"
+	case abandon:
+		return "abandoned"
+	default:
+		return "released"
+	}
+}
+
+func runPolecatKill(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionKill); err != nil {
+		return err
+	}
+	wipPolicy, err := polecatKillWIPPolicy(polecatKillWIP, polecatKillRequeue, polecatKillAbandon)
+	if err != nil {
+		return err
+	}
+	targets, err := resolveBulkPolecatTargets(args, polecatKillAll, polecatKillRig)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		fmt.Println("No polecats to kill.")
+		return nil
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	if !polecatKillDryRun {
+		ok, err := confirmPolecatBulkAction("kill", targets, polecatKillYes)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Kill canceled.")
+			return nil
+		}
+	}
+
+	var killErrors []string
+	for _, p := range targets {
+		if err := killPolecat(townRoot, p, wipPolicy); err != nil {
+			killErrors = append(killErrors, fmt.Sprintf("%s/%s: %v", p.rigName, p.polecatName, err))
+		}
+	}
+
+	if len(killErrors) > 0 {
+		fmt.Printf("\n%s Some kills failed:\n", style.Warning.Render("Warning:"))
+		for _, e := range killErrors {
+			fmt.Printf("  - %s\n", e)
+		}
+		return fmt.Errorf("%d of %d kill(s) failed", len(killErrors), len(targets))
+	}
+	return nil
+}
+
+// killPolecat stops one polecat and releases its hooked work per the kill flags.
+func killPolecat(townRoot string, p polecatTarget, wipPolicy string) error {
+	info, err := p.mgr.Get(p.polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat %s/%s: %w", p.rigName, p.polecatName, err)
+	}
+	address := p.rigName + "/" + p.polecatName
+	action := polecatKillAction(polecatKillRequeue, polecatKillAbandon)
+
+	if polecatKillDryRun {
+		fmt.Printf("Would kill %s:\n", address)
+		fmt.Printf("  - Kill session\n")
+		fmt.Printf("  - WIP: %s\n", wipPolicy)
+		if info.Issue != "" {
+			fmt.Printf("  - Bead %s: %s\n", info.Issue, action)
+		}
+		if !polecatKillKeepWorktree {
+			fmt.Printf("  - Remove worktree and reset agent bead\n")
+		}
+		return nil
+	}
+
+	fmt.Printf("Killing %s...\n", address)
+
+	// Step 1: Stop the session first so the agent can't race our cleanup.
+	// A paused session is thawed first so its processes can handle SIGTERM.
+	t := tmux.NewTmux()
+	sessMgr := polecat.NewSessionManager(t, p.r)
+	if sessionName := sessMgr.SessionName(p.polecatName); isPolecatPaused(t, sessionName) {
+		_ = resumePolecatSession(t, sessionName)
+	}
+	if err := sessMgr.Stop(p.polecatName, true); err != nil {
+		if !errors.Is(err, polecat.ErrSessionNotFound) {
+			return fmt.Errorf("killing session: %w", err)
+		}
+		fmt.Printf("  %s session not running\n", style.Dim.Render("○"))
+	} else {
+		fmt.Printf("  %s killed session\n", style.Success.Render("✓"))
+	}
+
+	// Step 2: Preserve (or drop) uncommitted work. WIP that could not be
+	// saved anywhere else keeps the worktree alive.
+	wipNote, keepWIP := saveKilledPolecatWIP(info, wipPolicy)
+
+	// Step 3: Release the hooked bead.
+	if info.Issue != "" {
+		nukeCleanupMolecules(info.Issue, p.r)
+		releaseKilledPolecatBead(townRoot, p, info.Issue, action, wipNote)
+	}
+
+	// Step 4: Clean the sandbox per policy.
+	if keepWIP && !polecatKillKeepWorktree {
+		style.PrintWarning("WIP not saved off the worktree; keeping %s and its branch", info.ClonePath)
+	}
+	if polecatKillKeepWorktree || keepWIP {
+		// Stuck keeps the polecat out of idle reuse, which would hard-reset
+		// the kept branch, until someone nukes it.
+		if err := p.mgr.SetAgentState(p.polecatName, string(beads.AgentStateStuck)); err != nil {
+			style.PrintWarning("could not set agent_state for %s: %v", address, err)
+		}
+		fmt.Printf("  %s kept worktree %s\n", style.Dim.Render("○"), info.ClonePath)
+	} else if err := nukePolecatFull(p.polecatName, p.rigName, p.mgr, p.r); err != nil {
+		style.PrintWarning("worktree cleanup failed: %v", err)
+	}
+
+	reason := polecatKillReason
+	if reason == "" {
+		reason = "killed via gt polecat kill"
+	}
+	_ = events.LogFeed(events.TypeKill, detectActor(), events.KillPayload(p.rigName, address, reason))
+
+	fmt.Printf("%s Killed %s", style.SuccessPrefix, address)
+	if info.Issue != "" {
+		fmt.Printf(" (%s %s)", info.Issue, action)
+	}
+	fmt.Println()
+	return nil
+}
+
+// saveKilledPolecatWIP applies the WIP policy to the polecat's worktree and
+// returns a short description of what was done, for the bead comment. keep
+// reports that the WIP exists only in the worktree (the check, commit or push
+// failed), so the worktree and branch must not be removed.
+func saveKilledPolecatWIP(info *polecat.Polecat, policy string) (note string, keep bool) {
+	if info.ClonePath == "" {
+		return "", false
+	}
+	if _, err := os.Stat(info.ClonePath); err != nil {
+		return "", false
+	}
+	g := git.NewGit(info.ClonePath)
+	dirty, err := g.HasUncommittedChanges()
+	if err != nil {
+		fmt.Printf("  %s could not check WIP: %v\n", style.Warning.Render("⚠"), err)
+		return "WIP not checked; worktree kept at " + info.ClonePath, policy != killWIPDiscard
+	}
+	if !dirty {
+		return "", false
+	}
+
+	switch policy {
+	case killWIPCommit:
+		if err := g.Add("-A"); err == nil {
+			err = g.Commit("WIP: interrupted by gt polecat kill")
+		}
+		if err != nil {
+			fmt.Printf("  %s WIP commit failed: %v\n", style.Warning.Render("⚠"), err)
+			return "WIP commit failed; worktree kept at " + info.ClonePath, true
+		}
+		fmt.Printf("  %s committed WIP on %s\n", style.Success.Render("✓"), info.Branch)
+		if info.Branch == "" {
+			return "WIP committed (no branch); worktree kept at " + info.ClonePath, true
+		}
+		if err := g.Push("origin", info.Branch+":"+info.Branch, false); err != nil {
+			fmt.Printf("  %s push failed (commit kept locally): %v\n", style.Dim.Render("○"), err)
+			return "WIP committed on " + info.Branch + " (not pushed); worktree and branch kept at " + info.ClonePath, true
+		}
+		fmt.Printf("  %s pushed %s\n", style.Success.Render("✓"), info.Branch)
+		return "WIP committed and pushed on " + info.Branch, false
+	case killWIPStash:
+		msg := fmt.Sprintf("gt polecat kill: %s/%s WIP", info.Rig, info.Name)
+		if err := g.Stash(msg); err != nil {
+			fmt.Printf("  %s WIP stash failed: %v\n", style.Warning.Render("⚠"), err)
+			return "WIP stash failed; worktree kept at " + info.ClonePath, true
+		}
+		fmt.Printf("  %s stashed WIP (%q)\n", style.Success.Render("✓"), msg)
+		return "WIP stashed as " + msg, false
+	default:
+		fmt.Printf("  %s discarding uncommitted changes\n", style.Dim.Render("○"))
+		return "WIP discarded", false
+	}
+}
+
+// releaseKilledPolecatBead unhooks the polecat's bead, optionally requeues it,
+// and records what happened as a bead comment.
+func releaseKilledPolecatBead(townRoot string, p polecatTarget, beadID, action, wipNote string) {
+	bd := beads.New(beads.ResolveHookDir(townRoot, beadID, p.r.Path))
+	issue, err := bd.Show(beadID)
+	if err != nil {
+		style.PrintWarning("could not load hooked bead %s: %v", beadID, err)
+		return
+	}
+	if issue.Status == "closed" {
+		fmt.Printf("  %s bead %s already closed\n", style.Dim.Render("○"), beadID)
+		return
+	}
+
+	open := "open"
+	unassigned := ""
+	if err := bd.Update(beadID, beads.UpdateOptions{Status: &open, Assignee: &unassigned}); err != nil {
+		style.PrintWarning("could not unhook %s: %v", beadID, err)
+		return
+	}
+	_ = events.LogFeed(events.TypeUnhook, p.rigName+"/polecats/"+p.polecatName, events.UnhookPayload(beadID))
+	fmt.Printf("  %s unhooked %s\n", style.Success.Render("✓"), beadID)
+
+	if action == "requeued" {
+		opts := ScheduleOptions{
+			Formula:  resolveFormula("", false, townRoot, p.rigName),
+			NoConvoy: true, // Already tracked by its original convoy, if any
+		}
+		if err := scheduleBead(beadID, p.rigName, opts); err != nil {
+			style.PrintWarning("could not requeue %s (left open): %v", beadID, err)
+			action = "released"
+		}
+	}
+
+	parts := []string{fmt.Sprintf("Polecat %s/%s killed by %s; bead %s.", p.rigName, p.polecatName, detectActor(), action)}
+	if polecatKillReason != "" {
+		parts = append(parts, "Reason: "+polecatKillReason+".")
+	}
+	if wipNote != "" {
+		parts = append(parts, wipNote+".")
+	}
+	if _, err := bd.Run("comments", "add", beadID, strings.Join(parts, " ")); err != nil {
+		fmt.Printf("  %s could not comment on %s: %v\n", style.Dim.Render("○"), beadID, err)
+	}
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestPolecatKillWIPPolicy(t *testing.T) {
+	tests := []struct {
+		wip              string
+		requeue, abandon bool
+		want             string
+		wantErr          bool
+	}{
+		{"", false, false, killWIPCommit, false},
+		{"", true, false, killWIPCommit, false},
+		{"", false, true, killWIPDiscard, false},
+		{"stash", false, true, killWIPStash, false},
+		{"commit", true, false, killWIPCommit, false},
+		{"", true, true, "", true},
+		{"shelve", false, false, "", true},
+	}
+	for _, tt := range tests {
+		got, err := polecatKillWIPPolicy(tt.wip, tt.requeue, tt.abandon)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("polecatKillWIPPolicy(%q, %v, %v) = %q, %v; want %q (err=%v)",
+				tt.wip, tt.requeue, tt.abandon, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if got := polecatKillAction(true, false); got != "requeued" {
+		t.Errorf("polecatKillAction(requeue) = %q", got)
+	}
+	if got := polecatKillAction(false, false); got != "released" {
+		t.Errorf("polecatKillAction(default) = %q", got)
+	}
+}
+
+func initKillTestRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-b", "polecat/toast"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test"},
+		{"commit", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "wip.txt"), []byte("half done"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestSaveKilledPolecatWIP_Commit(t *testing.T) {
+	dir := initKillTestRepo(t)
+	info := &polecat.Polecat{Name: "toast", Rig: "gastown", ClonePath: dir, Branch: "polecat/toast"}
+
+	note, keep := saveKilledPolecatWIP(info, killWIPCommit)
+	if !strings.Contains(note, "not pushed") || !strings.Contains(note, "kept") {
+		t.Errorf("note = %q, want commit without push (no origin) and kept worktree", note)
+	}
+	if !keep {
+		t.Error("unpushed WIP commit must keep the worktree and branch")
+	}
+	out, err := exec.Command("git", "-C", dir, "log", "-1", "--format=%s").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(out)); !strings.HasPrefix(got, "WIP:") {
+		t.Errorf("last commit = %q, want WIP commit", got)
+	}
+}
+
+func TestSaveKilledPolecatWIP_StashAndClean(t *testing.T) {
+	dir := initKillTestRepo(t)
+	info := &polecat.Polecat{Name: "toast", Rig: "gastown", ClonePath: dir, Branch: "polecat/toast"}
+
+	if note, keep := saveKilledPolecatWIP(info, killWIPStash); !strings.HasPrefix(note, "WIP stashed") || keep {
+		t.Errorf("note = %q, keep = %v", note, keep)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "wip.txt")); !os.IsNotExist(err) {
+		t.Error("stash should remove the untracked WIP file from the worktree")
+	}
+	if note, keep := saveKilledPolecatWIP(info, killWIPCommit); note != "" || keep {
+		t.Errorf("clean worktree should produce no note, got %q (keep %v)", note, keep)
+	}
+}
+
+func TestResolveBulkPolecatTargets_FlagErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		all  bool
+		rig  string
+	}{
+		{"no targets", nil, false, ""},
+		{"rig without all", []string{"gastown/toast"}, false, "gastown"},
+		{"rig and positional rigs", []string{"beads"}, true, "gastown"},
+	}
+	for _, tt := range tests {
+		if _, err := resolveBulkPolecatTargets(tt.args, tt.all, tt.rig); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestConfirmPolecatBulkAction(t *testing.T) {
+	oldIsTTY, oldPrompt := isStdinTerminal, promptBulkAction
+	t.Cleanup(func() { isStdinTerminal, promptBulkAction = oldIsTTY, oldPrompt })
+
+	one := []polecatTarget{{rigName: "gastown", polecatName: "toast"}}
+	many := append(one, polecatTarget{rigName: "gastown", polecatName: "nux"})
+
+	prompted := false
+	isStdinTerminal = func() bool { return true }
+	promptBulkAction = func(string) bool { prompted = true; return false }
+
+	if ok, err := confirmPolecatBulkAction("kill", one, false); !ok || err != nil || prompted {
+		t.Error("a single target should proceed without prompting")
+	}
+	if ok, err := confirmPolecatBulkAction("kill", many, true); !ok || err != nil || prompted {
+		t.Error("--yes should proceed without prompting")
+	}
+	if ok, err := confirmPolecatBulkAction("kill", many, false); ok || err != nil || !prompted {
+		t.Error("multiple targets should prompt, and a declined prompt should cancel")
+	}
+
+	isStdinTerminal = func() bool { return false }
+	if ok, err := confirmPolecatBulkAction("pause", many, false); ok || err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("without a terminal, bulk actions should fail asking for --yes, got %v, %v", ok, err)
+	}
+}
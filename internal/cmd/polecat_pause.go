@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// polecatPausedEnv is set in a polecat's tmux session while it is paused by
+// gt polecat pause. Its value records whether the polecat still holds a
+// capacity slot (pauseSlotHeld) or gave it up (pauseSlotReleased).
+const polecatPausedEnv = "GT_PAUSED"
+
+const (
+	pauseSlotHeld     = "held"
+	pauseSlotReleased = "released"
+)
+
+// polecatParkMessage is nudged into a parked polecat after its turn is interrupted.
+const polecatParkMessage = "You are PAUSED by the overseer. Stop working and do not touch the " +
+	"repository: a human may be editing your branch. Wait for a resume message before continuing."
+
+// polecatResumeMessage is nudged into a polecat when its pause is lifted.
+const polecatResumeMessage = "Pause lifted. Your branch may have changed while you were paused: " +
+	"check git status and git log, then resume work on your hooked bead."
+
+var (
+	polecatPauseAll         bool
+	polecatPauseRig         string
+	polecatPauseYes         bool
+	polecatPauseFreeze      bool
+	polecatPauseReleaseSlot bool
+	polecatResumeAll        bool
+	polecatResumeRig        string
+)
+
+var polecatPauseCmd = &cobra.Command{
+	Use:   "pause <rig>/<polecat>... | --all [--rig <rig>]",
+	Short: "Suspend polecats without killing them",
+	Long: `Suspend polecats while keeping their session, worktree, and hooked work.
+
+Pausing a polecat:
+  1. Interrupts its current turn
+  2. Nudges it to stand by (or, with --freeze, sends SIGTSTP to its session)
+  3. Marks its agent bead agent_state=paused, so it is not cleaned up as stale
+
+Parking (the default) leaves the agent responsive, so a human can take over
+the branch in its worktree and later hand it back. --freeze stops the
+session's processes outright, like gt estop scoped to polecats — use it for
+agents that ignore the park prompt.
+
+Paused polecats still count toward scheduler capacity. Use --release-slot to
+let the scheduler dispatch a replacement while this one is paused.
+
+With --all, every polecat in the town is paused (or every polecat in one rig
+with --rig). Acting on more than one polecat asks for confirmation unless
+--yes is given.
+
+To continue: gt polecat resume
+
+Examples:
+  gt polecat pause greenplace/Toast
+  gt polecat pause greenplace/Toast --release-slot
+  gt polecat pause --all --rig greenplace --freeze
+  gt polecat pause --all --yes`,
+	RunE: runPolecatPause,
+}
+
+var polecatResumeCmd = &cobra.Command{
+	Use:   "resume <rig>/<polecat>... | --all [--rig <rig>]",
+	Short: "Resume polecats paused by gt polecat pause",
+	Long: `Resume polecats paused by gt polecat pause.
+
+Thaws frozen sessions, restores the agent state, and nudges each polecat to
+re-check its branch before continuing. Polecats that are not paused are
+skipped.
+
+Examples:
+  gt polecat resume greenplace/Toast
+  gt polecat resume --all --rig greenplace`,
+	RunE: runPolecatResume,
+}
+
+func init() {
+	polecatPauseCmd.Flags().BoolVar(&polecatPauseAll, "all", false, "Pause all polecats (in every rig, or in --rig)")
+	polecatPauseCmd.Flags().StringVar(&polecatPauseRig, "rig", "", "With --all, only pause polecats in this rig")
+	polecatPauseCmd.Flags().BoolVarP(&polecatPauseYes, "yes", "y", false, "Skip confirmation prompt")
+	polecatPauseCmd.Flags().BoolVar(&polecatPauseFreeze, "freeze", false, "Freeze the session's processes (SIGTSTP) instead of parking the agent")
+	polecatPauseCmd.Flags().BoolVar(&polecatPauseReleaseSlot, "release-slot", false, "Don't count paused polecats toward scheduler capacity")
+	polecatResumeCmd.Flags().BoolVar(&polecatResumeAll, "all", false, "Resume all paused polecats (in every rig, or in --rig)")
+	polecatResumeCmd.Flags().StringVar(&polecatResumeRig, "rig", "", "With --all, only resume polecats in this rig")
+	polecatCmd.AddCommand(polecatPauseCmd)
+	polecatCmd.AddCommand(polecatResumeCmd)
+}
+
+// isPolecatPaused reports whether a polecat session is paused by gt polecat pause.
+func isPolecatPaused(t *tmux.Tmux, sessionName string) bool {
+	v, err := t.GetEnvironment(sessionName, polecatPausedEnv)
+	return err == nil && v != ""
+}
+
+func runPolecatPause(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	targets, err := resolveBulkPolecatTargets(args, polecatPauseAll, polecatPauseRig)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		fmt.Println("No polecats to pause.")
+		return nil
+	}
+	if ok, err := confirmPolecatBulkAction("pause", targets, polecatPauseYes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Pause canceled.")
+		return nil
+	}
+
+	slot := pauseSlotHeld
+	if polecatPauseReleaseSlot {
+		slot = pauseSlotReleased
+	}
+
+	t := tmux.NewTmux()
+	paused := 0
+	for _, p := range targets {
+		address := p.rigName + "/" + p.polecatName
+		if err := pausePolecat(t, p, polecatPauseFreeze, slot); err != nil {
+			fmt.Printf("  %s %s (%v)\n", style.Dim.Render("○"), address, err)
+			continue
+		}
+		fmt.Printf("  %s %s\n", style.Warning.Render("⏸"), address)
+		paused++
+	}
+
+	fmt.Printf("\n%s Paused %d polecat(s). Resume with: %s\n",
+		style.SuccessPrefix, paused, style.Bold.Render("gt polecat resume"))
+	return nil
+}
+
+func runPolecatResume(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	targets, err := resolveBulkPolecatTargets(args, polecatResumeAll, polecatResumeRig)
+	if err != nil {
+		return err
+	}
+
+	t := tmux.NewTmux()
+	resumed := 0
+	for _, p := range targets {
+		sessionName := polecat.NewSessionManager(t, p.r).SessionName(p.polecatName)
+		if !isPolecatPaused(t, sessionName) {
+			continue
+		}
+		address := p.rigName + "/" + p.polecatName
+		if err := resumePolecat(t, p, polecatResumeMessage); err != nil {
+			fmt.Printf("  %s %s: %v\n", style.Warning.Render("!"), address, err)
+			continue
+		}
+		fmt.Printf("  %s %s\n", style.Success.Render("▶"), address)
+		resumed++
+	}
+
+	if resumed == 0 {
+		fmt.Println("No paused polecats.")
+		return nil
+	}
+	fmt.Printf("\n%s Resumed %d polecat(s).\n", style.SuccessPrefix, resumed)
+	return nil
+}
+
+// errPolecatNotRunning is returned by pausePolecat for polecats without a session.
+var errPolecatNotRunning = errors.New("no session")
+
+// pausePolecat interrupts a polecat and parks (or freezes) it, marking it
+// paused in both its session and its agent bead.
+func pausePolecat(t *tmux.Tmux, p polecatTarget, freeze bool, slot string) error {
+	sessMgr := polecat.NewSessionManager(t, p.r)
+	sessionName := sessMgr.SessionName(p.polecatName)
+
+	if running, _ := sessMgr.IsRunning(p.polecatName); !running {
+		return errPolecatNotRunning
+	}
+	if isPolecatPaused(t, sessionName) {
+		return errors.New("already paused")
+	}
+
+	_ = t.SendKeysRaw(sessionName, "Escape") // best-effort interrupt of the current turn
+	if freeze {
+		if err := signalSessionGroup(t, sessionName, sigFreeze); err != nil {
+			return err
+		}
+	} else if err := t.NudgeSession(sessionName, polecatParkMessage); err != nil {
+		return err
+	}
+
+	address := p.rigName + "/" + p.polecatName
+	if err := t.SetEnvironment(sessionName, polecatPausedEnv, slot); err != nil {
+		style.PrintWarning("could not mark %s paused: %v", address, err)
+	}
+	if err := p.mgr.SetAgentState(p.polecatName, string(beads.AgentStatePaused)); err != nil {
+		style.PrintWarning("could not set agent_state for %s: %v", address, err)
+	}
+	return nil
+}
+
+// resumePolecat lifts a pause, restores the agent state, and nudges the
+// polecat with message.
+func resumePolecat(t *tmux.Tmux, p polecatTarget, message string) error {
+	sessionName := polecat.NewSessionManager(t, p.r).SessionName(p.polecatName)
+	if err := resumePolecatSession(t, sessionName); err != nil {
+		return err
+	}
+
+	state := beads.AgentStateIdle
+	if info, err := p.mgr.Get(p.polecatName); err == nil && info.Issue != "" {
+		state = beads.AgentStateWorking
+	}
+	if err := p.mgr.SetAgentState(p.polecatName, string(state)); err != nil {
+		style.PrintWarning("could not restore agent_state for %s/%s: %v", p.rigName, p.polecatName, err)
+	}
+	_ = t.NudgeSession(sessionName, message)
+	return nil
+}
+
+// resumePolecatSession thaws a paused polecat session and clears its marker.
+// SIGCONT is harmless for parked (not frozen) sessions, so it is always sent.
+func resumePolecatSession(t *tmux.Tmux, sessionName string) error {
+	if err := signalSessionGroup(t, sessionName, sigThaw); err != nil {
+		return err
+	}
+	return t.SetEnvironment(sessionName, polecatPausedEnv, "")
+}
@@ -33,8 +33,15 @@ type SpawnedPolecatInfo struct {
 	Branch      string // Git branch name (for cleanup on rollback)
 
 	// Internal fields for deferred session start
-	account string
-	agent   string
+	account       string
+	agent         string
+	correlationID string
+	hookBead      string
+
+	// Formula and FormulaVars are what the polecat was slung with, kept in
+	// its spawn record. Set them before StartSession.
+	Formula     string
+	FormulaVars []string
 }
 
 // AgentID returns the agent identifier (e.g., "gastown/polecats/Toast")
@@ -55,6 +62,12 @@ type SlingSpawnOptions struct {
 	HookBead   string // Bead ID to set as hook_bead at spawn time (atomic assignment)
 	Agent      string // Agent override for this spawn (e.g., "gemini", "codex", "claude-haiku")
 	BaseBranch string // Override base branch for polecat worktree (e.g., "develop", "release/v2")
+
+	// CorrelationID tags the spawn event and the polecat session's events.
+	CorrelationID string
+
+	// Sling records the gt sling options in the polecat's spawn record.
+	Sling *polecat.SlingOptions
 }
 
 // SpawnPolecatForSling creates a fresh polecat and optionally starts its session.
@@ -149,8 +162,14 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 
 	// Persistent polecat model (gt-4ac): try to reuse an idle polecat first.
 	// Idle polecats have completed their work but kept their sandbox (worktree).
-	// Reusing avoids the overhead of creating a new worktree.
-	idlePolecat, findErr := polecatMgr.FindIdlePolecat()
+	// Reusing avoids the overhead of creating a new worktree. Rigs that name
+	// polecats after their bead skip reuse: an idle polecat carries another
+	// bead's name.
+	var idlePolecat *polecat.Polecat
+	var findErr error
+	if opts.HookBead == "" || !polecatMgr.NamesFromBead() {
+		idlePolecat, findErr = polecatMgr.FindIdlePolecat()
+	}
 	if findErr == nil && idlePolecat != nil {
 		polecatName := idlePolecat.Name
 		fmt.Printf("Reusing idle polecat: %s\n", polecatName)
@@ -212,24 +231,28 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 			sessionName := polecatSessMgr.SessionName(polecatName)
 
 			fmt.Printf("%s Polecat %s reused (idle → working, session start deferred)\n", style.Bold.Render("✓"), polecatName)
-			_ = events.LogFeed(events.TypeSpawn, "gt", events.SpawnPayload(rigName, polecatName))
+			_ = events.LogFeed(events.TypeSpawn, "gt", events.WithCorrelation(events.SpawnPayload(rigName, polecatName), opts.CorrelationID))
 
 			effectiveBranch := strings.TrimPrefix(baseBranch, "origin/")
 			if effectiveBranch == "" {
 				effectiveBranch = r.DefaultBranch()
 			}
 
-			return &SpawnedPolecatInfo{
-				RigName:     rigName,
-				PolecatName: polecatName,
-				ClonePath:   polecatObj.ClonePath,
-				SessionName: sessionName,
-				Pane:        "",
-				BaseBranch:  effectiveBranch,
-				Branch:      polecatObj.Branch,
-				account:     opts.Account,
-				agent:       opts.Agent,
-			}, nil
+			info := &SpawnedPolecatInfo{
+				RigName:       rigName,
+				PolecatName:   polecatName,
+				ClonePath:     polecatObj.ClonePath,
+				SessionName:   sessionName,
+				Pane:          "",
+				BaseBranch:    effectiveBranch,
+				Branch:        polecatObj.Branch,
+				account:       opts.Account,
+				agent:         opts.Agent,
+				correlationID: opts.CorrelationID,
+				hookBead:      opts.HookBead,
+			}
+			recordSlingSpawn(r.Path, info, opts)
+			return info, nil
 		}
 	}
 
@@ -295,7 +318,7 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 	fmt.Printf("%s Polecat %s spawned (session start deferred)\n", style.Bold.Render("✓"), polecatName)
 
 	// Log spawn event to activity feed
-	_ = events.LogFeed(events.TypeSpawn, "gt", events.SpawnPayload(rigName, polecatName))
+	_ = events.LogFeed(events.TypeSpawn, "gt", events.WithCorrelation(events.SpawnPayload(rigName, polecatName), opts.CorrelationID))
 
 	// Compute effective base branch (strip origin/ prefix since formula prepends it)
 	effectiveBranch := strings.TrimPrefix(baseBranch, "origin/")
@@ -303,17 +326,48 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 		effectiveBranch = r.DefaultBranch()
 	}
 
-	return &SpawnedPolecatInfo{
-		RigName:     rigName,
-		PolecatName: polecatName,
-		ClonePath:   polecatObj.ClonePath,
-		SessionName: sessionName,
-		Pane:        "", // Empty until StartSession is called
-		BaseBranch:  effectiveBranch,
-		Branch:      polecatObj.Branch,
-		account:     opts.Account,
-		agent:       opts.Agent,
-	}, nil
+	info := &SpawnedPolecatInfo{
+		RigName:       rigName,
+		PolecatName:   polecatName,
+		ClonePath:     polecatObj.ClonePath,
+		SessionName:   sessionName,
+		Pane:          "", // Empty until StartSession is called
+		BaseBranch:    effectiveBranch,
+		Branch:        polecatObj.Branch,
+		account:       opts.Account,
+		agent:         opts.Agent,
+		correlationID: opts.CorrelationID,
+		hookBead:      opts.HookBead,
+	}
+	recordSlingSpawn(r.Path, info, opts)
+	return info, nil
+}
+
+// recordSlingSpawn writes the spawn record of a polecat gt sling just
+// spawned, replacing the record of its previous work. The session half is
+// filled in when the session starts. Best-effort: a polecat without a
+// record still works.
+func recordSlingSpawn(rigPath string, info *SpawnedPolecatInfo, opts SlingSpawnOptions) {
+	rec := &polecat.SpawnRecord{
+		Rig:           info.RigName,
+		Polecat:       info.PolecatName,
+		Session:       info.SessionName,
+		SlungAt:       time.Now().UTC(),
+		AgentOverride: opts.Agent,
+		Account:       opts.Account,
+		Bead:          opts.HookBead,
+		Sling:         opts.Sling,
+		BaseBranch:    info.BaseBranch,
+		Branch:        info.Branch,
+		Worktree:      info.ClonePath,
+		CorrelationID: opts.CorrelationID,
+	}
+	if sha, err := git.NewGit(info.ClonePath).Rev("HEAD"); err == nil {
+		rec.BaseSHA = sha
+	}
+	if err := polecat.WriteSpawnRecord(rigPath, rec); err != nil {
+		style.PrintWarning("could not write spawn record for %s: %v", info.PolecatName, err)
+	}
 }
 
 // StartSession starts the tmux session for a spawned polecat.
@@ -346,10 +400,13 @@ func (s *SpawnedPolecatInfo) StartSession() (string, error) {
 
 	// Resolve account
 	accountsPath := constants.MayorAccountsPath(townRoot)
-	claudeConfigDir, _, err := config.ResolveAccountConfigDir(accountsPath, s.account)
+	claudeConfigDir, accountHandle, err := config.ResolveAccountConfigDir(accountsPath, s.account)
 	if err != nil {
 		return "", fmt.Errorf("resolving account: %w", err)
 	}
+	if claudeConfigDir != "" {
+		prepareAccountConfigDir(accountHandle, claudeConfigDir)
+	}
 
 	// Start session
 	t := tmux.NewTmux()
@@ -358,7 +415,13 @@ func (s *SpawnedPolecatInfo) StartSession() (string, error) {
 	fmt.Printf("Starting session for %s/%s...\n", s.RigName, s.PolecatName)
 	startOpts := polecat.SessionStartOptions{
 		RuntimeConfigDir: claudeConfigDir,
+		Account:          accountHandle,
 		Agent:            s.agent,
+		CorrelationID:    s.correlationID,
+		Bead:             s.hookBead,
+		Formula:          s.Formula,
+		FormulaVars:      s.FormulaVars,
+		BaseBranch:       s.BaseBranch,
 	}
 	if err := polecatSessMgr.Start(s.PolecatName, startOpts); err != nil {
 		return "", fmt.Errorf("starting session: %w", err)
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var polecatWorktreePoolStatusJSON bool
+
+var polecatWorktreePoolCmd = &cobra.Command{
+	Use:   "worktree-pool",
+	Short: "Manage pre-warmed polecat worktrees",
+	Long: `Manage each rig's pool of pre-warmed worktrees.
+
+Creating a worktree dominates spawn latency on big repos. With
+worktree_pool_size set in a rig's config.json, spawns lease a ready
+worktree from <rig>/.worktree-pool/ (reset to the start point, new
+branch checked out) instead of running git worktree add, and removed
+polecats return their worktree to the pool while it has room. Ignored
+build caches survive the round trip.
+
+The daemon's worktree_pool patrol runs 'fill' during idle time. Repos with
+submodules fall back to fresh worktrees (git cannot move them).
+
+Examples:
+  gt polecat worktree-pool status
+  gt polecat worktree-pool fill gastown`,
+	RunE: requireSubcommand,
+}
+
+var polecatWorktreePoolStatusCmd = &cobra.Command{
+	Use:   "status [rig]",
+	Short: "Show ready and configured pool sizes",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runPolecatWorktreePoolStatus,
+}
+
+var polecatWorktreePoolFillCmd = &cobra.Command{
+	Use:   "fill [rig]",
+	Short: "Bring pools to their configured size",
+	Long: `Check out missing pool worktrees and remove extras.
+
+Without a rig, fills every rig that has worktree_pool_size set (and empties
+pools of rigs where it was turned off).`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPolecatWorktreePoolFill,
+}
+
+func init() {
+	polecatWorktreePoolStatusCmd.Flags().BoolVar(&polecatWorktreePoolStatusJSON, "json", false, "Output as JSON")
+	polecatWorktreePoolCmd.AddCommand(polecatWorktreePoolStatusCmd)
+	polecatWorktreePoolCmd.AddCommand(polecatWorktreePoolFillCmd)
+	polecatCmd.AddCommand(polecatWorktreePoolCmd)
+}
+
+// worktreePoolRigs returns the named rig, or all rigs when args is empty.
+func worktreePoolRigs(args []string) ([]*rig.Rig, error) {
+	if len(args) == 0 {
+		return getAllRigs()
+	}
+	_, r, err := getRig(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return []*rig.Rig{r}, nil
+}
+
+// WorktreePoolStatus is one rig's pool in status output.
+type WorktreePoolStatus struct {
+	Rig   string `json:"rig"`
+	Size  int    `json:"size"`
+	Ready int    `json:"ready"`
+}
+
+func runPolecatWorktreePoolStatus(cmd *cobra.Command, args []string) error {
+	rigs, err := worktreePoolRigs(args)
+	if err != nil {
+		return err
+	}
+
+	statuses := make([]WorktreePoolStatus, 0, len(rigs))
+	t := tmux.NewTmux()
+	for _, r := range rigs {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path), t)
+		statuses = append(statuses, WorktreePoolStatus{
+			Rig:   r.Name,
+			Size:  mgr.WorktreePoolSize(),
+			Ready: mgr.WorktreePoolReady(),
+		})
+	}
+
+	if polecatWorktreePoolStatusJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(statuses)
+	}
+
+	for _, s := range statuses {
+		if s.Size == 0 && s.Ready == 0 {
+			fmt.Printf("  %-20s %s\n", s.Rig, style.Dim.Render("disabled"))
+			continue
+		}
+		fmt.Printf("  %-20s %d/%d ready\n", s.Rig, s.Ready, s.Size)
+	}
+	return nil
+}
+
+func runPolecatWorktreePoolFill(cmd *cobra.Command, args []string) error {
+	rigs, err := worktreePoolRigs(args)
+	if err != nil {
+		return err
+	}
+
+	t := tmux.NewTmux()
+	var failed int
+	for _, r := range rigs {
+		mgr := polecat.NewManager(r, git.NewGit(r.Path), t)
+		if len(args) == 0 && mgr.WorktreePoolSize() == 0 && mgr.WorktreePoolReady() == 0 {
+			continue
+		}
+		added, removed, err := mgr.FillWorktreePool()
+		if err != nil {
+			fmt.Printf("%s %s: %v\n", style.Warning.Render("⚠"), r.Name, err)
+			failed++
+			continue
+		}
+		if added == 0 && removed == 0 {
+			continue
+		}
+		fmt.Printf("%s %s: +%d -%d (%d/%d ready)\n", style.Bold.Render("✓"), r.Name,
+			added, removed, mgr.WorktreePoolReady(), mgr.WorktreePoolSize())
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d rig(s) failed to fill", failed)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/cmdpolicy"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	policyRig          string
+	policyJSON         bool
+	policyDenialsSince string
+	policyDenialsPage  listFlags
+)
+
+var policyCmd = &cobra.Command{
+	Use:     "policy",
+	GroupID: GroupConfig,
+	Short:   "Inspect the command policy for agent Bash tool calls",
+	Long: `Inspect the town command policy and its audit trail.
+
+The policy (settings/command-policy.json) lists allow and deny patterns for
+the shell commands agents run, town-wide and per rig. It is enforced by the
+command-policy PreToolUse guard on every Bash tool call.
+
+  {
+    "version": 1,
+    "deny": [{"pattern": "curl *", "reason": "no network access"}],
+    "rigs": {
+      "webapp": {
+        "allow": [{"pattern": "curl localhost*"}]
+      },
+      "payments": {
+        "default": "deny",
+        "allow": [{"pattern": "go *"}, {"pattern": "git *"}, {"pattern": "gt *"}, {"pattern": "bd *"}]
+      }
+    }
+  }
+
+In patterns, * matches anything (including spaces) and the pattern must
+match the whole simple command. For each command, the most specific level
+decides: rig deny, rig allow, town deny, town allow, then the rig's default
+(falling back to the town's, then allow).`,
+	RunE: requireSubcommand,
+}
+
+var policyCheckCmd = &cobra.Command{
+	Use:   "check <command>",
+	Short: "Evaluate a command against the policy",
+	Long: `Evaluate a shell command as the guard would for an agent in --rig, and
+validate the policy file. Exits non-zero if the command would be denied.
+
+Examples:
+  gt policy check 'curl https://example.com'
+  gt policy check --rig payments 'go test ./... && npm publish'`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runPolicyCheck,
+}
+
+var policyDenialsCmd = &cobra.Command{
+	Use:   "denials",
+	Short: "List commands the policy denied",
+	Long: `List denied agent commands from the events log, newest first.
+
+Examples:
+  gt policy denials
+  gt policy denials --since 7d --rig payments
+  gt policy denials --json`,
+	RunE: runPolicyDenials,
+}
+
+func init() {
+	policyCheckCmd.Flags().StringVar(&policyRig, "rig", "", "Evaluate as an agent in this rig")
+	policyCheckCmd.Flags().BoolVar(&policyJSON, "json", false, "Output the decision as JSON")
+
+	policyDenialsCmd.Flags().StringVar(&policyRig, "rig", "", "Only show denials in this rig")
+	policyDenialsCmd.Flags().StringVar(&policyDenialsSince, "since", "24h", "Show denials since duration (e.g., 1h, 24h, 7d)")
+	policyDenialListing.register(policyDenialsCmd, &policyDenialsPage, 50)
+	policyDenialsCmd.Flags().BoolVar(&policyJSON, "json", false, "Output as JSON")
+
+	policyCmd.AddCommand(policyCheckCmd)
+	policyCmd.AddCommand(policyDenialsCmd)
+	rootCmd.AddCommand(policyCmd)
+}
+
+func runPolicyCheck(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	policy, err := cmdpolicy.Load(townRoot)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		fmt.Printf("%s No command policy (%s); all commands allowed\n", style.Dim.Render("○"), cmdpolicy.Path(townRoot))
+		return nil
+	}
+
+	d := policy.Evaluate(policyRig, strings.Join(args, " "))
+	if policyJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(d); err != nil {
+			return err
+		}
+	} else {
+		rule := d.Rule
+		if rule == "" {
+			rule = "default"
+		}
+		if d.Allowed {
+			fmt.Printf("%s Allowed (%s, %s)\n", style.SuccessPrefix, d.Scope, rule)
+		} else {
+			fmt.Printf("%s Denied: %s\n", style.Error.Render("✗"), d.Command)
+			fmt.Printf("  Rule:  %s (%s)\n", rule, d.Scope)
+			if d.Reason != "" {
+				fmt.Printf("  Reason: %s\n", d.Reason)
+			}
+		}
+	}
+	if !d.Allowed {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// policyDenial is one denied command, for gt policy denials output.
+type policyDenial struct {
+	Time    string `json:"time"`
+	Actor   string `json:"actor"`
+	Rig     string `json:"rig,omitempty"`
+	Command string `json:"command"`
+	Denied  string `json:"denied"`
+	Rule    string `json:"rule,omitempty"`
+	Scope   string `json:"scope"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func runPolicyDenials(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	since, err := parseDuration(policyDenialsSince)
+	if err != nil {
+		return fmt.Errorf("invalid --since duration: %w", err)
+	}
+
+	var denials []policyDenial
+	err = events.ReadRange(townRoot, time.Now().Add(-since), time.Time{}, func(e events.Event) bool {
+		if e.Type != events.TypeCommandDenied {
+			return true
+		}
+		str := func(k string) string { s, _ := e.Payload[k].(string); return s }
+		if policyRig != "" && str("rig") != policyRig {
+			return true
+		}
+		denials = append(denials, policyDenial{
+			Time:    e.Timestamp,
+			Actor:   e.Actor,
+			Rig:     str("rig"),
+			Command: str("command"),
+			Denied:  str("denied"),
+			Rule:    str("rule"),
+			Scope:   str("scope"),
+			Reason:  str("reason"),
+		})
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("reading events: %w", err)
+	}
+
+	// Newest first by default, then page.
+	page, err := policyDenialListing.apply(denials, policyDenialsPage)
+	if err != nil {
+		return err
+	}
+	denials = page.Items
+
+	if policyJSON {
+		return printPageJSON(page, denials)
+	}
+	if page.Total == 0 {
+		fmt.Printf("%s No denied commands in the last %s\n", style.Dim.Render("○"), policyDenialsSince)
+		return nil
+	}
+	for _, d := range denials {
+		rule := d.Rule
+		if rule == "" {
+			rule = "default"
+		}
+		fmt.Printf("%s %s %s\n", style.Dim.Render(d.Time), style.Bold.Render(d.Actor), truncateStr(d.Command, 80))
+		fmt.Printf("    denied %q by %s (%s)", d.Denied, rule, d.Scope)
+		if d.Reason != "" {
+			fmt.Printf(": %s", d.Reason)
+		}
+		fmt.Println()
+	}
+	printPageFooter(page)
+	return nil
+}
+
+// policyDenialListing sorts gt policy denials, newest first.
+var policyDenialListing = listing[policyDenial]{
+	fields: []listField[policyDenial]{
+		{"time", func(d policyDenial) any { return d.Time }},
+		{"actor", func(d policyDenial) any { return d.Actor }},
+		{"rig", func(d policyDenial) any { return d.Rig }},
+	},
+	defaultSort: "-time",
+	// Denials have no single unique key; this one is stable across runs.
+	id: func(d policyDenial) string {
+		return strings.Join([]string{d.Time, d.Actor, d.Command, d.Denied}, "\x00")
+	},
+}
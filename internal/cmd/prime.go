@@ -240,6 +240,11 @@ func runPrimeCompactResume(ctx RoleContext) {
 	// Session metadata for seance
 	outputSessionMetadata(ctx)
 
+	// Restore what the PreCompact hook saved (gt tap compact-snapshot).
+	if primeHookSource == "compact" {
+		outputCompactSnapshot(ctx)
+	}
+
 	fmt.Println("\n---")
 	fmt.Println()
 	fmt.Println("**Continue your current task.** If you've lost context, run `gt prime` for full reload.")
@@ -511,7 +516,7 @@ func runPrimeExternalTools(cwd string) {
 // runBdPrime runs `bd prime` and outputs the result.
 // This provides beads workflow context to the agent.
 func runBdPrime(workDir string) {
-	cmd := exec.Command("bd", "prime")
+	cmd := beads.Command("prime")
 	cmd.Dir = workDir
 	cmd.Env = os.Environ()
 
@@ -954,7 +959,7 @@ func outputRalphLoopDirective(ctx RoleContext, attachment *beads.AttachmentField
 // outputBeadPreview runs `bd show` and displays a truncated preview of the bead.
 func outputBeadPreview(hookedBead *beads.Issue) {
 	fmt.Println("**Bead details:**")
-	cmd := exec.Command("bd", "show", hookedBead.ID)
+	cmd := beads.Command("show", hookedBead.ID)
 	cmd.Env = os.Environ()
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -1213,7 +1218,7 @@ func setTmuxWorkContext(workRig, workBead, workMol string) {
 // This is called on Mayor startup to surface issues needing human attention.
 func checkPendingEscalations(ctx RoleContext) {
 	// Query for open escalations using bd list with tag filter
-	cmd := exec.Command("bd", "list", "--status=open", "--tag=escalation", "--json")
+	cmd := beads.Command("list", "--status=open", "--tag=escalation", "--json")
 	cmd.Dir = ctx.WorkDir
 	cmd.Env = os.Environ()
 
@@ -19,7 +19,7 @@ import (
 )
 
 // hookInput represents the JSON input from LLM runtime hooks.
-// Claude Code sends this on stdin for SessionStart hooks.
+// Claude Code sends this on stdin for SessionStart and Stop hooks.
 type hookInput struct {
 	SessionID      string `json:"session_id"`
 	TranscriptPath string `json:"transcript_path"`
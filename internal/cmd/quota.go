@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/quota"
@@ -35,6 +36,7 @@ var (
 
 var quotaCmd = &cobra.Command{
 	Use:     "quota",
+	Aliases: []string{"limits"},
 	GroupID: GroupServices,
 	Short:   "Manage account quota rotation",
 	RunE:    requireSubcommand,
@@ -46,8 +48,15 @@ and rotate them to available accounts from the pool.
 Commands:
   gt quota status            Show account quota status
   gt quota scan              Detect rate-limited sessions
+  gt quota record            Record a limit hit by this session (Stop hook)
   gt quota rotate            Swap blocked sessions to available accounts
-  gt quota clear             Mark account(s) as available again`,
+  gt quota clear             Mark account(s) as available again
+  gt quota predict           Estimate when the next limit will hit
+  gt quota snooze --for 30m  Keep the town quiet, even after limits reset
+  gt quota export            Export limit state for fleet monitoring (--push <url>)
+  gt quota calendar          Publish limit resets as an iCalendar feed
+
+Also available as 'gt limits'.`,
 }
 
 var quotaStatusCmd = &cobra.Command{
@@ -73,6 +82,8 @@ type QuotaStatusItem struct {
 	ResetsAt  string `json:"resets_at,omitempty"`
 	LastUsed  string `json:"last_used,omitempty"`
 	IsDefault bool   `json:"is_default"`
+
+	Windows map[string]config.QuotaWindow `json:"windows,omitempty"`
 }
 
 func runQuotaStatus(cmd *cobra.Command, args []string) error {
@@ -88,29 +99,28 @@ func runQuotaStatus(cmd *cobra.Command, args []string) error {
 		fmt.Println("No accounts configured.")
 		fmt.Println("\nTo add an account:")
 		fmt.Println("  gt account add <handle>")
+		printProviderLimits(townRoot)
 		return nil
 	}
 
 	if len(acctCfg.Accounts) == 0 {
 		fmt.Println("No accounts configured.")
+		printProviderLimits(townRoot)
 		return nil
 	}
 
-	// Load quota state
+	// Load quota state, tracking new accounts and auto-clearing accounts
+	// whose reset time has passed in one locked update.
 	mgr := quota.NewManager(townRoot)
-	state, err := mgr.Load()
+	state, err := mgr.Update(func(state *config.QuotaState) (bool, error) {
+		added := mgr.EnsureAccountsTracked(state, acctCfg.Accounts)
+		return mgr.ClearExpired(state) > 0 || added > 0, nil
+	})
 	if err != nil {
-		return fmt.Errorf("loading quota state: %w", err)
-	}
-
-	// Ensure all accounts are tracked
-	mgr.EnsureAccountsTracked(state, acctCfg.Accounts)
-
-	// Auto-clear accounts whose reset time has passed
-	if cleared := mgr.ClearExpired(state); cleared > 0 {
-		if err := mgr.Save(state); err != nil {
-			style.PrintWarning("could not persist expired account clearance: %v", err)
+		if state == nil {
+			return fmt.Errorf("loading quota state: %w", err)
 		}
+		style.PrintWarning("could not persist expired account clearance: %v", err)
 	}
 
 	if quotaJSON {
@@ -136,6 +146,7 @@ func printQuotaStatusJSON(acctCfg *config.AccountsConfig, state *config.QuotaSta
 			ResetsAt:  qs.ResetsAt,
 			LastUsed:  qs.LastUsed,
 			IsDefault: handle == acctCfg.Default,
+			Windows:   qs.Windows,
 		})
 	}
 	enc := json.NewEncoder(os.Stdout)
@@ -150,6 +161,12 @@ func printQuotaStatusText(acctCfg *config.AccountsConfig, state *config.QuotaSta
 	fmt.Println(style.Bold.Render("Account Quota Status"))
 	fmt.Println()
 
+	now := time.Now()
+	if until, ok := quota.SnoozedUntil(state, now); ok {
+		fmt.Printf(" %s Snoozed %s\n", style.Warning.Render("⚠"), snoozeLabel(until, state.Snooze.Reason, now))
+		fmt.Printf("   %s\n\n", style.Dim.Render("Limit wakes and scheduler dispatch are paused (gt limits snooze --clear to lift)"))
+	}
+
 	for _, handle := range slices.Sorted(maps.Keys(acctCfg.Accounts)) {
 		acct := acctCfg.Accounts[handle]
 		qs := state.Accounts[handle]
@@ -173,8 +190,8 @@ func printQuotaStatusText(acctCfg *config.AccountsConfig, state *config.QuotaSta
 		case config.QuotaStatusLimited:
 			badge = style.Error.Render("limited")
 			limited++
-			if qs.ResetsAt != "" {
-				badge += style.Dim.Render(" (resets " + qs.ResetsAt + ")")
+			if resets := quotaResetsLabel(qs); resets != "" {
+				badge += style.Dim.Render(" (" + resets + ")")
 			}
 		case config.QuotaStatusCooldown:
 			badge = style.Warning.Render("cooldown")
@@ -191,6 +208,14 @@ func printQuotaStatusText(acctCfg *config.AccountsConfig, state *config.QuotaSta
 		fmt.Printf(" %s %-12s %s%s\n", marker, handle, badge, email)
 	}
 
+	for _, provider := range slices.Sorted(maps.Keys(state.Providers)) {
+		badge := style.Error.Render("limited")
+		if resets := quotaResetsLabel(state.Providers[provider]); resets != "" {
+			badge += style.Dim.Render(" (" + resets + ")")
+		}
+		fmt.Printf("   %-12s %s%s\n", quota.ProviderKeyPrefix+provider, badge, style.Dim.Render(" sessions without an account"))
+	}
+
 	fmt.Println()
 	fmt.Printf(" %s %d available, %d limited\n",
 		style.Info.Render("Summary:"), available, limited)
@@ -198,9 +223,47 @@ func printQuotaStatusText(acctCfg *config.AccountsConfig, state *config.QuotaSta
 	return nil
 }
 
+// printProviderLimits lists provider-wide limits in a town without
+// registered accounts, where they are the only limit state.
+func printProviderLimits(townRoot string) {
+	state, err := quota.NewManager(townRoot).Load()
+	if err != nil || len(state.Providers) == 0 {
+		return
+	}
+	fmt.Println()
+	for _, provider := range slices.Sorted(maps.Keys(state.Providers)) {
+		line := quota.ProviderKeyPrefix + provider + " limited"
+		if resets := quotaResetsLabel(state.Providers[provider]); resets != "" {
+			line += " (" + resets + ")"
+		}
+		fmt.Printf(" %s %s\n", style.Error.Render("✗"), line)
+	}
+}
+
+// quotaResetsLabel describes when a limited account's windows reset, e.g.
+// "resets 7pm" or "5h resets 7pm, weekly resets Oct 20, 9am".
+func quotaResetsLabel(qs config.AccountQuotaState) string {
+	if len(qs.Windows) <= 1 {
+		if qs.ResetsAt == "" {
+			return ""
+		}
+		return "resets " + qs.ResetsAt
+	}
+	var parts []string
+	for _, name := range slices.Sorted(maps.Keys(qs.Windows)) {
+		resets := qs.Windows[name].ResetsAt
+		if resets == "" {
+			resets = "unknown"
+		}
+		parts = append(parts, name+" resets "+resets)
+	}
+	return strings.Join(parts, ", ")
+}
+
 // Scan command flags
 var (
-	scanUpdate bool
+	scanUpdate  bool
+	scanExplain bool
 )
 
 var quotaScanCmd = &cobra.Command{
@@ -213,9 +276,18 @@ messages. Reports which sessions are blocked and which account they use.
 
 Use --update to automatically update quota state with detected limits.
 
+Each limit carries a confidence score. An API error entry in the session's
+transcript is definitive (1.00). A pane line that leads with the limit
+message scores 0.70; one that only mentions it mid-line (as code or prose
+about rate limits would) scores 0.40. Matches below 0.70 are listed as
+possible limits but not treated as limited: --update does not record them,
+and they never trigger rotation or wake. Use --explain to see where each
+limit was found and the entry or line that matched.
+
 Examples:
   gt quota scan              # Report rate-limited sessions
   gt quota scan --update     # Report and update quota state
+  gt quota scan --explain    # Show why each session looks limited
   gt quota scan --json       # JSON output`,
 	RunE: runQuotaScan,
 }
@@ -228,8 +300,10 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 
 	// Load accounts config
 	accountsPath := constants.MayorAccountsPath(townRoot)
-	acctCfg, loadErr := config.LoadAccountsConfig(accountsPath)
-	// acctCfg can be nil if no accounts configured — scan still works
+	acctCfg, err := config.LoadAccountsConfig(accountsPath)
+	if err != nil {
+		acctCfg = nil // No accounts configured — scan still works, limits are kept per provider
+	}
 
 	// Create scanner
 	t := ttmux.NewTmux()
@@ -237,6 +311,7 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("creating scanner: %w", err)
 	}
+	scanner.WithTownRoot(townRoot)
 
 	results, err := scanner.ScanAll()
 	if err != nil {
@@ -244,7 +319,7 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 	}
 
 	// Optionally update quota state
-	if scanUpdate && loadErr == nil && acctCfg != nil {
+	if scanUpdate {
 		if err := updateQuotaState(townRoot, results, acctCfg); err != nil {
 			return fmt.Errorf("updating quota state: %w", err)
 		}
@@ -253,33 +328,81 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 	if quotaJSON {
 		return printScanJSON(results)
 	}
-	return printScanText(results)
+	return printScanText(results, scanExplain)
 }
 
 func updateQuotaState(townRoot string, results []quota.ScanResult, acctCfg *config.AccountsConfig) error {
 	mgr := quota.NewManager(townRoot)
-	return mgr.WithLock(func() error {
-		state, err := mgr.Load()
-		if err != nil {
-			return err
+	now := time.Now()
+	var hits []quota.HistoryEntry
+	_, err := mgr.Update(func(state *config.QuotaState) (bool, error) {
+		if acctCfg != nil {
+			mgr.EnsureAccountsTracked(state, acctCfg.Accounts)
 		}
-		mgr.EnsureAccountsTracked(state, acctCfg.Accounts)
 
-		now := time.Now().UTC().Format(time.RFC3339)
 		for _, r := range results {
-			if r.RateLimited && r.AccountHandle != "" {
-				existing := state.Accounts[r.AccountHandle]
-				state.Accounts[r.AccountHandle] = config.AccountQuotaState{
-					Status:    config.QuotaStatusLimited,
-					LimitedAt: now,
-					ResetsAt:  r.ResetsAt,
-					LastUsed:  existing.LastUsed,
+			if !r.RateLimited {
+				continue
+			}
+			window := r.Window
+			if window == "" {
+				window = quota.Window5Hour
+			}
+			// Remembered so the daemon can wake the session after the reset,
+			// even when it has no pane to scan.
+			quota.RecordStall(state, r, now)
+			if r.AccountHandle == "" {
+				// No registered account: the limit belongs to the provider,
+				// so only that provider's dispatch waits for the reset.
+				if r.Provider != "" {
+					quota.RecordProviderLimit(state, r.Provider, window, r.ResetsAt, now)
 				}
+				continue
+			}
+			if quota.RecordLimit(state, r.AccountHandle, window, r.ResetsAt, now) {
+				hits = append(hits, quota.HistoryEntry{
+					At:       now.UTC(),
+					Account:  r.AccountHandle,
+					Window:   window,
+					ResetsAt: r.ResetsAt,
+				})
 			}
 		}
-
-		return mgr.SaveUnlocked(state)
+		return true, nil
 	})
+	if err != nil || len(hits) == 0 {
+		return err
+	}
+
+	// Record the spend that led up to each new limit hit so predictions
+	// stay calibrated after the costs log is digested, and how many polecats
+	// were running, under which cap, for scheduler.limit_cap.
+	usage := loadCostsLogUsage()
+	concurrent := countActivePolecats()
+	capped := 0
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil && settings.Scheduler != nil {
+		if c := schedulerLimitCap(townRoot, settings.Scheduler, now); c != nil {
+			capped = c.Cap
+		}
+	}
+	for i := range hits {
+		hits[i].UsedUSD = quota.Predict(hits[i].Window, nil, usage, now).UsedUSD
+		hits[i].Concurrent = concurrent
+		hits[i].Capped = capped
+	}
+	if err := mgr.AppendHistory(hits...); err != nil {
+		style.PrintWarning("could not record limit history: %v", err)
+	}
+	return nil
+}
+
+// printScanExplain prints where a session's limit was detected and what matched.
+func printScanExplain(r quota.ScanResult) {
+	fmt.Printf("     %s %s, confidence %.2f\n", style.Dim.Render("source:"), r.Source, r.Confidence)
+	fmt.Printf("     %s %s\n", style.Dim.Render("matched:"), r.MatchedLine)
+	if r.MatchedEntry != "" {
+		fmt.Printf("     %s %s\n", style.Dim.Render("entry:"), r.MatchedEntry)
+	}
 }
 
 func printScanJSON(results []quota.ScanResult) error {
@@ -288,7 +411,7 @@ func printScanJSON(results []quota.ScanResult) error {
 	return enc.Encode(results)
 }
 
-func printScanText(results []quota.ScanResult) error {
+func printScanText(results []quota.ScanResult, explain bool) error {
 	limited := 0
 	nearLimit := 0
 
@@ -310,6 +433,18 @@ func printScanText(results []quota.ScanResult) error {
 				account,
 				resets,
 			)
+			if explain {
+				printScanExplain(r)
+			}
+		} else if r.Suspect() {
+			fmt.Printf(" %s %-25s %s\n",
+				style.Dim.Render("?"),
+				r.Session,
+				style.Dim.Render(fmt.Sprintf("possible limit ignored (confidence %.2f)", r.Confidence)),
+			)
+			if explain {
+				printScanExplain(r)
+			}
 		} else if r.NearLimit {
 			nearLimit++
 			account := r.AccountHandle
@@ -590,21 +725,30 @@ func runQuotaRotate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var quotaClearYes bool
+
 var quotaClearCmd = &cobra.Command{
 	Use:   "clear [handle...]",
 	Short: "Mark account(s) as available again",
 	Long: `Clear the rate-limited status for one or more accounts, marking them available.
 
-When no handles are specified, all limited accounts are cleared.
+When no handles are specified, all limited accounts are cleared, along with
+provider-wide limits recorded for sessions without an account. Clear one
+provider with provider:<name>. Clearing more than one account asks for
+confirmation first (threshold: confirm.clear_limits); pass --yes to skip it.
 
 Examples:
-  gt quota clear              # Clear all limited accounts
-  gt quota clear work         # Clear a specific account
-  gt quota clear work personal`,
+  gt quota clear                 # Clear all limited accounts and providers
+  gt quota clear work            # Clear a specific account
+  gt quota clear work personal
+  gt quota clear provider:gemini # Clear a provider-wide limit`,
 	RunE: runQuotaClear,
 }
 
 func runQuotaClear(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionClearLimits); err != nil {
+		return err
+	}
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
 		return fmt.Errorf("finding town root: %w", err)
@@ -612,23 +756,39 @@ func runQuotaClear(cmd *cobra.Command, args []string) error {
 
 	mgr := quota.NewManager(townRoot)
 
-	if len(args) == 0 {
-		// Clear all limited accounts
+	targets := args
+	if len(targets) == 0 {
 		state, err := mgr.Load()
 		if err != nil {
 			return fmt.Errorf("loading quota state: %w", err)
 		}
-		cleared := 0
 		for handle, acctState := range state.Accounts {
 			if acctState.Status == config.QuotaStatusLimited || acctState.Status == config.QuotaStatusCooldown {
-				if err := mgr.MarkAvailable(handle); err != nil {
-					return fmt.Errorf("clearing %s: %w", handle, err)
-				}
-				fmt.Printf(" %s %s → available\n", style.SuccessPrefix, handle)
-				cleared++
+				targets = append(targets, handle)
 			}
 		}
-		if cleared == 0 {
+		for provider := range state.Providers {
+			targets = append(targets, quota.ProviderKeyPrefix+provider)
+		}
+		slices.Sort(targets)
+	}
+	if ok, err := confirmBulkAction("clear limits on", "account(s)", targets, loadConfirmConfig().GetClearLimits(), quotaClearYes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Clear canceled.")
+		return nil
+	}
+
+	if len(args) == 0 {
+		// Clear all limited accounts
+		cleared, err := mgr.ClearLimited()
+		if err != nil {
+			return fmt.Errorf("clearing limited accounts: %w", err)
+		}
+		for _, handle := range cleared {
+			fmt.Printf(" %s %s → available\n", style.SuccessPrefix, handle)
+		}
+		if len(cleared) == 0 {
 			fmt.Printf(" %s No limited accounts to clear\n", style.SuccessPrefix)
 		}
 		return nil
@@ -779,11 +939,7 @@ func executeKeychainRotation(
 	result.ResumedSession = "continue"
 
 	// Update quota state: mark account as used and record swap mapping
-	if err := mgr.WithLock(func() error {
-		state, loadErr := mgr.Load()
-		if loadErr != nil {
-			return loadErr
-		}
+	if _, err := mgr.Update(func(state *config.QuotaState) (bool, error) {
 		existing := state.Accounts[newAccount]
 		existing.LastUsed = time.Now().UTC().Format(time.RFC3339)
 		state.Accounts[newAccount] = existing
@@ -791,8 +947,7 @@ func executeKeychainRotation(
 		// Record the swap mapping so SyncSwappedTokens can propagate
 		// fresh tokens if the source account re-authenticates later.
 		quota.RecordSwap(state, currentConfigDir, newAccount)
-
-		return mgr.SaveUnlocked(state)
+		return true, nil
 	}); err != nil {
 		style.PrintWarning("could not update LastUsed for %s: %v", newAccount, err)
 	}
@@ -962,6 +1117,7 @@ func init() {
 
 	quotaScanCmd.Flags().BoolVar(&quotaJSON, "json", false, "Output as JSON")
 	quotaScanCmd.Flags().BoolVar(&scanUpdate, "update", false, "Update quota state with detected limits")
+	quotaScanCmd.Flags().BoolVar(&scanExplain, "explain", false, "Show the source, confidence, and matching entry for each limit")
 
 	quotaRotateCmd.Flags().BoolVar(&rotateDryRun, "dry-run", false, "Show plan without executing")
 	quotaRotateCmd.Flags().BoolVar(&quotaJSON, "json", false, "Output as JSON")
@@ -974,6 +1130,7 @@ func init() {
 	quotaCmd.AddCommand(quotaStatusCmd)
 	quotaCmd.AddCommand(quotaScanCmd)
 	quotaCmd.AddCommand(quotaRotateCmd)
+	quotaClearCmd.Flags().BoolVarP(&quotaClearYes, "yes", "y", false, "Skip the confirmation for clearing several accounts")
 	quotaCmd.AddCommand(quotaClearCmd)
 	quotaCmd.AddCommand(quotaWatchCmd)
 
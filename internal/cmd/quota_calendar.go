@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/ics"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var quotaCalendarOutput string
+
+var quotaCalendarCmd = &cobra.Command{
+	Use:   "calendar",
+	Short: "Publish limit resets and dispatch pauses as an iCalendar feed",
+	Long: `Print this town's upcoming limit resets and dispatch pauses as an
+iCalendar (ICS) feed, so operators can see in their calendar when the agent
+fleet resumes.
+
+The feed holds one event per limited account or provider window, spanning
+from the limit hit to its reset; an event for when the town can start new
+work again (limits reset or snooze ends); and the energy saver quiet hours
+as a daily recurring event, when enabled.
+
+Write it to a file your calendar subscribes to, refreshed from cron, or
+subscribe to the dashboard's /api/limits.ics endpoint (gt dashboard).
+
+Examples:
+  gt limits calendar > limits.ics
+  gt limits calendar --output ~/Sync/gastown-limits.ics`,
+	Args: cobra.NoArgs,
+	RunE: runQuotaCalendar,
+}
+
+func init() {
+	quotaCalendarCmd.Flags().StringVarP(&quotaCalendarOutput, "output", "o", "", "Write the feed to this file instead of stdout")
+	quotaCmd.AddCommand(quotaCalendarCmd)
+}
+
+func runQuotaCalendar(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil {
+		acctCfg = nil // No accounts: provider-level limits only
+	}
+	state, err := quota.NewManager(townRoot).Load()
+	if err != nil {
+		return fmt.Errorf("loading quota state: %w", err)
+	}
+	var quietWindow string
+	if patrolCfg := daemon.LoadPatrolConfig(townRoot); patrolCfg != nil && patrolCfg.EnergySaver != nil && patrolCfg.EnergySaver.Enabled {
+		quietWindow = patrolCfg.EnergySaver.Window
+	}
+
+	feed := buildLimitsCalendar(townRoot, acctCfg, state, quietWindow, time.Now()).String()
+	if quotaCalendarOutput == "" {
+		fmt.Print(feed)
+		return nil
+	}
+	// Atomic, so a calendar syncing the file never reads half a feed.
+	if err := atomicfile.WriteFile(quotaCalendarOutput, []byte(feed), 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", quotaCalendarOutput, err)
+	}
+	fmt.Printf("%s Wrote limits calendar to %s\n", style.Bold.Render("✓"), quotaCalendarOutput)
+	return nil
+}
+
+// buildLimitsCalendar builds the limits feed. Event UIDs are derived from the
+// town and the limit hit, so a refreshed feed updates events in place.
+func buildLimitsCalendar(townRoot string, acctCfg *config.AccountsConfig, state *config.QuotaState, quietWindow string, now time.Time) *ics.Calendar {
+	town := filepath.Base(townRoot)
+	cal := &ics.Calendar{Name: "Gas Town limits (" + town + ")", Stamp: now}
+
+	// who describes the limited account or provider in event descriptions.
+	limitEvents := func(kind, name, who string, acct config.AccountQuotaState) {
+		for _, r := range quota.PendingResets(acct, now) {
+			e := ics.Event{
+				UID:     fmt.Sprintf("limit-%s-%s-%s-%d@%s", kind, name, r.Window, r.ResetsAt.Unix(), town),
+				Summary: fmt.Sprintf("%s limited (%s)", name, r.Window),
+				Start:   r.ResetsAt,
+			}
+			if !r.LimitedAt.IsZero() && r.LimitedAt.Before(r.ResetsAt) {
+				e.Start, e.End = r.LimitedAt, r.ResetsAt
+			}
+			e.Description = fmt.Sprintf("%s hit its %s limit; it resets at %s.",
+				who, r.Window, r.ResetsAt.Local().Format("Mon Jan 2 15:04 MST"))
+			cal.Events = append(cal.Events, e)
+		}
+	}
+	for _, handle := range slices.Sorted(maps.Keys(state.Accounts)) {
+		who := fmt.Sprintf("Account %s (%s)", handle, quota.ProviderOf(acctCfg, handle))
+		limitEvents("account", handle, who, state.Accounts[handle])
+	}
+	for _, provider := range slices.Sorted(maps.Keys(state.Providers)) {
+		limitEvents("provider", provider, "Provider "+provider, state.Providers[provider])
+	}
+
+	// When the town can start new work again, as gt limits export reports it.
+	export := buildLimitsExport("", townRoot, acctCfg, state, now)
+	if resume, err := time.Parse(time.RFC3339, export.ResumesAt); err == nil {
+		desc := "Limits reset: stalled polecats are woken and scheduled dispatch resumes."
+		if export.SnoozedUntil == export.ResumesAt {
+			desc = "Limits snooze ends: stalled polecats are woken and scheduled dispatch resumes."
+			if state.Snooze != nil && state.Snooze.Reason != "" {
+				desc += " Snoozed for: " + state.Snooze.Reason + "."
+			}
+		}
+		cal.Events = append(cal.Events, ics.Event{
+			UID:         fmt.Sprintf("resume-%d@%s", resume.Unix(), town),
+			Summary:     "Gas Town resumes work (" + town + ")",
+			Description: desc,
+			Start:       resume,
+		})
+	}
+
+	if start, end, err := daemon.ParseQuietWindow(quietWindow); err == nil {
+		local := now.Local()
+		midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.Local)
+		if end < start {
+			end += 24 * 60 // Wraps past midnight
+		}
+		cal.Events = append(cal.Events, ics.Event{
+			UID:         "energy-saver@" + town,
+			Summary:     "Gas Town quiet hours (" + town + ")",
+			Description: "Energy saver deep sleep: no patrols or scheduled dispatch until the window ends (gt config set energy_saver.window).",
+			Start:       midnight.Add(time.Duration(start) * time.Minute),
+			End:         midnight.Add(time.Duration(end) * time.Minute),
+			Floating:    true,
+			Daily:       true,
+		})
+	}
+	return cal
+}
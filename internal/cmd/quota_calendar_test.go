@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestBuildLimitsCalendar(t *testing.T) {
+	now := time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC)
+	state := &config.QuotaState{
+		Accounts: map[string]config.AccountQuotaState{
+			"work": {Status: config.QuotaStatusLimited, LimitedAt: "2026-03-01T13:00:00Z", ResetsAt: "7pm (UTC)"},
+			"home": {Status: config.QuotaStatusLimited, ResetsAt: "5pm (UTC)"},
+		},
+		Snooze: &config.QuotaSnooze{Until: "2026-03-01T20:00:00Z", Reason: "demo"},
+	}
+
+	cal := buildLimitsCalendar("/home/me/gt", nil, state, "23:00-06:30", now)
+	var summaries []string
+	for _, e := range cal.Events {
+		summaries = append(summaries, e.Summary)
+	}
+	want := []string{"home limited (5h)", "work limited (5h)", "Gas Town resumes work (gt)", "Gas Town quiet hours (gt)"}
+	if strings.Join(summaries, "|") != strings.Join(want, "|") {
+		t.Fatalf("events = %v, want %v", summaries, want)
+	}
+
+	home, work, resume, quiet := cal.Events[0], cal.Events[1], cal.Events[2], cal.Events[3]
+	if !home.End.IsZero() || !home.Start.Equal(time.Date(2026, 3, 1, 17, 0, 0, 0, time.UTC)) {
+		t.Errorf("home = %v-%v, want a point event at the 5pm reset", home.Start, home.End)
+	}
+	if !work.Start.Equal(time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC)) || !work.End.Equal(time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)) {
+		t.Errorf("work = %v-%v, want the limit hit through the 7pm reset", work.Start, work.End)
+	}
+	if !strings.Contains(work.UID, "@gt") || work.UID == home.UID {
+		t.Errorf("UIDs = %q, %q; want distinct town-scoped UIDs", work.UID, home.UID)
+	}
+	// The snooze outlasts every reset, so it decides when work resumes.
+	if !resume.Start.Equal(time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)) || !strings.Contains(resume.Description, "demo") {
+		t.Errorf("resume = %v %q, want the snooze end", resume.Start, resume.Description)
+	}
+	if !quiet.Daily || !quiet.Floating || quiet.End.Sub(quiet.Start) != 7*time.Hour+30*time.Minute {
+		t.Errorf("quiet hours = %+v, want a daily 7h30m floating event", quiet)
+	}
+}
+
+func TestBuildLimitsCalendar_Quiet(t *testing.T) {
+	state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+		"work": {Status: config.QuotaStatusAvailable},
+	}}
+	cal := buildLimitsCalendar("/home/me/gt", nil, state, "", time.Now())
+	if len(cal.Events) != 0 {
+		t.Errorf("events = %+v, want none with no limits and energy saver off", cal.Events)
+	}
+	if !strings.Contains(cal.String(), "BEGIN:VCALENDAR") {
+		t.Error("empty feed is not a calendar")
+	}
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/webhook"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// limitsPushTimeout bounds a --push POST, so a dead dashboard can't hang
+// the cron job or hook that exports.
+const limitsPushTimeout = 10 * time.Second
+
+// limitsPushSecretEnv names the env var holding the HMAC secret --push signs
+// with, using the same headers as webhook deliveries.
+const limitsPushSecretEnv = "GT_LIMITS_PUSH_SECRET"
+
+var quotaExportPush string
+
+var quotaExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export this town's limit state for fleet monitoring",
+	Long: `Print this town's rate-limit state as one JSON document, or POST it to a
+central dashboard with --push, so a fleet of machines can be watched in one
+place: which towns are limited, on which providers, and when they resume.
+
+The document names the host and town, whether the town can start new work,
+each provider's limit and earliest reset, each account's status, and any
+snooze. Run it from cron or a daemon hook to keep the dashboard current.
+
+With --push, the body is signed like webhook deliveries when ` + limitsPushSecretEnv + `
+is set (X-Gastown-Timestamp and X-Gastown-Signature headers).
+
+Examples:
+  gt limits export
+  gt limits export --push https://fleet.example.com/limits`,
+	Args: cobra.NoArgs,
+	RunE: runQuotaExport,
+}
+
+func init() {
+	quotaExportCmd.Flags().StringVar(&quotaExportPush, "push", "", "POST the export to this URL instead of printing it")
+	quotaCmd.AddCommand(quotaExportCmd)
+}
+
+// limitsExport is the fleet-monitoring view of one town's limits.
+type limitsExport struct {
+	Host         string                `json:"host"`
+	Town         string                `json:"town"`
+	TownRoot     string                `json:"town_root"`
+	GeneratedAt  string                `json:"generated_at"`
+	Limited      bool                  `json:"limited"`              // No new work can start
+	ResumesAt    string                `json:"resumes_at,omitempty"` // When new work can start again, if known
+	SnoozedUntil string                `json:"snoozed_until,omitempty"`
+	Providers    []providerLimitExport `json:"providers"`
+	Accounts     []accountLimitExport  `json:"accounts"`
+}
+
+type providerLimitExport struct {
+	Provider  string `json:"provider"`
+	Limited   bool   `json:"limited"`
+	ResumesAt string `json:"resumes_at,omitempty"`
+}
+
+type accountLimitExport struct {
+	Handle   string `json:"handle"`
+	Provider string `json:"provider"`
+	Status   string `json:"status"`
+	ResetsAt string `json:"resets_at,omitempty"`
+}
+
+func runQuotaExport(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil {
+		acctCfg = nil // No accounts: provider-level limits only
+	}
+	mgr := quota.NewManager(townRoot)
+	state, err := mgr.Load()
+	if err != nil {
+		return fmt.Errorf("loading quota state: %w", err)
+	}
+	if acctCfg != nil {
+		// Accounts never limited have no state yet but still count as free.
+		mgr.EnsureAccountsTracked(state, acctCfg.Accounts)
+	}
+	host, _ := os.Hostname()
+
+	export := buildLimitsExport(host, townRoot, acctCfg, state, time.Now())
+	body, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if quotaExportPush == "" {
+		fmt.Println(string(body))
+		return nil
+	}
+	if err := pushLimitsExport(cmd.Context(), quotaExportPush, body, []byte(os.Getenv(limitsPushSecretEnv))); err != nil {
+		return fmt.Errorf("pushing to %s: %w", quotaExportPush, err)
+	}
+	fmt.Printf("%s Pushed limit state to %s\n", style.Bold.Render("✓"), quotaExportPush)
+	return nil
+}
+
+// buildLimitsExport summarizes state for a fleet dashboard. A town is
+// limited when it is snoozed or every provider it knows of is limited; it
+// resumes at the earliest provider reset, or the end of the snooze if later.
+func buildLimitsExport(host, townRoot string, acctCfg *config.AccountsConfig, state *config.QuotaState, now time.Time) limitsExport {
+	export := limitsExport{
+		Host:        host,
+		Town:        filepath.Base(townRoot),
+		TownRoot:    townRoot,
+		GeneratedAt: now.UTC().Format(time.RFC3339),
+		Providers:   []providerLimitExport{},
+		Accounts:    []accountLimitExport{},
+	}
+
+	providers := make(map[string]bool)
+	if acctCfg != nil {
+		for _, handle := range slices.Sorted(maps.Keys(acctCfg.Accounts)) {
+			qs := state.Accounts[handle]
+			status := qs.Status
+			if status == "" || quota.ShouldWake(qs, now) {
+				status = config.QuotaStatusAvailable
+			}
+			a := accountLimitExport{Handle: handle, Provider: quota.ProviderOf(acctCfg, handle), Status: string(status)}
+			if status != config.QuotaStatusAvailable {
+				a.ResetsAt = qs.ResetsAt
+			}
+			export.Accounts = append(export.Accounts, a)
+			providers[a.Provider] = true
+		}
+	}
+	for handle := range state.Accounts {
+		providers[quota.ProviderOf(acctCfg, handle)] = true
+	}
+	for provider := range state.Providers {
+		providers[provider] = true
+	}
+
+	var resume time.Time
+	allLimited := len(providers) > 0
+	for _, provider := range slices.Sorted(maps.Keys(providers)) {
+		p := providerLimitExport{Provider: provider, Limited: quota.ProviderLimited(state, acctCfg, provider, now)}
+		if p.Limited {
+			if reset, ok := quota.ProviderResetAt(state, acctCfg, provider, now); ok {
+				p.ResumesAt = reset.UTC().Format(time.RFC3339)
+				if resume.IsZero() || reset.Before(resume) {
+					resume = reset
+				}
+			}
+		} else {
+			allLimited = false
+		}
+		export.Providers = append(export.Providers, p)
+	}
+
+	export.Limited = allLimited
+	if !allLimited {
+		resume = time.Time{}
+	}
+	if until, ok := quota.SnoozedUntil(state, now); ok {
+		export.SnoozedUntil = until.UTC().Format(time.RFC3339)
+		export.Limited = true
+		if !allLimited || until.After(resume) {
+			resume = until
+		}
+	}
+	if export.Limited && !resume.IsZero() {
+		export.ResumesAt = resume.UTC().Format(time.RFC3339)
+	}
+	return export
+}
+
+// pushLimitsExport POSTs an export body to url, signing it when secret is set.
+func pushLimitsExport(ctx context.Context, url string, body, secret []byte) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, limitsPushTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "gastown-limits")
+	req.Header.Set(webhook.HeaderTimestamp, ts)
+	if len(secret) > 0 {
+		req.Header.Set(webhook.HeaderSignature, webhook.Sign(secret, ts, body))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/webhook"
+)
+
+func TestBuildLimitsExport(t *testing.T) {
+	utc := time.UTC
+	now := time.Date(2026, 3, 1, 14, 0, 0, 0, utc)
+	limited := func(resets string) config.AccountQuotaState {
+		return config.AccountQuotaState{
+			Status:    config.QuotaStatusLimited,
+			LimitedAt: "2026-03-01T13:00:00Z",
+			ResetsAt:  resets + " (UTC)",
+		}
+	}
+	acctCfg := &config.AccountsConfig{Accounts: map[string]config.Account{
+		"work":     {},
+		"personal": {},
+	}}
+
+	t.Run("one account free", func(t *testing.T) {
+		state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+			"work":     limited("7pm"),
+			"personal": {Status: config.QuotaStatusAvailable},
+		}}
+		got := buildLimitsExport("box1", "/home/me/gt", acctCfg, state, now)
+		if got.Limited || got.ResumesAt != "" {
+			t.Errorf("Limited = %v, ResumesAt = %q; want not limited while personal is free", got.Limited, got.ResumesAt)
+		}
+		if got.Town != "gt" || got.Host != "box1" {
+			t.Errorf("Town/Host = %q/%q", got.Town, got.Host)
+		}
+		if len(got.Accounts) != 2 || got.Accounts[1].Handle != "work" || got.Accounts[1].Status != "limited" {
+			t.Errorf("Accounts = %+v, want personal then work (limited)", got.Accounts)
+		}
+	})
+
+	t.Run("all accounts limited", func(t *testing.T) {
+		state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+			"work":     limited("7pm"),
+			"personal": limited("5pm"),
+		}}
+		got := buildLimitsExport("box1", "/home/me/gt", acctCfg, state, now)
+		if !got.Limited {
+			t.Fatal("Limited = false with every account limited")
+		}
+		if want := "2026-03-01T17:00:00Z"; got.ResumesAt != want {
+			t.Errorf("ResumesAt = %q, want the earliest reset %q", got.ResumesAt, want)
+		}
+		if len(got.Providers) != 1 || !got.Providers[0].Limited {
+			t.Errorf("Providers = %+v, want one limited provider", got.Providers)
+		}
+	})
+
+	t.Run("snoozed", func(t *testing.T) {
+		state := &config.QuotaState{Snooze: &config.QuotaSnooze{Until: "2026-03-01T16:00:00Z"}}
+		got := buildLimitsExport("box1", "/home/me/gt", nil, state, now)
+		if !got.Limited || got.ResumesAt != "2026-03-01T16:00:00Z" || got.SnoozedUntil == "" {
+			t.Errorf("got %+v, want limited until the snooze ends", got)
+		}
+	})
+}
+
+func TestPushLimitsExport(t *testing.T) {
+	var gotBody []byte
+	var gotSig, gotTS string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSig = r.Header.Get(webhook.HeaderSignature)
+		gotTS = r.Header.Get(webhook.HeaderTimestamp)
+	}))
+	defer srv.Close()
+
+	body, _ := json.Marshal(limitsExport{Host: "box1"})
+	if err := pushLimitsExport(t.Context(), srv.URL, body, []byte("s3cret")); err != nil {
+		t.Fatalf("pushLimitsExport: %v", err)
+	}
+	if string(gotBody) != string(body) {
+		t.Errorf("body = %s, want %s", gotBody, body)
+	}
+	if !webhook.Verify([]byte("s3cret"), gotTS, gotBody, gotSig) {
+		t.Error("signature does not verify")
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	if err := pushLimitsExport(t.Context(), failing.URL, body, nil); err == nil {
+		t.Error("pushLimitsExport succeeded against a 502")
+	}
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var quotaPredictCmd = &cobra.Command{
+	Use:   "predict",
+	Short: "Estimate when the next rate limit will hit",
+	Long: `Estimate when the next rate limit will likely hit at the current dispatch rate.
+
+For each limit window (5h and weekly), compares the spend so far in the
+trailing window with the typical spend observed when limits were hit
+before, and projects the remaining runway at the spend rate of the last
+hour. Use it to decide whether to queue more work now or hold off.
+
+Spend comes from the costs log (gt costs record) and daily cost digests;
+past limit hits come from 'gt quota scan --update'. Estimates are
+town-wide and improve as more limits are observed.
+
+Examples:
+  gt quota predict
+  gt quota predict --json`,
+	RunE: runQuotaPredict,
+}
+
+func init() {
+	quotaPredictCmd.Flags().BoolVar(&quotaJSON, "json", false, "Output as JSON")
+	quotaCmd.AddCommand(quotaPredictCmd)
+}
+
+func runQuotaPredict(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+
+	now := time.Now()
+	mgr := quota.NewManager(townRoot)
+	history, err := mgr.LoadHistory(now.AddDate(0, 0, -90))
+	if err != nil {
+		return err
+	}
+
+	usage := loadCostsLogUsage()
+	// Days already digested have been removed from the costs log; fold in
+	// their totals so the weekly window sees the whole week.
+	if digests, err := queryDigestBeads(7); err == nil {
+		for _, e := range digests {
+			usage = append(usage, quota.UsageSample{At: e.EndedAt, CostUSD: e.CostUSD})
+		}
+	}
+
+	predictions := []quota.Prediction{
+		quota.Predict(quota.Window5Hour, history, usage, now),
+		quota.Predict(quota.WindowWeekly, history, usage, now),
+	}
+
+	if quotaJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(predictions)
+	}
+
+	fmt.Println(style.Bold.Render("Limit Forecast"))
+	fmt.Println()
+	for _, p := range predictions {
+		fmt.Printf(" %-7s %s\n", p.Window, describePrediction(p, now))
+	}
+	return nil
+}
+
+// describePrediction renders a one-line forecast for a limit window.
+func describePrediction(p quota.Prediction, now time.Time) string {
+	rate := fmt.Sprintf("$%.2f/h", p.RatePerHour)
+	if p.CapacityUSD == 0 {
+		return fmt.Sprintf("$%.2f used, %s %s", p.UsedUSD, rate,
+			style.Dim.Render("· no limit history yet"))
+	}
+
+	used := fmt.Sprintf("$%.2f used of ~$%.2f %s", p.UsedUSD, p.CapacityUSD,
+		style.Dim.Render(fmt.Sprintf("(%d past limit(s))", p.Samples)))
+	switch {
+	case p.RunwayUSD == 0:
+		return fmt.Sprintf("%s, %s · %s", used, rate, style.Error.Render("at typical limit now"))
+	case p.LimitAt == "":
+		return fmt.Sprintf("%s, %s · $%.2f runway, not being spent", used, rate, p.RunwayUSD)
+	}
+	at := now.Add(p.Runway)
+	forecast := fmt.Sprintf("limit in ~%s (%s)", humanize.Duration(p.Runway.Round(time.Minute)), at.Format("Mon 15:04"))
+	if p.Runway < time.Hour {
+		forecast = style.Warning.Render(forecast)
+	}
+	return fmt.Sprintf("%s, %s · $%.2f runway, %s", used, rate, p.RunwayUSD, forecast)
+}
+
+// loadCostsLogUsage reads spend samples from the local costs log.
+// A missing or unreadable log yields no samples.
+func loadCostsLogUsage() []quota.UsageSample {
+	f, err := os.Open(getCostsLogPath())
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var usage []quota.UsageSample
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e CostLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil || e.CostUSD <= 0 {
+			continue
+		}
+		usage = append(usage, quota.UsageSample{At: e.EndedAt, CostUSD: e.CostUSD})
+	}
+	return usage
+}
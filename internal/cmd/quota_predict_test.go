@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/quota"
+)
+
+func TestDescribePrediction(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		p    quota.Prediction
+		want string
+	}{
+		{"no history", quota.Prediction{UsedUSD: 7, RatePerHour: 3}, "no limit history yet"},
+		{"at limit", quota.Prediction{UsedUSD: 30, CapacityUSD: 25, Samples: 2, LimitAt: "x"}, "at typical limit now"},
+		{"idle", quota.Prediction{UsedUSD: 5, CapacityUSD: 25, Samples: 2, RunwayUSD: 20}, "not being spent"},
+		{"runway", quota.Prediction{UsedUSD: 15, CapacityUSD: 25, Samples: 3, RatePerHour: 5, RunwayUSD: 10,
+			LimitAt: "x", Runway: 2 * time.Hour}, "limit in ~2h"},
+	}
+	for _, tt := range tests {
+		if got := describePrediction(tt.p, now); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: describePrediction() = %q, want it to contain %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLoadCostsLogUsage(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GT_HOME", home)
+	logPath := getCostsLogPath()
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := `{"session_id":"a","role":"polecat","cost_usd":1.5,"ended_at":"2026-10-16T14:00:00Z"}
+not json
+{"session_id":"b","role":"witness","cost_usd":0,"ended_at":"2026-10-16T14:10:00Z"}
+`
+	if err := os.WriteFile(logPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	usage := loadCostsLogUsage()
+	if len(usage) != 1 || usage[0].CostUSD != 1.5 {
+		t.Errorf("loadCostsLogUsage() = %+v, want the single priced entry", usage)
+	}
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/quota"
+	ttmux "github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	quotaRecordHookStdin bool
+	quotaRecordSession   string
+)
+
+var quotaRecordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Record a limit hit by this session (Stop hook)",
+	Long: `Check whether the current session just stopped on a usage limit and,
+if so, mark its account limited in the quota state.
+
+Meant to run from the Claude Code Stop hook. By default the session is
+resolved from GT_SESSION, the GT_* role variables, or the enclosing tmux
+session, and its pane and transcript are scanned like 'gt quota scan'.
+
+With --hook-stdin, the hook's JSON payload is read from stdin instead and
+the transcript it names is checked directly, so detection works for
+sessions that are not running in tmux. The account is taken from
+GT_QUOTA_ACCOUNT, else the registered account whose config dir holds the
+transcript, else CLAUDE_CONFIG_DIR.
+
+The command never fails the hook: problems are reported on stderr and the
+exit code is always 0.
+
+Examples:
+  gt quota record --hook-stdin      # In a Stop hook
+  gt quota record --session gt-gastown-Toast`,
+	Args: cobra.NoArgs,
+	RunE: runQuotaRecord,
+}
+
+func init() {
+	quotaRecordCmd.Flags().BoolVar(&quotaRecordHookStdin, "hook-stdin", false, "Read the Stop hook JSON payload from stdin")
+	quotaRecordCmd.Flags().StringVar(&quotaRecordSession, "session", "", "Tmux session to check (default: detect)")
+	quotaRecordCmd.MarkFlagsMutuallyExclusive("hook-stdin", "session")
+	quotaCmd.AddCommand(quotaRecordCmd)
+}
+
+func runQuotaRecord(cmd *cobra.Command, args []string) error {
+	// Hooks run with the agent's CWD, which need not be inside the town.
+	townRoot, err := workspace.Discover()
+	if err != nil {
+		// No town on this machine: nothing to record.
+		return nil
+	}
+	acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil {
+		acctCfg = nil // No accounts registered: limits are recorded per provider
+	}
+	scanner, err := quota.NewScanner(ttmux.NewTmux(), nil, acctCfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[quota] creating scanner: %v\n", err)
+		return nil
+	}
+	scanner.WithTownRoot(townRoot)
+
+	var result quota.ScanResult
+	if quotaRecordHookStdin {
+		input := readStdinJSON()
+		if input == nil {
+			fmt.Fprintf(os.Stderr, "[quota] no hook payload on stdin\n")
+			return nil
+		}
+		result = hookLimitResult(scanner, input, acctCfg)
+	} else {
+		session := quotaRecordSession
+		if session == "" {
+			session = os.Getenv("GT_SESSION")
+		}
+		if session == "" {
+			session = deriveSessionName()
+		}
+		if session == "" {
+			session = detectCurrentTmuxSession()
+		}
+		if session == "" {
+			return nil
+		}
+		result = scanner.ScanSession(session)
+	}
+
+	if !result.RateLimited {
+		return nil
+	}
+	if err := updateQuotaState(townRoot, []quota.ScanResult{result}, acctCfg); err != nil {
+		fmt.Fprintf(os.Stderr, "[quota] updating quota state: %v\n", err)
+		return nil
+	}
+	if result.AccountHandle == "" {
+		fmt.Fprintf(os.Stderr, "[quota] recorded limit for provider %s (%s, confidence %.2f)\n", result.Provider, result.Source, result.Confidence)
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "[quota] recorded limit for account %s (%s, confidence %.2f)\n", result.AccountHandle, result.Source, result.Confidence)
+	return nil
+}
+
+// hookLimitResult checks the transcript named in a Stop hook payload for a
+// usage limit. The result's account is resolved only when a limit is found.
+func hookLimitResult(scanner *quota.Scanner, input *hookInput, acctCfg *config.AccountsConfig) quota.ScanResult {
+	result := quota.ScanResult{Session: os.Getenv("GT_SESSION")}
+	if result.Session == "" {
+		result.Session = input.SessionID
+	}
+	if input.TranscriptPath == "" || !scanner.CheckTranscript(input.TranscriptPath, &result) {
+		return result
+	}
+	result.ConfigDir = quota.ConfigDirForTranscript(input.TranscriptPath)
+	result.AccountHandle = hookAccount(acctCfg, result.ConfigDir)
+	result.Agent = os.Getenv("GT_AGENT")
+	result.Provider = scanner.Provider(result.Agent, result.AccountHandle)
+	return result
+}
+
+// hookAccount resolves the account a hook's session runs under. Mirrors the
+// scanner's tmux-based resolution, using the hook's own environment and the
+// config dir its transcript lives in.
+func hookAccount(acctCfg *config.AccountsConfig, transcriptConfigDir string) string {
+	if acctCfg == nil {
+		return ""
+	}
+	if h := os.Getenv("GT_QUOTA_ACCOUNT"); h != "" && acctCfg.GetAccount(h) != nil {
+		return h
+	}
+	if h := quota.AccountForConfigDir(acctCfg, transcriptConfigDir); h != "" {
+		return h
+	}
+	return quota.AccountForConfigDir(acctCfg, os.Getenv("CLAUDE_CONFIG_DIR"))
+}
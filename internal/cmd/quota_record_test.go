@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/quota"
+)
+
+func writeHookTranscript(t *testing.T, configDir, lastEntry string) string {
+	t.Helper()
+	dir := filepath.Join(configDir, "projects", "-work-gastown")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "abc123.jsonl")
+	data := `{"type":"user","message":{"role":"user","content":"fix the bug"}}` + "\n" + lastEntry + "\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestHookLimitResult(t *testing.T) {
+	t.Setenv("GT_SESSION", "")
+	t.Setenv("GT_QUOTA_ACCOUNT", "")
+	t.Setenv("CLAUDE_CONFIG_DIR", "")
+
+	workDir, personalDir := t.TempDir(), t.TempDir()
+	acctCfg := &config.AccountsConfig{Accounts: map[string]config.Account{
+		"work":     {ConfigDir: workDir},
+		"personal": {ConfigDir: personalDir},
+	}}
+	scanner, err := quota.NewScanner(nil, nil, acctCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limited := writeHookTranscript(t, workDir,
+		`{"type":"assistant","isApiErrorMessage":true,"message":{"role":"assistant","content":[{"type":"text","text":"You've hit your limit · resets 7pm"}]}}`)
+	busy := writeHookTranscript(t, personalDir,
+		`{"type":"assistant","message":{"role":"assistant","content":[{"type":"text","text":"Done."}]}}`)
+
+	r := hookLimitResult(scanner, &hookInput{SessionID: "abc123", TranscriptPath: limited, HookEventName: "Stop"}, acctCfg)
+	if !r.RateLimited || r.Source != quota.SourceTranscript {
+		t.Fatalf("expected transcript limit, got %+v", r)
+	}
+	if r.AccountHandle != "work" || r.Session != "abc123" || r.ResetsAt != "7pm" {
+		t.Errorf("got account=%q session=%q resets=%q, want work/abc123/7pm", r.AccountHandle, r.Session, r.ResetsAt)
+	}
+
+	if r := hookLimitResult(scanner, &hookInput{TranscriptPath: busy}, acctCfg); r.RateLimited {
+		t.Errorf("expected no limit for a normal stop, got %+v", r)
+	}
+	if r := hookLimitResult(scanner, &hookInput{SessionID: "abc123"}, acctCfg); r.RateLimited {
+		t.Errorf("expected no limit without a transcript path, got %+v", r)
+	}
+
+	// GT_QUOTA_ACCOUNT (set by keychain rotation) wins over the config dir.
+	t.Setenv("GT_QUOTA_ACCOUNT", "personal")
+	t.Setenv("GT_SESSION", "gt-gastown-nux")
+	r = hookLimitResult(scanner, &hookInput{SessionID: "abc123", TranscriptPath: limited}, acctCfg)
+	if r.AccountHandle != "personal" || r.Session != "gt-gastown-nux" {
+		t.Errorf("got account=%q session=%q, want personal/gt-gastown-nux", r.AccountHandle, r.Session)
+	}
+}
+
+func TestHookAccount_FallsBackToClaudeConfigDir(t *testing.T) {
+	t.Setenv("GT_QUOTA_ACCOUNT", "")
+	workDir := t.TempDir()
+	acctCfg := &config.AccountsConfig{Accounts: map[string]config.Account{"work": {ConfigDir: workDir}}}
+
+	t.Setenv("CLAUDE_CONFIG_DIR", workDir)
+	if got := hookAccount(acctCfg, "/elsewhere/.claude"); got != "work" {
+		t.Errorf("hookAccount = %q, want work", got)
+	}
+	t.Setenv("CLAUDE_CONFIG_DIR", "")
+	if got := hookAccount(acctCfg, "/elsewhere/.claude"); got != "" {
+		t.Errorf("hookAccount = %q, want empty for an unregistered dir", got)
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	snoozeFor    time.Duration
+	snoozeReason string
+	snoozeClear  bool
+)
+
+var quotaSnoozeCmd = &cobra.Command{
+	Use:   "snooze",
+	Short: "Keep the town quiet for a while, even after limits reset",
+	Long: `Temporarily stop the daemon from waking limit-stalled polecats and from
+dispatching scheduled work, even once account limits have reset.
+
+Use it when the machine should stay quiet for a fixed time, e.g. during a
+demo. Running agents are not interrupted. The snooze is stored in the quota
+state (mayor/quota.json), shown by 'gt quota status' and 'gt scheduler
+status', and lifts by itself when it expires.
+
+Examples:
+  gt limits snooze --for 30m
+  gt limits snooze --for 2h --reason "customer demo"
+  gt limits snooze --clear      # Lift the snooze now`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runQuotaSnooze,
+}
+
+func init() {
+	quotaSnoozeCmd.Flags().DurationVar(&snoozeFor, "for", 0, "How long to stay quiet (e.g. 30m, 2h)")
+	quotaSnoozeCmd.Flags().StringVar(&snoozeReason, "reason", "", "Why the town is snoozed (shown in status)")
+	quotaSnoozeCmd.Flags().BoolVar(&snoozeClear, "clear", false, "Lift an active snooze")
+	quotaSnoozeCmd.MarkFlagsMutuallyExclusive("for", "clear")
+	quotaCmd.AddCommand(quotaSnoozeCmd)
+}
+
+func runQuotaSnooze(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	mgr := quota.NewManager(townRoot)
+
+	if snoozeClear {
+		cleared, err := mgr.Unsnooze()
+		if err != nil {
+			return fmt.Errorf("clearing snooze: %w", err)
+		}
+		if !cleared {
+			fmt.Printf("%s Not snoozed\n", style.Dim.Render("○"))
+			return nil
+		}
+		fmt.Printf("%s Snooze lifted; limit wakes and dispatch resume on the next heartbeat\n", style.Bold.Render("✓"))
+		return nil
+	}
+
+	if snoozeFor <= 0 {
+		return fmt.Errorf("--for is required (e.g. --for 30m)")
+	}
+	until, err := mgr.Snooze(snoozeFor, snoozeReason, detectActor())
+	if err != nil {
+		return fmt.Errorf("snoozing: %w", err)
+	}
+	fmt.Printf("%s Snoozed until %s\n", style.Bold.Render("✓"), until.Local().Format("15:04 MST"))
+	fmt.Printf("  %s\n", style.Dim.Render("No limit wakes or scheduler dispatch until then. Lift early with: gt limits snooze --clear"))
+	return nil
+}
+
+// snoozeLabel describes an active snooze for status output, e.g.
+// "until 15:04 (24m left) — customer demo".
+func snoozeLabel(until time.Time, reason string, now time.Time) string {
+	left := until.Sub(now).Round(time.Minute)
+	if left < time.Minute {
+		left = time.Minute
+	}
+	label := fmt.Sprintf("until %s (%s left)", until.Local().Format("15:04"), humanize.Duration(left))
+	if reason != "" {
+		label += " — " + reason
+	}
+	return label
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -383,7 +382,7 @@ func filterFormulaScaffolds(issues []*beads.Issue, formulaNames map[string]bool)
 // This is a defense-in-depth exclusion - bd ready should already filter wisps,
 // but we double-check at the display layer to ensure operational work doesn't leak.
 func getWispIDs(beadsPath string) map[string]bool {
-	cmd := exec.Command("bd", "mol", "wisp", "list", "--json")
+	cmd := beads.Command("mol", "wisp", "list", "--json")
 	cmd.Dir = beadsPath
 	output, err := cmd.Output()
 	if err != nil {
@@ -686,6 +686,12 @@ func runRefineryUnclaimed(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// refineryReadyOutput is the --json output of gt refinery ready.
+type refineryReadyOutput struct {
+	Ready     []*refinery.MRInfo    `json:"ready"`
+	Anomalies []*refinery.MRAnomaly `json:"anomalies,omitempty"`
+}
+
 func runRefineryReady(cmd *cobra.Command, args []string) error {
 	rigName := ""
 	if len(args) > 0 {
@@ -716,13 +722,9 @@ func runRefineryReady(cmd *cobra.Command, args []string) error {
 
 	// JSON output
 	if refineryReadyJSON {
-		type readyOutput struct {
-			Ready     []*refinery.MRInfo    `json:"ready"`
-			Anomalies []*refinery.MRAnomaly `json:"anomalies,omitempty"`
-		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		return enc.Encode(readyOutput{
+		return enc.Encode(refineryReadyOutput{
 			Ready:     ready,
 			Anomalies: anomalies,
 		})
@@ -6,10 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -193,14 +193,14 @@ func sanitizeKey(key string) string {
 
 // bdKvSet calls bd kv set <key> <value>.
 func bdKvSet(key, value string) error {
-	cmd := exec.Command("bd", "kv", "set", key, value)
+	cmd := beads.Command("kv", "set", key, value)
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
 }
 
 // bdKvGet calls bd kv get <key> and returns the value.
 func bdKvGet(key string) (string, error) {
-	cmd := exec.Command("bd", "kv", "get", key)
+	cmd := beads.Command("kv", "get", key)
 	out, err := cmd.Output()
 	if err != nil {
 		return "", err
@@ -210,14 +210,14 @@ func bdKvGet(key string) (string, error) {
 
 // bdKvClear calls bd kv clear <key>.
 func bdKvClear(key string) error {
-	cmd := exec.Command("bd", "kv", "clear", key)
+	cmd := beads.Command("kv", "clear", key)
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
 }
 
 // bdKvListJSON calls bd kv list --json and returns the parsed map.
 func bdKvListJSON() (map[string]string, error) {
-	cmd := exec.Command("bd", "kv", "list", "--json")
+	cmd := beads.Command("kv", "list", "--json")
 	out, err := cmd.Output()
 	if err != nil {
 		return nil, err
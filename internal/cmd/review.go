@@ -0,0 +1,455 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/ui"
+	"github.com/steveyegge/gastown/internal/workspace"
+	"github.com/steveyegge/gastown/internal/worktree"
+	"golang.org/x/term"
+)
+
+// labelReviewApproved marks a merge-request bead approved by gt review.
+const labelReviewApproved = "gt:review-approved"
+
+// Review diff summary limits: the files with the most churn are shown, each
+// with its largest hunk cut to a few lines. --full shows everything.
+const (
+	reviewMaxFiles      = 5
+	reviewMaxHunkLines  = 15
+	reviewMaxCheckLines = 20 // Output tail kept for a failed check
+)
+
+var (
+	reviewRig            string
+	reviewFull           bool
+	reviewNoChecks       bool
+	reviewApprove        bool
+	reviewRequestChanges string
+)
+
+var reviewCmd = &cobra.Command{
+	Use:     "review <bead-id>",
+	GroupID: GroupWork,
+	Short:   "Review a polecat branch before it merges",
+	Long: `Review the work a polecat submitted for a bead, then approve it or
+request changes, without leaving the terminal.
+
+gt review finds the bead's open merge request, fetches its branch, and shows:
+  - Files changed with line counts (git diff --stat)
+  - Key hunks: the largest hunk of each of the most-changed files
+  - Results of the rig's configured checks (setup, typecheck, lint, and
+    test commands from merge_queue settings), run in a scratch worktree
+
+Then it offers two actions that feed back into the merge queue:
+  approve          Label the MR gt:review-approved (shown as "approved" in
+                   gt mq list) and note the approval on the bead; only the
+                   refinery or a human reviewer may approve
+  request changes  Reject the MR with your feedback, nudge the polecat, and
+                   note the feedback on the bead; the bead stays open
+
+In a terminal you are prompted for the action; otherwise pass --approve or
+--request-changes.
+
+Examples:
+  gt review gt-abc123                        # Summary, checks, then prompt
+  gt review gt-abc123 --full                 # Page the complete diff
+  gt review gt-abc123 --no-checks --approve
+  gt review gt-abc123 --request-changes "Handle the empty-config case"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runReview,
+}
+
+func init() {
+	reviewCmd.Flags().StringVar(&reviewRig, "rig", "", "Rig of the bead (default: from the bead prefix)")
+	reviewCmd.Flags().BoolVar(&reviewFull, "full", false, "Show the complete diff through the pager")
+	reviewCmd.Flags().BoolVar(&reviewNoChecks, "no-checks", false, "Skip the configured lint/test commands")
+	reviewCmd.Flags().BoolVar(&reviewApprove, "approve", false, "Approve the merge request")
+	reviewCmd.Flags().StringVar(&reviewRequestChanges, "request-changes", "", "Reject the merge request with this feedback")
+	reviewCmd.MarkFlagsMutuallyExclusive("approve", "request-changes")
+	rootCmd.AddCommand(reviewCmd)
+}
+
+func runReview(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	rigName := reviewRig
+	if rigName == "" {
+		rigName = beads.GetRigNameForPrefix(townRoot, beads.ExtractPrefix(beadID))
+		if rigName == "" {
+			return fmt.Errorf("cannot resolve rig for %s; pass --rig", beadID)
+		}
+	}
+	mgr, r, _, err := getRefineryManager(rigName)
+	if err != nil {
+		return err
+	}
+	queue, err := mgr.Queue()
+	if err != nil {
+		return err
+	}
+	mr := findMRForBead(queue, beadID)
+	if mr == nil {
+		return fmt.Errorf("no open merge request for %s in rig %s (see 'gt mq list %s')", beadID, rigName, rigName)
+	}
+	if mr.Branch == "" {
+		return fmt.Errorf("merge request %s has no branch", mr.ID)
+	}
+
+	g, err := getRigGit(r.Path)
+	if err != nil {
+		return err
+	}
+	_ = g.FetchBranch("origin", mr.TargetBranch)
+	if err := g.FetchBranch("origin", mr.Branch); err != nil {
+		return fmt.Errorf("fetching %s: %w", mr.Branch, err)
+	}
+	rangeSpec := "origin/" + mr.TargetBranch + "...origin/" + mr.Branch
+
+	fmt.Printf("%s %s  %s → %s\n", style.Bold.Render("Review"), beadID, mr.Branch, mr.TargetBranch)
+	fmt.Printf("  MR: %s  Worker: %s\n\n", mr.ID, mr.Worker)
+
+	patch, err := g.Diff(rangeSpec)
+	if err != nil {
+		return fmt.Errorf("diffing %s: %w", rangeSpec, err)
+	}
+	if reviewFull {
+		if err := ui.ToPager(patch, ui.PagerOptions{}); err != nil {
+			return err
+		}
+	} else {
+		if stat, err := g.DiffStat(rangeSpec); err == nil && stat != "" {
+			fmt.Println(stat)
+		}
+		printKeyHunks(summarizeDiff(patch, reviewMaxFiles, reviewMaxHunkLines))
+	}
+
+	checksOK := true
+	if !reviewNoChecks {
+		checks := reviewChecks(townRoot, rigName)
+		if len(checks) == 0 {
+			fmt.Printf("%s No lint/test commands configured (merge_queue settings)\n\n", style.Dim.Render("○"))
+		} else {
+			results, err := runReviewChecks(worktree.NewManager(g), "origin/"+mr.Branch, checks)
+			if err != nil {
+				return err
+			}
+			checksOK = printReviewChecks(results)
+		}
+	}
+
+	action, feedback := reviewAction(checksOK)
+	switch action {
+	case "approve":
+		return approveReview(r.BeadsPath(), mr, beadID)
+	case "request-changes":
+		return requestReviewChanges(mgr, mr, beadID, feedback)
+	}
+	fmt.Printf("No action taken. Decide with:\n  gt review %s --approve\n  gt review %s --request-changes \"<feedback>\"\n", beadID, beadID)
+	return nil
+}
+
+// findMRForBead returns the open merge request whose source issue is beadID.
+func findMRForBead(queue []refinery.QueueItem, beadID string) *refinery.MergeRequest {
+	for _, item := range queue {
+		if item.MR != nil && item.MR.IssueID == beadID {
+			return item.MR
+		}
+	}
+	return nil
+}
+
+// reviewFileHunk is one changed file in a review summary and its largest hunk.
+type reviewFileHunk struct {
+	Path      string
+	Churn     int      // Added plus removed lines across the file
+	Hunk      []string // Largest hunk, header first, truncated
+	Truncated int      // Lines cut from Hunk
+}
+
+// summarizeDiff picks the maxFiles files with the most churn from a unified
+// diff and, for each, its largest hunk cut to maxLines lines.
+func summarizeDiff(patch string, maxFiles, maxLines int) []reviewFileHunk {
+	type hunk struct {
+		lines []string
+		churn int
+	}
+	type file struct {
+		path  string
+		churn int
+		hunks []*hunk
+	}
+	var files []*file
+	var cur *file
+	var h *hunk
+	for _, line := range strings.Split(patch, "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			path := line[len("diff --git "):]
+			if i := strings.Index(path, " b/"); i >= 0 {
+				path = path[i+len(" b/"):]
+			}
+			cur, h = &file{path: path}, nil
+			files = append(files, cur)
+		case cur == nil, line == "":
+		case strings.HasPrefix(line, "@@"):
+			h = &hunk{lines: []string{line}}
+			cur.hunks = append(cur.hunks, h)
+		case h == nil:
+			// File header (index, ---, +++); not part of a hunk.
+		default:
+			h.lines = append(h.lines, line)
+			if strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-") {
+				h.churn++
+				cur.churn++
+			}
+		}
+	}
+
+	sort.SliceStable(files, func(i, j int) bool { return files[i].churn > files[j].churn })
+	if len(files) > maxFiles {
+		files = files[:maxFiles]
+	}
+	var out []reviewFileHunk
+	for _, f := range files {
+		fh := reviewFileHunk{Path: f.path, Churn: f.churn}
+		var best *hunk
+		for _, h := range f.hunks {
+			if best == nil || h.churn > best.churn {
+				best = h
+			}
+		}
+		if best != nil {
+			fh.Hunk = best.lines
+			if len(fh.Hunk) > maxLines {
+				fh.Truncated = len(fh.Hunk) - maxLines
+				fh.Hunk = fh.Hunk[:maxLines]
+			}
+		}
+		out = append(out, fh)
+	}
+	return out
+}
+
+func printKeyHunks(files []reviewFileHunk) {
+	if len(files) == 0 {
+		fmt.Printf("%s No changes\n\n", style.Dim.Render("○"))
+		return
+	}
+	fmt.Printf("\n%s\n", style.Bold.Render("Key hunks"))
+	for _, f := range files {
+		fmt.Printf("\n  %s %s\n", style.Bold.Render(f.Path), style.Dim.Render(fmt.Sprintf("(%d lines changed)", f.Churn)))
+		for _, line := range f.Hunk {
+			switch {
+			case strings.HasPrefix(line, "@@"):
+				line = style.Dim.Render(line)
+			case strings.HasPrefix(line, "+"):
+				line = style.Success.Render(line)
+			case strings.HasPrefix(line, "-"):
+				line = style.Error.Render(line)
+			}
+			fmt.Printf("    %s\n", line)
+		}
+		if f.Truncated > 0 {
+			fmt.Printf("    %s\n", style.Dim.Render(fmt.Sprintf("… %d more line(s)", f.Truncated)))
+		}
+	}
+	fmt.Println()
+}
+
+// reviewCheck is a configured command run against the branch under review.
+type reviewCheck struct {
+	Name    string
+	Command string
+}
+
+// reviewCheckResult is the outcome of one reviewCheck.
+type reviewCheckResult struct {
+	reviewCheck
+	OK       bool
+	Duration time.Duration
+	Output   string // Tail of combined output, for failures
+}
+
+// reviewChecks returns the rig's configured merge queue commands in the
+// order the refinery runs them.
+func reviewChecks(townRoot, rigName string) []reviewCheck {
+	mq := loadRigMergeQueueSettings(townRoot, rigName)
+	if mq == nil {
+		return nil
+	}
+	var checks []reviewCheck
+	for _, c := range []reviewCheck{
+		{"setup", mq.SetupCommand},
+		{"typecheck", mq.TypecheckCommand},
+		{"lint", mq.LintCommand},
+		{"test", mq.TestCommand},
+	} {
+		if strings.TrimSpace(c.Command) != "" {
+			checks = append(checks, c)
+		}
+	}
+	return checks
+}
+
+// runReviewChecks runs checks in order in a scratch worktree of ref, removed
+// afterwards. A failed setup stops the remaining checks.
+func runReviewChecks(worktrees interface {
+	AddDetached(path, ref string) error
+	Remove(path string, force bool) error
+}, ref string, checks []reviewCheck) ([]reviewCheckResult, error) {
+	parent, err := os.MkdirTemp("", "gt-review-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(parent)
+	dir := filepath.Join(parent, "worktree")
+	if err := worktrees.AddDetached(dir, ref); err != nil {
+		return nil, fmt.Errorf("creating review worktree: %w", err)
+	}
+	defer func() { _ = worktrees.Remove(dir, true) }()
+
+	var results []reviewCheckResult
+	for _, c := range checks {
+		fmt.Printf("  %s %s: %s\n", style.Dim.Render("…"), c.Name, style.Dim.Render(c.Command))
+		start := time.Now()
+		run := exec.Command("sh", "-c", c.Command)
+		run.Dir = dir
+		out, err := run.CombinedOutput()
+		res := reviewCheckResult{reviewCheck: c, OK: err == nil, Duration: time.Since(start)}
+		if err != nil {
+			res.Output = tailLines(string(out), reviewMaxCheckLines)
+		}
+		results = append(results, res)
+		if !res.OK && c.Name == "setup" {
+			break
+		}
+	}
+	return results, nil
+}
+
+// printReviewChecks prints check results and reports whether all passed.
+func printReviewChecks(results []reviewCheckResult) bool {
+	fmt.Printf("\n%s\n", style.Bold.Render("Checks"))
+	ok := true
+	for _, r := range results {
+		d := style.Dim.Render(r.Duration.Round(time.Second).String())
+		if r.OK {
+			fmt.Printf("  %s %s %s\n", style.Bold.Render("✓"), r.Name, d)
+			continue
+		}
+		ok = false
+		fmt.Printf("  %s %s %s\n", style.Error.Render("✗"), r.Name, d)
+		for _, line := range strings.Split(r.Output, "\n") {
+			fmt.Printf("      %s\n", style.Dim.Render(line))
+		}
+	}
+	fmt.Println()
+	return ok
+}
+
+// tailLines returns the last n lines of s.
+func tailLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// reviewAction returns the action chosen by flag or, in a terminal, by
+// prompt: "approve", "request-changes" (with feedback), or "" to skip.
+func reviewAction(checksOK bool) (string, string) {
+	if reviewApprove {
+		return "approve", ""
+	}
+	if reviewRequestChanges != "" {
+		return "request-changes", reviewRequestChanges
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return "", ""
+	}
+
+	if !checksOK {
+		style.PrintWarning("some checks failed")
+	}
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("[a]pprove, [r]equest changes, or [s]kip? ")
+	answer, _ := reader.ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "a", "approve":
+		return "approve", ""
+	case "r", "request", "request changes":
+		fmt.Print("Feedback for the polecat: ")
+		feedback, _ := reader.ReadString('\n')
+		if feedback = strings.TrimSpace(feedback); feedback != "" {
+			return "request-changes", feedback
+		}
+		fmt.Println("No feedback given.")
+	}
+	return "", ""
+}
+
+// reviewGetRole resolves the caller's role for the approval check; a var so
+// tests can stub it.
+var reviewGetRole = GetRole
+
+// canApproveReview reports whether role may approve a merge request: the
+// refinery, or a human reviewer — crew, or the overseer outside any agent
+// workspace. Polecats and the patrol agents may not approve.
+func canApproveReview(role Role) bool {
+	switch role {
+	case RoleRefinery, RoleCrew, RoleUnknown:
+		return true
+	}
+	return false
+}
+
+// approveReview labels the MR approved and notes the approval on the bead.
+func approveReview(beadsPath string, mr *refinery.MergeRequest, beadID string) error {
+	roleInfo, err := reviewGetRole()
+	if err != nil {
+		return fmt.Errorf("detecting role: %w", err)
+	}
+	if !canApproveReview(roleInfo.Role) {
+		return fmt.Errorf("%s cannot approve merge requests; only the refinery or a human reviewer can", roleInfo.ActorString())
+	}
+
+	b := beads.New(beadsPath)
+	if err := b.Update(mr.ID, beads.UpdateOptions{AddLabels: []string{labelReviewApproved}}); err != nil {
+		return fmt.Errorf("labeling %s approved: %w", mr.ID, err)
+	}
+	if err := addBeadNote(beadID, fmt.Sprintf("Review approved by %s (%s)", detectActor(), mr.ID)); err != nil {
+		style.PrintWarning("%v", err)
+	}
+	fmt.Printf("%s Approved %s (%s)\n", style.Bold.Render("✓"), mr.ID, mr.Branch)
+	return nil
+}
+
+// requestReviewChanges rejects the MR with the reviewer's feedback, nudging
+// the polecat, and notes the feedback on the bead.
+func requestReviewChanges(mgr *refinery.Manager, mr *refinery.MergeRequest, beadID, feedback string) error {
+	if _, err := mgr.RejectMR(mr.ID, "changes requested: "+feedback, true); err != nil {
+		return fmt.Errorf("rejecting %s: %w", mr.ID, err)
+	}
+	if err := addBeadNote(beadID, "Changes requested: "+feedback); err != nil {
+		style.PrintWarning("%v", err)
+	}
+	fmt.Printf("%s Requested changes on %s; %s stays open\n", style.Bold.Render("✗"), mr.ID, beadID)
+	return nil
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/refinery"
+)
+
+const reviewTestPatch = `diff --git a/small.go b/small.go
+index 1111111..2222222 100644
+--- a/small.go
++++ b/small.go
+@@ -1,3 +1,3 @@ package small
+ package small
+-var x = 1
++var x = 2
+diff --git a/big.go b/big.go
+index 3333333..4444444 100644
+--- a/big.go
++++ b/big.go
+@@ -1,2 +1,3 @@
+ package big
++// one
+@@ -10,2 +11,6 @@ func f() {
+ 	a()
++	b()
++	c()
++	d()
++	e()
+`
+
+func TestSummarizeDiff(t *testing.T) {
+	got := summarizeDiff(reviewTestPatch, 5, 4)
+	if len(got) != 2 {
+		t.Fatalf("summarizeDiff returned %d files, want 2: %+v", len(got), got)
+	}
+	big := got[0]
+	if big.Path != "big.go" || big.Churn != 5 {
+		t.Errorf("first file = %s churn %d, want big.go churn 5", big.Path, big.Churn)
+	}
+	// Largest hunk, cut to 4 lines.
+	if len(big.Hunk) != 4 || !strings.HasPrefix(big.Hunk[0], "@@ -10,2") || big.Truncated != 2 {
+		t.Errorf("big.go hunk = %q (truncated %d)", big.Hunk, big.Truncated)
+	}
+	if got[1].Path != "small.go" || got[1].Churn != 2 || got[1].Truncated != 0 {
+		t.Errorf("second file = %+v", got[1])
+	}
+
+	if top := summarizeDiff(reviewTestPatch, 1, 10); len(top) != 1 || top[0].Path != "big.go" {
+		t.Errorf("maxFiles=1 = %+v, want only big.go", top)
+	}
+	if empty := summarizeDiff("", 5, 10); len(empty) != 0 {
+		t.Errorf("empty patch = %+v", empty)
+	}
+}
+
+func TestFindMRForBead(t *testing.T) {
+	queue := []refinery.QueueItem{
+		{MR: nil},
+		{MR: &refinery.MergeRequest{ID: "mr-1", IssueID: "gt-aaa"}},
+		{MR: &refinery.MergeRequest{ID: "mr-2", IssueID: "gt-bbb"}},
+	}
+	if mr := findMRForBead(queue, "gt-bbb"); mr == nil || mr.ID != "mr-2" {
+		t.Errorf("findMRForBead(gt-bbb) = %+v, want mr-2", mr)
+	}
+	if mr := findMRForBead(queue, "gt-zzz"); mr != nil {
+		t.Errorf("findMRForBead(gt-zzz) = %+v, want nil", mr)
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	if got := tailLines("a\nb\nc\n", 2); got != "b\nc" {
+		t.Errorf("tailLines = %q, want %q", got, "b\nc")
+	}
+	if got := tailLines("a", 5); got != "a" {
+		t.Errorf("tailLines short = %q", got)
+	}
+}
+
+func TestApproveReviewRequiresReviewerRole(t *testing.T) {
+	for role, want := range map[Role]bool{
+		RoleRefinery: true,
+		RoleCrew:     true,
+		RoleUnknown:  true, // The overseer, outside any agent workspace
+		RolePolecat:  false,
+		RoleWitness:  false,
+		RoleDeacon:   false,
+		RoleDog:      false,
+	} {
+		if got := canApproveReview(role); got != want {
+			t.Errorf("canApproveReview(%s) = %v, want %v", role, got, want)
+		}
+	}
+
+	old := reviewGetRole
+	t.Cleanup(func() { reviewGetRole = old })
+	reviewGetRole = func() (RoleInfo, error) {
+		return RoleInfo{Role: RolePolecat, Rig: "gastown", Polecat: "toast"}, nil
+	}
+	mr := &refinery.MergeRequest{ID: "gt-mr1", Branch: "polecat/toast"}
+	err := approveReview(t.TempDir(), mr, "gt-abc")
+	if err == nil || !strings.Contains(err.Error(), "cannot approve") {
+		t.Errorf("approveReview as a polecat = %v, want a role error", err)
+	}
+}
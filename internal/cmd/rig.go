@@ -75,9 +75,14 @@ Use --adopt to register an existing directory instead of creating new:
   - Auto-detects git URL from origin remote (git-url argument not required)
   - Adds entry to mayor/rigs.json
 
+For huge repos, --layout worktrees makes mayor/rig a linked worktree of the
+rig's single bare repo instead of a second clone. It is detached at
+origin/<default-branch>, since the refinery holds the default branch.
+
 Example:
   gt rig add gastown https://github.com/steveyegge/gastown
   gt rig add my_project git@github.com:user/repo.git --prefix mp
+  gt rig add monorepo git@github.com:org/mono.git --layout worktrees --filter blob:none
   gt rig add existing_rig --adopt`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: runRigAdd,
@@ -306,6 +311,7 @@ var (
 	rigAddAdoptForce     bool
 	rigAddFilter         string
 	rigAddSparseCheckout []string
+	rigAddLayout         string
 	rigResetHandoff    bool
 	rigResetMail       bool
 	rigResetStale      bool
@@ -369,6 +375,7 @@ func init() {
 	rigAddCmd.Flags().BoolVar(&rigAddAdoptForce, "force", false, "With --adopt, register even if git remote cannot be detected")
 	rigAddCmd.Flags().StringVar(&rigAddFilter, "filter", "", "Partial clone filter (e.g. \"blob:none\", \"tree:0\") to reduce clone size")
 	rigAddCmd.Flags().StringSliceVar(&rigAddSparseCheckout, "sparse-checkout", nil, "Sparse checkout paths (cone mode); comma-separated or repeated")
+	rigAddCmd.Flags().StringVar(&rigAddLayout, "layout", "", "Working copy layout: clones (default) or worktrees (mayor/rig is a detached worktree of the shared bare repo; for huge repos)")
 
 	rigResetCmd.Flags().BoolVar(&rigResetHandoff, "handoff", false, "Clear handoff content")
 	rigResetCmd.Flags().BoolVar(&rigResetMail, "mail", false, "Clear stale mail messages")
@@ -556,6 +563,9 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 	if len(rigAddSparseCheckout) > 0 {
 		fmt.Printf("  Sparse checkout: %v\n", rigAddSparseCheckout)
 	}
+	if rigAddLayout != "" {
+		fmt.Printf("  Layout: %s\n", rigAddLayout)
+	}
 
 	startTime := time.Now()
 
@@ -570,6 +580,7 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 		DefaultBranch:  rigAddBranch,
 		CloneFilter:    rigAddFilter,
 		SparseCheckout: rigAddSparseCheckout,
+		Layout:         rigAddLayout,
 	})
 	if err != nil {
 		return fmt.Errorf("adding rig: %w", err)
@@ -726,6 +737,19 @@ func rigStatePriority(hasWitness, hasRefinery bool, opState string) int {
 	}
 }
 
+// rigListItem is one rig in the --json output of gt rig list.
+type rigListItem struct {
+	Name        string `json:"name"`
+	BeadsPrefix string `json:"beads_prefix"`
+	Status      string `json:"status"`
+	Witness     string `json:"witness"`
+	Refinery    string `json:"refinery"`
+	Polecats    int    `json:"polecats"`
+	Crew        int    `json:"crew"`
+	// sorting fields (not exported to JSON)
+	sortPrio int
+}
+
 func runRigList(cmd *cobra.Command, args []string) error {
 	// Find workspace
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -752,26 +776,14 @@ func runRigList(cmd *cobra.Command, args []string) error {
 	mgr := rig.NewManager(townRoot, rigsConfig, g)
 	t := tmux.NewTmux()
 
-	type rigInfo struct {
-		Name        string `json:"name"`
-		BeadsPrefix string `json:"beads_prefix"`
-		Status      string `json:"status"`
-		Witness     string `json:"witness"`
-		Refinery    string `json:"refinery"`
-		Polecats    int    `json:"polecats"`
-		Crew        int    `json:"crew"`
-		// sorting fields (not exported to JSON)
-		sortPrio int
-	}
-
-	var rigs []rigInfo
+	var rigs []rigListItem
 
 	for name := range rigsConfig.Rigs {
 		prefix := session.PrefixFor(name)
 
 		r, err := mgr.GetRig(name)
 		if err != nil {
-			rigs = append(rigs, rigInfo{Name: name, BeadsPrefix: prefix, Status: "error", sortPrio: 99})
+			rigs = append(rigs, rigListItem{Name: name, BeadsPrefix: prefix, Status: "error", sortPrio: 99})
 			continue
 		}
 
@@ -792,7 +804,7 @@ func runRigList(cmd *cobra.Command, args []string) error {
 		}
 
 		summary := r.Summary()
-		rigs = append(rigs, rigInfo{
+		rigs = append(rigs, rigListItem{
 			Name:        name,
 			BeadsPrefix: prefix,
 			Status:      strings.ToLower(opState),
@@ -1213,7 +1225,7 @@ func runRigAdopt(_ *cobra.Command, args []string) error {
 			}
 			if json.Unmarshal(metaBytes, &meta) == nil && meta.Backend == "dolt" {
 				workDir := filepath.Dir(beadsDir)
-				bdCmd := exec.Command("bd", "config", "get", "issue_prefix")
+				bdCmd := beads.Command("config", "get", "issue_prefix")
 				bdCmd.Dir = workDir
 				if out, bdErr := bdCmd.Output(); bdErr == nil {
 					detected := strings.TrimSpace(string(out))
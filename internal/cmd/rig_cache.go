@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var rigWarmCacheAll bool
+
+var rigWarmCacheCmd = &cobra.Command{
+	Use:   "warm-cache <rig>... | --all",
+	Short: "Create or refresh a rig's shared git object cache",
+	Long: `Create or refresh the shared git object cache for rigs.
+
+The cache is a full mirror of the rig's git_url under <town>/.git-cache/,
+shared by every rig cloned from the same URL. Warming a rig:
+  1. Clones the mirror (first time) or fetches into it
+  2. Links the rig's bare repo to it (objects/info/alternates), so polecat
+     worktrees and fetches reuse its objects instead of downloading them
+  3. Records it as object_cache in the rig's config.json
+
+Afterwards, crew clones and new rigs with the same URL borrow from the
+cache, and the refinery refreshes it in the background whenever it merges
+to a base branch. Clones that borrow objects depend on the cache: don't
+delete .git-cache/ while rigs use it.
+
+Examples:
+  gt rig warm-cache gastown
+  gt rig warm-cache --all`,
+	RunE: runRigWarmCache,
+}
+
+func init() {
+	rigWarmCacheCmd.Flags().BoolVar(&rigWarmCacheAll, "all", false, "Refresh every rig that already has an object cache")
+	rigCmd.AddCommand(rigWarmCacheCmd)
+}
+
+func runRigWarmCache(cmd *cobra.Command, args []string) error {
+	var rigs []*rig.Rig
+	if rigWarmCacheAll {
+		all, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		for _, r := range all {
+			if rig.ObjectCacheFor(r.Path) != "" {
+				rigs = append(rigs, r)
+			}
+		}
+	} else {
+		if len(args) == 0 {
+			return fmt.Errorf("rig name required (or use --all)")
+		}
+		for _, name := range args {
+			_, r, err := getRig(name)
+			if err != nil {
+				return err
+			}
+			rigs = append(rigs, r)
+		}
+	}
+
+	var failed int
+	for _, r := range rigs {
+		fmt.Printf("Warming object cache for %s...\n", r.Name)
+		cache, err := rig.WarmObjectCache(filepath.Dir(r.Path), r.Path)
+		if err != nil {
+			fmt.Printf("%s %s: %v\n", style.Error.Render("✗"), r.Name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("%s %s → %s\n", style.Bold.Render("✓"), r.Name, cache)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to warm %d rig(s)", failed)
+	}
+	return nil
+}
+
+var rigCacheCleanAll bool
+
+var rigCacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage a rig's shared dependency caches",
+	Long: `Manage the dependency caches a rig's polecats share.
+
+Enable caches per rig with dep_cache in the rig's config.json:
+
+  "dep_cache": ["go", "pnpm"]
+
+Known caches: pnpm (store), npm, yarn, go (module and build cache), cargo
+(CARGO_HOME) and pip. Enabled caches live in <rig>/.runtime/dep-cache/, and
+every polecat session and rig bootstrap gets environment variables pointing
+its package managers there (GOMODCACHE, npm_config_store_dir, ...), so a new
+worktree installs from the cache instead of re-downloading everything.`,
+	RunE: requireSubcommand,
+}
+
+var rigCacheStatusCmd = &cobra.Command{
+	Use:   "status [rig...]",
+	Short: "Show dependency cache sizes",
+	Long: `Show each rig's dependency caches and their size on disk.
+
+Without arguments, shows every rig. Caches that are no longer enabled but
+still take up space are listed too; remove them with gt rig cache clean.`,
+	RunE: runRigCacheStatus,
+}
+
+var rigCacheCleanCmd = &cobra.Command{
+	Use:   "clean <rig> [cache...] | --all [cache...]",
+	Short: "Delete a rig's dependency caches",
+	Long: `Delete a rig's dependency caches to reclaim disk space.
+
+With cache names (go, pnpm, ...), deletes only those; otherwise deletes all
+of them. Polecats repopulate the caches on their next install.
+
+Examples:
+  gt rig cache clean gastown
+  gt rig cache clean gastown go
+  gt rig cache clean --all`,
+	RunE: runRigCacheClean,
+}
+
+func init() {
+	rigCacheCleanCmd.Flags().BoolVar(&rigCacheCleanAll, "all", false, "Clean every rig's caches")
+	rigCacheCmd.AddCommand(rigCacheStatusCmd)
+	rigCacheCmd.AddCommand(rigCacheCleanCmd)
+	rigCmd.AddCommand(rigCacheCmd)
+}
+
+// rigsFromArgs resolves rig names, or every rig when names is empty.
+func rigsFromArgs(names []string) ([]*rig.Rig, error) {
+	if len(names) == 0 {
+		return getAllRigs()
+	}
+	rigs := make([]*rig.Rig, 0, len(names))
+	for _, name := range names {
+		_, r, err := getRig(name)
+		if err != nil {
+			return nil, err
+		}
+		rigs = append(rigs, r)
+	}
+	return rigs, nil
+}
+
+func runRigCacheStatus(cmd *cobra.Command, args []string) error {
+	rigs, err := rigsFromArgs(args)
+	if err != nil {
+		return err
+	}
+	for i, r := range rigs {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s\n", style.Bold.Render(r.Name))
+		if cache := rig.ObjectCacheFor(r.Path); cache != "" {
+			fmt.Printf("  %-6s %s\n", "git", style.Dim.Render(cache))
+		}
+		shown := 0
+		for _, u := range rig.DepCacheStatus(r.Path) {
+			if !u.Enabled && u.Bytes == 0 {
+				continue
+			}
+			shown++
+			state := ""
+			if !u.Enabled {
+				state = style.Warning.Render(" (not enabled)")
+			}
+			fmt.Printf("  %-6s %9s%s\n", u.Kind, formatBytes(u.Bytes), state)
+		}
+		if shown == 0 {
+			fmt.Printf("  %s\n", style.Dim.Render("No dependency caches (set dep_cache in config.json)"))
+		}
+	}
+	return nil
+}
+
+func runRigCacheClean(cmd *cobra.Command, args []string) error {
+	var rigs []*rig.Rig
+	var kinds []string
+	if rigCacheCleanAll {
+		all, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		rigs, kinds = all, args
+	} else {
+		if len(args) == 0 {
+			return fmt.Errorf("rig name required (or use --all)")
+		}
+		_, r, err := getRig(args[0])
+		if err != nil {
+			return err
+		}
+		rigs, kinds = []*rig.Rig{r}, args[1:]
+	}
+
+	var failed int
+	for _, r := range rigs {
+		freed, err := rig.CleanDepCache(r.Path, kinds)
+		if err != nil {
+			fmt.Printf("%s %s: %v\n", style.Error.Render("✗"), r.Name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("%s %s: freed %s\n", style.Bold.Render("✓"), r.Name, formatBytes(freed))
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to clean %d rig(s)", failed)
+	}
+	return nil
+}
@@ -75,6 +75,7 @@ var beadsExemptCommands = map[string]bool{
 	"health":              true, // Health check doesn't require beads
 	"upgrade":             true, // Post-install migration orchestrator
 	"heartbeat":           true, // Heartbeat state update — must be fast and dependency-free
+	"schema":              true, // Static CLI description, no beads needed
 }
 
 // Commands exempt from the town root branch warning.
@@ -89,6 +90,7 @@ var branchCheckExemptCommands = map[string]bool{
 	"install":    true, // Initial setup
 	"git-init":   true, // Git setup
 	"upgrade":    true, // Post-install migration
+	"schema":     true, // Static CLI description
 }
 
 // persistentPreRun runs before every command.
@@ -107,6 +109,11 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 		os.Exit(1)
 	}
 
+	// gt --town names the town explicitly; it wins over the CWD and env.
+	if err := applyTownFlag(cmd); err != nil {
+		return err
+	}
+
 	// Initialize CLI theme (dark/light mode support)
 	initCLITheme()
 
@@ -118,10 +125,19 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 	// Env var fallback ensures commands invoked from outside the town directory
 	// (e.g., "gt agents menu" via a cross-socket tmux binding) still connect to
 	// the correct town socket rather than silently using the wrong server.
-	if townRoot := detectTownRootFromCwd(); townRoot != "" {
+	townRoot := detectTownRootFromCwd()
+	if townRoot != "" {
 		if err := session.InitRegistry(townRoot); err != nil {
 			fmt.Fprintf(os.Stderr, "WARNING: failed to initialize town registry: %v\n", err)
 		}
+		// Remember the town machine-wide so commands run from outside it
+		// (agent hooks) can still find it (workspace.Discover).
+		_ = workspace.TouchRegistry(townRoot)
+	}
+
+	// Observer mode (gt --observer) refuses state-mutating commands.
+	if err := checkObserverMode(cmd, townRoot); err != nil {
+		return err
 	}
 
 	// Get the root command name being run
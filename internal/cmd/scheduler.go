@@ -6,9 +6,17 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/quota"
 	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -19,9 +27,11 @@ import (
 var (
 	schedulerStatusJSON bool
 	schedulerListJSON   bool
+	schedulerListPage   listFlags
 	schedulerClearBead  string
 	schedulerRunBatch   int
 	schedulerRunDryRun  bool
+	schedulerRunSelect  capacity.Selector
 )
 
 var schedulerCmd = &cobra.Command{
@@ -33,14 +43,21 @@ var schedulerCmd = &cobra.Command{
 Subcommands:
   gt scheduler status    # Show scheduler state
   gt scheduler list      # List all scheduled beads
+  gt scheduler estimate  # Estimate a bead's duration
   gt scheduler run       # Manual dispatch trigger
   gt scheduler pause     # Pause dispatch
   gt scheduler resume    # Resume dispatch
+  gt scheduler hold      # Hold dispatch to one rig
+  gt scheduler release   # Release a held rig
   gt scheduler clear     # Remove beads from scheduler
+  gt scheduler preview   # Render a scheduled bead's formula
+  gt scheduler auto      # Enqueue beads matching routing rules
+  gt scheduler audit     # Check the queue for inconsistent contexts
 
 Config:
-  gt config set scheduler.max_polecats 5    # Enable deferred dispatch
-  gt config set scheduler.max_polecats -1   # Direct dispatch (default)`,
+  gt config set scheduler.max_polecats 5       # Enable deferred dispatch
+  gt config set scheduler.max_polecats -1      # Direct dispatch (default)
+  gt config set scheduler.auto_enqueue true    # Daemon enqueues rule matches`,
 	RunE: requireSubcommand,
 }
 
@@ -52,7 +69,7 @@ var schedulerStatusCmd = &cobra.Command{
 
 var schedulerListCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List all scheduled beads with titles, rig, blocked status",
+	Short: "List all scheduled beads with titles, rig, blocked status, estimates",
 	RunE:  runSchedulerList,
 }
 
@@ -68,6 +85,27 @@ var schedulerResumeCmd = &cobra.Command{
 	RunE:  runSchedulerResume,
 }
 
+var schedulerHoldCmd = &cobra.Command{
+	Use:   "hold <rig>",
+	Short: "Stop dispatching to a single rig",
+	Long: `Hold scheduler dispatch for one rig while other rigs continue.
+
+Beads targeting a held rig stay scheduled but are skipped by dispatch until
+the rig is released. Useful when a rig's CI or upstream is temporarily down.
+
+  gt scheduler hold gastown       # Stop dispatching to gastown
+  gt scheduler release gastown    # Resume dispatching to gastown`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSchedulerHold,
+}
+
+var schedulerReleaseCmd = &cobra.Command{
+	Use:   "release <rig>",
+	Short: "Resume dispatching to a held rig",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSchedulerRelease,
+}
+
 var schedulerClearCmd = &cobra.Command{
 	Use:   "clear",
 	Short: "Remove beads from the scheduler",
@@ -88,7 +126,17 @@ but can be run ad-hoc. Useful for testing or when the daemon is not running.
 
   gt scheduler run                  # Dispatch using config defaults
   gt scheduler run --batch 5        # Dispatch up to 5
-  gt scheduler run --dry-run        # Preview what would dispatch`,
+  gt scheduler run --dry-run        # Preview what would dispatch
+
+Select a slice of the queue to dispatch only matching beads, leaving the
+rest queued. Flags combine; --label may repeat and all labels must match.
+
+  gt scheduler run --label docs             # Only docs beads, right now
+  gt scheduler run --rig gastown --limit 2  # At most 2 gastown beads
+  gt scheduler run --bead gt-abc --bead gt-def
+
+When scheduler.auto_enqueue is true, beads matching scheduler.rules are
+enqueued first (see gt scheduler auto), except on a selective run.`,
 	RunE: runSchedulerRun,
 }
 
@@ -98,6 +146,7 @@ func init() {
 
 	// List flags
 	schedulerListCmd.Flags().BoolVar(&schedulerListJSON, "json", false, "Output as JSON")
+	scheduledBeadListing.register(schedulerListCmd, &schedulerListPage, 0)
 
 	// Clear flags
 	schedulerClearCmd.Flags().StringVar(&schedulerClearBead, "bead", "", "Remove specific bead from scheduler")
@@ -105,12 +154,18 @@ func init() {
 	// Run flags
 	schedulerRunCmd.Flags().IntVar(&schedulerRunBatch, "batch", 0, "Override batch size (0 = use config)")
 	schedulerRunCmd.Flags().BoolVar(&schedulerRunDryRun, "dry-run", false, "Preview what would dispatch")
+	schedulerRunCmd.Flags().StringSliceVar(&schedulerRunSelect.Rigs, "rig", nil, "Only dispatch beads targeting this rig (repeatable)")
+	schedulerRunCmd.Flags().StringSliceVar(&schedulerRunSelect.Labels, "label", nil, "Only dispatch beads with this label (repeatable; all must match)")
+	schedulerRunCmd.Flags().StringSliceVar(&schedulerRunSelect.Beads, "bead", nil, "Only dispatch this bead (repeatable)")
+	schedulerRunCmd.Flags().IntVar(&schedulerRunSelect.Limit, "limit", 0, "Dispatch at most N matching beads")
 
 	// Build command tree (flat — no intermediary "capacity" level)
 	schedulerCmd.AddCommand(schedulerStatusCmd)
 	schedulerCmd.AddCommand(schedulerListCmd)
 	schedulerCmd.AddCommand(schedulerPauseCmd)
 	schedulerCmd.AddCommand(schedulerResumeCmd)
+	schedulerCmd.AddCommand(schedulerHoldCmd)
+	schedulerCmd.AddCommand(schedulerReleaseCmd)
 	schedulerCmd.AddCommand(schedulerClearCmd)
 	schedulerCmd.AddCommand(schedulerRunCmd)
 
@@ -119,11 +174,62 @@ func init() {
 
 // scheduledBeadInfo holds info about a scheduled bead for display.
 type scheduledBeadInfo struct {
-	ID        string `json:"id"`
-	Title     string `json:"title"`
-	Status    string `json:"status"`
-	TargetRig string `json:"target_rig"`
-	Blocked   bool   `json:"blocked,omitempty"`
+	ID        string             `json:"id"`
+	Title     string             `json:"title"`
+	Status    string             `json:"status"`
+	TargetRig string             `json:"target_rig"`
+	Blocked   bool               `json:"blocked,omitempty"`
+	Formula   string             `json:"formula,omitempty"`
+	Labels    []string           `json:"labels,omitempty"`
+	Estimate  *capacity.Estimate `json:"estimate,omitempty"`
+
+	EnqueuedAt string `json:"enqueued_at,omitempty"`
+	Stale      bool   `json:"stale,omitempty"` // Queued longer than scheduler.max_age
+}
+
+// scheduledBeadListing sorts gt scheduler list, oldest enqueued first.
+var scheduledBeadListing = listing[scheduledBeadInfo]{
+	fields: []listField[scheduledBeadInfo]{
+		{"enqueued", func(b scheduledBeadInfo) any {
+			if t, err := time.Parse(time.RFC3339, b.EnqueuedAt); err == nil {
+				return t
+			}
+			return b.EnqueuedAt
+		}},
+		{"id", func(b scheduledBeadInfo) any { return b.ID }},
+		{"rig", func(b scheduledBeadInfo) any { return b.TargetRig }},
+		{"title", func(b scheduledBeadInfo) any { return b.Title }},
+		{"status", func(b scheduledBeadInfo) any { return b.Status }},
+		{"blocked", func(b scheduledBeadInfo) any { return b.Blocked }},
+	},
+	defaultSort: "enqueued",
+	id:          func(b scheduledBeadInfo) string { return b.ID },
+}
+
+// schedulerStatusOutput is the --json output of gt scheduler status.
+type schedulerStatusOutput struct {
+	Paused         bool               `json:"paused"`
+	PausedBy       string             `json:"paused_by,omitempty"`
+	HeldRigs       []string           `json:"held_rigs,omitempty"`
+	ScheduledTotal int                `json:"queued_total"`
+	ScheduledReady int                `json:"queued_ready"`
+	ScheduledStale int                `json:"queued_stale,omitempty"`
+	MaxAge         string             `json:"max_age,omitempty"`
+	Starved        []string           `json:"starved,omitempty"`
+	ConflictHolds  map[string]capacity.ConflictHold `json:"conflict_holds,omitempty"`
+	ActivePolecats int                `json:"active_polecats"`
+	LastDispatchAt string             `json:"last_dispatch_at,omitempty"`
+	Beads          []scheduledBeadInfo `json:"beads"`
+	Skipped        []capacity.SkippedBead `json:"skipped,omitempty"`
+
+	SnoozedUntil     string   `json:"snoozed_until,omitempty"`
+	LimitedProviders []string `json:"limited_providers,omitempty"`
+
+	Adaptive   bool                    `json:"adaptive"`
+	BatchSize  int                     `json:"batch_size"`
+	SpawnDelay string                  `json:"spawn_delay"`
+	Throttle   *capacity.ThrottleState `json:"throttle,omitempty"`
+	LimitCap   *capacity.LimitCap      `json:"limit_cap,omitempty"`
 }
 
 func runSchedulerStatus(cmd *cobra.Command, args []string) error {
@@ -139,24 +245,96 @@ func runSchedulerStatus(cmd *cobra.Command, args []string) error {
 
 	scheduled := listScheduledBeads(townRoot)
 
+	// Why scheduled beads aren't dispatching, as the next cycle would see it
+	// (less the beads it would leave for lack of capacity).
+	var skipped []capacity.SkippedBead
+	// Ready beads boosted for being passed over (scheduler.starvation_cycles).
+	var starved []string
+	var ready []capacity.PendingBead
+	if len(scheduled) > 0 {
+		ready, skipped, _ = getReadySlingContextsWithSkips(townRoot)
+		for _, b := range ready {
+			if state.IsStarved(b.ID) {
+				starved = append(starved, b.WorkBeadID)
+			}
+		}
+		var limitSkips []capacity.SkippedBead
+		ready, _, limitSkips = holdLimitedProviders(townRoot, ready)
+		skipped = append(skipped, limitSkips...)
+	}
+
 	activePolecats := countActivePolecats()
 
+	// A limits snooze holds dispatch without pausing the scheduler.
+	var snoozedUntil time.Time
+	var snoozeReason string
+	// A usage limit holds only the beads for the limited provider.
+	var limited []string
+	var limitedLabels []string
+	if qs, err := quota.NewManager(townRoot).Load(); err == nil {
+		now := time.Now()
+		if until, ok := quota.SnoozedUntil(qs, now); ok {
+			snoozedUntil, snoozeReason = until, qs.Snooze.Reason
+		}
+		acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+		if err != nil {
+			acctCfg = nil
+		}
+		limited = quota.LimitedProviders(qs, acctCfg, now)
+		for _, p := range limited {
+			label := p
+			if reset, ok := quota.ProviderResetAt(qs, acctCfg, p, now); ok {
+				label += " (resets " + humanize.Relative(reset, now) + ")"
+			}
+			limitedLabels = append(limitedLabels, label)
+		}
+	}
+
+	// Effective batch size and spawn delay, which the adaptive throttle
+	// moves between cycles.
+	schedulerCfg := capacity.DefaultSchedulerConfig()
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil && settings.Scheduler != nil {
+		schedulerCfg = settings.Scheduler
+	}
+	batchSize, spawnDelay := schedulerCfg.GetBatchSize(), schedulerCfg.GetSpawnDelay()
+	if schedulerCfg.Adaptive {
+		batchSize, spawnDelay = state.Throttle.Effective(batchSize, spawnDelay)
+	}
+	markStale(scheduled, schedulerCfg.GetMaxAge(), time.Now())
+	_, conflictSkips := capacity.SerializeConflicts(ready, schedulerCfg.ConflictGroups, state.ConflictHolds)
+	skipped = append(skipped, conflictSkips...)
+	// The cap limit-aware dispatch puts on polecats and batch size, and why.
+	limitCap := schedulerLimitCap(townRoot, schedulerCfg, time.Now())
+	if limitCap != nil {
+		batchSize = min(batchSize, limitCap.Cap)
+	}
+
 	if schedulerStatusJSON {
-		out := struct {
-			Paused         bool               `json:"paused"`
-			PausedBy       string             `json:"paused_by,omitempty"`
-			ScheduledTotal int                `json:"queued_total"`
-			ScheduledReady int                `json:"queued_ready"`
-			ActivePolecats int                `json:"active_polecats"`
-			LastDispatchAt string             `json:"last_dispatch_at,omitempty"`
-			Beads          []scheduledBeadInfo `json:"beads"`
-		}{
+		out := schedulerStatusOutput{
 			Paused:         state.Paused,
 			PausedBy:       state.PausedBy,
+			HeldRigs:       state.HeldRigNames(),
 			ScheduledTotal: len(scheduled),
+			ScheduledStale: countStale(scheduled),
+			MaxAge:         schedulerCfg.MaxAge,
+			Starved:        starved,
+			ConflictHolds:  state.ConflictHolds,
 			ActivePolecats: activePolecats,
 			LastDispatchAt: state.LastDispatchAt,
 			Beads:          scheduled,
+			Skipped:        skipped,
+			Adaptive:       schedulerCfg.Adaptive,
+			BatchSize:      batchSize,
+			SpawnDelay:     spawnDelay.String(),
+
+			LimitedProviders: limited,
+			LimitCap:         limitCap,
+		}
+		if schedulerCfg.Adaptive {
+			out.Throttle = state.Throttle
+		}
+		if !snoozedUntil.IsZero() {
+			out.SnoozedUntil = snoozedUntil.Format(time.RFC3339)
 		}
 		for _, b := range scheduled {
 			if !b.Blocked {
@@ -181,8 +359,55 @@ func runSchedulerStatus(cmd *cobra.Command, args []string) error {
 	} else {
 		fmt.Printf("  State:    active\n")
 	}
+	if held := state.HeldRigNames(); len(held) > 0 {
+		fmt.Printf("  Held:     %s\n", style.Warning.Render(strings.Join(held, ", ")))
+	}
+	if !snoozedUntil.IsZero() {
+		fmt.Printf("  Snoozed:  %s\n", style.Warning.Render(snoozeLabel(snoozedUntil, snoozeReason, time.Now())))
+	}
+	if len(limitedLabels) > 0 {
+		fmt.Printf("  Limited:  %s %s\n", style.Warning.Render(strings.Join(limitedLabels, ", ")),
+			style.Dim.Render("— their beads wait; other providers dispatch"))
+	}
 	fmt.Printf("  Scheduled: %d total, %d ready\n", len(scheduled), readyCount)
+	if n := countStale(scheduled); n > 0 {
+		action := "labeled " + capacity.LabelQueueStale
+		if schedulerCfg.CancelStale {
+			action = "cancelled on the next cycle"
+		}
+		fmt.Printf("  Stale:     %s\n", style.Warning.Render(fmt.Sprintf("%d queued longer than %s", n, schedulerCfg.MaxAge))+
+			style.Dim.Render(" — "+action))
+	}
+	if len(starved) > 0 {
+		fmt.Printf("  Starved:   %s\n", style.Warning.Render(strings.Join(starved, ", "))+
+			style.Dim.Render(fmt.Sprintf(" — passed over %d cycles, dispatching first", schedulerCfg.GetStarvationCycles())))
+	}
+	if held := conflictHoldLabels(state); len(held) > 0 {
+		fmt.Printf("  Conflicts: %s\n", strings.Join(held, ", ")+
+			style.Dim.Render(" — other beads in these groups wait"))
+	}
 	fmt.Printf("  Active:    %d polecats\n", activePolecats)
+	if limitCap != nil {
+		fmt.Printf("  Limit cap: %d polecats %s\n", limitCap.Cap, style.Dim.Render("— "+limitCap.Reason))
+	}
+	if len(skipped) > 0 {
+		fmt.Printf("  Skipped:   %s\n", capacity.SummarizeSkips(skipped))
+		for i, sk := range skipped {
+			if i == maxSkipsShown {
+				fmt.Printf("             %s\n", style.Dim.Render(fmt.Sprintf("... and %d more (--json)", len(skipped)-i)))
+				break
+			}
+			fmt.Printf("             %s %s\n", style.Dim.Render("○"), sk)
+		}
+	}
+	throttle := fmt.Sprintf("batch %d, spawn delay %s", batchSize, spawnDelay)
+	if schedulerCfg.Adaptive {
+		throttle += style.Dim.Render(" (adaptive)")
+		if t := state.Throttle; t != nil && t.Reason != "" {
+			throttle += style.Dim.Render(" — " + t.Reason)
+		}
+	}
+	fmt.Printf("  Throttle:  %s\n", throttle)
 	if state.LastDispatchAt != "" {
 		fmt.Printf("  Last dispatch: %s (%d beads)\n", state.LastDispatchAt, state.LastDispatchCount)
 	}
@@ -197,11 +422,25 @@ func runSchedulerList(cmd *cobra.Command, args []string) error {
 	}
 
 	scheduled := listScheduledBeads(townRoot)
+	if len(scheduled) > 0 {
+		if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil {
+			markStale(scheduled, settings.Scheduler.GetMaxAge(), time.Now())
+		}
+		history := loadDurationHistory(townRoot, time.Now())
+		for i := range scheduled {
+			if est, ok := capacity.EstimateDuration(history, scheduled[i].Formula, scheduled[i].Labels); ok {
+				scheduled[i].Estimate = &est
+			}
+		}
+	}
+
+	page, err := scheduledBeadListing.apply(scheduled, schedulerListPage)
+	if err != nil {
+		return err
+	}
 
 	if schedulerListJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(scheduled)
+		return printPageJSON(page, page.Items)
 	}
 
 	if len(scheduled) == 0 {
@@ -210,79 +449,207 @@ func runSchedulerList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Group by rig, rigs in the order they first appear in the sorted page.
+	var rigs []string
 	byRig := make(map[string][]scheduledBeadInfo)
-	for _, b := range scheduled {
+	for _, b := range page.Items {
+		if _, ok := byRig[b.TargetRig]; !ok {
+			rigs = append(rigs, b.TargetRig)
+		}
 		byRig[b.TargetRig] = append(byRig[b.TargetRig], b)
 	}
 
+	// Best-effort: a missing or unreadable state just means no held rigs.
+	state, _ := capacity.LoadState(townRoot)
+
 	fmt.Printf("%s (%d beads)\n\n", style.Bold.Render("Scheduled Work"), len(scheduled))
-	for rig, beads := range byRig {
-		fmt.Printf("  %s (%d):\n", style.Bold.Render(rig), len(beads))
+	for _, rig := range rigs {
+		beads := byRig[rig]
+		if state != nil && state.IsRigHeld(rig) {
+			fmt.Printf("  %s (%d) %s:\n", style.Bold.Render(rig), len(beads), style.Warning.Render("[held]"))
+		} else {
+			fmt.Printf("  %s (%d):\n", style.Bold.Render(rig), len(beads))
+		}
 		for _, b := range beads {
 			indicator := "○"
 			if b.Blocked {
 				indicator = "⏸"
 			}
-			fmt.Printf("    %s %s: %s\n", indicator, b.ID, b.Title)
+			line := fmt.Sprintf("    %s %s: %s", indicator, b.ID, b.Title)
+			if b.Estimate != nil {
+				line += " " + style.Dim.Render("("+b.Estimate.String()+")")
+			}
+			if b.Stale {
+				stale := "[stale]"
+				if age, ok := capacity.QueueAge(b.EnqueuedAt, time.Now()); ok {
+					stale = "[stale: queued " + humanize.Short(age) + "]"
+				}
+				line += " " + style.Warning.Render(stale)
+			}
+			fmt.Println(line)
 		}
 		fmt.Println()
 	}
+	printPageFooter(page)
 
 	return nil
 }
 
 func runSchedulerPause(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return err
 	}
 
-	state, err := capacity.LoadState(townRoot)
+	changed, pausedBy, err := setSchedulerPaused(townRoot, true, detectActor())
 	if err != nil {
-		return fmt.Errorf("loading scheduler state: %w", err)
+		return err
 	}
-
-	if state.Paused {
-		fmt.Printf("%s Scheduler is already paused (by %s)\n", style.Dim.Render("○"), state.PausedBy)
+	if !changed {
+		fmt.Printf("%s Scheduler is already paused (by %s)\n", style.Dim.Render("○"), pausedBy)
 		return nil
 	}
 
-	actor := detectActor()
-	state.SetPaused(actor)
-	if err := capacity.SaveState(townRoot, state); err != nil {
-		return fmt.Errorf("saving scheduler state: %w", err)
-	}
-
 	fmt.Printf("%s Scheduler paused\n", style.Bold.Render("⏸"))
 	return nil
 }
 
 func runSchedulerResume(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return err
 	}
 
-	state, err := capacity.LoadState(townRoot)
+	changed, _, err := setSchedulerPaused(townRoot, false, detectActor())
 	if err != nil {
-		return fmt.Errorf("loading scheduler state: %w", err)
+		return err
 	}
-
-	if !state.Paused {
+	if !changed {
 		fmt.Printf("%s Scheduler is not paused\n", style.Dim.Render("○"))
 		return nil
 	}
 
-	state.SetResumed()
-	if err := capacity.SaveState(townRoot, state); err != nil {
-		return fmt.Errorf("saving scheduler state: %w", err)
+	fmt.Printf("%s Scheduler resumed\n", style.Bold.Render("▶"))
+	return nil
+}
+
+// setSchedulerPaused pauses or resumes the scheduler on behalf of actor,
+// through the daemon when it is running, and records the change in the audit
+// trail. It reports whether the state changed and who holds the pause.
+// Callers check actor's access first.
+func setSchedulerPaused(townRoot string, paused bool, actor string) (changed bool, pausedBy string, err error) {
+	if reply, ok := pauseSchedulerViaDaemon(townRoot, paused, actor); ok {
+		changed, pausedBy = reply.Changed, reply.PausedBy
+	} else {
+		state, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+			if state.Paused == paused {
+				return false, nil
+			}
+			if paused {
+				state.SetPaused(actor)
+			} else {
+				state.SetResumed()
+			}
+			changed = true
+			return true, nil
+		})
+		if err != nil {
+			return false, "", fmt.Errorf("updating scheduler state: %w", err)
+		}
+		pausedBy = state.PausedBy
 	}
+	if changed {
+		_ = events.LogAudit(events.TypeSchedulerPause, actor, events.SchedulerPausePayload(paused))
+	}
+	return changed, pausedBy, nil
+}
 
-	fmt.Printf("%s Scheduler resumed\n", style.Bold.Render("▶"))
+// pauseSchedulerViaDaemon pauses or resumes the scheduler through the
+// daemon's control socket. ok is false when the daemon can't be reached (or
+// the call fails), in which case the caller edits the state file directly.
+func pauseSchedulerViaDaemon(townRoot string, paused bool, actor string) (reply *daemon.PauseReply, ok bool) {
+	c, err := daemon.DialControl(townRoot)
+	if err != nil {
+		return nil, false
+	}
+	defer c.Close()
+	reply, err = c.Pause(paused, actor)
+	if err != nil {
+		return nil, false
+	}
+	return reply, true
+}
+
+func runSchedulerHold(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	rigName := args[0]
+	townRoot, _, err := getRig(rigName)
+	if err != nil {
+		return err
+	}
+
+	actor := detectActor()
+	changed := false
+	state, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		if state.IsRigHeld(rigName) {
+			return false, nil
+		}
+		state.HoldRig(rigName, actor)
+		changed = true
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("updating scheduler state: %w", err)
+	}
+
+	if !changed {
+		fmt.Printf("%s Rig %s is already held (by %s)\n", style.Dim.Render("○"), rigName, state.HeldRigs[rigName])
+		return nil
+	}
+
+	fmt.Printf("%s Dispatch held for rig %s\n", style.Bold.Render("⏸"), rigName)
+	return nil
+}
+
+func runSchedulerRelease(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	rigName := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	released := false
+	if _, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		released = state.ReleaseRig(rigName)
+		return released, nil
+	}); err != nil {
+		return fmt.Errorf("updating scheduler state: %w", err)
+	}
+
+	if !released {
+		fmt.Printf("%s Rig %s is not held\n", style.Dim.Render("○"), rigName)
+		return nil
+	}
+
+	fmt.Printf("%s Dispatch released for rig %s\n", style.Bold.Render("▶"), rigName)
 	return nil
 }
 
 func runSchedulerClear(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return err
@@ -299,7 +666,7 @@ func runSchedulerClear(cmd *cobra.Command, args []string) error {
 			fields := beads.ParseSlingContextFields(ctx.Description)
 			if fields != nil && fields.WorkBeadID == schedulerClearBead {
 				b := beadsForContext(townRoot, fields)
-				if err := b.CloseSlingContext(ctx.ID, "cleared"); err != nil {
+				if err := closeSlingContextForGood(townRoot, b, ctx.ID, fields, "cleared"); err != nil {
 					fmt.Printf("  %s Could not close context %s: %v\n", style.Dim.Render("Warning:"), ctx.ID, err)
 					continue
 				}
@@ -328,7 +695,7 @@ func runSchedulerClear(cmd *cobra.Command, args []string) error {
 	for _, ctx := range allContexts {
 		fields := beads.ParseSlingContextFields(ctx.Description)
 		b := beadsForContext(townRoot, fields)
-		if err := b.CloseSlingContext(ctx.ID, "cleared"); err != nil {
+		if err := closeSlingContextForGood(townRoot, b, ctx.ID, fields, "cleared"); err != nil {
 			fmt.Printf("  %s Could not close context %s: %v\n", style.Dim.Render("Warning:"), ctx.ID, err)
 			continue
 		}
@@ -340,12 +707,47 @@ func runSchedulerClear(cmd *cobra.Command, args []string) error {
 }
 
 func runSchedulerRun(cmd *cobra.Command, args []string) error {
+	// The daemon heartbeat runs this on its own schedule; only people and
+	// agents forcing a dispatch are checked.
+	if !isDaemonDispatch() {
+		if err := requireAccess(cmd, access.ActionDispatch); err != nil {
+			return err
+		}
+	}
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
 		return err
 	}
 
-	_, err = dispatchScheduledWork(townRoot, detectActor(), schedulerRunBatch, schedulerRunDryRun)
+	// Auto-enqueue (scheduler.auto_enqueue): pull matching beads into the
+	// queue before dispatching, so the daemon's heartbeat drives both.
+	// A selective run leaves the rest of the queue alone, so it skips this.
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+	if scfg := settings.Scheduler; scfg.IsDeferred() && scfg.AutoEnqueue && len(scfg.Rules) > 0 && schedulerRunSelect.Empty() {
+		if _, err := autoEnqueueMatchingBeads(townRoot, scfg.Rules, schedulerRunDryRun); err != nil {
+			style.PrintWarning("auto-enqueue skipped: %v", err)
+		}
+	}
+
+	_, err = dispatchScheduledWork(townRoot, detectActor(), schedulerRunBatch, schedulerRunDryRun, schedulerRunSelect)
+
+	// Queue watermarks are evaluated once per heartbeat, after dispatch has
+	// drained what it can.
+	if isDaemonDispatch() && !schedulerRunDryRun && schedulerRunSelect.Empty() && settings.Scheduler.IsDeferred() {
+		checkQueueWatermarks(townRoot, settings.Scheduler)
+	}
+	// Stale beads are checked even with deferred dispatch off, so labels
+	// and leftover contexts are cleaned up after scheduling is disabled.
+	if isDaemonDispatch() && !schedulerRunDryRun && schedulerRunSelect.Empty() {
+		checkStaleQueue(townRoot, settings.Scheduler)
+	}
+	if isDaemonDispatch() && !schedulerRunDryRun && schedulerRunSelect.Empty() &&
+		settings.Scheduler.IsDeferred() && settings.Scheduler.QueueNotes {
+		updateQueueNotes(townRoot, settings.Scheduler, nil)
+	}
 	return err
 }
 
@@ -381,7 +783,7 @@ func listScheduledBeads(townRoot string) []scheduledBeadInfo {
 		}
 
 		// Exclude circuit-broken
-		if fields.DispatchFailures >= maxDispatchFailures {
+		if fields.CircuitBroken(maxDispatchFailures) {
 			continue
 		}
 
@@ -409,6 +811,10 @@ func listScheduledBeads(townRoot string) []scheduledBeadInfo {
 			Status:    status,
 			TargetRig: fields.TargetRig,
 			Blocked:   !readyWorkIDs[fields.WorkBeadID],
+			Formula:   fields.Formula,
+			Labels:    fields.Labels,
+
+			EnqueuedAt: fields.EnqueuedAt,
 		})
 	}
 
@@ -495,20 +901,34 @@ func countActivePolecats() int {
 // A polecat is "working" if its agent bead has a non-null hook_bead.
 // Idle polecats (completed work, hook_bead=null) don't count toward capacity
 // since they're available for re-sling under the persistent polecat model.
+// Polecats paused with --release-slot or waiting in gt ask don't count either.
 func countWorkingPolecats() int {
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
 		return countActivePolecats() // Fallback to total count
 	}
+	return countPolecatSlots(townRoot).Working
+}
 
+// polecatSlots tallies polecat sessions for capacity accounting.
+type polecatSlots struct {
+	Working       int // Hooked and counting toward the cap (see countWorkingPolecats)
+	Paused        int // Paused by gt polecat pause, whether or not they hold a slot
+	PausedHolding int // Paused polecats included in Working
+	NeedsInput    int // Waiting on gt answer; not counted in Working
+}
+
+// countPolecatSlots counts polecat sessions by how they use capacity.
+func countPolecatSlots(townRoot string) polecatSlots {
+	var slots polecatSlots
 	listCmd := tmux.BuildCommand("list-sessions", "-F", "#{session_name}")
 	out, err := listCmd.Output()
 	if err != nil {
-		return 0
+		return slots
 	}
 
 	bd := beads.New(townRoot)
-	count := 0
+	t := tmux.NewTmux()
 	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
 		if line == "" {
 			continue
@@ -517,6 +937,17 @@ func countWorkingPolecats() int {
 		if err != nil || identity.Role != session.RolePolecat {
 			continue
 		}
+		if waiting, _ := t.GetEnvironment(line, polecatNeedsInputEnv); waiting != "" {
+			slots.NeedsInput++
+			continue // Parked by gt ask until answered
+		}
+		paused, _ := t.GetEnvironment(line, polecatPausedEnv)
+		if paused != "" {
+			slots.Paused++
+		}
+		if paused == pauseSlotReleased {
+			continue // Paused with its slot handed back to the scheduler
+		}
 
 		// Check if this polecat has hooked work
 		prefix := identity.Prefix
@@ -536,7 +967,10 @@ func countWorkingPolecats() int {
 		if fields.HookBead == "" {
 			continue // Idle — don't count toward cap
 		}
-		count++
+		slots.Working++
+		if paused != "" {
+			slots.PausedHolding++
+		}
 	}
-	return count
+	return slots
 }
@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	schedulerAuditFix  bool
+	schedulerAuditJSON bool
+)
+
+// Scheduler audit finding kinds. Each is an open sling context that breaks
+// one of the queue's invariants.
+const (
+	auditInvalid         = "invalid"          // Description is not valid context fields
+	auditOrphaned        = "orphaned"         // Work bead not found in any beads dir
+	auditStale           = "stale"            // Work bead already hooked or closed
+	auditDuplicate       = "duplicate"        // Another open context schedules the same work bead
+	auditCircuitBroken   = "circuit-broken"   // Failure count reached the limit but context still open
+	auditFailureMismatch = "failure-mismatch" // Last failure recorded with a zero failure count
+)
+
+// schedulerAuditFinding is one inconsistency found by gt scheduler audit.
+type schedulerAuditFinding struct {
+	Kind       string `json:"kind"`
+	ContextID  string `json:"context_id"`
+	WorkBeadID string `json:"work_bead_id,omitempty"`
+	Detail     string `json:"detail"`
+	Fix        string `json:"fix"`
+	Fixed      bool   `json:"fixed,omitempty"`
+	FixError   string `json:"fix_error,omitempty"`
+
+	fields *capacity.SlingContextFields
+}
+
+var schedulerAuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Check the scheduler queue for inconsistent sling contexts",
+	Long: `Cross-check every open sling context against its work bead and report
+contexts that break the queue's invariants:
+
+  invalid           description is not valid context fields (no metadata)
+  orphaned          work bead not found in any beads dir
+  stale             work bead already hooked or closed, context left open
+  duplicate         another open context schedules the same work bead
+  circuit-broken    dispatch failed too often but the context is still open
+  failure-mismatch  a last failure is recorded but the failure count is 0
+
+The dispatcher skips most of these on its own, but they still show up in
+counts and can shadow a later gt sling of the same bead. With --fix, each
+finding is repaired: mismatched failure fields are rewritten, everything
+else is closed (for duplicates, the oldest context is kept).
+
+Exits non-zero when inconsistencies remain.
+
+Examples:
+  gt scheduler audit
+  gt scheduler audit --fix
+  gt scheduler audit --json`,
+	Args: cobra.NoArgs,
+	RunE: runSchedulerAudit,
+}
+
+func init() {
+	schedulerAuditCmd.Flags().BoolVar(&schedulerAuditFix, "fix", false, "Repair each inconsistency found")
+	schedulerAuditCmd.Flags().BoolVar(&schedulerAuditJSON, "json", false, "Output as JSON")
+	schedulerCmd.AddCommand(schedulerAuditCmd)
+}
+
+func runSchedulerAudit(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	contexts := listAllSlingContexts(townRoot)
+	var workBeadIDs []string
+	for _, ctx := range contexts {
+		if fields := beads.ParseSlingContextFields(ctx.Description); fields != nil && fields.WorkBeadID != "" {
+			workBeadIDs = append(workBeadIDs, fields.WorkBeadID)
+		}
+	}
+	workBeadInfo := batchFetchBeadInfoByIDs(townRoot, workBeadIDs)
+	// If no work bead could be read at all, bd is more likely unavailable
+	// than every bead missing; don't report (or close) them as orphans.
+	checkOrphans := len(workBeadInfo) > 0
+
+	findings := auditSlingContexts(contexts, workBeadInfo, checkOrphans)
+	if schedulerAuditFix {
+		for i := range findings {
+			repairAuditFinding(townRoot, &findings[i])
+		}
+	}
+
+	remaining := 0
+	for _, f := range findings {
+		if !f.Fixed {
+			remaining++
+		}
+	}
+
+	if schedulerAuditJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(findings); err != nil {
+			return err
+		}
+	} else {
+		printAuditFindings(len(contexts), findings, checkOrphans)
+	}
+	if remaining > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// auditSlingContexts checks open sling contexts against their work beads'
+// status and returns the inconsistencies, in context order. workBeadInfo maps
+// work bead ID to status; checkOrphans enables reporting contexts whose work
+// bead is absent from it.
+func auditSlingContexts(contexts []*beads.Issue, workBeadInfo map[string]beadStatusInfo, checkOrphans bool) []schedulerAuditFinding {
+	type parsed struct {
+		ctx    *beads.Issue
+		fields *capacity.SlingContextFields
+	}
+	var valid []parsed
+	var findings []schedulerAuditFinding
+	for _, ctx := range contexts {
+		fields := beads.ParseSlingContextFields(ctx.Description)
+		if fields == nil || fields.WorkBeadID == "" {
+			findings = append(findings, schedulerAuditFinding{
+				Kind:      auditInvalid,
+				ContextID: ctx.ID,
+				Detail:    "description has no work_bead_id",
+				Fix:       "close context",
+			})
+			continue
+		}
+		valid = append(valid, parsed{ctx, fields})
+	}
+
+	// Oldest context first, matching the dispatcher's dedup order.
+	sort.SliceStable(valid, func(i, j int) bool {
+		if valid[i].fields.EnqueuedAt != valid[j].fields.EnqueuedAt {
+			return valid[i].fields.EnqueuedAt < valid[j].fields.EnqueuedAt
+		}
+		return valid[i].ctx.ID < valid[j].ctx.ID
+	})
+
+	kept := make(map[string]string) // work bead ID → context kept
+	for _, p := range valid {
+		f := schedulerAuditFinding{ContextID: p.ctx.ID, WorkBeadID: p.fields.WorkBeadID, fields: p.fields}
+		info, found := workBeadInfo[p.fields.WorkBeadID]
+		switch {
+		case checkOrphans && !found:
+			f.Kind, f.Detail, f.Fix = auditOrphaned, "work bead not found", "close context"
+		case found && (info.Status == "hooked" || info.Status == "closed" || info.Status == "tombstone"):
+			f.Kind, f.Detail, f.Fix = auditStale, "work bead is "+info.Status, "close context"
+		case kept[p.fields.WorkBeadID] != "":
+			f.Kind = auditDuplicate
+			f.Detail = "also scheduled by " + kept[p.fields.WorkBeadID]
+			f.Fix = "close context"
+		case p.fields.CircuitBroken(maxDispatchFailures):
+			f.Kind = auditCircuitBroken
+			f.Detail = fmt.Sprintf("%d dispatch failures (limit %d)", p.fields.DispatchFailures, p.fields.FailureLimit(maxDispatchFailures))
+			f.Fix = "close context"
+		case p.fields.DispatchFailures <= 0 && p.fields.LastFailure != "":
+			kept[p.fields.WorkBeadID] = p.ctx.ID
+			f.Kind = auditFailureMismatch
+			f.Detail = fmt.Sprintf("last_failure set but dispatch_failures is %d", p.fields.DispatchFailures)
+			f.Fix = "clear last_failure"
+		default:
+			kept[p.fields.WorkBeadID] = p.ctx.ID
+			continue
+		}
+		findings = append(findings, f)
+	}
+	return findings
+}
+
+// repairAuditFinding applies a finding's fix and records the outcome on it.
+func repairAuditFinding(townRoot string, f *schedulerAuditFinding) {
+	b := beadsForContext(townRoot, f.fields)
+	var err error
+	if f.Kind == auditFailureMismatch {
+		_, err = b.MutateSlingContextFields(f.ContextID, func(fields *capacity.SlingContextFields) {
+			fields.DispatchFailures = 0
+			fields.LastFailure = ""
+			fields.LastFailureAt = ""
+		})
+	} else {
+		err = b.CloseSlingContext(f.ContextID, "audit-"+f.Kind)
+	}
+	if err != nil {
+		f.FixError = err.Error()
+		return
+	}
+	f.Fixed = true
+}
+
+func printAuditFindings(contexts int, findings []schedulerAuditFinding, checkedOrphans bool) {
+	if !checkedOrphans && contexts > 0 {
+		style.PrintWarning("could not read any work beads; orphan check skipped")
+	}
+	if len(findings) == 0 {
+		fmt.Printf("%s Scheduler queue consistent (%d context(s) checked)\n", style.Bold.Render("✓"), contexts)
+		return
+	}
+
+	for _, f := range findings {
+		icon := style.Warning.Render("⚠")
+		note := style.Dim.Render("fix: " + f.Fix)
+		switch {
+		case f.Fixed:
+			icon = style.Bold.Render("✓")
+			note = style.Dim.Render("fixed: " + f.Fix)
+		case f.FixError != "":
+			icon = style.Error.Render("✗")
+			note = style.Dim.Render("fix failed: " + f.FixError)
+		}
+		work := f.WorkBeadID
+		if work == "" {
+			work = "-"
+		}
+		fmt.Printf("  %s %-16s %s → %s  %s  %s\n", icon, f.Kind, f.ContextID, work, f.Detail, note)
+	}
+	fmt.Printf("\n%d inconsistent context(s) of %d checked\n", len(findings), contexts)
+	if !schedulerAuditFix {
+		fmt.Printf("Run %s to repair them.\n", style.Bold.Render("gt scheduler audit --fix"))
+	}
+}
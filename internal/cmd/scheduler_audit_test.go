@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func auditContext(id string, fields *capacity.SlingContextFields) *beads.Issue {
+	desc := "not json"
+	if fields != nil {
+		desc = beads.FormatSlingContextDescription(fields)
+	}
+	return &beads.Issue{ID: id, Description: desc}
+}
+
+func TestAuditSlingContexts(t *testing.T) {
+	contexts := []*beads.Issue{
+		auditContext("ctx-ok", &capacity.SlingContextFields{WorkBeadID: "gt-ok", EnqueuedAt: "2026-01-01T00:00:00Z"}),
+		auditContext("ctx-bad", nil),
+		auditContext("ctx-orphan", &capacity.SlingContextFields{WorkBeadID: "gt-gone"}),
+		auditContext("ctx-stale", &capacity.SlingContextFields{WorkBeadID: "gt-done"}),
+		// Newer duplicate of gt-ok.
+		auditContext("ctx-dup", &capacity.SlingContextFields{WorkBeadID: "gt-ok", EnqueuedAt: "2026-01-02T00:00:00Z"}),
+		auditContext("ctx-broken", &capacity.SlingContextFields{WorkBeadID: "gt-flaky", DispatchFailures: maxDispatchFailures}),
+		auditContext("ctx-mismatch", &capacity.SlingContextFields{WorkBeadID: "gt-odd", LastFailure: "spawn failed"}),
+	}
+	info := map[string]beadStatusInfo{
+		"gt-ok":    {Status: "open"},
+		"gt-done":  {Status: "closed"},
+		"gt-flaky": {Status: "open"},
+		"gt-odd":   {Status: "open"},
+	}
+
+	got := make(map[string]string)
+	for _, f := range auditSlingContexts(contexts, info, true) {
+		got[f.ContextID] = f.Kind
+	}
+	want := map[string]string{
+		"ctx-bad":      auditInvalid,
+		"ctx-orphan":   auditOrphaned,
+		"ctx-stale":    auditStale,
+		"ctx-dup":      auditDuplicate,
+		"ctx-broken":   auditCircuitBroken,
+		"ctx-mismatch": auditFailureMismatch,
+	}
+	if len(got) != len(want) {
+		t.Errorf("findings = %v, want %v", got, want)
+	}
+	for id, kind := range want {
+		if got[id] != kind {
+			t.Errorf("%s: kind = %q, want %q", id, got[id], kind)
+		}
+	}
+
+	// Without the orphan check, a missing work bead is not a finding.
+	for _, f := range auditSlingContexts(contexts, info, false) {
+		if f.Kind == auditOrphaned {
+			t.Errorf("orphan reported with checkOrphans=false: %+v", f)
+		}
+	}
+}
+
+func TestAuditSlingContexts_Consistent(t *testing.T) {
+	contexts := []*beads.Issue{
+		auditContext("ctx-a", &capacity.SlingContextFields{WorkBeadID: "gt-a"}),
+		auditContext("ctx-b", &capacity.SlingContextFields{WorkBeadID: "gt-b", DispatchFailures: 1, LastFailure: "session"}),
+	}
+	info := map[string]beadStatusInfo{"gt-a": {Status: "open"}, "gt-b": {Status: "in_progress"}}
+	if findings := auditSlingContexts(contexts, info, true); len(findings) != 0 {
+		t.Errorf("expected no findings, got %+v", findings)
+	}
+}
@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var schedulerAutoDryRun bool
+
+var schedulerAutoCmd = &cobra.Command{
+	Use:   "auto",
+	Short: "Schedule unassigned beads that match routing rules",
+	Long: `Run one auto-enqueue pass: schedule every ready, unassigned bead that
+matches a routing rule in settings/config.json.
+
+Rules live under scheduler.rules and are evaluated in order (first match wins).
+A rule matches on labels (all required) and/or a title regex, and chooses the
+target rig, formula, and dispatch priority (0 = highest, default 2):
+
+  "scheduler": {
+    "max_polecats": 4,
+    "auto_enqueue": true,
+    "rules": [
+      {"name": "docs", "labels": ["docs"], "rig": "gastown", "formula": "mol-polecat-work"},
+      {"name": "hotfix", "title_pattern": "^(?i)hotfix", "priority": 0}
+    ]
+  }
+
+With scheduler.auto_enqueue=true the daemon runs this pass on every heartbeat
+before dispatch, so matching beads are pulled into the queue as they appear.
+
+Beads whose sling context was circuit-broken, cleared (gt scheduler clear) or
+expired (scheduler.max_age) are labeled gt:no-auto-enqueue and skipped.
+Remove the label to let the rules pick them up again.
+
+  gt scheduler auto              # Enqueue matching beads now
+  gt scheduler auto --dry-run    # Show which beads would be enqueued`,
+	RunE: runSchedulerAuto,
+}
+
+func init() {
+	schedulerAutoCmd.Flags().BoolVar(&schedulerAutoDryRun, "dry-run", false, "Show what would be enqueued")
+	schedulerCmd.AddCommand(schedulerAutoCmd)
+}
+
+// autoEnqueueSkipTypes are bead types that are never dispatched to polecats.
+var autoEnqueueSkipTypes = map[string]bool{
+	"epic":     true,
+	"convoy":   true,
+	"molecule": true,
+	"message":  true,
+	"agent":    true,
+}
+
+// autoEnqueueCandidate is a ready bead considered for auto-enqueue.
+type autoEnqueueCandidate struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	Assignee  string   `json:"assignee"`
+	IssueType string   `json:"issue_type"`
+	Labels    []string `json:"labels"`
+}
+
+func runSchedulerAuto(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+	if !settings.Scheduler.IsDeferred() {
+		return fmt.Errorf("scheduler is in direct dispatch mode\n  Enable deferred dispatch first: gt config set scheduler.max_polecats N")
+	}
+	if len(settings.Scheduler.Rules) == 0 {
+		fmt.Printf("%s No routing rules configured (scheduler.rules in settings/config.json)\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	n, err := autoEnqueueMatchingBeads(townRoot, settings.Scheduler.Rules, schedulerAutoDryRun)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		fmt.Printf("%s No new beads matched routing rules\n", style.Dim.Render("○"))
+	}
+	return nil
+}
+
+// autoEnqueueMatchingBeads schedules ready, unassigned beads that match a
+// routing rule and are not already scheduled. Returns the number enqueued
+// (or, in dry-run mode, the number that would be).
+func autoEnqueueMatchingBeads(townRoot string, rules []capacity.RoutingRule, dryRun bool) (int, error) {
+	if err := capacity.ValidateRoutingRules(rules); err != nil {
+		return 0, err
+	}
+
+	candidates := listAutoEnqueueCandidates(townRoot)
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+	scheduled := areScheduled(ids)
+
+	enqueued := 0
+	for _, c := range candidates {
+		if scheduled[c.ID] {
+			continue
+		}
+		rule := capacity.MatchRoutingRule(rules, c.Title, c.Labels)
+		if rule == nil {
+			continue
+		}
+
+		rigName := rule.Rig
+		if rigName == "" {
+			rigName = resolveRigForBead(townRoot, c.ID)
+		}
+		if rigName == "" {
+			style.PrintWarning("rule %q matched %s but no target rig could be resolved", rule.Name, c.ID)
+			continue
+		}
+
+		opts := ScheduleOptions{
+			Formula:  resolveFormula(rule.Formula, false, townRoot, rigName),
+			Priority: rule.Priority,
+			DryRun:   dryRun,
+		}
+		if rule.Name != "" {
+			fmt.Printf("%s %s matched rule %q\n", style.Dim.Render("→"), c.ID, rule.Name)
+		}
+		if err := scheduleBead(c.ID, rigName, opts); err != nil {
+			style.PrintWarning("could not auto-enqueue %s: %v", c.ID, err)
+			continue
+		}
+		enqueued++
+	}
+	return enqueued, nil
+}
+
+// listAutoEnqueueCandidates returns ready, open, unassigned work beads across
+// all beads databases in the town.
+func listAutoEnqueueCandidates(townRoot string) []autoEnqueueCandidate {
+	seen := make(map[string]bool)
+	var result []autoEnqueueCandidate
+	for _, dir := range beadsSearchDirs(townRoot) {
+		out, err := beads.New(dir).Run("ready", "--json", "--limit=0")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s Warning: bd ready failed for %s: %v\n",
+				style.Dim.Render("⚠"), dir, err)
+			continue
+		}
+		var ready []autoEnqueueCandidate
+		if err := json.Unmarshal(out, &ready); err != nil {
+			continue
+		}
+		for _, c := range ready {
+			if seen[c.ID] || !isAutoEnqueueCandidate(c) {
+				continue
+			}
+			seen[c.ID] = true
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// isAutoEnqueueCandidate filters out beads that are claimed, not open,
+// infrastructure (sling contexts, epics, agents, ...), or taken out of the
+// queue for good (see closeSlingContextForGood).
+func isAutoEnqueueCandidate(c autoEnqueueCandidate) bool {
+	if c.Assignee != "" || c.Status != "open" || autoEnqueueSkipTypes[c.IssueType] {
+		return false
+	}
+	for _, l := range c.Labels {
+		if l == capacity.LabelSlingContext || l == capacity.LabelNoAutoEnqueue {
+			return false
+		}
+	}
+	return true
+}
+
+// closeSlingContextForGood closes a sling context for a terminal reason
+// (circuit-broken, cleared, expired) and labels its work bead so the
+// auto-enqueue pass does not schedule it again on the next heartbeat.
+func closeSlingContextForGood(townRoot string, b *beads.Beads, contextID string, fields *capacity.SlingContextFields, reason string) error {
+	if err := b.CloseSlingContext(contextID, reason); err != nil {
+		return err
+	}
+	if fields == nil || fields.WorkBeadID == "" {
+		return nil
+	}
+	workID := fields.WorkBeadID
+	if err := workBeads(townRoot, workID).Update(workID, beads.UpdateOptions{AddLabels: []string{capacity.LabelNoAutoEnqueue}}); err != nil {
+		style.PrintWarning("could not label %s %s: %v", workID, capacity.LabelNoAutoEnqueue, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// TestCircuitBrokenBeadIsNotReEnqueued verifies that a bead whose sling
+// context was circuit-broken drops out of the auto-enqueue candidates, so
+// routing rules don't put it straight back in the queue.
+func TestCircuitBrokenBeadIsNotReEnqueued(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd script requires sh")
+	}
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// Mock bd: the work bead is ready and open; once labeled, bd ready
+	// reports the label. Every call is logged.
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "bd.log")
+	script := `#!/bin/sh
+LOG="` + logPath + `"
+echo "$*" >> "$LOG"
+cmd=""
+for arg in "$@"; do
+  case "$arg" in
+    --*) ;;
+    *) cmd="$arg"; break ;;
+  esac
+done
+case "$cmd" in
+  ready)
+    labels='["ui"]'
+    if grep -q -- "--add-label=gt:no-auto-enqueue" "$LOG"; then
+      labels='["ui","gt:no-auto-enqueue"]'
+    fi
+    echo '[{"id":"gt-abc","title":"fix button","status":"open","issue_type":"task","labels":'"$labels"'}]'
+    ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	if got := listAutoEnqueueCandidates(townRoot); len(got) != 1 {
+		t.Fatalf("precondition: candidates = %+v, want gt-abc", got)
+	}
+
+	fields := &capacity.SlingContextFields{WorkBeadID: "gt-abc", DispatchFailures: maxDispatchFailures}
+	b := beads.NewWithBeadsDir(townRoot, filepath.Join(townRoot, ".beads"))
+	if err := closeSlingContextForGood(townRoot, b, "hq-ctx1", fields, "circuit-broken"); err != nil {
+		t.Fatalf("closeSlingContextForGood: %v", err)
+	}
+
+	log, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(log), "close hq-ctx1 --reason=circuit-broken") {
+		t.Errorf("context not closed as circuit-broken:\n%s", log)
+	}
+	if got := listAutoEnqueueCandidates(townRoot); len(got) != 0 {
+		t.Errorf("candidates after circuit break = %+v, want none", got)
+	}
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// predictedPathsKey is the work bead metadata key listing the files its
+// work is expected to touch, as a JSON array or a comma-separated string:
+//
+//	bd update gt-abc --set-metadata=predicted_paths=migrations/0042.sql,db/schema.sql
+const predictedPathsKey = "predicted_paths"
+
+// predictedPaths returns the files a bead's work is predicted to touch, for
+// conflict groups (scheduler.conflict_groups): the bead's predicted_paths
+// metadata, or else the paths its formula declares.
+func predictedPaths(info *beadInfo, formulaName string) []string {
+	if paths := parsePredictedPaths(info.Metadata); len(paths) > 0 {
+		return paths
+	}
+	if formulaName == "" {
+		return nil
+	}
+	f, err := loadPreviewFormula(formulaName)
+	if err != nil {
+		return nil
+	}
+	return f.Paths
+}
+
+// parsePredictedPaths reads predicted_paths from bead metadata. Returns nil
+// when the key is absent or malformed.
+func parsePredictedPaths(metadata json.RawMessage) []string {
+	if len(metadata) == 0 {
+		return nil
+	}
+	var meta map[string]json.RawMessage
+	if err := json.Unmarshal(metadata, &meta); err != nil {
+		return nil
+	}
+	raw, ok := meta[predictedPathsKey]
+	if !ok {
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil
+		}
+		// --set-metadata stores values as strings, a JSON array included.
+		if err := json.Unmarshal([]byte(s), &list); err != nil {
+			list = strings.Split(s, ",")
+		}
+	}
+	var paths []string
+	for _, p := range list {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// serializeConflictGroups leaves queued the beads whose conflict group is
+// held by in-flight work or taken by an earlier bead this cycle. It first
+// releases, in state, the holds of work beads that have finished, reporting
+// whether any were released. A bead has finished once it is no longer hooked
+// or in progress and no MR for it is open: gt done closes the bead when it
+// files the MR, but the conflicting change lands only when the MR merges.
+func serializeConflictGroups(townRoot string, state *capacity.SchedulerState, pending []capacity.PendingBead, groups map[string][]string) ([]capacity.PendingBead, []capacity.SkippedBead, bool) {
+	released := false
+	if holders := state.ConflictHoldBeads(); len(holders) > 0 {
+		// A failed lookup tells nothing: keep the holds rather than let
+		// conflicting work through. Holds of unconfigured groups go anyway.
+		inFlight := func(string) bool { return true }
+		if len(groups) > 0 {
+			if infos := batchFetchBeadInfoByIDs(townRoot, holders); len(infos) > 0 {
+				var mrSources map[string]bool
+				mrLoaded, mrKnown := false, false
+				inFlight = func(bead string) bool {
+					info, ok := infos[bead]
+					return ok && conflictHolderInFlight(info.Status, func() bool {
+						if !mrLoaded {
+							mrSources, mrKnown = openMRSources(townRoot)
+							mrLoaded = true
+						}
+						return !mrKnown || mrSources[bead]
+					})
+				}
+			}
+		}
+		released = len(state.ReleaseConflicts(groups, inFlight)) > 0
+	}
+	kept, skipped := capacity.SerializeConflicts(pending, groups, state.ConflictHolds)
+	return kept, skipped, released
+}
+
+// conflictHolderInFlight reports whether a holding work bead with status has
+// yet to land: it is still being worked, or mrPending says its MR is open
+// (or that the merge queue could not be read).
+func conflictHolderInFlight(status string, mrPending func() bool) bool {
+	if status == "hooked" || status == "in_progress" {
+		return true
+	}
+	return mrPending()
+}
+
+// openMRSources returns the source issues of open merge requests across the
+// town's rigs. ok is false when no rig's merge queue could be listed.
+func openMRSources(townRoot string) (sources map[string]bool, ok bool) {
+	sources = make(map[string]bool)
+	for _, dir := range beadsSearchDirs(townRoot) {
+		mrs, err := beads.New(dir).ListMergeRequests(beads.ListOptions{
+			Status:   "open",
+			Label:    "gt:merge-request",
+			Priority: -1,
+		})
+		if err != nil {
+			continue
+		}
+		ok = true
+		for _, mr := range mrs {
+			if fields := beads.ParseMRFields(mr); fields != nil && fields.SourceIssue != "" {
+				sources[fields.SourceIssue] = true
+			}
+		}
+	}
+	return sources, ok
+}
+
+// conflictHoldLabels describes the held conflict groups for output, e.g.
+// "gastown/migrations (gt-abc)".
+func conflictHoldLabels(state *capacity.SchedulerState) []string {
+	var labels []string
+	for _, k := range slices.Sorted(maps.Keys(state.ConflictHolds)) {
+		labels = append(labels, k+" ("+state.ConflictHolds[k].Bead+")")
+	}
+	return labels
+}
+
+// setConflictGroup sets a conflict group's path globs from a comma-separated
+// list (scheduler.conflict_groups.<name>). An empty list removes the group.
+func setConflictGroup(cfg *capacity.SchedulerConfig, name, value string) error {
+	var globs []string
+	for _, g := range strings.Split(value, ",") {
+		if g = strings.TrimSpace(g); g == "" {
+			continue
+		}
+		if _, err := path.Match(g, ""); err != nil {
+			return fmt.Errorf("bad glob %q: %w", g, err)
+		}
+		globs = append(globs, g)
+	}
+	if len(globs) == 0 {
+		delete(cfg.ConflictGroups, name)
+		if len(cfg.ConflictGroups) == 0 {
+			cfg.ConflictGroups = nil
+		}
+		return nil
+	}
+	if cfg.ConflictGroups == nil {
+		cfg.ConflictGroups = make(map[string][]string)
+	}
+	cfg.ConflictGroups[name] = globs
+	return nil
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestParsePredictedPaths(t *testing.T) {
+	tests := []struct {
+		meta string
+		want []string
+	}{
+		{``, nil},
+		{`{"other": 1}`, nil},
+		{`{"predicted_paths": ["migrations/**", " go.mod ", ""]}`, []string{"migrations/**", "go.mod"}},
+		{`{"predicted_paths": "migrations/001.sql, db/schema.sql"}`, []string{"migrations/001.sql", "db/schema.sql"}},
+		{`{"predicted_paths": "[\"a.proto\", \"b.proto\"]"}`, []string{"a.proto", "b.proto"}},
+		{`{"predicted_paths": 42}`, nil},
+		{`not json`, nil},
+	}
+	for _, tt := range tests {
+		if got := parsePredictedPaths(json.RawMessage(tt.meta)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePredictedPaths(%s) = %v, want %v", tt.meta, got, tt.want)
+		}
+	}
+
+	info := &beadInfo{Metadata: json.RawMessage(`{"predicted_paths": ["api.proto"]}`)}
+	if got := predictedPaths(info, "no-such-formula"); !reflect.DeepEqual(got, []string{"api.proto"}) {
+		t.Errorf("predictedPaths = %v, metadata should win", got)
+	}
+	if got := predictedPaths(&beadInfo{}, "no-such-formula"); got != nil {
+		t.Errorf("predictedPaths with unknown formula = %v, want nil", got)
+	}
+}
+
+func TestSetConflictGroup(t *testing.T) {
+	cfg := &capacity.SchedulerConfig{}
+	if err := setConflictGroup(cfg, "migrations", "migrations/**, db/schema.sql,"); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.ConflictGroups["migrations"]; !reflect.DeepEqual(got, []string{"migrations/**", "db/schema.sql"}) {
+		t.Errorf("migrations = %v", got)
+	}
+	if err := setConflictGroup(cfg, "bad", "[z-a"); err == nil {
+		t.Error("malformed glob should be rejected")
+	}
+	if err := setConflictGroup(cfg, "migrations", ""); err != nil || cfg.ConflictGroups != nil {
+		t.Errorf("empty value should remove the group: %v, %v", cfg.ConflictGroups, err)
+	}
+}
+
+func TestSerializeConflictGroupsReleasesUnconfigured(t *testing.T) {
+	state := &capacity.SchedulerState{ConflictHolds: map[string]capacity.ConflictHold{"old": {Bead: "gt-a"}}}
+	pending := []capacity.PendingBead{{ID: "ctx-b", WorkBeadID: "gt-b"}}
+	kept, skipped, released := serializeConflictGroups(t.TempDir(), state, pending, nil)
+	if len(kept) != 1 || skipped != nil || !released || state.ConflictHolds != nil {
+		t.Errorf("kept = %v, skipped = %v, released = %v, holds = %v", kept, skipped, released, state.ConflictHolds)
+	}
+}
+
+func TestConflictHolderInFlight(t *testing.T) {
+	mrOpen := func() bool { return true }
+	mrDone := func() bool { return false }
+	tests := []struct {
+		status    string
+		mrPending func() bool
+		want      bool
+	}{
+		{"hooked", mrDone, true},
+		{"in_progress", mrDone, true},
+		{"closed", mrOpen, true}, // gt done filed the MR; it has not merged yet
+		{"closed", mrDone, false},
+		{"open", mrDone, false}, // Released without an MR
+	}
+	for _, tt := range tests {
+		if got := conflictHolderInFlight(tt.status, tt.mrPending); got != tt.want {
+			t.Errorf("conflictHolderInFlight(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
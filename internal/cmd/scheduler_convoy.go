@@ -12,11 +12,12 @@ import (
 
 // convoyScheduleOpts holds options for convoy schedule operations.
 type convoyScheduleOpts struct {
-	Formula     string
+	Formula     string // Explicit --formula; empty = each bead's rig default
 	HookRawBead bool
 	Force       bool
 	DryRun      bool
 	NoBoot      bool
+	Yes         bool // Skip the bulk confirmation (confirm.enqueue_beads)
 }
 
 // runConvoyScheduleByID schedules all open tracked issues of a convoy.
@@ -97,18 +98,12 @@ func runConvoyScheduleByID(convoyID string, opts convoyScheduleOpts) error {
 		return nil
 	}
 
-	formula := opts.Formula
-
 	if opts.DryRun {
 		fmt.Printf("%s Would schedule %d issue(s) from convoy %s:\n",
 			style.Bold.Render("DRY-RUN"), len(candidates), convoyID)
-		if formula != "" {
-			fmt.Printf("  Formula: %s\n", formula)
-		} else {
-			fmt.Printf("  Hook raw beads (no formula)\n")
-		}
 		for _, c := range candidates {
-			fmt.Printf("  Would schedule: %s -> %s (%s)\n", c.ID, c.RigName, c.Title)
+			fmt.Printf("  Would schedule: %s -> %s (%s) [%s]\n", c.ID, c.RigName, c.Title,
+				formulaLabel(resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName)))
 		}
 		if skippedClosed > 0 || skippedAssigned > 0 || skippedScheduled > 0 || skippedNoRig > 0 {
 			fmt.Printf("\nSkipped: %d closed, %d assigned, %d already scheduled, %d no rig\n",
@@ -117,13 +112,24 @@ func runConvoyScheduleByID(convoyID string, opts convoyScheduleOpts) error {
 		return nil
 	}
 
+	candidateIDs := make([]string, len(candidates))
+	for i, c := range candidates {
+		candidateIDs[i] = c.ID
+	}
+	if ok, err := confirmEnqueueBeads("schedule", candidateIDs, opts.Yes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Schedule canceled.")
+		return nil
+	}
+
 	fmt.Printf("%s Scheduling %d issue(s) from convoy %s...\n",
 		style.Bold.Render("📋"), len(candidates), convoyID)
 
 	successCount := 0
 	for _, c := range candidates {
 		err := scheduleBead(c.ID, c.RigName, ScheduleOptions{
-			Formula:     formula,
+			Formula:     resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName),
 			NoConvoy:    true, // Already tracked by this convoy
 			Force:       opts.Force,
 			HookRawBead: opts.HookRawBead,
@@ -212,13 +218,12 @@ func runConvoySlingByID(convoyID string, opts convoyScheduleOpts) error {
 		return nil
 	}
 
-	formula := opts.Formula
-
 	if opts.DryRun {
 		fmt.Printf("%s Would dispatch %d issue(s) from convoy %s:\n",
 			style.Bold.Render("DRY-RUN"), len(candidates), convoyID)
 		for _, c := range candidates {
-			fmt.Printf("  Would dispatch: %s -> %s (%s)\n", c.ID, c.RigName, c.Title)
+			fmt.Printf("  Would dispatch: %s -> %s (%s) [%s]\n", c.ID, c.RigName, c.Title,
+				formulaLabel(resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName)))
 		}
 		if skippedClosed > 0 || skippedAssigned > 0 || skippedNoRig > 0 {
 			fmt.Printf("\nSkipped: %d closed, %d assigned, %d no rig\n",
@@ -227,6 +232,17 @@ func runConvoySlingByID(convoyID string, opts convoyScheduleOpts) error {
 		return nil
 	}
 
+	candidateIDs := make([]string, len(candidates))
+	for i, c := range candidates {
+		candidateIDs[i] = c.ID
+	}
+	if ok, err := confirmEnqueueBeads("sling", candidateIDs, opts.Yes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Sling canceled.")
+		return nil
+	}
+
 	fmt.Printf("%s Dispatching %d issue(s) from convoy %s...\n",
 		style.Bold.Render("▶"), len(candidates), convoyID)
 
@@ -242,7 +258,7 @@ func runConvoySlingByID(convoyID string, opts convoyScheduleOpts) error {
 		_, err := executeSling(SlingParams{
 			BeadID:        c.ID,
 			RigName:       c.RigName,
-			FormulaName:   formula,
+			FormulaName:   resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName),
 			Force:         opts.Force,
 			HookRawBead:   opts.HookRawBead,
 			NoConvoy:      true, // Already tracked by this convoy
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -16,11 +15,12 @@ import (
 
 // epicScheduleOpts holds options for epic schedule operations.
 type epicScheduleOpts struct {
-	Formula     string
+	Formula     string // Explicit --formula; empty = each bead's rig default
 	HookRawBead bool
 	Force       bool
 	DryRun      bool
 	NoBoot      bool
+	Yes         bool // Skip the bulk confirmation (confirm.enqueue_beads)
 }
 
 // runEpicScheduleByID schedules all open children of an epic.
@@ -100,18 +100,12 @@ func runEpicScheduleByID(epicID string, opts epicScheduleOpts) error {
 		return nil
 	}
 
-	formula := opts.Formula
-
 	if opts.DryRun {
 		fmt.Printf("%s Would schedule %d child(ren) from epic %s:\n",
 			style.Bold.Render("DRY-RUN"), len(candidates), epicID)
-		if formula != "" {
-			fmt.Printf("  Formula: %s\n", formula)
-		} else {
-			fmt.Printf("  Hook raw beads (no formula)\n")
-		}
 		for _, c := range candidates {
-			fmt.Printf("  Would schedule: %s -> %s (%s)\n", c.ID, c.RigName, c.Title)
+			fmt.Printf("  Would schedule: %s -> %s (%s) [%s]\n", c.ID, c.RigName, c.Title,
+				formulaLabel(resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName)))
 		}
 		if skippedClosed > 0 || skippedAssigned > 0 || skippedScheduled > 0 || skippedNoRig > 0 {
 			fmt.Printf("\nSkipped: %d closed, %d assigned, %d already scheduled, %d no rig\n",
@@ -120,13 +114,24 @@ func runEpicScheduleByID(epicID string, opts epicScheduleOpts) error {
 		return nil
 	}
 
+	candidateIDs := make([]string, len(candidates))
+	for i, c := range candidates {
+		candidateIDs[i] = c.ID
+	}
+	if ok, err := confirmEnqueueBeads("schedule", candidateIDs, opts.Yes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Schedule canceled.")
+		return nil
+	}
+
 	fmt.Printf("%s Scheduling %d child(ren) from epic %s...\n",
 		style.Bold.Render("📋"), len(candidates), epicID)
 
 	successCount := 0
 	for _, c := range candidates {
 		err := scheduleBead(c.ID, c.RigName, ScheduleOptions{
-			Formula:     formula,
+			Formula:     resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName),
 			Force:       opts.Force,
 			HookRawBead: opts.HookRawBead,
 			NoConvoy:    true, // Epic is the organizing structure
@@ -214,13 +219,12 @@ func runEpicSlingByID(epicID string, opts epicScheduleOpts) error {
 		return nil
 	}
 
-	formula := opts.Formula
-
 	if opts.DryRun {
 		fmt.Printf("%s Would dispatch %d child(ren) from epic %s:\n",
 			style.Bold.Render("DRY-RUN"), len(candidates), epicID)
 		for _, c := range candidates {
-			fmt.Printf("  Would dispatch: %s -> %s (%s)\n", c.ID, c.RigName, c.Title)
+			fmt.Printf("  Would dispatch: %s -> %s (%s) [%s]\n", c.ID, c.RigName, c.Title,
+				formulaLabel(resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName)))
 		}
 		if skippedClosed > 0 || skippedAssigned > 0 || skippedNoRig > 0 {
 			fmt.Printf("\nSkipped: %d closed, %d assigned, %d no rig\n",
@@ -229,6 +233,17 @@ func runEpicSlingByID(epicID string, opts epicScheduleOpts) error {
 		return nil
 	}
 
+	candidateIDs := make([]string, len(candidates))
+	for i, c := range candidates {
+		candidateIDs[i] = c.ID
+	}
+	if ok, err := confirmEnqueueBeads("sling", candidateIDs, opts.Yes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Sling canceled.")
+		return nil
+	}
+
 	fmt.Printf("%s Dispatching %d child(ren) from epic %s...\n",
 		style.Bold.Render("▶"), len(candidates), epicID)
 
@@ -244,7 +259,7 @@ func runEpicSlingByID(epicID string, opts epicScheduleOpts) error {
 		_, err := executeSling(SlingParams{
 			BeadID:        c.ID,
 			RigName:       c.RigName,
-			FormulaName:   formula,
+			FormulaName:   resolveFormula(opts.Formula, opts.HookRawBead, townRoot, c.RigName),
 			Force:         opts.Force,
 			HookRawBead:   opts.HookRawBead,
 			NoConvoy:      true, // Epic is the organizing structure
@@ -354,15 +369,10 @@ func getEpicChildren(epicID string) ([]epicChild, error) {
 func bdDepListFallback(dir, epicID string) ([]string, error) {
 	depArgs := beads.MaybePrependAllowStale([]string{"dep", "list", epicID,
 		"--direction=down", "--type=depends_on", "--json"})
-	depCmd := exec.Command("bd", depArgs...)
-	depCmd.Dir = dir
-	var stdout bytes.Buffer
-	depCmd.Stdout = &stdout
-
 	var stderr bytes.Buffer
-	depCmd.Stderr = &stderr
-	if err := depCmd.Run(); err != nil {
-		if stdout.Len() == 0 && stderr.Len() == 0 {
+	stdout, err := BdCmd(depArgs...).Dir(dir).Stderr(&stderr).Output()
+	if err != nil {
+		if len(stdout) == 0 && stderr.Len() == 0 {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("bd dep list %s: %w (stderr: %s)", epicID, err, strings.TrimSpace(stderr.String()))
@@ -371,7 +381,7 @@ func bdDepListFallback(dir, epicID string) ([]string, error) {
 	var deps []struct {
 		ID string `json:"id"`
 	}
-	if err := json.Unmarshal(stdout.Bytes(), &deps); err != nil {
+	if err := json.Unmarshal(stdout, &deps); err != nil {
 		return nil, fmt.Errorf("parsing dependency list: %w", err)
 	}
 
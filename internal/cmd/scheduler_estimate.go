@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// estimateWindow bounds how far back duration history is read. Older runs
+// say little about today's codebase and agents.
+const estimateWindow = 30 * 24 * time.Hour
+
+var (
+	schedulerEstimateFormula string
+	schedulerEstimateJSON    bool
+)
+
+var schedulerEstimateCmd = &cobra.Command{
+	Use:   "estimate <bead-id>",
+	Short: "Estimate how long a bead will take",
+	Long: `Estimate how long a bead will take once dispatched, from the durations
+of similar past runs.
+
+A run is the time from scheduler dispatch to gt done, read from the last 30
+days of the town event log. The estimate is the median of runs with the same
+formula and labels, falling back to runs with the same formula, then to all
+runs, whichever first has at least 3 samples. Internal gt: labels are ignored.
+
+For a scheduled bead the formula and labels come from its sling context;
+otherwise from the bead itself, with --formula naming the formula it would be
+slung with.
+
+The same estimate is shown when a bead is scheduled and in gt scheduler list.
+
+Examples:
+  gt scheduler estimate gt-abc
+  gt scheduler estimate gt-abc --formula shiny
+  gt scheduler estimate gt-abc --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSchedulerEstimate,
+}
+
+func init() {
+	schedulerEstimateCmd.Flags().StringVar(&schedulerEstimateFormula, "formula", "", "Formula the bead would be slung with (unscheduled beads)")
+	schedulerEstimateCmd.Flags().BoolVar(&schedulerEstimateJSON, "json", false, "Output as JSON")
+	schedulerCmd.AddCommand(schedulerEstimateCmd)
+}
+
+// schedulerEstimateOutput is the --json output of gt scheduler estimate.
+// Estimate is null when there is too little history to go on.
+type schedulerEstimateOutput struct {
+	Bead     string             `json:"bead"`
+	Formula  string             `json:"formula,omitempty"`
+	Estimate *capacity.Estimate `json:"estimate"`
+}
+
+func runSchedulerEstimate(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	beadID := args[0]
+
+	formula := schedulerEstimateFormula
+	var labels []string
+	if fields := findSlingContextFields(townRoot, beadID); fields != nil && !cmd.Flags().Changed("formula") {
+		formula = fields.Formula
+		labels = fields.Labels
+	} else {
+		info, err := getBeadInfo(beadID)
+		if err != nil {
+			return fmt.Errorf("bead '%s' not found", beadID)
+		}
+		labels = info.Labels
+	}
+
+	est, ok := capacity.EstimateDuration(loadDurationHistory(townRoot, time.Now()), formula, labels)
+
+	if schedulerEstimateJSON {
+		out := schedulerEstimateOutput{Bead: beadID, Formula: formula}
+		if ok {
+			out.Estimate = &est
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+
+	if !ok {
+		fmt.Printf("%s Not enough history to estimate %s (need %d completed runs)\n",
+			style.Dim.Render("○"), beadID, capacity.MinEstimateSamples)
+		return nil
+	}
+	fmt.Printf("%s %s: %s\n", style.Bold.Render("⏱"), beadID, est)
+	fmt.Printf("  Median of %d %s runs (p90 %s)\n", est.Samples, estimateBasisDesc(est), capacity.FormatEstimate(est.P90))
+	return nil
+}
+
+// estimateBasisDesc describes which runs an estimate was drawn from.
+func estimateBasisDesc(est capacity.Estimate) string {
+	switch est.Basis {
+	case "formula+labels":
+		return "similar"
+	case "formula":
+		return "same-formula"
+	default:
+		return "recent"
+	}
+}
+
+// findSlingContextFields returns the sling context fields of a scheduled
+// work bead, or nil if it is not scheduled.
+func findSlingContextFields(townRoot, beadID string) *capacity.SlingContextFields {
+	for _, ctx := range listAllSlingContexts(townRoot) {
+		fields := beads.ParseSlingContextFields(ctx.Description)
+		if fields != nil && fields.WorkBeadID == beadID {
+			return fields
+		}
+	}
+	return nil
+}
+
+// schedulerDispatchPayload is the scheduler_dispatch event payload for b.
+// It records the formula and labels the bead was scheduled with, so the
+// run can later be matched to similar beads for duration estimates.
+func schedulerDispatchPayload(b capacity.PendingBead, polecat string) map[string]interface{} {
+	p := events.SchedulerDispatchPayload(b.WorkBeadID, b.TargetRig, polecat)
+	if f := b.Context; f != nil {
+		if f.Formula != "" {
+			p["formula"] = f.Formula
+		}
+		if len(f.Labels) > 0 {
+			p["labels"] = f.Labels
+		}
+		p = events.WithCorrelation(p, f.CorrelationID)
+	}
+	return p
+}
+
+// loadDurationHistory pairs each scheduler dispatch in the last
+// estimateWindow with the bead's next gt done, yielding one duration sample
+// per completed run. Best-effort: an unreadable log yields no history.
+func loadDurationHistory(townRoot string, now time.Time) []capacity.DurationSample {
+	type dispatch struct {
+		at      time.Time
+		formula string
+		labels  []string
+	}
+	open := make(map[string]dispatch)
+	var history []capacity.DurationSample
+
+	_ = events.ReadRange(townRoot, now.Add(-estimateWindow), time.Time{}, func(e events.Event) bool {
+		beadID, _ := e.Payload["bead"].(string)
+		if beadID == "" {
+			return true
+		}
+		ts, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil {
+			return true
+		}
+		switch e.Type {
+		case events.TypeSchedulerDispatch:
+			formula, _ := e.Payload["formula"].(string)
+			var labels []string
+			if raw, ok := e.Payload["labels"].([]interface{}); ok {
+				for _, l := range raw {
+					if s, ok := l.(string); ok {
+						labels = append(labels, s)
+					}
+				}
+			}
+			open[beadID] = dispatch{at: ts, formula: formula, labels: labels}
+		case events.TypeDone:
+			d, ok := open[beadID]
+			if !ok {
+				return true
+			}
+			delete(open, beadID)
+			if dur := ts.Sub(d.at); dur > 0 {
+				history = append(history, capacity.DurationSample{Formula: d.formula, Labels: d.labels, Duration: dur})
+			}
+		}
+		return true
+	})
+	return history
+}
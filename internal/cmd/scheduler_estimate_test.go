@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestLoadDurationHistory(t *testing.T) {
+	town := t.TempDir()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(ago time.Duration) string { return now.Add(-ago).Format(time.RFC3339) }
+
+	var lines []byte
+	for _, e := range []events.Event{
+		// Too old: outside the estimate window.
+		{Timestamp: at(40 * 24 * time.Hour), Type: events.TypeSchedulerDispatch, Payload: map[string]interface{}{"bead": "gt-old"}},
+		{Timestamp: at(39 * 24 * time.Hour), Type: events.TypeDone, Payload: map[string]interface{}{"bead": "gt-old"}},
+		// A completed run with formula and labels.
+		{Timestamp: at(3 * time.Hour), Type: events.TypeSchedulerDispatch, Payload: map[string]interface{}{
+			"bead": "gt-a", "formula": "shiny", "labels": []string{"docs"}}},
+		{Timestamp: at(2 * time.Hour), Type: events.TypeDone, Payload: map[string]interface{}{"bead": "gt-a"}},
+		// Redispatched: the later dispatch starts the run.
+		{Timestamp: at(5 * time.Hour), Type: events.TypeSchedulerDispatch, Payload: map[string]interface{}{"bead": "gt-b"}},
+		{Timestamp: at(90 * time.Minute), Type: events.TypeSchedulerDispatch, Payload: map[string]interface{}{"bead": "gt-b"}},
+		{Timestamp: at(60 * time.Minute), Type: events.TypeDone, Payload: map[string]interface{}{"bead": "gt-b"}},
+		// Still running, and a done that was never scheduled.
+		{Timestamp: at(30 * time.Minute), Type: events.TypeSchedulerDispatch, Payload: map[string]interface{}{"bead": "gt-c"}},
+		{Timestamp: at(10 * time.Minute), Type: events.TypeDone, Payload: map[string]interface{}{"bead": "gt-d"}},
+	} {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(append(lines, data...), '\n')
+	}
+	if err := os.MkdirAll(filepath.Dir(filepath.Join(town, events.EventsFile)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(town, events.EventsFile), lines, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	history := loadDurationHistory(town, now)
+	if len(history) != 2 {
+		t.Fatalf("got %d samples, want 2: %+v", len(history), history)
+	}
+	a, b := history[0], history[1]
+	if a.Formula != "shiny" || len(a.Labels) != 1 || a.Labels[0] != "docs" || a.Duration != time.Hour {
+		t.Errorf("gt-a sample = %+v", a)
+	}
+	if b.Formula != "" || b.Duration != 30*time.Minute {
+		t.Errorf("gt-b sample = %+v", b)
+	}
+}
+
+func TestSchedulerDispatchPayload(t *testing.T) {
+	b := capacity.PendingBead{
+		WorkBeadID: "gt-a",
+		TargetRig:  "gastown",
+		Context:    &capacity.SlingContextFields{Formula: "shiny", Labels: []string{"docs"}},
+	}
+	p := schedulerDispatchPayload(b, "Toast")
+	if p["bead"] != "gt-a" || p["polecat"] != "Toast" || p["formula"] != "shiny" {
+		t.Errorf("payload = %v", p)
+	}
+
+	p = schedulerDispatchPayload(capacity.PendingBead{WorkBeadID: "gt-b"}, "")
+	if _, ok := p["formula"]; ok {
+		t.Errorf("payload without context has formula: %v", p)
+	}
+}
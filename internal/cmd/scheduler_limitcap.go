@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// schedulerLimitCap returns the concurrency cap scheduler.limit_cap learns
+// from the polecats running at recent limit hits, or nil when it is off or
+// the history is too thin to go on.
+func schedulerLimitCap(townRoot string, schedulerCfg *capacity.SchedulerConfig, now time.Time) *capacity.LimitCap {
+	level := schedulerCfg.GetLimitCap()
+	if level == capacity.LimitCapOff {
+		return nil
+	}
+	history, err := quota.NewManager(townRoot).LoadHistory(now.AddDate(0, 0, -capacity.LimitCapLookbackDays))
+	if err != nil {
+		return nil
+	}
+	hits := make([]capacity.LimitHit, len(history))
+	for i, h := range history {
+		hits[i] = capacity.LimitHit{Concurrent: h.Concurrent, Capped: h.Capped}
+	}
+	c, ok := capacity.ComputeLimitCap(hits, level)
+	if !ok {
+		return nil
+	}
+	return &c
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestSchedulerLimitCap(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Now()
+	cfg := &capacity.SchedulerConfig{LimitCap: capacity.LimitCapLow}
+	if c := schedulerLimitCap(townRoot, cfg, now); c != nil {
+		t.Fatalf("no history: cap = %+v, want nil", c)
+	}
+
+	mgr := quota.NewManager(townRoot)
+	if err := mgr.AppendHistory(
+		quota.HistoryEntry{At: now.AddDate(0, 0, -30), Account: "a", Concurrent: 2}, // Out of the window
+		quota.HistoryEntry{At: now.Add(-3 * time.Hour), Account: "a", Concurrent: 6},
+		quota.HistoryEntry{At: now.Add(-2 * time.Hour), Account: "b", Concurrent: 5},
+		quota.HistoryEntry{At: now.Add(-time.Hour), Account: "a", Concurrent: 7},
+	); err != nil {
+		t.Fatal(err)
+	}
+	c := schedulerLimitCap(townRoot, cfg, now)
+	if c == nil || c.Threshold != 6 || c.Cap != 5 {
+		t.Fatalf("cap = %+v, want threshold 6, cap 5", c)
+	}
+	if c := schedulerLimitCap(townRoot, &capacity.SchedulerConfig{}, now); c != nil {
+		t.Errorf("limit_cap off: cap = %+v, want nil", c)
+	}
+}
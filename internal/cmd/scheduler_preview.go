@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/formula"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var schedulerPreviewJSON bool
+
+var schedulerPreviewCmd = &cobra.Command{
+	Use:   "preview <bead-id>",
+	Short: "Show the rendered formula a scheduled bead will be dispatched with",
+	Long: `Render the formula for a scheduled bead with the same variables dispatch
+will use, and show the effective instructions the polecat will receive.
+
+Variables are assembled the way dispatch assembles them: rig command defaults,
+then --var values stored at schedule time, then feature/issue from the bead.
+Placeholders that remain unresolved and vars the formula never references are
+reported, so typos are caught before an agent spends an hour on bad input.
+
+  gt scheduler preview gt-abc          # Rendered steps + var warnings
+  gt scheduler preview gt-abc --json   # Machine-readable plan`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSchedulerPreview,
+}
+
+func init() {
+	schedulerPreviewCmd.Flags().BoolVar(&schedulerPreviewJSON, "json", false, "Output as JSON")
+	schedulerCmd.AddCommand(schedulerPreviewCmd)
+}
+
+// previewStep is one formula step after variable substitution.
+type previewStep struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// dispatchPreview is the effective prompt plan for a scheduled bead.
+type dispatchPreview struct {
+	BeadID     string            `json:"bead_id"`
+	TargetRig  string            `json:"target_rig"`
+	Formula    string            `json:"formula,omitempty"`
+	Vars       map[string]string `json:"vars,omitempty"`
+	Steps      []previewStep     `json:"steps,omitempty"`
+	Unresolved []string          `json:"unresolved,omitempty"`
+	Unused     []string          `json:"unused,omitempty"`
+}
+
+func runSchedulerPreview(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	_, fields := findSlingContextForBead(townRoot, beadID)
+	if fields == nil {
+		return fmt.Errorf("bead %s is not scheduled (no open sling context)", beadID)
+	}
+
+	title := beadID
+	if info, ok := batchFetchBeadInfoByIDs(townRoot, []string{beadID})[beadID]; ok && info.Title != "" {
+		title = info.Title
+	}
+
+	preview := &dispatchPreview{
+		BeadID:    beadID,
+		TargetRig: fields.TargetRig,
+		Formula:   fields.Formula,
+	}
+
+	if fields.Formula != "" {
+		f, err := loadPreviewFormula(fields.Formula)
+		if err != nil {
+			return err
+		}
+		applyFormulaOverlays(f, fields.Formula, townRoot, fields.TargetRig)
+
+		vars := buildDispatchVars(townRoot, fields, title)
+		userVars := capacity.ReconstructFromContext(fields).Vars
+		renderDispatchPreview(preview, f, vars, userVars)
+	}
+
+	if schedulerPreviewJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(preview)
+	}
+
+	printDispatchPreview(preview)
+	return nil
+}
+
+// findSlingContextForBead returns the open sling context for a work bead.
+// When duplicates exist, the oldest context wins (mirrors getReadySlingContexts).
+// Returns (nil, nil) if the bead is not scheduled.
+func findSlingContextForBead(townRoot, beadID string) (*beads.Issue, *capacity.SlingContextFields) {
+	var bestCtx *beads.Issue
+	var bestFields *capacity.SlingContextFields
+	for _, ctx := range listAllSlingContexts(townRoot) {
+		fields := beads.ParseSlingContextFields(ctx.Description)
+		if fields == nil || fields.WorkBeadID != beadID {
+			continue
+		}
+		if bestFields == nil || fields.EnqueuedAt < bestFields.EnqueuedAt {
+			bestCtx, bestFields = ctx, fields
+		}
+	}
+	return bestCtx, bestFields
+}
+
+// loadPreviewFormula loads a formula by name, preferring the embedded copy
+// (what prime renders for polecats) and falling back to on-disk formulas.
+func loadPreviewFormula(name string) (*formula.Formula, error) {
+	if content, err := formula.GetEmbeddedFormulaContent(name); err == nil {
+		f, err := formula.Parse(content)
+		if err != nil {
+			return nil, fmt.Errorf("parsing formula %s: %w", name, err)
+		}
+		return f, nil
+	}
+	path, err := findFormulaFile(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := parseFormulaFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("parsing formula %s: %w", name, err)
+	}
+	return f, nil
+}
+
+// buildDispatchVars assembles formula vars in the same order dispatch does:
+// rig command defaults, then scheduled --var values, then base_branch, then
+// the feature/issue vars and required defaults added at instantiation.
+func buildDispatchVars(townRoot string, fields *capacity.SlingContextFields, title string) []string {
+	dp := capacity.ReconstructFromContext(fields)
+	vars := loadRigCommandVars(townRoot, fields.TargetRig)
+	vars = append(vars, dp.Vars...)
+	if dp.BaseBranch != "" && dp.BaseBranch != "main" {
+		vars = append(vars, fmt.Sprintf("base_branch=%s", dp.BaseBranch))
+	}
+	vars = append([]string{"feature=" + title, "issue=" + dp.BeadID}, vars...)
+	return ensureFormulaRequiredVars(fields.Formula, vars)
+}
+
+// renderDispatchPreview substitutes vars into the formula steps and records
+// unresolved placeholders. userVars (the --var values given at schedule time)
+// that the formula neither declares nor references are reported as unused.
+func renderDispatchPreview(p *dispatchPreview, f *formula.Formula, vars, userVars []string) {
+	varMap := buildFormulaVarMap(f, vars)
+	p.Vars = varMap
+
+	var raw strings.Builder
+	raw.WriteString(f.Description)
+	unresolved := make(map[string]bool)
+	for _, step := range f.Steps {
+		raw.WriteString("\n" + step.Title + "\n" + step.Description)
+		rs := previewStep{
+			ID:          step.ID,
+			Title:       applyFormulaVars(step.Title, varMap),
+			Description: applyFormulaVars(step.Description, varMap),
+		}
+		for _, name := range formula.ExtractTemplateVariables(rs.Title + "\n" + rs.Description) {
+			unresolved[name] = true
+		}
+		p.Steps = append(p.Steps, rs)
+	}
+	for name := range unresolved {
+		p.Unresolved = append(p.Unresolved, name)
+	}
+	sort.Strings(p.Unresolved)
+
+	referenced := make(map[string]bool)
+	for _, name := range formula.ExtractTemplateVariables(raw.String()) {
+		referenced[name] = true
+	}
+	for _, kv := range userVars {
+		name, _, ok := strings.Cut(kv, "=")
+		if !ok || referenced[name] {
+			continue
+		}
+		if _, declared := f.Vars[name]; declared {
+			continue
+		}
+		p.Unused = append(p.Unused, name)
+	}
+	sort.Strings(p.Unused)
+}
+
+// printDispatchPreview renders a dispatch preview for humans.
+func printDispatchPreview(p *dispatchPreview) {
+	fmt.Printf("%s %s → %s\n\n", style.Bold.Render("Dispatch preview:"), p.BeadID, p.TargetRig)
+	if p.Formula == "" {
+		fmt.Println("  No formula — the raw bead will be hooked as-is.")
+		return
+	}
+	fmt.Printf("  Formula: %s\n", p.Formula)
+
+	if len(p.Vars) > 0 {
+		names := make([]string, 0, len(p.Vars))
+		for name := range p.Vars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Printf("  Vars:\n")
+		for _, name := range names {
+			fmt.Printf("    %s=%s\n", name, p.Vars[name])
+		}
+	}
+
+	for i, step := range p.Steps {
+		fmt.Printf("\n### Step %d: %s\n\n", i+1, step.Title)
+		if step.Description != "" {
+			fmt.Println(step.Description)
+		}
+	}
+
+	if len(p.Unresolved) > 0 || len(p.Unused) > 0 {
+		fmt.Println()
+	}
+	if len(p.Unresolved) > 0 {
+		fmt.Printf("%s Unresolved placeholders: %s\n",
+			style.Warning.Render("⚠"), strings.Join(p.Unresolved, ", "))
+	}
+	if len(p.Unused) > 0 {
+		fmt.Printf("%s Vars not used by %s (typo?): %s\n",
+			style.Warning.Render("⚠"), p.Formula, strings.Join(p.Unused, ", "))
+	}
+}
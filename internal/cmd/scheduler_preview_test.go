@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/formula"
+)
+
+const previewTestFormula = `
+formula = "preview-test"
+type = "workflow"
+
+[vars.depth]
+description = "How deep to look"
+default = "shallow"
+
+[[steps]]
+id = "load"
+title = "Load {{issue}}"
+description = "Work on {{feature}} at {{depth}} depth."
+
+[[steps]]
+id = "check"
+title = "Check"
+description = "Compare against {{typo_var}}."
+needs = ["load"]
+`
+
+// TestRenderDispatchPreview verifies substitution, unresolved placeholder
+// detection, and reporting of user vars the formula never uses.
+func TestRenderDispatchPreview(t *testing.T) {
+	f, err := formula.Parse([]byte(previewTestFormula))
+	if err != nil {
+		t.Fatalf("parse formula: %v", err)
+	}
+
+	userVars := []string{"depth=deep", "dpeth=oops"}
+	vars := append([]string{"feature=Fix login", "issue=gt-abc"}, userVars...)
+
+	p := &dispatchPreview{BeadID: "gt-abc", Formula: "preview-test"}
+	renderDispatchPreview(p, f, vars, userVars)
+
+	if len(p.Steps) != 2 {
+		t.Fatalf("got %d steps, want 2", len(p.Steps))
+	}
+	if p.Steps[0].Title != "Load gt-abc" {
+		t.Errorf("step 0 title = %q, want %q", p.Steps[0].Title, "Load gt-abc")
+	}
+	if !strings.Contains(p.Steps[0].Description, "Fix login at deep depth") {
+		t.Errorf("step 0 description not substituted: %q", p.Steps[0].Description)
+	}
+	if want := []string{"typo_var"}; !reflect.DeepEqual(p.Unresolved, want) {
+		t.Errorf("Unresolved = %v, want %v", p.Unresolved, want)
+	}
+	if want := []string{"dpeth"}; !reflect.DeepEqual(p.Unused, want) {
+		t.Errorf("Unused = %v, want %v", p.Unused, want)
+	}
+}
+
+// TestRenderDispatchPreviewDefaults verifies declared defaults fill vars the
+// scheduler did not pass, and that system vars are never reported as unused.
+func TestRenderDispatchPreviewDefaults(t *testing.T) {
+	f, err := formula.Parse([]byte(previewTestFormula))
+	if err != nil {
+		t.Fatalf("parse formula: %v", err)
+	}
+
+	p := &dispatchPreview{}
+	renderDispatchPreview(p, f, []string{"feature=X", "issue=gt-1", "base_branch=dev"}, nil)
+
+	if p.Vars["depth"] != "shallow" {
+		t.Errorf("depth = %q, want default %q", p.Vars["depth"], "shallow")
+	}
+	if len(p.Unused) != 0 {
+		t.Errorf("Unused = %v, want none", p.Unused)
+	}
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// queueNoteCommentPrefix marks bd comments written for scheduler.queue_notes.
+const queueNoteCommentPrefix = "⏳ "
+
+// queueNoteCycle is the usual gap between the daemon's dispatch cycles, used
+// to estimate when a bead with free capacity ahead of it is dispatched.
+const queueNoteCycle = 3 * time.Minute
+
+// queueNotes works out the note for every queued bead: its place in the
+// dispatch order and estimated dispatch time, or why it isn't in line.
+func queueNotes(townRoot string, schedulerCfg *capacity.SchedulerConfig) (map[string]capacity.QueueNote, error) {
+	ready, skipped, err := getReadySlingContextsWithSkips(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	ready, _, limitSkips := holdLimitedProviders(townRoot, ready)
+	skipped = append(skipped, limitSkips...)
+
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := make(map[string]capacity.QueueNote)
+	for _, sk := range skipped {
+		if sk.WorkBeadID == "" || sk.Reason == capacity.SkipDuplicate {
+			continue
+		}
+		waiting := sk.Reason
+		if sk.Detail != "" {
+			waiting += " (" + sk.Detail + ")"
+		}
+		notes[sk.WorkBeadID] = capacity.QueueNote{Rig: sk.TargetRig, Waiting: waiting, Reason: sk.Reason}
+	}
+
+	parallel, batch := schedulerCfg.GetMaxPolecats(), schedulerCfg.GetBatchSize()
+	if parallel > 0 {
+		parallel, batch = schedulerLimitCap(townRoot, schedulerCfg, time.Now()).Apply(parallel, batch)
+	}
+	free := 0
+	if parallel > 0 {
+		free = max(parallel-countActivePolecats(), 0)
+	}
+	history := loadDurationHistory(townRoot, time.Now())
+	var ahead []time.Duration
+	for i, b := range ready {
+		n := capacity.QueueNote{Rig: b.TargetRig, Position: i + 1, Total: len(ready)}
+		if state.Paused {
+			n = capacity.QueueNote{Rig: b.TargetRig, Waiting: "scheduler paused", Reason: "scheduler paused"}
+		} else if eta, ok := capacity.DispatchETA(ahead, i, free, parallel, batch, queueNoteCycle); ok {
+			n.ETA = eta
+		}
+		notes[b.WorkBeadID] = n
+
+		if b.Context != nil {
+			if est, ok := capacity.EstimateDuration(history, b.Context.Formula, b.Context.Labels); ok {
+				ahead = append(ahead, est.Median)
+			}
+		}
+	}
+	return notes, nil
+}
+
+// updateQueueNotes posts a queue note on each queued bead that has none yet
+// or whose note changed significantly (see QueueNote.Significant). only
+// limits posting to those work beads, e.g. the one just enqueued; nil posts
+// for the whole queue and forgets beads that have left it.
+func updateQueueNotes(townRoot string, schedulerCfg *capacity.SchedulerConfig, only []string) {
+	notes, err := queueNotes(townRoot, schedulerCfg)
+	if err != nil {
+		style.PrintWarning("queue notes skipped: %v", err)
+		return
+	}
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		return
+	}
+
+	posted := make(map[string]capacity.QueueNote)
+	for _, id := range slices.Sorted(maps.Keys(notes)) {
+		if only != nil && !slices.Contains(only, id) {
+			continue
+		}
+		n := notes[id]
+		if prev, ok := state.QueueNotes[id]; ok && !n.Significant(prev) {
+			continue
+		}
+		if err := BdCmd("comments", "add", id, queueNoteCommentPrefix+n.String()).
+			Dir(resolveBeadDir(id)).
+			StripBeadsDir().
+			Run(); err != nil {
+			style.PrintWarning("could not post queue note on %s: %v", id, err)
+			continue
+		}
+		posted[id] = n
+	}
+
+	// Record what was posted under the state lock: posting runs bd for each
+	// bead, too long to hold the lock over.
+	if _, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		changed := len(posted) > 0
+		if state.QueueNotes == nil {
+			state.QueueNotes = make(map[string]capacity.QueueNote)
+		}
+		maps.Copy(state.QueueNotes, posted)
+		if only == nil {
+			for id := range state.QueueNotes {
+				if _, queued := notes[id]; !queued {
+					delete(state.QueueNotes, id)
+					changed = true
+				}
+			}
+		}
+		return changed, nil
+	}); err != nil {
+		style.PrintWarning("could not save queue notes: %v", err)
+	}
+}
+
+// postEnqueueQueueNote posts the queue note for a bead just enqueued, when
+// scheduler.queue_notes is on.
+func postEnqueueQueueNote(townRoot, beadID string) {
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil || settings.Scheduler == nil || !settings.Scheduler.QueueNotes {
+		return
+	}
+	updateQueueNotes(townRoot, settings.Scheduler, []string{beadID})
+}
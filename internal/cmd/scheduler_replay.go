@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	schedulerReplayDryRun bool
+	schedulerReplayJSON   bool
+)
+
+var schedulerReplayCmd = &cobra.Command{
+	Use:   "replay <bead-id>",
+	Short: "Re-run a scheduled bead's dispatch now, for debugging",
+	Long: `Reconstruct the sling parameters dispatch would use for a scheduled bead,
+print them, and (without --dry-run) run the dispatch immediately instead of
+waiting for the daemon's next cycle.
+
+Parameters come from the bead's sling context exactly as the scheduler
+reads them, so what you see is what dispatch executes. The sling context's
+failure count and last error are shown alongside.
+
+A replay runs with debug output enabled (GT_DEBUG, GT_DEBUG_SESSION), and
+everything it prints is also captured to .runtime/replay/<bead>-<time>.log.
+It bypasses pause, rig holds, and capacity (with a warning), but takes the
+dispatch lock so it can't race a daemon dispatch. On success the sling
+context is closed as dispatched. Failures are logged as dispatch failure
+events but do not count toward the circuit breaker.
+
+Examples:
+  gt scheduler replay gt-abc --dry-run   # Show the SlingParams only
+  gt scheduler replay gt-abc --json --dry-run
+  gt scheduler replay gt-abc             # Dispatch now, capturing logs`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSchedulerReplay,
+}
+
+func init() {
+	schedulerReplayCmd.Flags().BoolVarP(&schedulerReplayDryRun, "dry-run", "n", false, "Print the reconstructed parameters without dispatching")
+	schedulerReplayCmd.Flags().BoolVar(&schedulerReplayJSON, "json", false, "Print the replay plan as JSON")
+	schedulerCmd.AddCommand(schedulerReplayCmd)
+}
+
+// replayPlan is what gt scheduler replay reconstructs for a bead.
+type replayPlan struct {
+	ContextID        string      `json:"context_id"`
+	BeadID           string      `json:"bead_id"`
+	TargetRig        string      `json:"target_rig"`
+	EnqueuedAt       string      `json:"enqueued_at,omitempty"`
+	DispatchFailures int         `json:"dispatch_failures"`
+	LastFailure      string      `json:"last_failure,omitempty"`
+	Params           SlingParams `json:"params"`
+}
+
+func runSchedulerReplay(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	ctx, fields := findSlingContextForBead(townRoot, beadID)
+	if fields == nil {
+		return fmt.Errorf("bead %s is not scheduled (no open sling context)", beadID)
+	}
+	plan := replayPlan{
+		ContextID:        ctx.ID,
+		BeadID:           beadID,
+		TargetRig:        fields.TargetRig,
+		EnqueuedAt:       fields.EnqueuedAt,
+		DispatchFailures: fields.DispatchFailures,
+		LastFailure:      fields.LastFailure,
+		Params:           dispatchSlingParams(fields, townRoot),
+	}
+
+	if schedulerReplayJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(plan); err != nil {
+			return err
+		}
+	} else {
+		printReplayPlan(plan)
+	}
+	if schedulerReplayDryRun {
+		return nil
+	}
+	if err := requireAccess(cmd, access.ActionDispatch); err != nil {
+		return err
+	}
+
+	warnReplayBypasses(townRoot, fields.TargetRig)
+
+	// Same lock as dispatchScheduledWork, so a daemon cycle can't dispatch
+	// this bead concurrently.
+	runtimeDir := filepath.Join(townRoot, ".runtime")
+	_ = os.MkdirAll(runtimeDir, 0755)
+	fileLock := flock.New(filepath.Join(runtimeDir, "scheduler-dispatch.lock"))
+	locked, err := fileLock.TryLock()
+	if err != nil {
+		return fmt.Errorf("acquiring dispatch lock: %w", err)
+	}
+	if !locked {
+		return fmt.Errorf("a scheduler dispatch is in progress; retry when it finishes")
+	}
+	defer func() { _ = fileLock.Unlock() }()
+
+	logPath := replayLogPath(townRoot, beadID, time.Now())
+	restore, err := captureReplayOutput(logPath)
+	if err != nil {
+		return fmt.Errorf("capturing replay output: %w", err)
+	}
+	restoreEnv := setReplayDebugEnv()
+
+	b := capacity.PendingBead{ID: ctx.ID, WorkBeadID: beadID, Title: ctx.Title, TargetRig: fields.TargetRig, Context: fields}
+	fmt.Printf("\n%s Replaying dispatch of %s (log: %s)\n", style.Bold.Render("▶"), beadID, logPath)
+	started := time.Now()
+	result, dispatchErr := dispatchSingleBead(b, townRoot, detectActor())
+	elapsed := time.Since(started).Round(time.Millisecond)
+
+	if dispatchErr != nil {
+		failure := newDispatchFailure(b, dispatchErr, started)
+		fmt.Printf("\n%s Dispatch failed after %s [%s]: %v\n", style.Error.Render("✗"), elapsed, failure.Kind, dispatchErr)
+		restoreEnv()
+		restore()
+		_ = events.LogFeed(events.TypeSchedulerDispatchFailed, detectActor(), events.SchedulerDispatchFailedPayload(failure))
+		return fmt.Errorf("replay of %s failed (log: %s)", beadID, logPath)
+	}
+
+	polecatName := ""
+	if result != nil {
+		polecatName = result.PolecatName
+	}
+	if err := beadsForContext(townRoot, fields).CloseSlingContext(ctx.ID, "dispatched"); err != nil {
+		style.PrintWarning("dispatched, but could not close sling context %s (risk of re-dispatch): %v", ctx.ID, err)
+	}
+	fmt.Printf("\n%s Dispatched %s → %s/%s in %s\n", style.SuccessPrefix, beadID, fields.TargetRig, polecatName, elapsed)
+	restoreEnv()
+	restore()
+
+	_ = events.LogFeed(events.TypeSchedulerDispatch, detectActor(), events.SchedulerDispatchPayload(beadID, fields.TargetRig, polecatName))
+	wakeRigAgents(fields.TargetRig)
+	return nil
+}
+
+// printReplayPlan renders a replay plan for humans, listing every
+// non-zero SlingParams field.
+func printReplayPlan(p replayPlan) {
+	fmt.Printf("%s %s → %s\n", style.Bold.Render("Replay:"), p.BeadID, p.TargetRig)
+	fmt.Printf("  Sling context: %s (enqueued %s)\n", p.ContextID, p.EnqueuedAt)
+	if p.DispatchFailures > 0 {
+		fmt.Printf("  Previous failures: %d\n", p.DispatchFailures)
+		if p.LastFailure != "" {
+			fmt.Printf("  Last failure: %s\n", p.LastFailure)
+		}
+	}
+
+	fmt.Printf("\n  %s\n", style.Bold.Render("SlingParams:"))
+	data, _ := json.Marshal(p.Params)
+	var fields map[string]interface{}
+	_ = json.Unmarshal(data, &fields)
+	for _, name := range sortedKeys(fields) {
+		switch v := fields[name].(type) {
+		case nil:
+			continue
+		case string:
+			if v == "" {
+				continue
+			}
+		case bool:
+			if !v {
+				continue
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				continue
+			}
+		}
+		fmt.Printf("    %-17s %v\n", name+":", fields[name])
+	}
+}
+
+// sortedKeys returns m's keys in order.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// warnReplayBypasses warns about scheduler gates the replay ignores.
+func warnReplayBypasses(townRoot, rig string) {
+	if state, err := capacity.LoadState(townRoot); err == nil {
+		if state.Paused {
+			style.PrintWarning("scheduler is paused (by %s); replaying anyway", state.PausedBy)
+		}
+		if by, held := state.HeldRigs[rig]; held {
+			style.PrintWarning("rig %s is held (by %s); replaying anyway", rig, by)
+		}
+	}
+	if settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil && settings.Scheduler != nil {
+		if max := settings.Scheduler.GetMaxPolecats(); max > 0 {
+			if working := countWorkingPolecats(); working >= max {
+				style.PrintWarning("at capacity (%d/%d polecats working); replaying anyway", working, max)
+			}
+		}
+	}
+}
+
+// replayLogPath returns where a replay's output is captured.
+func replayLogPath(townRoot, beadID string, now time.Time) string {
+	name := fmt.Sprintf("%s-%s.log", beadID, now.UTC().Format("20060102T150405Z"))
+	return filepath.Join(constants.TownRuntimePath(townRoot), "replay", name)
+}
+
+// setReplayDebugEnv turns on debug output for the replayed dispatch and
+// returns a func that restores the previous environment.
+func setReplayDebugEnv() func() {
+	var restores []func()
+	for _, key := range []string{"GT_DEBUG", "GT_DEBUG_SESSION"} {
+		old, had := os.LookupEnv(key)
+		_ = os.Setenv(key, "1")
+		key := key
+		restores = append(restores, func() {
+			if had {
+				_ = os.Setenv(key, old)
+			} else {
+				_ = os.Unsetenv(key)
+			}
+		})
+	}
+	return func() {
+		for _, r := range restores {
+			r()
+		}
+	}
+}
+
+// captureReplayOutput tees the process's stdout and stderr into logPath
+// until the returned func is called. Subprocesses that inherit stdout or
+// stderr are captured too.
+func captureReplayOutput(logPath string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return nil, err
+	}
+	logFile, err := os.Create(logPath) //nolint:gosec // G304: path is under the town runtime dir
+	if err != nil {
+		return nil, err
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		_ = logFile.Close()
+		return nil, err
+	}
+
+	origStdout, origStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = w, w
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(io.MultiWriter(origStdout, logFile), r)
+		close(done)
+	}()
+
+	return func() {
+		os.Stdout, os.Stderr = origStdout, origStderr
+		_ = w.Close()
+		<-done
+		_ = r.Close()
+		_ = logFile.Close()
+	}, nil
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestReplayLogPath(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	got := replayLogPath("/town", "gt-abc", now)
+	want := filepath.Join("/town", ".runtime", "replay", "gt-abc-20260301T123000Z.log")
+	if got != want {
+		t.Errorf("replayLogPath = %q, want %q", got, want)
+	}
+}
+
+func TestSetReplayDebugEnv_Restores(t *testing.T) {
+	t.Setenv("GT_DEBUG", "keep")
+	os.Unsetenv("GT_DEBUG_SESSION")
+
+	restore := setReplayDebugEnv()
+	if os.Getenv("GT_DEBUG") != "1" || os.Getenv("GT_DEBUG_SESSION") != "1" {
+		t.Fatal("debug env not enabled during replay")
+	}
+	restore()
+
+	if got := os.Getenv("GT_DEBUG"); got != "keep" {
+		t.Errorf("GT_DEBUG = %q after restore, want %q", got, "keep")
+	}
+	if _, ok := os.LookupEnv("GT_DEBUG_SESSION"); ok {
+		t.Error("GT_DEBUG_SESSION should be unset after restore")
+	}
+}
+
+func TestCaptureReplayOutput(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "replay", "gt-abc.log")
+	restore, err := captureReplayOutput(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println("to stdout")
+	fmt.Fprintln(os.Stderr, "to stderr")
+	restore()
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "to stdout") || !strings.Contains(string(data), "to stderr") {
+		t.Errorf("log = %q, want both streams captured", data)
+	}
+}
+
+func TestDispatchSlingParams(t *testing.T) {
+	fields := &capacity.SlingContextFields{
+		WorkBeadID: "gt-work",
+		TargetRig:  "gastown",
+		Formula:    "mol-polecat-work",
+		Args:       "fix it",
+		Merge:      "local",
+		Mode:       "ralph",
+		NoMerge:    true,
+	}
+	p := dispatchSlingParams(fields, "/town")
+	if p.BeadID != "gt-work" || p.RigName != "gastown" || p.FormulaName != "mol-polecat-work" ||
+		p.Args != "fix it" || p.Merge != "local" || p.Mode != "ralph" || !p.NoMerge ||
+		p.TownRoot != "/town" || p.CallerContext != "scheduler-dispatch" {
+		t.Errorf("dispatchSlingParams = %+v", p)
+	}
+}
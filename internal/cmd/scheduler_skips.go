@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// maxSkipsShown caps the skipped beads listed in text output and events;
+// the rest are counted in the summary.
+const maxSkipsShown = 20
+
+// capacitySkips returns the last n beads of queued, which the planner left
+// for lack of capacity (PlanDispatch takes a prefix of the ready beads).
+func capacitySkips(queued []capacity.PendingBead, n int) []capacity.SkippedBead {
+	if n <= 0 || n > len(queued) {
+		return nil
+	}
+	skipped := make([]capacity.SkippedBead, 0, n)
+	for _, b := range queued[len(queued)-n:] {
+		skipped = append(skipped, capacity.SkipBead(b, capacity.SkipCapacity, ""))
+	}
+	return skipped
+}
+
+// printSkippedBeads lists why scheduled beads were not dispatched.
+func printSkippedBeads(skipped []capacity.SkippedBead) {
+	if len(skipped) == 0 {
+		return
+	}
+	fmt.Printf("  Not dispatched: %s\n", capacity.SummarizeSkips(skipped))
+	for i, s := range skipped {
+		if i == maxSkipsShown {
+			fmt.Printf("    %s\n", style.Dim.Render(fmt.Sprintf("... and %d more (gt scheduler status --json)", len(skipped)-i)))
+			break
+		}
+		fmt.Printf("    %s %s\n", style.Dim.Render("○"), s)
+	}
+}
+
+// recordSkippedBeads reports why a dispatch cycle left beads queued, logging
+// a scheduler_skipped event when the reasons change so the feed explains a
+// stuck queue without repeating itself every heartbeat. Beads waiting only
+// for capacity are left out: that is the queue working as intended.
+func recordSkippedBeads(townRoot, actor string, skipped []capacity.SkippedBead) {
+	var stuck []capacity.SkippedBead
+	for _, s := range skipped {
+		if s.Reason != capacity.SkipCapacity {
+			stuck = append(stuck, s)
+		}
+	}
+	summary := capacity.SummarizeSkips(stuck)
+	if summary != "" {
+		fmt.Printf("%s Not dispatched: %s\n", style.Dim.Render("○"), summary)
+	}
+
+	changed := false
+	if _, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		changed = state.SkipSummary != summary
+		state.SkipSummary = summary
+		return changed, nil
+	}); err != nil {
+		style.PrintWarning("could not save scheduler state: %v", err)
+		return
+	}
+	if !changed {
+		return
+	}
+	if summary == "" {
+		return // Cleared: nothing left to explain
+	}
+	descs := make([]string, 0, min(len(stuck), maxSkipsShown))
+	for _, s := range stuck[:min(len(stuck), maxSkipsShown)] {
+		descs = append(descs, s.String())
+	}
+	_ = events.LogFeed(events.TypeSchedulerSkipped, actor,
+		events.SchedulerSkippedPayload(summary, capacity.SkipCounts(stuck), descs))
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// staleBead is a queued bead older than scheduler.max_age.
+type staleBead struct {
+	ContextID string
+	Fields    *capacity.SlingContextFields
+	Age       time.Duration
+}
+
+// markStale sets Stale on the scheduled beads queued longer than maxAge.
+func markStale(scheduled []scheduledBeadInfo, maxAge time.Duration, now time.Time) {
+	for i := range scheduled {
+		scheduled[i].Stale = capacity.IsStale(scheduled[i].EnqueuedAt, maxAge, now)
+	}
+}
+
+// countStale returns how many scheduled beads are stale.
+func countStale(scheduled []scheduledBeadInfo) int {
+	n := 0
+	for _, b := range scheduled {
+		if b.Stale {
+			n++
+		}
+	}
+	return n
+}
+
+// checkStaleQueue acts on beads queued longer than scheduler.max_age, once
+// per daemon dispatch cycle. By default they are labeled gt:queue-stale so
+// they stand out in gt scheduler list and bd; with scheduler.cancel_stale
+// they are removed from the queue and the overseer is mailed the list.
+// Labels come off when a bead leaves the queue or max_age is raised.
+func checkStaleQueue(townRoot string, schedulerCfg *capacity.SchedulerConfig) {
+	maxAge := schedulerCfg.GetMaxAge()
+	state, err := capacity.LoadState(townRoot)
+	if err != nil {
+		return
+	}
+	if maxAge == 0 && len(state.StaleBeads) == 0 {
+		return
+	}
+
+	stale := findStaleBeads(townRoot, maxAge, time.Now())
+	if schedulerCfg != nil && schedulerCfg.CancelStale && len(stale) > 0 {
+		expired := cancelStaleBeads(townRoot, stale)
+		if len(expired) > 0 {
+			notifyStaleCancelled(townRoot, expired, maxAge)
+			ids := make([]string, len(expired))
+			for i, s := range expired {
+				ids[i] = s.Fields.WorkBeadID
+			}
+			_ = events.LogFeed(events.TypeQueueStale, "gt-scheduler", events.QueueStalePayload("cancel", maxAge, ids))
+		}
+		stale = nil // Nothing stale is left queued to flag
+	}
+
+	var staleIDs []string
+	for _, s := range stale {
+		staleIDs = append(staleIDs, s.Fields.WorkBeadID)
+	}
+	flag, unflag := staleLabelChanges(state.StaleBeads, staleIDs)
+	var flagged []string
+	for _, id := range flag {
+		if err := workBeads(townRoot, id).Update(id, beads.UpdateOptions{AddLabels: []string{capacity.LabelQueueStale}}); err != nil {
+			style.PrintWarning("could not flag %s stale: %v", id, err)
+			continue
+		}
+		flagged = append(flagged, id)
+	}
+	var kept []string
+	for _, id := range unflag {
+		if err := workBeads(townRoot, id).Update(id, beads.UpdateOptions{RemoveLabels: []string{capacity.LabelQueueStale}}); err != nil {
+			kept = append(kept, id) // Retry next cycle
+		}
+	}
+	for _, id := range state.StaleBeads {
+		if slices.Contains(staleIDs, id) {
+			kept = append(kept, id)
+		}
+	}
+	staleBeads := append(kept, flagged...)
+	slices.Sort(staleBeads)
+	staleBeads = slices.Compact(staleBeads)
+	if _, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		state.StaleBeads = staleBeads
+		return true, nil
+	}); err != nil {
+		style.PrintWarning("could not save stale beads: %v", err)
+	}
+
+	if len(flagged) > 0 {
+		fmt.Printf("%s %s queued longer than %s, labeled %s\n", style.Warning.Render("⚠"),
+			humanize.Count(len(flagged), "bead"), humanize.Duration(maxAge), capacity.LabelQueueStale)
+		_ = events.LogFeed(events.TypeQueueStale, "gt-scheduler", events.QueueStalePayload("flag", maxAge, flagged))
+	}
+}
+
+// findStaleBeads returns the open sling contexts queued longer than maxAge,
+// one per work bead, oldest first.
+func findStaleBeads(townRoot string, maxAge time.Duration, now time.Time) []staleBead {
+	if maxAge <= 0 {
+		return nil
+	}
+	var stale []staleBead
+	seen := make(map[string]bool)
+	for _, ctx := range listAllSlingContexts(townRoot) {
+		fields := beads.ParseSlingContextFields(ctx.Description)
+		if fields == nil || seen[fields.WorkBeadID] || !capacity.IsStale(fields.EnqueuedAt, maxAge, now) {
+			continue
+		}
+		seen[fields.WorkBeadID] = true
+		age, _ := capacity.QueueAge(fields.EnqueuedAt, now)
+		stale = append(stale, staleBead{ContextID: ctx.ID, Fields: fields, Age: age})
+	}
+	slices.SortFunc(stale, func(a, b staleBead) int { return cmp.Compare(b.Age, a.Age) })
+	return stale
+}
+
+// staleLabelChanges compares the work beads labeled stale with those that
+// are stale now, returning the beads to label and to unlabel.
+func staleLabelChanges(labeled, stale []string) (flag, unflag []string) {
+	for _, id := range stale {
+		if !slices.Contains(labeled, id) {
+			flag = append(flag, id)
+		}
+	}
+	for _, id := range labeled {
+		if !slices.Contains(stale, id) {
+			unflag = append(unflag, id)
+		}
+	}
+	return flag, unflag
+}
+
+// cancelStaleBeads closes the sling contexts of stale beads, removing them
+// from the queue, and returns the ones cancelled.
+func cancelStaleBeads(townRoot string, stale []staleBead) []staleBead {
+	var cancelled []staleBead
+	for _, s := range stale {
+		if err := closeSlingContextForGood(townRoot, beadsForContext(townRoot, s.Fields), s.ContextID, s.Fields, "expired"); err != nil {
+			style.PrintWarning("could not cancel stale %s: %v", s.Fields.WorkBeadID, err)
+			continue
+		}
+		cancelled = append(cancelled, s)
+	}
+	return cancelled
+}
+
+// notifyStaleCancelled mails the overseer the beads removed from the queue
+// for exceeding scheduler.max_age.
+func notifyStaleCancelled(townRoot string, cancelled []staleBead, maxAge time.Duration) {
+	subject := fmt.Sprintf("%s expired from the queue (queued over %s)",
+		humanize.Count(len(cancelled), "bead"), humanize.Duration(maxAge))
+	var lines []string
+	for _, s := range cancelled {
+		lines = append(lines, fmt.Sprintf("  %s → %s (queued %s)", s.Fields.WorkBeadID, s.Fields.TargetRig, humanize.Duration(s.Age)))
+	}
+	body := fmt.Sprintf(`These beads sat in the queue longer than scheduler.max_age (%s) and were removed:
+
+%s
+
+The beads themselves are untouched. Re-queue one with: gt sling <bead> <rig>
+Flag stale beads instead of cancelling with: gt config set scheduler.cancel_stale false`,
+		humanize.Duration(maxAge), strings.Join(lines, "\n"))
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	if err := router.Send(&mail.Message{
+		From:    "gt-scheduler",
+		To:      "overseer",
+		Subject: subject,
+		Body:    body,
+	}); err != nil {
+		style.PrintWarning("could not send stale queue notice: %v", err)
+		return
+	}
+	fmt.Printf("%s %s\n", style.Warning.Render("⚠"), subject)
+}
+
+// workBeads returns a Beads for the database that holds work bead id.
+func workBeads(townRoot, id string) *beads.Beads {
+	return beads.NewWithBeadsDir(townRoot, beads.ResolveBeadsDirForID(filepath.Join(townRoot, ".beads"), id))
+}
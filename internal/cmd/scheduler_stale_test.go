@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStaleLabelChanges(t *testing.T) {
+	flag, unflag := staleLabelChanges([]string{"gt-a", "gt-b"}, []string{"gt-b", "gt-c"})
+	if !reflect.DeepEqual(flag, []string{"gt-c"}) {
+		t.Errorf("flag = %v, want [gt-c]", flag)
+	}
+	if !reflect.DeepEqual(unflag, []string{"gt-a"}) {
+		t.Errorf("unflag = %v, want [gt-a]", unflag)
+	}
+
+	flag, unflag = staleLabelChanges(nil, nil)
+	if flag != nil || unflag != nil {
+		t.Errorf("nothing stale: got flag=%v unflag=%v", flag, unflag)
+	}
+}
+
+func TestMarkStale(t *testing.T) {
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	scheduled := []scheduledBeadInfo{
+		{ID: "gt-old", EnqueuedAt: "2026-02-01T00:00:00Z"},
+		{ID: "gt-new", EnqueuedAt: "2026-03-14T00:00:00Z"},
+		{ID: "gt-unknown"},
+	}
+	markStale(scheduled, 14*24*time.Hour, now)
+	if !scheduled[0].Stale || scheduled[1].Stale || scheduled[2].Stale {
+		t.Errorf("Stale = %v/%v/%v, want true/false/false", scheduled[0].Stale, scheduled[1].Stale, scheduled[2].Stale)
+	}
+	if n := countStale(scheduled); n != 1 {
+		t.Errorf("countStale = %d, want 1", n)
+	}
+
+	markStale(scheduled, 0, now)
+	if countStale(scheduled) != 0 {
+		t.Error("no max age: nothing should be stale")
+	}
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// passedOverBeads returns the beads a dispatch cycle left waiting for
+// capacity, with batch members listed alongside their leader.
+func passedOverBeads(queued []capacity.PendingBead, skipped int) []capacity.PendingBead {
+	if skipped <= 0 || skipped > len(queued) {
+		return nil
+	}
+	var waiting []capacity.PendingBead
+	for _, b := range queued[len(queued)-skipped:] {
+		waiting = append(waiting, b)
+		waiting = append(waiting, b.Batch...)
+	}
+	return waiting
+}
+
+// recordPassedOver counts the ready beads a dispatch cycle passed over for
+// other work, and boosts those passed over threshold cycles running so
+// nothing waits forever behind a stream of higher-priority beads. Each
+// boost is logged as a queue_starved event.
+func recordPassedOver(townRoot, actor string, queued []capacity.PendingBead, report capacity.DispatchReport, threshold int) {
+	var newly []capacity.PendingBead
+	if _, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		if threshold <= 0 && len(state.PassedOver) == 0 && len(state.Starved) == 0 {
+			return false, nil
+		}
+		waiting := passedOverBeads(queued, report.Skipped)
+		newly = state.RecordPassedOver(waiting, report.Dispatched > 0, threshold)
+		return true, nil
+	}); err != nil {
+		style.PrintWarning("could not save starvation counts: %v", err)
+		return
+	}
+	if len(newly) == 0 {
+		return
+	}
+
+	ids := make([]string, len(newly))
+	for i, b := range newly {
+		ids[i] = b.WorkBeadID
+	}
+	fmt.Printf("%s %s passed over for %d cycles, boosted to the front of the queue: %s\n",
+		style.Warning.Render("⚠"), humanize.Count(len(newly), "bead"), threshold, strings.Join(ids, ", "))
+	_ = events.LogFeed(events.TypeQueueStarved, actor, events.QueueStarvedPayload(threshold, ids))
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestPassedOverBeads(t *testing.T) {
+	queued := []capacity.PendingBead{
+		{ID: "ctx-1"},
+		{ID: "ctx-2", Batch: []capacity.PendingBead{{ID: "ctx-3"}}},
+		{ID: "ctx-4"},
+	}
+	var ids []string
+	for _, b := range passedOverBeads(queued, 2) {
+		ids = append(ids, b.ID)
+	}
+	if want := []string{"ctx-2", "ctx-3", "ctx-4"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("passed over = %v, want %v", ids, want)
+	}
+	if got := passedOverBeads(queued, 0); got != nil {
+		t.Errorf("nothing skipped: got %+v", got)
+	}
+}
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var schedulerVerifyJSON bool
+
+// Scheduler verify checks. Each names the part of a queued bead's dispatch
+// that would fail.
+const (
+	verifyMetadata = "metadata"  // Context fields missing or unparseable
+	verifyWorkBead = "work-bead" // Work bead not found
+	verifyRig      = "rig"       // Target rig not registered in rigs.json
+	verifyFormula  = "formula"   // Formula not found, unparseable, or won't cook
+	verifyAccount  = "account"   // Account not in the accounts config
+	verifyAgent    = "agent"     // Agent override not a known agent
+)
+
+// schedulerVerifyProblem is one reason a queued bead would fail to dispatch.
+type schedulerVerifyProblem struct {
+	Check      string `json:"check"`
+	ContextID  string `json:"context_id"`
+	WorkBeadID string `json:"work_bead_id,omitempty"`
+	Detail     string `json:"detail"`
+}
+
+// schedulerVerifyReport is the result of gt scheduler verify.
+type schedulerVerifyReport struct {
+	Checked  int                      `json:"checked"`
+	Problems []schedulerVerifyProblem `json:"problems"`
+}
+
+// queueVerifyEnv is the town state queued beads are checked against.
+// Formula and agent checks are functions so they can be stubbed in tests.
+type queueVerifyEnv struct {
+	workBeads      map[string]beadStatusInfo
+	rigs           map[string]bool
+	accounts       *config.AccountsConfig // nil = no accounts configured
+	checkFormula   func(name string, fields *capacity.SlingContextFields) error
+	checkAgent     func(agent, rig string) error
+	formulaResults map[string]error
+}
+
+var schedulerVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check that every queued bead can be dispatched",
+	Long: `Simulate dispatch for every scheduled bead without spawning anything, and
+report the beads that would fail when the scheduler reaches them:
+
+  metadata   context fields missing or unparseable (work bead, rig,
+             enqueued_at, merge strategy, retry backoff)
+  work-bead  work bead not found
+  rig        target rig not registered in rigs.json
+  formula    formula not found, fails to parse, or fails to cook
+  account    --account handle not in the accounts config
+  agent      --agent override not a known agent
+
+Formulas are cooked with bd cook exactly as dispatch cooks them, once per
+formula and rig. Meant for CI of the town config, so a broken queue fails
+a check instead of the daemon at 3am. Exits non-zero when any problem is
+found, or when a rig's queue or the work beads can't be read.
+
+Examples:
+  gt scheduler verify
+  gt scheduler verify --json`,
+	Args: cobra.NoArgs,
+	RunE: runSchedulerVerify,
+}
+
+func init() {
+	schedulerVerifyCmd.Flags().BoolVar(&schedulerVerifyJSON, "json", false, "Output as JSON")
+	schedulerCmd.AddCommand(schedulerVerifyCmd)
+}
+
+func runSchedulerVerify(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	// A rig whose queue can't be read can't be vouched for: fail rather
+	// than report its beads as dispatchable.
+	contexts, err := listAllSlingContextsWithError(townRoot)
+	if err != nil {
+		return fmt.Errorf("listing queued beads: %w", err)
+	}
+	env, err := newQueueVerifyEnv(townRoot, contexts)
+	if err != nil {
+		return err
+	}
+	report := schedulerVerifyReport{Checked: len(contexts), Problems: []schedulerVerifyProblem{}}
+	for _, ctx := range contexts {
+		report.Problems = append(report.Problems, verifySlingContext(ctx, env)...)
+	}
+
+	if schedulerVerifyJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		printVerifyReport(report)
+	}
+	if len(report.Problems) > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// newQueueVerifyEnv loads the town config the queued contexts are checked
+// against: their work beads, the registered rigs and the accounts.
+func newQueueVerifyEnv(townRoot string, contexts []*beads.Issue) (*queueVerifyEnv, error) {
+	env := &queueVerifyEnv{rigs: make(map[string]bool), formulaResults: make(map[string]error)}
+
+	var workBeadIDs []string
+	for _, ctx := range contexts {
+		if fields := beads.ParseSlingContextFields(ctx.Description); fields != nil && fields.WorkBeadID != "" {
+			workBeadIDs = append(workBeadIDs, fields.WorkBeadID)
+		}
+	}
+	// As in audit: no work bead readable at all means bd is unavailable,
+	// not that every bead is missing. Unlike audit, verify can't pass
+	// without the check.
+	env.workBeads = batchFetchBeadInfoByIDs(townRoot, workBeadIDs)
+	if len(workBeadIDs) > 0 && len(env.workBeads) == 0 {
+		return nil, fmt.Errorf("could not read any of %d work bead(s); is bd available?", len(workBeadIDs))
+	}
+
+	if rigsConfig, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot)); err == nil {
+		for name := range rigsConfig.Rigs {
+			env.rigs[name] = true
+		}
+	}
+	if acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot)); err == nil {
+		env.accounts = acctCfg
+	}
+
+	env.checkFormula = func(name string, fields *capacity.SlingContextFields) error {
+		if _, err := loadPreviewFormula(name); err != nil {
+			return err
+		}
+		workDir := beads.ResolveHookDir(townRoot, fields.WorkBeadID, filepath.Join(townRoot, fields.TargetRig))
+		if err := CookFormula(name, workDir, townRoot); err != nil {
+			return fmt.Errorf("bd cook %s failed: %w", name, err)
+		}
+		return nil
+	}
+	env.checkAgent = func(agent, rig string) error {
+		_, _, err := config.ResolveAgentConfigWithOverride(townRoot, filepath.Join(townRoot, rig), agent)
+		return err
+	}
+	return env, nil
+}
+
+// verifySlingContext dry-runs the dispatch of one queued context against env
+// and returns every problem found. Formula results are cached per formula and
+// rig, since a queue usually holds many beads with the same formula.
+func verifySlingContext(ctx *beads.Issue, env *queueVerifyEnv) []schedulerVerifyProblem {
+	fields := beads.ParseSlingContextFields(ctx.Description)
+	if fields == nil {
+		return []schedulerVerifyProblem{{Check: verifyMetadata, ContextID: ctx.ID, Detail: "description is not valid sling context fields"}}
+	}
+
+	var problems []schedulerVerifyProblem
+	add := func(check, format string, a ...interface{}) {
+		problems = append(problems, schedulerVerifyProblem{
+			Check:      check,
+			ContextID:  ctx.ID,
+			WorkBeadID: fields.WorkBeadID,
+			Detail:     fmt.Sprintf(format, a...),
+		})
+	}
+
+	if fields.WorkBeadID == "" {
+		add(verifyMetadata, "no work_bead_id")
+	}
+	if fields.TargetRig == "" {
+		add(verifyMetadata, "no target_rig")
+	}
+	if _, err := time.Parse(time.RFC3339, fields.EnqueuedAt); err != nil {
+		add(verifyMetadata, "enqueued_at %q is not an RFC 3339 time", fields.EnqueuedAt)
+	}
+	switch fields.Merge {
+	case "", "direct", "mr", "local":
+	default:
+		add(verifyMetadata, "merge %q is not direct, mr, or local", fields.Merge)
+	}
+	if fields.RetryBackoff != "" {
+		if _, err := time.ParseDuration(fields.RetryBackoff); err != nil {
+			add(verifyMetadata, "retry_backoff %q is not a duration", fields.RetryBackoff)
+		}
+	}
+
+	if fields.WorkBeadID != "" {
+		if _, ok := env.workBeads[fields.WorkBeadID]; !ok {
+			add(verifyWorkBead, "work bead %s not found", fields.WorkBeadID)
+		}
+	}
+
+	rigKnown := fields.TargetRig != "" && env.rigs[fields.TargetRig]
+	if fields.TargetRig != "" && !rigKnown {
+		add(verifyRig, "rig %q is not registered", fields.TargetRig)
+	}
+
+	if fields.Formula != "" && rigKnown {
+		key := fields.Formula + "@" + fields.TargetRig
+		err, done := env.formulaResults[key]
+		if !done {
+			err = env.checkFormula(fields.Formula, fields)
+			env.formulaResults[key] = err
+		}
+		if err != nil {
+			add(verifyFormula, "%v", err)
+		}
+	}
+
+	if fields.Account != "" {
+		if env.accounts == nil {
+			add(verifyAccount, "account %q requested but no accounts are configured", fields.Account)
+		} else if env.accounts.GetAccount(fields.Account) == nil {
+			add(verifyAccount, "account %q not found in accounts config", fields.Account)
+		}
+	}
+
+	if fields.Agent != "" && rigKnown {
+		if err := env.checkAgent(fields.Agent, fields.TargetRig); err != nil {
+			add(verifyAgent, "%v", err)
+		}
+	}
+	return problems
+}
+
+func printVerifyReport(report schedulerVerifyReport) {
+	if len(report.Problems) == 0 {
+		fmt.Printf("%s All %d queued bead(s) can be dispatched\n", style.Bold.Render("✓"), report.Checked)
+		return
+	}
+
+	failing := make(map[string]bool)
+	for _, p := range report.Problems {
+		failing[p.ContextID] = true
+		work := p.WorkBeadID
+		if work == "" {
+			work = "-"
+		}
+		fmt.Printf("  %s %-9s %s → %s  %s\n", style.Error.Render("✗"), p.Check, p.ContextID, work, p.Detail)
+	}
+	fmt.Printf("\n%d of %d queued bead(s) would fail to dispatch (%d problem(s))\n",
+		len(failing), report.Checked, len(report.Problems))
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestVerifySlingContext(t *testing.T) {
+	cooks := 0
+	env := &queueVerifyEnv{
+		workBeads: map[string]beadStatusInfo{"gt-a": {Status: "open"}, "gt-b": {Status: "open"}},
+		rigs:      map[string]bool{"gastown": true},
+		accounts:  &config.AccountsConfig{Accounts: map[string]config.Account{"work": {}}},
+		checkFormula: func(name string, _ *capacity.SlingContextFields) error {
+			cooks++
+			if name == "mol-broken" {
+				return fmt.Errorf("bd cook mol-broken failed")
+			}
+			return nil
+		},
+		checkAgent: func(agent, _ string) error {
+			if agent != "claude" {
+				return fmt.Errorf("agent '%s' not found", agent)
+			}
+			return nil
+		},
+		formulaResults: make(map[string]error),
+	}
+	const at = "2026-03-01T12:00:00Z"
+
+	tests := []struct {
+		name   string
+		fields *capacity.SlingContextFields
+		want   []string // checks failed, sorted
+	}{
+		{"valid", &capacity.SlingContextFields{WorkBeadID: "gt-a", TargetRig: "gastown", EnqueuedAt: at, Formula: "mol-polecat-work", Account: "work", Agent: "claude"}, nil},
+		{"unparseable", nil, []string{verifyMetadata}},
+		{"bad metadata", &capacity.SlingContextFields{WorkBeadID: "gt-a", TargetRig: "gastown", EnqueuedAt: "yesterday", Merge: "squash", RetryBackoff: "soon"},
+			[]string{verifyMetadata, verifyMetadata, verifyMetadata}},
+		{"missing bead and rig", &capacity.SlingContextFields{WorkBeadID: "gt-gone", TargetRig: "nowhere", EnqueuedAt: at, Formula: "mol-polecat-work", Agent: "nope"},
+			[]string{verifyRig, verifyWorkBead}},
+		{"broken formula", &capacity.SlingContextFields{WorkBeadID: "gt-b", TargetRig: "gastown", EnqueuedAt: at, Formula: "mol-broken"}, []string{verifyFormula}},
+		{"unknown account and agent", &capacity.SlingContextFields{WorkBeadID: "gt-b", TargetRig: "gastown", EnqueuedAt: at, Account: "personal", Agent: "nope"},
+			[]string{verifyAccount, verifyAgent}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, p := range verifySlingContext(auditContext("ctx-1", tt.fields), env) {
+				got = append(got, p.Check)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("checks failed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Formulas are cooked once per formula and rig, however many beads use them.
+	cooks = 0
+	for i := 0; i < 3; i++ {
+		verifySlingContext(auditContext("ctx-1", &capacity.SlingContextFields{WorkBeadID: "gt-a", TargetRig: "gastown", EnqueuedAt: at, Formula: "mol-polecat-work"}), env)
+	}
+	if cooks != 0 {
+		t.Errorf("formula cooked %d more times, want cached result", cooks)
+	}
+}
+
+func TestVerifySlingContext_NoAccounts(t *testing.T) {
+	env := &queueVerifyEnv{
+		workBeads:      map[string]beadStatusInfo{"gt-a": {Status: "open"}},
+		rigs:           map[string]bool{"gastown": true},
+		formulaResults: make(map[string]error),
+	}
+	fields := &capacity.SlingContextFields{WorkBeadID: "gt-a", TargetRig: "gastown", EnqueuedAt: "2026-03-01T12:00:00Z", Account: "work"}
+	problems := verifySlingContext(&beads.Issue{ID: "ctx-1", Description: beads.FormatSlingContextDescription(fields)}, env)
+	if len(problems) != 1 || problems[0].Check != verifyAccount {
+		t.Fatalf("problems = %+v, want one account problem", problems)
+	}
+}
@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Rig config keys for queue depth watermarks (gt rig config set).
+const (
+	queueHighWatermarkKey = "queue_high_watermark"
+	queueLowWatermarkKey  = "queue_low_watermark"
+	queueLowHookKey       = "queue_low_hook"
+)
+
+// queueLowHookTimeout bounds a rig's low-watermark hook, which runs inside
+// the daemon's dispatch cycle. Hooks that plan for longer should background
+// themselves.
+const queueLowHookTimeout = 2 * time.Minute
+
+// queueCrossing is a rig's queue crossing into a watermark level.
+type queueCrossing struct {
+	Rig        string
+	Level      string // capacity.QueueLevelHigh or capacity.QueueLevelLow
+	Depth      int
+	Watermarks capacity.Watermarks
+}
+
+// checkQueueWatermarks compares each rig's queue depth to its watermarks,
+// once per daemon dispatch cycle. Crossing the high watermark mails the
+// overseer ("queue backing up: 40 beads, ETA 18h"); crossing the low one runs
+// the rig's queue_low_hook, e.g. to plan more work. Levels are kept in the
+// scheduler state, so each crossing acts once rather than every heartbeat.
+func checkQueueWatermarks(townRoot string, schedulerCfg *capacity.SchedulerConfig) {
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return
+	}
+	watermarks := make(map[string]capacity.Watermarks)
+	for _, name := range slices.Sorted(maps.Keys(rigsConfig.Rigs)) {
+		r := &rig.Rig{Name: name, Path: filepath.Join(townRoot, name)}
+		w := capacity.Watermarks{High: r.GetIntConfig(queueHighWatermarkKey), Low: r.GetIntConfig(queueLowWatermarkKey)}
+		if w.Enabled() {
+			watermarks[name] = w
+		}
+	}
+	if len(watermarks) == 0 {
+		return
+	}
+
+	scheduled := listScheduledBeads(townRoot)
+	var crossings []queueCrossing
+	if _, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		crossings = queueCrossings(watermarks, scheduled, state)
+		return true, nil
+	}); err != nil {
+		style.PrintWarning("could not save queue levels: %v", err)
+	}
+
+	for _, c := range crossings {
+		var eta time.Duration
+		if c.Level == capacity.QueueLevelHigh {
+			eta = rigQueueETA(townRoot, c.Rig, scheduled, schedulerCfg)
+			notifyQueueBackingUp(townRoot, c, eta)
+		} else {
+			runQueueLowHook(townRoot, c)
+		}
+		_ = events.LogFeed(events.TypeQueueWatermark, "gt-scheduler",
+			events.QueueWatermarkPayload(c.Rig, c.Level, c.Depth, c.Watermarks.High, c.Watermarks.Low, eta))
+	}
+}
+
+// queueCrossings records each watermarked rig's queue level in state and
+// returns the rigs whose level changed to high or low.
+func queueCrossings(watermarks map[string]capacity.Watermarks, scheduled []scheduledBeadInfo, state *capacity.SchedulerState) []queueCrossing {
+	depth := make(map[string]int)
+	for _, b := range scheduled {
+		depth[b.TargetRig]++
+	}
+	if state.QueueLevels == nil {
+		state.QueueLevels = make(map[string]string)
+	}
+
+	var crossings []queueCrossing
+	for _, name := range slices.Sorted(maps.Keys(watermarks)) {
+		w := watermarks[name]
+		prev := state.QueueLevels[name]
+		level := w.Level(depth[name], prev)
+		if level == capacity.QueueLevelNormal {
+			delete(state.QueueLevels, name)
+		} else {
+			state.QueueLevels[name] = level
+		}
+		if level != prev && level != capacity.QueueLevelNormal {
+			crossings = append(crossings, queueCrossing{Rig: name, Level: level, Depth: depth[name], Watermarks: w})
+		}
+	}
+	// Forget rigs whose watermarks were turned off.
+	for name := range state.QueueLevels {
+		if _, ok := watermarks[name]; !ok {
+			delete(state.QueueLevels, name)
+		}
+	}
+	return crossings
+}
+
+// rigQueueETA estimates how long rigName takes to drain its queue, from the
+// duration estimates of its queued beads and how many polecats it can run at
+// once. Zero when no estimate is possible.
+func rigQueueETA(townRoot, rigName string, scheduled []scheduledBeadInfo, schedulerCfg *capacity.SchedulerConfig) time.Duration {
+	history := loadDurationHistory(townRoot, time.Now())
+	var durations []time.Duration
+	depth := 0
+	for _, b := range scheduled {
+		if b.TargetRig != rigName {
+			continue
+		}
+		depth++
+		if est, ok := capacity.EstimateDuration(history, b.Formula, b.Labels); ok {
+			durations = append(durations, est.Median)
+		}
+	}
+	parallel := (&rig.Rig{Name: rigName, Path: filepath.Join(townRoot, rigName)}).GetIntConfig("max_polecats")
+	if town := schedulerCfg.GetMaxPolecats(); town > 0 && (parallel <= 0 || town < parallel) {
+		parallel = town
+	}
+	eta, _ := capacity.QueueETA(durations, depth, parallel)
+	return eta
+}
+
+// queueBackingUpSubject is the alert subject, e.g. "queue backing up:
+// 40 beads, ETA 18h".
+func queueBackingUpSubject(c queueCrossing, eta time.Duration) string {
+	subject := fmt.Sprintf("%s queue backing up: %d beads", c.Rig, c.Depth)
+	if eta > 0 {
+		subject += ", ETA " + capacity.FormatEstimate(eta)
+	}
+	return subject
+}
+
+// notifyQueueBackingUp mails the overseer that a rig's queue crossed its
+// high watermark.
+func notifyQueueBackingUp(townRoot string, c queueCrossing, eta time.Duration) {
+	subject := queueBackingUpSubject(c, eta)
+	body := fmt.Sprintf(`%d beads are queued for %s, at or above its high watermark of %d.
+
+Review the queue with: gt scheduler list
+Add capacity with: gt rig config set %s max_polecats <n>
+
+This alert fires again only after the queue recovers.`,
+		c.Depth, c.Rig, c.Watermarks.High, c.Rig)
+
+	router := mail.NewRouter(townRoot)
+	defer router.WaitPendingNotifications()
+	if err := router.Send(&mail.Message{
+		From:    "gt-scheduler",
+		To:      "overseer",
+		Subject: subject,
+		Body:    body,
+	}); err != nil {
+		style.PrintWarning("could not send queue alert for %s: %v", c.Rig, err)
+		return
+	}
+	fmt.Printf("%s %s\n", style.Warning.Render("⚠"), subject)
+}
+
+// runQueueLowHook runs a rig's queue_low_hook, if set, from the rig
+// directory with GT_RIG, GT_QUEUE_DEPTH and GT_QUEUE_LOW_WATERMARK set.
+func runQueueLowHook(townRoot string, c queueCrossing) {
+	rigPath := filepath.Join(townRoot, c.Rig)
+	hook := (&rig.Rig{Name: c.Rig, Path: rigPath}).GetStringConfig(queueLowHookKey)
+	if hook == "" {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), queueLowHookTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "sh", "-c", hook) //nolint:gosec // G204: hook is from trusted rig config
+	cmd.Dir = rigPath
+	cmd.Env = append(os.Environ(),
+		"GT_RIG="+c.Rig,
+		"GT_QUEUE_DEPTH="+strconv.Itoa(c.Depth),
+		"GT_QUEUE_LOW_WATERMARK="+strconv.Itoa(c.Watermarks.Low),
+	)
+	start := time.Now()
+	if out, err := cmd.CombinedOutput(); err != nil {
+		style.PrintWarning("%s queue_low_hook failed: %v\n%s", c.Rig, err, out)
+		return
+	}
+	fmt.Printf("%s %s queue below %d (%d queued); ran queue_low_hook in %s\n",
+		style.Bold.Render("✓"), c.Rig, c.Watermarks.Low, c.Depth, humanize.Duration(time.Since(start).Round(time.Second)))
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func TestQueueCrossings(t *testing.T) {
+	watermarks := map[string]capacity.Watermarks{
+		"gastown": {High: 3, Low: 1},
+		"beads":   {Low: 1},
+		"wyvern":  {High: 2},
+	}
+	queued := func(rig string, n int) []scheduledBeadInfo {
+		var beads []scheduledBeadInfo
+		for range n {
+			beads = append(beads, scheduledBeadInfo{TargetRig: rig})
+		}
+		return beads
+	}
+	state := &capacity.SchedulerState{QueueLevels: map[string]string{"retired": capacity.QueueLevelHigh}}
+
+	// gastown backs up, beads is empty, wyvern is fine.
+	scheduled := append(queued("gastown", 4), queued("wyvern", 1)...)
+	crossings := queueCrossings(watermarks, scheduled, state)
+	if len(crossings) != 2 ||
+		crossings[0].Rig != "beads" || crossings[0].Level != capacity.QueueLevelLow || crossings[0].Depth != 0 ||
+		crossings[1].Rig != "gastown" || crossings[1].Level != capacity.QueueLevelHigh || crossings[1].Depth != 4 {
+		t.Fatalf("crossings = %+v, want beads low then gastown high", crossings)
+	}
+	if _, ok := state.QueueLevels["retired"]; ok {
+		t.Error("level kept for a rig without watermarks")
+	}
+
+	// Same depths next heartbeat: no repeat alerts.
+	if again := queueCrossings(watermarks, scheduled, state); len(again) != 0 {
+		t.Errorf("repeat crossings = %+v, want none", again)
+	}
+	if state.QueueLevels["gastown"] != capacity.QueueLevelHigh || state.QueueLevels["beads"] != capacity.QueueLevelLow {
+		t.Errorf("levels = %v", state.QueueLevels)
+	}
+}
+
+func TestQueueBackingUpSubject(t *testing.T) {
+	c := queueCrossing{Rig: "gastown", Level: capacity.QueueLevelHigh, Depth: 40}
+	if got := queueBackingUpSubject(c, 18*time.Hour); got != "gastown queue backing up: 40 beads, ETA 18h" {
+		t.Errorf("subject = %q", got)
+	}
+	if got := queueBackingUpSubject(c, 0); got != "gastown queue backing up: 40 beads" {
+		t.Errorf("subject without ETA = %q", got)
+	}
+}
@@ -0,0 +1,422 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/steveyegge/gastown/internal/artifacts"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/cmdpolicy"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/doltserver"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/krc"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/plugin"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/reaper"
+	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// cliSchemaVersion is bumped when the shape of gt schema output changes
+// incompatibly. Additive changes (new fields, commands, flags) don't bump it.
+const cliSchemaVersion = 1
+
+var schemaIncludeHidden bool
+
+var schemaCmd = &cobra.Command{
+	Use:         "schema [command...]",
+	GroupID:     GroupDiag,
+	Annotations: map[string]string{AnnotationPolecatSafe: "true"},
+	Short:       "Dump gt's commands, flags, and JSON output schemas",
+	Long: `Print a machine-readable description of the gt CLI as JSON.
+
+The schema lists every command with its usage, aliases, group, flags (name,
+type, default), and, for commands with --json output, a JSON Schema of that
+output derived from the Go types it is encoded from. Wrappers, TUIs, and
+agents can use it to discover what this gt build supports instead of
+scraping --help.
+
+Pass a command path to describe only that subtree.
+
+Examples:
+  gt schema
+  gt schema polecat
+  gt schema quota predict
+  gt schema | jq '.commands[] | select(.output) | .path'`,
+	RunE: runSchema,
+}
+
+func init() {
+	schemaCmd.Flags().BoolVar(&schemaIncludeHidden, "include-hidden", false, "Include hidden and internal commands")
+	rootCmd.AddCommand(schemaCmd)
+}
+
+// jsonOutputTypes maps command paths (without the binary name) to the Go type
+// their --json output is encoded from. Add an entry when giving a command
+// --json output; TestJSONOutputTypesCoverCommands fails until you do.
+//
+// Commands that pass bd's or dolt's JSON through, or whose output shape
+// depends on their arguments, map to json.RawMessage: their schema is left
+// open. Streaming commands map to the type of each line.
+var jsonOutputTypes = map[string]interface{}{
+	"access":                       accessReport{},
+	"account list":                 []AccountListItem{},
+	"account status":               []AccountStatusItem{},
+	"activity trace":               events.Event{}, // One per line
+	"agents check":                 CollisionReport{},
+	"agents state":                 agentStateResult{},
+	"audit":                        []AuditEntry{},
+	"bead artifacts":               []artifacts.Artifact{},
+	"bead timeline":                beadTimeline{},
+	"boot status":                  map[string]interface{}{},
+	"capacity":                     capacity.Snapshot{},
+	"cat":                          json.RawMessage{},
+	"changelog":                    []ChangelogEntry{},
+	"compact":                      compactResult{},
+	"compact report":               json.RawMessage{}, // Daily report or --weekly rollup
+	"config agent list":            []AgentListItem{},
+	"config default-agent list":    []AgentListItem{},
+	"config validate":              []config.SettingsIssue{},
+	"convoy list":                  []convoyListEntry{},
+	"convoy stage":                 StageResult{},
+	"convoy status":                convoyStatusOutput{},
+	"convoy stranded":              []strandedConvoyInfo{},
+	"convoy sweep":                 []convoySweepAction{},
+	"convoy watch":                 map[string]interface{}{},
+	"costs":                        CostsOutput{},
+	"crew list":                    []CrewListItem{},
+	"crew pristine":                []*crew.PristineResult{},
+	"crew status":                  []CrewStatusItem{},
+	"deacon feed-stranded":         deacon.FeedResult{},
+	"deacon report":                deacon.FindingsSummary{},
+	"deacon status":                DeaconStatusOutput{},
+	"dog dispatch":                 dogDispatchResult{},
+	"dog health-check":             dogHealthReport{},
+	"dog list":                     []DogListItem{},
+	"dog status":                   json.RawMessage{}, // One dog, or the pack summary
+	"epic status":                  epicStatusOutput{},
+	"epic tree":                    epicTreeNodeJSON{},
+	"escalate":                     map[string]interface{}{},
+	"escalate list":                []*beads.Issue{},
+	"escalate show":                map[string]interface{}{},
+	"escalate stale":               []*beads.ReescalationResult{},
+	"formula list":                 json.RawMessage{},
+	"formula show":                 json.RawMessage{},
+	"formula test":                 formulaTestReport{},
+	"health":                       HealthReport{},
+	"hook":                         MoleculeStatusInfo{},
+	"hook show":                    hookShowInfo{},
+	"hook status":                  MoleculeStatusInfo{},
+	"hooks list":                   hooksListOutput{},
+	"hooks scan":                   HooksOutput{},
+	"info":                         map[string]interface{}{},
+	"krc decay":                    krc.DecayReport{},
+	"krc stats":                    krc.Stats{},
+	"mail announces":               json.RawMessage{}, // Channels, or one channel's messages
+	"mail channel":                 json.RawMessage{}, // Channels, or one channel's messages
+	"mail channel list":            map[string]*beads.ChannelFields{},
+	"mail channel show":            []channelMessage{},
+	"mail channel subscribers":     []string{},
+	"mail check":                   map[string]interface{}{},
+	"mail directory":               []DirectoryEntry{},
+	"mail group list":              map[string]*beads.GroupFields{},
+	"mail group show":              beads.GroupFields{},
+	"mail inbox":                   []*mail.Message{},
+	"mail queue list":              []map[string]interface{}{},
+	"mail queue show":              map[string]interface{}{},
+	"mail read":                    mail.Message{},
+	"mail search":                  []*mail.Message{},
+	"mail thread":                  []*mail.Message{},
+	"mol attachment":               attachmentOutput{},
+	"mol await-signal":             AwaitSignalResult{},
+	"mol burn":                     map[string]interface{}{},
+	"mol current":                  MoleculeCurrentInfo{},
+	"mol dag":                      DAGInfo{},
+	"mol progress":                 MoleculeProgressInfo{},
+	"mol squash":                   map[string]interface{}{},
+	"mol status":                   MoleculeStatusInfo{},
+	"mol step await-event":         AwaitEventResult{},
+	"mol step await-signal":        AwaitSignalResult{},
+	"mol step done":                StepDoneResult{},
+	"mol step emit-event":          EmitEventResult{},
+	"mountain":                     []mountainConvoyInfo{},
+	"mountain status":              map[string]interface{}{},
+	"mq integration status":        IntegrationStatusOutput{},
+	"mq list":                      []verifiedMRIssue{}, // Verification fields only with --verify
+	"mq next":                      beads.Issue{},
+	"mq status":                    MRStatusOutput{},
+	"patrol scan":                  PatrolScanOutput{},
+	"plugin history":               []*plugin.PluginRunBead{},
+	"plugin list":                  []plugin.PluginSummary{},
+	"plugin show":                  plugin.Plugin{},
+	"polecat list":                 []PolecatListItem{},
+	"polecat status":               PolecatStatus{},
+	"polecat git-state":            GitState{},
+	"polecat check-recovery":       RecoveryStatus{},
+	"polecat env":                  polecat.SpawnRecord{},
+	"polecat identity list":        []IdentityInfo{},
+	"polecat identity show":        identityShowOutput{},
+	"polecat stale":                []*polecat.StalenessInfo{},
+	"polecat worktree-pool status": []WorktreePoolStatus{},
+	"policy check":                 cmdpolicy.Decision{},
+	"policy denials":               []policyDenial{},
+	"prime":                        SessionState{}, // With --state
+	"quota status":                 []QuotaStatusItem{},
+	"quota scan":                   []quota.ScanResult{},
+	"quota rotate":                 []quota.RotateResult{},
+	"quota predict":                []quota.Prediction{},
+	"ready":                        ReadyResult{},
+	"reaper auto-close":            []*reaper.AutoCloseResult{},
+	"reaper databases":             []string{},
+	"reaper purge":                 []*reaper.PurgeResult{},
+	"reaper reap":                  []*reaper.ReapResult{},
+	"reaper scan":                  []*reaper.ScanResult{},
+	"refinery blocked":             []*refinery.MRInfo{},
+	"refinery queue":               []refinery.QueueItem{},
+	"refinery ready":               refineryReadyOutput{},
+	"refinery status":              RefineryStatusOutput{},
+	"refinery unclaimed":           []*refinery.MRInfo{},
+	"rig list":                     []rigListItem{},
+	"scheduler audit":              []schedulerAuditFinding{},
+	"scheduler estimate":           schedulerEstimateOutput{},
+	"scheduler list":               []scheduledBeadInfo{},
+	"scheduler preview":            dispatchPreview{},
+	"scheduler replay":             replayPlan{},
+	"scheduler status":             schedulerStatusOutput{},
+	"scheduler verify":             schedulerVerifyReport{},
+	"seance":                       []sessionEvent{},
+	"session list":                 []SessionListItem{},
+	"session status":               polecat.SessionInfo{},
+	"stale":                        StaleOutput{},
+	"stats":                        statsReport{},
+	"status":                       TownStatus{},
+	"up":                           UpOutput{},
+	"witness status":               WitnessStatusOutput{},
+	"wl browse":                    json.RawMessage{},
+	"wl charsheet":                 doltserver.CharacterSheet{},
+	"wl scorekeeper":               scorekeeperSummary{},
+	"wl show":                      doltserver.WantedItem{},
+	"wl stamps":                    json.RawMessage{},
+}
+
+// CLISchema is the output of gt schema.
+type CLISchema struct {
+	SchemaVersion int             `json:"schema_version"`
+	Version       string          `json:"version"`
+	Commands      []CommandSchema `json:"commands"`
+}
+
+// CommandSchema describes one command.
+type CommandSchema struct {
+	Path        string            `json:"path"`
+	Use         string            `json:"use"`
+	Short       string            `json:"short,omitempty"`
+	Aliases     []string          `json:"aliases,omitempty"`
+	Group       string            `json:"group,omitempty"`
+	Runnable    bool              `json:"runnable"`
+	Hidden      bool              `json:"hidden,omitempty"`
+	Deprecated  string            `json:"deprecated,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Flags       []FlagSchema      `json:"flags,omitempty"`
+	Output      *JSONSchema       `json:"output,omitempty"` // Shape of --json output
+}
+
+// FlagSchema describes one flag. Inherited marks persistent flags declared
+// on an ancestor command.
+type FlagSchema struct {
+	Name       string `json:"name"`
+	Shorthand  string `json:"shorthand,omitempty"`
+	Type       string `json:"type"`
+	Default    string `json:"default,omitempty"`
+	Usage      string `json:"usage,omitempty"`
+	Inherited  bool   `json:"inherited,omitempty"`
+	Deprecated string `json:"deprecated,omitempty"`
+}
+
+// JSONSchema is the subset of JSON Schema needed to describe gt's output types.
+type JSONSchema struct {
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+}
+
+func runSchema(cmd *cobra.Command, args []string) error {
+	root := cmd.Root()
+	start := root
+	if len(args) > 0 {
+		found, rest, err := root.Find(args)
+		if err != nil || len(rest) > 0 || found == root {
+			return fmt.Errorf("unknown command %q", strings.Join(args, " "))
+		}
+		start = found
+	}
+
+	schema := CLISchema{
+		SchemaVersion: cliSchemaVersion,
+		Version:       Version,
+		Commands:      collectCommandSchemas(start, schemaIncludeHidden),
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(schema)
+}
+
+// collectCommandSchemas describes cmd and its subcommands, depth first in
+// name order.
+func collectCommandSchemas(cmd *cobra.Command, includeHidden bool) []CommandSchema {
+	if !includeHidden && (cmd.Hidden || cmd.Name() == "help" || cmd.Name() == "completion") {
+		return nil
+	}
+
+	cs := CommandSchema{
+		Path:        cmd.CommandPath(),
+		Use:         cmd.Use,
+		Short:       cmd.Short,
+		Aliases:     cmd.Aliases,
+		Group:       cmd.GroupID,
+		Runnable:    cmd.Runnable() && !isRequireSubcommand(cmd),
+		Hidden:      cmd.Hidden,
+		Deprecated:  cmd.Deprecated,
+		Annotations: cmd.Annotations,
+		Flags:       collectFlagSchemas(cmd),
+	}
+	if sample, ok := jsonOutputTypes[strings.TrimPrefix(cs.Path, cmd.Root().Name()+" ")]; ok {
+		cs.Output = jsonSchemaFor(reflect.TypeOf(sample), map[reflect.Type]bool{})
+	}
+
+	out := []CommandSchema{cs}
+	subs := append([]*cobra.Command(nil), cmd.Commands()...)
+	sort.Slice(subs, func(i, j int) bool { return subs[i].Name() < subs[j].Name() })
+	for _, sub := range subs {
+		out = append(out, collectCommandSchemas(sub, includeHidden)...)
+	}
+	return out
+}
+
+// isRequireSubcommand reports whether cmd is a parent that only errors when
+// run without a subcommand.
+func isRequireSubcommand(cmd *cobra.Command) bool {
+	return cmd.RunE != nil && reflect.ValueOf(cmd.RunE).Pointer() == reflect.ValueOf(requireSubcommand).Pointer()
+}
+
+func collectFlagSchemas(cmd *cobra.Command) []FlagSchema {
+	var flags []FlagSchema
+	add := func(inherited bool) func(*pflag.Flag) {
+		return func(f *pflag.Flag) {
+			if f.Hidden || f.Name == "help" {
+				return
+			}
+			flags = append(flags, FlagSchema{
+				Name:       f.Name,
+				Shorthand:  f.Shorthand,
+				Type:       f.Value.Type(),
+				Default:    f.DefValue,
+				Usage:      f.Usage,
+				Inherited:  inherited,
+				Deprecated: f.Deprecated,
+			})
+		}
+	}
+	cmd.LocalFlags().VisitAll(add(false))
+	cmd.InheritedFlags().VisitAll(add(true))
+	return flags
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// jsonSchemaFor derives a JSON Schema from a Go type the way encoding/json
+// would encode it. visiting guards against recursive types.
+func jsonSchemaFor(t reflect.Type, visiting map[reflect.Type]bool) *JSONSchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return &JSONSchema{Type: "string", Format: "date-time"}
+	}
+	if t.Implements(reflect.TypeOf((*json.Marshaler)(nil)).Elem()) {
+		return &JSONSchema{} // Custom encoding: shape unknown
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if t == reflect.TypeOf(time.Duration(0)) {
+			return &JSONSchema{Type: "integer", Format: "duration-ns"}
+		}
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &JSONSchema{Type: "string", Format: "byte"}
+		}
+		return &JSONSchema{Type: "array", Items: jsonSchemaFor(t.Elem(), visiting)}
+	case reflect.Map:
+		return &JSONSchema{Type: "object", AdditionalProperties: jsonSchemaFor(t.Elem(), visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			return &JSONSchema{Type: "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		s := &JSONSchema{Type: "object", Properties: map[string]*JSONSchema{}}
+		addStructFields(s, t, visiting)
+		sort.Strings(s.Required)
+		return s
+	default:
+		return &JSONSchema{} // interface{} and friends: any value
+	}
+}
+
+// addStructFields adds t's exported fields to s, flattening embedded structs
+// as encoding/json does.
+func addStructFields(s *JSONSchema, t reflect.Type, visiting map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addStructFields(s, ft, visiting)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s.Properties[name] = jsonSchemaFor(f.Type, visiting)
+		if !strings.Contains(","+opts+",", ",omitempty,") {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
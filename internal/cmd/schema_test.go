@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestJSONOutputTypesMatchCommands(t *testing.T) {
+	for path := range jsonOutputTypes {
+		args := strings.Fields(path)
+		cmd, rest, err := rootCmd.Find(args)
+		if err != nil || len(rest) > 0 || cmd.CommandPath() != rootCmd.Name()+" "+strings.Join(args, " ") {
+			t.Errorf("%q: no such command", path)
+			continue
+		}
+		if cmd.Flags().Lookup("json") == nil {
+			t.Errorf("%q: has an output schema but no --json flag", path)
+		}
+	}
+}
+
+func TestJSONOutputTypesCoverCommands(t *testing.T) {
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		if cmd.Flags().Lookup("json") != nil {
+			path := strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+			if _, ok := jsonOutputTypes[path]; !ok {
+				t.Errorf("%q has a --json flag but no output schema in jsonOutputTypes", path)
+			}
+		}
+		for _, sub := range cmd.Commands() {
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+}
+
+func TestJSONSchemaFor(t *testing.T) {
+	type inner struct {
+		Name string `json:"name"`
+	}
+	type embedded struct {
+		Shared bool `json:"shared"`
+	}
+	type node struct {
+		embedded
+		ID       int              `json:"id"`
+		Note     string           `json:"note,omitempty"`
+		Secret   string           `json:"-"`
+		When     time.Time        `json:"when"`
+		Tags     []string         `json:"tags"`
+		Labels   map[string]inner `json:"labels,omitempty"`
+		Children []*node          `json:"children,omitempty"`
+		Extra    interface{}      `json:"extra,omitempty"`
+		Plain    float64
+		hidden   string //nolint:unused // Verifies unexported fields are skipped
+	}
+
+	s := jsonSchemaFor(reflect.TypeOf(node{}), map[reflect.Type]bool{})
+	if s.Type != "object" {
+		t.Fatalf("type = %q, want object", s.Type)
+	}
+	want := map[string]string{
+		"shared": "boolean", "id": "integer", "note": "string", "when": "string",
+		"tags": "array", "labels": "object", "children": "array", "extra": "", "Plain": "number",
+	}
+	if len(s.Properties) != len(want) {
+		t.Errorf("properties = %v, want %d entries", s.Properties, len(want))
+	}
+	for name, typ := range want {
+		if p, ok := s.Properties[name]; !ok || p.Type != typ {
+			t.Errorf("property %q = %+v, want type %q", name, p, typ)
+		}
+	}
+	if s.Properties["when"].Format != "date-time" {
+		t.Error("time.Time should be a date-time string")
+	}
+	if got := s.Properties["labels"].AdditionalProperties.Properties["name"]; got == nil || got.Type != "string" {
+		t.Error("map values should be described by additionalProperties")
+	}
+	if got := s.Properties["children"].Items; got.Type != "object" || got.Properties != nil {
+		t.Errorf("recursive type should stop at a bare object, got %+v", got)
+	}
+	if strings.Join(s.Required, ",") != "Plain,id,shared,tags,when" {
+		t.Errorf("required = %v", s.Required)
+	}
+}
+
+func TestCollectCommandSchemas(t *testing.T) {
+	schemas := collectCommandSchemas(rootCmd, false)
+	byPath := make(map[string]CommandSchema, len(schemas))
+	for _, s := range schemas {
+		byPath[s.Path] = s
+	}
+
+	if s, ok := byPath["gt polecat"]; !ok || s.Runnable {
+		t.Errorf("gt polecat should be listed as a non-runnable parent, got %+v", s)
+	}
+	list, ok := byPath["gt polecat list"]
+	if !ok || !list.Runnable || list.Output == nil || list.Output.Type != "array" {
+		t.Errorf("gt polecat list should be runnable with an array output schema, got %+v", list)
+	}
+	for _, s := range schemas {
+		if s.Hidden {
+			t.Errorf("%s: hidden commands should be omitted by default", s.Path)
+		}
+	}
+	if _, ok := byPath["gt help"]; ok {
+		t.Error("help command should be omitted")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
@@ -599,31 +600,13 @@ func runSessionStatus(cmd *cobra.Command, args []string) error {
 	if !info.Created.IsZero() {
 		uptime := time.Since(info.Created)
 		fmt.Printf("  Created: %s\n", info.Created.Format("2006-01-02 15:04:05"))
-		fmt.Printf("  Uptime: %s\n", formatDuration(uptime))
+		fmt.Printf("  Uptime: %s\n", humanize.Duration(uptime))
 	}
 
 	fmt.Printf("\nAttach with: %s\n", style.Dim.Render(fmt.Sprintf("gt session at %s/%s", rigName, polecatName)))
 	return nil
 }
 
-// formatDuration formats a duration for human display.
-func formatDuration(d time.Duration) string {
-	if d < time.Minute {
-		return fmt.Sprintf("%ds", int(d.Seconds()))
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm %ds", int(d.Minutes()), int(d.Seconds())%60)
-	}
-	hours := int(d.Hours())
-	mins := int(d.Minutes()) % 60
-	if hours >= 24 {
-		days := hours / 24
-		hours = hours % 24
-		return fmt.Sprintf("%dd %dh %dm", days, hours, mins)
-	}
-	return fmt.Sprintf("%dh %dm", hours, mins)
-}
-
 func runSessionCheck(cmd *cobra.Command, args []string) error {
 	// Find town root
 	townRoot, err := workspace.FindFromCwdOrError()
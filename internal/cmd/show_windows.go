@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // execBdShow runs 'bd show' with stdio passthrough on Windows.
@@ -27,7 +29,7 @@ func execBdShow(args []string) error {
 	env := stripEnvKey(os.Environ(), "BEADS_DIR")
 
 	cmdArgs := append([]string{"show"}, args...)
-	cmd := exec.Command(bdPath, cmdArgs...)
+	cmd := beads.Limit(exec.Command(bdPath, cmdArgs...))
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
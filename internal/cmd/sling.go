@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -98,13 +97,24 @@ Compare:
 
 The propulsion principle: if it's on your hook, YOU RUN IT.
 
+Watching (--watch):
+  gt sling gt-abc gastown --watch   # Sling, then follow the polecat
+
+  After dispatching (or scheduling) a single bead, --watch stays attached:
+  it prints dispatch and spawn progress, the polecat's tool calls and
+  messages from its transcript, and exits with the final merge status.
+  Ctrl-C stops watching without touching the work.
+
 Batch Slinging:
   gt sling gt-abc gt-def gt-ghi gastown   # Sling multiple beads to a rig
   gt sling gt-abc gt-def gastown --max-concurrent 3  # Spawn 3 at a time
 
   When multiple beads are provided with a rig target, each bead gets its own
   polecat. This parallelizes work dispatch without running gt sling N times.
-  Use --max-concurrent to throttle spawn rate and prevent Dolt server overload.`,
+  Use --max-concurrent to throttle spawn rate and prevent Dolt server overload.
+  Slinging or scheduling more than 20 beads at once (a batch, convoy or epic)
+  asks for confirmation; pass --yes to skip it. The threshold is
+  confirm.enqueue_beads (gt config set).`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: runSling,
 }
@@ -135,6 +145,10 @@ var (
 	slingFormula       string // --formula: override formula for dispatch (default: mol-polecat-work)
 	slingCrew          string // --crew: target a crew member in the specified rig
 	slingReviewOnly    bool   // --review-only: mark work as review-only (no merge/commit/push)
+	slingYes           bool   // --yes: skip the bulk enqueue confirmation
+	slingMaxRetries    int    // --max-retries: per-bead circuit breaker override (-1 = scheduler default)
+	slingRetryBackoff  string // --retry-backoff: wait after a dispatch failure before retrying
+	slingWatch         bool   // --watch: follow the dispatched work until it merges
 )
 
 func init() {
@@ -160,9 +174,13 @@ func init() {
 	slingCmd.Flags().IntVar(&slingMaxConcurrent, "max-concurrent", 0, "Throttle spawn rate: spawn N polecats, pause, then spawn N more (0 = no throttle). Does not limit total concurrent polecats")
 	slingCmd.Flags().StringVar(&slingBaseBranch, "base-branch", "", "Override base branch for polecat worktree (e.g., 'develop', 'release/v2')")
 	slingCmd.Flags().BoolVar(&slingRalph, "ralph", false, "Enable Ralph Wiggum loop mode (fresh context per step, for multi-step workflows)")
-	slingCmd.Flags().StringVar(&slingFormula, "formula", "", "Formula to apply (default: mol-polecat-work for polecat targets)")
+	slingCmd.Flags().StringVar(&slingFormula, "formula", "", "Formula to apply (default: the rig's default_formula, else mol-polecat-work)")
 	slingCmd.Flags().StringVar(&slingCrew, "crew", "", "Target a crew member in the specified rig (e.g., --crew mel with target gastown → gastown/crew/mel)")
 	slingCmd.Flags().BoolVar(&slingReviewOnly, "review-only", false, "Mark work as review-only: assignee evaluates and reports back, must NOT merge/commit/push")
+	slingCmd.Flags().IntVar(&slingMaxRetries, "max-retries", -1, "Scheduled dispatch: retries after a failed dispatch before giving up (0 = fail fast; default: scheduler's limit)")
+	slingCmd.Flags().StringVar(&slingRetryBackoff, "retry-backoff", "", "Scheduled dispatch: wait this long after a failed dispatch before retrying (e.g. 10m)")
+	slingCmd.Flags().BoolVar(&slingWatch, "watch", false, "After dispatch, follow the work: spawn progress, transcript highlights and final merge status")
+	slingCmd.Flags().BoolVarP(&slingYes, "yes", "y", false, "Skip the confirmation for slinging or scheduling many beads (confirm.enqueue_beads)")
 
 	slingCmd.AddCommand(slingRespawnResetCmd)
 	rootCmd.AddCommand(slingCmd)
@@ -299,12 +317,30 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		}
 	}
 
+	// --watch: once the sling succeeds, follow the bead until its work lands.
+	if slingWatch {
+		watchBead, err := slingWatchTarget(args)
+		if err != nil {
+			return err
+		}
+		watchSince := time.Now()
+		defer func() {
+			if retErr == nil {
+				retErr = watchSlungWork(ctx, townRoot, watchBead, watchSince)
+			}
+		}()
+	}
+
 	// Config-driven dispatch mode: check scheduler.max_polecats
 	deferred, deferErr := shouldDeferDispatch()
 	if deferErr != nil {
 		return deferErr
 	}
 
+	if !deferred && (slingMaxRetries >= 0 || slingRetryBackoff != "") {
+		return fmt.Errorf("--max-retries and --retry-backoff apply to scheduled dispatch only (gt config set scheduler.max_polecats N)")
+	}
+
 	// Batch mode detection: multiple beads with optional rig target
 	// Pattern A (explicit rig):  gt sling gt-abc gt-def gt-ghi gastown
 	// Pattern B (auto-resolve):  gt sling gt-abc gt-def gt-ghi
@@ -366,6 +402,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				Agent:       slingAgent,
 				HookRawBead: slingHookRawBead,
 				Ralph:       slingRalph,
+
+				MaxRetries:   slingMaxRetriesOpt(),
+				RetryBackoff: slingRetryBackoff,
 			})
 		}
 	}
@@ -406,6 +445,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 			Agent:       slingAgent,
 			HookRawBead: slingHookRawBead,
 			Ralph:       slingRalph,
+
+			MaxRetries:   slingMaxRetriesOpt(),
+			RetryBackoff: slingRetryBackoff,
 		})
 	}
 
@@ -443,6 +485,9 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 				Agent:       slingAgent,
 				HookRawBead: slingHookRawBead,
 				Ralph:       slingRalph,
+
+				MaxRetries:   slingMaxRetriesOpt(),
+				RetryBackoff: slingRetryBackoff,
 			})
 		}
 		// Non-rig target in deferred mode — reject to prevent bypassing capacity control
@@ -453,7 +498,8 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	if len(args) == 1 {
 		idType, err := detectSchedulerIDType(args[0])
 		if err == nil && idType != "task" {
-			formula := resolveFormula(slingFormula, slingHookRawBead, townRoot, "")
+			// Each child gets its own rig's default formula unless --formula is set.
+			formula := slingFormula
 
 			switch idType {
 			case "convoy":
@@ -466,6 +512,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 						HookRawBead: slingHookRawBead,
 						Force:       slingForce,
 						DryRun:      slingDryRun,
+						Yes:         slingYes,
 					})
 				}
 				return runConvoySlingByID(args[0], convoyScheduleOpts{
@@ -474,6 +521,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 					Force:       slingForce,
 					DryRun:      slingDryRun,
 					NoBoot:      slingNoBoot,
+					Yes:         slingYes,
 				})
 			case "epic":
 				if err := validateNoTaskOnlySchedulerFlags(cmd, "epic"); err != nil {
@@ -485,6 +533,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 						HookRawBead: slingHookRawBead,
 						Force:       slingForce,
 						DryRun:      slingDryRun,
+						Yes:         slingYes,
 					})
 				}
 				return runEpicSlingByID(args[0], epicScheduleOpts{
@@ -493,6 +542,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 					Force:       slingForce,
 					DryRun:      slingDryRun,
 					NoBoot:      slingNoBoot,
+					Yes:         slingYes,
 				})
 			}
 		}
@@ -663,17 +713,20 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	if len(args) > 1 {
 		target = args[1]
 	}
+	correlationID := slingCorrelationID("", info)
 	resolved, err := resolveTarget(target, ResolveTargetOptions{
-		DryRun:     slingDryRun,
-		Force:      force,
-		Create:     slingCreate,
-		Account:    slingAccount,
-		Agent:      slingAgent,
-		NoBoot:     slingNoBoot,
-		HookBead:   beadID,
-		BeadID:     beadID,
-		TownRoot:   townRoot,
-		BaseBranch: slingBaseBranch,
+		DryRun:        slingDryRun,
+		Force:         force,
+		Create:        slingCreate,
+		Account:       slingAccount,
+		Agent:         slingAgent,
+		NoBoot:        slingNoBoot,
+		HookBead:      beadID,
+		BeadID:        beadID,
+		TownRoot:      townRoot,
+		BaseBranch:    slingBaseBranch,
+		CorrelationID: correlationID,
+		Sling:         slingFlagOptions(),
 	})
 	if err != nil {
 		return err
@@ -747,9 +800,8 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		}
 
 		// Unhook the bead from old owner (set status back to open)
-		unhookCmd := exec.Command("bd", "update", beadID, "--status=open", "--assignee=")
-		unhookCmd.Dir = beads.ResolveHookDir(townRoot, beadID, "")
-		if err := unhookCmd.Run(); err != nil {
+		unhookDir := beads.ResolveHookDir(townRoot, beadID, "")
+		if err := BdCmd("update", beadID, "--status=open", "--assignee=").Dir(unhookDir).Stderr(io.Discard).Run(); err != nil {
 			fmt.Printf("%s Could not unhook bead from old owner: %v\n", style.Dim.Render("Warning:"), err)
 		}
 	}
@@ -930,7 +982,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 
 	// Log sling event to activity feed
 	actor := detectActor()
-	_ = events.LogFeed(events.TypeSling, actor, events.SlingPayload(beadID, targetAgent))
+	_ = events.LogFeed(events.TypeSling, actor, events.WithCorrelation(events.SlingPayload(beadID, targetAgent), correlationID))
 
 	// Update agent bead's hook_bead field (ZFC: agents track their current work)
 	// Skip if hook was already set atomically during polecat spawn - avoids "agent bead not found"
@@ -952,6 +1004,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 		NoMerge:          slingNoMerge,
 		ReviewOnly:       slingReviewOnly,
 		FormulaVars:      strings.Join(slingVars, "\n"),
+		CorrelationID:    correlationID,
 	}
 	if err := storeFieldsInBead(beadID, fieldUpdates); err != nil {
 		// Warn but don't fail - polecat will still complete work
@@ -982,6 +1035,7 @@ func runSling(cmd *cobra.Command, args []string) (retErr error) {
 	// This ensures polecat sees the molecule when gt prime runs on session start.
 	freshlySpawned := newPolecatInfo != nil
 	if freshlySpawned {
+		newPolecatInfo.Formula, newPolecatInfo.FormulaVars = formulaName, slingVars
 		pane, err := newPolecatInfo.StartSession()
 		if err != nil {
 			// Rollback: session failed, clean up zombie artifacts (worktree, hooked bead).
@@ -1073,11 +1127,7 @@ func restorePinnedBead(townRoot, beadID, assignee string) {
 		return
 	}
 	dir := beads.ResolveHookDir(townRoot, beadID, "")
-	cmd := exec.Command("bd", "update", beadID, "--status=pinned", "--assignee="+assignee)
-	if dir != "" {
-		cmd.Dir = dir
-	}
-	if err := cmd.Run(); err != nil {
+	if err := BdCmd("update", beadID, "--status=pinned", "--assignee="+assignee).Dir(dir).Stderr(io.Discard).Run(); err != nil {
 		fmt.Printf("  %s Could not restore pinned state for bead %s: %v\n", style.Dim.Render("Warning:"), beadID, err)
 	} else {
 		fmt.Printf("  %s Restored pinned state for bead %s\n", style.Dim.Render("○"), beadID)
@@ -1170,9 +1220,7 @@ func rollbackSlingArtifacts(spawnInfo *SpawnedPolecatInfo, beadID, hookWorkDir,
 
 			// 2. Unhook the bead (set status back to open so it can be re-slung).
 			unhookDir := beads.ResolveHookDir(townRoot, beadID, hookWorkDir)
-			unhookCmd := exec.Command("bd", "update", beadID, "--status=open", "--assignee=")
-			unhookCmd.Dir = unhookDir
-			if err := unhookCmd.Run(); err != nil {
+			if err := BdCmd("update", beadID, "--status=open", "--assignee=").Dir(unhookDir).Stderr(io.Discard).Run(); err != nil {
 				fmt.Printf("  %s Could not unhook bead %s: %v\n", style.Dim.Render("Warning:"), beadID, err)
 			} else {
 				fmt.Printf("  %s Unhooked bead %s\n", style.Dim.Render("○"), beadID)
@@ -2,8 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -85,6 +85,13 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 		return nil
 	}
 
+	if ok, err := confirmEnqueueBeads("sling", beadIDs, slingYes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Sling canceled.")
+		return nil
+	}
+
 	fmt.Printf("%s Batch slinging %d beads to rig '%s'...\n", style.Bold.Render("🎯"), len(beadIDs), rigName)
 
 	if slingMaxConcurrent > 0 {
@@ -366,9 +373,7 @@ func closeConvoy(convoyID, reason string) {
 	}
 	townBeads := filepath.Join(townRoot, ".beads")
 	closeArgs := []string{"close", convoyID, "-r", reason}
-	closeCmd := exec.Command("bd", closeArgs...)
-	closeCmd.Dir = townBeads
-	if err := closeCmd.Run(); err != nil {
+	if err := BdCmd(closeArgs...).Dir(townBeads).Stderr(io.Discard).Run(); err != nil {
 		fmt.Printf("  %s Could not close convoy %s: %v\n", style.Dim.Render("Warning:"), convoyID, err)
 	} else {
 		fmt.Printf("  %s Closed convoy %s\n", style.Dim.Render("○"), convoyID)
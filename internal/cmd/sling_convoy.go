@@ -7,7 +7,7 @@ import (
 	"encoding/base32"
 	"encoding/json"
 	"fmt"
-	"os/exec"
+	"io"
 	"path/filepath"
 	"strings"
 
@@ -68,10 +68,10 @@ func findConvoyByDescription(townRoot, beadID string) string {
 	townBeads := filepath.Join(townRoot, ".beads")
 
 	// Query all open convoys from HQ
-	listCmd := exec.Command("bd", "list", "--type=convoy", "--status=open", "--json")
-	listCmd.Dir = townBeads
-
-	out, err := listCmd.Output()
+	out, err := BdCmd("list", "--type=convoy", "--status=open", "--json").
+		Dir(townBeads).
+		Stderr(io.Discard).
+		Output()
 	if err != nil {
 		return ""
 	}
@@ -149,13 +149,9 @@ func getConvoyInfoForIssue(issueID string) *ConvoyInfo {
 	townBeads := filepath.Join(townRoot, ".beads")
 
 	// Get convoy details (labels + description) for ownership and merge strategy
-	showCmd := exec.Command("bd", "show", convoyID, "--json")
-	showCmd.Dir = townBeads
-	var stdout, stderr bytes.Buffer
-	showCmd.Stdout = &stdout
-	showCmd.Stderr = &stderr
-
-	if err := showCmd.Run(); err != nil {
+	var stderr bytes.Buffer
+	stdout, err := BdCmd("show", convoyID, "--json").Dir(townBeads).Stderr(&stderr).Output()
+	if err != nil {
 		// Check if this is a "not found" error (phantom convoy) vs transient error.
 		// Phantom convoys occur when a convoy bead is deleted from HQ but tracking
 		// deps still exist in local beads DB (gt-9xum2). Return nil to treat as
@@ -174,7 +170,7 @@ func getConvoyInfoForIssue(issueID string) *ConvoyInfo {
 		Labels      []string `json:"labels"`
 		Description string   `json:"description"`
 	}
-	if err := json.Unmarshal(stdout.Bytes(), &convoys); err != nil || len(convoys) == 0 {
+	if err := json.Unmarshal(stdout, &convoys); err != nil || len(convoys) == 0 {
 		return &ConvoyInfo{ID: convoyID}
 	}
 
@@ -234,15 +230,11 @@ func printConvoyConflict(beadID, convoyID string) {
 
 	// Get convoy title
 	var convoyTitle string
-	showCmd := exec.Command("bd", "show", convoyID, "--json")
-	showCmd.Dir = townBeads
-	var showOut bytes.Buffer
-	showCmd.Stdout = &showOut
-	if err := showCmd.Run(); err == nil {
+	if showOut, err := BdCmd("show", convoyID, "--json").Dir(townBeads).Stderr(io.Discard).Output(); err == nil {
 		var items []struct {
 			Title string `json:"title"`
 		}
-		if json.Unmarshal(showOut.Bytes(), &items) == nil && len(items) > 0 {
+		if json.Unmarshal(showOut, &items) == nil && len(items) > 0 {
 			convoyTitle = items[0].Title
 		}
 	}
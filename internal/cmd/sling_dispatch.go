@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
@@ -38,6 +39,10 @@ type SlingParams struct {
 	Mode       string   // --ralph: "" (normal) or "ralph"
 	ReviewOnly bool     // --review-only: review and report back only, no merge/commit/push
 
+	// CorrelationID ties this dispatch's events together; executeSling
+	// picks one when empty (see slingCorrelationID).
+	CorrelationID string
+
 	// Execution behavior (set by caller, not serialized to queue)
 	SkipCook         bool   // Batch optimization: formula already cooked
 	FormulaFailFatal bool   // true=rollback+error (single/queue), false=hook raw bead (batch)
@@ -46,6 +51,46 @@ type SlingParams struct {
 	BeadsDir         string
 }
 
+// spawnRecordOptions returns the sling options kept in a spawned polecat's
+// spawn record.
+func (p SlingParams) spawnRecordOptions() *polecat.SlingOptions {
+	return &polecat.SlingOptions{
+		Args:        p.Args,
+		Vars:        p.Vars,
+		Merge:       p.Merge,
+		Mode:        p.Mode,
+		NoMerge:     p.NoMerge,
+		ReviewOnly:  p.ReviewOnly,
+		Owned:       p.Owned,
+		NoConvoy:    p.NoConvoy,
+		HookRawBead: p.HookRawBead,
+		Force:       p.Force,
+		Caller:      p.CallerContext,
+	}
+}
+
+// slingFlagOptions returns the gt sling flags for a spawn record, for the
+// single-sling paths that don't build SlingParams.
+func slingFlagOptions() *polecat.SlingOptions {
+	mode := ""
+	if slingRalph {
+		mode = "ralph"
+	}
+	return SlingParams{
+		Args:          slingArgs,
+		Vars:          slingVars,
+		Merge:         slingMerge,
+		Mode:          mode,
+		NoMerge:       slingNoMerge,
+		ReviewOnly:    slingReviewOnly,
+		Owned:         slingOwned,
+		NoConvoy:      slingNoConvoy,
+		HookRawBead:   slingHookRawBead,
+		Force:         slingForce,
+		CallerContext: "sling",
+	}.spawnRecordOptions()
+}
+
 // SlingResult captures the outcome of executeSling for caller-level tracking.
 type SlingResult struct {
 	BeadID           string
@@ -164,6 +209,8 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 		return result, fmt.Errorf("bead %s is deferred (use --force to override)", params.BeadID)
 	}
 
+	correlationID := slingCorrelationID(params.CorrelationID, info)
+
 	// Send LIFECYCLE:Shutdown to the witness when force-stealing a bead from a
 	// live polecat. Without this, the old polecat becomes a zombie — still running
 	// but unaware it lost its hook. Mirrors the same logic in runSling (sling.go).
@@ -216,11 +263,13 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 
 	// 3. Spawn polecat (via spawnPolecatForSling)
 	spawnOpts := SlingSpawnOptions{
-		Force:      params.Force,
-		Account:    params.Account,
-		HookBead:   params.BeadID,
-		Agent:      params.Agent,
-		BaseBranch: params.BaseBranch,
+		Force:         params.Force,
+		Account:       params.Account,
+		HookBead:      params.BeadID,
+		Agent:         params.Agent,
+		BaseBranch:    params.BaseBranch,
+		CorrelationID: correlationID,
+		Sling:         params.spawnRecordOptions(),
 		// Create is always true for rig targets: executeSling only handles
 		// rig-targeted dispatch (batch sling + queue dispatch), where a fresh
 		// polecat must be spawned. The single-sling path (runSling) handles
@@ -333,7 +382,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 
 	// 8. Log sling event
 	actor := detectActor()
-	_ = events.LogFeed(events.TypeSling, actor, events.SlingPayload(beadToHook, targetAgent))
+	_ = events.LogFeed(events.TypeSling, actor, events.WithCorrelation(events.SlingPayload(beadToHook, targetAgent), correlationID))
 
 	// 9. Update agent hook_bead state
 	updateAgentHookBead(targetAgent, beadToHook, hookWorkDir, beadsDir)
@@ -349,6 +398,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 		ReviewOnly:       params.ReviewOnly,
 		Mode:             params.Mode,
 		FormulaVars:      strings.Join(allVars, "\n"),
+		CorrelationID:    correlationID,
 	}
 	// Use beadToHook for the update target (may differ from beadID when formula-on-bead)
 	if err := storeFieldsInBead(beadToHook, fieldUpdates); err != nil {
@@ -361,6 +411,7 @@ func executeSling(params SlingParams) (*SlingResult, error) {
 	}
 
 	// 11. Start polecat session
+	spawnInfo.Formula, spawnInfo.FormulaVars = params.FormulaName, allVars
 	pane, err := spawnInfo.StartSession()
 	if err != nil {
 		fmt.Printf("  %s Could not start session: %v, cleaning up partial state...\n", style.Dim.Render("✗"), err)
@@ -99,6 +99,7 @@ func runSlingFormula(ctx context.Context, args []string) error {
 		NoBoot:   slingNoBoot,
 		WorkDesc: formulaName,
 		TownRoot: townRoot,
+		Sling:    slingFlagOptions(),
 	})
 	if err != nil {
 		return err
@@ -231,6 +232,7 @@ func runSlingFormula(ctx context.Context, args []string) error {
 	// Start spawned polecat session now that hook is set.
 	// This ensures polecat sees the wisp when gt prime runs on session start.
 	if resolved.NewPolecatInfo != nil {
+		resolved.NewPolecatInfo.Formula, resolved.NewPolecatInfo.FormulaVars = formulaName, slingVars
 		pane, err := resolved.NewPolecatInfo.StartSession()
 		if err != nil {
 			// Rollback: unhook wisp, delete Dolt branch, clean up polecat worktree/agent bead
@@ -19,6 +19,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/formula"
 	rigpkg "github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
@@ -91,6 +92,7 @@ type beadInfo struct {
 	Labels       []string         `json:"labels,omitempty"`
 	Dependencies []beads.IssueDep `json:"dependencies,omitempty"`
 	IssueType    string           `json:"issue_type,omitempty"`
+	Metadata     json.RawMessage  `json:"metadata,omitempty"`
 }
 
 // isDeferredBead checks whether a bead should be rejected from slinging because
@@ -109,6 +111,22 @@ func isDeferredBead(info *beadInfo) bool {
 	return false
 }
 
+// slingCorrelationID picks the correlation ID for a dispatch: the caller's
+// (e.g. the scheduler's, minted at enqueue), else the one a previous sling
+// left on the bead, else a fresh one.
+func slingCorrelationID(explicit string, info *beadInfo) string {
+	if explicit != "" {
+		return explicit
+	}
+	if info != nil {
+		fields := beads.ParseAttachmentFields(&beads.Issue{Description: info.Description})
+		if fields != nil && fields.CorrelationID != "" {
+			return fields.CorrelationID
+		}
+	}
+	return events.NewCorrelationID()
+}
+
 // collectExistingMolecules returns all molecule wisp IDs attached to a bead.
 // Checks both dependency bonds (ground truth from bd mol bond) and the
 // description's attached_molecule field (metadata pointer). Wisp IDs are
@@ -274,40 +292,61 @@ type beadFieldUpdates struct {
 	MergeStrategy    string // Convoy merge strategy: "direct", "mr", "local"
 	ConvoyOwned      bool   // Convoy has gt:owned label (caller-managed lifecycle)
 	FormulaVars      string // Newline-separated key=value pairs for formula template substitution
+	BatchBeads       []string // Beads batched onto this bead's polecat
+	CorrelationID    string   // Ties the bead's pipeline events together
 }
 
 // storeFieldsInBead performs a single read-modify-write to update all attachment fields
 // in a bead's description atomically. This replaces the sequential storeDispatcherInBead,
 // storeArgsInBead, storeAttachedMoleculeInBead, and storeNoMergeInBead calls that each
 // independently read-modify-write and could race under concurrent access.
+// The write is skipped and redone if the description changes after it is read (for
+// example a human editing the bead mid-dispatch); see beads.ApplyDescriptionUpdate.
 func storeFieldsInBead(beadID string, updates beadFieldUpdates) error {
-	logPath := os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG")
+	if logPath := os.Getenv("GT_TEST_ATTACHED_MOLECULE_LOG"); logPath != "" {
+		_ = os.WriteFile(logPath, []byte(applyBeadFieldUpdates(&beads.Issue{}, updates)), 0644)
+		return nil
+	}
 
-	issue := &beads.Issue{}
-	if logPath == "" {
-		// Read the bead once
+	read := func() (*beads.Issue, error) {
 		out, err := BdCmd("show", beadID, "--json", "--allow-stale").
 			Dir(resolveBeadDir(beadID)).
 			StripBeadsDir().
 			Stderr(io.Discard).
 			Output()
 		if err != nil {
-			return fmt.Errorf("fetching bead: %w", err)
+			return nil, fmt.Errorf("fetching bead: %w", err)
 		}
 		if len(out) == 0 {
-			return fmt.Errorf("bead not found")
+			return nil, fmt.Errorf("bead not found")
 		}
 
 		var issues []beads.Issue
 		if err := json.Unmarshal(out, &issues); err != nil {
-			return fmt.Errorf("parsing bead: %w", err)
+			return nil, fmt.Errorf("parsing bead: %w", err)
 		}
 		if len(issues) == 0 {
-			return fmt.Errorf("bead not found")
+			return nil, fmt.Errorf("bead not found")
+		}
+		return &issues[0], nil
+	}
+	write := func(description string) error {
+		if err := BdCmd("update", beadID, "--description="+description).
+			Dir(resolveBeadDir(beadID)).
+			StripBeadsDir().
+			Run(); err != nil {
+			return fmt.Errorf("updating bead description: %w", err)
 		}
-		issue = &issues[0]
+		return nil
 	}
+	return beads.ApplyDescriptionUpdate(read, write, func(issue *beads.Issue) (string, error) {
+		return applyBeadFieldUpdates(issue, updates), nil
+	})
+}
 
+// applyBeadFieldUpdates returns issue's description with updates applied to
+// its attachment fields.
+func applyBeadFieldUpdates(issue *beads.Issue, updates beadFieldUpdates) string {
 	// Get or create attachment fields
 	fields := beads.ParseAttachmentFields(issue)
 	if fields == nil {
@@ -354,22 +393,14 @@ func storeFieldsInBead(beadID string, updates beadFieldUpdates) error {
 	if updates.FormulaVars != "" {
 		fields.FormulaVars = updates.FormulaVars
 	}
-
-	// Write back once
-	newDesc := beads.SetAttachmentFields(issue, fields)
-	if logPath != "" {
-		_ = os.WriteFile(logPath, []byte(newDesc), 0644)
-		return nil
+	if len(updates.BatchBeads) > 0 {
+		fields.BatchBeads = append([]string(nil), updates.BatchBeads...)
 	}
-
-	if err := BdCmd("update", beadID, "--description="+newDesc).
-		Dir(resolveBeadDir(beadID)).
-		StripBeadsDir().
-		Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
+	if updates.CorrelationID != "" {
+		fields.CorrelationID = updates.CorrelationID
 	}
 
-	return nil
+	return beads.SetAttachmentFields(issue, fields)
 }
 
 // injectStartPrompt sends a prompt to the target pane to start working.
@@ -590,13 +621,26 @@ func wakeRigAgents(rigName string) {
 		}
 	}
 
-	// Immediate delivery to witness: send directly to tmux pane.
-	// No cooperative queue — idle agents never call Drain(), so queued
-	// nudges would be stuck forever. Direct delivery is safe: if the
-	// agent is busy, text buffers in tmux and is processed at next prompt.
+	// Immediate delivery to witness: send directly to the tmux pane.
+	// No cooperative queue for tmux agents — idle agents never call Drain(),
+	// so queued nudges would be stuck forever. Direct delivery is safe: if
+	// the agent is busy, text buffers in tmux and is processed at next
+	// prompt. A headless witness has no pane and is woken through its queue.
 	witnessSession := session.WitnessSessionName(session.PrefixFor(rigName))
-	t := tmux.NewTmux()
-	if err := t.NudgeSession(witnessSession, "Polecat dispatched - check for work"); err != nil {
+
+	// Test hook: log nudge for test observability (same as nudgeWitness)
+	if logPath := os.Getenv("GT_TEST_NUDGE_LOG"); logPath != "" {
+		entry := fmt.Sprintf("nudge:%s:%s\n", witnessSession, "Polecat dispatched - check for work")
+		f, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err == nil {
+			_, _ = f.WriteString(entry)
+			_ = f.Close()
+		}
+		return // Don't actually wake the witness in tests
+	}
+
+	waker := session.NewWaker(townRoot, "sling", tmux.NewTmux())
+	if _, err := waker.Wake(witnessSession, "Polecat dispatched - check for work"); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: failed to nudge witness %s: %v\n", witnessSession, err)
 	}
 }
@@ -891,8 +935,9 @@ func parseBondSpawnRootIDWithStatus(bondOut []byte, formulaName, beadID, fallbac
 // ensureFormulaRequiredVars appends missing required vars for formulas that enforce
 // strict var presence on direct bond paths.
 func ensureFormulaRequiredVars(formulaName string, vars []string) []string {
-	// Currently only mol-polecat-work has strict required vars on bond.
-	if formulaName != "mol-polecat-work" && formulaName != "polecat-work" {
+	// Currently only mol-polecat-work and its batch variant have strict
+	// required vars on bond.
+	if formulaName != "mol-polecat-work" && formulaName != "polecat-work" && formulaName != "mol-polecat-batch" {
 		return vars
 	}
 
@@ -1113,24 +1158,7 @@ func loadRigCommandVars(townRoot, rig string) []string {
 		vars = append(vars, fmt.Sprintf("base_branch=%s", rigCfg.DefaultBranch))
 	}
 
-	// Load repo-sourced settings (floor — committed to git, always present after clone)
-	var repoMQ *config.MergeQueueConfig
-	repoRoot := filepath.Join(townRoot, rig, "mayor", "rig")
-	repoSettings, _ := config.LoadRepoSettings(repoRoot)
-	if repoSettings != nil {
-		repoMQ = repoSettings.MergeQueue
-	}
-
-	// Load rig-local settings (override — operator tuning)
-	var localMQ *config.MergeQueueConfig
-	settingsPath := filepath.Join(townRoot, rig, "settings", "config.json")
-	localSettings, err := config.LoadRigSettings(settingsPath)
-	if err == nil && localSettings != nil {
-		localMQ = localSettings.MergeQueue
-	}
-
-	// Merge: repo defaults + local overrides
-	mq := config.MergeSettingsCommand(repoMQ, localMQ)
+	mq := loadRigMergeQueueSettings(townRoot, rig)
 	if mq == nil {
 		return vars
 	}
@@ -1159,6 +1187,30 @@ func loadRigCommandVars(townRoot, rig string) []string {
 	return vars
 }
 
+// loadRigMergeQueueSettings returns the rig's merge queue settings: the
+// repository defaults (<rig>/mayor/rig/.gastown/settings.json) overlaid with
+// rig-local overrides (<rig>/settings/config.json). Nil if neither is set.
+func loadRigMergeQueueSettings(townRoot, rig string) *config.MergeQueueConfig {
+	// Load repo-sourced settings (floor — committed to git, always present after clone)
+	var repoMQ *config.MergeQueueConfig
+	repoRoot := filepath.Join(townRoot, rig, "mayor", "rig")
+	repoSettings, _ := config.LoadRepoSettings(repoRoot)
+	if repoSettings != nil {
+		repoMQ = repoSettings.MergeQueue
+	}
+
+	// Load rig-local settings (override — operator tuning)
+	var localMQ *config.MergeQueueConfig
+	settingsPath := filepath.Join(townRoot, rig, "settings", "config.json")
+	localSettings, err := config.LoadRigSettings(settingsPath)
+	if err == nil && localSettings != nil {
+		localMQ = localSettings.MergeQueue
+	}
+
+	// Merge: repo defaults + local overrides
+	return config.MergeSettingsCommand(repoMQ, localMQ)
+}
+
 // shouldAcceptPermissionWarning checks if the agent emits a bypass-permissions
 // warning on startup that needs to be acknowledged via tmux.
 func shouldAcceptPermissionWarning(agentName string) bool {
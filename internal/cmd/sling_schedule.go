@@ -61,6 +61,13 @@ type ScheduleOptions struct {
 	Agent       string   // Agent override (e.g., "gemini", "codex")
 	HookRawBead bool     // Hook raw bead without default formula
 	Ralph       bool     // Ralph Wiggum loop mode
+	Priority    *int     // Dispatch priority (0 = highest); nil = default
+
+	// MaxRetries overrides the circuit breaker: dispatch retries after the
+	// first failure. nil = the scheduler default.
+	MaxRetries *int
+	// RetryBackoff is the wait after a dispatch failure (e.g. "10m").
+	RetryBackoff string
 }
 
 // scheduleBead schedules a bead for deferred dispatch via the capacity scheduler.
@@ -79,6 +86,17 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 		return fmt.Errorf("'%s' is not a known rig", rigName)
 	}
 
+	if err := validateRetryPolicy(opts.MaxRetries, opts.RetryBackoff); err != nil {
+		return err
+	}
+
+	// Catch a mistyped --account now rather than when the bead is dispatched.
+	if opts.Account != "" {
+		if err := validateAccountHandle(townRoot, opts.Account); err != nil {
+			return err
+		}
+	}
+
 	if !opts.Force {
 		if err := checkCrossRigGuard(beadID, rigName+"/polecats/_", townRoot); err != nil {
 			return err
@@ -137,10 +155,11 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 
 	// Build sling context fields
 	fields := &capacity.SlingContextFields{
-		Version:    1,
-		WorkBeadID: beadID,
-		TargetRig:  rigName,
-		EnqueuedAt: time.Now().UTC().Format(time.RFC3339),
+		Version:       capacity.SlingContextVersion,
+		WorkBeadID:    beadID,
+		TargetRig:     rigName,
+		EnqueuedAt:    time.Now().UTC().Format(time.RFC3339),
+		CorrelationID: events.NewCorrelationID(),
 	}
 	if opts.Formula != "" {
 		fields.Formula = opts.Formula
@@ -170,6 +189,12 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 		fields.Mode = "ralph"
 	}
 	fields.Owned = opts.Owned
+	fields.Priority = opts.Priority
+	fields.MaxRetries = opts.MaxRetries
+	fields.RetryBackoff = opts.RetryBackoff
+	fields.Batchable = capacity.HasBatchableLabel(info.Labels)
+	fields.Labels = info.Labels
+	fields.Paths = predictedPaths(info, fields.Formula)
 
 	// Create sling context bead in the target rig's beads dir so the rig's
 	// witness discovers it during patrol. (GH#3468)
@@ -189,7 +214,9 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 				fmt.Printf("%s Created convoy %s\n", style.Bold.Render("→"), convoyID)
 				// Update the context bead fields with convoy ID
 				fields.Convoy = convoyID
-				if updateErr := rigBeads.UpdateSlingContextFields(ctxBead.ID, fields); updateErr != nil {
+				if _, updateErr := rigBeads.MutateSlingContextFields(ctxBead.ID, func(f *capacity.SlingContextFields) {
+					f.Convoy = convoyID
+				}); updateErr != nil {
 					fmt.Printf("%s Could not update context with convoy: %v\n", style.Dim.Render("Warning:"), updateErr)
 				}
 			}
@@ -199,9 +226,14 @@ func scheduleBead(beadID, rigName string, opts ScheduleOptions) error {
 	}
 
 	actor := detectActor()
-	_ = events.LogFeed(events.TypeSchedulerEnqueue, actor, events.SchedulerEnqueuePayload(beadID, rigName))
+	_ = events.LogFeed(events.TypeSchedulerEnqueue, actor,
+		events.WithCorrelation(events.SchedulerEnqueuePayload(beadID, rigName), fields.CorrelationID))
 
 	fmt.Printf("%s Scheduled %s → %s (context: %s)\n", style.Bold.Render("✓"), beadID, rigName, ctxBead.ID)
+	if est, ok := capacity.EstimateDuration(loadDurationHistory(townRoot, time.Now()), fields.Formula, fields.Labels); ok {
+		fmt.Printf("  Estimated duration: %s (median of %d %s runs)\n", est, est.Samples, estimateBasisDesc(est))
+	}
+	postEnqueueQueueNote(townRoot, beadID)
 	return nil
 }
 
@@ -216,6 +248,13 @@ func runBatchSchedule(beadIDs []string, rigName, townRoot string) error {
 		return nil
 	}
 
+	if ok, err := confirmEnqueueBeads("schedule", beadIDs, slingYes); err != nil {
+		return err
+	} else if !ok {
+		fmt.Println("Schedule canceled.")
+		return nil
+	}
+
 	fmt.Printf("%s Scheduling %d beads to rig '%s'...\n", style.Bold.Render("📋"), len(beadIDs), rigName)
 
 	successCount := 0
@@ -236,6 +275,9 @@ func runBatchSchedule(beadIDs []string, rigName, townRoot string) error {
 			Agent:       slingAgent,
 			HookRawBead: slingHookRawBead,
 			Ralph:       slingRalph,
+
+			MaxRetries:   slingMaxRetriesOpt(),
+			RetryBackoff: slingRetryBackoff,
 		})
 		if err != nil {
 			fmt.Printf("  %s %s: %v\n", style.Dim.Render("✗"), beadID, err)
@@ -263,14 +305,17 @@ func resolveRigForBead(townRoot, beadID string) string {
 // resolveFormula determines the formula name from user flags and rig settings.
 // Resolution order:
 //  1. Explicit --formula flag
-//  2. Rig property layers (wisp → bead → system default "mol-polecat-work")
+//  2. Rig property layers (wisp → bead)
 //  3. Rig settings file (workflow.default_formula in settings/config.json)
-//  4. Hardcoded fallback "mol-polecat-work"
+//  4. System default "mol-polecat-work"
 //
 // The property layers are the primary mechanism, supporting:
 //
 //	gt rig config set <rig> default_formula mol-evolve         # wisp layer
 //	gt rig config set <rig> default_formula mol-evolve --global # bead layer
+//
+// Bulk paths (epics, convoys, batches) resolve per bead with the bead's own
+// rig, so children landing in different rigs each get their rig's default.
 func resolveFormula(explicit string, hookRawBead bool, townRoot, rigName string) string {
 	if hookRawBead {
 		return ""
@@ -278,19 +323,16 @@ func resolveFormula(explicit string, hookRawBead bool, townRoot, rigName string)
 	if explicit != "" {
 		return explicit
 	}
-	// Check rig property layers: wisp → bead → system default (issue gt-y18).
 	if townRoot != "" && rigName != "" {
-		r := &rig.Rig{
-			Name: rigName,
-			Path: filepath.Join(townRoot, rigName),
-		}
-		if df := r.GetStringConfig("default_formula"); df != "" {
+		rigPath := filepath.Join(townRoot, rigName)
+		// Check rig property layers: wisp → bead (issue gt-y18). The system
+		// default layer is skipped so the settings file below still applies.
+		r := &rig.Rig{Name: rigName, Path: rigPath}
+		result := r.GetConfigWithSource("default_formula")
+		if df, ok := result.Value.(string); ok && df != "" && result.Source != rig.SourceSystem {
 			return df
 		}
-	}
-	// Fallback: check rig settings file (legacy path, issue gt-boc).
-	if townRoot != "" && rigName != "" {
-		rigPath := filepath.Join(townRoot, rigName)
+		// Rig settings file (legacy path, issue gt-boc).
 		if df := config.GetDefaultFormula(rigPath); df != "" {
 			return df
 		}
@@ -298,6 +340,14 @@ func resolveFormula(explicit string, hookRawBead bool, townRoot, rigName string)
 	return "mol-polecat-work"
 }
 
+// formulaLabel names a resolved formula for dry-run output.
+func formulaLabel(formula string) string {
+	if formula == "" {
+		return "raw bead, no formula"
+	}
+	return formula
+}
+
 // slingContextTTL is the maximum age of a sling context before it's considered
 // stale and ignored by areScheduled(). This prevents orphaned sling contexts
 // (from failed spawns or throttled dispatches) from permanently blocking tasks.
@@ -398,11 +448,35 @@ func detectSchedulerIDType(id string) (string, error) {
 	return "task", nil
 }
 
+// validateRetryPolicy checks a per-bead retry override before it is stored.
+func validateRetryPolicy(maxRetries *int, backoff string) error {
+	if maxRetries != nil && *maxRetries < 0 {
+		return fmt.Errorf("invalid --max-retries %d: must be 0 or more", *maxRetries)
+	}
+	if backoff != "" {
+		d, err := time.ParseDuration(backoff)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid --retry-backoff %q: must be a positive duration (e.g. 30s, 10m)", backoff)
+		}
+	}
+	return nil
+}
+
+// slingMaxRetriesOpt returns --max-retries, or nil when it was not given.
+func slingMaxRetriesOpt() *int {
+	if slingMaxRetries < 0 {
+		return nil
+	}
+	n := slingMaxRetries
+	return &n
+}
+
 // schedulerTaskOnlyFlagNames lists flags that only apply to task bead scheduling,
 // not convoy or epic mode.
 var schedulerTaskOnlyFlagNames = []string{
 	"account", "agent", "ralph", "args", "var",
 	"merge", "base-branch", "no-convoy", "owned", "no-merge",
+	"max-retries", "retry-backoff",
 }
 
 // validateNoTaskOnlySchedulerFlags checks that no task-only flags were set.
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/wisp"
 )
 
@@ -45,7 +46,7 @@ func TestAreScheduledEmptyInput(t *testing.T) {
 }
 
 // TestResolveFormula verifies formula resolution precedence:
-// explicit flag > wisp layer > bead layer > system default > settings file > hardcoded fallback.
+// explicit flag > wisp layer > bead layer > rig settings file > system default.
 func TestResolveFormula(t *testing.T) {
 	t.Parallel()
 
@@ -93,6 +94,29 @@ func TestResolveFormula(t *testing.T) {
 		}
 	})
 
+	t.Run("rig settings file overrides system default", func(t *testing.T) {
+		t.Parallel()
+		tmpDir := t.TempDir()
+		rigName := "docsrig"
+		settings := config.NewRigSettings()
+		settings.Workflow = &config.WorkflowConfig{DefaultFormula: "mol-docs-work"}
+		if err := config.SaveRigSettings(config.RigSettingsPath(filepath.Join(tmpDir, rigName)), settings); err != nil {
+			t.Fatalf("save settings: %v", err)
+		}
+
+		if got := resolveFormula("", false, tmpDir, rigName); got != "mol-docs-work" {
+			t.Errorf("got %q, want %q", got, "mol-docs-work")
+		}
+
+		wispCfg := wisp.NewConfig(tmpDir, rigName)
+		if err := wispCfg.Set("default_formula", "mol-evolve"); err != nil {
+			t.Fatalf("wisp set: %v", err)
+		}
+		if got := resolveFormula("", false, tmpDir, rigName); got != "mol-evolve" {
+			t.Errorf("with wisp layer: got %q, want %q", got, "mol-evolve")
+		}
+	})
+
 	t.Run("explicit flag overrides wisp layer", func(t *testing.T) {
 		t.Parallel()
 		tmpDir := t.TempDir()
@@ -118,3 +142,28 @@ func TestResolveFormula(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateRetryPolicy(t *testing.T) {
+	zero, neg := 0, -1
+	tests := []struct {
+		name       string
+		maxRetries *int
+		backoff    string
+		wantErr    bool
+	}{
+		{"defaults", nil, "", false},
+		{"fail fast", &zero, "", false},
+		{"backoff", nil, "10m", false},
+		{"negative retries", &neg, "", true},
+		{"unparseable backoff", nil, "soon", true},
+		{"zero backoff", nil, "0s", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRetryPolicy(tt.maxRetries, tt.backoff)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateRetryPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
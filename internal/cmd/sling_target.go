@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -110,6 +111,9 @@ type ResolveTargetOptions struct {
 	TownRoot   string
 	WorkDesc   string // Description for dog dispatch (defaults to HookBead if empty)
 	BaseBranch string // Override base branch for polecat worktree
+
+	CorrelationID string                // Tags a spawned polecat's events (see SlingSpawnOptions)
+	Sling         *polecat.SlingOptions // Recorded in a spawned polecat's spawn record
 }
 
 // ResolvedTarget holds the results of target resolution.
@@ -208,12 +212,14 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 		}
 		fmt.Printf("Target is rig '%s', spawning fresh polecat...\n", rigName)
 		spawnOpts := SlingSpawnOptions{
-			Force:      opts.Force,
-			Account:    opts.Account,
-			Create:     opts.Create,
-			HookBead:   opts.HookBead,
-			Agent:      opts.Agent,
-			BaseBranch: opts.BaseBranch,
+			Force:         opts.Force,
+			Account:       opts.Account,
+			Create:        opts.Create,
+			HookBead:      opts.HookBead,
+			Agent:         opts.Agent,
+			BaseBranch:    opts.BaseBranch,
+			CorrelationID: opts.CorrelationID,
+			Sling:         opts.Sling,
 		}
 		spawnInfo, err := spawnPolecatForSling(rigName, spawnOpts)
 		if err != nil {
@@ -245,12 +251,14 @@ func resolveTarget(target string, opts ResolveTargetOptions) (*ResolvedTarget, e
 				}
 				fmt.Printf("Target polecat has no active session, spawning fresh polecat in rig '%s'...\n", rigName)
 				spawnOpts := SlingSpawnOptions{
-					Force:      opts.Force,
-					Account:    opts.Account,
-					Create:     opts.Create,
-					HookBead:   opts.HookBead,
-					Agent:      opts.Agent,
-					BaseBranch: opts.BaseBranch,
+					Force:         opts.Force,
+					Account:       opts.Account,
+					Create:        opts.Create,
+					HookBead:      opts.HookBead,
+					Agent:         opts.Agent,
+					BaseBranch:    opts.BaseBranch,
+					CorrelationID: opts.CorrelationID,
+					Sling:         opts.Sling,
 				}
 				spawnInfo, spawnErr := spawnPolecatForSling(rigName, spawnOpts)
 				if spawnErr != nil {
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/agentlog"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// slingWatchPollInterval is how often --watch rereads the event feed and
+// the bead. Transcript lines stream in between polls.
+const slingWatchPollInterval = 3 * time.Second
+
+// slingWatchTarget returns the bead --watch will follow, or an error if this
+// sling has no single bead to follow.
+func slingWatchTarget(args []string) (string, error) {
+	if slingDryRun {
+		return "", fmt.Errorf("--watch can't be used with --dry-run")
+	}
+	if slingOnTarget != "" {
+		return slingOnTarget, nil
+	}
+	if len(args) > 2 {
+		return "", fmt.Errorf("--watch follows a single bead; sling batches without it")
+	}
+	if verifyBeadExists(args[0]) != nil {
+		return "", fmt.Errorf("--watch needs a bead to follow (use --on <bead> with a formula)")
+	}
+	if idType, err := detectSchedulerIDType(args[0]); err == nil && idType != "task" {
+		return "", fmt.Errorf("%s is a %s; --watch follows a single bead", args[0], idType)
+	}
+	return args[0], nil
+}
+
+// slingWatcher follows one slung bead through the event feed: dispatch,
+// spawn, gt done and merge. It tracks the correlation IDs seen on the
+// bead's events (as gt activity trace does) so untagged-by-bead events such
+// as the polecat spawn are picked up too.
+type slingWatcher struct {
+	beadID      string
+	expectMerge bool // false for --no-merge and --merge=local: gt done is the end
+
+	corrIDs map[string]bool
+	printed int // matching events already shown
+
+	rigName     string // set once the polecat is known
+	polecatName string
+	finished    string // final status line, set when the work has landed or stopped
+}
+
+func newSlingWatcher(beadID string, expectMerge bool) *slingWatcher {
+	return &slingWatcher{beadID: beadID, expectMerge: expectMerge, corrIDs: make(map[string]bool)}
+}
+
+// observe takes every event since the watch began and returns the ones about
+// the bead not yet shown, updating the watcher's polecat and final status.
+func (w *slingWatcher) observe(all []events.Event) []events.Event {
+	for _, e := range all {
+		if eventBead(e) == w.beadID {
+			if c := e.CorrelationID(); c != "" {
+				w.corrIDs[c] = true
+			}
+		}
+	}
+	var matched []events.Event
+	for _, e := range all {
+		if eventBead(e) == w.beadID || w.corrIDs[e.CorrelationID()] {
+			matched = append(matched, e)
+		}
+	}
+	if w.printed >= len(matched) {
+		return nil
+	}
+	fresh := matched[w.printed:]
+	w.printed = len(matched)
+
+	for _, e := range fresh {
+		switch e.Type {
+		case events.TypeSchedulerDispatch, events.TypeSpawn:
+			rig, _ := e.Payload["rig"].(string)
+			name, _ := e.Payload["polecat"].(string)
+			if rig != "" && name != "" {
+				w.rigName, w.polecatName = rig, name
+			}
+		case events.TypeDone:
+			exit, _ := e.Payload["exit_type"].(string)
+			if exit != "" && exit != ExitCompleted {
+				w.finished = fmt.Sprintf("%s Polecat exited %s without landing the work", style.Warning.Render("⚠"), exit)
+			} else if !w.expectMerge {
+				w.finished = fmt.Sprintf("%s Work done; not merged (%s)", style.Bold.Render("✓"), watchPayloadString(e, "branch"))
+			}
+		case events.TypeMerged:
+			w.finished = fmt.Sprintf("%s Merged %s (%s)", style.Bold.Render("✓"), watchPayloadString(e, "branch"), watchPayloadString(e, "mr"))
+		case events.TypeMergeFailed:
+			w.finished = fmt.Sprintf("%s Merge failed: %s", style.Warning.Render("✗"), watchPayloadString(e, "reason"))
+		case events.TypeMergeSkipped:
+			w.finished = fmt.Sprintf("%s Merge skipped: %s", style.Warning.Render("⚠"), watchPayloadString(e, "reason"))
+		}
+	}
+	return fresh
+}
+
+// watchEventLine renders one pipeline event as a progress line.
+func watchEventLine(e events.Event) string {
+	var msg string
+	switch e.Type {
+	case events.TypeSchedulerEnqueue:
+		msg = "Scheduled, waiting for capacity"
+	case events.TypeSchedulerDispatch:
+		msg = "Dispatched to " + watchPolecatAddress(e)
+	case events.TypeSchedulerDispatchFailed:
+		msg = fmt.Sprintf("Dispatch attempt %v failed: %s", e.Payload["attempt"], watchPayloadString(e, "error"))
+	case events.TypeSling:
+		msg = "Slung to " + watchPayloadString(e, "target")
+	case events.TypeSpawn:
+		msg = "Polecat " + watchPolecatAddress(e) + " spawned"
+	case events.TypeDone:
+		msg = fmt.Sprintf("gt done: %s on %s", watchPayloadString(e, "exit_type"), watchPayloadString(e, "branch"))
+	case events.TypeMergeStarted:
+		msg = "Refinery merging " + watchPayloadString(e, "branch")
+	case events.TypeBeadNote:
+		msg = "Note: " + watchPayloadString(e, "message")
+	default:
+		msg = e.Type + " " + tracePayloadSummary(e.Payload)
+	}
+	ts := e.Timestamp
+	if t, err := time.Parse(time.RFC3339, e.Timestamp); err == nil {
+		ts = t.Local().Format("15:04:05")
+	}
+	return fmt.Sprintf("%s  %s", style.Dim.Render(ts), strings.TrimSpace(msg))
+}
+
+// transcriptWatchLine renders a transcript event for --watch, or "" for
+// events that are too noisy to follow (thinking, tool results, usage).
+func transcriptWatchLine(ev agentlog.AgentEvent) string {
+	switch ev.EventType {
+	case "tool_use":
+		return style.Dim.Render("          → " + truncate(ev.Content, 100))
+	case "text":
+		if ev.Role == "assistant" && strings.TrimSpace(ev.Content) != "" {
+			return "          " + truncate(strings.TrimSpace(ev.Content), 100)
+		}
+	}
+	return ""
+}
+
+func watchPayloadString(e events.Event, key string) string {
+	if v, ok := e.Payload[key]; ok && v != nil {
+		if s := fmt.Sprint(v); s != "" {
+			return s
+		}
+	}
+	return "?"
+}
+
+func watchPolecatAddress(e events.Event) string {
+	rig, _ := e.Payload["rig"].(string)
+	name, _ := e.Payload["polecat"].(string)
+	if name == "" {
+		return rig
+	}
+	return rig + "/" + name
+}
+
+// watchSlungWork follows beadID after a successful sling until its work
+// merges, fails, or the user interrupts: pipeline events from the feed, and
+// the polecat's transcript once its worktree is known. since is when the
+// sling began, so events it logged are shown too.
+func watchSlungWork(ctx context.Context, townRoot, beadID string, since time.Time) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	w := newSlingWatcher(beadID, !slingNoMerge && slingMerge != "local")
+	fmt.Printf("\n%s Watching %s %s\n", style.Bold.Render("👀"), beadID,
+		style.Dim.Render("(Ctrl-C stops watching; the work carries on)"))
+
+	var transcript <-chan agentlog.AgentEvent
+	transcriptStarted, beadClosed := false, false
+	ticker := time.NewTicker(slingWatchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		var all []events.Event
+		if err := events.ReadRange(townRoot, since.Add(-time.Second), time.Time{}, func(e events.Event) bool {
+			all = append(all, e)
+			return true
+		}); err != nil {
+			return fmt.Errorf("reading events: %w", err)
+		}
+		for _, e := range w.observe(all) {
+			fmt.Println(watchEventLine(e))
+		}
+		if w.finished == "" && beadClosed {
+			// Closed on the previous poll with no merge event since: the
+			// bead was closed some other way.
+			w.finished = fmt.Sprintf("%s %s closed", style.Bold.Render("✓"), beadID)
+		}
+		if w.finished != "" {
+			fmt.Println(w.finished)
+			return nil
+		}
+
+		if info, err := getBeadInfo(beadID); err == nil {
+			if w.polecatName == "" {
+				if rig, name, ok := parsePolecatAssignee(info.Assignee); ok {
+					w.rigName, w.polecatName = rig, name
+				}
+			}
+			// Give the refinery a poll to log the merge that closed it.
+			beadClosed = info.Status == "closed"
+		}
+
+		if !transcriptStarted && w.polecatName != "" {
+			transcript = startTranscriptWatch(ctx, w.rigName, w.polecatName, since)
+			transcriptStarted = true
+		}
+
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				fmt.Printf("\n%s Stopped watching. Follow up with: gt activity trace %s\n", style.Dim.Render("○"), beadID)
+				return nil
+			case ev, ok := <-transcript:
+				if !ok {
+					transcript = nil
+					continue
+				}
+				if line := transcriptWatchLine(ev); line != "" {
+					fmt.Println(line)
+				}
+			case <-ticker.C:
+				break wait
+			}
+		}
+	}
+}
+
+// startTranscriptWatch tails the polecat's agent transcript, or returns nil
+// (with a warning) if its worktree can't be found. A nil channel never
+// delivers, so the watch carries on with events alone.
+func startTranscriptWatch(ctx context.Context, rigName, polecatName string, since time.Time) <-chan agentlog.AgentEvent {
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		style.PrintWarning("not following %s/%s's transcript: %v", rigName, polecatName, err)
+		return nil
+	}
+	ch, err := agentlog.NewAdapter("claudecode").Watch(ctx, rigName+"/"+polecatName, mgr.ClonePath(polecatName), since.Add(-time.Minute))
+	if err != nil {
+		style.PrintWarning("not following %s/%s's transcript: %v", rigName, polecatName, err)
+		return nil
+	}
+	return ch
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/agentlog"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func watchTestEvent(typ string, payload map[string]interface{}) events.Event {
+	return events.Event{Type: typ, Timestamp: "2026-03-01T12:00:00Z", Payload: payload}
+}
+
+func TestSlingWatcherObserve(t *testing.T) {
+	cor := map[string]interface{}{events.CorrelationKey: "cor-1"}
+	with := func(p map[string]interface{}) map[string]interface{} {
+		for k, v := range cor {
+			p[k] = v
+		}
+		return p
+	}
+	feed := []events.Event{
+		watchTestEvent(events.TypeSchedulerEnqueue, with(map[string]interface{}{"bead": "gt-abc"})),
+		watchTestEvent(events.TypeSling, map[string]interface{}{"bead": "gt-other", "target": "gastown"}),
+		watchTestEvent(events.TypeSchedulerDispatch, with(map[string]interface{}{"bead": "gt-abc", "rig": "gastown", "polecat": "Toast"})),
+		watchTestEvent(events.TypeSpawn, with(map[string]interface{}{"rig": "gastown", "polecat": "Toast"})),
+	}
+
+	w := newSlingWatcher("gt-abc", true)
+	got := w.observe(feed)
+	if len(got) != 3 {
+		t.Fatalf("observe() returned %d events, want 3 (the other bead's sling excluded)", len(got))
+	}
+	if w.rigName != "gastown" || w.polecatName != "Toast" {
+		t.Errorf("polecat = %s/%s, want gastown/Toast", w.rigName, w.polecatName)
+	}
+	if w.finished != "" {
+		t.Errorf("finished = %q before any merge", w.finished)
+	}
+
+	if again := w.observe(feed); len(again) != 0 {
+		t.Errorf("observe() repeated %d already-shown events", len(again))
+	}
+
+	feed = append(feed,
+		watchTestEvent(events.TypeDone, map[string]interface{}{"bead": "gt-abc", "branch": "polecat/Toast", "exit_type": ExitCompleted}))
+	w.observe(feed)
+	if w.finished != "" {
+		t.Errorf("finished after gt done while a merge is expected: %q", w.finished)
+	}
+
+	feed = append(feed,
+		watchTestEvent(events.TypeMerged, with(map[string]interface{}{"mr": "gt-mr1", "branch": "polecat/Toast"})))
+	if got := w.observe(feed); len(got) != 1 {
+		t.Fatalf("observe() returned %d events for the merge, want 1", len(got))
+	}
+	if !strings.Contains(w.finished, "Merged polecat/Toast") {
+		t.Errorf("finished = %q, want a merged status", w.finished)
+	}
+}
+
+func TestSlingWatcherObserve_DoneEndsWatch(t *testing.T) {
+	done := func(exit string) []events.Event {
+		return []events.Event{watchTestEvent(events.TypeDone, map[string]interface{}{"bead": "gt-abc", "branch": "b", "exit_type": exit})}
+	}
+
+	w := newSlingWatcher("gt-abc", false)
+	w.observe(done(ExitCompleted))
+	if !strings.Contains(w.finished, "not merged") {
+		t.Errorf("no-merge sling: finished = %q, want 'not merged'", w.finished)
+	}
+
+	w = newSlingWatcher("gt-abc", true)
+	w.observe(done("ESCALATED"))
+	if !strings.Contains(w.finished, "ESCALATED") {
+		t.Errorf("escalated: finished = %q, want the exit type", w.finished)
+	}
+}
+
+func TestTranscriptWatchLine(t *testing.T) {
+	tests := []struct {
+		ev   agentlog.AgentEvent
+		want string // substring; "" = no line
+	}{
+		{agentlog.AgentEvent{EventType: "tool_use", Content: `Bash: {"command":"go test ./..."}`}, "Bash:"},
+		{agentlog.AgentEvent{EventType: "text", Role: "assistant", Content: "Fixing the parser\nmore detail"}, "Fixing the parser"},
+		{agentlog.AgentEvent{EventType: "text", Role: "user", Content: "hello"}, ""},
+		{agentlog.AgentEvent{EventType: "thinking", Content: "hmm"}, ""},
+		{agentlog.AgentEvent{EventType: "tool_result", Content: "ok"}, ""},
+		{agentlog.AgentEvent{EventType: "usage"}, ""},
+	}
+	for _, tt := range tests {
+		got := transcriptWatchLine(tt.ev)
+		if tt.want == "" {
+			if got != "" {
+				t.Errorf("transcriptWatchLine(%s) = %q, want no line", tt.ev.EventType, got)
+			}
+			continue
+		}
+		if !strings.Contains(got, tt.want) || strings.Contains(got, "more detail") {
+			t.Errorf("transcriptWatchLine(%s) = %q, want one line containing %q", tt.ev.EventType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// statsAttributionLookback is how far before --since the event log is read to
+// find who queued beads that complete inside the window.
+const statsAttributionLookback = 30 * 24 * time.Hour
+
+var (
+	statsSince string
+	statsJSON  bool
+)
+
+var statsCmd = &cobra.Command{
+	Use:     "stats",
+	GroupID: GroupDiag,
+	Short:   "Summarize what the automation delivered",
+	Long: `Summarize, per human actor and per rig, what agents delivered over a
+period, from the town event log:
+
+  QUEUED      Beads slung or scheduled by the actor / into the rig
+  COMPLETED   Beads agents finished with gt done (status COMPLETED)
+  MERGED      Merges the refinery landed
+  TURNAROUND  Average time from queueing a bead to its merge (or to gt done
+              when it never went through the merge queue)
+  LIMIT DOWN  Time polecats sat stalled on rate limits before the daemon
+              resumed them
+
+Work is credited to the actor who first queued the bead; beads queued by
+agents (polecats, witnesses, refineries, the deacon) appear only per rig.
+
+Examples:
+  gt stats                 # Last 7 days
+  gt stats --since 30d
+  gt stats --since 24h --json`,
+	Args: cobra.NoArgs,
+	RunE: runStats,
+}
+
+func init() {
+	statsCmd.Flags().StringVar(&statsSince, "since", "7d", "Period to summarize (e.g., 24h, 7d)")
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(statsCmd)
+}
+
+// statsRow is one actor's or rig's totals.
+type statsRow struct {
+	Name          string        `json:"name"`
+	Queued        int           `json:"queued"`
+	Completed     int           `json:"completed"`
+	Merged        int           `json:"merged"`
+	AvgTurnaround time.Duration `json:"avg_turnaround_ns"`
+	LimitDowntime time.Duration `json:"limit_downtime_ns"`
+
+	turnaroundSum time.Duration
+	turnaroundN   int
+}
+
+// statsReport is the output of gt stats.
+type statsReport struct {
+	Since  time.Time   `json:"since"`
+	Actors []*statsRow `json:"actors"`
+	Rigs   []*statsRow `json:"rigs"`
+	Total  *statsRow   `json:"total"`
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	window, err := parseDuration(statsSince)
+	if err != nil || window <= 0 {
+		return fmt.Errorf("invalid --since %q: expected a duration like 24h or 7d", statsSince)
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+
+	since := time.Now().Add(-window)
+	var evs []events.Event
+	if err := events.ReadRange(townRoot, since.Add(-statsAttributionLookback), time.Time{}, func(e events.Event) bool {
+		evs = append(evs, e)
+		return true
+	}); err != nil {
+		return fmt.Errorf("reading event log: %w", err)
+	}
+	report := buildStats(evs, since)
+
+	if statsJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	fmt.Printf("%s since %s (%s)\n", style.Bold.Render("Stats"), since.Local().Format("2006-01-02 15:04"), statsSince)
+	if report.Total.Queued+report.Total.Completed+report.Total.Merged == 0 && report.Total.LimitDowntime == 0 {
+		fmt.Printf("\n%s No agent activity in this period\n", style.Dim.Render("○"))
+		return nil
+	}
+	printStatsTable("Actor", report.Actors)
+	printStatsTable("Rig", report.Rigs)
+	printStatsTable("", []*statsRow{report.Total})
+	return nil
+}
+
+// printStatsTable prints rows under a header naming the first column. An
+// empty title prints rows without a header.
+func printStatsTable(title string, rows []*statsRow) {
+	if len(rows) == 0 {
+		return
+	}
+	fmt.Println()
+	if title != "" {
+		fmt.Println(style.Dim.Render(fmt.Sprintf("  %-24s %7s %10s %7s %12s %11s",
+			strings.ToUpper(title), "QUEUED", "COMPLETED", "MERGED", "TURNAROUND", "LIMIT DOWN")))
+	}
+	for _, r := range rows {
+		turnaround, downtime := "-", "-"
+		if r.AvgTurnaround > 0 {
+			turnaround = humanize.Duration(r.AvgTurnaround.Round(time.Minute))
+		}
+		if r.LimitDowntime > 0 {
+			downtime = humanize.Duration(r.LimitDowntime.Round(time.Minute))
+		}
+		fmt.Printf("  %-24s %7d %10d %7d %12s %11s\n",
+			r.Name, r.Queued, r.Completed, r.Merged, turnaround, downtime)
+	}
+}
+
+// buildStats totals the events at or after since, per queueing actor and per
+// rig. Earlier events only attribute beads to the actor who queued them.
+func buildStats(evs []events.Event, since time.Time) *statsReport {
+	type beadInfo struct {
+		queuer   string // Human actor who first queued the bead, if any
+		queuedAt time.Time
+		doneAt   time.Time // First COMPLETED gt done in the window
+		doneRig  string
+		landed   bool
+	}
+	beadsByID := make(map[string]*beadInfo)
+	bead := func(id string) *beadInfo {
+		b := beadsByID[id]
+		if b == nil {
+			b = &beadInfo{}
+			beadsByID[id] = b
+		}
+		return b
+	}
+
+	actors := make(map[string]*statsRow)
+	rigs := make(map[string]*statsRow)
+	total := &statsRow{Name: "Total"}
+	// rows returns the rows an event counts toward.
+	rows := func(actor, rig string) []*statsRow {
+		out := []*statsRow{total}
+		if actor != "" {
+			if actors[actor] == nil {
+				actors[actor] = &statsRow{Name: actor}
+			}
+			out = append(out, actors[actor])
+		}
+		if rig != "" {
+			if rigs[rig] == nil {
+				rigs[rig] = &statsRow{Name: rig}
+			}
+			out = append(out, rigs[rig])
+		}
+		return out
+	}
+	addTurnaround := func(rs []*statsRow, d time.Duration) {
+		for _, r := range rs {
+			r.turnaroundSum += d
+			r.turnaroundN++
+		}
+	}
+
+	for _, e := range evs {
+		ts, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil {
+			continue
+		}
+		inWindow := !ts.Before(since)
+		beadID, _ := e.Payload["bead"].(string)
+
+		switch e.Type {
+		case events.TypeSling, events.TypeSchedulerEnqueue:
+			if beadID == "" || !bead(beadID).queuedAt.IsZero() {
+				continue // Re-slings and scheduler dispatch of queued beads
+			}
+			b := bead(beadID)
+			b.queuedAt = ts
+			if !isAgentActor(e.Actor) {
+				b.queuer = e.Actor
+			}
+			if !inWindow {
+				continue
+			}
+			rig, _ := e.Payload["rig"].(string)
+			if rig == "" {
+				target, _ := e.Payload["target"].(string)
+				rig = statsRigOf(target)
+			}
+			for _, r := range rows(b.queuer, rig) {
+				r.Queued++
+			}
+
+		case events.TypeDone:
+			exitType, _ := e.Payload["exit_type"].(string)
+			if !inWindow || (exitType != "" && exitType != ExitCompleted) {
+				continue
+			}
+			var queuer string
+			if beadID != "" {
+				b := bead(beadID)
+				queuer = b.queuer
+				if b.doneAt.IsZero() {
+					b.doneAt, b.doneRig = ts, statsRigOf(e.Actor)
+				}
+			}
+			for _, r := range rows(queuer, statsRigOf(e.Actor)) {
+				r.Completed++
+			}
+
+		case events.TypeMerged:
+			if !inWindow {
+				continue
+			}
+			var b *beadInfo
+			if beadID != "" {
+				b = bead(beadID)
+			}
+			var queuer string
+			if b != nil {
+				queuer = b.queuer
+			}
+			rs := rows(queuer, statsRigOf(e.Actor))
+			for _, r := range rs {
+				r.Merged++
+			}
+			if b != nil && !b.landed {
+				b.landed = true
+				if !b.queuedAt.IsZero() {
+					addTurnaround(rs, ts.Sub(b.queuedAt))
+				}
+			}
+
+		case events.TypeLimitWake:
+			if !inWindow {
+				continue
+			}
+			ms, _ := e.Payload["stalled_ms"].(float64)
+			rig, _ := e.Payload["rig"].(string)
+			var queuer string
+			if beadID != "" {
+				queuer = bead(beadID).queuer
+			}
+			for _, r := range rows(queuer, rig) {
+				r.LimitDowntime += time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+
+	// Beads that finished without going through the merge queue turn around
+	// at gt done.
+	for _, b := range beadsByID {
+		if !b.landed && !b.doneAt.IsZero() && !b.queuedAt.IsZero() {
+			addTurnaround(rows(b.queuer, b.doneRig), b.doneAt.Sub(b.queuedAt))
+		}
+	}
+
+	report := &statsReport{Since: since, Total: total}
+	for _, r := range actors {
+		report.Actors = append(report.Actors, r)
+	}
+	for _, r := range rigs {
+		report.Rigs = append(report.Rigs, r)
+	}
+	for _, list := range [][]*statsRow{report.Actors, report.Rigs, {total}} {
+		for _, r := range list {
+			if r.turnaroundN > 0 {
+				r.AvgTurnaround = r.turnaroundSum / time.Duration(r.turnaroundN)
+			}
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Completed != list[j].Completed {
+				return list[i].Completed > list[j].Completed
+			}
+			return list[i].Name < list[j].Name
+		})
+	}
+	return report
+}
+
+// isAgentActor reports whether an event actor is an automated agent rather
+// than a human (or the mayor or crew acting for one).
+func isAgentActor(actor string) bool {
+	switch actor {
+	case "", "unknown", "daemon", "deacon", "deacon-boot", "polecat", "witness", "refinery":
+		return true
+	}
+	return strings.Contains(actor, "/polecats/") ||
+		strings.HasSuffix(actor, "/witness") ||
+		strings.HasSuffix(actor, "/refinery") ||
+		strings.HasPrefix(actor, "deacon/")
+}
+
+// statsRigOf returns the rig an agent address or sling target belongs to, or
+// "" for town-level agents.
+func statsRigOf(addr string) string {
+	rig, _, _ := strings.Cut(strings.TrimSuffix(addr, "/"), "/")
+	switch rig {
+	case "", "mayor", "deacon", "deacon-boot", "daemon", "overseer":
+		return ""
+	}
+	return rig
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestBuildStats(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	since := now.Add(-7 * 24 * time.Hour)
+	ev := func(ago time.Duration, typ, actor string, payload map[string]interface{}) events.Event {
+		return events.Event{Timestamp: now.Add(-ago).Format(time.RFC3339), Type: typ, Actor: actor, Payload: payload}
+	}
+
+	evs := []events.Event{
+		// Queued before the window, merged inside it: attributed, not counted as queued.
+		ev(10*24*time.Hour, events.TypeSling, "mayor", events.SlingPayload("gt-old", "gastown")),
+		ev(5*time.Hour, events.TypeMerged, "gastown/refinery", map[string]interface{}{"bead": "gt-old"}),
+
+		// Scheduled by crew, then dispatched by the daemon: one queue, by crew.
+		ev(6*time.Hour, events.TypeSchedulerEnqueue, "gastown/crew/joe", events.SchedulerEnqueuePayload("gt-a", "gastown")),
+		ev(5*time.Hour, events.TypeSling, "daemon", events.SlingPayload("gt-a", "gastown/polecats/nux")),
+		ev(3*time.Hour, events.TypeLimitWake, "daemon", map[string]interface{}{"rig": "gastown", "bead": "gt-a", "stalled_ms": float64(30 * time.Minute / time.Millisecond)}),
+		ev(2*time.Hour, events.TypeDone, "gastown/polecats/nux", map[string]interface{}{"bead": "gt-a", "exit_type": "COMPLETED"}),
+		ev(1*time.Hour, events.TypeMerged, "gastown/refinery", map[string]interface{}{"bead": "gt-a"}),
+
+		// Completed without a merge queue; turnaround ends at gt done.
+		ev(4*time.Hour, events.TypeSling, "mayor", events.SlingPayload("bd-b", "beads")),
+		ev(2*time.Hour, events.TypeDone, "beads/polecats/rictus", map[string]interface{}{"bead": "bd-b"}),
+
+		// Escalated work isn't a completion; agent-queued work has no actor row.
+		ev(3*time.Hour, events.TypeSling, "gastown/polecats/nux", events.SlingPayload("gt-c", "gastown")),
+		ev(2*time.Hour, events.TypeDone, "gastown/polecats/slit", map[string]interface{}{"bead": "gt-c", "exit_type": "ESCALATED"}),
+	}
+
+	r := buildStats(evs, since)
+
+	byName := func(rows []*statsRow) map[string]*statsRow {
+		m := make(map[string]*statsRow)
+		for _, row := range rows {
+			m[row.Name] = row
+		}
+		return m
+	}
+	actors, rigs := byName(r.Actors), byName(r.Rigs)
+
+	if len(actors) != 2 {
+		t.Fatalf("actors = %v, want mayor and gastown/crew/joe", actors)
+	}
+	joe := actors["gastown/crew/joe"]
+	if joe.Queued != 1 || joe.Completed != 1 || joe.Merged != 1 || joe.AvgTurnaround != 5*time.Hour || joe.LimitDowntime != 30*time.Minute {
+		t.Errorf("joe = %+v", joe)
+	}
+	mayor := actors["mayor"]
+	if mayor.Queued != 1 || mayor.Completed != 1 || mayor.Merged != 1 {
+		t.Errorf("mayor = %+v", mayor)
+	}
+	// gt-old: 10d - 5h; bd-b: 2h.
+	if want := (10*24*time.Hour - 5*time.Hour + 2*time.Hour) / 2; mayor.AvgTurnaround != want {
+		t.Errorf("mayor turnaround = %v, want %v", mayor.AvgTurnaround, want)
+	}
+
+	gastown := rigs["gastown"]
+	if gastown.Queued != 2 || gastown.Completed != 1 || gastown.Merged != 2 || gastown.LimitDowntime != 30*time.Minute {
+		t.Errorf("gastown = %+v", gastown)
+	}
+	if b := rigs["beads"]; b == nil || b.Queued != 1 || b.Completed != 1 || b.AvgTurnaround != 2*time.Hour {
+		t.Errorf("beads = %+v", b)
+	}
+	if r.Total.Queued != 3 || r.Total.Completed != 2 || r.Total.Merged != 2 {
+		t.Errorf("total = %+v", r.Total)
+	}
+}
+
+func TestIsAgentActor(t *testing.T) {
+	for actor, want := range map[string]bool{
+		"mayor":                false,
+		"overseer":             false,
+		"gastown/crew/joe":     false,
+		"gastown/polecats/nux": true,
+		"gastown/witness":      true,
+		"gastown/refinery":     true,
+		"deacon":               true,
+		"daemon":               true,
+	} {
+		if got := isAgentActor(actor); got != want {
+			t.Errorf("isAgentActor(%q) = %v, want %v", actor, got, want)
+		}
+	}
+}
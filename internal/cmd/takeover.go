@@ -0,0 +1,395 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/access"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	takeoverAs     string
+	takeoverKill   bool
+	handbackNote   string
+	handbackResume bool
+)
+
+var takeoverCmd = &cobra.Command{
+	Use:     "takeover <bead-id>",
+	GroupID: GroupWork,
+	Short:   "Take a bead over from its polecat to work it by hand",
+	Long: `Take over a bead that a polecat is working on.
+
+The polecat working the bead is paused (parked, see gt polecat pause), or
+stopped with --kill. Its worktree and branch are kept. The bead is assigned
+to you and the worktree path and branch are printed so you can continue
+the work by hand.
+
+When you're done, return the bead with gt handback.
+
+Examples:
+  gt takeover gt-abc
+  gt takeover gt-abc --kill
+  gt takeover gt-abc --as alice`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTakeover,
+}
+
+var handbackCmd = &cobra.Command{
+	Use:     "handback <bead-id>",
+	GroupID: GroupWork,
+	Short:   "Return a taken-over bead to the agents",
+	Long: `Return a bead taken over with gt takeover.
+
+Uncommitted changes in the worktree are committed and the branch is pushed.
+The commits you made and your --note are recorded on the bead as context
+for whoever picks it up next.
+
+By default the bead is released and requeued for a fresh polecat. If the
+push fails, the original polecat stays paused so its worktree, which then
+holds the only copy of your work, is not reused. With
+--resume it goes back to the original polecat instead, if that polecat
+was paused rather than killed.
+
+Examples:
+  gt handback gt-abc --note "fixed the flaky test, feature code still TODO"
+  gt handback gt-abc --resume`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHandback,
+}
+
+func init() {
+	takeoverCmd.Flags().StringVar(&takeoverAs, "as", "overseer", "Human identity to assign the bead to")
+	takeoverCmd.Flags().BoolVar(&takeoverKill, "kill", false, "Stop the polecat's session instead of parking it")
+	handbackCmd.Flags().StringVar(&handbackNote, "note", "", "Context for the next worker (recorded on the bead)")
+	handbackCmd.Flags().BoolVar(&handbackResume, "resume", false, "Return the bead to the original (paused) polecat")
+	rootCmd.AddCommand(takeoverCmd)
+	rootCmd.AddCommand(handbackCmd)
+}
+
+// takeoverRecord tracks a bead a human has taken over from a polecat, so
+// gt handback knows where the work lives and who it came from.
+type takeoverRecord struct {
+	BeadID     string `json:"bead_id"`
+	Human      string `json:"human"`
+	Assignee   string `json:"assignee"` // Polecat address the bead was taken from
+	Rig        string `json:"rig"`
+	Polecat    string `json:"polecat"`
+	Worktree   string `json:"worktree"`
+	Branch     string `json:"branch"`
+	BaseCommit string `json:"base_commit,omitempty"` // HEAD at takeover
+	Killed     bool   `json:"killed,omitempty"`
+	TakenAt    string `json:"taken_at"`
+}
+
+func takeoverRecordPath(townRoot, beadID string) string {
+	return filepath.Join(constants.TownRuntimePath(townRoot), "takeovers", beadID+".json")
+}
+
+func loadTakeoverRecord(townRoot, beadID string) (*takeoverRecord, error) {
+	data, err := os.ReadFile(takeoverRecordPath(townRoot, beadID)) //nolint:gosec // G304: path is constructed from trusted townRoot
+	if err != nil {
+		return nil, err
+	}
+	var rec takeoverRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("parsing takeover record: %w", err)
+	}
+	return &rec, nil
+}
+
+func saveTakeoverRecord(townRoot string, rec *takeoverRecord) error {
+	path := takeoverRecordPath(townRoot, rec.BeadID)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// parsePolecatAssignee splits a polecat assignee ("rig/polecats/name").
+func parsePolecatAssignee(assignee string) (rigName, polecatName string, ok bool) {
+	parts := strings.Split(strings.TrimSuffix(assignee, "/"), "/")
+	if len(parts) != 3 || parts[1] != "polecats" || parts[0] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[2], true
+}
+
+func runTakeover(cmd *cobra.Command, args []string) error {
+	action := access.ActionPause
+	if takeoverKill {
+		action = access.ActionKill
+	}
+	if err := requireAccess(cmd, action); err != nil {
+		return err
+	}
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	if rec, err := loadTakeoverRecord(townRoot, beadID); err == nil {
+		return fmt.Errorf("%s is already taken over by %s (worktree %s)", beadID, rec.Human, rec.Worktree)
+	}
+
+	bd := beads.New(beads.ResolveHookDir(townRoot, beadID, townRoot))
+	issue, err := bd.Show(beadID)
+	if err != nil {
+		return fmt.Errorf("loading %s: %w", beadID, err)
+	}
+	if issue.Status == "closed" {
+		return fmt.Errorf("%s is closed", beadID)
+	}
+	rigName, polecatName, ok := parsePolecatAssignee(issue.Assignee)
+	if !ok {
+		return fmt.Errorf("%s is not assigned to a polecat (assignee %q)", beadID, issue.Assignee)
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	info, err := mgr.Get(polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat %s/%s: %w", rigName, polecatName, err)
+	}
+	p := polecatTarget{rigName: rigName, polecatName: polecatName, mgr: mgr, r: r}
+
+	// Stop the agent before handing over its worktree.
+	if err := stopTakeoverPolecat(tmux.NewTmux(), p, takeoverKill); err != nil {
+		return err
+	}
+
+	rec := &takeoverRecord{
+		BeadID:   beadID,
+		Human:    takeoverAs,
+		Assignee: issue.Assignee,
+		Rig:      rigName,
+		Polecat:  polecatName,
+		Worktree: info.ClonePath,
+		Branch:   info.Branch,
+		Killed:   takeoverKill,
+		TakenAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	if head, err := git.NewGit(info.ClonePath).Rev("HEAD"); err == nil {
+		rec.BaseCommit = head
+	}
+	if err := saveTakeoverRecord(townRoot, rec); err != nil {
+		return fmt.Errorf("saving takeover record: %w", err)
+	}
+
+	inProgress := string(beads.StatusInProgress)
+	if err := bd.Update(beadID, beads.UpdateOptions{Status: &inProgress, Assignee: &takeoverAs}); err != nil {
+		return fmt.Errorf("assigning %s to %s: %w", beadID, takeoverAs, err)
+	}
+	comment := fmt.Sprintf("Taken over from %s by %s. Worktree %s, branch %s.", issue.Assignee, takeoverAs, rec.Worktree, rec.Branch)
+	if _, err := bd.Run("comments", "add", beadID, comment); err != nil {
+		fmt.Printf("  %s could not comment on %s: %v\n", style.Dim.Render("○"), beadID, err)
+	}
+	_ = events.LogFeed(events.TypeTakeover, takeoverAs, events.TakeoverPayload(beadID, issue.Assignee, takeoverAs, rec.Branch))
+
+	fmt.Printf("%s %s is yours\n\n", style.SuccessPrefix, style.Bold.Render(beadID))
+	fmt.Printf("  Worktree: %s\n", rec.Worktree)
+	fmt.Printf("  Branch:   %s\n\n", rec.Branch)
+	fmt.Printf("  cd %s\n\n", rec.Worktree)
+	fmt.Printf("When done: %s\n", style.Bold.Render("gt handback "+beadID+" --note \"...\""))
+	return nil
+}
+
+// stopTakeoverPolecat kills or pauses the polecat whose worktree is being
+// handed to a human. Either way the agent bead is left paused: with no hooked
+// bead and no live session the polecat would otherwise look idle, and sling
+// would reuse it by hard-resetting the worktree the human is editing.
+func stopTakeoverPolecat(t *tmux.Tmux, p polecatTarget, kill bool) error {
+	address := p.rigName + "/" + p.polecatName
+	if !kill {
+		err := pausePolecat(t, p, false, pauseSlotHeld)
+		if err == nil {
+			fmt.Printf("%s Paused %s\n", style.Success.Render("✓"), address)
+			return nil
+		}
+		if !errors.Is(err, errPolecatNotRunning) {
+			return fmt.Errorf("pausing %s: %w", address, err)
+		}
+		fmt.Printf("%s %s has no running session\n", style.Dim.Render("○"), address)
+	} else {
+		sessMgr := polecat.NewSessionManager(t, p.r)
+		if sessionName := sessMgr.SessionName(p.polecatName); isPolecatPaused(t, sessionName) {
+			_ = resumePolecatSession(t, sessionName)
+		}
+		if err := sessMgr.Stop(p.polecatName, true); err != nil && !errors.Is(err, polecat.ErrSessionNotFound) {
+			return fmt.Errorf("stopping %s: %w", address, err)
+		}
+		fmt.Printf("%s Stopped %s\n", style.Success.Render("✓"), address)
+	}
+	if err := p.mgr.SetAgentState(p.polecatName, string(beads.AgentStatePaused)); err != nil {
+		style.PrintWarning("could not set agent_state for %s: %v", address, err)
+	}
+	return nil
+}
+
+func runHandback(cmd *cobra.Command, args []string) error {
+	if err := requireAccess(cmd, access.ActionPause); err != nil {
+		return err
+	}
+	beadID := args[0]
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	rec, err := loadTakeoverRecord(townRoot, beadID)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%s was not taken over (see gt takeover)", beadID)
+		}
+		return err
+	}
+	if handbackResume && rec.Killed {
+		return fmt.Errorf("%s/%s was killed at takeover; hand back to the queue instead (omit --resume)", rec.Rig, rec.Polecat)
+	}
+
+	// Save the human's work where the next worker can find it.
+	commits, pushed := saveHandbackWork(rec)
+
+	mgr, r, err := getPolecatManager(rec.Rig)
+	if err != nil {
+		return err
+	}
+	p := polecatTarget{rigName: rec.Rig, polecatName: rec.Polecat, mgr: mgr, r: r}
+	bd := beads.New(beads.ResolveHookDir(townRoot, beadID, r.Path))
+	t := tmux.NewTmux()
+	summary := handbackContext(rec, commits, pushed, handbackNote)
+
+	to := "queue"
+	if handbackResume {
+		hooked := beads.StatusHooked
+		if err := bd.Update(beadID, beads.UpdateOptions{Status: &hooked, Assignee: &rec.Assignee}); err != nil {
+			return fmt.Errorf("reassigning %s to %s: %w", beadID, rec.Assignee, err)
+		}
+		if err := resumePolecat(t, p, polecatResumeMessage+"\n\n"+summary); err != nil {
+			return fmt.Errorf("resuming %s/%s: %w", rec.Rig, rec.Polecat, err)
+		}
+		to = rec.Assignee
+	} else {
+		// Retire the paused polecat: the bead goes to a fresh one.
+		sessMgr := polecat.NewSessionManager(t, r)
+		if sessionName := sessMgr.SessionName(rec.Polecat); isPolecatPaused(t, sessionName) {
+			_ = resumePolecatSession(t, sessionName)
+		}
+		if err := sessMgr.Stop(rec.Polecat, true); err != nil && !errors.Is(err, polecat.ErrSessionNotFound) {
+			style.PrintWarning("could not stop %s/%s: %v", rec.Rig, rec.Polecat, err)
+		}
+		// Work that only exists in the worktree keeps the polecat paused;
+		// resetting it for reuse would let sling hard-reset the branch.
+		if pushed {
+			agentBeadID := polecatBeadIDForRig(r, rec.Rig, rec.Polecat)
+			if err := beads.New(r.Path).ResetAgentBeadForReuse(agentBeadID, "handback"); err != nil {
+				fmt.Printf("  %s agent bead not reset: %v\n", style.Dim.Render("○"), err)
+			}
+		} else {
+			fmt.Printf("  %s kept %s/%s paused: work is only in %s\n", style.Dim.Render("○"), rec.Rig, rec.Polecat, rec.Worktree)
+		}
+
+		open := "open"
+		unassigned := ""
+		if err := bd.Update(beadID, beads.UpdateOptions{Status: &open, Assignee: &unassigned}); err != nil {
+			return fmt.Errorf("releasing %s: %w", beadID, err)
+		}
+		opts := ScheduleOptions{
+			Formula:  resolveFormula("", false, townRoot, rec.Rig),
+			NoConvoy: true, // Already tracked by its original convoy, if any
+		}
+		if err := scheduleBead(beadID, rec.Rig, opts); err != nil {
+			style.PrintWarning("could not requeue %s (left open): %v", beadID, err)
+		}
+	}
+
+	if _, err := bd.Run("comments", "add", beadID, summary); err != nil {
+		fmt.Printf("  %s could not comment on %s: %v\n", style.Dim.Render("○"), beadID, err)
+	}
+	_ = events.LogFeed(events.TypeHandback, rec.Human, events.HandbackPayload(beadID, rec.Human, to, len(commits)))
+	if err := os.Remove(takeoverRecordPath(townRoot, beadID)); err != nil {
+		style.PrintWarning("could not remove takeover record: %v", err)
+	}
+
+	if handbackResume {
+		fmt.Printf("%s Handed %s back to %s\n", style.SuccessPrefix, beadID, rec.Assignee)
+	} else {
+		fmt.Printf("%s Handed %s back to the queue\n", style.SuccessPrefix, beadID)
+	}
+	return nil
+}
+
+// saveHandbackWork commits any uncommitted changes in the taken-over worktree
+// and pushes the branch. Returns the commits made since takeover and whether
+// the branch was pushed.
+func saveHandbackWork(rec *takeoverRecord) (commits []string, pushed bool) {
+	if _, err := os.Stat(rec.Worktree); err != nil {
+		style.PrintWarning("worktree %s is gone; no work to save", rec.Worktree)
+		return nil, false
+	}
+	g := git.NewGit(rec.Worktree)
+	if dirty, err := g.HasUncommittedChanges(); err == nil && dirty {
+		if err := g.Add("-A"); err == nil {
+			err = g.Commit("WIP: handback from " + rec.Human)
+		}
+		if err != nil {
+			style.PrintWarning("could not commit uncommitted changes: %v", err)
+		} else {
+			fmt.Printf("  %s committed uncommitted changes\n", style.Success.Render("✓"))
+		}
+	}
+	if rec.BaseCommit != "" {
+		commits, _ = g.CommitSubjects(rec.BaseCommit, "HEAD")
+	}
+	if rec.Branch != "" {
+		if err := g.Push("origin", rec.Branch+":"+rec.Branch, false); err != nil {
+			fmt.Printf("  %s push failed (work kept locally): %v\n", style.Dim.Render("○"), err)
+		} else {
+			fmt.Printf("  %s pushed %s\n", style.Success.Render("✓"), rec.Branch)
+			pushed = true
+		}
+	}
+	return commits, pushed
+}
+
+// handbackContext summarizes the takeover for the bead comment and resume nudge.
+func handbackContext(rec *takeoverRecord, commits []string, pushed bool, note string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Handed back by %s after takeover (taken %s).", rec.Human, rec.TakenAt)
+	where := "local only, in " + rec.Worktree
+	if pushed {
+		where = "pushed to origin"
+	}
+	fmt.Fprintf(&sb, " Work is on branch %s (%s).", rec.Branch, where)
+	if len(commits) > 0 {
+		fmt.Fprintf(&sb, "\nCommits made during takeover:")
+		for _, c := range commits {
+			fmt.Fprintf(&sb, "\n- %s", c)
+		}
+	} else {
+		sb.WriteString(" No commits were made during takeover.")
+	}
+	if note != "" {
+		fmt.Fprintf(&sb, "\nNote: %s", note)
+	}
+	return sb.String()
+}
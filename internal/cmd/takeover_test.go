@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func TestParsePolecatAssignee(t *testing.T) {
+	tests := []struct {
+		in        string
+		rig, name string
+		ok        bool
+	}{
+		{"gastown/polecats/Toast", "gastown", "Toast", true},
+		{"gastown/polecats/Toast/", "gastown", "Toast", true},
+		{"gastown/crew/max", "", "", false},
+		{"overseer", "", "", false},
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		rig, name, ok := parsePolecatAssignee(tt.in)
+		if rig != tt.rig || name != tt.name || ok != tt.ok {
+			t.Errorf("parsePolecatAssignee(%q) = %q, %q, %v; want %q, %q, %v",
+				tt.in, rig, name, ok, tt.rig, tt.name, tt.ok)
+		}
+	}
+}
+
+func TestTakeoverRecordRoundTrip(t *testing.T) {
+	townRoot := t.TempDir()
+	rec := &takeoverRecord{
+		BeadID:   "gt-abc",
+		Human:    "overseer",
+		Assignee: "gastown/polecats/Toast",
+		Rig:      "gastown",
+		Polecat:  "Toast",
+		Worktree: "/tmp/toast",
+		Branch:   "polecat/toast",
+	}
+	if err := saveTakeoverRecord(townRoot, rec); err != nil {
+		t.Fatal(err)
+	}
+	got, err := loadTakeoverRecord(townRoot, "gt-abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != *rec {
+		t.Errorf("loaded %+v, want %+v", got, rec)
+	}
+	if _, err := loadTakeoverRecord(townRoot, "gt-other"); err == nil {
+		t.Error("expected error for a bead that was not taken over")
+	}
+}
+
+func TestHandbackContext(t *testing.T) {
+	rec := &takeoverRecord{Human: "overseer", Branch: "polecat/toast", Worktree: "/tmp/toast", TakenAt: "2026-10-16T12:00:00Z"}
+
+	got := handbackContext(rec, []string{"fix flaky test", "WIP: handback from overseer"}, true, "feature still TODO")
+	for _, want := range []string{"pushed to origin", "- fix flaky test", "Note: feature still TODO"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("handbackContext() = %q, want it to contain %q", got, want)
+		}
+	}
+
+	got = handbackContext(rec, nil, false, "")
+	if !strings.Contains(got, "local only, in /tmp/toast") || !strings.Contains(got, "No commits") {
+		t.Errorf("handbackContext() = %q", got)
+	}
+}
+
+// TestTakeoverKillKeepsPolecatOutOfIdleReuse verifies that after takeover
+// --kill the polecat, now with no hooked bead and no session, is not offered
+// to sling as idle: reuse would hard-reset the worktree the human is editing.
+func TestTakeoverKillKeepsPolecatOutOfIdleReuse(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd script requires sh")
+	}
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux not installed")
+	}
+
+	// Mock bd: no assigned work; the agent bead's agent_state is whatever the
+	// last description update wrote (initially working, as when slung).
+	binDir := t.TempDir()
+	statePath := filepath.Join(binDir, "agent_state")
+	script := `#!/bin/sh
+cmd=""
+for arg in "$@"; do
+  case "$arg" in
+    --*) ;;
+    *) cmd="$arg"; break ;;
+  esac
+done
+case "$cmd" in
+  list) echo '[]' ;;
+  show)
+    state=$(cat "` + statePath + `" 2>/dev/null || echo working)
+    printf '[{"id":"agent-toast","title":"Polecat toast","status":"open","labels":["gt:agent"],"description":"Polecat toast\\n\\nrole_type: polecat\\nrig: takeoverrig\\nagent_state: %s\\nhook_bead: null"}]' "$state"
+    ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in
+        --description=*) printf '%s\n' "${arg#--description=}" | sed -n 's/^agent_state: //p' > "` + statePath + `" ;;
+      esac
+    done
+    ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write mock bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	reg := session.NewPrefixRegistry()
+	reg.Register("tko", "takeoverrig")
+	old := session.DefaultRegistry()
+	session.SetDefaultRegistry(reg)
+	t.Cleanup(func() { session.SetDefaultRegistry(old) })
+
+	rigPath := filepath.Join(t.TempDir(), "takeoverrig")
+	for _, dir := range []string{filepath.Join(rigPath, "polecats", "toast"), filepath.Join(rigPath, "mayor", "rig")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	r := &rig.Rig{Name: "takeoverrig", Path: rigPath}
+	tm := tmux.NewTmux()
+	mgr := polecat.NewManager(r, git.NewGit(rigPath), tm)
+	p := polecatTarget{rigName: r.Name, polecatName: "toast", mgr: mgr, r: r}
+
+	if idle, err := mgr.FindIdlePolecat(); err != nil || idle == nil {
+		t.Fatalf("precondition: FindIdlePolecat() = %v, %v; want toast", idle, err)
+	}
+	if err := stopTakeoverPolecat(tm, p, true); err != nil {
+		t.Fatalf("stopTakeoverPolecat: %v", err)
+	}
+	idle, err := mgr.FindIdlePolecat()
+	if err != nil {
+		t.Fatalf("FindIdlePolecat: %v", err)
+	}
+	if idle != nil {
+		t.Errorf("FindIdlePolecat() = %q after takeover --kill, want nil", idle.Name)
+	}
+}
@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// compactSnapshotMaxNext caps the upcoming steps listed in a snapshot; the
+// agent only needs to know where it was headed, not the whole checklist.
+const compactSnapshotMaxNext = 3
+
+var tapCompactSnapshotCmd = &cobra.Command{
+	Use:   "compact-snapshot",
+	Short: "Save the agent's working context into its bead before compaction",
+	Long: `Snapshot the critical context of the current session into the hooked
+bead, so it survives context compaction.
+
+This command is designed to run from a Claude Code PreCompact hook. It
+records the bead being worked on, the formula checklist progress (current
+and next steps), the latest progress note, and the git state of the
+worktree in a delimited section of the bead's description, replacing any
+earlier snapshot.
+
+After compaction, gt prime --hook (SessionStart, source "compact") prints
+the snapshot back into the fresh context.
+
+Always exits 0: a failed snapshot must never block compaction.`,
+	Args:         cobra.NoArgs,
+	RunE:         runTapCompactSnapshot,
+	SilenceUsage: true,
+}
+
+func init() {
+	tapCmd.AddCommand(tapCompactSnapshotCmd)
+}
+
+// compactSnapshotStep is a formula step named in a snapshot.
+type compactSnapshotStep struct {
+	ID    string
+	Title string
+}
+
+// compactSnapshot is the context an agent needs to pick its work back up.
+type compactSnapshot struct {
+	TakenAt    time.Time
+	Agent      string
+	BeadID     string
+	BeadTitle  string
+	Formula    string
+	Molecule   string
+	DoneSteps  int
+	TotalSteps int
+	Current    []compactSnapshotStep // Steps in progress
+	Next       []compactSnapshotStep // Ready steps, in sequence order
+	Branch     string
+	Dirty      int    // Uncommitted files
+	LastCommit string // Hash and subject
+	LatestNote string
+}
+
+func runTapCompactSnapshot(cmd *cobra.Command, args []string) error {
+	roleInfo, err := GetRole()
+	if err != nil {
+		return nil // Not in a workspace — nothing to snapshot
+	}
+	agentID := getAgentIdentity(roleInfo)
+	if agentID == "" {
+		return nil
+	}
+	// One attempt: compaction is waiting on this hook.
+	work, err := findAgentWorkOnce(roleInfo, agentID)
+	if err != nil || work == nil {
+		return nil
+	}
+
+	snapshot := collectCompactSnapshot(roleInfo, agentID, work)
+	text := formatCompactSnapshot(snapshot)
+	b := beads.New(beads.ResolveHookDir(roleInfo.TownRoot, work.ID, roleInfo.WorkDir))
+	if err := b.UpdateDescription(work.ID, func(issue *beads.Issue) (string, error) {
+		return beads.SetCompactSnapshot(issue.Description, text), nil
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "compact-snapshot: could not save to %s: %v\n", work.ID, err)
+		return nil
+	}
+
+	// Tell the post-compaction prime which bead to read, so it can skip the
+	// hooked-work query.
+	runtimeDir := filepath.Join(roleInfo.WorkDir, constants.DirRuntime)
+	if err := os.MkdirAll(runtimeDir, 0755); err == nil {
+		_ = os.WriteFile(filepath.Join(runtimeDir, constants.FileCompactSnapshot), []byte(work.ID), 0644)
+	}
+	fmt.Fprintf(os.Stderr, "compact-snapshot: saved context to %s\n", work.ID)
+	return nil
+}
+
+// collectCompactSnapshot gathers the snapshot for work. Every source is
+// best-effort: whatever can't be read is left out.
+func collectCompactSnapshot(ctx RoleContext, agentID string, work *beads.Issue) compactSnapshot {
+	snapshot := compactSnapshot{
+		TakenAt:   time.Now(),
+		Agent:     agentID,
+		BeadID:    work.ID,
+		BeadTitle: work.Title,
+	}
+
+	if attachment := beads.ParseAttachmentFields(work); attachment != nil {
+		snapshot.Formula = attachment.AttachedFormula
+		snapshot.Molecule = attachment.AttachedMolecule
+	}
+	if snapshot.Molecule != "" {
+		b := beads.New(beads.ResolveHookDir(ctx.TownRoot, snapshot.Molecule, ctx.WorkDir))
+		if steps, err := b.List(beads.ListOptions{Parent: snapshot.Molecule, Status: "all", Priority: -1}); err == nil {
+			snapshot.addSteps(steps)
+		}
+	}
+
+	g := git.NewGit(ctx.WorkDir)
+	if branch, err := g.CurrentBranch(); err == nil {
+		snapshot.Branch = branch
+	}
+	if status, err := g.Status(); err == nil {
+		snapshot.Dirty = len(status.Modified) + len(status.Added) + len(status.Deleted) + len(status.Untracked)
+	}
+	if commits, err := g.RecentCommits(1); err == nil {
+		snapshot.LastCommit = strings.TrimSpace(commits)
+	}
+
+	if ctx.TownRoot != "" {
+		if note, ok := latestBeadNotes(ctx.TownRoot, []string{work.ID})[work.ID]; ok {
+			snapshot.LatestNote = note.Message
+		}
+	}
+	return snapshot
+}
+
+// addSteps records checklist progress from a molecule's steps. Open steps
+// are listed in sequence order without dependency checks; the snapshot only
+// needs to say where the agent was headed.
+func (s *compactSnapshot) addSteps(steps []*beads.Issue) {
+	byID := make(map[string]*beads.Issue, len(steps))
+	var open []string
+	for _, step := range steps {
+		byID[step.ID] = step
+		s.TotalSteps++
+		switch step.Status {
+		case "closed":
+			s.DoneSteps++
+		case "in_progress":
+			s.Current = append(s.Current, compactSnapshotStep{ID: step.ID, Title: step.Title})
+		case "open":
+			open = append(open, step.ID)
+		}
+	}
+	sortStepIDsBySequence(open)
+	for _, id := range open {
+		if len(s.Next) == compactSnapshotMaxNext {
+			break
+		}
+		s.Next = append(s.Next, compactSnapshotStep{ID: id, Title: byID[id].Title})
+	}
+}
+
+// formatCompactSnapshot renders a snapshot as the markdown stored in the
+// bead and printed back after compaction.
+func formatCompactSnapshot(s compactSnapshot) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Context snapshot before compaction (%s, %s)\n\n",
+		s.Agent, s.TakenAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&sb, "- Working on %s: %s\n", s.BeadID, s.BeadTitle)
+	if s.Formula != "" || s.Molecule != "" {
+		plan := s.Formula
+		if plan == "" {
+			plan = "molecule"
+		}
+		if s.Molecule != "" {
+			plan += " (" + s.Molecule + ")"
+		}
+		if s.TotalSteps > 0 {
+			plan += fmt.Sprintf(", %d/%d steps done", s.DoneSteps, s.TotalSteps)
+		}
+		fmt.Fprintf(&sb, "- Plan: %s\n", plan)
+	}
+	for _, step := range s.Current {
+		fmt.Fprintf(&sb, "- In progress: %s %s\n", step.ID, step.Title)
+	}
+	for _, step := range s.Next {
+		fmt.Fprintf(&sb, "- Next: %s %s\n", step.ID, step.Title)
+	}
+	if s.LatestNote != "" {
+		fmt.Fprintf(&sb, "- Latest note: %s\n", s.LatestNote)
+	}
+	if s.Branch != "" {
+		fmt.Fprintf(&sb, "- Branch %s, %d uncommitted file(s)\n", s.Branch, s.Dirty)
+	}
+	if s.LastCommit != "" {
+		fmt.Fprintf(&sb, "- Last commit: %s\n", s.LastCommit)
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// outputCompactSnapshot prints the snapshot saved before this compaction,
+// if the PreCompact hook left one for this worktree. Costs a single bead
+// read, keeping the compact/resume prime fast.
+func outputCompactSnapshot(ctx RoleContext) {
+	if ctx.WorkDir == "" {
+		return
+	}
+	data, err := os.ReadFile(filepath.Join(ctx.WorkDir, constants.DirRuntime, constants.FileCompactSnapshot))
+	if err != nil {
+		return
+	}
+	beadID := strings.TrimSpace(string(data))
+	if beadID == "" {
+		return
+	}
+	issue, err := beads.New(beads.ResolveHookDir(ctx.TownRoot, beadID, ctx.WorkDir)).Show(beadID)
+	if err != nil || issue == nil || issue.Status == "closed" {
+		return
+	}
+	// A reused worktree may hold a marker for someone else's work.
+	if agentID := getAgentIdentity(ctx); issue.Assignee != "" && issue.Assignee != agentID {
+		return
+	}
+	if snapshot := beads.CompactSnapshot(issue.Description); snapshot != "" {
+		fmt.Printf("\n## Restored Context\n\n%s\n", snapshot)
+	}
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestCompactSnapshotSteps(t *testing.T) {
+	var s compactSnapshot
+	s.addSteps([]*beads.Issue{
+		{ID: "gt-wisp-1.5", Title: "Open MR", Status: "open"},
+		{ID: "gt-wisp-1.1", Title: "Read the bead", Status: "closed"},
+		{ID: "gt-wisp-1.2", Title: "Implement", Status: "in_progress"},
+		{ID: "gt-wisp-1.3", Title: "Run tests", Status: "open"},
+		{ID: "gt-wisp-1.4", Title: "Write docs", Status: "open"},
+		{ID: "gt-wisp-1.6", Title: "gt done", Status: "open"},
+	})
+
+	if s.DoneSteps != 1 || s.TotalSteps != 6 {
+		t.Errorf("progress = %d/%d, want 1/6", s.DoneSteps, s.TotalSteps)
+	}
+	if len(s.Current) != 1 || s.Current[0].Title != "Implement" {
+		t.Errorf("current = %+v, want Implement", s.Current)
+	}
+	var next []string
+	for _, step := range s.Next {
+		next = append(next, step.Title)
+	}
+	if got := strings.Join(next, ","); got != "Run tests,Write docs,Open MR" {
+		t.Errorf("next = %s, want the first %d open steps in sequence order", got, compactSnapshotMaxNext)
+	}
+}
+
+func TestFormatCompactSnapshot(t *testing.T) {
+	s := compactSnapshot{
+		TakenAt:    time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Agent:      "gastown/polecats/toast",
+		BeadID:     "gt-abc",
+		BeadTitle:  "Fix login",
+		Formula:    "mol-polecat-work",
+		Molecule:   "gt-wisp-1",
+		DoneSteps:  1,
+		TotalSteps: 6,
+		Current:    []compactSnapshotStep{{ID: "gt-wisp-1.2", Title: "Implement"}},
+		Next:       []compactSnapshotStep{{ID: "gt-wisp-1.3", Title: "Run tests"}},
+		Branch:     "polecat/toast",
+		Dirty:      2,
+		LatestNote: "reproduced the bug",
+	}
+	got := formatCompactSnapshot(s)
+	for _, want := range []string{
+		"(gastown/polecats/toast, 2026-03-01T12:00:00Z)",
+		"- Working on gt-abc: Fix login",
+		"- Plan: mol-polecat-work (gt-wisp-1), 1/6 steps done",
+		"- In progress: gt-wisp-1.2 Implement",
+		"- Next: gt-wisp-1.3 Run tests",
+		"- Latest note: reproduced the bug",
+		"- Branch polecat/toast, 2 uncommitted file(s)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("snapshot missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Last commit") {
+		t.Errorf("snapshot should omit unknown fields:\n%s", got)
+	}
+
+	// The snapshot must not be mistaken for attachment fields once stored.
+	desc := beads.SetCompactSnapshot("attached_molecule: gt-wisp-1", got)
+	if f := beads.ParseAttachmentFields(&beads.Issue{Description: desc}); f == nil || f.AttachedMolecule != "gt-wisp-1" || f.Mode != "" {
+		t.Errorf("attachment fields after snapshot = %+v", f)
+	}
+}
+
+func TestOutputCompactSnapshot_NoMarker(t *testing.T) {
+	output := captureStdout(t, func() {
+		outputCompactSnapshot(RoleContext{Role: RolePolecat, WorkDir: t.TempDir()})
+	})
+	if output != "" {
+		t.Errorf("expected no output without a snapshot marker, got:\n%s", output)
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/cmdpolicy"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var tapGuardPolicyCmd = &cobra.Command{
+	Use:   "command-policy",
+	Short: "Enforce the town command policy on Bash tool calls",
+	Long: `Evaluate an agent's Bash command against the town command policy
+(settings/command-policy.json) and block it if denied.
+
+Each simple command in the line (split on ;, &&, ||, pipes, subshells, and
+command substitutions) must be allowed. Denied attempts are recorded as
+command_denied audit events; list them with gt policy denials.
+
+Without a policy file every command is allowed. A malformed policy is
+reported on stderr and fails open, so a bad edit can't wedge every agent;
+check edits with gt policy check.
+
+Exit codes:
+  0 - Command allowed
+  2 - Command BLOCKED`,
+	RunE: runTapGuardPolicy,
+}
+
+func init() {
+	tapGuardCmd.AddCommand(tapGuardPolicyCmd)
+}
+
+func runTapGuardPolicy(cmd *cobra.Command, args []string) error {
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil // fail open
+	}
+	command := extractCommand(input)
+	if command == "" || !isGasTownAgentContext() {
+		return nil
+	}
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+
+	policy, err := cmdpolicy.Load(townRoot)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: command policy not enforced: %v\n", err)
+		return nil
+	}
+	if policy == nil {
+		return nil
+	}
+
+	actor, rig := "unknown", ""
+	if info, err := GetRole(); err == nil {
+		actor, rig = info.ActorString(), info.Rig
+	}
+	d := policy.Evaluate(rig, command)
+	if d.Allowed {
+		return nil
+	}
+
+	printPolicyBlock(d)
+	_ = events.LogAudit(events.TypeCommandDenied, actor,
+		events.CommandDeniedPayload(rig, truncateStr(command, 500), d.Command, d.Rule, d.Scope, d.Reason))
+	return NewSilentExit(2)
+}
+
+// printPolicyBlock prints the block banner to stderr.
+func printPolicyBlock(d cmdpolicy.Decision) {
+	rule := d.Rule
+	if rule == "" {
+		rule = "(default deny)"
+	}
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "╔══════════════════════════════════════════════════════════════════╗")
+	fmt.Fprintln(os.Stderr, "║  ❌ COMMAND DENIED BY TOWN POLICY                                ║")
+	fmt.Fprintln(os.Stderr, "╠══════════════════════════════════════════════════════════════════╣")
+	fmt.Fprintf(os.Stderr, "║  Command: %-53s ║\n", truncateStr(d.Command, 53))
+	fmt.Fprintf(os.Stderr, "║  Rule:    %-53s ║\n", truncateStr(rule, 53))
+	fmt.Fprintf(os.Stderr, "║  Scope:   %-53s ║\n", truncateStr(d.Scope, 53))
+	if d.Reason != "" {
+		fmt.Fprintf(os.Stderr, "║  Reason:  %-53s ║\n", truncateStr(d.Reason, 53))
+	}
+	fmt.Fprintln(os.Stderr, "║                                                                  ║")
+	fmt.Fprintln(os.Stderr, "║  Find another way, or ask the operator to change the policy.     ║")
+	fmt.Fprintln(os.Stderr, "╚══════════════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(os.Stderr, "")
+}
@@ -0,0 +1,337 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/channelevents"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var tapGuardProtectedCmd = &cobra.Command{
+	Use:   "protected-paths",
+	Short: "Block agent writes to protected town paths",
+	Long: `Block agent tool calls that modify protected town paths.
+
+Protected paths are listed in town settings (settings/config.json):
+
+  "protected_paths": {
+    "paths": [".runtime/", "*/.runtime/", "daemon/", "settings/"]
+  }
+
+Entries are relative to the town root. A trailing "/" protects a directory
+and everything under it. When unset, runtime state, the daemon directory,
+and town config are protected.
+
+The guard checks Write, Edit, MultiEdit, and NotebookEdit targets, and Bash
+commands that write to a path (redirection, rm, mv, cp, touch, sed -i, ...).
+Reads are allowed. Violations are logged to the events feed and reported to
+the rig's witness.
+
+Gas Town itself (gt, bd, the daemon) still writes these paths; only agent
+tool calls are guarded.
+
+Exit codes:
+  0 - Operation allowed
+  2 - Operation BLOCKED`,
+	RunE: runTapGuardProtected,
+}
+
+func init() {
+	tapGuardCmd.AddCommand(tapGuardProtectedCmd)
+}
+
+// protectedHookInput is the part of the PreToolUse hook input the guard reads.
+type protectedHookInput struct {
+	ToolName  string `json:"tool_name"`
+	Cwd       string `json:"cwd"`
+	ToolInput struct {
+		FilePath     string `json:"file_path"`
+		NotebookPath string `json:"notebook_path"`
+		Command      string `json:"command"`
+	} `json:"tool_input"`
+}
+
+// protectedViolation is a tool call target that matched a protected entry.
+type protectedViolation struct {
+	path string // Town-relative
+	rule string
+}
+
+func runTapGuardProtected(cmd *cobra.Command, args []string) error {
+	input, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil // fail open
+	}
+	var hookInput protectedHookInput
+	if err := json.Unmarshal(input, &hookInput); err != nil {
+		return nil
+	}
+	if !isGasTownAgentContext() {
+		return nil
+	}
+
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil || townRoot == "" {
+		return nil
+	}
+	rules := protectedPathRules(townRoot)
+	if len(rules) == 0 {
+		return nil
+	}
+	cwd := hookInput.Cwd
+	if cwd == "" {
+		cwd, _ = os.Getwd()
+	}
+
+	v := findProtectedViolation(townRoot, cwd, hookInput, rules)
+	if v == nil {
+		return nil
+	}
+
+	printProtectedBlock(hookInput.ToolName, v)
+	reportProtectedViolation(townRoot, hookInput, v)
+	return NewSilentExit(2)
+}
+
+// protectedPathRules returns the town's protected path entries, or nil when
+// the guard is disabled.
+func protectedPathRules(townRoot string) []string {
+	cfg := config.DefaultProtectedPathsConfig()
+	if ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil && ts.ProtectedPaths != nil {
+		if ts.ProtectedPaths.Disabled {
+			return nil
+		}
+		if len(ts.ProtectedPaths.Paths) > 0 {
+			cfg = ts.ProtectedPaths
+		}
+	}
+	return cfg.Paths
+}
+
+// findProtectedViolation returns the first protected path the tool call
+// would modify, or nil.
+func findProtectedViolation(townRoot, cwd string, in protectedHookInput, rules []string) *protectedViolation {
+	var targets []protectedTarget
+	switch in.ToolName {
+	case "Write", "Edit", "MultiEdit":
+		targets = []protectedTarget{{path: in.ToolInput.FilePath, cwd: cwd}}
+	case "NotebookEdit":
+		targets = []protectedTarget{{path: in.ToolInput.NotebookPath, cwd: cwd}}
+	case "Bash":
+		targets = bashWriteTargets(in.ToolInput.Command, cwd)
+	}
+
+	for _, t := range targets {
+		rel, ok := townRelPath(townRoot, t.cwd, t.path)
+		if !ok {
+			continue
+		}
+		if rule := matchProtectedPath(rel, rules); rule != "" {
+			return &protectedViolation{path: rel, rule: rule}
+		}
+	}
+	return nil
+}
+
+// protectedTarget is a path a command writes, with the directory it is
+// relative to.
+type protectedTarget struct {
+	path string
+	cwd  string
+}
+
+// mutatingCommands write every path argument they're given.
+var mutatingCommands = map[string]bool{
+	"rm": true, "rmdir": true, "unlink": true, "shred": true, "mv": true,
+	"touch": true, "mkdir": true, "chmod": true, "chown": true, "truncate": true,
+	"tee": true, "ln": true,
+}
+
+// copyCommands write only their last argument (the destination).
+var copyCommands = map[string]bool{"cp": true, "install": true, "rsync": true}
+
+// bashWriteTargets returns the paths a shell command would write. It is a
+// heuristic over a whitespace tokenization, not a shell parser: it covers
+// redirections, the common file-mutating commands, in-place sed/perl, and
+// dd of=, and follows cd between chained commands.
+func bashWriteTargets(command, cwd string) []protectedTarget {
+	var targets []protectedTarget
+	replacer := strings.NewReplacer("&&", "\n", "||", "\n", ";", "\n", "|", "\n")
+	for _, segment := range strings.Split(replacer.Replace(command), "\n") {
+		fields := strings.Fields(segment)
+		for i := range fields {
+			fields[i] = strings.Trim(fields[i], `"'`)
+		}
+
+		// Redirections: >file, >> file, 2>file, &>file.
+		var words []string
+		for i := 0; i < len(fields); i++ {
+			f := fields[i]
+			idx := strings.LastIndex(f, ">")
+			if idx < 0 {
+				words = append(words, f)
+				continue
+			}
+			target := f[idx+1:]
+			if target == "" && i+1 < len(fields) {
+				i++
+				target = fields[i]
+			}
+			if target != "" && !strings.HasPrefix(target, "&") {
+				targets = append(targets, protectedTarget{path: target, cwd: cwd})
+			}
+		}
+
+		// Skip env assignments and wrappers to find the command.
+		for len(words) > 0 && (strings.Contains(words[0], "=") || words[0] == "env" || words[0] == "sudo" || words[0] == "command") {
+			words = words[1:]
+		}
+		if len(words) == 0 {
+			continue
+		}
+		verb, args := filepath.Base(words[0]), words[1:]
+
+		var operands []string
+		inPlace := false
+		for _, a := range args {
+			if strings.HasPrefix(a, "-") {
+				if strings.HasPrefix(a, "-i") || a == "--in-place" {
+					inPlace = true
+				}
+				continue
+			}
+			operands = append(operands, a)
+		}
+
+		switch {
+		case verb == "cd":
+			if len(operands) > 0 {
+				cwd = resolveAgainst(cwd, operands[0])
+			}
+		case mutatingCommands[verb]:
+			for _, o := range operands {
+				targets = append(targets, protectedTarget{path: o, cwd: cwd})
+			}
+		case copyCommands[verb]:
+			if len(operands) > 0 {
+				targets = append(targets, protectedTarget{path: operands[len(operands)-1], cwd: cwd})
+			}
+		case (verb == "sed" || verb == "perl") && inPlace:
+			// The first operand is the script unless given with -e.
+			if len(operands) > 1 {
+				operands = operands[1:]
+			}
+			for _, o := range operands {
+				targets = append(targets, protectedTarget{path: o, cwd: cwd})
+			}
+		case verb == "dd":
+			for _, o := range operands {
+				if strings.HasPrefix(o, "of=") {
+					targets = append(targets, protectedTarget{path: strings.TrimPrefix(o, "of="), cwd: cwd})
+				}
+			}
+		}
+	}
+	return targets
+}
+
+// resolveAgainst returns p as an absolute, cleaned path, expanding a leading
+// ~ and resolving relative paths against cwd.
+func resolveAgainst(cwd, p string) string {
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			p = filepath.Join(home, strings.TrimPrefix(p, "~"))
+		}
+	}
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(cwd, p)
+	}
+	return filepath.Clean(p)
+}
+
+// townRelPath returns p relative to townRoot, or false if p is outside it.
+func townRelPath(townRoot, cwd, p string) (string, bool) {
+	if p == "" || strings.ContainsAny(p, "$`") {
+		return "", false
+	}
+	rel, err := filepath.Rel(filepath.Clean(townRoot), resolveAgainst(cwd, p))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// matchProtectedPath returns the protected entry that covers the
+// town-relative path rel, or "" if none does.
+func matchProtectedPath(rel string, rules []string) string {
+	relSegs := strings.Split(rel, "/")
+	for _, rule := range rules {
+		isDir := strings.HasSuffix(rule, "/")
+		ruleSegs := strings.Split(strings.Trim(rule, "/"), "/")
+		if len(relSegs) < len(ruleSegs) || (!isDir && len(relSegs) != len(ruleSegs)) {
+			continue
+		}
+		matched := true
+		for i, seg := range ruleSegs {
+			if ok, err := filepath.Match(seg, relSegs[i]); err != nil || !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return rule
+		}
+	}
+	return ""
+}
+
+// printProtectedBlock prints the block banner to stderr.
+func printProtectedBlock(tool string, v *protectedViolation) {
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "╔══════════════════════════════════════════════════════════════════╗")
+	fmt.Fprintln(os.Stderr, "║  ❌ PROTECTED PATH BLOCKED                                       ║")
+	fmt.Fprintln(os.Stderr, "╠══════════════════════════════════════════════════════════════════╣")
+	fmt.Fprintf(os.Stderr, "║  Tool:    %-53s ║\n", truncateStr(tool, 53))
+	fmt.Fprintf(os.Stderr, "║  Path:    %-53s ║\n", truncateStr(v.path, 53))
+	fmt.Fprintf(os.Stderr, "║  Rule:    %-53s ║\n", truncateStr(v.rule, 53))
+	fmt.Fprintln(os.Stderr, "║                                                                  ║")
+	fmt.Fprintln(os.Stderr, "║  This path is managed by Gas Town. Use gt commands instead.      ║")
+	fmt.Fprintln(os.Stderr, "║  The witness has been notified.                                  ║")
+	fmt.Fprintln(os.Stderr, "╚══════════════════════════════════════════════════════════════════╝")
+	fmt.Fprintln(os.Stderr, "")
+}
+
+// reportProtectedViolation logs the violation to the events feed and tells
+// the agent's witness. Best-effort: the block stands even if reporting fails.
+func reportProtectedViolation(townRoot string, in protectedHookInput, v *protectedViolation) {
+	actor, rig := "unknown", ""
+	var role Role
+	if info, err := GetRole(); err == nil {
+		actor, rig, role = info.ActorString(), info.Rig, info.Role
+	}
+
+	_ = events.LogFeed(events.TypeProtectedPathBlocked, actor,
+		events.ProtectedPathBlockedPayload(rig, in.ToolName, v.path, v.rule, truncateStr(in.ToolInput.Command, 200)))
+
+	if rig == "" || role == RoleWitness {
+		return
+	}
+	_, _ = channelevents.EmitToTown(townRoot, "witness", "PROTECTED_PATH", []string{
+		"source=" + actor,
+		"rig=" + rig,
+		"tool=" + in.ToolName,
+		"path=" + v.path,
+	})
+	msg := fmt.Sprintf("Protected path violation: %s tried to modify %s via %s (blocked)", actor, v.path, in.ToolName)
+	_ = tmux.NewTmux().NudgeSession(session.WitnessSessionName(session.PrefixFor(rig)), msg)
+}
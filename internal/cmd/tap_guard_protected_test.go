@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestMatchProtectedPath(t *testing.T) {
+	rules := config.DefaultProtectedPathsConfig().Paths
+	tests := []struct {
+		rel  string
+		want string
+	}{
+		{".runtime/scheduler-state.json", ".runtime/"},
+		{".runtime", ".runtime/"},
+		{"gastown/.runtime/locks/x.lock", "*/.runtime/"},
+		{"daemon/state.json", "daemon/"},
+		{"settings/config.json", "settings/"},
+		{"mayor/rigs.json", "mayor/rigs.json"},
+		{"mayor/notes.md", ""},
+		{"gastown/polecats/nux/gastown/main.go", ""},
+		{"gastown/polecats/nux/.runtime/x", ""},
+	}
+	for _, tt := range tests {
+		if got := matchProtectedPath(tt.rel, rules); got != tt.want {
+			t.Errorf("matchProtectedPath(%q) = %q, want %q", tt.rel, got, tt.want)
+		}
+	}
+}
+
+func TestFindProtectedViolation(t *testing.T) {
+	townRoot := "/town"
+	cwd := "/town/gastown/polecats/nux/gastown"
+	rules := config.DefaultProtectedPathsConfig().Paths
+
+	tests := []struct {
+		name     string
+		input    string
+		wantPath string
+	}{
+		{"write into runtime", `{"tool_name":"Write","tool_input":{"file_path":"/town/.runtime/foo.json"}}`, ".runtime/foo.json"},
+		{"edit relative escape", `{"tool_name":"Edit","tool_input":{"file_path":"../../../../settings/config.json"}}`, "settings/config.json"},
+		{"write own worktree", `{"tool_name":"Write","tool_input":{"file_path":"main.go"}}`, ""},
+		{"write outside town", `{"tool_name":"Write","tool_input":{"file_path":"/tmp/x"}}`, ""},
+		{"bash redirect", `{"tool_name":"Bash","tool_input":{"command":"echo paused > /town/.runtime/scheduler-state.json"}}`, ".runtime/scheduler-state.json"},
+		{"bash rm", `{"tool_name":"Bash","tool_input":{"command":"rm -f /town/daemon/daemon.pid"}}`, "daemon/daemon.pid"},
+		{"bash cd then rm", `{"tool_name":"Bash","tool_input":{"command":"cd /town && rm -rf .runtime"}}`, ".runtime"},
+		{"bash sed in place", `{"tool_name":"Bash","tool_input":{"command":"sed -i 's/a/b/' /town/mayor/rigs.json"}}`, "mayor/rigs.json"},
+		{"bash cp destination", `{"tool_name":"Bash","tool_input":{"command":"cp /tmp/cfg.json /town/settings/config.json"}}`, "settings/config.json"},
+		{"bash read allowed", `{"tool_name":"Bash","tool_input":{"command":"cat /town/.runtime/scheduler-state.json | jq ."}}`, ""},
+		{"bash cp from protected allowed", `{"tool_name":"Bash","tool_input":{"command":"cp /town/settings/config.json /tmp/"}}`, ""},
+		{"bash stderr dup", `{"tool_name":"Bash","tool_input":{"command":"go test ./... 2>&1"}}`, ""},
+		{"read tool ignored", `{"tool_name":"Read","tool_input":{"file_path":"/town/.runtime/foo.json"}}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var in protectedHookInput
+			if err := json.Unmarshal([]byte(tt.input), &in); err != nil {
+				t.Fatal(err)
+			}
+			v := findProtectedViolation(townRoot, cwd, in, rules)
+			got := ""
+			if v != nil {
+				got = v.path
+			}
+			if got != tt.wantPath {
+				t.Errorf("violation = %q, want %q", got, tt.wantPath)
+			}
+		})
+	}
+}
@@ -61,6 +61,22 @@ func runTapList(cmd *cobra.Command, args []string) error {
 			matchers:    []string{"Bash(sudo *)", "Bash(apt install*)", "Bash(dnf install*)", "Bash(brew install*)", "Bash(rm -rf /*)", "Bash(git push --force*)", "Bash(git push -f*)"},
 			implemented: true,
 		},
+		{
+			name:        "protected-paths",
+			kind:        "guard",
+			description: "Block agent writes to protected town paths (.runtime/, daemon/, settings/)",
+			event:       "PreToolUse",
+			matchers:    []string{"Write|Edit|MultiEdit|NotebookEdit", "Bash"},
+			implemented: true,
+		},
+		{
+			name:        "command-policy",
+			kind:        "guard",
+			description: "Enforce the town command policy (settings/command-policy.json)",
+			event:       "PreToolUse",
+			matchers:    []string{"Bash"},
+			implemented: true,
+		},
 	}
 
 	// Try to load registry for additional handlers
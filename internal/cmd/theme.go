@@ -11,6 +11,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -67,11 +68,16 @@ Modes:
 The setting is stored in town settings (settings/config.json) and can
 be overridden per-session via the GT_THEME environment variable.
 
+When stdout is not a terminal (hooks, pipes, logs), output is plain: no
+styling and ASCII icons (+ ! x -> instead of ✓ ⚠ ✖ →). Set GT_OUTPUT=plain
+or GT_OUTPUT=styled to choose explicitly.
+
 Examples:
   gt theme cli              # Show current CLI theme
   gt theme cli dark         # Set CLI theme to dark mode
   gt theme cli auto         # Reset to auto-detection
-  GT_THEME=light gt status  # Override for a single command`,
+  GT_THEME=light gt status  # Override for a single command
+  GT_OUTPUT=plain gt status # Grep-able output in a terminal`,
 	RunE: runThemeCLI,
 }
 
@@ -401,6 +407,7 @@ func runThemeCLI(cmd *cobra.Command, args []string) error {
 			}
 			fmt.Printf("  Detected:   %s background\n", detected)
 		}
+		fmt.Printf("  Output:     %s\n", style.Current().Name())
 
 		return nil
 	}
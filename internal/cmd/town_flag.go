@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// townFlag is the global --town flag: a town root path, or the name of a
+// town in the machine registry.
+var townFlag string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&townFlag, "town", "",
+		"Town to operate on, by path or registered name, regardless of the working directory")
+}
+
+// applyTownFlag resolves --town and makes it the town every command sees:
+// the workspace CWD lookups return it, and GT_TOWN_ROOT and GT_ROOT are
+// exported so gt, bd and agents started from this process use it too. When
+// the working directory is outside that town, the process also changes to
+// the town root, so bd subprocesses started without a Dir find the town's
+// database rather than whatever the caller's directory holds.
+func applyTownFlag(cmd *cobra.Command) error {
+	if townFlag == "" {
+		return nil
+	}
+	townRoot, err := workspace.ResolveTown(townFlag)
+	if err != nil {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("--town: %w", err)
+	}
+	if cwd, err := os.Getwd(); err != nil || !inTown(cwd, townRoot) {
+		if err := os.Chdir(townRoot); err != nil {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("--town: %w", err)
+		}
+	}
+	workspace.SetTownOverride(townRoot)
+	_ = os.Setenv("GT_TOWN_ROOT", townRoot)
+	_ = os.Setenv("GT_ROOT", townRoot)
+	return nil
+}
+
+// inTown reports whether dir lies inside the town rooted at townRoot.
+func inTown(dir, townRoot string) bool {
+	root, err := workspace.Find(dir)
+	return err == nil && root != "" && filepath.Clean(root) == filepath.Clean(townRoot)
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+func TestApplyTownFlag_ChangesToTownOutsideIt(t *testing.T) {
+	t.Setenv("GT_TOWN_ROOT", "")
+	t.Setenv("GT_ROOT", "")
+	mkTown := func() string {
+		root, err := filepath.EvalSymlinks(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(root, "mayor"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, workspace.PrimaryMarker), []byte(`{"type":"town"}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+	town := mkTown()
+	rigDir := filepath.Join(town, "gastown")
+	if err := os.MkdirAll(rigDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	oldFlag := townFlag
+	t.Cleanup(func() {
+		townFlag = oldFlag
+		workspace.SetTownOverride("")
+	})
+	townFlag = town
+
+	// Inside the town, the working directory is kept for rig context.
+	t.Chdir(rigDir)
+	if err := applyTownFlag(&cobra.Command{}); err != nil {
+		t.Fatal(err)
+	}
+	if cwd, _ := os.Getwd(); cwd != rigDir {
+		t.Errorf("cwd inside the town = %q, want %q", cwd, rigDir)
+	}
+
+	// Outside it (here, another town), commands run from the town root.
+	t.Chdir(mkTown())
+	if err := applyTownFlag(&cobra.Command{}); err != nil {
+		t.Fatal(err)
+	}
+	if cwd, _ := os.Getwd(); cwd != town {
+		t.Errorf("cwd outside the town = %q, want %q", cwd, town)
+	}
+}
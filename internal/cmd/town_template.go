@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/towntemplate"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	townTemplateOutput string
+	townCreateFrom     string
+	townCreateName     string
+	townCreateNoRigs   bool
+)
+
+func init() {
+	townTemplateExportCmd.Flags().StringVarP(&townTemplateOutput, "output", "o", "", "Write the template here (default: <town>-template.tar; - for stdout)")
+	townCreateCmd.Flags().StringVar(&townCreateFrom, "from", "", "Town template to create from (gt town template export)")
+	townCreateCmd.Flags().StringVarP(&townCreateName, "name", "n", "", "Town name (defaults to directory name)")
+	townCreateCmd.Flags().BoolVar(&townCreateNoRigs, "no-rigs", false, "Apply town configuration only; don't clone the template's rigs")
+	_ = townCreateCmd.MarkFlagRequired("from")
+
+	townTemplateCmd.AddCommand(townTemplateExportCmd)
+	townCmd.AddCommand(townTemplateCmd)
+	townCmd.AddCommand(townCreateCmd)
+}
+
+var townTemplateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Export this town's configuration as a reusable template",
+	Long: `Town templates capture a town's configuration so a new machine or a
+teammate's environment can be stood up with one command (gt town create).
+
+A template holds:
+  settings/               Town settings, scheduler routing rules, escalation
+  config/messaging.json   Mailing lists, queues and channels
+  mayor/daemon.json       Patrol schedules and the energy saver window
+  .beads/formulas/        Formulas, including local edits
+  plugins/                Town plugins
+  rigs                    Each rig's git URL, prefix and default branch, plus
+                          its settings/ and plugins/
+
+Runtime state, beads, accounts and rig clones are never captured.`,
+}
+
+var townTemplateExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write this town's configuration to a template archive",
+	Long: `Write this town's configuration to a tar archive that gt town create
+--from can build a new town from. See gt town template --help for what is
+captured.
+
+Examples:
+  gt town template export                      # ./<town>-template.tar
+  gt town template export -o ~/gastown.tar
+  gt town template export -o - | ssh box 'cat > town.tar'`,
+	Args: cobra.NoArgs,
+	RunE: runTownTemplateExport,
+}
+
+var townCreateCmd = &cobra.Command{
+	Use:   "create <path> --from <template.tar>",
+	Short: "Create a new town from a template",
+	Long: `Create a new town at <path> from a template written by
+gt town template export.
+
+Runs gt install for the new town, applies the template's configuration over
+the defaults, then adds each of the template's rigs (cloning from its git
+URL) and applies the rig's settings. A rig that fails to add is reported and
+skipped; add it later with gt rig add.
+
+Examples:
+  gt town create ~/gt --from gastown-template.tar
+  gt town create ~/gt --from gastown-template.tar --no-rigs`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTownCreate,
+}
+
+func runTownTemplateExport(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	data, manifest, err := towntemplate.Bytes(townRoot, time.Now())
+	if err != nil {
+		return fmt.Errorf("exporting template: %w", err)
+	}
+
+	if townTemplateOutput == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	out := townTemplateOutput
+	if out == "" {
+		out = filepath.Base(townRoot) + "-template.tar"
+	}
+	if err := atomicfile.WriteFile(out, data, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", out, err)
+	}
+	fmt.Printf("%s Exported town template to %s (%s)\n", style.Bold.Render("✓"), out, manifest.Summary())
+	fmt.Printf("  Create a town from it with: gt town create <path> --from %s\n", out)
+	return nil
+}
+
+func runTownCreate(cmd *cobra.Command, args []string) error {
+	// Read and validate the whole template before touching disk.
+	f, err := os.Open(townCreateFrom)
+	if err != nil {
+		return err
+	}
+	tmpl, err := towntemplate.Read(f)
+	_ = f.Close()
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", townCreateFrom, err)
+	}
+	townPath, err := filepath.Abs(args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Creating town from template %s (%s)\n\n",
+		style.Bold.Render("📦"), tmpl.Manifest.Town, tmpl.Manifest.Summary())
+
+	installArgs := []string{"install", townPath}
+	if townCreateName != "" {
+		installArgs = append(installArgs, "--name", townCreateName)
+	}
+	if err := runGT("", installArgs...); err != nil {
+		return fmt.Errorf("gt install: %w", err)
+	}
+
+	n, err := tmpl.ApplyTown(townPath)
+	if err != nil {
+		return fmt.Errorf("applying template: %w", err)
+	}
+	fmt.Printf("\n%s Applied %d town configuration file(s)\n", style.Bold.Render("✓"), n)
+
+	if townCreateNoRigs {
+		if len(tmpl.Manifest.Rigs) > 0 {
+			fmt.Printf("%s Skipped %d rig(s) (--no-rigs)\n", style.Dim.Render("○"), len(tmpl.Manifest.Rigs))
+		}
+	} else {
+		var failed []string
+		for _, r := range tmpl.Manifest.Rigs {
+			fmt.Printf("\n%s Adding rig %s from %s\n", style.Bold.Render("→"), r.Name, r.GitURL)
+			if err := runGT(townPath, templateRigAddArgs(r)...); err != nil {
+				style.PrintWarning("could not add rig %s: %v", r.Name, err)
+				failed = append(failed, r.Name)
+				continue
+			}
+			if n, err := tmpl.ApplyRig(townPath, r.Name); err != nil {
+				style.PrintWarning("could not apply %s settings: %v", r.Name, err)
+			} else if n > 0 {
+				fmt.Printf("%s Applied %d %s configuration file(s)\n", style.Bold.Render("✓"), n, r.Name)
+			}
+		}
+		if len(failed) > 0 {
+			fmt.Printf("\n%s %d rig(s) not added: %v\n", style.Warning.Render("⚠"), len(failed), failed)
+			fmt.Printf("  Retry with: gt rig add <name> <git-url> (see the template's template.json)\n")
+		}
+	}
+
+	fmt.Printf("\n%s Town created at %s\n", style.Bold.Render("✓"), townPath)
+	fmt.Printf("  Next: cd %s && gt up\n", townPath)
+	return nil
+}
+
+// templateRigAddArgs returns the gt rig add arguments that recreate r.
+func templateRigAddArgs(r towntemplate.Rig) []string {
+	args := []string{"rig", "add", r.Name, r.GitURL}
+	if r.Prefix != "" {
+		args = append(args, "--prefix", r.Prefix)
+	}
+	if r.DefaultBranch != "" {
+		args = append(args, "--branch", r.DefaultBranch)
+	}
+	if r.PushURL != "" {
+		args = append(args, "--push-url", r.PushURL)
+	}
+	if r.UpstreamURL != "" {
+		args = append(args, "--upstream-url", r.UpstreamURL)
+	}
+	return args
+}
+
+// runGT runs this gt binary with args in dir (the current directory when
+// empty), passing its output through.
+func runGT(dir string, args ...string) error {
+	gtPath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	c := exec.Command(gtPath, args...) //nolint:gosec // G204: re-invoking our own binary
+	c.Dir = dir
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	return c.Run()
+}
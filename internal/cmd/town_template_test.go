@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/towntemplate"
+)
+
+func TestTemplateRigAddArgs(t *testing.T) {
+	got := templateRigAddArgs(towntemplate.Rig{
+		Name:          "gastown",
+		GitURL:        "https://example.com/gastown.git",
+		Prefix:        "gt",
+		DefaultBranch: "trunk",
+		PushURL:       "git@example.com:me/gastown.git",
+	})
+	want := "rig add gastown https://example.com/gastown.git --prefix gt --branch trunk --push-url git@example.com:me/gastown.git"
+	if strings.Join(got, " ") != want {
+		t.Errorf("args = %q\nwant    %q", strings.Join(got, " "), want)
+	}
+
+	got = templateRigAddArgs(towntemplate.Rig{Name: "beads", GitURL: "https://example.com/beads.git"})
+	if strings.Join(got, " ") != "rig add beads https://example.com/beads.git" {
+		t.Errorf("minimal args = %q", got)
+	}
+}
@@ -1,11 +1,10 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -17,10 +16,12 @@ import (
 )
 
 var (
-	trailSince string
-	trailLimit int
-	trailJSON  bool
-	trailAll   bool
+	trailSince       string
+	trailCommitsPage listFlags
+	trailBeadsPage   listFlags
+	trailHooksPage   listFlags
+	trailJSON        bool
+	trailAll         bool
 )
 
 var trailCmd = &cobra.Command{
@@ -40,6 +41,8 @@ Subcommands:
 Flags:
   --since    Show activity since this time (e.g., "1h", "24h", "7d")
   --limit    Maximum number of items to show (default: 20)
+  --sort, --offset, --cursor
+             Sort and page, as for other lists
   --json     Output as JSON
   --all      Include all activity (not just agents)
 
@@ -97,7 +100,10 @@ Examples:
 func init() {
 	// Add flags to trail command
 	trailCmd.PersistentFlags().StringVar(&trailSince, "since", "", "Show activity since this time (e.g., 1h, 24h, 7d)")
-	trailCmd.PersistentFlags().IntVar(&trailLimit, "limit", 20, "Maximum number of items to show")
+	trailCommitListing.register(trailCmd, &trailCommitsPage, 20)
+	trailCommitListing.register(trailCommitsCmd, &trailCommitsPage, 20)
+	trailBeadListing.register(trailBeadsCmd, &trailBeadsPage, 20)
+	trailHookListing.register(trailHooksCmd, &trailHooksPage, 20)
 	trailCmd.PersistentFlags().BoolVar(&trailJSON, "json", false, "Output as JSON")
 	trailCmd.PersistentFlags().BoolVar(&trailAll, "all", false, "Include all activity (not just agents)")
 
@@ -133,11 +139,11 @@ func runTrailCommits(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Build git log command
+	// Build git log command. All matching commits are read so pages
+	// past the first line up.
 	gitArgs := []string{
 		"log",
 		"--format=%H|%h|%an|%ae|%aI|%ar|%s",
-		fmt.Sprintf("-n%d", trailLimit*2), // Get extra to filter
 	}
 
 	if trailSince != "" {
@@ -156,7 +162,7 @@ func runTrailCommits(cmd *cobra.Command, args []string) error {
 	}
 
 	// Parse commits
-	var commits []CommitEntry
+	commits := []CommitEntry{}
 	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
 	for _, line := range lines {
 		if line == "" {
@@ -186,20 +192,20 @@ func runTrailCommits(cmd *cobra.Command, args []string) error {
 			Subject:   parts[6],
 			IsAgent:   isAgent,
 		})
+	}
 
-		if len(commits) >= trailLimit {
-			break
-		}
+	page, err := trailCommitListing.apply(commits, trailCommitsPage)
+	if err != nil {
+		return err
 	}
+	commits = page.Items
 
 	if trailJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(commits)
+		return printPageJSON(page, commits)
 	}
 
 	// Text output
-	if len(commits) == 0 {
+	if page.Total == 0 {
 		fmt.Println("No commits found")
 		return nil
 	}
@@ -214,10 +220,21 @@ func runTrailCommits(cmd *cobra.Command, args []string) error {
 		fmt.Printf("%s %s\n", style.Dim.Render(c.ShortHash), c.Subject)
 		fmt.Printf("    %s %s\n", authorLabel, style.Dim.Render(c.DateRel))
 	}
+	printPageFooter(page)
 
 	return nil
 }
 
+// trailCommitListing sorts gt trail commits, newest first.
+var trailCommitListing = listing[CommitEntry]{
+	fields: []listField[CommitEntry]{
+		{"date", func(c CommitEntry) any { return c.Date }},
+		{"author", func(c CommitEntry) any { return c.Author }},
+	},
+	defaultSort: "-date",
+	id:          func(c CommitEntry) string { return c.Hash },
+}
+
 // BeadEntry represents a bead for output.
 type BeadEntry struct {
 	ID        string    `json:"id"`
@@ -248,7 +265,6 @@ func runTrailBeads(cmd *cobra.Command, args []string) error {
 	beadsArgs := []string{
 		"query",
 		"--format", "{{.ID}}|{{.Title}}|{{.Status}}|{{.Agent}}|{{.UpdatedAt}}",
-		"--limit", fmt.Sprintf("%d", trailLimit),
 		"--sort", "-updated_at",
 	}
 
@@ -272,7 +288,7 @@ func runTrailBeads(cmd *cobra.Command, args []string) error {
 	}
 
 	// Parse output
-	var beads []BeadEntry
+	beads := []BeadEntry{}
 	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
 	for _, line := range lines {
 		if line == "" {
@@ -295,14 +311,18 @@ func runTrailBeads(cmd *cobra.Command, args []string) error {
 		})
 	}
 
+	page, err := trailBeadListing.apply(beads, trailBeadsPage)
+	if err != nil {
+		return err
+	}
+	beads = page.Items
+
 	if trailJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(beads)
+		return printPageJSON(page, beads)
 	}
 
 	// Text output
-	if len(beads) == 0 {
+	if page.Total == 0 {
 		fmt.Println("No beads found")
 		return nil
 	}
@@ -326,13 +346,25 @@ func runTrailBeads(cmd *cobra.Command, args []string) error {
 		}
 		fmt.Println()
 	}
+	printPageFooter(page)
 
 	return nil
 }
 
+// trailBeadListing sorts gt trail beads, most recently updated first.
+var trailBeadListing = listing[BeadEntry]{
+	fields: []listField[BeadEntry]{
+		{"updated", func(b BeadEntry) any { return b.UpdatedAt }},
+		{"status", func(b BeadEntry) any { return b.Status }},
+		{"agent", func(b BeadEntry) any { return b.Agent }},
+	},
+	defaultSort: "-updated",
+	id:          func(b BeadEntry) string { return b.ID },
+}
+
 func runTrailBeadsSimple(beadsDir string) error {
 	// Simple fallback using beads list
-	beadsCmd := exec.Command("beads", "list", "--limit", fmt.Sprintf("%d", trailLimit))
+	beadsCmd := exec.Command("beads", "list", "--limit", fmt.Sprintf("%d", trailBeadsPage.Limit))
 	beadsCmd.Dir = beadsDir
 	beadsCmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir+"/.beads")
 	beadsCmd.Stdout = os.Stdout
@@ -355,18 +387,24 @@ func runTrailHooks(cmd *cobra.Command, args []string) error {
 		since = time.Now().Add(-duration)
 	}
 
-	entries, err := readHookTrailEntries(filepath.Join(townRoot, events.EventsFile), since, trailLimit)
+	entries, err := readHookTrailEntries(townRoot, since, 0)
 	if err != nil {
 		return err
 	}
+	if entries == nil {
+		entries = []HookEntry{}
+	}
+	page, err := trailHookListing.apply(entries, trailHooksPage)
+	if err != nil {
+		return err
+	}
+	entries = page.Items
 
 	if trailJSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(entries)
+		return printPageJSON(page, entries)
 	}
 
-	if len(entries) == 0 {
+	if page.Total == 0 {
 		fmt.Println("No hook activity found")
 		return nil
 	}
@@ -392,55 +430,55 @@ func runTrailHooks(cmd *cobra.Command, args []string) error {
 			fmt.Printf("    %s\n", style.Dim.Render(entry.TimeRel))
 		}
 	}
+	printPageFooter(page)
 
 	return nil
 }
 
-func readHookTrailEntries(eventsPath string, since time.Time, limit int) ([]HookEntry, error) {
+// trailHookListing sorts gt trail hooks events, newest first.
+var trailHookListing = listing[HookEntry]{
+	fields: []listField[HookEntry]{
+		{"time", func(e HookEntry) any { return e.Timestamp }},
+		{"actor", func(e HookEntry) any { return e.Actor }},
+		{"type", func(e HookEntry) any { return e.Type }},
+	},
+	defaultSort: "-time",
+	// Events have no single unique key; this one is stable across runs.
+	id: func(e HookEntry) string {
+		return strings.Join([]string{e.Actor, e.Type, e.Bead}, "\x00")
+	},
+}
+
+// readHookTrailEntries returns up to limit (0 = all) hook/unhook events
+// since the given time, newest first, from the events log and any rotated
+// segments.
+func readHookTrailEntries(townRoot string, since time.Time, limit int) ([]HookEntry, error) {
 	if limit <= 0 {
-		return []HookEntry{}, nil
+		limit = math.MaxInt
 	}
 
-	data, err := os.ReadFile(eventsPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil
+	var matched []events.Event
+	err := events.ReadRange(townRoot, since, time.Time{}, func(event events.Event) bool {
+		if event.Type == events.TypeHook || event.Type == events.TypeUnhook {
+			matched = append(matched, event)
 		}
-		return nil, fmt.Errorf("reading events file: %w", err)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading events: %w", err)
 	}
-
-	trimmed := strings.TrimSpace(string(data))
-	if trimmed == "" {
+	if len(matched) == 0 {
 		return nil, nil
 	}
 
-	lines := strings.Split(trimmed, "\n")
-	entryCap := len(lines)
+	entryCap := len(matched)
 	if limit < entryCap {
 		entryCap = limit
 	}
 	entries := make([]HookEntry, 0, entryCap)
-	for i := len(lines) - 1; i >= 0; i-- {
-		line := strings.TrimSpace(lines[i])
-		if line == "" {
-			continue
-		}
-
-		var event events.Event
-		if err := json.Unmarshal([]byte(line), &event); err != nil {
-			continue
-		}
-		if event.Type != events.TypeHook && event.Type != events.TypeUnhook {
-			continue
-		}
-
-		ts, err := time.Parse(time.RFC3339, event.Timestamp)
-		if err != nil {
-			continue
-		}
-		if !since.IsZero() && ts.Before(since) {
-			continue
-		}
+	for i := len(matched) - 1; i >= 0 && len(entries) < limit; i-- {
+		event := matched[i]
+		ts, _ := time.Parse(time.RFC3339, event.Timestamp) // Validated by ReadRange
 
 		bead := ""
 		if rawBead, ok := event.Payload["bead"]; ok && rawBead != nil {
@@ -459,9 +497,6 @@ func readHookTrailEntries(eventsPath string, since time.Time, limit int) ([]Hook
 			Timestamp: ts,
 			TimeRel:   relativeTime(ts),
 		})
-		if len(entries) >= limit {
-			break
-		}
 	}
 
 	return entries, nil
@@ -32,9 +32,8 @@ func writeTrailEventsFile(t *testing.T, path string, entries []events.Event) {
 
 func TestReadHookTrailEntriesMissingFile(t *testing.T) {
 	tmp := t.TempDir()
-	path := filepath.Join(tmp, ".events.jsonl")
 
-	got, err := readHookTrailEntries(path, time.Time{}, 20)
+	got, err := readHookTrailEntries(tmp, time.Time{}, 20)
 	if err != nil {
 		t.Fatalf("readHookTrailEntries() error = %v", err)
 	}
@@ -75,7 +74,7 @@ func TestReadHookTrailEntriesFiltersAndOrders(t *testing.T) {
 		},
 	})
 
-	got, err := readHookTrailEntries(path, time.Time{}, 10)
+	got, err := readHookTrailEntries(tmp, time.Time{}, 10)
 	if err != nil {
 		t.Fatalf("readHookTrailEntries() error = %v", err)
 	}
@@ -121,7 +120,7 @@ func TestReadHookTrailEntriesSinceAndLimit(t *testing.T) {
 	})
 
 	since := base.Add(-90 * time.Minute)
-	got, err := readHookTrailEntries(path, since, 1)
+	got, err := readHookTrailEntries(tmp, since, 1)
 	if err != nil {
 		t.Fatalf("readHookTrailEntries() error = %v", err)
 	}
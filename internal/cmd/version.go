@@ -43,16 +43,7 @@ and the git revision the binary was built from (if available).`,
 			return
 		}
 
-		commit := resolveCommitHash()
-		branch := resolveBranch()
-
-		if commit != "" && branch != "" {
-			fmt.Printf("gt version %s (%s: %s@%s)\n", Version, Build, branch, version.ShortCommit(commit))
-		} else if commit != "" {
-			fmt.Printf("gt version %s (%s: %s)\n", Version, Build, version.ShortCommit(commit))
-		} else {
-			fmt.Printf("gt version %s (%s)\n", Version, Build)
-		}
+		fmt.Println(versionLine())
 
 		if versionVerbose {
 			fmt.Printf("Timestamp: %s\n", time.Now().Format(time.RFC3339))
@@ -61,6 +52,20 @@ and the git revision the binary was built from (if available).`,
 	},
 }
 
+// versionLine returns the one-line gt version, with the branch and commit
+// the binary was built from when known.
+func versionLine() string {
+	commit := resolveCommitHash()
+	branch := resolveBranch()
+
+	if commit != "" && branch != "" {
+		return fmt.Sprintf("gt version %s (%s: %s@%s)", Version, Build, branch, version.ShortCommit(commit))
+	} else if commit != "" {
+		return fmt.Sprintf("gt version %s (%s: %s)", Version, Build, version.ShortCommit(commit))
+	}
+	return fmt.Sprintf("gt version %s (%s)", Version, Build)
+}
+
 func init() {
 	rootCmd.AddCommand(versionCmd)
 	versionCmd.Flags().BoolVarP(&versionVerbose, "verbose", "v", false, "Show extended version info including timestamp")
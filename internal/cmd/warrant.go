@@ -18,10 +18,11 @@ import (
 
 // Warrant flags
 var (
-	warrantReason  string
-	warrantListAll bool
-	warrantForce   bool
-	warrantStdin   bool // Read reason from stdin
+	warrantReason   string
+	warrantListAll  bool
+	warrantListPage listFlags
+	warrantForce    bool
+	warrantStdin    bool // Read reason from stdin
 )
 
 // Warrant represents a death warrant for an agent
@@ -108,6 +109,7 @@ func init() {
 
 	// List flags
 	warrantListCmd.Flags().BoolVarP(&warrantListAll, "all", "a", false, "Include executed warrants")
+	warrantListing.register(warrantListCmd, &warrantListPage, 0)
 
 	// Execute flags
 	warrantExecuteCmd.Flags().BoolVarP(&warrantForce, "force", "f", false, "Execute even without a warrant")
@@ -246,7 +248,12 @@ func runWarrantList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	if len(warrants) == 0 {
+	page, err := warrantListing.apply(warrants, warrantListPage)
+	if err != nil {
+		return err
+	}
+
+	if page.Total == 0 {
 		if warrantListAll {
 			fmt.Println("No warrants found")
 		} else {
@@ -258,7 +265,7 @@ func runWarrantList(cmd *cobra.Command, args []string) error {
 	fmt.Println(style.Bold.Render("Death Warrants"))
 	fmt.Println()
 
-	for _, w := range warrants {
+	for _, w := range page.Items {
 		status := "⚠️  PENDING"
 		if w.Executed {
 			status = "✓ EXECUTED"
@@ -271,10 +278,23 @@ func runWarrantList(cmd *cobra.Command, args []string) error {
 		}
 		fmt.Println()
 	}
+	printPageFooter(page)
 
 	return nil
 }
 
+// warrantListing sorts gt warrant list entries, oldest first.
+var warrantListing = listing[Warrant]{
+	fields: []listField[Warrant]{
+		{"filed", func(w Warrant) any { return w.FiledAt }},
+		{"target", func(w Warrant) any { return w.Target }},
+		{"executed", func(w Warrant) any { return w.Executed }},
+	},
+	defaultSort: "filed",
+	// One warrant file per target, so the target is unique.
+	id: func(w Warrant) string { return w.Target },
+}
+
 func runWarrantExecute(cmd *cobra.Command, args []string) error {
 	target := args[0]
 
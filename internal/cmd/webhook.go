@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/webhook"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	webhookTestState string
+	webhookTestBead  string
+)
+
+var webhookCmd = &cobra.Command{
+	Use:     "webhook",
+	GroupID: GroupServices,
+	Short:   "Post bead lifecycle transitions to external systems",
+	RunE:    requireSubcommand,
+	Long: `Send signed webhooks as beads move through their lifecycle, so dashboards
+and trackers (e.g. Jira automations) can follow agent work.
+
+States, in lifecycle order:
+  queued       gt sling scheduled the bead for deferred dispatch
+  dispatched   slung to an agent (directly or by the scheduler)
+  in_review    the polecat ran gt done; work is in the merge queue
+  merged       the refinery merged the work
+  closed       the bead was closed (by any means)
+
+The daemon delivers transitions that happen while it runs. Configure
+endpoints in settings/config.json:
+
+  "webhooks": {
+    "endpoints": [
+      {"url": "https://example.com/hooks/gastown", "states": ["merged", "closed"]},
+      {"url": "https://dash.internal/gt", "secret_env": "DASH_WEBHOOK_SECRET"}
+    ]
+  }
+
+Each delivery is a JSON POST with headers:
+  X-Gastown-Event       bead.<state>
+  X-Gastown-Delivery    unique delivery ID (repeated on retries; dedupe on it)
+  X-Gastown-Timestamp   Unix seconds
+  X-Gastown-Signature   sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">
+
+The signing secret is read from the environment variable named by
+secret_env (default GT_WEBHOOK_SECRET) in the daemon's environment; it is
+never stored in settings. Failed deliveries are retried twice with backoff.`,
+}
+
+var webhookTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Send a sample transition to each configured endpoint",
+	Long: `Send one signed sample transition to every endpoint subscribed to its
+state and report the result. Use this to check URLs and signature
+verification on the receiving side.
+
+Examples:
+  GT_WEBHOOK_SECRET=s3cret gt webhook test
+  gt webhook test --state merged --bead gt-abc`,
+	Args: cobra.NoArgs,
+	RunE: runWebhookTest,
+}
+
+func init() {
+	webhookTestCmd.Flags().StringVar(&webhookTestState, "state", webhook.StateDispatched, "Lifecycle state to send ("+strings.Join(config.WebhookStates, ", ")+")")
+	webhookTestCmd.Flags().StringVar(&webhookTestBead, "bead", "gt-test", "Bead ID to put in the sample")
+	webhookCmd.AddCommand(webhookTestCmd)
+	rootCmd.AddCommand(webhookCmd)
+}
+
+func runWebhookTest(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil {
+		return fmt.Errorf("loading town settings: %w", err)
+	}
+	if settings.Webhooks == nil || len(settings.Webhooks.Endpoints) == 0 {
+		return fmt.Errorf("no webhook endpoints configured (settings/config.json webhooks.endpoints)")
+	}
+	if !slices.Contains(config.WebhookStates, webhookTestState) {
+		return fmt.Errorf("invalid --state %q: must be one of %s", webhookTestState, strings.Join(config.WebhookStates, ", "))
+	}
+
+	t := webhook.Transition{
+		ID:        fmt.Sprintf("test-%d", time.Now().UnixNano()),
+		Bead:      webhookTestBead,
+		State:     webhookTestState,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Actor:     detectActor(),
+		Details:   map[string]interface{}{"test": true},
+	}
+	body, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, ep := range settings.Webhooks.Endpoints {
+		if !ep.Wants(t.State) {
+			fmt.Printf("%s %s %s\n", style.Dim.Render("○"), ep.URL, style.Dim.Render("(not subscribed to "+t.State+")"))
+			continue
+		}
+		secret := os.Getenv(ep.GetSecretEnv())
+		if err := webhook.Post(context.Background(), http.DefaultClient, ep, t, body, []byte(secret)); err != nil {
+			fmt.Printf("%s %s: %v\n", style.Error.Render("✗"), ep.URL, err)
+			failed++
+			continue
+		}
+		note := ""
+		if secret == "" {
+			note = style.Warning.Render(" (unsigned: " + ep.GetSecretEnv() + " not set)")
+		}
+		fmt.Printf("%s %s%s\n", style.Bold.Render("✓"), ep.URL, note)
+	}
+	if failed > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -19,8 +21,8 @@ var (
 	wlBrowseStatus   string
 	wlBrowseType     string
 	wlBrowsePriority int
-	wlBrowseLimit    int
 	wlBrowseJSON     bool
+	wlBrowsePage     listFlags
 )
 
 var wlBrowseCmd = &cobra.Command{
@@ -39,7 +41,8 @@ EXAMPLES:
   gt wl browse --type bug               # Only bugs
   gt wl browse --status claimed         # Claimed items
   gt wl browse --priority 0             # Critical priority only
-  gt wl browse --limit 5               # Show 5 items
+  gt wl browse --limit 5                # Show 5 items
+  gt wl browse --sort -created          # Newest first
   gt wl browse --json                   # JSON output`,
 }
 
@@ -48,8 +51,8 @@ func init() {
 	wlBrowseCmd.Flags().StringVar(&wlBrowseStatus, "status", "open", "Filter by status (open, claimed, in_review, completed, withdrawn)")
 	wlBrowseCmd.Flags().StringVar(&wlBrowseType, "type", "", "Filter by type (feature, bug, design, rfc, docs)")
 	wlBrowseCmd.Flags().IntVar(&wlBrowsePriority, "priority", -1, "Filter by priority (0=critical, 2=medium, 4=backlog)")
-	wlBrowseCmd.Flags().IntVar(&wlBrowseLimit, "limit", 50, "Maximum items to display")
 	wlBrowseCmd.Flags().BoolVar(&wlBrowseJSON, "json", false, "Output as JSON")
+	wlWantedListing.register(wlBrowseCmd, &wlBrowsePage, 50)
 
 	wlCmd.AddCommand(wlBrowseCmd)
 }
@@ -60,59 +63,27 @@ func runWLBrowse(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	// Fetch every match and page locally, so --sort and --cursor see the
+	// whole list.
+	query := buildBrowseQuery(BrowseFilter{
+		Status:   wlBrowseStatus,
+		Project:  wlBrowseProject,
+		Type:     wlBrowseType,
+		Priority: wlBrowsePriority,
+	})
+
 	// Fast path: query through the Dolt server if the database is registered.
 	dbName := wasteland.ResolveDBName(townRoot)
 	if doltserver.DatabaseExists(townRoot, dbName) {
-		query := buildBrowseQuery(BrowseFilter{
-			Status:   wlBrowseStatus,
-			Project:  wlBrowseProject,
-			Type:     wlBrowseType,
-			Priority: wlBrowsePriority,
-			Limit:    wlBrowseLimit,
-		})
-		serverQuery := fmt.Sprintf("USE %s; %s", dbName, query)
-
-		if wlBrowseJSON {
-			output, err := doltserver.QueryJSON(townRoot, serverQuery)
-			if err != nil {
-				return err
-			}
-			fmt.Print(output)
-			return nil
-		}
-
-		output, err := doltserver.QueryCSV(townRoot, serverQuery)
+		output, err := doltserver.QueryJSON(townRoot, fmt.Sprintf("USE %s; %s", dbName, query))
 		if err != nil {
 			return err
 		}
-		rows := wlParseCSV(output)
-		if len(rows) <= 1 {
-			fmt.Println("No wanted items found matching your filters.")
-			return nil
-		}
-
-		tbl := style.NewTable(
-			style.Column{Name: "ID", Width: 12},
-			style.Column{Name: "TITLE", Width: 40},
-			style.Column{Name: "PROJECT", Width: 12},
-			style.Column{Name: "TYPE", Width: 10},
-			style.Column{Name: "PRI", Width: 4, Align: style.AlignRight},
-			style.Column{Name: "POSTED BY", Width: 16},
-			style.Column{Name: "STATUS", Width: 10},
-			style.Column{Name: "EFFORT", Width: 8},
-		)
-
-		for _, row := range rows[1:] {
-			if len(row) < 8 {
-				continue
-			}
-			pri := wlFormatPriority(row[4])
-			tbl.AddRow(row[0], row[1], row[2], row[3], pri, row[5], row[6], row[7])
+		rows, err := wlParseJSONRows([]byte(output))
+		if err != nil {
+			return err
 		}
-
-		fmt.Printf("Wanted items (%d):\n\n", len(rows)-1)
-		fmt.Print(tbl.Render())
-		return nil
+		return renderWLBrowse(rows)
 	}
 
 	// Fallback: read from local filesystem clone.
@@ -129,23 +100,11 @@ func runWLBrowse(cmd *cobra.Command, args []string) error {
 		defer os.RemoveAll(tmpDir)
 	}
 
-	query := buildBrowseQuery(BrowseFilter{
-		Status:   wlBrowseStatus,
-		Project:  wlBrowseProject,
-		Type:     wlBrowseType,
-		Priority: wlBrowsePriority,
-		Limit:    wlBrowseLimit,
-	})
-
-	if wlBrowseJSON {
-		sqlCmd := exec.Command(doltPath, "sql", "-q", query, "-r", "json")
-		sqlCmd.Dir = cloneDir
-		sqlCmd.Stdout = os.Stdout
-		sqlCmd.Stderr = os.Stderr
-		return sqlCmd.Run()
+	rows, err := wlQueryCloneRows(doltPath, cloneDir, query)
+	if err != nil {
+		return err
 	}
-
-	return renderWLBrowseTable(doltPath, cloneDir, query)
+	return renderWLBrowse(rows)
 }
 
 // resolveWLCommonsBrowse finds the local wl-commons clone directory for browsing.
@@ -212,7 +171,7 @@ type BrowseFilter struct {
 	Project  string
 	Type     string
 	Priority int
-	Limit    int
+	Limit    int // 0 = no LIMIT clause
 }
 
 func buildBrowseQuery(f BrowseFilter) string {
@@ -231,29 +190,43 @@ func buildBrowseQuery(f BrowseFilter) string {
 		conditions = append(conditions, fmt.Sprintf("priority = %d", f.Priority))
 	}
 
-	query := "SELECT id, title, project, type, priority, posted_by, status, effort_level FROM wanted"
+	query := "SELECT id, title, project, type, priority, posted_by, status, effort_level, created_at FROM wanted"
 	if len(conditions) > 0 {
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 	query += " ORDER BY priority ASC, created_at DESC"
-	query += fmt.Sprintf(" LIMIT %d", f.Limit)
+	if f.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", f.Limit)
+	}
 
 	return query
 }
 
-func renderWLBrowseTable(doltPath, cloneDir, query string) error {
-	sqlCmd := exec.Command(doltPath, "sql", "-q", query, "-r", "csv")
-	sqlCmd.Dir = cloneDir
-	output, err := sqlCmd.Output()
+// wlWantedListing sorts gt wl browse rows, most urgent first and newest
+// first within a priority.
+var wlWantedListing = listing[map[string]interface{}]{
+	fields: []listField[map[string]interface{}]{
+		{"priority", func(r map[string]interface{}) any { return r["priority"] }},
+		{"created", func(r map[string]interface{}) any { return getString(r, "created_at") }},
+		{"project", func(r map[string]interface{}) any { return getString(r, "project") }},
+		{"type", func(r map[string]interface{}) any { return getString(r, "type") }},
+		{"status", func(r map[string]interface{}) any { return getString(r, "status") }},
+	},
+	defaultSort: "priority,-created",
+	id:          func(r map[string]interface{}) string { return getString(r, "id") },
+}
+
+func renderWLBrowse(rows []map[string]interface{}) error {
+	page, err := wlWantedListing.apply(rows, wlBrowsePage)
 	if err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			return fmt.Errorf("query failed: %s", string(exitErr.Stderr))
-		}
-		return fmt.Errorf("running query: %w", err)
+		return err
 	}
 
-	rows := wlParseCSV(string(output))
-	if len(rows) <= 1 {
+	if wlBrowseJSON {
+		return printPageJSON(page, page.Items)
+	}
+
+	if page.Total == 0 {
 		fmt.Println("No wanted items found matching your filters.")
 		return nil
 	}
@@ -269,20 +242,52 @@ func renderWLBrowseTable(doltPath, cloneDir, query string) error {
 		style.Column{Name: "EFFORT", Width: 8},
 	)
 
-	for _, row := range rows[1:] {
-		if len(row) < 8 {
-			continue
-		}
-		pri := wlFormatPriority(row[4])
-		tbl.AddRow(row[0], row[1], row[2], row[3], pri, row[5], row[6], row[7])
+	for _, row := range page.Items {
+		tbl.AddRow(getString(row, "id"), getString(row, "title"), getString(row, "project"),
+			getString(row, "type"), wlFormatPriority(getString(row, "priority")),
+			getString(row, "posted_by"), getString(row, "status"), getString(row, "effort_level"))
 	}
 
-	fmt.Printf("Wanted items (%d):\n\n", len(rows)-1)
+	fmt.Printf("Wanted items (%d):\n\n", page.Total)
 	fmt.Print(tbl.Render())
+	printPageFooter(page)
 
 	return nil
 }
 
+// wlQueryCloneRows runs query against a local wl-commons clone and returns
+// its rows.
+func wlQueryCloneRows(doltPath, cloneDir, query string) ([]map[string]interface{}, error) {
+	sqlCmd := exec.Command(doltPath, "sql", "-q", query, "-r", "json")
+	sqlCmd.Dir = cloneDir
+	output, err := sqlCmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("query failed: %s", string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("running query: %w", err)
+	}
+	return wlParseJSONRows(output)
+}
+
+// wlParseJSONRows decodes the rows of dolt's JSON result format. An empty
+// result yields an empty, non-nil slice.
+func wlParseJSONRows(output []byte) ([]map[string]interface{}, error) {
+	result := struct {
+		Rows []map[string]interface{} `json:"rows"`
+	}{Rows: []map[string]interface{}{}}
+	if len(bytes.TrimSpace(output)) == 0 {
+		return result.Rows, nil
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if result.Rows == nil {
+		result.Rows = []map[string]interface{}{}
+	}
+	return result.Rows, nil
+}
+
 func wlParseCSV(data string) [][]string {
 	var rows [][]string
 	for _, line := range strings.Split(strings.TrimSpace(data), "\n") {
@@ -138,7 +138,7 @@ func TestBuildBrowseQuery_DefaultFilters(t *testing.T) {
 		Limit:    50,
 	}
 	got := buildBrowseQuery(f)
-	want := "SELECT id, title, project, type, priority, posted_by, status, effort_level FROM wanted WHERE status = 'open' ORDER BY priority ASC, created_at DESC LIMIT 50"
+	want := "SELECT id, title, project, type, priority, posted_by, status, effort_level, created_at FROM wanted WHERE status = 'open' ORDER BY priority ASC, created_at DESC LIMIT 50"
 	if got != want {
 		t.Errorf("buildBrowseQuery(default) =\n  %q\nwant\n  %q", got, want)
 	}
@@ -193,3 +193,33 @@ func TestBuildBrowseQuery_EscapesSQL(t *testing.T) {
 	}
 }
 
+
+func TestBuildBrowseQuery_NoLimit(t *testing.T) {
+	t.Parallel()
+	f := BrowseFilter{
+		Status:   "open",
+		Priority: -1,
+	}
+	got := buildBrowseQuery(f)
+	if strings.Contains(got, "LIMIT") {
+		t.Errorf("buildBrowseQuery(Limit 0) should not have LIMIT clause: %q", got)
+	}
+}
+
+func TestWLParseJSONRows(t *testing.T) {
+	t.Parallel()
+	rows, err := wlParseJSONRows([]byte(`{"rows": [{"id": "w-1", "priority": 2}]}`))
+	if err != nil {
+		t.Fatalf("wlParseJSONRows: %v", err)
+	}
+	if len(rows) != 1 || getString(rows[0], "id") != "w-1" || getString(rows[0], "priority") != "2" {
+		t.Errorf("wlParseJSONRows = %v", rows)
+	}
+
+	for _, empty := range []string{"", "{}", `{"rows": null}`} {
+		rows, err := wlParseJSONRows([]byte(empty))
+		if err != nil || rows == nil || len(rows) != 0 {
+			t.Errorf("wlParseJSONRows(%q) = %v, %v; want empty non-nil", empty, rows, err)
+		}
+	}
+}
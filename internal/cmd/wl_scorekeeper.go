@@ -59,6 +59,14 @@ func runWlScorekeeper(cmd *cobra.Command, args []string) error {
 	return runScorekeeperWithStore(store)
 }
 
+// scorekeeperSummary is the --json output of gt wl scorekeeper.
+type scorekeeperSummary struct {
+	RigsScored   int            `json:"rigs_scored"`
+	TierDist     map[string]int `json:"tier_distribution"`
+	MaxTier      string         `json:"max_tier"`
+	ClusterNote  string         `json:"cluster_note"`
+}
+
 func runScorekeeperWithStore(store doltserver.WLCommonsStore) error {
 	if !wlScorekeeperJSON {
 		fmt.Printf("%s Running scorekeeper...\n", style.Bold.Render("⚡"))
@@ -76,12 +84,7 @@ func runScorekeeperWithStore(store doltserver.WLCommonsStore) error {
 	}
 
 	if wlScorekeeperJSON {
-		summary := struct {
-			RigsScored   int            `json:"rigs_scored"`
-			TierDist     map[string]int `json:"tier_distribution"`
-			MaxTier      string         `json:"max_tier"`
-			ClusterNote  string         `json:"cluster_note"`
-		}{
+		summary := scorekeeperSummary{
 			RigsScored:  len(entries),
 			TierDist:    tierDist,
 			MaxTier:     highestTier(tierDist),
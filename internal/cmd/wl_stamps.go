@@ -23,8 +23,8 @@ var (
 	wlStampsStampType   string
 	wlStampsCohort      string
 	wlStampsSeverity    string
-	wlStampsLimit       int
 	wlStampsJSON        bool
+	wlStampsPage        listFlags
 )
 
 var wlStampsCmd = &cobra.Command{
@@ -55,8 +55,8 @@ func init() {
 	wlStampsCmd.Flags().StringVar(&wlStampsStampType, "stamp-type", "", "Filter by stamp_type (work, mentoring, peer_review, endorsement, boot_block)")
 	wlStampsCmd.Flags().StringVar(&wlStampsCohort, "cohort", "", "Filter by pilot_cohort (andela-pilot, commbank-pilot, indie)")
 	wlStampsCmd.Flags().StringVar(&wlStampsSeverity, "severity", "", "Filter by severity (leaf, branch, root)")
-	wlStampsCmd.Flags().IntVar(&wlStampsLimit, "limit", 50, "Maximum stamps to display")
 	wlStampsCmd.Flags().BoolVar(&wlStampsJSON, "json", false, "Output as JSON")
+	wlStampListing.register(wlStampsCmd, &wlStampsPage, 50)
 
 	wlCmd.AddCommand(wlStampsCmd)
 }
@@ -70,7 +70,7 @@ type StampsFilter struct {
 	StampType   string
 	PilotCohort string
 	Severity    string
-	Limit       int
+	Limit       int // 0 = no LIMIT clause
 }
 
 func buildStampsQuery(f StampsFilter) string {
@@ -103,7 +103,9 @@ func buildStampsQuery(f StampsFilter) string {
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 	query += " ORDER BY created_at DESC"
-	query += fmt.Sprintf(" LIMIT %d", f.Limit)
+	if f.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", f.Limit)
+	}
 
 	return query
 }
@@ -116,74 +118,31 @@ func runWLStamps(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	// Fetch every match and page locally, so --sort and --cursor see the
+	// whole list.
+	query := buildStampsQuery(StampsFilter{
+		Subject:     wlStampsRig,
+		Author:      wlStampsAuthor,
+		Skill:       wlStampsSkill,
+		ContextType: wlStampsContextType,
+		StampType:   wlStampsStampType,
+		PilotCohort: wlStampsCohort,
+		Severity:    wlStampsSeverity,
+	})
+
 	// Fast path: query through the Dolt server if the database is registered.
+	// JSON output gives richer parsing (valence, skill_tags are JSON).
 	dbName := wasteland.ResolveDBName(townRoot)
 	if doltserver.DatabaseExists(townRoot, dbName) {
-		query := buildStampsQuery(StampsFilter{
-			Subject:     wlStampsRig,
-			Author:      wlStampsAuthor,
-			Skill:       wlStampsSkill,
-			ContextType: wlStampsContextType,
-			StampType:   wlStampsStampType,
-			PilotCohort: wlStampsCohort,
-			Severity:    wlStampsSeverity,
-			Limit:       wlStampsLimit,
-		})
-		serverQuery := fmt.Sprintf("USE %s; %s", dbName, query)
-
-		if wlStampsJSON {
-			output, err := doltserver.QueryJSON(townRoot, serverQuery)
-			if err != nil {
-				return err
-			}
-			fmt.Print(output)
-			return nil
-		}
-
-		// Use JSON output for richer parsing (valence, skill_tags are JSON).
-		output, err := doltserver.QueryJSON(townRoot, serverQuery)
+		output, err := doltserver.QueryJSON(townRoot, fmt.Sprintf("USE %s; %s", dbName, query))
 		if err != nil {
 			return err
 		}
-
-		var result struct {
-			Rows []map[string]interface{} `json:"rows"`
-		}
-		if err := json.Unmarshal([]byte(output), &result); err != nil {
-			return fmt.Errorf("parsing response: %w", err)
-		}
-
-		if len(result.Rows) == 0 {
-			fmt.Printf("No stamps found for rig %q.\n", wlStampsRig)
-			return nil
-		}
-
-		tbl := style.NewTable(
-			style.Column{Name: "ID", Width: 16},
-			style.Column{Name: "AUTHOR", Width: 20},
-			style.Column{Name: "VALENCE", Width: 28},
-			style.Column{Name: "CONF", Width: 5, Align: style.AlignRight},
-			style.Column{Name: "SEVERITY", Width: 8},
-			style.Column{Name: "TYPE", Width: 14},
-			style.Column{Name: "SKILLS", Width: 18},
-			style.Column{Name: "DATE", Width: 10},
-		)
-
-		for _, row := range result.Rows {
-			id := getString(row, "id")
-			author := getString(row, "author")
-			valence := formatValence(row["valence"])
-			conf := getString(row, "confidence")
-			severity := getString(row, "severity")
-			ctxType := getString(row, "context_type")
-			skills := formatSkillTags(row["skill_tags"])
-			date := formatStampDate(getString(row, "created_at"))
-			tbl.AddRow(id, author, valence, conf, severity, ctxType, skills, date)
+		rows, err := wlParseJSONRows([]byte(output))
+		if err != nil {
+			return err
 		}
-
-		fmt.Printf("Stamps for %s (%d):\n\n", style.Bold.Render(wlStampsRig), len(result.Rows))
-		fmt.Print(tbl.Render())
-		return nil
+		return renderStamps(rows)
 	}
 
 	// Fallback: read from local filesystem clone.
@@ -241,48 +200,36 @@ func runWLStamps(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	query := buildStampsQuery(StampsFilter{
-		Subject:     wlStampsRig,
-		Author:      wlStampsAuthor,
-		Skill:       wlStampsSkill,
-		ContextType: wlStampsContextType,
-		StampType:   wlStampsStampType,
-		PilotCohort: wlStampsCohort,
-		Severity:    wlStampsSeverity,
-		Limit:       wlStampsLimit,
-	})
-
-	if wlStampsJSON {
-		sqlCmd := exec.Command(doltPath, "sql", "-q", query, "-r", "json")
-		sqlCmd.Dir = cloneDir
-		sqlCmd.Stdout = os.Stdout
-		sqlCmd.Stderr = os.Stderr
-		return sqlCmd.Run()
+	rows, err := wlQueryCloneRows(doltPath, cloneDir, query)
+	if err != nil {
+		return err
 	}
+	return renderStamps(rows)
+}
 
-	return renderStampsTable(doltPath, cloneDir, query)
+// wlStampListing sorts gt wl stamps rows, newest first.
+var wlStampListing = listing[map[string]interface{}]{
+	fields: []listField[map[string]interface{}]{
+		{"created", func(r map[string]interface{}) any { return getString(r, "created_at") }},
+		{"author", func(r map[string]interface{}) any { return getString(r, "author") }},
+		{"severity", func(r map[string]interface{}) any { return getString(r, "severity") }},
+		{"type", func(r map[string]interface{}) any { return getString(r, "context_type") }},
+	},
+	defaultSort: "-created",
+	id:          func(r map[string]interface{}) string { return getString(r, "id") },
 }
 
-func renderStampsTable(doltPath, cloneDir, query string) error {
-	// Use JSON output for richer parsing of nested fields (valence, skill_tags)
-	sqlCmd := exec.Command(doltPath, "sql", "-q", query, "-r", "json")
-	sqlCmd.Dir = cloneDir
-	output, err := sqlCmd.Output()
+func renderStamps(rows []map[string]interface{}) error {
+	page, err := wlStampListing.apply(rows, wlStampsPage)
 	if err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			return fmt.Errorf("query failed: %s", string(exitErr.Stderr))
-		}
-		return fmt.Errorf("running query: %w", err)
+		return err
 	}
 
-	var result struct {
-		Rows []map[string]interface{} `json:"rows"`
-	}
-	if err := json.Unmarshal(output, &result); err != nil {
-		return fmt.Errorf("parsing response: %w", err)
+	if wlStampsJSON {
+		return printPageJSON(page, page.Items)
 	}
 
-	if len(result.Rows) == 0 {
+	if page.Total == 0 {
 		fmt.Printf("No stamps found for rig %q.\n", wlStampsRig)
 		return nil
 	}
@@ -298,7 +245,7 @@ func renderStampsTable(doltPath, cloneDir, query string) error {
 		style.Column{Name: "DATE", Width: 10},
 	)
 
-	for _, row := range result.Rows {
+	for _, row := range page.Items {
 		id := getString(row, "id")
 		author := getString(row, "author")
 		valence := formatValence(row["valence"])
@@ -311,8 +258,9 @@ func renderStampsTable(doltPath, cloneDir, query string) error {
 		tbl.AddRow(id, author, valence, conf, severity, ctxType, skills, date)
 	}
 
-	fmt.Printf("Stamps for %s (%d):\n\n", style.Bold.Render(wlStampsRig), len(result.Rows))
+	fmt.Printf("Stamps for %s (%d):\n\n", style.Bold.Render(wlStampsRig), page.Total)
 	fmt.Print(tbl.Render())
+	printPageFooter(page)
 
 	return nil
 }
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	layoutRebuild  bool
+	layoutNoAttach bool
+	layoutList     bool
+	layoutDryRun   bool
+)
+
+var workspaceCmd = &cobra.Command{
+	Use:     "workspace",
+	GroupID: GroupWorkspace,
+	Short:   "Operator workspace tools",
+	RunE:    requireSubcommand,
+}
+
+var workspaceLayoutCmd = &cobra.Command{
+	Use:   "layout [name]",
+	Short: "Open a tmux dashboard of daemon logs, queue, limits, and the latest polecat",
+	Long: `Build a tmux session laid out as an operator dashboard and attach to it.
+
+The built-in mission-control layout has four panes: daemon logs, the
+scheduler queue, account limits, and a live peek at the most recently
+active polecat. Define your own layouts in settings/layouts.json:
+
+  {
+    "version": 1,
+    "default": "review",
+    "layouts": {
+      "review": {
+        "tmux_layout": "main-vertical",
+        "panes": [
+          {"title": "feed", "command": "gt feed --plain --follow"},
+          {"title": "mq", "command": "gt mq list", "refresh": "15s"},
+          {"title": "polecat", "command": "gt peek {{recent_polecat}} 60", "refresh": "5s"}
+        ]
+      }
+    }
+  }
+
+Pane commands run in the town root. {{town}} expands to the town root and
+{{recent_polecat}} to the most recently active polecat (resolved when the
+layout is built). Panes with "refresh" rerun their command on that interval.
+
+Each layout gets its own tmux session (layout-<name>); running the command
+again reattaches to it. Use --rebuild to recreate it after editing the file
+or to pick up a newer polecat.
+
+Examples:
+  gt workspace layout                  # Default layout
+  gt workspace layout review --rebuild
+  gt workspace layout --list
+  gt workspace layout --dry-run        # Show the panes without creating them`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkspaceLayout,
+}
+
+func init() {
+	workspaceLayoutCmd.Flags().BoolVar(&layoutRebuild, "rebuild", false, "Recreate the layout session if it exists")
+	workspaceLayoutCmd.Flags().BoolVar(&layoutNoAttach, "no-attach", false, "Create the session without attaching")
+	workspaceLayoutCmd.Flags().BoolVar(&layoutList, "list", false, "List available layouts")
+	workspaceLayoutCmd.Flags().BoolVarP(&layoutDryRun, "dry-run", "n", false, "Print the panes without creating the session")
+
+	workspaceCmd.AddCommand(workspaceLayoutCmd)
+	rootCmd.AddCommand(workspaceCmd)
+}
+
+func runWorkspaceLayout(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return err
+	}
+	cfg, err := config.LoadLayoutsConfig(config.LayoutsPath(townRoot))
+	if err != nil {
+		return err
+	}
+
+	if layoutList {
+		names := make([]string, 0, len(cfg.Layouts))
+		for name := range cfg.Layouts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			marker := " "
+			if name == cfg.Default {
+				marker = "*"
+			}
+			fmt.Printf("%s %s %s\n", marker, style.Bold.Render(name), style.Dim.Render(fmt.Sprintf("(%d panes)", len(cfg.Layouts[name].Panes))))
+		}
+		return nil
+	}
+
+	name := cfg.Default
+	if len(args) > 0 {
+		name = args[0]
+	}
+	layout, ok := cfg.Layouts[name]
+	if !ok {
+		return fmt.Errorf("unknown layout %q (see gt workspace layout --list)", name)
+	}
+
+	t := tmux.NewTmux()
+	sessionName := layoutSessionName(name)
+	vars := map[string]string{
+		"town":           townRoot,
+		"recent_polecat": recentPolecatAddress(t),
+	}
+	commands := make([]string, len(layout.Panes))
+	for i, pane := range layout.Panes {
+		commands[i] = layoutPaneCommand(pane, vars)
+	}
+
+	if layoutDryRun {
+		fmt.Printf("%s %s → session %s (%s)\n", style.Bold.Render("Layout:"), name, sessionName, layoutTmuxLayout(layout))
+		for i, pane := range layout.Panes {
+			fmt.Printf("  %d. %-10s %s\n", i+1, pane.Title, commands[i])
+		}
+		return nil
+	}
+
+	exists, err := t.HasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking for session %s: %w", sessionName, err)
+	}
+	if exists && layoutRebuild {
+		if err := t.KillSession(sessionName); err != nil {
+			return fmt.Errorf("killing session %s: %w", sessionName, err)
+		}
+		exists = false
+	}
+	if !exists {
+		if err := buildLayoutSession(t, sessionName, name, townRoot, layout, commands); err != nil {
+			_ = t.KillSession(sessionName)
+			return err
+		}
+		fmt.Printf("%s Built layout %s (%d panes) in session %s\n", style.SuccessPrefix, name, len(commands), sessionName)
+	}
+
+	if layoutNoAttach {
+		if exists {
+			fmt.Printf("Session %s already exists (use --rebuild to recreate it)\n", sessionName)
+		}
+		return nil
+	}
+	return attachToTmuxSession(sessionName)
+}
+
+// buildLayoutSession creates the layout's session with one pane per command.
+func buildLayoutSession(t *tmux.Tmux, sessionName, name, townRoot string, layout *config.LayoutConfig, commands []string) error {
+	if err := t.NewSession(sessionName, townRoot); err != nil {
+		return fmt.Errorf("creating session %s: %w", sessionName, err)
+	}
+	// Keep panes whose command exits so the operator can read its output.
+	_ = t.SetRemainOnExit(sessionName, true)
+	first, err := t.GetPaneID(sessionName)
+	if err != nil {
+		return err
+	}
+	if err := t.RespawnPaneWithWorkDir(first, townRoot, commands[0]); err != nil {
+		return fmt.Errorf("starting pane 1: %w", err)
+	}
+	_ = t.RenameWindow(first, name)
+	_ = t.ShowPaneTitles(first)
+
+	panes := []string{first}
+	for i, command := range commands[1:] {
+		pane, err := t.SplitWindow(first, townRoot, command)
+		if err != nil {
+			return fmt.Errorf("starting pane %d: %w", i+2, err)
+		}
+		panes = append(panes, pane)
+		// Re-tile after each split so the window never runs out of room.
+		_ = t.SelectLayout(first, "tiled")
+	}
+	for i, pane := range panes {
+		if title := layout.Panes[i].Title; title != "" {
+			_ = t.SetPaneTitle(pane, title)
+		}
+	}
+	return t.SelectLayout(first, layoutTmuxLayout(layout))
+}
+
+// layoutSessionName returns the tmux session name for a layout. It avoids
+// rig prefixes so the session is never mistaken for an agent.
+func layoutSessionName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('-')
+		}
+	}
+	return "layout-" + b.String()
+}
+
+func layoutTmuxLayout(layout *config.LayoutConfig) string {
+	if layout.TmuxLayout != "" {
+		return layout.TmuxLayout
+	}
+	return "tiled"
+}
+
+// layoutPaneCommand expands a pane's placeholders and, for refreshing panes,
+// wraps the command in a redraw loop.
+func layoutPaneCommand(pane config.LayoutPane, vars map[string]string) string {
+	command := pane.Command
+	for k, v := range vars {
+		command = strings.ReplaceAll(command, "{{"+k+"}}", v)
+	}
+	if pane.Refresh == "" {
+		return command
+	}
+	d, err := time.ParseDuration(pane.Refresh)
+	if err != nil || d <= 0 {
+		return command
+	}
+	secs := int(d.Round(time.Second) / time.Second)
+	if secs < 1 {
+		secs = 1
+	}
+	return fmt.Sprintf("while true; do clear; %s; sleep %d; done", command, secs)
+}
+
+// recentPolecatAddress returns the rig/name address of the polecat session
+// with the most recent activity, or a placeholder when none is running.
+func recentPolecatAddress(t *tmux.Tmux) string {
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return "no-polecat-running"
+	}
+	var best string
+	var bestAt time.Time
+	for _, s := range sessions {
+		id, err := session.ParseSessionName(s)
+		if err != nil || id.Role != session.RolePolecat {
+			continue
+		}
+		at, err := t.GetSessionActivity(s)
+		if err != nil {
+			continue
+		}
+		if best == "" || at.After(bestAt) {
+			best, bestAt = id.Rig+"/"+id.Name, at
+		}
+	}
+	if best == "" {
+		fmt.Fprintln(os.Stderr, "Note: no polecat sessions running; {{recent_polecat}} panes will show an error until --rebuild")
+		return "no-polecat-running"
+	}
+	return best
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestLayoutSessionName(t *testing.T) {
+	tests := map[string]string{
+		"mission-control": "layout-mission-control",
+		"my layout":       "layout-my-layout",
+		"a.b:c":           "layout-a-b-c",
+	}
+	for in, want := range tests {
+		if got := layoutSessionName(in); got != want {
+			t.Errorf("layoutSessionName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestLayoutPaneCommand(t *testing.T) {
+	vars := map[string]string{"town": "/town", "recent_polecat": "gastown/nux"}
+	tests := []struct {
+		name string
+		pane config.LayoutPane
+		want string
+	}{
+		{"plain", config.LayoutPane{Command: "gt daemon logs -f"}, "gt daemon logs -f"},
+		{"placeholders", config.LayoutPane{Command: "gt peek {{recent_polecat}} 40 # {{town}}"}, "gt peek gastown/nux 40 # /town"},
+		{"refresh", config.LayoutPane{Command: "gt quota status", Refresh: "30s"}, "while true; do clear; gt quota status; sleep 30; done"},
+		{"sub-second refresh rounds up", config.LayoutPane{Command: "date", Refresh: "200ms"}, "while true; do clear; date; sleep 1; done"},
+		{"bad refresh runs once", config.LayoutPane{Command: "date", Refresh: "soon"}, "date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := layoutPaneCommand(tt.pane, vars); got != tt.want {
+				t.Errorf("layoutPaneCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
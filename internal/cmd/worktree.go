@@ -13,6 +13,7 @@ import (
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
+	"github.com/steveyegge/gastown/internal/worktree"
 )
 
 // Worktree command flags
@@ -154,7 +155,7 @@ func runWorktree(cmd *cobra.Command, args []string) error {
 	// Create the worktree on main branch
 	// Use WorktreeAddExistingForce because main may already be checked out
 	// in other worktrees (e.g., mayor/rig). This is safe for cross-rig work.
-	if err := g.WorktreeAddExistingForce(worktreePath, "main"); err != nil {
+	if err := worktree.NewManager(g).AddExistingForce(worktreePath, "main"); err != nil {
 		return fmt.Errorf("creating worktree: %w", err)
 	}
 
@@ -338,7 +339,7 @@ func runWorktreeRemove(cmd *cobra.Command, args []string) error {
 	g := git.NewGit(targetMayorRig)
 
 	// Remove the worktree
-	if err := g.WorktreeRemove(worktreePath, worktreeRemoveForce); err != nil {
+	if err := worktree.NewManager(g).Remove(worktreePath, worktreeRemoveForce); err != nil {
 		return fmt.Errorf("removing worktree: %w", err)
 	}
 
@@ -0,0 +1,301 @@
+// Package cmdpolicy evaluates the town's command policy: allow/deny patterns
+// for the shell commands agents run through their Bash tool.
+//
+// The policy lives in settings/command-policy.json. Town-wide rules apply to
+// every agent; rules under "rigs" apply only to that rig's agents and take
+// precedence. It is enforced by the command-policy PreToolUse guard
+// (gt tap guard command-policy), so operators can restrict what queued agents
+// execute without editing formulas or per-role hooks.
+package cmdpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// FileName is the policy file name in the town settings directory.
+const FileName = "command-policy.json"
+
+// Actions a rule set's default can take.
+const (
+	ActionAllow = "allow"
+	ActionDeny  = "deny"
+)
+
+// Policy is the parsed command policy file.
+type Policy struct {
+	Version int `json:"version"`
+	RuleSet
+	// Rigs holds per-rig rules, keyed by rig name.
+	Rigs map[string]RuleSet `json:"rigs,omitempty"`
+}
+
+// RuleSet is one level (town or rig) of allow and deny rules.
+type RuleSet struct {
+	// Default is the action for commands no rule matches: "allow" or "deny".
+	// Empty defers to the town default, and at town level means allow.
+	Default string `json:"default,omitempty"`
+	Allow   []Rule `json:"allow,omitempty"`
+	Deny    []Rule `json:"deny,omitempty"`
+}
+
+// Rule is a command pattern. In Pattern, * matches any run of characters
+// (including spaces and slashes) and ? matches one; the pattern must match
+// the whole command, so "git push*" matches "git push origin main" but
+// "push" alone matches nothing.
+type Rule struct {
+	Pattern string `json:"pattern"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Decision is the result of evaluating a command.
+type Decision struct {
+	Allowed bool   `json:"allowed"`
+	Command string `json:"command,omitempty"` // The simple command that decided it
+	Rule    string `json:"rule,omitempty"`    // Matched pattern; empty when the default applied
+	Scope   string `json:"scope,omitempty"`   // "town", "rig:<name>", or "default"
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Path returns the policy file path for a town.
+func Path(townRoot string) string {
+	return filepath.Join(townRoot, "settings", FileName)
+}
+
+// Load reads and validates the town's command policy. It returns nil, nil
+// when no policy file exists.
+func Load(townRoot string) (*Policy, error) {
+	data, err := os.ReadFile(Path(townRoot)) //nolint:gosec // G304: path is within the town root
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var p Policy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", FileName, err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", FileName, err)
+	}
+	return &p, nil
+}
+
+// Validate checks defaults and patterns.
+func (p *Policy) Validate() error {
+	if err := p.RuleSet.validate("town"); err != nil {
+		return err
+	}
+	for rig, rs := range p.Rigs {
+		if err := rs.validate("rig " + rig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rs RuleSet) validate(scope string) error {
+	switch rs.Default {
+	case "", ActionAllow, ActionDeny:
+	default:
+		return fmt.Errorf("%s: invalid default %q (want %q or %q)", scope, rs.Default, ActionAllow, ActionDeny)
+	}
+	for _, r := range append(append([]Rule(nil), rs.Allow...), rs.Deny...) {
+		if strings.TrimSpace(r.Pattern) == "" {
+			return fmt.Errorf("%s: rule with empty pattern", scope)
+		}
+	}
+	return nil
+}
+
+// Evaluate decides whether an agent in rig (empty for town-level agents)
+// may run command. Every simple command in it must be allowed; the first
+// denied one decides. For each simple command the most specific level wins:
+// rig deny, rig allow, town deny, town allow, then the default.
+func (p *Policy) Evaluate(rig, command string) Decision {
+	segments := SplitCommands(command)
+	if len(segments) == 0 {
+		return Decision{Allowed: true, Scope: "default"}
+	}
+	var last Decision
+	for _, seg := range segments {
+		last = p.evaluateOne(rig, seg)
+		if !last.Allowed {
+			return last
+		}
+	}
+	return last
+}
+
+func (p *Policy) evaluateOne(rig, command string) Decision {
+	type level struct {
+		scope string
+		rs    RuleSet
+	}
+	var levels []level
+	if rs, ok := p.Rigs[rig]; ok && rig != "" {
+		levels = append(levels, level{"rig:" + rig, rs})
+	}
+	levels = append(levels, level{"town", p.RuleSet})
+
+	for _, l := range levels {
+		if r, ok := firstMatch(l.rs.Deny, command); ok {
+			return Decision{Allowed: false, Command: command, Rule: r.Pattern, Scope: l.scope, Reason: r.Reason}
+		}
+		if r, ok := firstMatch(l.rs.Allow, command); ok {
+			return Decision{Allowed: true, Command: command, Rule: r.Pattern, Scope: l.scope, Reason: r.Reason}
+		}
+	}
+
+	def := p.Default
+	if rs, ok := p.Rigs[rig]; ok && rig != "" && rs.Default != "" {
+		def = rs.Default
+	}
+	if def == ActionDeny {
+		return Decision{Allowed: false, Command: command, Scope: "default", Reason: "not on the allow list"}
+	}
+	return Decision{Allowed: true, Command: command, Scope: "default"}
+}
+
+func firstMatch(rules []Rule, command string) (Rule, bool) {
+	for _, r := range rules {
+		if MatchPattern(r.Pattern, command) {
+			return r, true
+		}
+	}
+	return Rule{}, false
+}
+
+// MatchPattern reports whether a rule pattern matches a whole simple command.
+// Whitespace in both is normalized to single spaces.
+func MatchPattern(pattern, command string) bool {
+	pattern = strings.Join(strings.Fields(pattern), " ")
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return false
+	}
+	return re.MatchString(command)
+}
+
+// fdRedirects neutralizes the & in 2>&1 and &>file so it isn't taken for a
+// background separator.
+var fdRedirects = strings.NewReplacer(">&", ">", "&>", ">")
+
+// SplitCommands splits a shell line into its simple commands, each with
+// whitespace normalized and leading VAR=value assignments removed. Commands
+// are separated by ;, &, |, &&, ||, newlines, and subshell parentheses
+// outside quotes. Command substitutions ($(...) and backticks) run too, so
+// they are returned as commands of their own, even inside double quotes,
+// and stand in the enclosing command as "$(...)". This is a tokenizer, not
+// a shell parser, but quoted arguments like commit messages stay intact.
+func SplitCommands(command string) []string {
+	var out []string
+	flush := func(b *strings.Builder) {
+		fields := strings.Fields(b.String())
+		b.Reset()
+		for len(fields) > 0 && isAssignment(fields[0]) {
+			fields = fields[1:]
+		}
+		if len(fields) > 0 {
+			out = append(out, strings.Join(fields, " "))
+		}
+	}
+
+	// Each open substitution suspends the enclosing command and its quote
+	// state until the substitution closes.
+	type frame struct {
+		cmd      *strings.Builder
+		quote    byte
+		backtick bool
+	}
+	var stack []frame
+	cur := &strings.Builder{}
+	var quote byte // ' or " while inside quotes
+	backtick := false
+
+	open := func(isBacktick bool) {
+		stack = append(stack, frame{cur, quote, backtick})
+		cur, quote, backtick = &strings.Builder{}, 0, isBacktick
+	}
+	closeSub := func() {
+		flush(cur)
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		cur, quote, backtick = top.cmd, top.quote, top.backtick
+		cur.WriteString("$(...)")
+	}
+
+	s := fdRedirects.Replace(command)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			}
+			cur.WriteByte(c)
+		case c == '\\' && i+1 < len(s):
+			cur.WriteByte(c)
+			cur.WriteByte(s[i+1])
+			i++
+		case c == '$' && i+1 < len(s) && s[i+1] == '(':
+			open(false)
+			i++
+		case c == '`' && backtick:
+			closeSub()
+		case c == '`':
+			open(true)
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			}
+			cur.WriteByte(c)
+		case c == '\'' || c == '"':
+			quote = c
+			cur.WriteByte(c)
+		case c == ')' && len(stack) > 0 && !backtick:
+			closeSub()
+		case c == ';' || c == '&' || c == '|' || c == '\n' || c == '(' || c == ')':
+			flush(cur)
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	for len(stack) > 0 { // Unterminated substitutions
+		closeSub()
+	}
+	flush(cur)
+	return out
+}
+
+// isAssignment reports whether a shell word is a VAR=value prefix.
+func isAssignment(word string) bool {
+	name, _, ok := strings.Cut(word, "=")
+	if !ok || name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !(r >= 'A' && r <= 'Z') && !(r >= 'a' && r <= 'z') && (i == 0 || !(r >= '0' && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
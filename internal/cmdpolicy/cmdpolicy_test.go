@@ -0,0 +1,122 @@
+package cmdpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitCommands(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"go test ./...", []string{"go test ./..."}},
+		{"cd /x && make  build || echo failed", []string{"cd /x", "make build", "echo failed"}},
+		{"cat a | grep b; ls", []string{"cat a", "grep b", "ls"}},
+		{"echo $(curl -s x) `whoami`", []string{"curl -s x", "whoami", "echo $(...) $(...)"}},
+		{"GOFLAGS=-mod=mod CGO_ENABLED=0 go build", []string{"go build"}},
+		{"go test ./... 2>&1 | tail", []string{"go test ./... 2>1", "tail"}},
+		{"sleep 5 & curl x", []string{"sleep 5", "curl x"}},
+		{`git commit -m "fix; cleanup | docs"`, []string{`git commit -m "fix; cleanup | docs"`}},
+		{`echo 'a && b' && ls`, []string{`echo 'a && b'`, "ls"}},
+		{`echo "today is $(date +%F)"`, []string{"date +%F", `echo "today is $(...)"`}},
+		{"x=$(git rev-parse HEAD); echo $x", []string{"git rev-parse HEAD", "echo $x"}},
+		{"(cd sub && make)", []string{"cd sub", "make"}},
+		{"   ", nil},
+	}
+	for _, tt := range tests {
+		if got := SplitCommands(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitCommands(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern, command string
+		want             bool
+	}{
+		{"git push*", "git push origin main", true},
+		{"git push*", "git pushd", true},
+		{"git push *", "git status", false},
+		{"push", "git push", false},
+		{"curl *", "curl -s https://example.com/a?b", true},
+		{"rm -rf  *", "rm -rf build/", true},
+		{"go test ?", "go test x", true},
+		{"npm publish", "npm publish --tag next", false},
+	}
+	for _, tt := range tests {
+		if got := MatchPattern(tt.pattern, tt.command); got != tt.want {
+			t.Errorf("MatchPattern(%q, %q) = %v, want %v", tt.pattern, tt.command, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	p := &Policy{
+		Version: 1,
+		RuleSet: RuleSet{
+			Deny: []Rule{{Pattern: "curl *", Reason: "no network"}, {Pattern: "npm publish*"}},
+		},
+		Rigs: map[string]RuleSet{
+			"webapp": {Allow: []Rule{{Pattern: "curl localhost*"}}},
+			"locked": {Default: ActionDeny, Allow: []Rule{{Pattern: "go *"}, {Pattern: "git *"}}},
+			"nopush": {Deny: []Rule{{Pattern: "git push*", Reason: "refinery merges"}}},
+		},
+	}
+
+	tests := []struct {
+		rig, command string
+		allowed      bool
+		scope        string
+	}{
+		{"", "go build ./...", true, "default"},
+		{"", "curl https://x", false, "town"},
+		{"gastown", "echo hi && curl https://x", false, "town"},
+		{"webapp", "curl localhost:8080/health", true, "rig:webapp"},
+		{"webapp", "curl https://x", false, "town"},
+		{"locked", "go test ./...", true, "rig:locked"},
+		{"locked", "python3 x.py", false, "default"},
+		{"locked", "go test ./... | tee out.txt", false, "default"},
+		{"locked", "npm publish", false, "town"},
+		{"nopush", "git push origin HEAD", false, "rig:nopush"},
+		{"nopush", "git status", true, "default"},
+	}
+	for _, tt := range tests {
+		d := p.Evaluate(tt.rig, tt.command)
+		if d.Allowed != tt.allowed || d.Scope != tt.scope {
+			t.Errorf("Evaluate(%q, %q) = %+v, want allowed=%v scope=%s", tt.rig, tt.command, d, tt.allowed, tt.scope)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	townRoot := t.TempDir()
+	if p, err := Load(townRoot); p != nil || err != nil {
+		t.Fatalf("Load without a file = %v, %v; want nil, nil", p, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(Path(townRoot)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	write := func(s string) {
+		t.Helper()
+		if err := os.WriteFile(Path(townRoot), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(`{"version":1,"deny":[{"pattern":"curl *"}],"rigs":{"gastown":{"default":"deny"}}}`)
+	p, err := Load(townRoot)
+	if err != nil || p == nil || len(p.Deny) != 1 || p.Rigs["gastown"].Default != ActionDeny {
+		t.Fatalf("Load = %+v, %v", p, err)
+	}
+
+	write(`{"version":1,"rigs":{"gastown":{"default":"maybe"}}}`)
+	if _, err := Load(townRoot); err == nil || !strings.Contains(err.Error(), "invalid default") {
+		t.Errorf("Load with a bad default = %v, want validation error", err)
+	}
+}
@@ -667,3 +667,34 @@ func ClaudeConfigDir() (string, error) {
 	}
 	return filepath.Join(home, ".claude"), nil
 }
+
+// ClaudeLoggedIn reports whether Claude Code has completed a login under
+// configDir. A login leaves OAuth credentials in .credentials.json (or the
+// macOS Keychain) and the account identity in .claude.json's oauthAccount;
+// either is taken as logged in.
+func ClaudeLoggedIn(configDir string) bool {
+	if _, err := os.Stat(filepath.Join(configDir, ".credentials.json")); err == nil {
+		return true
+	}
+	data, err := os.ReadFile(filepath.Join(configDir, ".claude.json")) //nolint:gosec // G304: path is a configured account dir
+	if err != nil {
+		return false
+	}
+	var doc struct {
+		OAuthAccount json.RawMessage `json:"oauthAccount"`
+	}
+	if json.Unmarshal(data, &doc) != nil {
+		return false
+	}
+	return len(doc.OAuthAccount) > 0 && string(doc.OAuthAccount) != "null"
+}
+
+// ClaudeProjectDir returns where Claude Code keeps the transcripts of
+// sessions started in workDir under configDir: <configDir>/projects/<name>,
+// where name is workDir with path separators and underscores replaced by
+// hyphens (the leading slash becomes a leading hyphen).
+func ClaudeProjectDir(configDir, workDir string) string {
+	projectName := strings.ReplaceAll(workDir, "/", "-")
+	projectName = strings.ReplaceAll(projectName, "_", "-")
+	return filepath.Join(configDir, "projects", projectName)
+}
@@ -1332,3 +1332,41 @@ func TestAgentEnv_EffortLevel(t *testing.T) {
 		}
 	})
 }
+
+func TestClaudeProjectDir(t *testing.T) {
+	got := ClaudeProjectDir("/cfg", "/home/me/gt/my_rig/polecats/nux")
+	want := filepath.Join("/cfg", "projects", "-home-me-gt-my-rig-polecats-nux")
+	if got != want {
+		t.Errorf("ClaudeProjectDir() = %q, want %q", got, want)
+	}
+}
+
+func TestClaudeLoggedIn(t *testing.T) {
+	dir := t.TempDir()
+	if ClaudeLoggedIn(dir) {
+		t.Error("empty dir reported logged in")
+	}
+
+	claudeJSON := filepath.Join(dir, ".claude.json")
+	if err := os.WriteFile(claudeJSON, []byte(`{"oauthAccount":null,"numStartups":1}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if ClaudeLoggedIn(dir) {
+		t.Error("null oauthAccount reported logged in")
+	}
+
+	if err := os.WriteFile(claudeJSON, []byte(`{"oauthAccount":{"emailAddress":"me@example.com"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !ClaudeLoggedIn(dir) {
+		t.Error("oauthAccount not recognized as logged in")
+	}
+
+	credDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(credDir, ".credentials.json"), []byte(`{}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if !ClaudeLoggedIn(credDir) {
+		t.Error(".credentials.json not recognized as logged in")
+	}
+}
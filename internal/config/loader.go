@@ -852,6 +852,9 @@ func validateAccountsConfig(c *AccountsConfig) error {
 		if acct.ConfigDir == "" {
 			return fmt.Errorf("%w: config_dir for account '%s'", ErrMissingField, handle)
 		}
+		if acct.Priority < 0 {
+			return fmt.Errorf("account '%s': priority must be >= 0, got %d", handle, acct.Priority)
+		}
 	}
 	return nil
 }
@@ -872,6 +875,23 @@ func (c *AccountsConfig) GetAccount(handle string) *Account {
 	return nil
 }
 
+// Handles returns the registered account handles ordered by priority, then
+// handle.
+func (c *AccountsConfig) Handles() []string {
+	handles := make([]string, 0, len(c.Accounts))
+	for handle := range c.Accounts {
+		handles = append(handles, handle)
+	}
+	sort.Slice(handles, func(i, j int) bool {
+		pi, pj := c.Accounts[handles[i]].Priority, c.Accounts[handles[j]].Priority
+		if pi != pj {
+			return pi < pj
+		}
+		return handles[i] < handles[j]
+	})
+	return handles
+}
+
 // GetDefaultAccount returns the default account, or nil if not set.
 func (c *AccountsConfig) GetDefaultAccount() *Account {
 	if c.Default == "" {
@@ -1065,6 +1085,53 @@ func TownSettingsPath(townRoot string) string {
 	return filepath.Join(townRoot, "settings", "config.json")
 }
 
+// LayoutsPath returns the path to the operator layouts file.
+func LayoutsPath(townRoot string) string {
+	return filepath.Join(townRoot, "settings", "layouts.json")
+}
+
+// LoadLayoutsConfig loads operator layouts. A missing file yields the
+// built-in layouts; layouts defined in the file are added to (or replace)
+// the built-in ones by name.
+func LoadLayoutsConfig(path string) (*LayoutsConfig, error) {
+	cfg := DefaultLayoutsConfig()
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("reading layouts config: %w", err)
+	}
+
+	var file LayoutsConfig
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing layouts config: %w", err)
+	}
+	for name, layout := range file.Layouts {
+		if layout == nil || len(layout.Panes) == 0 {
+			return nil, fmt.Errorf("%w: layout %q has no panes", ErrMissingField, name)
+		}
+		for i, pane := range layout.Panes {
+			if strings.TrimSpace(pane.Command) == "" {
+				return nil, fmt.Errorf("%w: command for pane %d of layout %q", ErrMissingField, i+1, name)
+			}
+			if pane.Refresh != "" {
+				if d, err := time.ParseDuration(pane.Refresh); err != nil || d <= 0 {
+					return nil, fmt.Errorf("layout %q pane %d: invalid refresh %q", name, i+1, pane.Refresh)
+				}
+			}
+		}
+		cfg.Layouts[name] = layout
+	}
+	if file.Default != "" {
+		if _, ok := cfg.Layouts[file.Default]; !ok {
+			return nil, fmt.Errorf("%w: default layout %q not defined", ErrMissingField, file.Default)
+		}
+		cfg.Default = file.Default
+	}
+	return cfg, nil
+}
+
 // RigSettingsPath returns the path to rig settings file.
 func RigSettingsPath(rigPath string) string {
 	return filepath.Join(rigPath, "settings", "config.json")
@@ -1798,6 +1865,26 @@ func ResolveAgentConfigByName(name, townRoot, rigPath string) *RuntimeConfig {
 	return lookupAgentConfigIfExists(name, townSettings, rigSettings)
 }
 
+// ResolveAgentProvider returns the provider an agent runs on ("claude",
+// "gemini", ...), for keying rate-limit state. An empty name means the rig's
+// polecat agent. Custom agents report their underlying provider; unknown
+// names are returned as-is.
+func ResolveAgentProvider(name, townRoot, rigPath string) string {
+	var rc *RuntimeConfig
+	if name == "" {
+		rc = ResolveRoleAgentConfig("polecat", townRoot, rigPath)
+	} else {
+		rc = ResolveAgentConfigByName(name, townRoot, rigPath)
+	}
+	if rc != nil && rc.Provider != "" {
+		return rc.Provider
+	}
+	if name != "" {
+		return name
+	}
+	return DefaultAccountProvider
+}
+
 // HasExplicitRoleAgent returns true if role_agents (rig or town level)
 // explicitly maps this role to a named agent. This distinguishes between
 // "role_agents says use claude-sonnet" and "no role_agents entry, falling
@@ -707,6 +707,16 @@ func TestAccountsConfigValidation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "negative priority",
+			config: &AccountsConfig{
+				Version: 1,
+				Accounts: map[string]Account{
+					"test": {ConfigDir: "~/.claude-accounts/test", Priority: -1},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -719,6 +729,23 @@ func TestAccountsConfigValidation(t *testing.T) {
 	}
 }
 
+func TestAccountsConfigHandles(t *testing.T) {
+	t.Parallel()
+	c := &AccountsConfig{Accounts: map[string]Account{
+		"work":     {Priority: 1},
+		"personal": {},
+		"backup":   {Priority: 2},
+		"alt":      {},
+	}}
+	got := strings.Join(c.Handles(), ",")
+	if got != "alt,personal,work,backup" {
+		t.Errorf("Handles() = %s, want alt,personal,work,backup", got)
+	}
+	if p := c.Accounts["alt"].GetProvider(); p != DefaultAccountProvider {
+		t.Errorf("GetProvider() = %q, want %q", p, DefaultAccountProvider)
+	}
+}
+
 func TestLoadAccountsConfigNotFound(t *testing.T) {
 	t.Parallel()
 	_, err := LoadAccountsConfig("/nonexistent/path.json")
@@ -5586,3 +5613,46 @@ func TestBuildStartupCommandWithAgentOverride_NoDoubleSettingsOnNonOverridePath(
 		t.Errorf("default Claude agent on polecat role should still get --settings, got: %q", cmd)
 	}
 }
+
+func TestLoadLayoutsConfig(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "layouts.json")
+
+	cfg, err := LoadLayoutsConfig(path)
+	if err != nil {
+		t.Fatalf("missing file: %v", err)
+	}
+	if cfg.Default != DefaultLayoutName || len(cfg.Layouts[DefaultLayoutName].Panes) != 4 {
+		t.Fatalf("defaults = %+v", cfg)
+	}
+
+	data := `{"version":1,"default":"review","layouts":{"review":{"panes":[{"title":"mq","command":"gt mq list","refresh":"15s"}]}}}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadLayoutsConfig(path)
+	if err != nil {
+		t.Fatalf("LoadLayoutsConfig: %v", err)
+	}
+	if cfg.Default != "review" {
+		t.Errorf("Default = %q, want review", cfg.Default)
+	}
+	if _, ok := cfg.Layouts[DefaultLayoutName]; !ok {
+		t.Error("built-in layout dropped when file defines others")
+	}
+
+	for name, bad := range map[string]string{
+		"no panes":        `{"layouts":{"x":{"panes":[]}}}`,
+		"empty command":   `{"layouts":{"x":{"panes":[{"command":" "}]}}}`,
+		"bad refresh":     `{"layouts":{"x":{"panes":[{"command":"ls","refresh":"often"}]}}}`,
+		"unknown default": `{"default":"nope"}`,
+	} {
+		if err := os.WriteFile(path, []byte(bad), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadLayoutsConfig(path); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
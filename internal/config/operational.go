@@ -1,6 +1,7 @@
 package config
 
 import (
+	"math"
 	"path/filepath"
 	"time"
 )
@@ -48,6 +49,8 @@ const (
 	DefaultRecoveryHeartbeatInterval       = 3 * time.Minute
 	DefaultBootSpawnCooldown               = 2 * time.Minute
 	DefaultDeaconGracePeriod               = 5 * time.Minute
+	DefaultDaemonCrashLoopCount            = 5
+	DefaultDaemonCrashLoopWindow           = 10 * time.Minute
 
 	// Pressure check defaults — fully opt-in. All zero = disabled.
 	// Configure in settings/config.json under operational.daemon to enable.
@@ -68,6 +71,7 @@ const (
 	DefaultRedispatchCooldown              = 5 * time.Minute
 	DefaultMaxFeedsPerCycle                = 3
 	DefaultFeedCooldown                    = 10 * time.Minute
+	DefaultHookedSLA                       = 4 * time.Hour
 )
 
 // Polecat defaults.
@@ -86,6 +90,9 @@ const (
 	DefaultDoltCmdTimeout          = 15 * time.Second
 	DefaultDoltMaxConnections      = 1000
 	DefaultDoltSlowQueryThreshold  = 1 * time.Second
+	DefaultDoltBdMaxConcurrent     = 0
+	DefaultDoltBdQueueTimeout      = 60 * time.Second
+	DefaultDoltBdRateLimit         = 0.0
 )
 
 // Mail defaults.
@@ -392,6 +399,22 @@ func (d *DaemonThresholds) DeaconGracePeriodD() time.Duration {
 	return DefaultDeaconGracePeriod
 }
 
+// CrashLoopCountV returns the configured or default daemon crash loop restart count.
+func (d *DaemonThresholds) CrashLoopCountV() int {
+	if d != nil && d.CrashLoopCount != nil {
+		return *d.CrashLoopCount
+	}
+	return DefaultDaemonCrashLoopCount
+}
+
+// CrashLoopWindowD returns the configured or default daemon crash loop window.
+func (d *DaemonThresholds) CrashLoopWindowD() time.Duration {
+	if d != nil {
+		return ParseDurationOrDefault(d.CrashLoopWindow, DefaultDaemonCrashLoopWindow)
+	}
+	return DefaultDaemonCrashLoopWindow
+}
+
 // PressureCPUThresholdV returns the configured or default CPU pressure threshold (load per core).
 func (d *DaemonThresholds) PressureCPUThresholdV() float64 {
 	if d != nil && d.PressureCPUThreshold != nil {
@@ -498,6 +521,14 @@ func (d *DeaconThresholds) FeedCooldownD() time.Duration {
 	return DefaultFeedCooldown
 }
 
+// HookedSLAD returns the configured or default hooked-bead SLA.
+func (d *DeaconThresholds) HookedSLAD() time.Duration {
+	if d != nil {
+		return ParseDurationOrDefault(d.HookedSLA, DefaultHookedSLA)
+	}
+	return DefaultHookedSLA
+}
+
 // --- Polecat accessors ---
 
 // GetPolecatConfig returns the polecat thresholds, never nil.
@@ -598,6 +629,39 @@ func (dt *DoltThresholds) SlowQueryThresholdD() time.Duration {
 	return DefaultDoltSlowQueryThreshold
 }
 
+// BdMaxConcurrentV returns the configured or default bd concurrency cap.
+func (dt *DoltThresholds) BdMaxConcurrentV() int {
+	if dt != nil && dt.BdMaxConcurrent != nil {
+		return *dt.BdMaxConcurrent
+	}
+	return DefaultDoltBdMaxConcurrent
+}
+
+// BdRateLimitV returns the configured or default bd calls per second.
+func (dt *DoltThresholds) BdRateLimitV() float64 {
+	if dt != nil && dt.BdRateLimit != nil {
+		return *dt.BdRateLimit
+	}
+	return DefaultDoltBdRateLimit
+}
+
+// BdRateBurstV returns the configured bd burst, defaulting to the rate
+// rounded up (at least 1).
+func (dt *DoltThresholds) BdRateBurstV() int {
+	if dt != nil && dt.BdRateBurst != nil && *dt.BdRateBurst > 0 {
+		return *dt.BdRateBurst
+	}
+	return max(1, int(math.Ceil(dt.BdRateLimitV())))
+}
+
+// BdQueueTimeoutD returns the configured or default bd slot wait limit.
+func (dt *DoltThresholds) BdQueueTimeoutD() time.Duration {
+	if dt != nil {
+		return ParseDurationOrDefault(dt.BdQueueTimeout, DefaultDoltBdQueueTimeout)
+	}
+	return DefaultDoltBdQueueTimeout
+}
+
 // --- Mail accessors ---
 
 // GetMailConfig returns the mail thresholds, never nil.
@@ -202,6 +202,12 @@ func TestDoltThresholds_Defaults(t *testing.T) {
 	if got := dolt.SlowQueryThresholdD(); got != DefaultDoltSlowQueryThreshold {
 		t.Errorf("SlowQueryThreshold: got %v, want %v", got, DefaultDoltSlowQueryThreshold)
 	}
+	if got := dolt.BdMaxConcurrentV(); got != DefaultDoltBdMaxConcurrent {
+		t.Errorf("BdMaxConcurrent: got %v, want %v", got, DefaultDoltBdMaxConcurrent)
+	}
+	if got := dolt.BdQueueTimeoutD(); got != DefaultDoltBdQueueTimeout {
+		t.Errorf("BdQueueTimeout: got %v, want %v", got, DefaultDoltBdQueueTimeout)
+	}
 }
 
 func TestLoadOperationalConfig_NonexistentDir(t *testing.T) {
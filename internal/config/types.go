@@ -88,9 +88,23 @@ type TownSettings struct {
 	// FeedCurator configures event deduplication and aggregation windows.
 	FeedCurator *FeedCuratorConfig `json:"feed_curator,omitempty"`
 
+	// Events configures rotation and retention of the raw events log.
+	Events *EventsConfig `json:"events,omitempty"`
+
+	// Artifacts configures retention of per-bead result artifacts
+	// (.runtime/artifacts/<bead-id>/).
+	Artifacts *ArtifactsConfig `json:"artifacts,omitempty"`
+
+	// ProtectedPaths lists town paths agents may not write to.
+	ProtectedPaths *ProtectedPathsConfig `json:"protected_paths,omitempty"`
+
 	// Convoy configures convoy behavior settings.
 	Convoy *ConvoyConfig `json:"convoy,omitempty"`
 
+	// Confirm sets how big a bulk operator action may get before gt asks for
+	// confirmation.
+	Confirm *ConfirmConfig `json:"confirm,omitempty"`
+
 	// RoleEffort maps role names to effort levels for per-role effort configuration.
 	// Keys are role names: "mayor", "deacon", "witness", "refinery", "polecat", "crew", "boot", "dog".
 	// Values are effort levels: "low", "medium", "high", "max".
@@ -106,6 +120,12 @@ type TownSettings struct {
 	// Scheduler configures the capacity scheduler for polecat dispatch.
 	Scheduler *capacity.SchedulerConfig `json:"scheduler,omitempty"`
 
+	// Ingest configures the inbound email/form webhook (gt ingest serve).
+	Ingest *IngestConfig `json:"ingest,omitempty"`
+
+	// Webhooks configures outbound webhooks for bead lifecycle transitions.
+	Webhooks *WebhooksConfig `json:"webhooks,omitempty"`
+
 	// Operational configures operational thresholds (timeouts, retries, intervals).
 	// These were previously hardcoded as Go constants throughout the codebase.
 	// All values are optional — omitted values use compiled-in defaults.
@@ -209,6 +229,74 @@ func DefaultFeedCuratorConfig() *FeedCuratorConfig {
 	}
 }
 
+// EventsConfig configures size-based rotation of the raw events log
+// (.events.jsonl). Rotated segments are kept alongside it and indexed by
+// time range; the oldest are deleted beyond RetainSegments.
+type EventsConfig struct {
+	// MaxSizeMB is the size at which .events.jsonl is rotated into a segment.
+	// Default: 50.
+	MaxSizeMB int `json:"max_size_mb,omitempty"`
+	// RetainSegments is the number of rotated segments to keep.
+	// Default: 10.
+	RetainSegments int `json:"retain_segments,omitempty"`
+}
+
+// DefaultEventsConfig returns an EventsConfig with sensible defaults.
+func DefaultEventsConfig() *EventsConfig {
+	return &EventsConfig{
+		MaxSizeMB:      50,
+		RetainSegments: 10,
+	}
+}
+
+// ArtifactsConfig configures garbage collection of per-bead artifact
+// directories (.runtime/artifacts/<bead-id>/). A bead's directory is removed
+// once nothing in it has changed for MaxAgeDays; beyond that, the least
+// recently touched directories are removed until the total fits MaxSizeMB.
+type ArtifactsConfig struct {
+	// MaxAgeDays is how long a bead's artifacts are kept after their last
+	// change. Default: 30.
+	MaxAgeDays int `json:"max_age_days,omitempty"`
+	// MaxSizeMB caps the total size of all artifacts. Default: 2048.
+	MaxSizeMB int `json:"max_size_mb,omitempty"`
+}
+
+// DefaultArtifactsConfig returns an ArtifactsConfig with sensible defaults.
+func DefaultArtifactsConfig() *ArtifactsConfig {
+	return &ArtifactsConfig{
+		MaxAgeDays: 30,
+		MaxSizeMB:  2048,
+	}
+}
+
+// ProtectedPathsConfig lists town paths that agent tool calls may not modify.
+// Enforced by the protected-paths PreToolUse guard (gt tap guard protected-paths).
+type ProtectedPathsConfig struct {
+	// Paths are relative to the town root. A trailing "/" protects a directory
+	// and everything under it; otherwise the entry names a single file. Each
+	// segment may use filepath.Match wildcards ("*/.runtime/" matches every
+	// rig's runtime dir).
+	// Default: see DefaultProtectedPathsConfig.
+	Paths []string `json:"paths,omitempty"`
+	// Disabled turns the guard off without removing the hook.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// DefaultProtectedPathsConfig returns the paths protected when a town doesn't
+// configure its own: runtime state, the daemon's directory, and town config.
+func DefaultProtectedPathsConfig() *ProtectedPathsConfig {
+	return &ProtectedPathsConfig{
+		Paths: []string{
+			".runtime/",
+			"*/.runtime/",
+			"daemon/",
+			"settings/",
+			"mayor/town.json",
+			"mayor/rigs.json",
+		},
+	}
+}
+
 // OperationalConfig groups operational thresholds that were previously hardcoded
 // as Go constants. All fields are optional — omitted values use compiled-in defaults.
 // This enables per-town tuning without code changes (ZFC: Zero Fixed Constants).
@@ -351,6 +439,13 @@ type DaemonThresholds struct {
 	// DeaconGracePeriod is time to wait after starting Deacon before checking heartbeat (default "5m").
 	DeaconGracePeriod string `json:"deacon_grace_period,omitempty"`
 
+	// CrashLoopCount is how many unclean daemon restarts within
+	// CrashLoopWindow count as a crash loop, which stops the daemon (default 5).
+	CrashLoopCount *int `json:"crash_loop_count,omitempty"`
+
+	// CrashLoopWindow is the time window for counting daemon restarts (default "10m").
+	CrashLoopWindow string `json:"crash_loop_window,omitempty"`
+
 	// PressureCPUThreshold is the per-core load average above which new
 	// non-infrastructure spawns are deferred. Disabled by default (0).
 	// Recommended starting value: 3.0 (only trips under severe load).
@@ -394,6 +489,10 @@ type DeaconThresholds struct {
 
 	// FeedCooldown is min time between feeding same convoy (default "10m").
 	FeedCooldown string `json:"feed_cooldown,omitempty"`
+
+	// HookedSLA is how long a bead may stay hooked without an update before
+	// patrol findings report an SLA breach (default "4h").
+	HookedSLA string `json:"hooked_sla,omitempty"`
 }
 
 // PolecatThresholds configures polecat session and retry thresholds.
@@ -430,6 +529,23 @@ type DoltThresholds struct {
 
 	// SlowQueryThreshold is duration above which a query is flagged slow (default "1s").
 	SlowQueryThreshold string `json:"slow_query_threshold,omitempty"`
+
+	// BdMaxConcurrent caps bd invocations running at once across the town,
+	// so bursts of gt commands queue instead of tripping Dolt lock errors
+	// (default 0 = unlimited).
+	BdMaxConcurrent *int `json:"bd_max_concurrent,omitempty"`
+
+	// BdQueueTimeout is how long a bd call waits for a slot or a rate token
+	// before running anyway (default "60s").
+	BdQueueTimeout string `json:"bd_queue_timeout,omitempty"`
+
+	// BdRateLimit caps how many bd invocations start per second across the
+	// town, as a token bucket refilled at this rate (default 0 = unlimited).
+	BdRateLimit *float64 `json:"bd_rate_limit,omitempty"`
+
+	// BdRateBurst is how many bd calls may start back to back before
+	// BdRateLimit paces them (default: the rate rounded up, at least 1).
+	BdRateBurst *int `json:"bd_rate_burst,omitempty"`
 }
 
 // MailThresholds configures mail system thresholds.
@@ -497,6 +613,185 @@ type ConvoyConfig struct {
 	// NotifyOnComplete controls whether convoy completion pushes a notification
 	// into the active Mayor session (in addition to mail). Opt-in; default false.
 	NotifyOnComplete bool `json:"notify_on_complete,omitempty"`
+
+	// Lifecycle policy, enforced by gt convoy sweep (run periodically by the
+	// daemon). Owned convoys (gt:owned) are exempt: their caller lands them.
+
+	// AutoCloseEmpty closes convoys that track no issues once they are past
+	// the creation grace period. Default true.
+	AutoCloseEmpty *bool `json:"auto_close_empty,omitempty"`
+
+	// AutoCloseComplete closes convoys whose tracked issues are all closed.
+	// Default true.
+	AutoCloseComplete *bool `json:"auto_close_complete,omitempty"`
+
+	// StaleTTL closes convoys with no active work that haven't been updated
+	// for this long (e.g. "336h"). Empty disables.
+	StaleTTL string `json:"stale_ttl,omitempty"`
+}
+
+// IsAutoCloseEmptyEnabled reports whether empty convoys are auto-closed.
+// Nil-safe, defaults to true.
+func (c *ConvoyConfig) IsAutoCloseEmptyEnabled() bool {
+	if c == nil || c.AutoCloseEmpty == nil {
+		return true
+	}
+	return *c.AutoCloseEmpty
+}
+
+// IsAutoCloseCompleteEnabled reports whether completed convoys are
+// auto-closed. Nil-safe, defaults to true.
+func (c *ConvoyConfig) IsAutoCloseCompleteEnabled() bool {
+	if c == nil || c.AutoCloseComplete == nil {
+		return true
+	}
+	return *c.AutoCloseComplete
+}
+
+// GetStaleTTL returns the stale-convoy TTL, or 0 when disabled or invalid.
+func (c *ConvoyConfig) GetStaleTTL() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return ParseDurationOrDefault(c.StaleTTL, 0)
+}
+
+// Default confirmation thresholds.
+const (
+	DefaultConfirmEnqueueBeads = 20
+	DefaultConfirmKillPolecats = 1
+	DefaultConfirmClearLimits  = 1
+)
+
+// ConfirmConfig sets confirmation thresholds for bulk operator actions. An
+// action touching more items than its threshold needs an interactive "y" or
+// --yes (without a terminal, only --yes). 0 confirms every time; a negative
+// threshold turns confirmation off. Nil fields use the defaults.
+type ConfirmConfig struct {
+	// EnqueueBeads covers gt sling of several beads, a convoy or an epic.
+	// Default 20.
+	EnqueueBeads *int `json:"enqueue_beads,omitempty"`
+
+	// KillPolecats covers gt polecat kill and gt polecat pause. Default 1.
+	KillPolecats *int `json:"kill_polecats,omitempty"`
+
+	// ClearLimits covers gt quota clear of rate-limited accounts. Default 1.
+	ClearLimits *int `json:"clear_limits,omitempty"`
+}
+
+// GetEnqueueBeads returns the bead enqueue threshold. Nil-safe.
+func (c *ConfirmConfig) GetEnqueueBeads() int {
+	if c == nil || c.EnqueueBeads == nil {
+		return DefaultConfirmEnqueueBeads
+	}
+	return *c.EnqueueBeads
+}
+
+// GetKillPolecats returns the bulk polecat action threshold. Nil-safe.
+func (c *ConfirmConfig) GetKillPolecats() int {
+	if c == nil || c.KillPolecats == nil {
+		return DefaultConfirmKillPolecats
+	}
+	return *c.KillPolecats
+}
+
+// GetClearLimits returns the limit clearing threshold. Nil-safe.
+func (c *ConfirmConfig) GetClearLimits() int {
+	if c == nil || c.ClearLimits == nil {
+		return DefaultConfirmClearLimits
+	}
+	return *c.ClearLimits
+}
+
+// IngestConfig configures the inbound email/form bridge that files beads.
+// The shared secret is read from GT_INGEST_TOKEN, never from settings.
+type IngestConfig struct {
+	// Listen is the address the webhook binds to. Default: "127.0.0.1:8095".
+	Listen string `json:"listen,omitempty"`
+
+	// Rig is the rig whose beads database receives new beads.
+	// Empty = town-level (hq) beads.
+	Rig string `json:"rig,omitempty"`
+
+	// Labels are applied to every ingested bead.
+	Labels []string `json:"labels,omitempty"`
+
+	// AllowedSenders restricts accepted From addresses (addresses or domains).
+	// Empty = accept any sender that presents the token.
+	AllowedSenders []string `json:"allowed_senders,omitempty"`
+
+	// AutoEnqueue schedules each ingested bead on Rig immediately.
+	// Requires Rig and deferred dispatch (scheduler.max_polecats > 0).
+	AutoEnqueue bool `json:"auto_enqueue,omitempty"`
+}
+
+// DefaultIngestListen is the default bind address for gt ingest serve.
+const DefaultIngestListen = "127.0.0.1:8095"
+
+// GetListen returns Listen or DefaultIngestListen.
+func (c *IngestConfig) GetListen() string {
+	if c == nil || c.Listen == "" {
+		return DefaultIngestListen
+	}
+	return c.Listen
+}
+
+// WebhooksConfig configures outbound webhooks that the daemon posts on bead
+// lifecycle transitions (queued, dispatched, in_review, merged, closed).
+type WebhooksConfig struct {
+	Endpoints []WebhookEndpoint `json:"endpoints,omitempty"`
+}
+
+// WebhookEndpoint is one webhook receiver. Deliveries are signed with
+// HMAC-SHA256 using the secret read from the SecretEnv environment variable;
+// the secret is never stored in settings.
+type WebhookEndpoint struct {
+	// URL receives a POST per transition.
+	URL string `json:"url"`
+
+	// SecretEnv names the environment variable holding the signing secret.
+	// Default: "GT_WEBHOOK_SECRET".
+	SecretEnv string `json:"secret_env,omitempty"`
+
+	// States limits deliveries to these lifecycle states. Empty = all.
+	States []string `json:"states,omitempty"`
+
+	// Timeout bounds each delivery attempt. Default: "10s".
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// DefaultWebhookSecretEnv is the default environment variable holding a
+// webhook endpoint's signing secret.
+const DefaultWebhookSecretEnv = "GT_WEBHOOK_SECRET"
+
+// WebhookStates are the bead lifecycle states a webhook endpoint can
+// subscribe to, in lifecycle order.
+var WebhookStates = []string{"queued", "dispatched", "in_review", "merged", "closed"}
+
+// GetSecretEnv returns SecretEnv or DefaultWebhookSecretEnv.
+func (e WebhookEndpoint) GetSecretEnv() string {
+	if e.SecretEnv == "" {
+		return DefaultWebhookSecretEnv
+	}
+	return e.SecretEnv
+}
+
+// GetTimeout returns the per-attempt delivery timeout (default 10s).
+func (e WebhookEndpoint) GetTimeout() time.Duration {
+	return ParseDurationOrDefault(e.Timeout, 10*time.Second)
+}
+
+// Wants reports whether the endpoint subscribes to state.
+func (e WebhookEndpoint) Wants(state string) bool {
+	if len(e.States) == 0 {
+		return true
+	}
+	for _, s := range e.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
 }
 
 // ParseDurationOrDefault parses a Go duration string, returning fallback on error or empty input.
@@ -683,6 +978,32 @@ type RigSettings struct {
 	// Values are effort levels: "low", "medium", "high", "max".
 	// Example: {"crew": "max", "witness": "low"}
 	RoleEffort map[string]string `json:"role_effort,omitempty"`
+
+	// Jira mirrors issues from a Jira project into this rig's beads.
+	Jira *JiraConfig `json:"jira,omitempty"`
+}
+
+// JiraConfig configures bidirectional issue sync between Jira and a rig's beads.
+// The API token is read from JIRA_API_TOKEN; never store it in settings.
+type JiraConfig struct {
+	// BaseURL is the Jira site, e.g. "https://example.atlassian.net".
+	BaseURL string `json:"base_url"`
+
+	// Email is the account used with the API token (Jira Cloud basic auth).
+	// Falls back to JIRA_EMAIL. Empty = bearer personal access token (Server/DC).
+	Email string `json:"email,omitempty"`
+
+	// JQL selects the issues to mirror, e.g. "project = WEB AND labels = agents".
+	JQL string `json:"jql"`
+
+	// Labels are applied to every mirrored bead in addition to the jira:KEY label.
+	Labels []string `json:"labels,omitempty"`
+
+	// Transitions maps Gas Town status to a Jira transition (or target status)
+	// name. Keys are bead statuses ("open", "in_progress", "hooked", "closed")
+	// or "scheduled" for beads waiting in the scheduler.
+	// Example: {"scheduled": "Selected for Development", "in_progress": "In Progress", "closed": "Done"}
+	Transitions map[string]string `json:"transitions,omitempty"`
 }
 
 // CrewConfig represents crew workspace settings for a rig.
@@ -1339,6 +1660,10 @@ type MergeQueueConfig struct {
 	// is enabled. Valid values: "quick", "standard", "deep".
 	// Nil defaults to "standard".
 	ReviewDepth string `json:"review_depth,omitempty"`
+
+	// Changelog is a repo-relative file (e.g. "CHANGELOG.md") the refinery
+	// appends a one-line entry to for every merge. Empty disables it.
+	Changelog string `json:"changelog,omitempty"`
 }
 
 // OnConflict strategy constants.
@@ -1455,6 +1780,15 @@ type NamepoolConfig struct {
 	// MaxBeforeNumbering is when to start appending numbers.
 	// Default is 50. After this many polecats, names become name-01, name-02, etc.
 	MaxBeforeNumbering int `json:"max_before_numbering,omitempty"`
+
+	// Prefix is prepended to every polecat name in this rig (e.g., "api"
+	// gives api-furiosa), so sessions read as the project they belong to.
+	Prefix string `json:"prefix,omitempty"`
+
+	// FromBead names each new polecat after the bead it is spawned for
+	// (gt-abc12 becomes abc12, so its session is gt-abc12) instead of
+	// drawing from the theme. The theme is still used when there is no bead.
+	FromBead bool `json:"from_bead,omitempty"`
 }
 
 // DefaultNamepoolConfig returns a NamepoolConfig with sensible defaults.
@@ -1465,6 +1799,57 @@ func DefaultNamepoolConfig() *NamepoolConfig {
 	}
 }
 
+// LayoutsConfig defines operator tmux dashboards (settings/layouts.json),
+// built by gt workspace layout.
+type LayoutsConfig struct {
+	Version int                      `json:"version"`
+	Default string                   `json:"default,omitempty"` // Layout used when none is named
+	Layouts map[string]*LayoutConfig `json:"layouts"`
+}
+
+// LayoutConfig is one named dashboard: a tmux session whose window is split
+// into panes.
+type LayoutConfig struct {
+	// TmuxLayout is a tmux layout name (tiled, even-horizontal, main-vertical,
+	// ...) or a layout string. Default: tiled.
+	TmuxLayout string       `json:"tmux_layout,omitempty"`
+	Panes      []LayoutPane `json:"panes"`
+}
+
+// LayoutPane is one pane of a layout. Command runs in the town root and may
+// use {{town}} and {{recent_polecat}} (the rig/name address of the most
+// recently active polecat). With Refresh set, the command is rerun on that
+// interval (e.g., "10s") instead of once.
+type LayoutPane struct {
+	Title   string `json:"title,omitempty"`
+	Command string `json:"command"`
+	Refresh string `json:"refresh,omitempty"`
+}
+
+// DefaultLayoutName is the built-in layout gt workspace layout builds when a
+// town defines none.
+const DefaultLayoutName = "mission-control"
+
+// DefaultLayoutsConfig returns the built-in mission-control layout: daemon
+// logs, the scheduler queue, account limits, and the latest polecat.
+func DefaultLayoutsConfig() *LayoutsConfig {
+	return &LayoutsConfig{
+		Version: 1,
+		Default: DefaultLayoutName,
+		Layouts: map[string]*LayoutConfig{
+			DefaultLayoutName: {
+				TmuxLayout: "tiled",
+				Panes: []LayoutPane{
+					{Title: "daemon", Command: "gt daemon logs -f"},
+					{Title: "queue", Command: "gt scheduler list", Refresh: "10s"},
+					{Title: "limits", Command: "gt quota status", Refresh: "30s"},
+					{Title: "polecat", Command: "gt peek {{recent_polecat}} 40", Refresh: "5s"},
+				},
+			},
+		},
+	}
+}
+
 // AccountsConfig represents Claude Code account configuration (mayor/accounts.json).
 // This enables Gas Town to manage multiple Claude Code accounts with easy switching.
 type AccountsConfig struct {
@@ -1478,6 +1863,24 @@ type Account struct {
 	Email       string `json:"email"`                 // account email
 	Description string `json:"description,omitempty"` // human description
 	ConfigDir   string `json:"config_dir"`            // path to CLAUDE_CONFIG_DIR
+
+	// Provider names the service the account belongs to (default "claude").
+	Provider string `json:"provider,omitempty"`
+
+	// Priority orders accounts for quota rotation and listings: lower is
+	// preferred. Accounts with equal priority rotate least-recently-used first.
+	Priority int `json:"priority,omitempty"`
+}
+
+// DefaultAccountProvider is the provider of accounts registered without one.
+const DefaultAccountProvider = "claude"
+
+// GetProvider returns the account's provider, or DefaultAccountProvider.
+func (a Account) GetProvider() string {
+	if a.Provider == "" {
+		return DefaultAccountProvider
+	}
+	return a.Provider
 }
 
 // CurrentAccountsVersion is the current schema version for AccountsConfig.
@@ -1508,6 +1911,39 @@ type QuotaState struct {
 	// keychain entry — not the target's. SyncSwappedTokens uses this map
 	// to propagate fresh tokens to all target keychain entries.
 	ActiveSwaps map[string]string `json:"active_swaps,omitempty"` // targetConfigDir -> sourceAccountHandle
+
+	// Snooze keeps the town quiet until it expires: the daemon neither wakes
+	// limit-stalled polecats nor dispatches scheduled work, even after
+	// account limits reset. Set by gt limits snooze.
+	Snooze *QuotaSnooze `json:"snooze,omitempty"`
+
+	// Providers holds limit state for sessions that run without a
+	// registered account (e.g. a Gemini API key shared by every Gemini
+	// session), keyed by provider. A provider with registered accounts is
+	// limited only when all of its accounts are.
+	Providers map[string]AccountQuotaState `json:"providers,omitempty"`
+
+	// Stalled records sessions seen stopped at a usage limit, keyed by
+	// session name, so the daemon can wake them once the limit resets even
+	// when they have no tmux pane to scan (headless agents). Written with
+	// each recorded limit; an entry is dropped once its session is woken.
+	Stalled map[string]StalledSession `json:"stalled,omitempty"`
+}
+
+// StalledSession is a session stopped at a usage limit (QuotaState.Stalled).
+type StalledSession struct {
+	Account  string `json:"account,omitempty"`   // Account the session ran under, if registered
+	Provider string `json:"provider,omitempty"`  // Provider, for sessions without an account
+	Window   string `json:"window,omitempty"`    // Limit window hit
+	ResetsAt string `json:"resets_at,omitempty"` // Reset time shown with the limit
+	Since    string `json:"since"`               // RFC3339 when the stall was first recorded
+}
+
+// QuotaSnooze is a temporary suppression of limit wakes and dispatch.
+type QuotaSnooze struct {
+	Until  string `json:"until"`            // RFC3339 when the snooze expires
+	Reason string `json:"reason,omitempty"` // Why the town was snoozed (e.g. "demo")
+	By     string `json:"by,omitempty"`     // Who snoozed it
 }
 
 // AccountQuotaStatus is the rate-limit status of an account.
@@ -1530,6 +1966,19 @@ type AccountQuotaState struct {
 	LimitedAt string             `json:"limited_at,omitempty"` // RFC3339 when limit was detected
 	ResetsAt  string             `json:"resets_at,omitempty"`  // Human-readable reset time from provider (e.g. "7pm (America/Los_Angeles)")
 	LastUsed  string             `json:"last_used,omitempty"`  // RFC3339 when account was last assigned to a session
+
+	// Windows holds each limit window currently blocking the account, keyed
+	// by window name ("5h", "weekly"). Providers enforce several rolling caps
+	// at once, and each resets independently: the account is only available
+	// again once every window has reset. ResetsAt mirrors the window that
+	// resets last, for display and for states written before windows existed.
+	Windows map[string]QuotaWindow `json:"windows,omitempty"`
+}
+
+// QuotaWindow is a single rate-limit window on an account.
+type QuotaWindow struct {
+	LimitedAt string `json:"limited_at,omitempty"` // RFC3339 when this window's limit was detected
+	ResetsAt  string `json:"resets_at,omitempty"`  // Human-readable reset time (e.g. "7pm (America/Los_Angeles)", "Oct 20, 9am")
 }
 
 // CurrentQuotaVersion is the current schema version for QuotaState.
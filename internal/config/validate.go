@@ -0,0 +1,357 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// SettingsIssue is one problem found in town settings.
+type SettingsIssue struct {
+	Key     string `json:"key"` // Dot-notation path, e.g. "scheduler.spawn_delay"
+	Message string `json:"message"`
+	Warning bool   `json:"warning,omitempty"` // Suspicious but not wrong
+}
+
+func (i SettingsIssue) String() string {
+	if i.Key == "" {
+		return i.Message
+	}
+	return i.Key + ": " + i.Message
+}
+
+// durationKeySuffixes mark string settings that hold Go durations ("30s",
+// "5m"). Every duration setting in TownSettings is named this way.
+var durationKeySuffixes = []string{
+	"timeout", "interval", "delay", "window", "threshold", "ttl",
+	"cooldown", "backoff", "grace", "grace_period", "max_age", "message_age",
+}
+
+// ValidateTownSettingsFile checks a settings/config.json file: that it parses,
+// that every key is one TownSettings knows (unknown keys are silently ignored
+// on load, so a typo quietly disables a setting), that duration values parse,
+// and the semantic checks in ValidateTownSettings. A missing file has no
+// issues.
+func ValidateTownSettingsFile(path string) ([]SettingsIssue, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return []SettingsIssue{{Message: fmt.Sprintf("invalid JSON: %v", err)}}, nil
+	}
+	issues := unknownKeys(raw, reflect.TypeOf(TownSettings{}), "")
+
+	var settings TownSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return append(issues, SettingsIssue{Message: fmt.Sprintf("does not match schema: %v", err)}), nil
+	}
+	return append(issues, ValidateTownSettings(&settings)...), nil
+}
+
+// ValidateTownSettings checks decoded settings for values the code would
+// reject or silently replace with defaults, and for options that conflict.
+func ValidateTownSettings(s *TownSettings) []SettingsIssue {
+	var issues []SettingsIssue
+	add := func(key, format string, args ...interface{}) {
+		issues = append(issues, SettingsIssue{Key: key, Message: fmt.Sprintf(format, args...)})
+	}
+	warn := func(key, format string, args ...interface{}) {
+		issues = append(issues, SettingsIssue{Key: key, Message: fmt.Sprintf(format, args...), Warning: true})
+	}
+
+	if s.Type != "" && s.Type != "town-settings" {
+		add("type", "expected %q, got %q", "town-settings", s.Type)
+	}
+	if s.Version > CurrentTownSettingsVersion {
+		add("version", "%d is newer than this gt supports (%d)", s.Version, CurrentTownSettingsVersion)
+	}
+
+	switch s.CLITheme {
+	case "", "dark", "light", "auto":
+	default:
+		add("cli_theme", "%q is not one of dark, light, auto", s.CLITheme)
+	}
+
+	if s.DefaultAgent != "" && lookupAgentConfigIfExists(s.DefaultAgent, s, nil) == nil {
+		add("default_agent", "unknown agent %q", s.DefaultAgent)
+	}
+	for _, role := range sortedKeys(s.RoleAgents) {
+		if agent := s.RoleAgents[role]; lookupAgentConfigIfExists(agent, s, nil) == nil {
+			add("role_agents."+role, "unknown agent %q", agent)
+		}
+	}
+	for _, name := range sortedKeys(s.CrewAgents) {
+		if agent := s.CrewAgents[name]; lookupAgentConfigIfExists(agent, s, nil) == nil {
+			add("crew_agents."+name, "unknown agent %q", agent)
+		}
+	}
+	for _, role := range sortedKeys(s.RoleEffort) {
+		if effort := s.RoleEffort[role]; !IsValidEffortLevel(effort) {
+			add("role_effort."+role, "%q is not one of %s", effort, strings.Join(ValidEffortLevels(), ", "))
+		}
+	}
+
+	issues = append(issues, invalidDurations(reflect.ValueOf(s).Elem(), "")...)
+
+	if sc := s.Scheduler; sc != nil {
+		maxPolecats := sc.GetMaxPolecats()
+		if maxPolecats < -1 {
+			add("scheduler.max_polecats", "must be >= -1, got %d", maxPolecats)
+		}
+		if sc.BatchSize != nil && *sc.BatchSize < 1 {
+			add("scheduler.batch_size", "must be >= 1, got %d", *sc.BatchSize)
+		}
+		if sc.MaxBatchedBeads != nil && *sc.MaxBatchedBeads < 1 {
+			add("scheduler.max_batched_beads", "must be >= 1, got %d", *sc.MaxBatchedBeads)
+		}
+		if err := capacity.ValidateRoutingRules(sc.Rules); err != nil {
+			add("scheduler.rules", "%v", err)
+		}
+		if sc.AutoEnqueue && !sc.IsDeferred() {
+			warn("scheduler.auto_enqueue", "has no effect unless scheduler.max_polecats > 0")
+		}
+		if sc.IsDeferred() && sc.GetBatchSize() > maxPolecats {
+			warn("scheduler.batch_size", "%d exceeds scheduler.max_polecats (%d); at most %d dispatch per heartbeat",
+				sc.GetBatchSize(), maxPolecats, maxPolecats)
+		}
+	}
+
+	if wh := s.Webhooks; wh != nil {
+		for i, ep := range wh.Endpoints {
+			key := fmt.Sprintf("webhooks.endpoints[%d]", i)
+			if u, err := url.Parse(ep.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				add(key+".url", "%q is not an http(s) URL", ep.URL)
+			}
+			for _, state := range ep.States {
+				if !slices.Contains(WebhookStates, state) {
+					add(key+".states", "%q is not one of %s", state, strings.Join(WebhookStates, ", "))
+				}
+			}
+		}
+	}
+
+	if wt := s.WebTimeouts; wt != nil && wt.DefaultRunTimeout != "" && wt.MaxRunTimeout != "" {
+		def, err1 := time.ParseDuration(wt.DefaultRunTimeout)
+		max, err2 := time.ParseDuration(wt.MaxRunTimeout)
+		if err1 == nil && err2 == nil && def > max {
+			add("web_timeouts.default_run_timeout", "%s exceeds web_timeouts.max_run_timeout (%s)", wt.DefaultRunTimeout, wt.MaxRunTimeout)
+		}
+	}
+	if ws := s.WorkerStatus; ws != nil && ws.StaleThreshold != "" && ws.StuckThreshold != "" {
+		stale, err1 := time.ParseDuration(ws.StaleThreshold)
+		stuck, err2 := time.ParseDuration(ws.StuckThreshold)
+		if err1 == nil && err2 == nil && stale >= stuck {
+			add("worker_status.stale_threshold", "%s must be shorter than worker_status.stuck_threshold (%s)", ws.StaleThreshold, ws.StuckThreshold)
+		}
+	}
+
+	return issues
+}
+
+// HasErrors reports whether issues contains anything other than warnings.
+func HasErrors(issues []SettingsIssue) bool {
+	for _, i := range issues {
+		if !i.Warning {
+			return true
+		}
+	}
+	return false
+}
+
+// unknownKeys walks raw decoded JSON alongside the Go type it decodes into
+// and reports keys the type has no field for.
+func unknownKeys(raw interface{}, t reflect.Type, prefix string) []SettingsIssue {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// Types that decode themselves may accept any shape.
+	if reflect.PointerTo(t).Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()) {
+		return nil
+	}
+
+	var issues []SettingsIssue
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		fields := jsonFields(t)
+		for _, key := range sortedKeys(obj) {
+			// "_comment"-style keys annotate settings files; JSON has no comments.
+			if strings.HasPrefix(key, "_") {
+				continue
+			}
+			f, ok := fields[key]
+			if !ok {
+				issue := SettingsIssue{Key: joinKey(prefix, key), Message: "unknown key"}
+				if near := closestKey(key, fields); near != "" {
+					issue.Message += fmt.Sprintf(" (did you mean %q?)", near)
+				}
+				issues = append(issues, issue)
+				continue
+			}
+			issues = append(issues, unknownKeys(obj[key], f.Type, joinKey(prefix, key))...)
+		}
+	case reflect.Map:
+		obj, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for _, key := range sortedKeys(obj) {
+			issues = append(issues, unknownKeys(obj[key], t.Elem(), joinKey(prefix, key))...)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := raw.([]interface{})
+		if !ok {
+			return nil
+		}
+		for i, v := range arr {
+			issues = append(issues, unknownKeys(v, t.Elem(), fmt.Sprintf("%s[%d]", prefix, i))...)
+		}
+	}
+	return issues
+}
+
+// jsonFields maps a struct's JSON keys to its fields, flattening embedded
+// structs the way encoding/json does.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			et := f.Type
+			if et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+			if et.Kind() == reflect.Struct {
+				for k, v := range jsonFields(et) {
+					fields[k] = v
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
+
+// invalidDurations reports duration-named string settings that don't parse.
+func invalidDurations(v reflect.Value, prefix string) []SettingsIssue {
+	var issues []SettingsIssue
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			issues = append(issues, invalidDurations(v.Elem(), prefix)...)
+		}
+	case reflect.Struct:
+		fields := jsonFields(v.Type())
+		for _, key := range sortedKeys(fields) {
+			fv := v.FieldByIndex(fields[key].Index)
+			if fv.Kind() == reflect.String {
+				if s := fv.String(); s != "" && isDurationKey(key) {
+					if _, err := time.ParseDuration(s); err != nil {
+						issues = append(issues, SettingsIssue{
+							Key:     joinKey(prefix, key),
+							Message: fmt.Sprintf("%q is not a duration (e.g. 30s, 5m, 2h)", s),
+						})
+					}
+				}
+				continue
+			}
+			issues = append(issues, invalidDurations(fv, joinKey(prefix, key))...)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, k := range keys {
+			issues = append(issues, invalidDurations(v.MapIndex(k), joinKey(prefix, fmt.Sprint(k)))...)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			issues = append(issues, invalidDurations(v.Index(i), fmt.Sprintf("%s[%d]", prefix, i))...)
+		}
+	}
+	return issues
+}
+
+func isDurationKey(key string) bool {
+	for _, suffix := range durationKeySuffixes {
+		if key == suffix || strings.HasSuffix(key, "_"+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// closestKey returns the known key within edit distance 2 of key, if any.
+func closestKey(key string, fields map[string]reflect.StructField) string {
+	best, bestDist := "", 3
+	for _, k := range sortedKeys(fields) {
+		if d := editDistance(key, k); d < bestDist {
+			best, bestDist = k, d
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
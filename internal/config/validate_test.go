@@ -0,0 +1,177 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func issueKeys(issues []SettingsIssue) map[string]SettingsIssue {
+	m := make(map[string]SettingsIssue)
+	for _, i := range issues {
+		m[i.Key] = i
+	}
+	return m
+}
+
+func TestValidateTownSettingsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{
+  "type": "town-settings",
+  "version": 1,
+  "cli_theme": "dark",
+  "scheduler": {"max_polecat": 5, "spawn_delay": "2 seconds"},
+  "web_timeouts": {"cmd_timeout": "15s", "default_run_timeout": "2m", "max_run_timeout": "1m"},
+  "worker_status": {"stale_threshold": "30m", "stuck_threshold": "5m"},
+  "role_effort": {"mayor": "extreme"},
+  "unheard_of": true
+}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := ValidateTownSettingsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := issueKeys(issues)
+	for _, key := range []string{
+		"scheduler.max_polecat",
+		"scheduler.spawn_delay",
+		"web_timeouts.default_run_timeout",
+		"worker_status.stale_threshold",
+		"role_effort.mayor",
+		"unheard_of",
+	} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("missing issue for %s; got %v", key, issues)
+		}
+	}
+	if msg := got["scheduler.max_polecat"].Message; !strings.Contains(msg, `did you mean "max_polecats"`) {
+		t.Errorf("no suggestion for typo: %q", msg)
+	}
+	if _, ok := got["web_timeouts.cmd_timeout"]; ok {
+		t.Error("valid duration reported")
+	}
+	if !HasErrors(issues) {
+		t.Error("HasErrors = false")
+	}
+}
+
+func TestValidateTownSettingsFile_RoutingRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{
+  "type": "town-settings",
+  "version": 1,
+  "scheduler": {"rules": [{"name": "broken", "title_pattern": "(", "rig_name": "gastown"}]}
+}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A bad pattern must not stop the file from loading, or the CLI could
+	// not repair it.
+	if _, err := LoadOrCreateTownSettings(path); err != nil {
+		t.Fatalf("settings with a bad title_pattern failed to load: %v", err)
+	}
+	issues, err := ValidateTownSettingsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := issueKeys(issues)
+	if msg := got["scheduler.rules"].Message; !strings.Contains(msg, "invalid title_pattern") {
+		t.Errorf("scheduler.rules issue = %q, want invalid title_pattern; got %v", msg, issues)
+	}
+	if _, ok := got["scheduler.rules[0].rig_name"]; !ok {
+		t.Errorf("missing issue for typo key in a rule; got %v", issues)
+	}
+}
+
+func TestValidateTownSettingsFile_ValidAndMissing(t *testing.T) {
+	dir := t.TempDir()
+	if issues, err := ValidateTownSettingsFile(filepath.Join(dir, "missing.json")); err != nil || len(issues) != 0 {
+		t.Errorf("missing file: issues=%v err=%v", issues, err)
+	}
+
+	path := filepath.Join(dir, "config.json")
+	data := `{"type": "town-settings", "version": 1, "default_agent": "claude",
+  "agents": {"fast": {"command": "claude", "args": ["--model", "haiku"]}},
+  "role_agents": {"witness": "fast"},
+  "scheduler": {"max_polecats": 4, "batch_size": 2, "spawn_delay": "2s"}}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := ValidateTownSettingsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("valid settings reported issues: %v", issues)
+	}
+}
+
+func TestValidateTownSettingsFile_Example(t *testing.T) {
+	// The documented example must validate; its "_comment" keys are not
+	// settings and are skipped.
+	issues, err := ValidateTownSettingsFile(filepath.Join("..", "..", "docs", "examples", "town-settings.example.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if HasErrors(issues) {
+		t.Errorf("example settings reported errors: %v", issues)
+	}
+	for _, i := range issues {
+		if strings.HasPrefix(i.Key[strings.LastIndex(i.Key, ".")+1:], "_") {
+			t.Errorf("comment key reported: %v", i)
+		}
+	}
+}
+
+func TestValidateTownSettings_SchedulerConflicts(t *testing.T) {
+	maxPolecats, batch, zero := 2, 5, 0
+	s := NewTownSettings()
+	s.Scheduler = &capacity.SchedulerConfig{MaxPolecats: &maxPolecats, BatchSize: &batch, MaxBatchedBeads: &zero}
+
+	got := issueKeys(ValidateTownSettings(s))
+	if i, ok := got["scheduler.batch_size"]; !ok || !i.Warning {
+		t.Errorf("batch_size > max_polecats: got %+v, want warning", i)
+	}
+	if i, ok := got["scheduler.max_batched_beads"]; !ok || i.Warning {
+		t.Errorf("max_batched_beads 0: got %+v, want error", i)
+	}
+
+	direct := -1
+	s.Scheduler = &capacity.SchedulerConfig{MaxPolecats: &direct, AutoEnqueue: true}
+	got = issueKeys(ValidateTownSettings(s))
+	if i, ok := got["scheduler.auto_enqueue"]; !ok || !i.Warning {
+		t.Errorf("auto_enqueue in direct mode: got %+v, want warning", i)
+	}
+}
+
+func TestValidateTownSettings_UnknownAgent(t *testing.T) {
+	s := NewTownSettings()
+	s.DefaultAgent = "claud"
+	if _, ok := issueKeys(ValidateTownSettings(s))["default_agent"]; !ok {
+		t.Error("unknown default_agent not reported")
+	}
+}
+
+func TestValidateTownSettings_Webhooks(t *testing.T) {
+	s := NewTownSettings()
+	s.Webhooks = &WebhooksConfig{Endpoints: []WebhookEndpoint{
+		{URL: "https://example.com/hook", States: []string{"merged"}},
+		{URL: "example.com/hook", States: []string{"reviewed"}},
+	}}
+	got := issueKeys(ValidateTownSettings(s))
+	if _, ok := got["webhooks.endpoints[0].url"]; ok {
+		t.Error("valid URL reported")
+	}
+	for _, key := range []string{"webhooks.endpoints[1].url", "webhooks.endpoints[1].states"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("missing issue for %s; got %v", key, got)
+		}
+	}
+}
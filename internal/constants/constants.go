@@ -160,6 +160,11 @@ const (
 	// (gt-058d)
 	FileLastHandoffTS = "last_handoff_ts"
 
+	// FileCompactSnapshot names the bead holding the agent's last compaction
+	// snapshot. Written by gt tap compact-snapshot, read by gt prime after
+	// compaction to restore the snapshot without a hooked-work query.
+	FileCompactSnapshot = "compact_snapshot_bead"
+
 	// FileQuotaJSON is the quota state file in mayor/.
 	FileQuotaJSON = "quota.json"
 )
@@ -415,7 +420,8 @@ func MayorQuotaPath(townRoot string) string {
 }
 
 // DefaultRateLimitPatterns are the default patterns that indicate a session
-// is rate-limited. These are matched against tmux pane content.
+// is rate-limited. These are matched against tmux pane content and against
+// API error entries in Claude transcripts.
 // Note: patterns are compiled with (?i) for case-insensitive matching.
 // Patterns are intentionally specific to actual Claude rate-limit messages
 // to avoid false positives from agent discussion or code comments.
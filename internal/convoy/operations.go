@@ -81,8 +81,18 @@ func CheckConvoysForIssue(ctx context.Context, store beadsdk.Storage, townRoot,
 		// Continuation feed: if convoy is still open after the completion check,
 		// reactively dispatch the next ready issue. This makes convoy feeding
 		// event-driven instead of relying on polling-based patrol cycles.
+		// A convoy that just closed instead feeds the convoys waiting on it.
 		if !isConvoyClosed(ctx, store, convoyID) {
+			if on := convoyWaitingOn(ctx, store, convoyID); on != "" {
+				logger("%s: convoy %s waits on convoy %s, not feeding", caller, convoyID, on)
+				continue
+			}
 			feedNextReadyIssue(ctx, store, townRoot, convoyID, caller, logger, gtPath, isRigParked, res)
+		} else {
+			for _, next := range releasedConvoys(ctx, store, convoyID) {
+				logger("%s: convoy %s closed, feeding dependent convoy %s", caller, convoyID, next)
+				feedNextReadyIssue(ctx, store, townRoot, next, caller, logger, gtPath, isRigParked, res)
+			}
 		}
 	}
 
@@ -129,6 +139,43 @@ func isConvoyStaged(ctx context.Context, store beadsdk.Storage, convoyID string)
 	return strings.HasPrefix(string(issue.Status), "staged_")
 }
 
+// convoyWaitingOn returns an unfinished convoy that convoyID depends on
+// (gt convoy depend), or "" when it is free to be fed.
+func convoyWaitingOn(ctx context.Context, store beadsdk.Storage, convoyID string) string {
+	deps, err := store.GetDependenciesWithMetadata(ctx, convoyID)
+	if err != nil {
+		return "" // fail-open, as for staged checks
+	}
+	for _, d := range deps {
+		if string(d.DependencyType) != "blocks" || string(d.IssueType) != "convoy" {
+			continue
+		}
+		if status := string(d.Status); status != "closed" && status != "tombstone" {
+			return d.ID
+		}
+	}
+	return ""
+}
+
+// releasedConvoys returns the open convoys that depended on convoyID and
+// wait on nothing else now that it has closed.
+func releasedConvoys(ctx context.Context, store beadsdk.Storage, convoyID string) []string {
+	dependents, err := store.GetDependentsWithMetadata(ctx, convoyID)
+	if err != nil {
+		return nil
+	}
+	var released []string
+	for _, d := range dependents {
+		if string(d.DependencyType) != "blocks" || string(d.IssueType) != "convoy" || string(d.Status) != "open" {
+			continue
+		}
+		if convoyWaitingOn(ctx, store, d.ID) == "" {
+			released = append(released, d.ID)
+		}
+	}
+	return released
+}
+
 // runConvoyCheck runs `gt convoy check <convoy-id>` to check a specific convoy.
 // This is idempotent and handles already-closed convoys gracefully.
 // The context parameter enables cancellation on daemon shutdown.
@@ -490,9 +537,9 @@ func fetchCrossRigBeadStatus(townRoot string, ids []string) map[string]*beadsdk.
 		}
 
 		args := append([]string{"show", "--json"}, prefixIDs...)
-		cmd := exec.Command("bd", args...)
+		cmd := beads.Command(args...)
 		cmd.Dir = rigPath
-		util.SetDetachedProcessGroup(cmd)
+		util.SetDetachedProcessGroup(cmd.Cmd)
 		out, err := cmd.Output()
 		if err != nil {
 			continue
@@ -212,14 +212,19 @@ func (m *Manager) addLocked(name string, createBranch bool) (*CrewWorker, error)
 	// Clone the rig repo on the configured default branch.
 	// CloneBranch ensures the crew lands on the rig's default_branch even when
 	// it differs from the remote's HEAD. Falls back gracefully for new/empty repos.
+	// Borrow objects from the rig's local repo, or its shared object cache.
 	defaultBranch := m.rig.DefaultBranch()
-	if m.rig.LocalRepo != "" {
-		if err := m.git.CloneBranchWithReference(m.rig.GitURL, crewPath, defaultBranch, m.rig.LocalRepo); err != nil {
+	reference := m.rig.LocalRepo
+	if reference == "" {
+		reference = rig.ObjectCacheFor(m.rig.Path)
+	}
+	if reference != "" {
+		if err := m.git.CloneBranchWithReference(m.rig.GitURL, crewPath, defaultBranch, reference); err != nil {
 			style.PrintWarning("could not clone branch %s with reference: %v", defaultBranch, err)
 			// Try branch without reference (network fetch), then reference without branch
 			if err := m.git.CloneBranch(m.rig.GitURL, crewPath, defaultBranch); err != nil {
 				style.PrintWarning("could not clone branch %s: %v", defaultBranch, err)
-				if err := m.git.CloneWithReference(m.rig.GitURL, crewPath, m.rig.LocalRepo); err != nil {
+				if err := m.git.CloneWithReference(m.rig.GitURL, crewPath, reference); err != nil {
 					style.PrintWarning("could not clone with reference: %v", err)
 					if err := m.git.Clone(m.rig.GitURL, crewPath); err != nil {
 						return nil, fmt.Errorf("cloning rig: %w", err)
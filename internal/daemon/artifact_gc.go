@@ -0,0 +1,29 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/artifacts"
+)
+
+// artifactGCInterval is how often the heartbeat enforces artifact retention.
+// GC walks every bead's artifact directory, so it runs far less often than
+// the heartbeat itself.
+const artifactGCInterval = time.Hour
+
+// gcArtifacts removes bead artifact directories that fall outside the town's
+// artifacts retention policy.
+func (d *Daemon) gcArtifacts() {
+	if time.Since(d.lastArtifactGC) < artifactGCInterval {
+		return
+	}
+	d.lastArtifactGC = time.Now()
+
+	result := artifacts.GC(d.config.TownRoot, artifacts.LoadPolicy(d.config.TownRoot), time.Now(), false)
+	for _, r := range result.Removed {
+		d.logger.Printf("artifact_gc: removed %s (%d bytes, %s)", r.BeadID, r.Size, r.Reason)
+	}
+	for _, err := range result.Errors {
+		d.logger.Printf("artifact_gc: error: %v", err)
+	}
+}
@@ -0,0 +1,346 @@
+package daemon
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// Control socket: a local JSON-RPC endpoint the CLI uses to ask a running
+// daemon to act now, instead of writing a file or sending a signal and
+// waiting for the next heartbeat to notice.
+//
+// RPCs that touch heartbeat-owned state (DispatchNow, WakeAgents) are handed
+// to the main loop and run between its other cases, so they never overlap a
+// heartbeat. Pause, GetState and Profile only touch files or the runtime and
+// answer immediately.
+//
+// Clients treat ErrControlUnavailable as "daemon not listening" and fall back
+// to the file-based path, so every RPC must remain optional.
+
+// controlServiceName is the net/rpc service name for the control API.
+const controlServiceName = "Control"
+
+// ErrControlUnavailable is returned by DialControl when no daemon is
+// listening on the control socket.
+var ErrControlUnavailable = errors.New("daemon control socket unavailable")
+
+// ControlSocketPath returns the path of the daemon control socket.
+func ControlSocketPath(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "control.sock")
+}
+
+// DispatchArgs are the arguments to Control.DispatchNow.
+type DispatchArgs struct{}
+
+// DispatchReply is the result of Control.DispatchNow.
+type DispatchReply struct {
+	// Deferred is set when dispatch was skipped, with the reason.
+	Deferred string `json:"deferred,omitempty"`
+}
+
+// PauseArgs are the arguments to Control.Pause.
+type PauseArgs struct {
+	Paused bool   `json:"paused"`
+	Actor  string `json:"actor"`
+}
+
+// PauseReply is the result of Control.Pause.
+type PauseReply struct {
+	// Changed is false when the scheduler was already in the requested state.
+	Changed  bool   `json:"changed"`
+	PausedBy string `json:"paused_by,omitempty"`
+}
+
+// WakeArgs are the arguments to Control.WakeAgents.
+type WakeArgs struct{}
+
+// WakeReply is the result of Control.WakeAgents.
+type WakeReply struct {
+	// LimitWoken is how many rate-limit-stalled polecats were nudged.
+	LimitWoken int `json:"limit_woken"`
+	// SleepEnded is true when the daemon was in its energy saver quiet
+	// period and stays awake until the period ends.
+	SleepEnded bool `json:"sleep_ended,omitempty"`
+}
+
+// StateArgs are the arguments to Control.GetState.
+type StateArgs struct{}
+
+// StateReply is the result of Control.GetState.
+type StateReply struct {
+	State
+	SchedulerPaused bool   `json:"scheduler_paused"`
+	PausedBy        string `json:"paused_by,omitempty"`
+}
+
+// ProfileArgs are the arguments to Control.Profile.
+type ProfileArgs struct {
+	// Name is a runtime/pprof profile (goroutine, heap, allocs, block,
+	// mutex, threadcreate) or "cpu".
+	Name string `json:"name"`
+	// Seconds is how long a cpu profile samples. Default: 10.
+	Seconds int `json:"seconds,omitempty"`
+	// Debug selects the output format as in pprof.Profile.WriteTo: 0 is the
+	// binary protobuf go tool pprof reads, 1 and 2 are text.
+	Debug int `json:"debug,omitempty"`
+}
+
+// ProfileReply is the result of Control.Profile.
+type ProfileReply struct {
+	Data []byte `json:"data"`
+}
+
+// maxCPUProfileSeconds bounds a cpu profile so one RPC can't hold the
+// profiler indefinitely.
+const maxCPUProfileSeconds = 120
+
+// controlRequest is an RPC body waiting to run on the daemon's main loop.
+type controlRequest struct {
+	fn   func()
+	done chan struct{}
+}
+
+// controlService implements the Control RPCs.
+type controlService struct {
+	d *Daemon
+}
+
+// onLoop runs fn on the daemon's main loop and waits for it to finish.
+func (s *controlService) onLoop(fn func()) error {
+	req := controlRequest{fn: fn, done: make(chan struct{})}
+	select {
+	case s.d.controlCh <- req:
+	case <-s.d.ctx.Done():
+		return errors.New("daemon shutting down")
+	}
+	<-req.done
+	return nil
+}
+
+// DispatchNow runs scheduler dispatch immediately, subject to the same
+// shutdown, deep sleep, snooze and pressure gates as the heartbeat.
+func (s *controlService) DispatchNow(_ DispatchArgs, reply *DispatchReply) error {
+	return s.onLoop(func() {
+		if s.d.isShutdownInProgress() {
+			reply.Deferred = "shutdown in progress"
+			return
+		}
+		if s.d.inDeepSleep() {
+			reply.Deferred = "energy saver deep sleep (wake with gt daemon wake)"
+			return
+		}
+		if until, ok := s.d.limitsSnoozedUntil(); ok {
+			reply.Deferred = "limits snoozed until " + until.Format(time.RFC3339)
+			return
+		}
+		if p := s.d.checkPressure("polecat"); !p.OK {
+			reply.Deferred = p.Reason
+			return
+		}
+		s.d.logger.Println("Control: dispatching scheduled work")
+		s.d.dispatchQueuedWork()
+	})
+}
+
+// WakeAgents processes pending lifecycle requests and nudges polecats whose
+// rate limit has reset, without waiting for the next heartbeat. It also ends
+// an energy saver deep sleep.
+func (s *controlService) WakeAgents(_ WakeArgs, reply *WakeReply) error {
+	return s.onLoop(func() {
+		s.d.logger.Println("Control: waking agents")
+		reply.SleepEnded = s.d.wakeFromDeepSleep("gt daemon wake")
+		s.d.processLifecycleRequests()
+		reply.LimitWoken = s.d.wakeLimitStalledPolecats()
+	})
+}
+
+// Pause pauses or resumes scheduler dispatch. The state file is updated
+// under the scheduler state lock, so a dispatch cycle saving its own changes
+// at the same time can't undo it.
+func (s *controlService) Pause(args PauseArgs, reply *PauseReply) error {
+	state, err := capacity.UpdateState(s.d.config.TownRoot, func(state *capacity.SchedulerState) (bool, error) {
+		if state.Paused == args.Paused {
+			return false, nil
+		}
+		if args.Paused {
+			state.SetPaused(args.Actor)
+		} else {
+			state.SetResumed()
+		}
+		reply.Changed = true
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("updating scheduler state: %w", err)
+	}
+	if reply.Changed {
+		s.d.logger.Printf("Control: scheduler paused=%v by %s", args.Paused, args.Actor)
+	}
+	reply.PausedBy = state.PausedBy
+	return nil
+}
+
+// GetState returns the daemon and scheduler state.
+func (s *controlService) GetState(_ StateArgs, reply *StateReply) error {
+	state, err := LoadState(s.d.config.TownRoot)
+	if err != nil {
+		return fmt.Errorf("loading daemon state: %w", err)
+	}
+	reply.State = *state
+	if sched, err := capacity.LoadState(s.d.config.TownRoot); err == nil {
+		reply.SchedulerPaused = sched.Paused
+		reply.PausedBy = sched.PausedBy
+	}
+	return nil
+}
+
+// Profile captures a pprof profile of the running daemon, the same data
+// net/http/pprof would serve. It doesn't touch heartbeat state, so it runs
+// off the main loop and can profile a heartbeat that is stuck.
+func (s *controlService) Profile(args ProfileArgs, reply *ProfileReply) error {
+	var buf bytes.Buffer
+	if args.Name == "cpu" {
+		secs := args.Seconds
+		if secs <= 0 {
+			secs = 10
+		}
+		if secs > maxCPUProfileSeconds {
+			return fmt.Errorf("cpu profile of %ds exceeds the %ds limit", secs, maxCPUProfileSeconds)
+		}
+		if err := pprof.StartCPUProfile(&buf); err != nil {
+			return fmt.Errorf("starting cpu profile: %w", err)
+		}
+		select {
+		case <-time.After(time.Duration(secs) * time.Second):
+		case <-s.d.ctx.Done():
+		}
+		pprof.StopCPUProfile()
+		reply.Data = buf.Bytes()
+		return nil
+	}
+
+	p := pprof.Lookup(args.Name)
+	if p == nil {
+		return fmt.Errorf("unknown profile %q", args.Name)
+	}
+	if err := p.WriteTo(&buf, args.Debug); err != nil {
+		return fmt.Errorf("writing %s profile: %w", args.Name, err)
+	}
+	s.d.logger.Printf("Control: %s profile captured (%d bytes)", args.Name, buf.Len())
+	reply.Data = buf.Bytes()
+	return nil
+}
+
+// startControlServer listens on the control socket and serves RPCs until the
+// daemon's context is canceled. The returned listener is closed by the caller
+// on shutdown.
+func (d *Daemon) startControlServer() (net.Listener, error) {
+	path := ControlSocketPath(d.config.TownRoot)
+	// A stale socket from a crashed daemon blocks Listen. The PID file lock
+	// guarantees no other daemon owns it.
+	_ = os.Remove(path)
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		_ = l.Close()
+		return nil, err
+	}
+
+	srv := rpc.NewServer()
+	if err := srv.RegisterName(controlServiceName, &controlService{d: d}); err != nil {
+		_ = l.Close()
+		return nil, err
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return // Listener closed
+			}
+			go srv.ServeCodec(jsonrpc.NewServerCodec(conn))
+		}
+	}()
+	return l, nil
+}
+
+// ControlClient calls the control RPCs of a running daemon.
+type ControlClient struct {
+	conn   net.Conn
+	client *rpc.Client
+}
+
+// controlDialTimeout bounds how long the CLI waits before falling back.
+const controlDialTimeout = 2 * time.Second
+
+// DialControl connects to the daemon's control socket. It returns
+// ErrControlUnavailable when no daemon is listening.
+func DialControl(townRoot string) (*ControlClient, error) {
+	conn, err := net.DialTimeout("unix", ControlSocketPath(townRoot), controlDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrControlUnavailable, err)
+	}
+	return &ControlClient{conn: conn, client: jsonrpc.NewClient(conn)}, nil
+}
+
+// Close closes the connection.
+func (c *ControlClient) Close() error {
+	return c.client.Close()
+}
+
+// call invokes method with a deadline.
+func (c *ControlClient) call(method string, args, reply interface{}, timeout time.Duration) error {
+	_ = c.conn.SetDeadline(time.Now().Add(timeout))
+	return c.client.Call(controlServiceName+"."+method, args, reply)
+}
+
+// DispatchNow asks the daemon to dispatch scheduled work immediately. It
+// waits for dispatch to finish, which may take as long as gt scheduler run.
+func (c *ControlClient) DispatchNow() (*DispatchReply, error) {
+	var reply DispatchReply
+	// Queued behind a running heartbeat, then up to dispatchQueuedWork's own 5m timeout.
+	return &reply, c.call("DispatchNow", DispatchArgs{}, &reply, 15*time.Minute)
+}
+
+// WakeAgents asks the daemon to process lifecycle requests and wake
+// limit-stalled polecats immediately.
+func (c *ControlClient) WakeAgents() (*WakeReply, error) {
+	var reply WakeReply
+	return &reply, c.call("WakeAgents", WakeArgs{}, &reply, 15*time.Minute)
+}
+
+// Pause pauses (or, with paused=false, resumes) scheduler dispatch.
+func (c *ControlClient) Pause(paused bool, actor string) (*PauseReply, error) {
+	var reply PauseReply
+	return &reply, c.call("Pause", PauseArgs{Paused: paused, Actor: actor}, &reply, 10*time.Second)
+}
+
+// GetState returns the running daemon's state.
+func (c *ControlClient) GetState() (*StateReply, error) {
+	var reply StateReply
+	return &reply, c.call("GetState", StateArgs{}, &reply, 10*time.Second)
+}
+
+// Profile captures a pprof profile from the running daemon; see
+// ProfileArgs for names and formats.
+func (c *ControlClient) Profile(name string, seconds, debug int) ([]byte, error) {
+	var reply ProfileReply
+	timeout := 30 * time.Second
+	if name == "cpu" {
+		timeout += time.Duration(max(seconds, 10)) * time.Second
+	}
+	err := c.call("Profile", ProfileArgs{Name: name, Seconds: seconds, Debug: debug}, &reply, timeout)
+	return reply.Data, err
+}
@@ -0,0 +1,138 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+func newControlTestDaemon(t *testing.T) *Daemon {
+	t.Helper()
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &Daemon{
+		config:    DefaultConfig(townRoot),
+		logger:    log.New(io.Discard, "", 0),
+		ctx:       ctx,
+		cancel:    cancel,
+		controlCh: make(chan controlRequest),
+	}
+}
+
+func TestDialControl_Unavailable(t *testing.T) {
+	_, err := DialControl(t.TempDir())
+	if !errors.Is(err, ErrControlUnavailable) {
+		t.Fatalf("DialControl without a daemon = %v, want ErrControlUnavailable", err)
+	}
+}
+
+func TestControlPauseAndGetState(t *testing.T) {
+	d := newControlTestDaemon(t)
+	townRoot := d.config.TownRoot
+	if err := SaveState(townRoot, &State{Running: true, PID: 42, HeartbeatCount: 7}); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := d.startControlServer()
+	if err != nil {
+		t.Fatalf("startControlServer: %v", err)
+	}
+	defer l.Close()
+
+	c, err := DialControl(townRoot)
+	if err != nil {
+		t.Fatalf("DialControl: %v", err)
+	}
+	defer c.Close()
+
+	reply, err := c.Pause(true, "mayor")
+	if err != nil || !reply.Changed || reply.PausedBy != "mayor" {
+		t.Fatalf("Pause(true) = %+v, %v", reply, err)
+	}
+	if reply, err := c.Pause(true, "deacon"); err != nil || reply.Changed || reply.PausedBy != "mayor" {
+		t.Errorf("second Pause(true) = %+v, %v; want unchanged, still paused by mayor", reply, err)
+	}
+	if sched, err := capacity.LoadState(townRoot); err != nil || !sched.Paused {
+		t.Errorf("scheduler state after pause = %+v, %v", sched, err)
+	}
+
+	state, err := c.GetState()
+	if err != nil {
+		t.Fatalf("GetState: %v", err)
+	}
+	if !state.Running || state.PID != 42 || state.HeartbeatCount != 7 || !state.SchedulerPaused {
+		t.Errorf("GetState = %+v", state)
+	}
+
+	if reply, err := c.Pause(false, "mayor"); err != nil || !reply.Changed {
+		t.Errorf("Pause(false) = %+v, %v", reply, err)
+	}
+	if sched, _ := capacity.LoadState(townRoot); sched.Paused {
+		t.Error("scheduler still paused after resume")
+	}
+}
+
+func TestControlOnLoopRunsOnMainLoop(t *testing.T) {
+	d := newControlTestDaemon(t)
+	s := &controlService{d: d}
+
+	ran := make(chan bool, 1)
+	go func() {
+		req := <-d.controlCh
+		req.fn()
+		close(req.done)
+	}()
+	if err := s.onLoop(func() { ran <- true }); err != nil {
+		t.Fatalf("onLoop: %v", err)
+	}
+	select {
+	case <-ran:
+	default:
+		t.Fatal("request was not run by the loop before onLoop returned")
+	}
+
+	d.cancel()
+	if err := s.onLoop(func() {}); err == nil {
+		t.Error("onLoop should fail once the daemon is shutting down")
+	}
+}
+
+func TestControlProfile(t *testing.T) {
+	d := newControlTestDaemon(t)
+	l, err := d.startControlServer()
+	if err != nil {
+		t.Fatalf("startControlServer: %v", err)
+	}
+	defer l.Close()
+
+	c, err := DialControl(d.config.TownRoot)
+	if err != nil {
+		t.Fatalf("DialControl: %v", err)
+	}
+	defer c.Close()
+
+	data, err := c.Profile("goroutine", 0, 1)
+	if err != nil {
+		t.Fatalf("Profile(goroutine): %v", err)
+	}
+	if !strings.Contains(string(data), "goroutine profile:") {
+		t.Errorf("goroutine profile = %.80q, want text profile", data)
+	}
+	if data, err := c.Profile("heap", 0, 0); err != nil || len(data) == 0 {
+		t.Errorf("Profile(heap) = %d bytes, %v", len(data), err)
+	}
+	if _, err := c.Profile("nonsense", 0, 0); err == nil {
+		t.Error("Profile(nonsense) should fail")
+	}
+}
@@ -30,6 +30,10 @@ const (
 	// auto-close. This prevents a race where the daemon's stranded scan
 	// fires before the sling's bd dep add is visible in Dolt. See GH#2303.
 	convoyGracePeriod = 5 * time.Minute
+
+	// convoySweepInterval is how often the stranded scan also runs
+	// gt convoy sweep to apply the convoy lifecycle policy.
+	convoySweepInterval = 10 * time.Minute
 )
 
 // strandedConvoyInfo matches the JSON output of `gt convoy stranded --json`.
@@ -91,6 +95,17 @@ type ConvoyManager struct {
 	// duplicate convoy checks for the same stranded convoy.
 	scanMu sync.Mutex
 
+	// lastSweep is when scan last ran gt convoy sweep. Protected by scanMu.
+	lastSweep time.Time
+
+	// onClose, if set, is called once per detected issue close (after
+	// dedup), before the convoy checks. Set via SetCloseHook before Start.
+	onClose func(issueID string)
+
+	// isPaused, if set, makes the poll and scan loops skip their ticks while
+	// it returns true (energy saver deep sleep). Set via SetPauseCheck before Start.
+	isPaused func() bool
+
 	// lastEventIDs tracks per-store high-water marks for event polling.
 	// Key matches stores map keys ("hq", "gastown", etc.).
 	lastEventIDs sync.Map // map[string]time.Time
@@ -143,6 +158,24 @@ func NewConvoyManager(townRoot string, logger func(format string, args ...interf
 	}
 }
 
+// SetCloseHook registers fn to be called for each issue close the event
+// poll detects. Must be called before Start.
+func (m *ConvoyManager) SetCloseHook(fn func(issueID string)) {
+	m.onClose = fn
+}
+
+// SetPauseCheck registers fn to be consulted on each event poll and
+// stranded scan tick; ticks are skipped while it returns true. Must be
+// called before Start.
+func (m *ConvoyManager) SetPauseCheck(fn func() bool) {
+	m.isPaused = fn
+}
+
+// paused reports whether the pause check asks to skip this tick.
+func (m *ConvoyManager) paused() bool {
+	return m.isPaused != nil && m.isPaused()
+}
+
 // Start begins the convoy manager goroutines (event poll + stranded scan).
 // It is safe to call multiple times; subsequent calls are no-ops.
 func (m *ConvoyManager) Start() error {
@@ -205,6 +238,9 @@ func (m *ConvoyManager) runEventPoll() {
 		case <-m.ctx.Done():
 			return
 		case <-ticker.C:
+			if m.paused() {
+				continue
+			}
 			m.storesMu.Lock()
 			// Lazy store initialization: retry if stores not yet available
 			if len(m.stores) == 0 {
@@ -381,6 +417,9 @@ func (m *ConvoyManager) pollStore(name string, store beadsdk.Storage, stores map
 		}
 
 		m.logger("Convoy: close detected: %s (from %s)", issueID, name)
+		if m.onClose != nil {
+			m.onClose(issueID)
+		}
 		resolver := convoy.NewStoreResolver(m.townRoot, stores)
 		convoy.CheckConvoysForIssue(m.ctx, hqStore, m.townRoot, issueID, "Convoy", m.logger, m.gtPath, m.isRigParked, resolver)
 	}
@@ -451,6 +490,9 @@ func (m *ConvoyManager) runStrandedScan() {
 		case <-m.ctx.Done():
 			return
 		case <-ticker.C:
+			if m.paused() {
+				continue
+			}
 			// While in recovery mode, shorten the next tick so we retry quickly
 			// after a Dolt outage without waiting the full scan interval.
 			if m.recoveryMode.Load() {
@@ -503,6 +545,44 @@ func (m *ConvoyManager) scan() {
 			m.checkConvoyCompletion(c.ID)
 		}
 	}
+
+	if time.Since(m.lastSweep) >= convoySweepInterval {
+		m.lastSweep = time.Now()
+		m.sweep()
+	}
+}
+
+// sweep runs gt convoy sweep, which closes empty, completed, and (if
+// convoy.stale_ttl is set) stale convoys per the town's lifecycle policy.
+func (m *ConvoyManager) sweep() {
+	cmd := exec.CommandContext(m.ctx, m.gtPath, "convoy", "sweep", "--json")
+	cmd.Dir = m.townRoot
+	util.SetProcessGroup(cmd)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		m.logger("Convoy: sweep failed: %s", util.FirstLine(stderr.String()))
+		return
+	}
+
+	var actions []struct {
+		ID     string `json:"id"`
+		Reason string `json:"reason"`
+		Closed bool   `json:"closed"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &actions); err != nil {
+		m.logger("Convoy: parsing sweep JSON: %v", err)
+		return
+	}
+	for _, a := range actions {
+		if a.Closed {
+			m.logger("Convoy %s: swept (%s)", a.ID, a.Reason)
+		} else {
+			m.logger("Convoy %s: sweep close failed (%s)", a.ID, a.Reason)
+		}
+	}
 }
 
 // findStranded runs `gt convoy stranded --json` and parses the output.
@@ -44,6 +44,7 @@ type scanTestOpts struct {
 	strandedJSON  string // JSON for `gt convoy stranded --json`; default "[]"
 	slingFailOnce bool   // first sling invocation exits 1, subsequent succeed
 	routes        string // routes.jsonl content; empty = no routes file
+	sweepJSON     string // JSON for `gt convoy sweep --json`; default "[]"
 }
 
 // scanTestPaths holds paths created by mockGtForScanTest.
@@ -52,6 +53,7 @@ type scanTestPaths struct {
 	townRoot     string
 	slingLogPath string // sling call log; absent if sling was never called
 	checkLogPath string // convoy check call log; absent if check was never called
+	sweepLogPath string // convoy sweep call log; absent if sweep was never called
 }
 
 // mockGtForScanTest creates a mock gt binary and directory layout for scan tests.
@@ -76,8 +78,14 @@ func mockGtForScanTest(t *testing.T, opts scanTestOpts) scanTestPaths {
 		strandedJSON = "[]"
 	}
 
+	sweepJSON := opts.sweepJSON
+	if sweepJSON == "" {
+		sweepJSON = "[]"
+	}
+
 	slingLogPath := filepath.Join(binDir, "sling.log")
 	checkLogPath := filepath.Join(binDir, "check.log")
+	sweepLogPath := filepath.Join(binDir, "sweep.log")
 
 	slingFailClause := ""
 	if opts.slingFailOnce {
@@ -102,6 +110,11 @@ if [ "$1" = "convoy" ] && [ "$2" = "check" ]; then
   echo "$@" >> "` + checkLogPath + `"
   exit 0
 fi
+if [ "$1" = "convoy" ] && [ "$2" = "sweep" ]; then
+  echo "$@" >> "` + sweepLogPath + `"
+  echo '` + strings.ReplaceAll(sweepJSON, "'", "'\\''") + `'
+  exit 0
+fi
 exit 0
 `
 
@@ -115,6 +128,7 @@ exit 0
 		townRoot:     townRoot,
 		slingLogPath: slingLogPath,
 		checkLogPath: checkLogPath,
+		sweepLogPath: sweepLogPath,
 	}
 }
 
@@ -279,6 +293,33 @@ func TestScanStranded_ClosesEmptyConvoys(t *testing.T) {
 	}
 }
 
+func TestScan_SweepsOncePerInterval(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skipping on Windows")
+	}
+
+	paths := mockGtForScanTest(t, scanTestOpts{
+		sweepJSON: `[{"id":"hq-done1","title":"Done","reason":"All tracked issues completed","closed":true}]`,
+	})
+
+	var logs []string
+	logger := func(format string, args ...interface{}) { logs = append(logs, fmt.Sprintf(format, args...)) }
+	m := NewConvoyManager(paths.townRoot, logger, "gt", 10*time.Minute, nil, nil, nil)
+	m.scan()
+	m.scan() // within convoySweepInterval: no second sweep
+
+	data, err := os.ReadFile(paths.sweepLogPath)
+	if err != nil {
+		t.Fatalf("read sweep log: %v", err)
+	}
+	if n := strings.Count(string(data), "convoy sweep --json"); n != 1 {
+		t.Errorf("sweep ran %d times, want 1: %q", n, data)
+	}
+	if !strings.Contains(strings.Join(logs, "\n"), "Convoy hq-done1: swept (All tracked issues completed)") {
+		t.Errorf("swept convoy not logged: %v", logs)
+	}
+}
+
 func TestScanStranded_GracePeriodSkipsRecentConvoy(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("skipping on Windows")
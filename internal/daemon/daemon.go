@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofrs/flock"
@@ -40,6 +41,7 @@ import (
 	"github.com/steveyegge/gastown/internal/telemetry"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/webhook"
 	"github.com/steveyegge/gastown/internal/wisp"
 	"github.com/steveyegge/gastown/internal/witness"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -62,6 +64,9 @@ type Daemon struct {
 	doltServer    *DoltServerManager
 	krcPruner     *KRCPruner
 
+	// webhooks posts bead lifecycle transitions; nil when none configured.
+	webhooks *webhook.Dispatcher
+
 	// disabledPatrols is loaded from town settings (disabled_patrols field).
 	// Provides a simple way to disable individual patrol dogs without editing
 	// mayor/daemon.json. Checked by isPatrolActive alongside patrolConfig.
@@ -74,7 +79,7 @@ type Daemon struct {
 	// Deacon startup tracking: prevents race condition where newly started
 	// sessions are immediately killed by the heartbeat check.
 	// See: https://github.com/steveyegge/gastown/issues/567
-	// Note: Only accessed from heartbeat loop goroutine - no sync needed.
+	// Note: Only accessed from the heartbeat's agents lane - no sync needed.
 	deaconLastStarted time.Time
 
 	// syncFailures tracks consecutive git pull failures per workdir.
@@ -87,7 +92,7 @@ type Daemon struct {
 	bdPath string
 
 	// Boot spawn cooldown: prevents Boot from spawning on every heartbeat tick.
-	// Only accessed from heartbeat loop goroutine - no sync needed.
+	// Only accessed from the heartbeat's agents lane - no sync needed.
 	bootLastSpawned time.Time
 
 	// Restart tracking with exponential backoff to prevent crash loops
@@ -107,6 +112,41 @@ type Daemon struct {
 	// Only accessed from heartbeat loop goroutine - no sync needed.
 	lastDoctorMolTime time.Time
 
+	// lastLimitWake tracks when each limit-stalled polecat session was last
+	// nudged, so a session that stays at the prompt isn't nudged (and dispatch
+	// deferred) every heartbeat.
+	// Only accessed from the heartbeat's polecats lane - no sync needed.
+	lastLimitWake map[string]time.Time
+
+	// limitStalledSince tracks when each polecat session was first seen at a
+	// rate-limit prompt, to report limit downtime when it is woken.
+	// Only accessed from the heartbeat's polecats lane - no sync needed.
+	limitStalledSince map[string]time.Time
+
+	// lastRebaseNudge tracks when each polecat session was last nudged about
+	// a branch behind its base. Rigs are checked in parallel, so guarded by
+	// rebaseNudgeMu.
+	rebaseNudgeMu   sync.Mutex
+	lastRebaseNudge map[string]time.Time
+
+	// lease is this instance's leader lease. leaseLost is set when another
+	// daemon takes the lease over, so shutdown leaves the shared Dolt server
+	// and state file to the new leader.
+	lease     *leaseHolder
+	leaseLost atomic.Bool
+
+	// lastArtifactGC tracks when bead artifact retention last ran.
+	// Only accessed from the heartbeat's cleanup lane - no sync needed.
+	lastArtifactGC time.Time
+
+	// deepSleep is set during the energy saver quiet period; see
+	// energy_saver.go. Read by the convoy manager's goroutines.
+	deepSleep atomic.Bool
+
+	// sleepWokenUntil suppresses deep sleep until the end of a quiet period
+	// after a wake signal. Only accessed from the main loop - no sync needed.
+	sleepWokenUntil time.Time
+
 	// lastMaintenanceRun tracks when scheduled maintenance last ran.
 	// Only accessed from heartbeat loop goroutine - no sync needed.
 	lastMaintenanceRun time.Time
@@ -114,7 +154,7 @@ type Daemon struct {
 	// mayorZombieCount tracks consecutive patrol cycles where the Mayor tmux
 	// session exists but the agent process is not detected. A count >= 3
 	// triggers a zombie restart, debouncing transient gaps during handoffs.
-	// Only accessed from heartbeat loop goroutine - no sync needed.
+	// Only accessed from the heartbeat's agents lane - no sync needed.
 	mayorZombieCount int
 
 	// rigPool runs per-rig heartbeat operations (witness checks, refinery checks,
@@ -126,9 +166,14 @@ type Daemon struct {
 	// duration of a single heartbeat tick. ~10 call sites per tick otherwise
 	// re-read and re-parse the same file. Invalidated at the start of each
 	// heartbeat so rigs.json changes between ticks are picked up.
-	// Only accessed from heartbeat loop goroutine - no sync needed.
+	// Guarded by knownRigsMu: heartbeat lanes read it concurrently.
+	knownRigsMu         sync.Mutex
 	knownRigsCache      []string
 	knownRigsCacheValid bool
+
+	// controlCh carries control socket requests that must run on the main
+	// loop (see control.go), serialized with heartbeats and patrol dogs.
+	controlCh chan controlRequest
 }
 
 // sessionDeath records a detected session death for mass death analysis.
@@ -333,6 +378,7 @@ func New(config *Config) (*Daemon, error) {
 		otelProvider:    otelProvider,
 		metrics:         dm,
 		rigPool:         newRigWorkerPool(0, 0, logger), // defaults: 10 workers, 30s timeout
+		controlCh:       make(chan controlRequest),
 	}, nil
 }
 
@@ -352,22 +398,24 @@ func (d *Daemon) Run() (err error) {
 		d.logger.Printf("Daemon startup failed (PID %d): %v", pid, err)
 	}()
 
-	// Acquire exclusive lock to prevent multiple daemons from running.
-	// This prevents the TOCTOU race condition where multiple concurrent starts
-	// can all pass the IsRunning() check before any writes the PID file.
-	// Uses gofrs/flock for cross-platform compatibility (Unix + Windows).
-	lockFile := filepath.Join(d.config.TownRoot, "daemon", "daemon.lock")
-	fileLock := flock.New(lockFile)
-
-	// Try to acquire exclusive lock (non-blocking)
-	locked, err := fileLock.TryLock()
+	// Become the town's leader: the leader lease, then the exclusive
+	// daemon.lock that keeps concurrent starts from racing past IsRunning().
+	release, err := d.lead()
 	if err != nil {
-		return fmt.Errorf("acquiring lock: %w", err)
+		return err
 	}
-	if !locked {
-		return fmt.Errorf("daemon already running (lock held by another process)")
+	defer release()
+
+	// Count this start toward crash loop detection, and stop here if the
+	// daemon keeps dying. A clean return marks the exit clean.
+	if err := d.checkCrashLoop(); err != nil {
+		return err
 	}
-	defer func() { _ = fileLock.Unlock() }()
+	defer func() {
+		if err == nil {
+			d.recordExit()
+		}
+	}()
 
 	// Pre-flight check: all rigs must be on Dolt backend.
 	if err := d.checkAllRigsDolt(); err != nil {
@@ -398,6 +446,17 @@ func (d *Daemon) Run() (err error) {
 		d.logger.Printf("Warning: failed to save state: %v", err)
 	}
 
+	// Serve the control socket so the CLI can trigger dispatch, pause, and
+	// wake directly. Non-fatal: clients fall back to files and signals.
+	if l, err := d.startControlServer(); err != nil {
+		d.logger.Printf("Warning: control socket unavailable: %v", err)
+	} else {
+		defer func() {
+			_ = l.Close()
+			_ = os.Remove(ControlSocketPath(d.config.TownRoot))
+		}()
+	}
+
 	// Handle signals
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, daemonSignals()...)
@@ -440,6 +499,23 @@ func (d *Daemon) Run() (err error) {
 		}
 	}
 	d.convoyManager = NewConvoyManager(d.config.TownRoot, d.logger.Printf, d.gtPath, 0, d.beadsStores, storeOpener, isRigParked)
+	d.convoyManager.SetPauseCheck(d.inDeepSleep)
+
+	// Start bead lifecycle webhooks. Closes come from the convoy manager's
+	// beads event poll; the other transitions from the events log.
+	if ts, err := agentconfig.LoadOrCreateTownSettings(agentconfig.TownSettingsPath(d.config.TownRoot)); err == nil {
+		d.webhooks = webhook.NewDispatcher(d.config.TownRoot, ts.Webhooks, d.logger.Printf)
+	}
+	if d.webhooks != nil {
+		if err := d.webhooks.Start(); err != nil {
+			d.logger.Printf("Warning: failed to start webhooks: %v", err)
+			d.webhooks = nil
+		} else {
+			d.convoyManager.SetCloseHook(d.webhooks.NotifyClosed)
+			d.logger.Println("Webhook dispatcher started")
+		}
+	}
+
 	if err := d.convoyManager.Start(); err != nil {
 		d.logger.Printf("Warning: failed to start convoy manager: %v", err)
 	} else {
@@ -606,6 +682,18 @@ func (d *Daemon) Run() (err error) {
 		d.logger.Printf("Quota dog ticker started (interval %v)", interval)
 	}
 
+	// Start worktree pool ticker if configured.
+	// Keeps pre-warmed polecat worktrees ready so spawns skip git worktree add.
+	var worktreePoolTicker *time.Ticker
+	var worktreePoolChan <-chan time.Time
+	if d.isPatrolActive("worktree_pool") {
+		interval := worktreePoolInterval(d.patrolConfig)
+		worktreePoolTicker = time.NewTicker(interval)
+		worktreePoolChan = worktreePoolTicker.C
+		defer worktreePoolTicker.Stop()
+		d.logger.Printf("Worktree pool ticker started (interval %v)", interval)
+	}
+
 	// Note: PATCH-010 uses per-session hooks in deacon/manager.go (SetAutoRespawnHook).
 	// Global pane-died hooks don't fire reliably in tmux 3.2a, so we rely on the
 	// per-session approach which has been tested to work for continuous recovery.
@@ -624,6 +712,7 @@ func (d *Daemon) Run() (err error) {
 			if isLifecycleSignal(sig) {
 				// Lifecycle signal: immediate lifecycle processing (from gt handoff)
 				d.logger.Println("Received lifecycle signal, processing lifecycle requests immediately")
+				d.wakeFromDeepSleep("lifecycle signal")
 				d.processLifecycleRequests()
 			} else if isReloadRestartSignal(sig) {
 				// Reload restart tracker from disk (from 'gt daemon clear-backoff')
@@ -641,81 +730,94 @@ func (d *Daemon) Run() (err error) {
 		case <-doltHealthChan:
 			// Dedicated Dolt health check — fast crash detection independent
 			// of the 3-minute general heartbeat.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.ensureDoltServerRunning()
 			}
 
 		case <-doltRemotesChan:
 			// Periodic Dolt remote push — pushes databases to their configured
 			// git remotes on a 15-minute cadence (independent of heartbeat).
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.pushDoltRemotes()
 			}
 
 		case <-doltBackupChan:
 			// Periodic Dolt filesystem backup — syncs production databases to
 			// local backup directory on a 15-minute cadence.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.syncDoltBackups()
 			}
 
 		case <-jsonlGitBackupChan:
 			// Periodic JSONL git backup — exports issues, scrubs ephemeral data,
 			// commits and pushes to git repo.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.syncJsonlGitBackup()
 			}
 
 		case <-wispReaperChan:
 			// Periodic wisp reaper — closes stale wisps (abandoned molecule steps,
 			// old patrol data) to prevent unbounded table growth (Clown Show audit).
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.reapWisps()
 			}
 
 		case <-doctorDogChan:
 			// Doctor dog — comprehensive Dolt health monitor: connectivity, latency,
 			// gc, zombie detection, backup staleness, and disk usage checks.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.runDoctorDog()
 			}
 
 		case <-compactorDogChan:
 			// Compactor dog — flattens Dolt commit history on production databases.
 			// Reclaims commit graph storage, then runs gc to reclaim chunks.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.runCompactorDog()
 			}
 
 		case <-checkpointDogChan:
 			// Checkpoint dog — auto-commits WIP changes in active polecat
 			// worktrees to prevent data loss from session crashes.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.runCheckpointDog()
 			}
 
 		case <-scheduledMaintenanceChan:
 			// Scheduled maintenance — checks if we're in the maintenance window
 			// and runs `gt maintain --force` when commit counts exceed threshold.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.runScheduledMaintenance()
 			}
 
 		case <-mainBranchTestChan:
 			// Main branch test runner — periodically runs quality gates on each
 			// rig's main branch to catch regressions from merges or direct pushes.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.runMainBranchTests()
 			}
 
 		case <-quotaDogChan:
 			// Quota dog — scans for rate-limited sessions and automatically
 			// rotates credentials to available accounts via keychain swap.
-			if !d.isShutdownInProgress() {
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
 				d.runQuotaDog()
 			}
 
+		case <-worktreePoolChan:
+			// Worktree pool — refills each rig's pre-warmed polecat worktrees
+			// while the system is idle, so dispatch leases instead of checking out.
+			if !d.isShutdownInProgress() && !d.inDeepSleep() {
+				d.runWorktreePool()
+			}
+
+		case req := <-d.controlCh:
+			// Control socket request (gt daemon dispatch, gt daemon wake).
+			req.fn()
+			close(req.done)
+
 		case <-timer.C:
+			d.updateDeepSleep(time.Now())
 			d.heartbeat(state)
 
 			// Fixed recovery interval (no activity-based backoff)
@@ -740,6 +842,8 @@ func (d *Daemon) recoveryHeartbeatInterval() time.Duration {
 // - Agents with work-on-hook not progressing (GUPP violation)
 // - Orphaned work (assigned to dead agents)
 func (d *Daemon) heartbeat(state *State) {
+	defer d.recordHeartbeatPanic()
+
 	// Skip heartbeat if shutdown is in progress.
 	// This prevents the daemon from fighting shutdown by auto-restarting killed agents.
 	// The shutdown.lock file is created by gt down before terminating sessions.
@@ -757,6 +861,13 @@ func (d *Daemon) heartbeat(state *State) {
 		return
 	}
 
+	// Skip everything during the energy saver quiet period. Dolt was stopped
+	// on entry and is restarted when the period ends.
+	if d.inDeepSleep() {
+		d.logger.Println("Energy saver: deep sleep, skipping heartbeat")
+		return
+	}
+
 	d.metrics.recordHeartbeat(d.ctx)
 	d.logger.Println("Heartbeat starting (recovery-focused)")
 
@@ -777,35 +888,105 @@ func (d *Daemon) heartbeat(state *State) {
 	d.killDefaultPrefixGhosts()
 
 	// 0. Ensure Dolt server is running (if configured)
-	// This must happen before beads operations that depend on Dolt.
-	d.ensureDoltServerRunning()
+	// This must happen before beads operations that depend on Dolt, so it
+	// runs alone before the lanes start.
+	d.runHeartbeatTask(heartbeatTask{name: "dolt", run: d.ensureDoltServerRunning})
+
+	// The remaining steps run in concurrent lanes; steps within a lane keep
+	// their order. Each step is timed, and a watchdog logs steps that overrun
+	// their budget, so one slow bd call no longer holds up every other check.
+	var woken int
+	d.runHeartbeatLanes([]heartbeatLane{
+		{name: "agents", tasks: []heartbeatTask{
+			{name: "deacon", run: d.heartbeatDeacon},
+			{name: "witness", run: d.heartbeatWitnesses},
+			{name: "refinery", run: d.heartbeatRefineries},
+			// 6. Ensure Mayor is running (restart if dead)
+			{name: "mayor", run: d.ensureMayorRunning},
+			// 7. Process lifecycle requests. Shares the lane with the
+			// agent checks so a requested restart can't race a respawn.
+			{name: "lifecycle", run: d.processLifecycleRequests},
+		}},
+		{name: "dogs", tasks: []heartbeatTask{
+			{name: "dogs", run: d.heartbeatDogs},
+		}},
+		{name: "polecats", tasks: []heartbeatTask{
+			// 9. (Removed) Stale agent check - violated "discover, don't track"
+
+			// 10. Check for GUPP violations (agents with work-on-hook not progressing)
+			{name: "gupp", run: d.checkGUPPViolations},
+			// 11. Check for orphaned work (assigned to dead agents)
+			{name: "orphaned_work", run: d.checkOrphanedWork},
+			// 12. Check polecat session health (proactive crash detection)
+			// This validates tmux sessions are still alive for polecats with work-on-hook
+			{name: "polecat_health", run: d.checkPolecatSessionHealth},
+			// 12b. Reap idle polecat sessions to prevent API slot burn.
+			// Polecats transition to IDLE after gt done but sessions stay alive.
+			// Kill sessions that have been idle longer than the configured threshold.
+			{name: "reap_idle", run: d.reapIdlePolecats},
+			// 13b. Wake polecats stalled mid-bead on a rate limit that has since reset.
+			// Must run before dispatch: resumed work gets the fresh window first.
+			{name: "limit_wake", run: func() { woken = d.wakeLimitStalledPolecats() }},
+			// 14. Dispatch scheduled work. Last in the lane so it never
+			// races the reaper for an idle polecat.
+			{name: "dispatch", budget: dispatchTaskBudget, run: func() { d.heartbeatDispatch(woken) }},
+		}},
+		{name: "cleanup", tasks: []heartbeatTask{
+			// 13. Clean up orphaned claude subagent processes (memory leak prevention)
+			// These are Task tool subagents that didn't clean up after completion.
+			// This is a safety net - Deacon patrol also does this more frequently.
+			{name: "orphan_processes", run: d.cleanupOrphanedProcesses},
+			// 13. Prune stale local polecat tracking branches across all rig clones.
+			// When polecats push branches to origin, other clones create local tracking
+			// branches via git fetch. After merge, remote branches are deleted but local
+			// branches persist indefinitely. This cleans them up periodically.
+			{name: "prune_branches", run: d.pruneStaleBranches},
+			// 13c. Nudge (or rebase) polecats whose branch has fallen behind
+			// its base mid-bead. Off unless a rig sets rebase_nudge_behind.
+			{name: "rebase_nudge", run: d.nudgeStaleBranches},
+			// 15. Rotate oversized Dolt logs (copytruncate for child process fds).
+			// daemon.log uses lumberjack for automatic rotation; this handles Dolt server logs.
+			{name: "log_rotation", run: d.rotateOversizedLogs},
+			// 15b. Enforce bead artifact retention (hourly).
+			{name: "artifact_gc", run: d.gcArtifacts},
+		}},
+	})
+
+	// Update state
+	state.LastHeartbeat = time.Now()
+	state.HeartbeatCount++
+	if err := SaveState(d.config.TownRoot, state); err != nil {
+		d.logger.Printf("Warning: failed to save state: %v", err)
+	}
 
+	d.logger.Printf("Heartbeat complete (#%d)", state.HeartbeatCount)
+}
+
+// heartbeatDeacon runs heartbeat steps 1-3: keep the Deacon and Boot alive.
+func (d *Daemon) heartbeatDeacon() {
 	// 1. Ensure Deacon is running (restart if dead)
 	// Check patrol config - can be disabled in mayor/daemon.json
-	if d.isPatrolActive("deacon") {
-		d.ensureDeaconRunning()
-	} else {
+	if !d.isPatrolActive("deacon") {
 		d.logger.Printf("Deacon patrol disabled in config, skipping")
 		// Kill leftover deacon/boot sessions from before patrol was disabled.
 		// Without this, a stale deacon keeps running its own patrol loop,
 		// spawning witnesses and refineries despite daemon config. (hq-2mstj)
 		d.killDeaconSessions()
+		return
 	}
+	d.ensureDeaconRunning()
 
 	// 2. Poke Boot for intelligent triage (stuck/nudge/interrupt)
 	// Boot handles nuanced "is Deacon responsive" decisions
-	// Only run if Deacon patrol is enabled
-	if d.isPatrolActive("deacon") {
-		d.ensureBootRunning()
-	}
+	d.ensureBootRunning()
 
 	// 3. Direct Deacon heartbeat check (belt-and-suspenders)
 	// Boot may not detect all stuck states; this provides a fallback
-	// Only run if Deacon patrol is enabled
-	if d.isPatrolActive("deacon") {
-		d.checkDeaconHeartbeat()
-	}
+	d.checkDeaconHeartbeat()
+}
 
+// heartbeatWitnesses runs heartbeat step 4.
+func (d *Daemon) heartbeatWitnesses() {
 	// 4. Ensure Witnesses are running for all rigs (restart if dead)
 	// Check patrol config - can be disabled in mayor/daemon.json
 	if d.isPatrolActive("witness") {
@@ -815,7 +996,10 @@ func (d *Daemon) heartbeat(state *State) {
 		// Kill leftover witness sessions from before patrol was disabled. (hq-2mstj)
 		d.killWitnessSessions()
 	}
+}
 
+// heartbeatRefineries runs heartbeat step 5.
+func (d *Daemon) heartbeatRefineries() {
 	// 5. Ensure Refineries are running for all rigs (restart if dead)
 	// Check patrol config - can be disabled in mayor/daemon.json
 	// Pressure-gated: refineries consume API credits, defer when system is loaded.
@@ -830,10 +1014,10 @@ func (d *Daemon) heartbeat(state *State) {
 		// Kill leftover refinery sessions from before patrol was disabled. (hq-2mstj)
 		d.killRefinerySessions()
 	}
+}
 
-	// 6. Ensure Mayor is running (restart if dead)
-	d.ensureMayorRunning()
-
+// heartbeatDogs runs heartbeat step 6.5.
+func (d *Daemon) heartbeatDogs() {
 	// 6.5. Handle Dog lifecycle: cleanup stuck dogs and dispatch plugins
 	// Pressure-gated: dog dispatch spawns new agent sessions.
 	if d.isPatrolActive("handler") {
@@ -847,59 +1031,25 @@ func (d *Daemon) heartbeat(state *State) {
 	} else {
 		d.logger.Printf("Handler patrol disabled in config, skipping")
 	}
+}
 
-	// 7. Process lifecycle requests
-	d.processLifecycleRequests()
-
-	// 9. (Removed) Stale agent check - violated "discover, don't track"
-
-	// 10. Check for GUPP violations (agents with work-on-hook not progressing)
-	d.checkGUPPViolations()
-
-	// 11. Check for orphaned work (assigned to dead agents)
-	d.checkOrphanedWork()
-
-	// 12. Check polecat session health (proactive crash detection)
-	// This validates tmux sessions are still alive for polecats with work-on-hook
-	d.checkPolecatSessionHealth()
-
-	// 12b. Reap idle polecat sessions to prevent API slot burn.
-	// Polecats transition to IDLE after gt done but sessions stay alive.
-	// Kill sessions that have been idle longer than the configured threshold.
-	d.reapIdlePolecats()
-
-	// 13. Clean up orphaned claude subagent processes (memory leak prevention)
-	// These are Task tool subagents that didn't clean up after completion.
-	// This is a safety net - Deacon patrol also does this more frequently.
-	d.cleanupOrphanedProcesses()
-
-	// 13. Prune stale local polecat tracking branches across all rig clones.
-	// When polecats push branches to origin, other clones create local tracking
-	// branches via git fetch. After merge, remote branches are deleted but local
-	// branches persist indefinitely. This cleans them up periodically.
-	d.pruneStaleBranches()
-
+// heartbeatDispatch runs heartbeat step 14, given how many limit-stalled
+// polecats step 13b just woke.
+func (d *Daemon) heartbeatDispatch(woken int) {
 	// 14. Dispatch scheduled work (capacity-controlled polecat dispatch).
 	// Shells out to `gt scheduler run` to avoid circular import between daemon and cmd.
 	// Pressure-gated: polecats are the primary resource consumers.
-	if p := d.checkPressure("polecat"); !p.OK {
+	// Deferred for one heartbeat after a limit wake so new spawns don't
+	// consume the reset window before the resumed polecats are running.
+	if woken > 0 {
+		d.logger.Printf("Deferring polecat dispatch: woke %d limit-stalled polecat(s) first", woken)
+	} else if until, ok := d.limitsSnoozedUntil(); ok {
+		d.logger.Printf("Deferring polecat dispatch: limits snoozed until %s", until.Format(time.RFC3339))
+	} else if p := d.checkPressure("polecat"); !p.OK {
 		d.logger.Printf("Deferring polecat dispatch: %s", p.Reason)
 	} else {
 		d.dispatchQueuedWork()
 	}
-
-	// 15. Rotate oversized Dolt logs (copytruncate for child process fds).
-	// daemon.log uses lumberjack for automatic rotation; this handles Dolt server logs.
-	d.rotateOversizedLogs()
-
-	// Update state
-	state.LastHeartbeat = time.Now()
-	state.HeartbeatCount++
-	if err := SaveState(d.config.TownRoot, state); err != nil {
-		d.logger.Printf("Warning: failed to save state: %v", err)
-	}
-
-	d.logger.Printf("Heartbeat complete (#%d)", state.HeartbeatCount)
 }
 
 // rotateOversizedLogs checks Dolt server log files and rotates any that exceed
@@ -1956,6 +2106,8 @@ func (d *Daemon) openBeadsStores() (map[string]beadsdk.Storage, error) {
 // into a single mayor/rigs.json read. The cache is invalidated at the start of
 // each heartbeat.
 func (d *Daemon) getKnownRigs() []string {
+	d.knownRigsMu.Lock()
+	defer d.knownRigsMu.Unlock()
 	if d.knownRigsCacheValid {
 		return d.knownRigsCache
 	}
@@ -1968,6 +2120,8 @@ func (d *Daemon) getKnownRigs() []string {
 // invalidateKnownRigsCache clears the per-tick cache so the next
 // getKnownRigs() call re-reads mayor/rigs.json from disk.
 func (d *Daemon) invalidateKnownRigsCache() {
+	d.knownRigsMu.Lock()
+	defer d.knownRigsMu.Unlock()
 	d.knownRigsCache = nil
 	d.knownRigsCacheValid = false
 }
@@ -2117,6 +2271,12 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 		d.convoyManager.Stop()
 		d.logger.Println("Convoy manager stopped")
 	}
+
+	// Stop webhooks after the convoy manager, which feeds it closes
+	if d.webhooks != nil {
+		d.webhooks.Stop()
+		d.logger.Println("Webhook dispatcher stopped")
+	}
 	d.beadsStores = nil
 
 	// Stop KRC pruner
@@ -2125,6 +2285,13 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 		d.logger.Println("KRC pruner stopped")
 	}
 
+	// A daemon that lost the leader lease leaves the shared Dolt server and
+	// state file to the leader that replaced it.
+	if d.leaseLost.Load() {
+		d.logger.Println("Daemon stopped (lost leader lease)")
+		return nil
+	}
+
 	// Push Dolt remotes before stopping the server (if patrol is enabled)
 	d.pushDoltRemotes()
 
@@ -2636,8 +2803,8 @@ func (d *Daemon) emitMassDeathEvent() {
 // On any error (bead not found, bd failure), returns false to err on the side
 // of crash detection rather than silently suppressing alerts.
 func (d *Daemon) isBeadClosed(beadID string) bool {
-	cmd := exec.Command(d.bdPath, "show", beadID, "--json") //nolint:gosec // G204: args are constructed internally
-	setSysProcAttr(cmd)
+	cmd := beads.Limit(exec.Command(d.bdPath, "show", beadID, "--json")) //nolint:gosec // G204: args are constructed internally
+	setSysProcAttr(cmd.Cmd)
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = os.Environ()
 
@@ -2664,7 +2831,7 @@ func (d *Daemon) isBeadClosed(beadID string) bool {
 // kills working polecats whose agent bead hook_bead is stale.
 func (d *Daemon) hasAssignedOpenWork(rigName, assignee string) bool {
 	for _, status := range []string{"hooked", "in_progress", "open"} {
-		cmd := exec.Command(d.bdPath, "list", "--rig="+rigName, "--assignee="+assignee, "--status="+status, "--json") //nolint:gosec // G204: args are constructed internally
+		cmd := beads.Limit(exec.Command(d.bdPath, "list", "--rig="+rigName, "--assignee="+assignee, "--status="+status, "--json")) //nolint:gosec // G204: args are constructed internally
 		cmd.Dir = d.config.TownRoot
 		cmd.Env = os.Environ()
 		output, err := cmd.Output()
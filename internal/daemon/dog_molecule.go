@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -279,9 +280,9 @@ func (dm *dogMol) runBd(args ...string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), bdMolTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, bdPath, args...)
+	cmd := beads.Limit(exec.CommandContext(ctx, bdPath, args...))
 	cmd.Dir = dm.townRoot
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -0,0 +1,135 @@
+package daemon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EnergySaverConfig holds the daemon's deep-sleep quiet period, for towns
+// running on laptops. User opts in via:
+//
+//	gt config set energy_saver.window 02:00-07:00
+//
+// During the window the daemon skips heartbeats and every patrol, stops a
+// Dolt server it manages, and defers dispatch. Only wake signals (gt daemon
+// wake, lifecycle signals from gt handoff) bring it back before the window
+// ends; a woken daemon stays awake until the next window.
+type EnergySaverConfig struct {
+	// Enabled controls whether the quiet period applies.
+	Enabled bool `json:"enabled"`
+
+	// Window is the quiet period as "HH:MM-HH:MM" in local 24-hour time.
+	// It may wrap past midnight (e.g., "23:00-06:30").
+	Window string `json:"window,omitempty"`
+}
+
+// energySaverWindow returns the configured quiet period, or empty string
+// when energy saver is off.
+func energySaverWindow(config *DaemonPatrolConfig) string {
+	if config != nil && config.EnergySaver != nil && config.EnergySaver.Enabled {
+		return config.EnergySaver.Window
+	}
+	return ""
+}
+
+// ParseQuietWindow parses an "HH:MM-HH:MM" quiet period and returns its
+// start and end as minutes after midnight.
+func ParseQuietWindow(window string) (start, end int, err error) {
+	from, to, ok := strings.Cut(window, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid quiet window %q: expected HH:MM-HH:MM", window)
+	}
+	h, m, err := parseWindowTime(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, err
+	}
+	start = h*60 + m
+	h, m, err = parseWindowTime(strings.TrimSpace(to))
+	if err != nil {
+		return 0, 0, err
+	}
+	end = h*60 + m
+	if start == end {
+		return 0, 0, fmt.Errorf("invalid quiet window %q: start and end are equal", window)
+	}
+	return start, end, nil
+}
+
+// quietWindowEnd reports whether now falls within the quiet period and, if
+// so, when the period ends. Windows that wrap past midnight are handled.
+func quietWindowEnd(now time.Time, window string) (time.Time, bool) {
+	start, end, err := ParseQuietWindow(window)
+	if err != nil {
+		return time.Time{}, false
+	}
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	minute := now.Hour()*60 + now.Minute()
+	at := func(dayOffset, minutes int) time.Time {
+		return midnight.AddDate(0, 0, dayOffset).Add(time.Duration(minutes) * time.Minute)
+	}
+	switch {
+	case start < end && minute >= start && minute < end:
+		return at(0, end), true
+	case start > end && minute >= start:
+		return at(1, end), true
+	case start > end && minute < end:
+		return at(0, end), true
+	}
+	return time.Time{}, false
+}
+
+// inDeepSleep reports whether the daemon is in its energy saver quiet period.
+// Safe to call from any goroutine.
+func (d *Daemon) inDeepSleep() bool {
+	return d.deepSleep.Load()
+}
+
+// updateDeepSleep enters or leaves deep sleep for the current time. The
+// config is re-read each call so window changes apply without a restart.
+// Called from the main loop before each heartbeat.
+func (d *Daemon) updateDeepSleep(now time.Time) {
+	end, quiet := quietWindowEnd(now, energySaverWindow(LoadPatrolConfig(d.config.TownRoot)))
+	if quiet && !now.Before(d.sleepWokenUntil) {
+		if !d.deepSleep.Swap(true) {
+			d.logger.Printf("Energy saver: entering deep sleep until %s", end.Format("15:04"))
+			d.stopDoltForSleep()
+		}
+		return
+	}
+	if !quiet {
+		d.sleepWokenUntil = time.Time{}
+	}
+	if d.deepSleep.Swap(false) {
+		d.logger.Println("Energy saver: leaving deep sleep")
+		d.ensureDoltServerRunning()
+	}
+}
+
+// wakeFromDeepSleep leaves deep sleep on a wake signal and keeps the daemon
+// awake for the rest of the current quiet period. It reports whether the
+// daemon was asleep.
+func (d *Daemon) wakeFromDeepSleep(reason string) bool {
+	if !d.inDeepSleep() {
+		return false
+	}
+	now := time.Now()
+	if end, ok := quietWindowEnd(now, energySaverWindow(LoadPatrolConfig(d.config.TownRoot))); ok {
+		d.sleepWokenUntil = end
+	}
+	d.logger.Printf("Energy saver: woken by %s", reason)
+	d.updateDeepSleep(now)
+	return true
+}
+
+// stopDoltForSleep stops the Dolt server if the daemon manages a local one.
+// External and remote servers are left alone.
+func (d *Daemon) stopDoltForSleep() {
+	if d.doltServer == nil || !d.doltServer.IsEnabled() || d.doltServer.IsExternal() || d.doltServer.isRemote() {
+		return
+	}
+	d.logger.Println("Energy saver: stopping Dolt server")
+	if err := d.doltServer.Stop(); err != nil {
+		d.logger.Printf("Energy saver: error stopping Dolt server: %v", err)
+	}
+}
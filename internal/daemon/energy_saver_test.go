@@ -0,0 +1,96 @@
+package daemon
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+)
+
+func TestParseQuietWindow(t *testing.T) {
+	tests := []struct {
+		window     string
+		start, end int
+		wantErr    bool
+	}{
+		{window: "02:00-07:00", start: 120, end: 420},
+		{window: "23:30 - 06:15", start: 1410, end: 375},
+		{window: "02:00", wantErr: true},
+		{window: "02:00-25:00", wantErr: true},
+		{window: "07:00-07:00", wantErr: true},
+		{window: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		start, end, err := ParseQuietWindow(tt.window)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseQuietWindow(%q) error = %v, wantErr %v", tt.window, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && (start != tt.start || end != tt.end) {
+			t.Errorf("ParseQuietWindow(%q) = %d, %d; want %d, %d", tt.window, start, end, tt.start, tt.end)
+		}
+	}
+}
+
+func TestQuietWindowEnd(t *testing.T) {
+	loc := time.Local
+	day := func(d, h, m int) time.Time { return time.Date(2026, 3, d, h, m, 0, 0, loc) }
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		window    string
+		wantQuiet bool
+		wantEnd   time.Time
+	}{
+		{"inside same-day window", day(10, 3, 0), "02:00-07:00", true, day(10, 7, 0)},
+		{"at window start", day(10, 2, 0), "02:00-07:00", true, day(10, 7, 0)},
+		{"at window end", day(10, 7, 0), "02:00-07:00", false, time.Time{}},
+		{"before same-day window", day(10, 1, 59), "02:00-07:00", false, time.Time{}},
+		{"wrapping window, before midnight", day(10, 23, 30), "23:00-06:00", true, day(11, 6, 0)},
+		{"wrapping window, after midnight", day(11, 1, 0), "23:00-06:00", true, day(11, 6, 0)},
+		{"wrapping window, daytime", day(11, 12, 0), "23:00-06:00", false, time.Time{}},
+		{"invalid window", day(10, 3, 0), "nope", false, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			end, quiet := quietWindowEnd(tt.now, tt.window)
+			if quiet != tt.wantQuiet || !end.Equal(tt.wantEnd) {
+				t.Errorf("quietWindowEnd(%s, %q) = %s, %v; want %s, %v",
+					tt.now.Format("15:04"), tt.window, end, quiet, tt.wantEnd, tt.wantQuiet)
+			}
+		})
+	}
+}
+
+func TestDeepSleepWake(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := SavePatrolConfig(townRoot, &DaemonPatrolConfig{
+		Type:        "daemon-patrol-config",
+		Version:     1,
+		EnergySaver: &EnergySaverConfig{Enabled: true, Window: "00:00-23:59"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	d := &Daemon{config: &Config{TownRoot: townRoot}, logger: log.New(io.Discard, "", 0)}
+
+	now := time.Now()
+	if now.Hour() == 23 && now.Minute() == 59 {
+		t.Skip("outside test window")
+	}
+	d.updateDeepSleep(now)
+	if !d.inDeepSleep() {
+		t.Fatal("expected deep sleep inside the quiet window")
+	}
+
+	if !d.wakeFromDeepSleep("test") {
+		t.Fatal("wakeFromDeepSleep reported not asleep")
+	}
+	d.updateDeepSleep(now)
+	if d.inDeepSleep() {
+		t.Error("woken daemon went back to sleep within the same window")
+	}
+	if d.wakeFromDeepSleep("test") {
+		t.Error("wakeFromDeepSleep on an awake daemon reported asleep")
+	}
+}
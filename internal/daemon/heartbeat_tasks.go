@@ -0,0 +1,74 @@
+package daemon
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// defaultHeartbeatTaskBudget is how long a heartbeat task may run before
+	// the watchdog logs it as over budget.
+	defaultHeartbeatTaskBudget = 30 * time.Second
+
+	// dispatchTaskBudget is the budget for scheduler dispatch, which spawns
+	// polecats and legitimately takes longer than a health check.
+	dispatchTaskBudget = 2 * time.Minute
+)
+
+// heartbeatTask is one named step of the heartbeat.
+type heartbeatTask struct {
+	name   string
+	budget time.Duration // Zero means defaultHeartbeatTaskBudget
+	run    func()
+}
+
+// heartbeatLane is a sequence of tasks that must run in order, because later
+// tasks depend on earlier ones or act on the same sessions. Lanes are
+// independent of each other and run concurrently.
+type heartbeatLane struct {
+	name  string
+	tasks []heartbeatTask
+}
+
+// runHeartbeatLanes runs each lane on its own goroutine and blocks until all
+// of them finish, so a slow bd or tmux call only delays the tasks behind it in
+// its own lane. Waiting keeps heartbeats from overlapping each other or the
+// patrol dogs on the main loop.
+func (d *Daemon) runHeartbeatLanes(lanes []heartbeatLane) {
+	var wg sync.WaitGroup
+	for _, lane := range lanes {
+		wg.Add(1)
+		go func(lane heartbeatLane) {
+			defer wg.Done()
+			for _, t := range lane.tasks {
+				d.runHeartbeatTask(t)
+			}
+		}(lane)
+	}
+	wg.Wait()
+}
+
+// runHeartbeatTask runs t, records its duration, and has a watchdog log it
+// as soon as it exceeds its budget (and again with its total when it ends).
+// Tasks are not canceled: most shell out to tmux or bd with their own
+// timeouts, and abandoning one mid-restart would leave sessions half started.
+func (d *Daemon) runHeartbeatTask(t heartbeatTask) {
+	budget := t.budget
+	if budget <= 0 {
+		budget = defaultHeartbeatTaskBudget
+	}
+
+	start := time.Now()
+	watchdog := time.AfterFunc(budget, func() {
+		d.logger.Printf("heartbeat: task %s exceeded its %v budget, still running", t.name, budget)
+	})
+	t.run()
+	watchdog.Stop()
+
+	elapsed := time.Since(start)
+	overBudget := elapsed > budget
+	if overBudget {
+		d.logger.Printf("heartbeat: task %s took %v (budget %v)", t.name, elapsed.Round(time.Millisecond), budget)
+	}
+	d.metrics.recordHeartbeatTask(d.ctx, t.name, elapsed, overBudget)
+}
@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"bytes"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunHeartbeatLanes_ConcurrentAcrossLanesOrderedWithin(t *testing.T) {
+	d := &Daemon{logger: log.New(&bytes.Buffer{}, "", 0)}
+
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) func() {
+		return func() {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+		}
+	}
+
+	// The slow lane blocks until the fast lane has finished: with sequential
+	// lanes this would deadlock (and time out the test).
+	fastDone := make(chan struct{})
+	d.runHeartbeatLanes([]heartbeatLane{
+		{name: "slow", tasks: []heartbeatTask{
+			{name: "wait", run: func() { <-fastDone }},
+			{name: "after", run: record("slow-after")},
+		}},
+		{name: "fast", tasks: []heartbeatTask{
+			{name: "a", run: record("fast-a")},
+			{name: "b", run: func() { record("fast-b")(); close(fastDone) }},
+		}},
+	})
+
+	want := []string{"fast-a", "fast-b", "slow-after"}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("order = %v, want %v", order, want)
+	}
+}
+
+func TestRunHeartbeatTask_WatchdogLogsOverBudget(t *testing.T) {
+	var buf bytes.Buffer
+	d := &Daemon{logger: log.New(&buf, "", 0)}
+
+	d.runHeartbeatTask(heartbeatTask{name: "quick", budget: time.Second, run: func() {}})
+	if buf.Len() != 0 {
+		t.Errorf("task within budget logged: %q", buf.String())
+	}
+
+	d.runHeartbeatTask(heartbeatTask{name: "slow", budget: 10 * time.Millisecond, run: func() {
+		time.Sleep(50 * time.Millisecond)
+	}})
+	out := buf.String()
+	if !strings.Contains(out, "task slow exceeded its 10ms budget, still running") {
+		t.Errorf("watchdog did not log while task ran: %q", out)
+	}
+	if !strings.Contains(out, "task slow took") {
+		t.Errorf("over-budget task's total not logged: %q", out)
+	}
+}
@@ -0,0 +1,257 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+// Leader lease timing. The leader renews every leaseRenewInterval from its
+// own goroutine, so a long heartbeat never lets the lease lapse; a lease not
+// renewed for leaseTTL is considered abandoned (crashed, hung, or suspended).
+const (
+	leaseRenewInterval = 30 * time.Second
+	leaseTTL           = 2 * time.Minute
+)
+
+// standbyPollInterval is how often a standby daemon retries the lease and
+// daemon.lock; shortened in tests.
+var standbyPollInterval = leaseRenewInterval
+
+// Lease records which daemon instance leads the town. Only the leader runs
+// the heartbeat, dispatch, and patrols. It backs up daemon.lock: the flock
+// keeps two daemons from starting against the same lock file, while the
+// lease also catches daemons that got past it (a lock on another mount,
+// a lock file removed under a running daemon) through the renewal check.
+type Lease struct {
+	ID         string    `json:"id"` // Random per daemon instance
+	PID        int       `json:"pid"`
+	Host       string    `json:"host"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	RenewedAt  time.Time `json:"renewed_at"`
+}
+
+// LeasePath returns the path of the town's leader lease file.
+func LeasePath(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "leader.json")
+}
+
+// ReadLease returns the current leader lease, or nil if there is none.
+func ReadLease(townRoot string) (*Lease, error) {
+	data, err := os.ReadFile(LeasePath(townRoot))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var l Lease
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, fmt.Errorf("parsing leader lease: %w", err)
+	}
+	return &l, nil
+}
+
+// Expired reports whether the lease has gone unrenewed for leaseTTL.
+func (l *Lease) Expired(now time.Time) bool {
+	return now.Sub(l.RenewedAt) > leaseTTL
+}
+
+// String describes the lease holder for logs and errors.
+func (l *Lease) String() string {
+	return fmt.Sprintf("PID %d on %s (renewed %s)", l.PID, l.Host, l.RenewedAt.Local().Format("15:04:05"))
+}
+
+// leaseHolder acquires and renews the leader lease for one daemon instance.
+type leaseHolder struct {
+	townRoot string
+	id       string
+	pid      int
+	host     string
+
+	// processAlive reports whether a same-host PID is running; swapped out
+	// in tests.
+	processAlive func(pid int) bool
+}
+
+func newLeaseHolder(townRoot string) (*leaseHolder, error) {
+	id, err := generateNonce()
+	if err != nil {
+		return nil, fmt.Errorf("generating lease ID: %w", err)
+	}
+	host, _ := os.Hostname()
+	return &leaseHolder{
+		townRoot:     townRoot,
+		id:           id,
+		pid:          os.Getpid(),
+		host:         host,
+		processAlive: pidAlive,
+	}, nil
+}
+
+// tryAcquire takes or renews the lease unless another live instance holds
+// it. Returns whether this instance now leads, and the lease as found
+// (nil if there was none) so callers can report the other leader.
+//
+// A lease is free when it is absent, expired, already ours, or held by a
+// dead process on this host. Read-modify-write runs under a short flock on
+// leader.lock so two instances can't both take a free lease.
+func (h *leaseHolder) tryAcquire(now time.Time) (bool, *Lease, error) {
+	lock := flock.New(LeasePath(h.townRoot) + ".lock")
+	if err := lock.Lock(); err != nil {
+		return false, nil, fmt.Errorf("locking leader lease: %w", err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	cur, err := ReadLease(h.townRoot)
+	if err != nil {
+		// A corrupt lease is treated as absent rather than blocking forever.
+		cur = nil
+	}
+	if cur != nil && cur.ID != h.id && !cur.Expired(now) &&
+		!(cur.Host == h.host && !h.processAlive(cur.PID)) {
+		return false, cur, nil
+	}
+
+	next := Lease{ID: h.id, PID: h.pid, Host: h.host, AcquiredAt: now, RenewedAt: now}
+	if cur != nil && cur.ID == h.id {
+		next.AcquiredAt = cur.AcquiredAt
+	}
+	data, err := json.MarshalIndent(next, "", "  ")
+	if err != nil {
+		return false, cur, err
+	}
+	tmp := LeasePath(h.townRoot) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return false, cur, fmt.Errorf("writing leader lease: %w", err)
+	}
+	if err := os.Rename(tmp, LeasePath(h.townRoot)); err != nil {
+		return false, cur, fmt.Errorf("writing leader lease: %w", err)
+	}
+	return true, cur, nil
+}
+
+// release removes the lease if this instance still holds it.
+func (h *leaseHolder) release() {
+	lock := flock.New(LeasePath(h.townRoot) + ".lock")
+	if err := lock.Lock(); err != nil {
+		return
+	}
+	defer func() { _ = lock.Unlock() }()
+	if cur, err := ReadLease(h.townRoot); err == nil && cur != nil && cur.ID == h.id {
+		_ = os.Remove(LeasePath(h.townRoot))
+	}
+}
+
+// acquireLeadership takes the leader lease at startup. If another daemon
+// leads, it returns an error, or with Config.Standby waits as an observer
+// until that leader's lease lapses.
+func (d *Daemon) acquireLeadership() error {
+	h, err := newLeaseHolder(d.config.TownRoot)
+	if err != nil {
+		return err
+	}
+	d.lease = h
+
+	for {
+		ok, cur, err := h.tryAcquire(time.Now())
+		if err != nil {
+			return err
+		}
+		if ok {
+			if cur != nil && cur.ID != h.id {
+				d.logger.Printf("Took over leader lease from %s", cur)
+			}
+			return nil
+		}
+		if !d.config.Standby {
+			return fmt.Errorf("another daemon leads this town: %s", cur)
+		}
+		d.logger.Printf("Standby: another daemon leads this town (%s); observing", cur)
+		select {
+		case <-d.ctx.Done():
+			return d.ctx.Err()
+		case <-time.After(standbyPollInterval):
+		}
+	}
+}
+
+// lead makes this daemon the town's leader and returns a func that steps
+// down. The lease is taken before the process-exclusive daemon.lock, so a
+// standby daemon waits as an observer instead of being turned away by the
+// flock the leader holds. After taking over, a standby also waits for the
+// old leader to let go of the flock (a hung leader exits once it sees its
+// lease taken); any other daemon that finds the flock held exits.
+func (d *Daemon) lead() (func(), error) {
+	if err := d.acquireLeadership(); err != nil {
+		return nil, err
+	}
+	go d.renewLeadership()
+
+	// Uses gofrs/flock for cross-platform compatibility (Unix + Windows).
+	fileLock := flock.New(filepath.Join(d.config.TownRoot, "daemon", "daemon.lock"))
+	for {
+		locked, err := fileLock.TryLock()
+		if err != nil {
+			d.lease.release()
+			return nil, fmt.Errorf("acquiring lock: %w", err)
+		}
+		if locked {
+			break
+		}
+		if !d.config.Standby {
+			d.lease.release()
+			return nil, fmt.Errorf("daemon already running (lock held by another process)")
+		}
+		d.logger.Printf("Standby: waiting for the previous leader to release daemon.lock")
+		select {
+		case <-d.ctx.Done():
+			d.lease.release()
+			return nil, d.ctx.Err()
+		case <-time.After(standbyPollInterval):
+		}
+	}
+	return func() {
+		_ = fileLock.Unlock()
+		d.lease.release()
+	}, nil
+}
+
+// renewLeadership renews the lease until ctx ends. If another instance has
+// taken the lease (this daemon was suspended past leaseTTL, say), the daemon
+// shuts down rather than race the new leader.
+func (d *Daemon) renewLeadership() {
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			ok, cur, err := d.lease.tryAcquire(time.Now())
+			if err != nil {
+				d.logger.Printf("Warning: renewing leader lease: %v", err)
+				continue
+			}
+			if !ok {
+				d.logger.Printf("Lost leader lease to %s; shutting down", cur)
+				d.leaseLost.Store(true)
+				d.cancel()
+				return
+			}
+		}
+	}
+}
+
+// pidAlive reports whether a process with the given PID is running.
+func pidAlive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return isProcessAlive(p)
+}
@@ -0,0 +1,167 @@
+package daemon
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testLeaseHolder(t *testing.T, townRoot, id string, pid int, alive map[int]bool) *leaseHolder {
+	t.Helper()
+	return &leaseHolder{
+		townRoot:     townRoot,
+		id:           id,
+		pid:          pid,
+		host:         "host-a",
+		processAlive: func(pid int) bool { return alive[pid] },
+	}
+}
+
+func TestLeaseHolder_TryAcquire(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	alive := map[int]bool{100: true, 200: true}
+	first := testLeaseHolder(t, townRoot, "first", 100, alive)
+	second := testLeaseHolder(t, townRoot, "second", 200, alive)
+	now := time.Now()
+
+	if ok, cur, err := first.tryAcquire(now); err != nil || !ok || cur != nil {
+		t.Fatalf("first acquire: ok=%v cur=%v err=%v", ok, cur, err)
+	}
+	ok, cur, err := second.tryAcquire(now.Add(time.Second))
+	if err != nil || ok {
+		t.Fatalf("second acquire while first leads: ok=%v err=%v", ok, err)
+	}
+	if cur == nil || cur.ID != "first" || cur.PID != 100 {
+		t.Errorf("reported leader = %+v, want first", cur)
+	}
+
+	// Renewal keeps the original acquisition time.
+	if ok, _, err := first.tryAcquire(now.Add(leaseRenewInterval)); err != nil || !ok {
+		t.Fatalf("renew: ok=%v err=%v", ok, err)
+	}
+	l, err := ReadLease(townRoot)
+	if err != nil || l == nil {
+		t.Fatalf("ReadLease: %v %v", l, err)
+	}
+	if !l.AcquiredAt.Equal(now) || !l.RenewedAt.Equal(now.Add(leaseRenewInterval)) {
+		t.Errorf("lease times = %v/%v", l.AcquiredAt, l.RenewedAt)
+	}
+
+	// An expired lease is taken over, and the old leader then loses it.
+	later := now.Add(leaseRenewInterval + leaseTTL + time.Second)
+	if ok, cur, _ := second.tryAcquire(later); !ok || cur.ID != "first" {
+		t.Fatalf("takeover of expired lease: ok=%v cur=%v", ok, cur)
+	}
+	if ok, cur, _ := first.tryAcquire(later.Add(time.Second)); ok || cur.ID != "second" {
+		t.Errorf("old leader renewing after takeover: ok=%v cur=%v", ok, cur)
+	}
+}
+
+func TestLeaseHolder_DeadLeaderOnSameHost(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	alive := map[int]bool{100: true, 200: true}
+	first := testLeaseHolder(t, townRoot, "first", 100, alive)
+	second := testLeaseHolder(t, townRoot, "second", 200, alive)
+	now := time.Now()
+	if ok, _, _ := first.tryAcquire(now); !ok {
+		t.Fatal("first acquire failed")
+	}
+
+	alive[100] = false // crashed without releasing
+	if ok, _, _ := second.tryAcquire(now.Add(time.Second)); !ok {
+		t.Error("expected a fresh lease held by a dead same-host PID to be free")
+	}
+
+	// A remote holder can't be checked, so only expiry frees it.
+	remote := testLeaseHolder(t, townRoot, "remote", 200, alive)
+	remote.host = "host-b"
+	if ok, _, _ := remote.tryAcquire(now.Add(2 * time.Second)); ok {
+		t.Error("remote instance took a live lease")
+	}
+}
+
+func TestLeaseHolder_Release(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	alive := map[int]bool{100: true, 200: true}
+	first := testLeaseHolder(t, townRoot, "first", 100, alive)
+	second := testLeaseHolder(t, townRoot, "second", 200, alive)
+	if ok, _, _ := first.tryAcquire(time.Now()); !ok {
+		t.Fatal("first acquire failed")
+	}
+
+	second.release() // not the holder: no-op
+	if l, _ := ReadLease(townRoot); l == nil || l.ID != "first" {
+		t.Fatalf("lease after foreign release = %v", l)
+	}
+	first.release()
+	if l, _ := ReadLease(townRoot); l != nil {
+		t.Errorf("lease after release = %v, want none", l)
+	}
+}
+
+func TestLead_TwoDaemons(t *testing.T) {
+	oldPoll := standbyPollInterval
+	standbyPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { standbyPollInterval = oldPoll })
+
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	newDaemon := func(standby bool) *Daemon {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		cfg := DefaultConfig(townRoot)
+		cfg.Standby = standby
+		return &Daemon{config: cfg, logger: log.New(io.Discard, "", 0), ctx: ctx, cancel: cancel}
+	}
+
+	leader := newDaemon(false)
+	stepDown, err := leader.lead()
+	if err != nil {
+		t.Fatalf("first daemon: %v", err)
+	}
+
+	if _, err := newDaemon(false).lead(); err == nil {
+		t.Fatal("second daemon without --standby started alongside the leader")
+	}
+
+	standby := newDaemon(true)
+	done := make(chan error, 1)
+	go func() {
+		release, err := standby.lead()
+		if err == nil {
+			release()
+		}
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("standby daemon returned while the leader runs: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	leader.cancel()
+	stepDown()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("standby daemon after the leader stopped: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("standby daemon never took over")
+	}
+}
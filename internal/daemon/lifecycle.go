@@ -808,10 +808,10 @@ func (d *Daemon) getAgentBeadState(agentBeadID string) (string, error) {
 
 // getAgentBeadInfo fetches and parses an agent bead by ID.
 func (d *Daemon) getAgentBeadInfo(agentBeadID string) (*AgentBeadInfo, error) {
-	cmd := exec.Command(d.bdPath, "show", agentBeadID, "--json")
+	cmd := beads.Limit(exec.Command(d.bdPath, "show", agentBeadID, "--json"))
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = os.Environ() // Inherit PATH to find bd executable
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 
 	output, err := cmd.Output()
 	if err != nil {
@@ -869,10 +869,10 @@ func (d *Daemon) getAgentBeadInfo(agentBeadID string) (*AgentBeadInfo, error) {
 // Used for TOCTOU re-verification before taking destructive action on agents.
 // Returns empty string on error or if no hook_bead is set.
 func (d *Daemon) getAgentHookBead(agentBeadID string) string {
-	cmd := exec.Command(d.bdPath, "show", agentBeadID, "--json")
+	cmd := beads.Limit(exec.Command(d.bdPath, "show", agentBeadID, "--json"))
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = os.Environ()
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 
 	output, err := cmd.Output()
 	if err != nil {
@@ -961,18 +961,18 @@ const GUPPViolationTimeout = constants.GUPPViolationTimeout
 // The wisps query is best-effort (gracefully ignored if table doesn't exist).
 func (d *Daemon) listAgentBeadsJSON(dest interface{}) error {
 	// Query issues table (backward compat during migration)
-	cmd := exec.Command(d.bdPath, "list", "--label=gt:agent", "--json", "--flat") //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.Limit(exec.Command(d.bdPath, "list", "--label=gt:agent", "--json", "--flat")) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = d.config.TownRoot
 	cmd.Env = os.Environ()
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 
 	issuesOutput, issuesErr := cmd.Output()
 
 	// Query wisps table (primary source after agent bead migration)
-	wispCmd := exec.Command(d.bdPath, "mol", "wisp", "list", "--json") //nolint:gosec // G204: bd is a trusted internal tool
+	wispCmd := beads.Limit(exec.Command(d.bdPath, "mol", "wisp", "list", "--json")) //nolint:gosec // G204: bd is a trusted internal tool
 	wispCmd.Dir = d.config.TownRoot
 	wispCmd.Env = os.Environ()
-	util.SetDetachedProcessGroup(wispCmd)
+	util.SetDetachedProcessGroup(wispCmd.Cmd)
 
 	wispOutput, _ := wispCmd.Output() // Best-effort: wisps table may not exist
 
@@ -0,0 +1,236 @@
+package daemon
+
+import (
+	"log"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// limitWakeMessage is nudged into a polecat left at a rate-limit prompt once
+// its limit has reset.
+const limitWakeMessage = "Your rate limit has reset. Resume work on your hooked bead where you left off."
+
+// limitWakeCooldown is the minimum interval between wake nudges to the same
+// session, giving a woken polecat time to push the limit message off screen.
+const limitWakeCooldown = 15 * time.Minute
+
+// wakeLimitStalledPolecats nudges polecats that were interrupted mid-bead by
+// a rate limit which has since reset. Returns the number of polecats woken.
+//
+// Runs before scheduler dispatch each heartbeat: when a window resets, the
+// half-finished work gets the fresh window first instead of new spawns
+// consuming it while stalled polecats sit at the limit prompt.
+//
+// Stalled polecats are found by scanning tmux panes and from the stalls
+// recorded in the quota state (gt quota record in the Stop hook), which is
+// the only trace of a headless polecat stopped at a limit.
+//
+// Nothing is woken while limits are snoozed (gt limits snooze).
+func (d *Daemon) wakeLimitStalledPolecats() int {
+	if _, ok := d.limitsSnoozedUntil(); ok {
+		return 0
+	}
+	townRoot := d.config.TownRoot
+	acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+	if err != nil {
+		acctCfg = nil // Single-account town: reset times come from the pane alone
+	}
+
+	scanner, err := quota.NewScanner(d.tmux, nil, acctCfg)
+	if err != nil {
+		d.logger.Printf("limit_wake: creating scanner: %v", err)
+		return 0
+	}
+	scanner.WithTownRoot(townRoot)
+	results, err := scanner.ScanAll()
+	if err != nil {
+		d.logger.Printf("limit_wake: scanning sessions: %v", err)
+		return 0
+	}
+
+	// Clear expired limits so accounts and providers whose windows reset
+	// read as available.
+	mgr := quota.NewManager(townRoot)
+	state, err := mgr.Update(func(s *config.QuotaState) (bool, error) {
+		return mgr.ClearExpired(s) > 0, nil
+	})
+	if err != nil && state == nil {
+		d.logger.Printf("limit_wake: loading quota state: %v", err)
+		return 0
+	}
+	results, forget := withRecordedStalls(results, state)
+
+	// Wake through the session's backend: the pane for tmux polecats, the
+	// nudge queue for headless ones.
+	waker := session.NewWaker(townRoot, "daemon", d.tmux)
+	now := time.Now()
+	d.trackLimitStalls(results, state, now)
+	woken := 0
+	for _, r := range results {
+		if !r.RateLimited || !limitHasReset(r, state, d.limitStalledSince[r.Session], now) {
+			continue
+		}
+		if last, ok := d.lastLimitWake[r.Session]; ok && now.Sub(last) < limitWakeCooldown {
+			continue
+		}
+		identity, err := session.ParseSessionName(r.Session)
+		if err != nil || identity.Role != session.RolePolecat {
+			forget = append(forget, r.Session)
+			continue
+		}
+
+		prefix := beads.GetPrefixForRig(townRoot, identity.Rig)
+		info, err := d.getAgentBeadInfo(beads.PolecatBeadIDWithPrefix(prefix, identity.Rig, identity.Name))
+		if err != nil || info.HookBead == "" || d.isBeadClosed(info.HookBead) {
+			forget = append(forget, r.Session)
+			continue // Not interrupted mid-bead; nothing to resume
+		}
+
+		via, err := waker.Wake(r.Session, limitWakeMessage)
+		if err != nil {
+			d.logger.Printf("limit_wake: waking %s via %s: %v", r.Session, via, err)
+			continue
+		}
+		if d.lastLimitWake == nil {
+			d.lastLimitWake = make(map[string]time.Time)
+		}
+		d.lastLimitWake[r.Session] = now
+		d.logger.Printf("limit_wake: woke %s/%s to resume %s after limit reset (via %s)",
+			identity.Rig, identity.Name, info.HookBead, via)
+		_ = events.LogAudit(events.TypeLimitWake, "daemon",
+			events.LimitWakePayload(identity.Rig, identity.Name, info.HookBead, now.Sub(d.limitStalledSince[r.Session])))
+		delete(d.limitStalledSince, r.Session)
+		forget = append(forget, r.Session)
+		woken++
+	}
+
+	if len(forget) > 0 {
+		if _, err := mgr.Update(func(s *config.QuotaState) (bool, error) {
+			return quota.ClearStalls(s, forget...), nil
+		}); err != nil {
+			d.logger.Printf("limit_wake: clearing recorded stalls: %v", err)
+		}
+	}
+	return woken
+}
+
+// WakeLimitStalledPolecats runs one limit wake pass without a running
+// daemon, for gt daemon wake when the control socket is unavailable. The
+// wake cooldown is not tracked across calls. Returns the number of polecats
+// woken.
+func WakeLimitStalledPolecats(townRoot string, logger *log.Logger) int {
+	d := &Daemon{
+		config: DefaultConfig(townRoot),
+		logger: logger,
+		tmux:   tmux.NewTmux(),
+		bdPath: "bd",
+	}
+	return d.wakeLimitStalledPolecats()
+}
+
+// withRecordedStalls adds the stalls recorded in the quota state for
+// sessions the pane scan did not cover, such as headless polecats. It also
+// returns the recorded sessions the scan found no longer at a limit, whose
+// records are stale.
+func withRecordedStalls(results []quota.ScanResult, state *config.QuotaState) ([]quota.ScanResult, []string) {
+	scanned := make(map[string]bool, len(results))
+	for _, r := range results {
+		scanned[r.Session] = r.RateLimited
+	}
+	var stale []string
+	for _, r := range quota.StalledResults(state) {
+		limited, ok := scanned[r.Session]
+		switch {
+		case !ok:
+			results = append(results, r)
+		case !limited:
+			stale = append(stale, r.Session)
+		}
+	}
+	return results, stale
+}
+
+// trackLimitStalls records when each session was first seen rate-limited,
+// or when its stall was recorded in state if earlier, and forgets sessions
+// no longer at a limit.
+func (d *Daemon) trackLimitStalls(results []quota.ScanResult, state *config.QuotaState, now time.Time) {
+	if d.limitStalledSince == nil {
+		d.limitStalledSince = make(map[string]time.Time)
+	}
+	limited := make(map[string]bool)
+	for _, r := range results {
+		if !r.RateLimited {
+			continue
+		}
+		limited[r.Session] = true
+		if _, ok := d.limitStalledSince[r.Session]; !ok {
+			d.limitStalledSince[r.Session] = now
+			if since, ok := quota.StalledSince(state, r.Session); ok && since.Before(now) {
+				d.limitStalledSince[r.Session] = since
+			}
+		}
+	}
+	for s := range d.limitStalledSince {
+		if !limited[s] {
+			delete(d.limitStalledSince, s)
+		}
+	}
+}
+
+// limitsSnoozedUntil reports whether a limits snooze is active, and until
+// when. While snoozed the daemon neither wakes stalled polecats nor
+// dispatches scheduled work, even after account limits reset.
+func (d *Daemon) limitsSnoozedUntil() (time.Time, bool) {
+	state, err := quota.NewManager(d.config.TownRoot).Load()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return quota.SnoozedUntil(state, time.Now())
+}
+
+// limitHasReset reports whether the limit a session is stalled on has reset.
+// Sessions on an account recorded as limited follow its quota state, where
+// every blocking window must have reset; sessions without an account follow
+// their provider's limit, so a reset on one provider never wakes sessions of
+// another. Otherwise the reset time shown in the pane decides, resolved from
+// since, when an earlier pass first saw the stall (else its recorded stall),
+// so "7pm" seen yesterday afternoon means yesterday's 7pm. A stall first seen
+// just now resolves from today. Without a reset time (e.g. a revoked token)
+// the session is left for quota rotation.
+func limitHasReset(r quota.ScanResult, state *config.QuotaState, since, now time.Time) bool {
+	if state != nil && r.AccountHandle != "" {
+		if acct, ok := state.Accounts[r.AccountHandle]; ok && acct.Status == config.QuotaStatusLimited {
+			return quota.ShouldWake(acct, now)
+		}
+	}
+	if state != nil && r.AccountHandle == "" && r.Provider != "" {
+		if p, ok := state.Providers[r.Provider]; ok && p.Status == config.QuotaStatusLimited {
+			return quota.ShouldWake(p, now)
+		}
+	}
+	if r.ResetsAt == "" {
+		return false
+	}
+	window := r.Window
+	if window == "" {
+		window = quota.Window5Hour
+	}
+	if since.IsZero() || !since.Before(now) {
+		since, _ = quota.StalledSince(state, r.Session)
+	}
+	w := config.QuotaWindow{ResetsAt: r.ResetsAt}
+	if !since.IsZero() && since.Before(now) {
+		w.LimitedAt = since.UTC().Format(time.RFC3339)
+	}
+	return quota.ShouldWake(config.AccountQuotaState{
+		Status:  config.QuotaStatusLimited,
+		Windows: map[string]config.QuotaWindow{window: w},
+	}, now)
+}
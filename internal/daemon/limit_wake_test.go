@@ -0,0 +1,129 @@
+package daemon
+
+import (
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/quota"
+)
+
+func TestLimitHasReset(t *testing.T) {
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	now := time.Date(2026, 10, 17, 8, 0, 0, 0, la)
+	limitedAt := time.Date(2026, 10, 16, 15, 0, 0, 0, la).UTC().Format(time.RFC3339)
+
+	state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+		"weekly-blocked": {
+			Status: config.QuotaStatusLimited,
+			Windows: map[string]config.QuotaWindow{
+				quota.Window5Hour:  {LimitedAt: limitedAt, ResetsAt: "7pm (America/Los_Angeles)"},
+				quota.WindowWeekly: {LimitedAt: limitedAt, ResetsAt: "Oct 20, 9am (America/Los_Angeles)"},
+			},
+		},
+		"reset": {
+			Status: config.QuotaStatusLimited,
+			Windows: map[string]config.QuotaWindow{
+				quota.Window5Hour: {LimitedAt: limitedAt, ResetsAt: "7pm (America/Los_Angeles)"},
+			},
+		},
+		"available": {Status: config.QuotaStatusAvailable},
+	}, Providers: map[string]config.AccountQuotaState{
+		"gemini": {
+			Status: config.QuotaStatusLimited,
+			Windows: map[string]config.QuotaWindow{
+				quota.Window5Hour: {LimitedAt: limitedAt, ResetsAt: "Oct 20, 9am (America/Los_Angeles)"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name string
+		r    quota.ScanResult
+		want bool
+	}{
+		{"weekly window still blocking", quota.ScanResult{AccountHandle: "weekly-blocked", ResetsAt: "7pm (America/Los_Angeles)"}, false},
+		{"all windows reset", quota.ScanResult{AccountHandle: "reset"}, true},
+		{"available account, pane reset passed", quota.ScanResult{AccountHandle: "available", ResetsAt: "7am (America/Los_Angeles)"}, true},
+		{"available account, pane reset ahead", quota.ScanResult{AccountHandle: "available", ResetsAt: "11am (America/Los_Angeles)"}, false},
+		{"untracked, no reset time", quota.ScanResult{}, false},
+		{"untracked weekly pane reset ahead", quota.ScanResult{Window: quota.WindowWeekly, ResetsAt: "Oct 20, 9am (America/Los_Angeles)"}, false},
+		{"provider limit still blocking", quota.ScanResult{Provider: "gemini", ResetsAt: "7am (America/Los_Angeles)"}, false},
+		{"other provider's limit ignored", quota.ScanResult{Provider: "claude", ResetsAt: "7am (America/Los_Angeles)"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := limitHasReset(tt.r, state, time.Time{}, now); got != tt.want {
+				t.Errorf("limitHasReset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if limitHasReset(quota.ScanResult{ResetsAt: "7am (America/Los_Angeles)"}, nil, time.Time{}, now) != true {
+		t.Error("without quota state the pane's passed reset time should decide")
+	}
+
+	// "7pm" seen at 3pm yesterday has passed, though today's 7pm has not.
+	seen := time.Date(2026, 10, 16, 15, 0, 0, 0, la)
+	pane := quota.ScanResult{Session: "gt-gastown-Toast", ResetsAt: "7pm (America/Los_Angeles)"}
+	if limitHasReset(pane, nil, time.Time{}, now) {
+		t.Error("without a first-seen time the reset resolves to today's 7pm")
+	}
+	if !limitHasReset(pane, nil, seen, now) {
+		t.Error("reset should resolve from when the stall was first seen")
+	}
+	if limitHasReset(pane, nil, now, now) {
+		t.Error("a stall first seen now should resolve from today")
+	}
+	stalled := &config.QuotaState{Stalled: map[string]config.StalledSession{
+		pane.Session: {ResetsAt: pane.ResetsAt, Since: seen.UTC().Format(time.RFC3339)},
+	}}
+	if !limitHasReset(pane, stalled, time.Time{}, now) {
+		t.Error("reset should resolve from the recorded stall")
+	}
+}
+
+func TestWithRecordedStalls(t *testing.T) {
+	state := &config.QuotaState{Stalled: map[string]config.StalledSession{
+		"gt-gastown-headless": {Account: "work", ResetsAt: "7pm"},
+		"gt-gastown-resumed":  {Account: "work"},
+		"gt-gastown-pane":     {Account: "work"},
+	}}
+	scanned := []quota.ScanResult{
+		{Session: "gt-gastown-resumed"},
+		{Session: "gt-gastown-pane", RateLimited: true, ResetsAt: "8pm"},
+	}
+	results, stale := withRecordedStalls(scanned, state)
+	if len(results) != 3 || results[2].Session != "gt-gastown-headless" || !results[2].RateLimited || results[2].ResetsAt != "7pm" {
+		t.Errorf("results = %+v, want the headless stall added", results)
+	}
+	if len(stale) != 1 || stale[0] != "gt-gastown-resumed" {
+		t.Errorf("stale = %v, want the session the scan found working", stale)
+	}
+}
+
+func TestLimitsSnoozedUntil(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	d := &Daemon{config: &Config{TownRoot: townRoot}, logger: log.New(io.Discard, "", 0)}
+
+	if _, ok := d.limitsSnoozedUntil(); ok {
+		t.Fatal("snoozed without a snooze set")
+	}
+	if _, err := quota.NewManager(townRoot).Snooze(30*time.Minute, "demo", "overseer"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := d.limitsSnoozedUntil(); !ok {
+		t.Fatal("snooze not detected")
+	}
+	// Snoozed towns skip the session scan entirely, so no tmux is needed.
+	if woken := d.wakeLimitStalledPolecats(); woken != 0 {
+		t.Errorf("woke %d polecats while snoozed", woken)
+	}
+}
@@ -3,6 +3,7 @@ package daemon
 import (
 	"context"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -23,6 +24,14 @@ type daemonMetrics struct {
 	// polecatSpawns counts polecat session spawns, labeled by rig name.
 	polecatSpawns metric.Int64Counter
 
+	// heartbeatTaskDuration records how long each heartbeat task ran,
+	// labeled by task name.
+	heartbeatTaskDuration metric.Float64Histogram
+
+	// heartbeatTaskOverBudget counts heartbeat tasks that exceeded their
+	// budget, labeled by task name.
+	heartbeatTaskOverBudget metric.Int64Counter
+
 	// doltMu protects dolt gauge values written by the health check goroutine.
 	doltMu             sync.RWMutex
 	doltConnections    int64
@@ -62,6 +71,21 @@ func newDaemonMetrics() (*daemonMetrics, error) {
 		return nil, err
 	}
 
+	dm.heartbeatTaskDuration, err = m.Float64Histogram("gastown.daemon.heartbeat.task.duration_ms",
+		metric.WithDescription("Duration of each daemon heartbeat task"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dm.heartbeatTaskOverBudget, err = m.Int64Counter("gastown.daemon.heartbeat.task.over_budget.total",
+		metric.WithDescription("Total number of heartbeat tasks that exceeded their time budget"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Dolt observable gauges — values are updated by health checks and
 	// collected by the SDK on each export interval.
 	connGauge, err := m.Int64ObservableGauge("gastown.dolt.connections",
@@ -147,6 +171,19 @@ func (dm *daemonMetrics) recordPolecatSpawn(ctx context.Context, rigName string)
 	)
 }
 
+// recordHeartbeatTask records a heartbeat task's duration, and counts it if
+// it ran over budget.
+func (dm *daemonMetrics) recordHeartbeatTask(ctx context.Context, task string, elapsed time.Duration, overBudget bool) {
+	if dm == nil {
+		return
+	}
+	attrs := metric.WithAttributes(attribute.String("task", task))
+	dm.heartbeatTaskDuration.Record(ctx, float64(elapsed.Microseconds())/1000, attrs)
+	if overBudget {
+		dm.heartbeatTaskOverBudget.Add(ctx, 1, attrs)
+	}
+}
+
 // updateDoltHealth stores the latest Dolt health snapshot for observable gauges.
 func (dm *daemonMetrics) updateDoltHealth(conns, maxConns int64, latencyMs float64, diskBytes int64, healthy bool) {
 	if dm == nil {
@@ -3,6 +3,7 @@ package daemon
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestNewDaemonMetrics(t *testing.T) {
@@ -22,6 +23,7 @@ func TestDaemonMetrics_NilReceiver(t *testing.T) {
 	// All methods must be nil-safe — no panic expected.
 	dm.recordHeartbeat(ctx)
 	dm.recordRestart(ctx, "deacon")
+	dm.recordHeartbeatTask(ctx, "dispatch", time.Second, true)
 	dm.updateDoltHealth(5, 100, 2.5, 1024, true)
 }
 
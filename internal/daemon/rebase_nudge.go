@@ -0,0 +1,232 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
+	gitpkg "github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// Rig config keys for stale-branch rebase nudges (gt rig config set).
+const (
+	// rebaseNudgeBehindKey is how many commits a polecat branch may fall
+	// behind its base before the agent is nudged. 0 disables the check.
+	rebaseNudgeBehindKey = "rebase_nudge_behind"
+	// rebaseNudgeModeKey selects what happens to a stale branch:
+	// rebaseModeNudge or rebaseModeAuto.
+	rebaseNudgeModeKey = "rebase_nudge_mode"
+)
+
+const (
+	// rebaseModeNudge asks the agent to rebase.
+	rebaseModeNudge = "nudge"
+	// rebaseModeAuto also rebases clean worktrees in place while the
+	// polecat has no live session, queueing a note for when it restarts.
+	// A live session is only ever nudged: rebasing under a working agent
+	// races with its edits and commits.
+	rebaseModeAuto = "auto"
+)
+
+// rebaseNudgeCooldown is the minimum interval between rebase nudges to the
+// same session, so an agent finishing a step isn't nagged every heartbeat.
+const rebaseNudgeCooldown = time.Hour
+
+// nudgeStaleBranches checks polecats working a bead in rigs that enable
+// rebase_nudge_behind, and nudges (or, with rebase_nudge_mode=auto, rebases)
+// those whose branch has fallen that many commits behind its base: the
+// bead's or convoy's base_branch, else its epic's integration branch, else
+// the rig's default branch. Rebasing mid-task keeps conflicts small instead of leaving them all
+// for the merge queue.
+func (d *Daemon) nudgeStaleBranches() {
+	d.rigPool.runPerRig(d.ctx, d.getKnownRigs(), func(ctx context.Context, rigName string) error {
+		d.nudgeRigStaleBranches(rigName)
+		return nil
+	})
+}
+
+// nudgeRigStaleBranches runs the stale-branch check for one rig.
+func (d *Daemon) nudgeRigStaleBranches(rigName string) {
+	rigPath := filepath.Join(d.config.TownRoot, rigName)
+	r := &rig.Rig{Name: rigName, Path: rigPath}
+	threshold := r.GetIntConfig(rebaseNudgeBehindKey)
+	if threshold <= 0 {
+		return
+	}
+	mode := r.GetStringConfig(rebaseNudgeModeKey)
+
+	defaultBranch := "main"
+	if rigCfg, err := rig.LoadRigConfig(rigPath); err == nil && rigCfg.DefaultBranch != "" {
+		defaultBranch = rigCfg.DefaultBranch
+	}
+
+	polecats, err := listPolecatWorktrees(filepath.Join(rigPath, "polecats"))
+	if err != nil {
+		return
+	}
+	bd := beads.New(d.config.TownRoot)
+	prefix := beads.GetPrefixForRig(d.config.TownRoot, rigName)
+	for _, name := range polecats {
+		sessionName := session.PolecatSessionName(session.PrefixFor(rigName), name)
+		if !d.rebaseNudgeDue(sessionName, time.Now()) {
+			continue
+		}
+		alive, err := d.tmux.HasSession(sessionName)
+		if err != nil || (!alive && mode != rebaseModeAuto) {
+			continue
+		}
+		info, err := d.getAgentBeadInfo(beads.PolecatBeadIDWithPrefix(prefix, rigName, name))
+		if err != nil || info.HookBead == "" || d.isBeadClosed(info.HookBead) {
+			continue // Not mid-bead; the next dispatch starts from a fresh base
+		}
+
+		g := gitpkg.NewGit(polecatClonePath(rigPath, rigName, name))
+		if !g.IsRepo() {
+			continue
+		}
+		base, err := rebaseBase(bd, g, info.HookBead, defaultBranch)
+		if err != nil {
+			d.logger.Printf("rebase_nudge: skipping %s/%s: resolving base of %s: %v", rigName, name, info.HookBead, err)
+			continue
+		}
+		_ = g.Fetch("origin")
+		behind, err := g.CountCommitsBehind(base)
+		if err != nil || behind < threshold {
+			continue
+		}
+
+		action := rebaseModeNudge
+		if !alive {
+			if !autoRebase(g, base) {
+				continue // Left for the agent to rebase once its session is back
+			}
+			action = rebaseModeAuto
+		}
+		msg := rebaseNudgeMessage(action, behind, base)
+		via, err := session.NewWaker(d.config.TownRoot, "daemon", d.tmux).Wake(sessionName, msg)
+		if err != nil {
+			d.logger.Printf("rebase_nudge: nudging %s via %s: %v", sessionName, via, err)
+			if action == rebaseModeNudge {
+				continue
+			}
+		}
+		d.recordRebaseNudge(sessionName, time.Now())
+		d.logger.Printf("rebase_nudge: %s/%s is %d commit(s) behind %s on %s (%s, via %s)",
+			rigName, name, behind, base, info.HookBead, action, via)
+		_ = events.LogAudit(events.TypeRebaseNudge, "daemon",
+			events.RebaseNudgePayload(rigName, name, info.HookBead, base, behind, action))
+	}
+}
+
+// rebaseBase returns the remote ref a polecat working hookBead should
+// rebase onto. The same precedence as gt mq submit applies: the bead's
+// base_branch formula var (gt sling --base-branch), then its convoy's
+// base_branch, then its epic's integration branch, then defaultBranch. A
+// failed lookup is returned rather than guessed, so a polecat on a feature
+// or integration branch is never rebased onto the default branch.
+func rebaseBase(bd beads.IssueShower, g beads.BranchChecker, hookBead, defaultBranch string) (string, error) {
+	issue, err := bd.Show(hookBead)
+	if err != nil {
+		return "", err
+	}
+	branch := ""
+	if af := beads.ParseAttachmentFields(issue); af != nil {
+		branch = formulaVar(af.FormulaVars, "base_branch")
+		if branch == "" && af.ConvoyID != "" {
+			convoy, err := bd.Show(af.ConvoyID)
+			if err != nil {
+				return "", fmt.Errorf("convoy %s: %w", af.ConvoyID, err)
+			}
+			if cf := beads.ParseConvoyFields(convoy); cf != nil {
+				branch = cf.BaseBranch
+			}
+		}
+	}
+	if branch == "" {
+		if branch, err = beads.DetectIntegrationBranch(bd, g, hookBead); err != nil {
+			return "", err
+		}
+	}
+	if branch == "" {
+		branch = defaultBranch
+	}
+	return "origin/" + strings.TrimPrefix(branch, "origin/"), nil
+}
+
+// formulaVar returns key's value from newline-separated key=value pairs.
+func formulaVar(formulaVars, key string) string {
+	for _, line := range strings.Split(formulaVars, "\n") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(line), "="); ok && k == key {
+			return v
+		}
+	}
+	return ""
+}
+
+// rebaseNudgeDue reports whether sessionName is out of its nudge cooldown.
+func (d *Daemon) rebaseNudgeDue(sessionName string, now time.Time) bool {
+	d.rebaseNudgeMu.Lock()
+	defer d.rebaseNudgeMu.Unlock()
+	last, ok := d.lastRebaseNudge[sessionName]
+	return !ok || now.Sub(last) >= rebaseNudgeCooldown
+}
+
+// recordRebaseNudge starts sessionName's nudge cooldown.
+func (d *Daemon) recordRebaseNudge(sessionName string, now time.Time) {
+	d.rebaseNudgeMu.Lock()
+	defer d.rebaseNudgeMu.Unlock()
+	if d.lastRebaseNudge == nil {
+		d.lastRebaseNudge = make(map[string]time.Time)
+	}
+	d.lastRebaseNudge[sessionName] = now
+}
+
+// autoRebase rebases a polecat worktree onto base, reporting whether it did.
+// Worktrees with uncommitted work are left alone, and a conflicting rebase is
+// aborted so the agent finds its branch as it left it.
+func autoRebase(g *gitpkg.Git, base string) bool {
+	status, err := g.CheckUncommittedWork()
+	if err != nil || !status.CleanExcludingBeads() {
+		return false
+	}
+	if err := g.Rebase(base); err != nil {
+		_ = g.AbortRebase()
+		return false
+	}
+	return true
+}
+
+// rebaseNudgeMessage is the prompt injected into a polecat whose branch is
+// behind base by the given number of commits. action says whether the daemon
+// already rebased it.
+func rebaseNudgeMessage(action string, behind int, base string) string {
+	if action == rebaseModeAuto {
+		return fmt.Sprintf("While your session was down, your branch was %d commit(s) behind %s and has been rebased onto it. "+
+			"Re-run the build and tests before continuing; use git push --force-with-lease if you had already pushed.",
+			behind, base)
+	}
+	return fmt.Sprintf("Your branch is %d commit(s) behind %s. Rebase now, while conflicts are small: "+
+		"commit your work in progress, run git fetch origin && git rebase %s, then continue.",
+		behind, base, base)
+}
+
+// polecatClonePath returns a polecat's worktree: polecats/<name>/<rig>/, or
+// the legacy polecats/<name>/ when that holds the checkout.
+func polecatClonePath(rigPath, rigName, name string) string {
+	nested := filepath.Join(rigPath, "polecats", name, rigName)
+	if info, err := os.Stat(nested); err == nil && info.IsDir() {
+		return nested
+	}
+	flat := filepath.Join(rigPath, "polecats", name)
+	if _, err := os.Stat(filepath.Join(flat, ".git")); err == nil {
+		return flat
+	}
+	return nested
+}
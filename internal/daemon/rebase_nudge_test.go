@@ -0,0 +1,163 @@
+package daemon
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	gitpkg "github.com/steveyegge/gastown/internal/git"
+)
+
+// setupStaleBranch returns a repo checked out on "work", one commit behind
+// "base". conflict makes the two commits touch the same file.
+func setupStaleBranch(t *testing.T, conflict bool) *gitpkg.Git {
+	t.Helper()
+	dir := t.TempDir()
+	initGitRepo(t, dir)
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	git("branch", "base")
+	git("checkout", "-q", "-b", "work")
+	workFile := "work.txt"
+	if conflict {
+		workFile = "README"
+	}
+	os.WriteFile(filepath.Join(dir, workFile), []byte("work\n"), 0644)
+	commitAll(t, dir, "work")
+	git("checkout", "-q", "base")
+	os.WriteFile(filepath.Join(dir, "README"), []byte("upstream\n"), 0644)
+	commitAll(t, dir, "upstream")
+	git("checkout", "-q", "work")
+	return gitpkg.NewGit(dir)
+}
+
+func TestAutoRebase(t *testing.T) {
+	g := setupStaleBranch(t, false)
+	if behind, err := g.CountCommitsBehind("base"); err != nil || behind != 1 {
+		t.Fatalf("behind = %d, %v; want 1", behind, err)
+	}
+	if !autoRebase(g, "base") {
+		t.Fatal("autoRebase on a clean worktree = false, want true")
+	}
+	if behind, _ := g.CountCommitsBehind("base"); behind != 0 {
+		t.Errorf("behind after rebase = %d, want 0", behind)
+	}
+}
+
+func TestAutoRebase_DirtyWorktree(t *testing.T) {
+	g := setupStaleBranch(t, false)
+	os.WriteFile(filepath.Join(g.WorkDir(), "work.txt"), []byte("edited\n"), 0644)
+	if autoRebase(g, "base") {
+		t.Fatal("autoRebase rebased a worktree with uncommitted work")
+	}
+	if behind, _ := g.CountCommitsBehind("base"); behind != 1 {
+		t.Errorf("behind = %d, want the branch untouched", behind)
+	}
+}
+
+func TestAutoRebase_ConflictAborts(t *testing.T) {
+	g := setupStaleBranch(t, true)
+	if autoRebase(g, "base") {
+		t.Fatal("autoRebase reported success on a conflicting rebase")
+	}
+	if _, err := os.Stat(filepath.Join(g.WorkDir(), ".git", "rebase-merge")); err == nil {
+		t.Error("conflicting rebase was left in progress")
+	}
+	if branch, _ := g.CurrentBranch(); branch != "work" {
+		t.Errorf("branch = %q, want work", branch)
+	}
+}
+
+func TestRebaseNudgeMessage(t *testing.T) {
+	nudge := rebaseNudgeMessage(rebaseModeNudge, 12, "origin/main")
+	for _, want := range []string{"12 commit(s) behind origin/main", "git rebase origin/main"} {
+		if !strings.Contains(nudge, want) {
+			t.Errorf("nudge message missing %q: %s", want, nudge)
+		}
+	}
+	if auto := rebaseNudgeMessage(rebaseModeAuto, 12, "origin/main"); !strings.Contains(auto, "has been rebased") {
+		t.Errorf("auto message = %s", auto)
+	}
+}
+
+func TestRebaseNudgeCooldown(t *testing.T) {
+	d := &Daemon{}
+	now := time.Now()
+	if !d.rebaseNudgeDue("gt-toast", now) {
+		t.Fatal("never-nudged session not due")
+	}
+	d.recordRebaseNudge("gt-toast", now)
+	if d.rebaseNudgeDue("gt-toast", now.Add(rebaseNudgeCooldown/2)) {
+		t.Error("session due again inside the cooldown")
+	}
+	if !d.rebaseNudgeDue("gt-toast", now.Add(rebaseNudgeCooldown)) {
+		t.Error("session not due after the cooldown")
+	}
+	if !d.rebaseNudgeDue("gt-nux", now) {
+		t.Error("cooldown leaked to another session")
+	}
+}
+
+type fakeShower map[string]*beads.Issue
+
+func (f fakeShower) Show(id string) (*beads.Issue, error) {
+	if issue, ok := f[id]; ok {
+		return issue, nil
+	}
+	return nil, errors.New("not found")
+}
+
+type fakeBranches map[string]bool
+
+func (f fakeBranches) BranchExists(name string) (bool, error) { return f[name], nil }
+func (f fakeBranches) RemoteBranchExists(_, name string) (bool, error) {
+	return f[name], nil
+}
+
+func TestRebaseBase(t *testing.T) {
+	bd := fakeShower{
+		"gt-var":    {ID: "gt-var", Description: "formula_vars: base_branch=feat/x"},
+		"gt-convoy": {ID: "gt-convoy", Description: "convoy_id: hq-cv-1"},
+		"hq-cv-1":   {ID: "hq-cv-1", Description: "base_branch: origin/release"},
+		"gt-lost":   {ID: "gt-lost", Description: "convoy_id: hq-cv-gone"},
+		"gt-epic":   {ID: "gt-epic", Type: "epic", Description: "integration_branch: integration/auth"},
+		"gt-child":  {ID: "gt-child", Parent: "gt-epic"},
+		"gt-plain":  {ID: "gt-plain"},
+	}
+	branches := fakeBranches{"integration/auth": true}
+	tests := []struct {
+		bead    string
+		want    string
+		wantErr bool
+	}{
+		{bead: "gt-var", want: "origin/feat/x"},
+		{bead: "gt-convoy", want: "origin/release"},
+		{bead: "gt-child", want: "origin/integration/auth"},
+		{bead: "gt-plain", want: "origin/main"},
+		{bead: "gt-lost", wantErr: true},
+		{bead: "gt-missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := rebaseBase(bd, branches, tt.bead, "main")
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("rebaseBase(%s) = %q, want an error", tt.bead, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("rebaseBase(%s) = %q, %v; want %q", tt.bead, got, err, tt.want)
+		}
+	}
+}
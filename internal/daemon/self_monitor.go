@@ -0,0 +1,262 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/scheduler/capacity"
+)
+
+// ErrCrashLoop is returned by Run when the daemon has restarted too often
+// and stopped itself. The crash loop incident file says what happened;
+// gt daemon clear-crash-loop lets it start again.
+var ErrCrashLoop = errors.New("daemon crash loop")
+
+// crashLoopPausedBy marks a scheduler pause made by crash loop detection.
+const crashLoopPausedBy = "daemon (crash loop)"
+
+// SelfMonitorState is the daemon's record of its own restarts and heartbeat
+// failures, kept across processes so a supervisor restarting a crashing
+// daemon over and over is noticed.
+type SelfMonitorState struct {
+	// Running is set while a daemon runs and cleared on a clean exit. A
+	// daemon that starts and finds it set knows the last one crashed.
+	Running bool `json:"running"`
+
+	Starts       int         `json:"starts"`            // Daemon starts, ever
+	UncleanExits int         `json:"unclean_exits"`     // Starts that found the last run crashed
+	Crashes      []time.Time `json:"crashes,omitempty"` // Unclean restarts within the crash loop window
+
+	HeartbeatFailures      int       `json:"heartbeat_failures"` // Heartbeats that panicked, ever
+	LastHeartbeatFailure   string    `json:"last_heartbeat_failure,omitempty"`
+	LastHeartbeatFailureAt time.Time `json:"last_heartbeat_failure_at,omitempty"`
+
+	// CrashLoopAt is when a crash loop stopped the daemon. Zero otherwise.
+	CrashLoopAt time.Time `json:"crash_loop_at,omitempty"`
+}
+
+// selfMonitorFile returns the path to the self-monitor state file.
+func selfMonitorFile(townRoot string) string {
+	return filepath.Join(townRoot, "daemon", "self_monitor.json")
+}
+
+// CrashLoopIncidentPath returns the incident file written when the daemon
+// stops itself for crash looping. It lives in the town root so it is seen.
+func CrashLoopIncidentPath(townRoot string) string {
+	return filepath.Join(townRoot, "DAEMON-CRASH-LOOP.md")
+}
+
+// LoadSelfMonitor loads the daemon's self-monitor state. A missing file is
+// an empty state.
+func LoadSelfMonitor(townRoot string) (*SelfMonitorState, error) {
+	s := &SelfMonitorState{}
+	data, err := os.ReadFile(selfMonitorFile(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SaveSelfMonitor persists the daemon's self-monitor state.
+func SaveSelfMonitor(townRoot string, s *SelfMonitorState) error {
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		return err
+	}
+	return atomicfile.WriteJSON(selfMonitorFile(townRoot), s)
+}
+
+// RecordStart records a daemon start at now, counting it as a crash when
+// the last run didn't exit cleanly, and reports whether at least count
+// crashes fell within window: a crash loop.
+func (s *SelfMonitorState) RecordStart(now time.Time, window time.Duration, count int) bool {
+	s.Starts++
+	if s.Running {
+		s.UncleanExits++
+		s.Crashes = append(s.Crashes, now)
+	}
+	s.Running = true
+
+	recent := s.Crashes[:0]
+	for _, t := range s.Crashes {
+		if now.Sub(t) <= window {
+			recent = append(recent, t)
+		}
+	}
+	s.Crashes = recent
+	return count > 0 && len(s.Crashes) >= count
+}
+
+// RecordHeartbeatFailure records a heartbeat that failed with msg.
+func (s *SelfMonitorState) RecordHeartbeatFailure(msg string, now time.Time) {
+	s.HeartbeatFailures++
+	s.LastHeartbeatFailure = msg
+	s.LastHeartbeatFailureAt = now
+}
+
+// ClearCrashLoop forgets recent crashes and an open crash loop, so the
+// daemon starts afresh. Lifetime counters are kept.
+func (s *SelfMonitorState) ClearCrashLoop() {
+	s.Running = false
+	s.Crashes = nil
+	s.CrashLoopAt = time.Time{}
+}
+
+// ClearCrashLoop lets a daemon stopped by a crash loop start again: it
+// removes the incident file and forgets the recent crashes. The scheduler
+// is left paused; resume it once the cause is fixed.
+func ClearCrashLoop(townRoot string) error {
+	s, err := LoadSelfMonitor(townRoot)
+	if err != nil {
+		return err
+	}
+	s.ClearCrashLoop()
+	if err := SaveSelfMonitor(townRoot, s); err != nil {
+		return err
+	}
+	if err := os.Remove(CrashLoopIncidentPath(townRoot)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// checkCrashLoop records this start and refuses to run when the daemon is
+// crash looping: too many unclean restarts within the window, or an
+// incident from an earlier crash loop still open. Entering a crash loop
+// writes the incident file, pauses the scheduler and notifies the overseer,
+// so a supervisor restarting a broken daemon doesn't thrash unnoticed.
+func (d *Daemon) checkCrashLoop() error {
+	townRoot := d.config.TownRoot
+	incident := CrashLoopIncidentPath(townRoot)
+	if _, err := os.Stat(incident); err == nil {
+		return fmt.Errorf("%w: incident open (see %s); fix the cause, then run 'gt daemon clear-crash-loop'", ErrCrashLoop, incident)
+	}
+
+	s, err := LoadSelfMonitor(townRoot)
+	if err != nil {
+		d.logger.Printf("Warning: self-monitor state unreadable, starting fresh: %v", err)
+		s = &SelfMonitorState{}
+	}
+	cfg := d.loadOperationalConfig().GetDaemonConfig()
+	window, count := cfg.CrashLoopWindowD(), cfg.CrashLoopCountV()
+	now := time.Now()
+	looping := s.RecordStart(now, window, count)
+	if looping {
+		s.Running = false // This process stops on purpose
+		s.CrashLoopAt = now
+	}
+	if err := SaveSelfMonitor(townRoot, s); err != nil {
+		d.logger.Printf("Warning: failed to save self-monitor state: %v", err)
+	}
+	if s.UncleanExits > 0 && len(s.Crashes) > 0 && !looping {
+		d.logger.Printf("Previous daemon did not exit cleanly (%d unclean restart(s) in the last %v)", len(s.Crashes), window)
+	}
+	if !looping {
+		return nil
+	}
+
+	d.enterCrashLoop(s, window)
+	return fmt.Errorf("%w: %d unclean restarts in %v; stopped (see %s)", ErrCrashLoop, len(s.Crashes), window, incident)
+}
+
+// enterCrashLoop writes the incident file, pauses the scheduler and
+// notifies the overseer. Each step is best-effort: the daemon stops either way.
+func (d *Daemon) enterCrashLoop(s *SelfMonitorState, window time.Duration) {
+	townRoot := d.config.TownRoot
+	incident := CrashLoopIncidentPath(townRoot)
+	d.logger.Printf("CRASH LOOP: %d unclean restarts in %v, stopping; see %s", len(s.Crashes), window, incident)
+
+	if err := atomicfile.WriteFile(incident, []byte(crashLoopIncident(s, window, d.config.LogFile)), 0644); err != nil {
+		d.logger.Printf("Warning: failed to write crash loop incident: %v", err)
+	}
+
+	if _, err := capacity.UpdateState(townRoot, func(state *capacity.SchedulerState) (bool, error) {
+		if state.Paused {
+			return false, nil
+		}
+		state.SetPaused(crashLoopPausedBy)
+		return true, nil
+	}); err != nil {
+		d.logger.Printf("Warning: failed to pause scheduler: %v", err)
+	}
+
+	_ = events.LogFeed(events.TypeDaemonCrashLoop, "daemon",
+		events.DaemonCrashLoopPayload(len(s.Crashes), window.String(), s.HeartbeatFailures, incident))
+
+	router := mail.NewRouterWithTownRoot(townRoot, townRoot)
+	defer router.WaitPendingNotifications()
+	if err := router.Send(&mail.Message{
+		From:     "daemon",
+		To:       "overseer",
+		Subject:  fmt.Sprintf("Daemon crash loop: %d restarts in %v, daemon stopped", len(s.Crashes), window),
+		Body:     crashLoopIncident(s, window, d.config.LogFile),
+		Priority: mail.PriorityUrgent,
+	}); err != nil {
+		d.logger.Printf("Warning: failed to notify overseer of crash loop: %v", err)
+	}
+}
+
+// crashLoopIncident renders the incident report for a crash loop.
+func crashLoopIncident(s *SelfMonitorState, window time.Duration, logFile string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Daemon crash loop\n\n")
+	fmt.Fprintf(&b, "The Gas Town daemon restarted %d times in %v without exiting cleanly, so it\n", len(s.Crashes), window)
+	fmt.Fprintf(&b, "stopped itself instead of restarting again. The scheduler is paused.\n\n")
+	fmt.Fprintf(&b, "Stopped: %s\n\n", s.CrashLoopAt.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Unclean restarts:\n")
+	for _, t := range s.Crashes {
+		fmt.Fprintf(&b, "  - %s\n", t.Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, "\nLifetime: %d starts, %d unclean, %d heartbeat failures\n", s.Starts, s.UncleanExits, s.HeartbeatFailures)
+	if s.LastHeartbeatFailure != "" {
+		fmt.Fprintf(&b, "Last heartbeat failure (%s): %s\n", s.LastHeartbeatFailureAt.Format(time.RFC3339), s.LastHeartbeatFailure)
+	}
+	fmt.Fprintf(&b, "\n## Recovery\n\n")
+	fmt.Fprintf(&b, "1. Find the cause in the daemon log: %s (gt daemon logs)\n", logFile)
+	fmt.Fprintf(&b, "2. Fix it, then: gt daemon clear-crash-loop && gt daemon start\n")
+	fmt.Fprintf(&b, "3. Resume dispatch: gt scheduler resume\n")
+	return b.String()
+}
+
+// recordExit marks a clean daemon exit, so the next start isn't counted as
+// a crash restart.
+func (d *Daemon) recordExit() {
+	s, err := LoadSelfMonitor(d.config.TownRoot)
+	if err != nil {
+		return
+	}
+	s.Running = false
+	if err := SaveSelfMonitor(d.config.TownRoot, s); err != nil {
+		d.logger.Printf("Warning: failed to save self-monitor state: %v", err)
+	}
+}
+
+// recordHeartbeatPanic records a heartbeat that panicked, then lets the
+// panic continue: the daemon crashes as before, and crash loop detection
+// sees the restart. Deferred at the top of heartbeat.
+func (d *Daemon) recordHeartbeatPanic() {
+	r := recover()
+	if r == nil {
+		return
+	}
+	d.logger.Printf("Heartbeat panicked: %v\n%s", r, debug.Stack())
+	if s, err := LoadSelfMonitor(d.config.TownRoot); err == nil {
+		s.RecordHeartbeatFailure(fmt.Sprint(r), time.Now())
+		_ = SaveSelfMonitor(d.config.TownRoot, s)
+	}
+	panic(r)
+}
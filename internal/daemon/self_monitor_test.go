@@ -0,0 +1,81 @@
+package daemon
+
+import (
+	"errors"
+	"io"
+	"log"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestSelfMonitorRecordStart(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	window := 10 * time.Minute
+	s := &SelfMonitorState{}
+
+	// A first start, and a restart after a clean exit, aren't crashes.
+	if s.RecordStart(base, window, 3) {
+		t.Fatal("first start reported a crash loop")
+	}
+	s.Running = false
+	if s.RecordStart(base.Add(time.Minute), window, 3) || s.UncleanExits != 0 {
+		t.Fatalf("clean restart counted as a crash: %+v", s)
+	}
+
+	// Two unclean restarts: not yet a loop.
+	for i := 2; i <= 3; i++ {
+		if s.RecordStart(base.Add(time.Duration(i)*time.Minute), window, 3) {
+			t.Fatalf("crash loop after %d crashes", s.UncleanExits)
+		}
+	}
+	// The third within the window is.
+	if !s.RecordStart(base.Add(4*time.Minute), window, 3) {
+		t.Fatalf("want crash loop after 3 crashes in %v: %+v", window, s)
+	}
+	if s.Starts != 5 || s.UncleanExits != 3 {
+		t.Errorf("Starts = %d, UncleanExits = %d; want 5, 3", s.Starts, s.UncleanExits)
+	}
+
+	// Crashes age out of the window.
+	if s.RecordStart(base.Add(time.Hour), window, 3) {
+		t.Error("old crashes still counted")
+	}
+	if len(s.Crashes) != 1 {
+		t.Errorf("Crashes = %v, want only the latest", s.Crashes)
+	}
+}
+
+func TestCheckCrashLoopRefusesWithOpenIncident(t *testing.T) {
+	townRoot := t.TempDir()
+	d := &Daemon{config: &Config{TownRoot: townRoot}, logger: log.New(io.Discard, "", 0)}
+
+	if err := d.checkCrashLoop(); err != nil {
+		t.Fatalf("first start: %v", err)
+	}
+	d.recordExit()
+
+	if err := os.WriteFile(CrashLoopIncidentPath(townRoot), []byte("# Daemon crash loop\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.checkCrashLoop(); !errors.Is(err, ErrCrashLoop) {
+		t.Fatalf("open incident: got %v, want ErrCrashLoop", err)
+	}
+
+	if err := ClearCrashLoop(townRoot); err != nil {
+		t.Fatalf("ClearCrashLoop: %v", err)
+	}
+	if _, err := os.Stat(CrashLoopIncidentPath(townRoot)); !os.IsNotExist(err) {
+		t.Error("incident file not removed")
+	}
+	if err := d.checkCrashLoop(); err != nil {
+		t.Fatalf("after clear: %v", err)
+	}
+	s, err := LoadSelfMonitor(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Starts != 2 || !s.Running {
+		t.Errorf("state = %+v, want 2 starts, running", s)
+	}
+}
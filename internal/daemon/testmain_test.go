@@ -9,6 +9,7 @@ import (
 
 	"github.com/steveyegge/gastown/internal/testutil"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 func TestMain(m *testing.M) {
@@ -34,8 +35,19 @@ func TestMain(m *testing.M) {
 		tmux.SetDefaultSocket(tmuxSocket)
 	}
 
+	// Session deaths log feed events to the town found from the CWD, which
+	// for this package is the source tree (internal/mayor looks like a
+	// town). Send them to a throwaway town instead.
+	town, err := os.MkdirTemp("", "daemon-test-town-")
+	if err == nil {
+		workspace.SetTownOverride(town)
+	}
+
 	code := m.Run()
 
+	if town != "" {
+		_ = os.RemoveAll(town)
+	}
 	if tmuxSocket != "" {
 		_ = exec.Command("tmux", "-L", tmuxSocket, "kill-server").Run()
 		socketPath := filepath.Join(tmux.SocketDir(), tmuxSocket)
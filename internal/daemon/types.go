@@ -32,6 +32,10 @@ type Config struct {
 
 	// PidFile is the path to the PID file.
 	PidFile string `json:"pid_file"`
+
+	// Standby makes the daemon wait as an observer while another daemon
+	// holds the leader lease, taking over when it lapses, instead of exiting.
+	Standby bool `json:"standby,omitempty"`
 }
 
 // DefaultConfig returns the default daemon configuration.
@@ -130,6 +134,7 @@ type PatrolsConfig struct {
 	ScheduledMaintenance   *ScheduledMaintenanceConfig    `json:"scheduled_maintenance,omitempty"`
 	MainBranchTest         *MainBranchTestConfig          `json:"main_branch_test,omitempty"`
 	QuotaDog               *QuotaDogConfig                `json:"quota_dog,omitempty"`
+	WorktreePool           *WorktreePoolConfig            `json:"worktree_pool,omitempty"`
 	RestartTracker         *RestartTrackerConfig          `json:"restart_tracker,omitempty"`
 }
 
@@ -204,6 +209,8 @@ type DaemonPatrolConfig struct {
 	// Propagated to all sessions spawned by the daemon and read by gt up/mayor attach.
 	// Example: {"GT_DOLT_PORT": "43211"}
 	Env       map[string]string `json:"env,omitempty"`
+	// EnergySaver configures the deep-sleep quiet period (see energy_saver.go).
+	EnergySaver *EnergySaverConfig `json:"energy_saver,omitempty"`
 }
 
 // PatrolConfigFile returns the path to the patrol config file.
@@ -308,6 +315,12 @@ func IsPatrolEnabled(config *DaemonPatrolConfig, patrol string) bool {
 		}
 		return config.Patrols.QuotaDog.Enabled
 	}
+	if patrol == "worktree_pool" {
+		if config == nil || config.Patrols == nil || config.Patrols.WorktreePool == nil {
+			return false
+		}
+		return config.Patrols.WorktreePool.Enabled
+	}
 
 	if config == nil || config.Patrols == nil {
 		return true // Default: enabled
@@ -0,0 +1,87 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+const (
+	defaultWorktreePoolInterval = 10 * time.Minute
+	// worktreePoolTimeout bounds one fill cycle (fresh checkouts of big repos).
+	worktreePoolTimeout = 15 * time.Minute
+)
+
+// WorktreePoolConfig holds configuration for the worktree_pool patrol.
+// This patrol keeps each rig's pool of pre-warmed polecat worktrees at its
+// worktree_pool_size so spawns skip git worktree add.
+type WorktreePoolConfig struct {
+	// Enabled controls whether the pool is refilled.
+	Enabled bool `json:"enabled"`
+
+	// IntervalStr is how often to refill, as a string (e.g., "10m").
+	IntervalStr string `json:"interval,omitempty"`
+}
+
+// worktreePoolInterval returns the configured interval, or the default (10m).
+func worktreePoolInterval(config *DaemonPatrolConfig) time.Duration {
+	if config != nil && config.Patrols != nil && config.Patrols.WorktreePool != nil {
+		if config.Patrols.WorktreePool.IntervalStr != "" {
+			if d, err := time.ParseDuration(config.Patrols.WorktreePool.IntervalStr); err == nil && d > 0 {
+				return d
+			}
+		}
+	}
+	return defaultWorktreePoolInterval
+}
+
+// runWorktreePool refills worktree pools by shelling out to
+// `gt polecat worktree-pool fill`, which does the checkouts. Fills are
+// background work: the cycle is skipped while the system is under pressure,
+// and when no rig has a pool configured.
+func (d *Daemon) runWorktreePool() {
+	if !d.isPatrolActive("worktree_pool") {
+		return
+	}
+	if !d.anyRigHasWorktreePool() {
+		return
+	}
+	if p := d.checkPressure("worktree_pool"); !p.OK {
+		d.logger.Printf("worktree_pool: deferring fill: %s", p.Reason)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(d.ctx, worktreePoolTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, d.gtPath, "polecat", "worktree-pool", "fill") //nolint:gosec // G204: gtPath resolved at daemon init
+	cmd.Dir = d.config.TownRoot
+
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	err := cmd.Run()
+	if s := strings.TrimSpace(out.String()); s != "" {
+		d.logger.Printf("worktree_pool: %s", s)
+	}
+	if err != nil {
+		d.logger.Printf("worktree_pool: fill failed (non-fatal): %v", err)
+	}
+}
+
+// anyRigHasWorktreePool reports whether a known rig has worktree_pool_size set.
+func (d *Daemon) anyRigHasWorktreePool() bool {
+	for _, rigName := range d.getKnownRigs() {
+		cfg, err := rig.LoadRigConfig(filepath.Join(d.config.TownRoot, rigName))
+		if err == nil && cfg.WorktreePoolSize > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorktreePoolInterval(t *testing.T) {
+	if got := worktreePoolInterval(nil); got != defaultWorktreePoolInterval {
+		t.Errorf("expected default interval %v, got %v", defaultWorktreePoolInterval, got)
+	}
+
+	config := &DaemonPatrolConfig{
+		Patrols: &PatrolsConfig{
+			WorktreePool: &WorktreePoolConfig{Enabled: true, IntervalStr: "30m"},
+		},
+	}
+	if got := worktreePoolInterval(config); got != 30*time.Minute {
+		t.Errorf("expected 30m interval, got %v", got)
+	}
+
+	config.Patrols.WorktreePool.IntervalStr = "invalid"
+	if got := worktreePoolInterval(config); got != defaultWorktreePoolInterval {
+		t.Errorf("expected default interval for invalid config, got %v", got)
+	}
+}
+
+func TestIsPatrolEnabled_WorktreePool(t *testing.T) {
+	// Opt-in: disabled with nil or empty config
+	if IsPatrolEnabled(nil, "worktree_pool") {
+		t.Error("expected worktree_pool to be disabled with nil config")
+	}
+	config := &DaemonPatrolConfig{Patrols: &PatrolsConfig{}}
+	if IsPatrolEnabled(config, "worktree_pool") {
+		t.Error("expected worktree_pool to be disabled by default")
+	}
+
+	config.Patrols.WorktreePool = &WorktreePoolConfig{Enabled: true}
+	if !IsPatrolEnabled(config, "worktree_pool") {
+		t.Error("expected worktree_pool to be enabled when configured")
+	}
+}
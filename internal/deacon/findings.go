@@ -0,0 +1,262 @@
+package deacon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Finding kinds recorded by each patrol.
+const (
+	FindingStaleLock     = "stale_lock"     // agent.lock held by a dead process with no session
+	FindingOrphanSession = "orphan_session" // Gas Town tmux session with no valid rig or role
+	FindingSLABreach     = "sla_breach"     // bead hooked longer than operational.deacon.hooked_sla
+	FindingDisk          = "disk"           // low free space on the town filesystem
+)
+
+// FindingKinds lists the finding kinds in report order.
+var FindingKinds = []string{FindingStaleLock, FindingOrphanSession, FindingSLABreach, FindingDisk}
+
+// MaxPatrolFindings is how many patrol findings files are kept on disk.
+const MaxPatrolFindings = 200
+
+// Finding is one actionable problem observed during a patrol.
+type Finding struct {
+	Kind     string `json:"kind"`
+	Subject  string `json:"subject"`          // What the finding is about (session, bead, path)
+	Detail   string `json:"detail,omitempty"` // Human-readable description
+	Severity string `json:"severity"`         // "warning" or "critical"
+	Action   string `json:"action,omitempty"` // Suggested command to resolve it
+}
+
+// PatrolFindings is the structured findings file written by one patrol.
+type PatrolFindings struct {
+	PatrolID  string    `json:"patrol_id,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Summary   string    `json:"summary,omitempty"`
+	Findings  []Finding `json:"findings"`
+
+	// Errors records checks that could not run, so an empty Findings list
+	// isn't mistaken for a clean bill of health.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// FindingsDir returns the directory holding per-patrol findings files.
+func FindingsDir(townRoot string) string {
+	return filepath.Join(townRoot, "deacon", "findings")
+}
+
+// WriteFindings writes pf as a new findings file and prunes the oldest
+// files beyond MaxPatrolFindings.
+func WriteFindings(townRoot string, pf *PatrolFindings) error {
+	dir := FindingsDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating findings dir: %w", err)
+	}
+	if pf.Timestamp.IsZero() {
+		pf.Timestamp = time.Now().UTC()
+	}
+	if pf.Findings == nil {
+		pf.Findings = []Finding{}
+	}
+
+	data, err := json.MarshalIndent(pf, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d.json", pf.Timestamp.UnixNano())
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil { //nolint:gosec // G306: findings are non-sensitive operational data
+		return fmt.Errorf("writing findings: %w", err)
+	}
+
+	files, err := findingsFiles(dir)
+	if err != nil {
+		return nil // Written; pruning is best-effort
+	}
+	for len(files) > MaxPatrolFindings {
+		_ = os.Remove(filepath.Join(dir, files[0]))
+		files = files[1:]
+	}
+	return nil
+}
+
+// LoadRecentFindings returns the last n patrols' findings, oldest first.
+// Unreadable files are skipped. Returns nil if no patrol has written any.
+func LoadRecentFindings(townRoot string, n int) ([]*PatrolFindings, error) {
+	dir := FindingsDir(townRoot)
+	files, err := findingsFiles(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading findings dir: %w", err)
+	}
+	if n > 0 && len(files) > n {
+		files = files[len(files)-n:]
+	}
+
+	var out []*PatrolFindings
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join(dir, name)) //nolint:gosec // G304: path is constructed from trusted townRoot
+		if err != nil {
+			continue
+		}
+		var pf PatrolFindings
+		if json.Unmarshal(data, &pf) != nil {
+			continue
+		}
+		out = append(out, &pf)
+	}
+	return out, nil
+}
+
+// findingsFiles returns the findings file names in dir, oldest first.
+func findingsFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			files = append(files, e.Name())
+		}
+	}
+	// Names are Unix nanosecond timestamps of equal width until 2286.
+	sort.Strings(files)
+	return files, nil
+}
+
+// HookedSLABreaches returns a finding for each bead hooked without an update
+// for longer than the configured hooked SLA.
+func HookedSLABreaches(townRoot string, now time.Time) ([]Finding, error) {
+	sla := config.LoadOperationalConfig(townRoot).GetDeaconConfig().HookedSLAD()
+	hooked, err := listHookedBeads(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("listing hooked beads: %w", err)
+	}
+	return hookedSLABreaches(hooked, sla, now), nil
+}
+
+func hookedSLABreaches(hooked []*HookedBead, sla time.Duration, now time.Time) []Finding {
+	var findings []Finding
+	for _, b := range hooked {
+		if b.UpdatedAt.IsZero() {
+			continue
+		}
+		age := now.Sub(b.UpdatedAt)
+		if age <= sla {
+			continue
+		}
+		severity := "warning"
+		if age > 2*sla {
+			severity = "critical"
+		}
+		findings = append(findings, Finding{
+			Kind:     FindingSLABreach,
+			Subject:  b.ID,
+			Detail:   fmt.Sprintf("hooked by %s with no update for %s (SLA %s): %s", b.Assignee, age.Round(time.Minute), sla, b.Title),
+			Severity: severity,
+			Action:   "gt show " + b.ID,
+		})
+	}
+	return findings
+}
+
+// KindTrend summarizes one finding kind across recent patrols.
+type KindTrend struct {
+	Kind     string  `json:"kind"`
+	Latest   int     `json:"latest"`
+	Previous int     `json:"previous"`
+	Average  float64 `json:"average"`
+	Trend    string  `json:"trend"` // "up", "down", or "flat" (latest vs previous)
+}
+
+// Recurring is a finding subject present in consecutive recent patrols.
+type Recurring struct {
+	Kind    string `json:"kind"`
+	Subject string `json:"subject"`
+	Patrols int    `json:"patrols"` // Consecutive patrols, ending with the latest
+	Action  string `json:"action,omitempty"`
+}
+
+// FindingsSummary summarizes findings across recent patrols.
+type FindingsSummary struct {
+	Patrols   int         `json:"patrols"`
+	From      time.Time   `json:"from"`
+	To        time.Time   `json:"to"`
+	Kinds     []KindTrend `json:"kinds"`
+	Recurring []Recurring `json:"recurring,omitempty"`
+	Latest    []Finding   `json:"latest"`
+}
+
+// recurringMin is how many consecutive patrols a finding must appear in
+// before the report calls it out as recurring (i.e. nobody is acting on it).
+const recurringMin = 3
+
+// SummarizeFindings computes per-kind trends and recurring findings across
+// patrols (oldest first).
+func SummarizeFindings(patrols []*PatrolFindings) *FindingsSummary {
+	s := &FindingsSummary{Patrols: len(patrols), Latest: []Finding{}}
+	if len(patrols) == 0 {
+		return s
+	}
+	latest := patrols[len(patrols)-1]
+	s.From, s.To = patrols[0].Timestamp, latest.Timestamp
+	s.Latest = latest.Findings
+
+	counts := make([]map[string]int, len(patrols))
+	for i, p := range patrols {
+		counts[i] = make(map[string]int)
+		for _, f := range p.Findings {
+			counts[i][f.Kind]++
+		}
+	}
+	for _, kind := range FindingKinds {
+		t := KindTrend{Kind: kind, Latest: counts[len(counts)-1][kind], Trend: "flat"}
+		total := 0
+		for _, c := range counts {
+			total += c[kind]
+		}
+		t.Average = float64(total) / float64(len(counts))
+		if len(counts) > 1 {
+			t.Previous = counts[len(counts)-2][kind]
+			switch {
+			case t.Latest > t.Previous:
+				t.Trend = "up"
+			case t.Latest < t.Previous:
+				t.Trend = "down"
+			}
+		}
+		s.Kinds = append(s.Kinds, t)
+	}
+
+	// A latest finding is recurring if the same kind+subject appears in the
+	// patrols immediately before it.
+	for _, f := range latest.Findings {
+		run := 1
+		for i := len(patrols) - 2; i >= 0 && hasFinding(patrols[i], f.Kind, f.Subject); i-- {
+			run++
+		}
+		if run >= recurringMin {
+			s.Recurring = append(s.Recurring, Recurring{Kind: f.Kind, Subject: f.Subject, Patrols: run, Action: f.Action})
+		}
+	}
+	sort.SliceStable(s.Recurring, func(i, j int) bool { return s.Recurring[i].Patrols > s.Recurring[j].Patrols })
+	return s
+}
+
+func hasFinding(p *PatrolFindings, kind, subject string) bool {
+	for _, f := range p.Findings {
+		if f.Kind == kind && f.Subject == subject {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package deacon
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWriteAndLoadFindings(t *testing.T) {
+	townRoot := t.TempDir()
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < MaxPatrolFindings+5; i++ {
+		pf := &PatrolFindings{PatrolID: "wisp", Timestamp: base.Add(time.Duration(i) * time.Minute)}
+		if err := WriteFindings(townRoot, pf); err != nil {
+			t.Fatalf("WriteFindings: %v", err)
+		}
+	}
+	entries, _ := os.ReadDir(FindingsDir(townRoot))
+	if len(entries) != MaxPatrolFindings {
+		t.Errorf("kept %d files, want %d", len(entries), MaxPatrolFindings)
+	}
+
+	recent, err := LoadRecentFindings(townRoot, 3)
+	if err != nil || len(recent) != 3 {
+		t.Fatalf("LoadRecentFindings = %d, %v; want 3", len(recent), err)
+	}
+	want := base.Add(time.Duration(MaxPatrolFindings+4) * time.Minute)
+	if !recent[2].Timestamp.Equal(want) || !recent[0].Timestamp.Before(recent[1].Timestamp) {
+		t.Errorf("recent not oldest-first ending at %v: %v, %v, %v", want, recent[0].Timestamp, recent[1].Timestamp, recent[2].Timestamp)
+	}
+
+	if none, err := LoadRecentFindings(t.TempDir(), 10); err != nil || none != nil {
+		t.Errorf("LoadRecentFindings(empty) = %v, %v", none, err)
+	}
+}
+
+func TestSummarizeFindings(t *testing.T) {
+	orphan := Finding{Kind: FindingOrphanSession, Subject: "gt-old-witness", Action: "gt doctor --fix"}
+	lockA := Finding{Kind: FindingStaleLock, Subject: "rig/polecats/a"}
+	lockB := Finding{Kind: FindingStaleLock, Subject: "rig/polecats/b"}
+	patrols := []*PatrolFindings{
+		{Findings: []Finding{orphan, lockA, lockB}},
+		{Findings: []Finding{orphan, lockA, lockB}},
+		{Findings: []Finding{orphan, lockA}},
+		{Findings: []Finding{orphan}},
+	}
+
+	s := SummarizeFindings(patrols)
+	if s.Patrols != 4 || len(s.Latest) != 1 {
+		t.Fatalf("summary = %+v", s)
+	}
+	trends := make(map[string]KindTrend)
+	for _, k := range s.Kinds {
+		trends[k.Kind] = k
+	}
+	if got := trends[FindingStaleLock]; got.Latest != 0 || got.Previous != 1 || got.Trend != "down" || got.Average != 1.25 {
+		t.Errorf("stale_lock trend = %+v", got)
+	}
+	if got := trends[FindingOrphanSession]; got.Latest != 1 || got.Trend != "flat" {
+		t.Errorf("orphan_session trend = %+v", got)
+	}
+	if len(s.Recurring) != 1 || s.Recurring[0].Subject != orphan.Subject || s.Recurring[0].Patrols != 4 {
+		t.Errorf("recurring = %+v, want the orphan across 4 patrols", s.Recurring)
+	}
+
+	if empty := SummarizeFindings(nil); empty.Patrols != 0 || empty.Latest == nil {
+		t.Errorf("SummarizeFindings(nil) = %+v", empty)
+	}
+}
+
+func TestHookedSLABreaches(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	hooked := []*HookedBead{
+		{ID: "gt-fresh", UpdatedAt: now.Add(-time.Hour)},
+		{ID: "gt-late", UpdatedAt: now.Add(-5 * time.Hour)},
+		{ID: "gt-very-late", UpdatedAt: now.Add(-9 * time.Hour)},
+		{ID: "gt-unknown"},
+	}
+	got := hookedSLABreaches(hooked, 4*time.Hour, now)
+	if len(got) != 2 {
+		t.Fatalf("breaches = %+v, want 2", got)
+	}
+	if got[0].Subject != "gt-late" || got[0].Severity != "warning" {
+		t.Errorf("first breach = %+v", got[0])
+	}
+	if got[1].Subject != "gt-very-late" || got[1].Severity != "critical" {
+		t.Errorf("second breach = %+v", got[1])
+	}
+}
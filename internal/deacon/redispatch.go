@@ -376,9 +376,9 @@ func resolveRigFromBead(townRoot, beadID string) string {
 
 // getBeadStatusForRedispatch returns the current status of a bead.
 func getBeadStatusForRedispatch(townRoot, beadID string) string {
-	cmd := exec.Command("bd", "show", beadID, "--json")
+	cmd := beads.Command("show", beadID, "--json")
 	cmd.Dir = townRoot
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 
 	output, err := cmd.Output()
 	if err != nil {
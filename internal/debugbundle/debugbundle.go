@@ -0,0 +1,391 @@
+// Package debugbundle collects a town's diagnostics into a gzipped tar
+// archive to attach to an issue report.
+//
+// A bundle holds what a maintainer needs to see what the town was doing:
+// the tails of the daemon logs, daemon and scheduler state files, recent
+// events, configuration with secrets redacted, and goroutine and heap
+// profiles of the running daemon. Beads, mail, transcripts and rig clones
+// are never captured.
+//
+// Archive layout:
+//
+//	manifest.json          Manifest
+//	logs/<name>            Log tails, relative to the town root
+//	state/<path>           Runtime state, relative to the town root
+//	events/.events.jsonl   Recent events
+//	config/<path>          Redacted configuration, relative to the town root
+//	profiles/<name>        Daemon pprof profiles
+package debugbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// Defaults for Options.
+const (
+	DefaultLogLines   = 2000
+	DefaultEventLines = 1000
+)
+
+// maxFileSize bounds a state or config file copied whole.
+const maxFileSize = 1 << 20 // 1 MiB
+
+// logGlobs are the town-relative logs whose tails a bundle captures.
+var logGlobs = []string{
+	"daemon/*.log",
+	"logs/*.log",
+}
+
+// stateGlobs are the town-relative runtime state files a bundle captures.
+var stateGlobs = []string{
+	"daemon/*.json",   // Daemon state, self-monitor, backoff, ...
+	".runtime/*.json", // Scheduler state and other runtime records
+}
+
+// configGlobs are the town-relative configuration files a bundle captures,
+// redacted.
+var configGlobs = []string{
+	"settings/*.json",
+	"mayor/town.json",
+	"mayor/rigs.json",
+	"mayor/daemon.json",
+	"config/*.json",
+}
+
+// Profile is a daemon profile to capture.
+type Profile struct {
+	Name  string // runtime/pprof profile name
+	Debug int    // 0 for the binary format, 2 for goroutine stacks as text
+	File  string // Name in the bundle
+}
+
+// Profiles are the profiles a bundle captures from a running daemon.
+var Profiles = []Profile{
+	{Name: "goroutine", Debug: 2, File: "goroutine.txt"},
+	{Name: "heap", Debug: 0, File: "heap.pb.gz"},
+}
+
+// Options configure a bundle.
+type Options struct {
+	// Version describes the gt binary, e.g. "gt version 1.0.1 (dev)".
+	Version string
+	// LogLines and EventLines bound the log and event tails.
+	LogLines   int
+	EventLines int
+	// Profile fetches a profile from the running daemon. Nil, or an error,
+	// leaves profiles out with a note in the manifest.
+	Profile func(p Profile) ([]byte, error)
+}
+
+// Manifest describes a bundle.
+type Manifest struct {
+	Town      string    `json:"town"`
+	CreatedAt time.Time `json:"created_at"`
+	Version   string    `json:"version,omitempty"`
+	GoVersion string    `json:"go_version"`
+	Platform  string    `json:"platform"`
+	// Files lists the archived files by archive path.
+	Files []string `json:"files"`
+	// Notes records what couldn't be collected and why.
+	Notes []string `json:"notes,omitempty"`
+}
+
+// Summary describes the bundle's contents in one line.
+func (m *Manifest) Summary() string {
+	var logs, state, config, profiles int
+	for _, f := range m.Files {
+		switch strings.SplitN(f, "/", 2)[0] {
+		case "logs":
+			logs++
+		case "state":
+			state++
+		case "config":
+			config++
+		case "profiles":
+			profiles++
+		}
+	}
+	return fmt.Sprintf("%d logs, %d state files, %d config files, %d profiles", logs, state, config, profiles)
+}
+
+// Write writes townRoot's debug bundle to w as a gzipped tar, returning its
+// manifest. Files that can't be read are noted in the manifest rather than
+// failing the bundle: a bundle is most wanted when something is broken.
+func Write(townRoot string, w io.Writer, opts Options, now time.Time) (*Manifest, error) {
+	if opts.LogLines <= 0 {
+		opts.LogLines = DefaultLogLines
+	}
+	if opts.EventLines <= 0 {
+		opts.EventLines = DefaultEventLines
+	}
+	m := &Manifest{
+		Town:      filepath.Base(townRoot),
+		CreatedAt: now.UTC(),
+		Version:   opts.Version,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	files := make(map[string][]byte)
+	note := func(format string, args ...interface{}) {
+		m.Notes = append(m.Notes, fmt.Sprintf(format, args...))
+	}
+
+	for _, rel := range glob(townRoot, logGlobs) {
+		data, err := tailFile(filepath.Join(townRoot, rel), opts.LogLines)
+		if err != nil {
+			note("%s: %v", rel, err)
+			continue
+		}
+		files["logs/"+rel] = RedactLines(data)
+	}
+	for _, rel := range glob(townRoot, stateGlobs) {
+		data, err := readSmall(filepath.Join(townRoot, rel))
+		if err != nil {
+			note("%s: %v", rel, err)
+			continue
+		}
+		files["state/"+rel] = Redact(data)
+	}
+	if data, err := tailFile(filepath.Join(townRoot, events.EventsFile), opts.EventLines); err == nil {
+		files["events/"+events.EventsFile] = RedactLines(data)
+	} else if !os.IsNotExist(err) {
+		note("%s: %v", events.EventsFile, err)
+	}
+	for _, rel := range glob(townRoot, configGlobs) {
+		data, err := readSmall(filepath.Join(townRoot, rel))
+		if err != nil {
+			note("%s: %v", rel, err)
+			continue
+		}
+		files["config/"+rel] = Redact(data)
+	}
+
+	if opts.Profile == nil {
+		note("profiles: daemon not queried")
+	} else {
+		for _, p := range Profiles {
+			data, err := opts.Profile(p)
+			if err != nil {
+				note("profiles: %s: %v", p.Name, err)
+				continue
+			}
+			files["profiles/"+p.File] = data
+		}
+	}
+
+	for name := range files {
+		m.Files = append(m.Files, name)
+	}
+	sort.Strings(m.Files)
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	zw := gzip.NewWriter(w)
+	tw := tar.NewWriter(zw)
+	add := func(name string, data []byte) error {
+		hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: now}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+	if err := add("manifest.json", append(manifest, '\n')); err != nil {
+		return nil, err
+	}
+	for _, name := range m.Files {
+		if err := add(name, files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Bytes returns townRoot's debug bundle and its manifest.
+func Bytes(townRoot string, opts Options, now time.Time) ([]byte, *Manifest, error) {
+	var buf bytes.Buffer
+	m, err := Write(townRoot, &buf, opts, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), m, nil
+}
+
+// glob returns the town-relative regular files matching patterns, sorted.
+func glob(townRoot string, patterns []string) []string {
+	var out []string
+	for _, pat := range patterns {
+		matches, _ := filepath.Glob(filepath.Join(townRoot, pat))
+		for _, path := range matches {
+			if fi, err := os.Stat(path); err != nil || !fi.Mode().IsRegular() {
+				continue
+			}
+			if rel, err := filepath.Rel(townRoot, path); err == nil {
+				out = append(out, filepath.ToSlash(rel))
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// readSmall reads a file of at most maxFileSize.
+func readSmall(path string) ([]byte, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() > maxFileSize {
+		return nil, fmt.Errorf("skipped, %d bytes exceeds %d", fi.Size(), maxFileSize)
+	}
+	return os.ReadFile(path)
+}
+
+// tailFile returns the last n lines of the file at path, reading at most
+// the final 8 MiB so a huge log doesn't stall the bundle.
+func tailFile(path string, n int) ([]byte, error) {
+	const window = 8 << 20
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := max(fi.Size()-window, 0)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return tailLines(data, n), nil
+}
+
+// tailLines returns the last n lines of data.
+func tailLines(data []byte, n int) []byte {
+	end := len(data)
+	if end > 0 && data[end-1] == '\n' {
+		end--
+	}
+	for i := end - 1; i >= 0; i-- {
+		if data[i] == '\n' {
+			n--
+			if n == 0 {
+				return data[i+1:]
+			}
+		}
+	}
+	return data
+}
+
+// redacted replaces a secret value.
+const redacted = "[REDACTED]"
+
+// Redact masks secrets in a JSON document: the values of keys that name a
+// secret (or a webhook, URL or DSN), and credentials embedded in URLs
+// elsewhere. Data that isn't JSON is
+// redacted line by line (see RedactLines).
+func Redact(data []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return RedactLines(data)
+	}
+	out, err := json.MarshalIndent(redactValue(v), "", "  ")
+	if err != nil {
+		return []byte(redacted + "\n")
+	}
+	return append(out, '\n')
+}
+
+// RedactLines redacts each line of data that is a JSON document, as in a
+// JSONL file. A line that isn't JSON is dropped when it mentions a secret,
+// since its secret can't be told apart from the rest.
+func RedactLines(data []byte) []byte {
+	var b bytes.Buffer
+	for _, line := range bytes.SplitAfter(data, []byte("\n")) {
+		trimmed := bytes.TrimSpace(line)
+		if len(trimmed) == 0 {
+			b.Write(line)
+			continue
+		}
+		var v interface{}
+		if json.Unmarshal(trimmed, &v) == nil {
+			out, _ := json.Marshal(redactValue(v))
+			b.Write(out)
+			b.WriteByte('\n')
+			continue
+		}
+		if util.IsSensitiveKey(string(trimmed)) {
+			b.WriteString(redacted + "\n")
+			continue
+		}
+		b.Write(line)
+	}
+	return b.Bytes()
+}
+
+// redactValue returns v with secrets masked. Any non-empty scalar under a
+// sensitive key is masked; objects and arrays under one are walked, so
+// {"slack_webhook": {"url": ...}} keeps its shape.
+func redactValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if util.IsSensitiveKey(k) && !emptyOrContainer(val) {
+				t[k] = redacted
+				continue
+			}
+			t[k] = redactValue(val)
+		}
+		return t
+	case []interface{}:
+		for i := range t {
+			t[i] = redactValue(t[i])
+		}
+		return t
+	case string:
+		if strings.Contains(t, "://") && strings.Contains(t, "@") {
+			return util.RedactURL(t)
+		}
+		return t
+	}
+	return v
+}
+
+// emptyOrContainer reports whether v is nil, "", an object or an array:
+// values redactValue leaves in place under a sensitive key.
+func emptyOrContainer(v interface{}) bool {
+	switch t := v.(type) {
+	case nil, map[string]interface{}, []interface{}:
+		return true
+	case string:
+		return t == ""
+	}
+	return false
+}
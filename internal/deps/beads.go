@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -46,8 +47,8 @@ func CheckBeads() (BeadsStatus, string) {
 	// packages), even a trivial shell script can take >3s to start.
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	cmd := exec.CommandContext(ctx, "bd", "version")
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, "version")
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	output, err := cmd.Output()
 	if err != nil {
 		return BeadsUnknown, ""
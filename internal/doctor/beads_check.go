@@ -293,7 +293,7 @@ type dbPrefixGetter interface {
 type realDBPrefixGetter struct{}
 
 func (r *realDBPrefixGetter) GetDBPrefix(rigPath string) (string, error) {
-	cmd := exec.Command("bd", "config", "get", "issue_prefix")
+	cmd := beads.Command("config", "get", "issue_prefix")
 	cmd.Dir = rigPath
 	output, err := cmd.Output()
 	if err != nil {
@@ -465,7 +465,7 @@ func (c *DatabasePrefixCheck) Fix(ctx *CheckContext) error {
 		fmt.Fprintf(os.Stderr, "WARNING: database-prefix fix: %s: changing issue_prefix from %q to %q (per routes.jsonl)\n",
 			m.rigPath, m.dbPrefix, m.routesPrefix)
 
-		cmd := exec.Command("bd", "config", "set", "issue_prefix", m.routesPrefix)
+		cmd := beads.Command("config", "set", "issue_prefix", m.routesPrefix)
 		cmd.Dir = filepath.Join(ctx.TownRoot, m.rigPath)
 		if output, err := cmd.CombinedOutput(); err != nil {
 			return fmt.Errorf("updating %s: %s", m.rigPath, strings.TrimSpace(string(output)))
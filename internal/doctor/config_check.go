@@ -645,7 +645,7 @@ func (c *CustomTypesCheck) Run(ctx *CheckContext) *CheckResult {
 
 	// Get current custom types configuration
 	// Use Output() not CombinedOutput() to avoid capturing bd's stderr messages
-	cmd := exec.Command("bd", "config", "get", "types.custom")
+	cmd := beads.Command("config", "get", "types.custom")
 	cmd.Dir = beadsDir
 	cmd.Env = doctorConfigEnv(beadsDir)
 	output, err := cmd.Output()
@@ -721,7 +721,7 @@ func parseConfigOutput(output []byte) string {
 
 // Fix registers the missing custom types.
 func (c *CustomTypesCheck) Fix(ctx *CheckContext) error {
-	getCmd := exec.Command("bd", "config", "get", "types.custom")
+	getCmd := beads.Command("config", "get", "types.custom")
 	getCmd.Dir = c.targetBeadsDir
 	getCmd.Env = doctorConfigEnv(c.targetBeadsDir)
 	existingOutput, _ := getCmd.Output()
@@ -745,7 +745,7 @@ func (c *CustomTypesCheck) Fix(ctx *CheckContext) error {
 	}
 	sort.Strings(merged)
 
-	cmd := exec.Command("bd", "config", "set", "types.custom", strings.Join(merged, ","))
+	cmd := beads.Command("config", "set", "types.custom", strings.Join(merged, ","))
 	cmd.Dir = c.targetBeadsDir
 	cmd.Env = doctorConfigEnv(c.targetBeadsDir)
 	output, err := cmd.CombinedOutput()
@@ -795,7 +795,7 @@ func (c *CustomStatusesCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	// Get current custom statuses configuration
-	cmd := exec.Command("bd", "config", "get", "status.custom")
+	cmd := beads.Command("config", "get", "status.custom")
 	cmd.Dir = beadsDir
 	cmd.Env = doctorConfigEnv(beadsDir)
 	output, err := cmd.Output()
@@ -856,7 +856,7 @@ func (c *CustomStatusesCheck) Run(ctx *CheckContext) *CheckResult {
 // Fix registers the missing custom statuses by merging with existing ones.
 func (c *CustomStatusesCheck) Fix(ctx *CheckContext) error {
 	// Read existing statuses
-	getCmd := exec.Command("bd", "config", "get", "status.custom")
+	getCmd := beads.Command("config", "get", "status.custom")
 	getCmd.Dir = c.targetBeadsDir
 	getCmd.Env = doctorConfigEnv(c.targetBeadsDir)
 	existingOutput, _ := getCmd.Output()
@@ -881,7 +881,7 @@ func (c *CustomStatusesCheck) Fix(ctx *CheckContext) error {
 	}
 	sort.Strings(merged)
 
-	cmd := exec.Command("bd", "config", "set", "status.custom", strings.Join(merged, ","))
+	cmd := beads.Command("config", "set", "status.custom", strings.Join(merged, ","))
 	cmd.Dir = c.targetBeadsDir
 	cmd.Env = doctorConfigEnv(c.targetBeadsDir)
 	output, err := cmd.CombinedOutput()
@@ -6,6 +6,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/ui"
 )
 
@@ -94,7 +95,7 @@ func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 				fmt.Fprintf(w, "%s", ui.RenderMuted(" "+result.Message))
 			}
 			if isSlow {
-				fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(result.Elapsed)+")"))
+				fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+humanize.Duration(result.Elapsed)+")"))
 			}
 			fmt.Fprintln(w)
 		}
@@ -222,7 +223,7 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 				fmt.Fprintf(w, "%s", ui.RenderMuted(" "+result.Message))
 			}
 			if isSlow {
-				fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(result.Elapsed)+")"))
+				fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+humanize.Duration(result.Elapsed)+")"))
 			}
 			fmt.Fprintln(w)
 		}
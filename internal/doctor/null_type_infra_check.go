@@ -3,7 +3,6 @@ package doctor
 import (
 	"encoding/csv"
 	"fmt"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -174,7 +173,7 @@ func (c *NullTypeInfraCheck) queryTable(rigDir, rigName, table string) []nullTyp
 			"GROUP BY i.id, i.title, i.issue_type",
 		table, labelTable(table))
 
-	cmd := exec.Command("bd", "sql", "--csv", query) //nolint:gosec // G204: query built from constants
+	cmd := beads.Command("sql", "--csv", query) //nolint:gosec // G204: query built from constants
 	cmd.Dir = rigDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
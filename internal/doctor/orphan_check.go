@@ -131,6 +131,11 @@ func (c *OrphanSessionCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 }
 
+// Orphans returns the orphaned sessions found by the last Run.
+func (c *OrphanSessionCheck) Orphans() []string {
+	return c.orphanSessions
+}
+
 // Fix kills all orphaned sessions, except crew sessions which are protected.
 func (c *OrphanSessionCheck) Fix(ctx *CheckContext) error {
 	if len(c.orphanSessions) == 0 {
@@ -14,6 +14,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/worktree"
 )
 
 // RigIsGitRepoCheck verifies the rig has a valid mayor/rig git clone.
@@ -579,8 +580,8 @@ func (c *RefineryExistsCheck) Fix(ctx *CheckContext) error {
 			return fmt.Errorf("cannot auto-create refinery/rig/ worktree: bare repo not found at %s", bareRepoPath)
 		}
 
-		bareGit := git.NewGitWithDir(bareRepoPath, "")
-		_ = bareGit.WorktreePrune()
+		worktrees := worktree.NewManager(git.NewGitWithDir(bareRepoPath, ""))
+		_ = worktrees.Prune()
 
 		rigClone := filepath.Join(refineryDir, "rig")
 		// Detect default branch from rig config
@@ -595,7 +596,7 @@ func (c *RefineryExistsCheck) Fix(ctx *CheckContext) error {
 			}
 		}
 
-		if err := bareGit.WorktreeAddExisting(rigClone, defaultBranch); err != nil {
+		if err := worktrees.AddExisting(rigClone, defaultBranch); err != nil {
 			return fmt.Errorf("creating refinery worktree from bare repo: %w", err)
 		}
 
@@ -867,7 +868,7 @@ func (c *BeadsConfigValidCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	// Check if bd command works
-	cmd := exec.Command("bd", "stats", "--json")
+	cmd := beads.Command("stats", "--json")
 	cmd.Dir = c.rigPath
 	if err := cmd.Run(); err != nil {
 		return &CheckResult{
@@ -1057,7 +1058,7 @@ func (c *BeadsRedirectCheck) Fix(ctx *CheckContext) error {
 			initArgs = append(initArgs, "--prefix", prefix)
 		}
 		initArgs = append(initArgs, "--server")
-		cmd := exec.Command("bd", initArgs...)
+		cmd := beads.Command(initArgs...)
 		cmd.Dir = rigPath
 		if output, err := cmd.CombinedOutput(); err != nil {
 			// bd might not be installed — create config.yaml via shared helper.
@@ -1068,7 +1069,7 @@ func (c *BeadsRedirectCheck) Fix(ctx *CheckContext) error {
 		} else {
 			_ = output // bd init succeeded
 			// Configure custom types for Gas Town (beads v0.46.0+)
-			configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+			configCmd := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
 			configCmd.Dir = rigPath
 			_, _ = configCmd.CombinedOutput() // Ignore errors - older beads don't need this
 		}
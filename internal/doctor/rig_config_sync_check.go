@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
@@ -338,7 +337,7 @@ func (c *RigConfigSyncCheck) Fix(ctx *CheckContext) error {
 
 		// Run bd init --prefix <prefix> --force --destroy-token to create the database
 		destroyToken := fmt.Sprintf("DESTROY-%s", entry.BeadsConfig.Prefix)
-		cmd := exec.Command("bd", "init", "--prefix", entry.BeadsConfig.Prefix, "--force", "--destroy-token="+destroyToken)
+		cmd := beads.Command("init", "--prefix", entry.BeadsConfig.Prefix, "--force", "--destroy-token="+destroyToken)
 		cmd.Dir = mayorRigPath
 		if output, err := cmd.CombinedOutput(); err != nil {
 			return fmt.Errorf("could not initialize Dolt DB for %s: %w\n%s", rigName, err, string(output))
@@ -438,7 +437,7 @@ func (c *RigConfigSyncCheck) Fix(ctx *CheckContext) error {
 
 		// Add status:docked label if the rig should be docked
 		rigBeadID := fmt.Sprintf("%s-rig-%s", info.prefix, info.rigName)
-		cmd := exec.Command("bd", "label", rigBeadID, "--add", "status:docked")
+		cmd := beads.Command("label", rigBeadID, "--add", "status:docked")
 		cmd.Dir = mayorRigPath
 		_ = cmd.Run() // Best effort - ignore errors
 	}
@@ -467,7 +466,7 @@ func (c *RigConfigSyncCheck) rigBeadExists(rigBeadID, rigPath string) bool {
 	mayorRigPath := filepath.Join(rigPath, "mayor", "rig")
 
 	// Try to show the bead using bd
-	cmd := exec.Command("bd", "show", rigBeadID, "--json")
+	cmd := beads.Command("show", rigBeadID, "--json")
 	cmd.Dir = mayorRigPath
 	output, err := cmd.CombinedOutput()
 	if err != nil {
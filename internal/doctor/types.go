@@ -6,6 +6,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/ui"
 )
 
@@ -298,7 +299,7 @@ func (r *Report) printCheck(w io.Writer, check *CheckResult, verbose bool, slowT
 		_, _ = fmt.Fprintf(w, "%s", ui.RenderMuted(" "+check.Message))
 	}
 	if isSlow {
-		_, _ = fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+formatDuration(check.Elapsed)+")"))
+		_, _ = fmt.Fprintf(w, "%s", ui.RenderMuted(" ("+humanize.Duration(check.Elapsed)+")"))
 	}
 	_, _ = fmt.Fprintln(w)
 
@@ -310,28 +311,6 @@ func (r *Report) printCheck(w io.Writer, check *CheckResult, verbose bool, slowT
 	}
 }
 
-// formatDuration formats a duration in a human-readable way.
-// Examples: "1.2s", "45s", "1m 30s", "2h 5m"
-func formatDuration(d time.Duration) string {
-	if d < time.Minute {
-		return fmt.Sprintf("%.1fs", d.Seconds())
-	}
-	if d < time.Hour {
-		m := int(d.Minutes())
-		s := int(d.Seconds()) % 60
-		if s == 0 {
-			return fmt.Sprintf("%dm", m)
-		}
-		return fmt.Sprintf("%dm %ds", m, s)
-	}
-	h := int(d.Hours())
-	m := int(d.Minutes()) % 60
-	if m == 0 {
-		return fmt.Sprintf("%dh", h)
-	}
-	return fmt.Sprintf("%dh %dm", h, m)
-}
-
 // printSummary outputs the summary line with semantic icons.
 func (r *Report) printSummary(w io.Writer, slowThreshold time.Duration) {
 	summary := fmt.Sprintf("%s %d passed  %s %d warnings  %s %d failed",
@@ -346,7 +325,7 @@ func (r *Report) printSummary(w io.Writer, slowThreshold time.Duration) {
 		summary += fmt.Sprintf("  ⏳ %d slow (slowest: %s %s)",
 			r.Summary.Slow,
 			r.Summary.SlowestName,
-			formatDuration(r.Summary.SlowestTime),
+			humanize.Duration(r.Summary.SlowestTime),
 		)
 	}
 	_, _ = fmt.Fprintln(w, summary)
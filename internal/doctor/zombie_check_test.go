@@ -55,6 +55,9 @@ func TestZombieSessionCheck_SkipsCrewSessions(t *testing.T) {
 }
 
 func TestZombieSessionCheck_FixProtectsCrewSessions(t *testing.T) {
+	// Fix logs session deaths to the town found from the CWD; keep them
+	// out of the source tree.
+	t.Chdir(t.TempDir())
 	// Verify that Fix() never kills crew sessions
 	check := NewZombieSessionCheck()
 
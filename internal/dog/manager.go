@@ -16,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/worktree"
 )
 
 // Common errors
@@ -187,7 +188,7 @@ func (m *Manager) createRigWorktree(dogPath, dogName, rigName string) (string, e
 	branchName := fmt.Sprintf("dog/%s-%s-%d", dogName, rigName, time.Now().UnixMilli())
 
 	// Create worktree with new branch from default branch
-	if err := repoGit.WorktreeAddFromRef(worktreePath, branchName, startPoint); err != nil {
+	if err := worktree.NewManager(repoGit).AddFromRef(worktreePath, branchName, startPoint); err != nil {
 		return "", fmt.Errorf("creating worktree from %s: %w", startPoint, err)
 	}
 
@@ -241,13 +242,13 @@ func (m *Manager) Remove(name string) error {
 		}
 
 		// Try to remove worktree properly
-		if err := repoGit.WorktreeRemove(worktreePath, true); err != nil {
+		if err := worktree.NewManager(repoGit).Remove(worktreePath, true); err != nil {
 			// Log but continue - will remove directory below
 			style.PrintWarning("could not remove worktree %s: %v", worktreePath, err)
 		}
 
 		// Prune stale entries
-		_ = repoGit.WorktreePrune()
+		_ = worktree.NewManager(repoGit).Prune()
 	}
 
 	// Remove dog directory
@@ -449,9 +450,9 @@ func (m *Manager) Refresh(name string) error {
 
 		// Remove old worktree if it exists
 		if oldWorktreePath != "" {
-			_ = repoGit.WorktreeRemove(oldWorktreePath, true)
+			_ = worktree.NewManager(repoGit).Remove(oldWorktreePath, true)
 			_ = os.RemoveAll(oldWorktreePath)
-			_ = repoGit.WorktreePrune()
+			_ = worktree.NewManager(repoGit).Prune()
 		}
 
 		// Fetch latest from origin
@@ -523,9 +524,9 @@ func (m *Manager) RefreshRig(name, rigName string) error {
 
 	// Remove old worktree if it exists
 	if oldWorktreePath != "" {
-		_ = repoGit.WorktreeRemove(oldWorktreePath, true)
+		_ = worktree.NewManager(repoGit).Remove(oldWorktreePath, true)
 		_ = os.RemoveAll(oldWorktreePath)
-		_ = repoGit.WorktreePrune()
+		_ = worktree.NewManager(repoGit).Prune()
 	}
 
 	// Fetch latest
@@ -3965,6 +3965,22 @@ func isDoltRetryableError(err error) bool {
 		strings.Contains(msg, "Unknown database")
 }
 
+// IsLockContention reports whether err is Dolt lock contention: concurrent
+// writers fighting over the manifest or a transaction. The scheduler's
+// adaptive throttle backs off on these.
+func IsLockContention(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "cannot update manifest") ||
+		strings.Contains(msg, "optimistic lock") ||
+		strings.Contains(msg, "serialization failure") ||
+		strings.Contains(msg, "lock wait timeout") ||
+		strings.Contains(msg, "try restarting transaction") ||
+		strings.Contains(msg, "database is locked")
+}
+
 // CommitServerWorkingSet stages all pending changes and commits them on the current branch via SQL.
 // This flushes the Dolt working set to HEAD so that DOLT_BRANCH (which forks from
 // HEAD, not the working set) will include all recent writes. Critical for the sling
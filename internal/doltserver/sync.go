@@ -624,10 +624,10 @@ func PurgeClosedEphemerals(townRoot, dbName string, dryRun bool) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", args...)
+	cmd := beads.CommandContext(ctx, args...)
 	cmd.Dir = filepath.Dir(beadsDir) // run from parent of .beads
 	cmd.Env = append(os.Environ(), "BEADS_DIR="+beadsDir)
-	setProcessGroup(cmd)
+	setProcessGroup(cmd.Cmd)
 
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
@@ -20,11 +20,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"os/exec"
 	"strings"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // MigrateWispsResult holds migration statistics.
@@ -117,9 +118,9 @@ func MigrateAgentBeadsToWisps(townRoot, workDir string, dryRun bool) (*MigrateWi
 
 // bdSQL executes a SQL query via `bd sql`.
 func bdSQL(workDir, query string) error {
-	cmd := exec.Command("bd", "sql", query)
+	cmd := beads.Command("sql", query)
 	cmd.Dir = workDir
-	setProcessGroup(cmd)
+	setProcessGroup(cmd.Cmd)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("bd sql: %s: %w", strings.TrimSpace(string(output)), err)
@@ -129,9 +130,9 @@ func bdSQL(workDir, query string) error {
 
 // bdSQLCSV executes a SQL query via `bd sql --csv` and returns the output.
 func bdSQLCSV(workDir, query string) (string, error) {
-	cmd := exec.Command("bd", "sql", "--csv", query)
+	cmd := beads.Command("sql", "--csv", query)
 	cmd.Dir = workDir
-	setProcessGroup(cmd)
+	setProcessGroup(cmd.Cmd)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return "", fmt.Errorf("bd sql: %s: %w", strings.TrimSpace(string(output)), err)
@@ -141,9 +142,9 @@ func bdSQLCSV(workDir, query string) (string, error) {
 
 // bdExec executes a bd command.
 func bdExec(workDir string, args ...string) error {
-	cmd := exec.Command("bd", args...)
+	cmd := beads.Command(args...)
 	cmd.Dir = workDir
-	setProcessGroup(cmd)
+	setProcessGroup(cmd.Cmd)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("bd %s: %s: %w", strings.Join(args, " "), strings.TrimSpace(string(output)), err)
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+)
+
+// CorrelationKey is the payload field that ties together the events of one
+// piece of work: enqueue, dispatch, sling, spawn, done and merge all carry
+// the same ID, so `gt activity trace <id>` (or a grep) tells the whole story.
+const CorrelationKey = "correlation_id"
+
+// EnvCorrelationID carries the correlation ID into a polecat's session, so
+// events its gt commands log are tagged without each call site knowing it.
+const EnvCorrelationID = "GT_CORRELATION_ID"
+
+// NewCorrelationID returns a fresh correlation ID, e.g. "cor-3f9a1c02b7de".
+func NewCorrelationID() string {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return "cor-" + hex.EncodeToString(b)
+}
+
+// WithCorrelation sets the correlation ID on payload and returns it. An
+// empty id leaves payload unchanged.
+func WithCorrelation(payload map[string]interface{}, id string) map[string]interface{} {
+	if id == "" {
+		return payload
+	}
+	if payload == nil {
+		payload = make(map[string]interface{})
+	}
+	payload[CorrelationKey] = id
+	return payload
+}
+
+// CorrelationID returns the event's correlation ID, or "" if it has none.
+func (e Event) CorrelationID() string {
+	id, _ := e.Payload[CorrelationKey].(string)
+	return id
+}
+
+// withEnvCorrelation tags payload with the process's GT_CORRELATION_ID
+// unless the caller already set one.
+func withEnvCorrelation(payload map[string]interface{}) map[string]interface{} {
+	if _, ok := payload[CorrelationKey]; ok {
+		return payload
+	}
+	return WithCorrelation(payload, os.Getenv(EnvCorrelationID))
+}
@@ -1,7 +1,8 @@
 // Package events provides event logging for the gt activity feed.
 //
 // Events are written to ~/gt/.events.jsonl (raw audit log) and later
-// curated by the feed daemon into ~/.feed.jsonl (user-facing).
+// curated by the feed daemon into ~/.feed.jsonl (user-facing). The raw log
+// is rotated by size into indexed segments; see rotate.go.
 package events
 
 import (
@@ -9,20 +10,34 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/gofrs/flock"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
+// SchemaVersion is the version of the event and payload format written by
+// this build. Events written before versioning have no schema_version and
+// are version 1.
+//
+// Version 2: scheduler_dispatch_failed payloads carry structured fields
+// (failure_kind, attempt, formula, duration_ms, stderr) alongside error.
+//
+// Version 3: events about a piece of work carry correlation_id, set at
+// enqueue (or at sling for direct dispatch) and threaded through dispatch,
+// spawn, done and merge.
+const SchemaVersion = 3
+
 // Event represents an activity event in Gas Town.
 type Event struct {
-	Timestamp  string                 `json:"ts"`
-	Source     string                 `json:"source"`
-	Type       string                 `json:"type"`
-	Actor      string                 `json:"actor"`
-	Payload    map[string]interface{} `json:"payload,omitempty"`
-	Visibility string                 `json:"visibility"`
+	SchemaVersion int                    `json:"schema_version,omitempty"`
+	Timestamp     string                 `json:"ts"`
+	Source        string                 `json:"source"`
+	Type          string                 `json:"type"`
+	Actor         string                 `json:"actor"`
+	Payload       map[string]interface{} `json:"payload,omitempty"`
+	Visibility    string                 `json:"visibility"`
 }
 
 // Visibility levels for events.
@@ -46,13 +61,18 @@ const (
 	TypeBoot    = "boot"
 	TypeHalt    = "halt"
 
+	// Human takeover events
+	TypeTakeover = "takeover" // Human took a bead over from a polecat
+	TypeHandback = "handback" // Human returned a taken-over bead
+
 	// Session events (for seance discovery)
 	TypeSessionStart = "session_start"
 	TypeSessionEnd   = "session_end"
 
 	// Session death events (for crash investigation)
-	TypeSessionDeath = "session_death" // Feed-visible session termination
-	TypeMassDeath    = "mass_death"    // Multiple sessions died in short window
+	TypeSessionDeath    = "session_death"     // Feed-visible session termination
+	TypeMassDeath       = "mass_death"        // Multiple sessions died in short window
+	TypeDaemonCrashLoop = "daemon_crash_loop" // Daemon restarted too often and stopped itself
 
 	// Witness patrol events
 	TypePatrolStarted   = "patrol_started"
@@ -74,6 +94,25 @@ const (
 	TypeSchedulerDispatch       = "scheduler_dispatch"        // Bead dispatched from scheduler
 	TypeSchedulerDispatchFailed = "scheduler_dispatch_failed" // Bead dispatch failed (requeued)
 	TypeSchedulerCloseRetry     = "scheduler_close_retry"     // Context close needed last-resort attempt
+	TypeSchedulerSkipped        = "scheduler_skipped"         // Why queued beads were not dispatched changed
+	TypeQueueWatermark          = "queue_watermark"           // A rig's queue crossed its high or low watermark
+	TypeQueueStale              = "queue_stale"               // Queued beads passed scheduler.max_age and were flagged or cancelled
+	TypeQueueStarved            = "queue_starved"             // Ready beads passed over for scheduler.starvation_cycles were boosted
+	TypeSchedulerPause          = "scheduler_pause"           // An operator paused or resumed scheduler dispatch
+
+	// Rate limit events
+	TypeLimitWake = "limit_wake" // Daemon resumed a polecat stalled on a rate limit
+
+	// Branch hygiene events
+	TypeRebaseNudge = "rebase_nudge" // Daemon nudged (or rebased) a polecat branch behind its base
+
+	// Progress narrative events
+	TypeBeadNote = "bead_note" // Agent appended a progress note to a bead
+
+	// Guard events
+	TypeProtectedPathBlocked = "protected_path_blocked" // Agent tool call touched a protected path
+	TypeCommandDenied        = "command_denied"         // Command policy denied an agent's Bash command
+	TypeAccessDenied         = "access_denied"          // Roles file denied a state-mutating gt command
 )
 
 // EventsFile is the name of the raw events log.
@@ -81,15 +120,18 @@ const EventsFile = ".events.jsonl"
 
 // Log writes an event to the events log.
 // The event is appended to ~/gt/.events.jsonl.
+// Inside a polecat session the payload is tagged with the work's
+// correlation ID (GT_CORRELATION_ID) unless it already carries one.
 // Returns nil if logging fails (events are best-effort).
 func Log(eventType, actor string, payload map[string]interface{}, visibility string) error {
 	event := Event{
-		Timestamp:  time.Now().UTC().Format(time.RFC3339),
-		Source:     "gt",
-		Type:       eventType,
-		Actor:      actor,
-		Payload:    payload,
-		Visibility: visibility,
+		SchemaVersion: SchemaVersion,
+		Timestamp:     time.Now().UTC().Format(time.RFC3339),
+		Source:        "gt",
+		Type:          eventType,
+		Actor:         actor,
+		Payload:       withEnvCorrelation(payload),
+		Visibility:    visibility,
 	}
 	return write(event)
 }
@@ -104,6 +146,10 @@ func LogAudit(eventType, actor string, payload map[string]interface{}) error {
 	return Log(eventType, actor, payload, VisibilityAudit)
 }
 
+// writeMu serializes writers within this process; the flock coordinates
+// across processes.
+var writeMu sync.Mutex
+
 // write appends an event to the events file.
 // Uses flock for cross-process synchronization — sync.Mutex only protects
 // intra-process goroutines, but multiple gt processes write concurrently.
@@ -115,8 +161,6 @@ func write(event Event) error {
 		return nil
 	}
 
-	eventsPath := filepath.Join(townRoot, EventsFile)
-
 	// Marshal event to JSON
 	data, err := json.Marshal(event)
 	if err != nil {
@@ -124,18 +168,47 @@ func write(event Event) error {
 	}
 	data = append(data, '\n')
 
+	return WithLock(townRoot, func() error {
+		// Rotation failure is not fatal: the event still goes to the current log.
+		maxSize, retain := rotationLimits(townRoot)
+		_ = rotateIfNeeded(townRoot, int64(len(data)), maxSize, retain)
+		return appendLine(filepath.Join(townRoot, EventsFile), data)
+	})
+}
+
+// WithLock runs fn while holding the events log lock. Anything that rewrites
+// or replaces .events.jsonl (such as the KRC pruner) must hold it, or
+// concurrent appends can be lost.
+func WithLock(townRoot string, fn func() error) error {
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
 	// Acquire cross-process file lock
-	fl := flock.New(eventsPath + ".lock")
+	fl := flock.New(filepath.Join(townRoot, EventsFile) + ".lock")
 	if err := fl.Lock(); err != nil {
 		return fmt.Errorf("acquiring events file lock: %w", err)
 	}
 	defer fl.Unlock() //nolint:errcheck // best-effort unlock
 
-	f, err := os.OpenFile(eventsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: events file is non-sensitive operational data
+	return fn()
+}
+
+// appendLine appends one newline-terminated record to path in a single
+// write. If a previous writer died mid-line, the torn line is terminated
+// first so it can't swallow this record.
+func appendLine(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644) //nolint:gosec // G302: events file is non-sensitive operational data
 	if err != nil {
 		return fmt.Errorf("opening events file: %w", err)
 	}
 
+	if info, err := f.Stat(); err == nil && info.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, info.Size()-1); err == nil && last[0] != '\n' {
+			data = append([]byte{'\n'}, data...)
+		}
+	}
+
 	if _, err := f.Write(data); err != nil {
 		_ = f.Close()
 		return fmt.Errorf("writing event: %w", err)
@@ -225,6 +298,18 @@ func MergePayload(mrID, worker, branch, reason string) map[string]interface{} {
 	return p
 }
 
+// AccessDeniedPayload creates a payload for roles file denials: the gt
+// command refused, the action it needed, and the caller's role versus the
+// role required.
+func AccessDeniedPayload(command, action, role, required string) map[string]interface{} {
+	return map[string]interface{}{
+		"command":  command,
+		"action":   action,
+		"role":     role,
+		"required": required,
+	}
+}
+
 // PatrolPayload creates a payload for patrol start/complete events.
 func PatrolPayload(rig string, polecatCount int, message string) map[string]interface{} {
 	p := map[string]interface{}{
@@ -323,6 +408,16 @@ func MassDeathPayload(count int, window string, sessions []string, possibleCause
 	return p
 }
 
+// DaemonCrashLoopPayload creates a payload for daemon crash loop events.
+func DaemonCrashLoopPayload(restarts int, window string, heartbeatFailures int, incident string) map[string]interface{} {
+	return map[string]interface{}{
+		"restarts":           restarts,
+		"window":             window,
+		"heartbeat_failures": heartbeatFailures,
+		"incident":           incident,
+	}
+}
+
 // SessionPayload creates a payload for session start/end events.
 // sessionID: Claude Code session UUID
 // role: Gas Town role (e.g., "gastown/crew/joe", "deacon")
@@ -343,6 +438,75 @@ func SessionPayload(sessionID, role, topic, cwd string) map[string]interface{} {
 	return p
 }
 
+// LimitWakePayload creates a payload for limit wake events. stalled is how
+// long the polecat sat at the limit prompt, as seen by the daemon.
+func LimitWakePayload(rig, polecat, beadID string, stalled time.Duration) map[string]interface{} {
+	return map[string]interface{}{
+		"rig":        rig,
+		"polecat":    polecat,
+		"bead":       beadID,
+		"stalled_ms": stalled.Milliseconds(),
+	}
+}
+
+// RebaseNudgePayload creates a payload for rebase nudge events. action is
+// "nudge" when the agent was asked to rebase, "auto" when the daemon rebased
+// the branch itself.
+func RebaseNudgePayload(rig, polecat, beadID, base string, behind int, action string) map[string]interface{} {
+	return map[string]interface{}{
+		"rig":     rig,
+		"polecat": polecat,
+		"bead":    beadID,
+		"base":    base,
+		"behind":  behind,
+		"action":  action,
+	}
+}
+
+// QueueWatermarkPayload creates a payload for queue watermark events. level
+// is "high" or "low"; eta is the estimated time to drain the queue, zero when
+// unknown.
+func QueueWatermarkPayload(rig, level string, depth, high, low int, eta time.Duration) map[string]interface{} {
+	p := map[string]interface{}{
+		"rig":   rig,
+		"level": level,
+		"depth": depth,
+		"high":  high,
+		"low":   low,
+	}
+	if eta > 0 {
+		p["eta_ms"] = eta.Milliseconds()
+	}
+	return p
+}
+
+// QueueStalePayload creates a payload for queue staleness events. action is
+// "flag" or "cancel"; beads are the work bead IDs acted on.
+func QueueStalePayload(action string, maxAge time.Duration, beads []string) map[string]interface{} {
+	return map[string]interface{}{
+		"action":     action,
+		"max_age_ms": maxAge.Milliseconds(),
+		"beads":      beads,
+	}
+}
+
+// SchedulerPausePayload creates a payload for scheduler pause events.
+func SchedulerPausePayload(paused bool) map[string]interface{} {
+	return map[string]interface{}{
+		"paused": paused,
+	}
+}
+
+// QueueStarvedPayload creates a payload for queue starvation events. beads
+// are the work bead IDs boosted after being passed over for cycles
+// dispatch cycles.
+func QueueStarvedPayload(cycles int, beads []string) map[string]interface{} {
+	return map[string]interface{}{
+		"cycles": cycles,
+		"beads":  beads,
+	}
+}
+
 // SchedulerEnqueuePayload creates a payload for scheduler enqueue events.
 func SchedulerEnqueuePayload(beadID, rig string) map[string]interface{} {
 	return map[string]interface{}{
@@ -360,11 +524,114 @@ func SchedulerDispatchPayload(beadID, rig, polecat string) map[string]interface{
 	}
 }
 
+// SchedulerSkippedPayload creates a payload for scheduler skip events.
+// counts maps skip reasons to bead counts; beads describes the skipped beads.
+func SchedulerSkippedPayload(summary string, counts map[string]int, beads []string) map[string]interface{} {
+	return map[string]interface{}{
+		"summary": summary,
+		"counts":  counts,
+		"beads":   beads,
+	}
+}
+
+// DispatchFailure describes one failed scheduler dispatch attempt.
+type DispatchFailure struct {
+	BeadID   string
+	Rig      string
+	Formula  string
+	Kind     string        // Failure class, e.g. "spawn" or "formula"
+	Attempt  int           // 1 for the first failed attempt
+	Duration time.Duration // Time spent before the attempt failed
+	Error    string
+	Stderr   string // Excerpt of subprocess stderr, if any
+
+	CorrelationID string
+}
+
 // SchedulerDispatchFailedPayload creates a payload for scheduler dispatch failure events.
-func SchedulerDispatchFailedPayload(beadID, rig, errMsg string) map[string]interface{} {
+func SchedulerDispatchFailedPayload(f DispatchFailure) map[string]interface{} {
+	p := map[string]interface{}{
+		"bead":         f.BeadID,
+		"rig":          f.Rig,
+		"error":        f.Error,
+		"failure_kind": f.Kind,
+		"attempt":      f.Attempt,
+		"duration_ms":  f.Duration.Milliseconds(),
+	}
+	if f.Formula != "" {
+		p["formula"] = f.Formula
+	}
+	if f.Stderr != "" {
+		p["stderr"] = f.Stderr
+	}
+	return WithCorrelation(p, f.CorrelationID)
+}
+
+// BeadNotePayload creates a payload for bead progress note events.
+func BeadNotePayload(beadID, message string) map[string]interface{} {
 	return map[string]interface{}{
-		"bead":  beadID,
-		"rig":   rig,
-		"error": errMsg,
+		"bead":    beadID,
+		"message": message,
 	}
 }
+
+// TakeoverPayload creates a payload for takeover events.
+func TakeoverPayload(beadID, from, to, branch string) map[string]interface{} {
+	return map[string]interface{}{
+		"bead":   beadID,
+		"from":   from,
+		"to":     to,
+		"branch": branch,
+	}
+}
+
+// HandbackPayload creates a payload for handback events.
+// to is the polecat the bead was returned to, or "queue" when requeued.
+func HandbackPayload(beadID, from, to string, commits int) map[string]interface{} {
+	return map[string]interface{}{
+		"bead":    beadID,
+		"from":    from,
+		"to":      to,
+		"commits": commits,
+	}
+}
+
+// ProtectedPathBlockedPayload creates a payload for protected path violations.
+// tool is the agent tool that was blocked (Write, Edit, Bash, ...), path the
+// town-relative path it touched, and rule the protected entry it matched.
+func ProtectedPathBlockedPayload(rig, tool, path, rule, command string) map[string]interface{} {
+	p := map[string]interface{}{
+		"tool": tool,
+		"path": path,
+		"rule": rule,
+	}
+	if rig != "" {
+		p["rig"] = rig
+	}
+	if command != "" {
+		p["command"] = command
+	}
+	return p
+}
+
+// CommandDeniedPayload creates a payload for command policy denials.
+// command is the full Bash command, denied the simple command within it that
+// was refused, and rule/scope the policy entry that refused it (rule is
+// empty when the scope's default denied it).
+func CommandDeniedPayload(rig, command, denied, rule, scope, reason string) map[string]interface{} {
+	p := map[string]interface{}{
+		"command": command,
+		"denied":  denied,
+		"scope":   scope,
+	}
+	if rig != "" {
+		p["rig"] = rig
+	}
+	if rule != "" {
+		p["rule"] = rule
+	}
+	if reason != "" {
+		p["reason"] = reason
+	}
+	return p
+}
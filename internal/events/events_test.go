@@ -2,6 +2,7 @@ package events
 
 import (
 	"testing"
+	"time"
 )
 
 func TestSlingPayload(t *testing.T) {
@@ -257,3 +258,64 @@ func TestSessionPayload_Minimal(t *testing.T) {
 		t.Error("expected no cwd key when empty")
 	}
 }
+
+func TestBeadNotePayload(t *testing.T) {
+	p := BeadNotePayload("gt-abc", "tests passing, starting docs")
+	if p["bead"] != "gt-abc" {
+		t.Errorf("bead = %v, want gt-abc", p["bead"])
+	}
+	if p["message"] != "tests passing, starting docs" {
+		t.Errorf("message = %v", p["message"])
+	}
+}
+
+func TestSchedulerDispatchFailedPayload(t *testing.T) {
+	p := SchedulerDispatchFailedPayload(DispatchFailure{
+		BeadID:   "gt-abc",
+		Rig:      "gastown",
+		Formula:  "mol-polecat-work",
+		Kind:     "spawn",
+		Attempt:  2,
+		Duration: 1500 * time.Millisecond,
+		Error:    "failed to spawn polecat: no names left",
+	})
+	if p["bead"] != "gt-abc" || p["rig"] != "gastown" || p["formula"] != "mol-polecat-work" {
+		t.Errorf("identity fields = %v", p)
+	}
+	if p["failure_kind"] != "spawn" || p["attempt"] != 2 || p["duration_ms"] != int64(1500) {
+		t.Errorf("failure fields = %v", p)
+	}
+	if p["error"] != "failed to spawn polecat: no names left" {
+		t.Errorf("error = %v", p["error"])
+	}
+	if _, ok := p["stderr"]; ok {
+		t.Error("expected no stderr key when empty")
+	}
+}
+
+func TestWithCorrelation(t *testing.T) {
+	p := WithCorrelation(SlingPayload("gt-123", "gastown"), "cor-abc")
+	if got := (Event{Payload: p}).CorrelationID(); got != "cor-abc" {
+		t.Errorf("CorrelationID() = %q, want cor-abc", got)
+	}
+	if p := WithCorrelation(HookPayload("gt-123"), ""); p[CorrelationKey] != nil {
+		t.Errorf("empty id should leave payload untagged, got %v", p)
+	}
+	if id := NewCorrelationID(); len(id) != len("cor-")+12 || id == NewCorrelationID() {
+		t.Errorf("NewCorrelationID() = %q, want unique cor-<12 hex>", id)
+	}
+}
+
+func TestWithEnvCorrelation(t *testing.T) {
+	t.Setenv(EnvCorrelationID, "cor-env")
+	if p := withEnvCorrelation(DonePayload("gt-123", "polecat/toast")); p[CorrelationKey] != "cor-env" {
+		t.Errorf("env correlation not applied: %v", p)
+	}
+	if p := withEnvCorrelation(nil); p[CorrelationKey] != "cor-env" {
+		t.Errorf("nil payload not tagged: %v", p)
+	}
+	explicit := WithCorrelation(SlingPayload("gt-456", "gastown"), "cor-explicit")
+	if p := withEnvCorrelation(explicit); p[CorrelationKey] != "cor-explicit" {
+		t.Errorf("explicit correlation overridden: %v", p)
+	}
+}
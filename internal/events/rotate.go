@@ -0,0 +1,284 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Rotation: when .events.jsonl would grow past events.max_size_mb it is
+// renamed to a timestamped segment (.events-<UTC time>.jsonl) and a fresh log
+// is started. Each segment's time range is recorded in .events.index.json so
+// time-range reads open only the segments that overlap the range. The oldest
+// segments beyond events.retain_segments are deleted.
+//
+// Rotation, index writes, and retention all happen under the events lock,
+// inside write, so concurrent writers never append to a file being rotated.
+
+const (
+	// IndexFile is the name of the rotated segment index.
+	IndexFile = ".events.index.json"
+
+	segmentPrefix = ".events-"
+	segmentSuffix = ".jsonl"
+	segmentTime   = "20060102T150405Z"
+)
+
+// Segment describes one rotated events file.
+type Segment struct {
+	File    string    `json:"file"` // Base name, relative to the town root
+	FirstTS time.Time `json:"first_ts"`
+	LastTS  time.Time `json:"last_ts"`
+	Events  int       `json:"events"`
+	Size    int64     `json:"size"`
+}
+
+// segmentIndex is the on-disk format of IndexFile.
+type segmentIndex struct {
+	Segments []Segment `json:"segments"`
+}
+
+// rotationLimits returns the rotation size (bytes) and number of segments to
+// retain, from town settings or defaults.
+func rotationLimits(townRoot string) (int64, int) {
+	cfg := config.DefaultEventsConfig()
+	maxMB, retain := cfg.MaxSizeMB, cfg.RetainSegments
+	if ts, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot)); err == nil && ts.Events != nil {
+		if ts.Events.MaxSizeMB > 0 {
+			maxMB = ts.Events.MaxSizeMB
+		}
+		if ts.Events.RetainSegments > 0 {
+			retain = ts.Events.RetainSegments
+		}
+	}
+	return int64(maxMB) * 1024 * 1024, retain
+}
+
+// rotateIfNeeded rotates the events log if appending incoming bytes would
+// exceed maxSize. Must be called under the events lock.
+func rotateIfNeeded(townRoot string, incoming, maxSize int64, retain int) error {
+	eventsPath := filepath.Join(townRoot, EventsFile)
+	info, err := os.Stat(eventsPath)
+	if err != nil || info.Size() == 0 || info.Size()+incoming <= maxSize {
+		return nil
+	}
+
+	seg, err := scanSegment(eventsPath)
+	if err != nil {
+		return fmt.Errorf("scanning events log: %w", err)
+	}
+	name := segmentName(townRoot, time.Now())
+	if err := os.Rename(eventsPath, filepath.Join(townRoot, name)); err != nil {
+		return fmt.Errorf("rotating events log: %w", err)
+	}
+	seg.File = name
+
+	segments, err := Segments(townRoot)
+	if err != nil {
+		segments = nil // Rebuilt from the segment files on the next read
+	}
+	segments = append(removeSegment(segments, name), seg)
+	for len(segments) > retain {
+		_ = os.Remove(filepath.Join(townRoot, segments[0].File))
+		segments = segments[1:]
+	}
+	return atomicfile.WriteJSON(filepath.Join(townRoot, IndexFile), segmentIndex{Segments: segments})
+}
+
+// segmentName returns an unused segment file name for a rotation at now.
+func segmentName(townRoot string, now time.Time) string {
+	base := segmentPrefix + now.UTC().Format(segmentTime)
+	name := base + segmentSuffix
+	for i := 1; ; i++ {
+		if _, err := os.Stat(filepath.Join(townRoot, name)); os.IsNotExist(err) {
+			return name
+		}
+		name = fmt.Sprintf("%s-%d%s", base, i, segmentSuffix)
+	}
+}
+
+func removeSegment(segments []Segment, name string) []Segment {
+	out := segments[:0]
+	for _, s := range segments {
+		if s.File != name {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Segments returns the rotated segments under townRoot, oldest first. The
+// index is reconciled with the files on disk: entries for deleted files are
+// dropped and unindexed segments are scanned.
+func Segments(townRoot string) ([]Segment, error) {
+	var idx segmentIndex
+	if data, err := os.ReadFile(filepath.Join(townRoot, IndexFile)); err == nil {
+		_ = json.Unmarshal(data, &idx) // A corrupt index is rebuilt from the files
+	}
+	indexed := make(map[string]Segment, len(idx.Segments))
+	for _, s := range idx.Segments {
+		indexed[s.File] = s
+	}
+
+	paths, err := filepath.Glob(filepath.Join(townRoot, segmentPrefix+"*"+segmentSuffix))
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]Segment, 0, len(paths))
+	for _, p := range paths {
+		name := filepath.Base(p)
+		if s, ok := indexed[name]; ok {
+			segments = append(segments, s)
+			continue
+		}
+		s, err := scanSegment(p)
+		if err != nil {
+			continue
+		}
+		s.File = name
+		segments = append(segments, s)
+	}
+	// Names sort chronologically once the suffix is dropped (".events-<t>" < ".events-<t>-1").
+	sort.Slice(segments, func(i, j int) bool {
+		return strings.TrimSuffix(segments[i].File, segmentSuffix) < strings.TrimSuffix(segments[j].File, segmentSuffix)
+	})
+	return segments, nil
+}
+
+// scanSegment computes the time range, event count, and size of an events file.
+func scanSegment(path string) (Segment, error) {
+	var seg Segment
+	err := scanEventsFile(path, func(e Event, ts time.Time) bool {
+		if seg.FirstTS.IsZero() || ts.Before(seg.FirstTS) {
+			seg.FirstTS = ts
+		}
+		if ts.After(seg.LastTS) {
+			seg.LastTS = ts
+		}
+		seg.Events++
+		return true
+	})
+	if err != nil {
+		return seg, err
+	}
+	if info, err := os.Stat(path); err == nil {
+		seg.Size = info.Size()
+	}
+	return seg, nil
+}
+
+// scanEventsFile calls fn for each parseable event in path, in file order,
+// until fn returns false. A missing file has no events.
+func scanEventsFile(path string, fn func(Event, time.Time) bool) error {
+	f, err := os.Open(path) //nolint:gosec // G304: path is within the town root
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			continue
+		}
+		ts, err := time.Parse(time.RFC3339, e.Timestamp)
+		if err != nil {
+			continue
+		}
+		if !fn(e, ts) {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// ReadRange calls fn for each event with since <= ts <= until, oldest first,
+// across rotated segments and the active log, until fn returns false. A zero
+// since or until leaves that end of the range open. Segments whose indexed
+// time range doesn't overlap are skipped without being opened.
+func ReadRange(townRoot string, since, until time.Time, fn func(Event) bool) error {
+	segments, err := Segments(townRoot)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(segments)+1)
+	for _, s := range segments {
+		if (!since.IsZero() && s.LastTS.Before(since)) || (!until.IsZero() && s.FirstTS.After(until)) {
+			continue
+		}
+		paths = append(paths, filepath.Join(townRoot, s.File))
+	}
+	paths = append(paths, filepath.Join(townRoot, EventsFile))
+
+	stopped := false
+	for _, p := range paths {
+		err := scanEventsFile(p, func(e Event, ts time.Time) bool {
+			if (!since.IsZero() && ts.Before(since)) || (!until.IsZero() && ts.After(until)) {
+				return true
+			}
+			if !fn(e) {
+				stopped = true
+			}
+			return !stopped
+		})
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", filepath.Base(p), err)
+		}
+		if stopped {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Reopen checks whether the events log under townRoot has been replaced since
+// f was opened, either by rotation or by the KRC pruner rewriting it. If so it
+// returns the new log: positioned at its start after a rotation (everything
+// in it is new), or at its end after a rewrite (its contents were already
+// read from f). Returns nil when f is still current.
+func Reopen(townRoot string, f *os.File) (*os.File, error) {
+	eventsPath := filepath.Join(townRoot, EventsFile)
+	cur, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if latest, err := os.Stat(eventsPath); err != nil || os.SameFile(cur, latest) {
+		return nil, nil //nolint:nilerr // Missing log: keep following f until it reappears
+	}
+
+	nf, err := os.Open(eventsPath) //nolint:gosec // G304: path is within the town root
+	if err != nil {
+		return nil, err
+	}
+	whence := io.SeekEnd
+	if segments, err := Segments(townRoot); err == nil && len(segments) > 0 {
+		newest, err := os.Stat(filepath.Join(townRoot, segments[len(segments)-1].File))
+		if err == nil && os.SameFile(cur, newest) {
+			whence = io.SeekStart
+		}
+	}
+	if _, err := nf.Seek(0, whence); err != nil {
+		_ = nf.Close()
+		return nil, err
+	}
+	return nf, nil
+}
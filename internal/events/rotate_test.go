@@ -0,0 +1,220 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func appendTestEvent(t *testing.T, townRoot string, ts time.Time, typ string) {
+	t.Helper()
+	data, err := json.Marshal(Event{Timestamp: ts.UTC().Format(time.RFC3339), Type: typ, Visibility: VisibilityFeed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := appendLine(filepath.Join(townRoot, EventsFile), append(data, '\n')); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAppendLine_TerminatesTornLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EventsFile)
+	if err := os.WriteFile(path, []byte(`{"ts":"2026-01-01T00:00:00Z","type":"sl`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := appendLine(path, []byte(`{"type":"hook"}`+"\n")); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || lines[1] != `{"type":"hook"}` {
+		t.Errorf("lines = %q, want torn line followed by the new record", lines)
+	}
+}
+
+func TestWithLock_ConcurrentAppends(t *testing.T) {
+	townRoot := t.TempDir()
+	const writers, perWriter = 8, 50
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				line := fmt.Sprintf(`{"type":"w%d","n":%d,"pad":%q}`+"\n", w, i, strings.Repeat("x", 512))
+				_ = WithLock(townRoot, func() error {
+					return appendLine(filepath.Join(townRoot, EventsFile), []byte(line))
+				})
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	f, err := os.Open(filepath.Join(townRoot, EventsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	count := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var v map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &v); err != nil {
+			t.Fatalf("interleaved line %d: %v", count, err)
+		}
+		count++
+	}
+	if count != writers*perWriter {
+		t.Errorf("got %d lines, want %d", count, writers*perWriter)
+	}
+}
+
+func TestRotateIfNeeded_SegmentsIndexAndRetention(t *testing.T) {
+	townRoot := t.TempDir()
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// Three rotations, retaining two segments.
+	for r := 0; r < 3; r++ {
+		for i := 0; i < 3; i++ {
+			appendTestEvent(t, townRoot, base.Add(time.Duration(r*10+i)*time.Hour), "sling")
+		}
+		if err := rotateIfNeeded(townRoot, 1, 1, 2); err != nil {
+			t.Fatalf("rotation %d: %v", r, err)
+		}
+		if _, err := os.Stat(filepath.Join(townRoot, EventsFile)); !os.IsNotExist(err) {
+			t.Fatalf("rotation %d: active log should have been moved aside", r)
+		}
+	}
+
+	segments, err := Segments(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 2 {
+		t.Fatalf("got %d segments, want 2 retained: %+v", len(segments), segments)
+	}
+	if segments[0].Events != 3 || !segments[0].FirstTS.Equal(base.Add(10*time.Hour)) ||
+		!segments[1].LastTS.Equal(base.Add(22*time.Hour)) {
+		t.Errorf("segments = %+v, want the two newest rotations", segments)
+	}
+
+	// A small log under the limit is left alone.
+	appendTestEvent(t, townRoot, base.Add(30*time.Hour), "hook")
+	if err := rotateIfNeeded(townRoot, 1, 1<<20, 2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(townRoot, EventsFile)); err != nil {
+		t.Errorf("log under the size limit should not rotate: %v", err)
+	}
+}
+
+func TestSegments_RebuildsMissingIndex(t *testing.T) {
+	townRoot := t.TempDir()
+	appendTestEvent(t, townRoot, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), "sling")
+	if err := rotateIfNeeded(townRoot, 1, 1, 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(townRoot, IndexFile)); err != nil {
+		t.Fatal(err)
+	}
+
+	segments, err := Segments(townRoot)
+	if err != nil || len(segments) != 1 || segments[0].Events != 1 {
+		t.Errorf("Segments without index = %+v, %v; want one rescanned segment", segments, err)
+	}
+}
+
+func TestReadRange_SpansSegments(t *testing.T) {
+	townRoot := t.TempDir()
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	appendTestEvent(t, townRoot, base, "old")
+	appendTestEvent(t, townRoot, base.Add(time.Hour), "older-mid")
+	if err := rotateIfNeeded(townRoot, 1, 1, 5); err != nil {
+		t.Fatal(err)
+	}
+	appendTestEvent(t, townRoot, base.Add(48*time.Hour), "new")
+
+	var got []string
+	collect := func(e Event) bool { got = append(got, e.Type); return true }
+
+	if err := ReadRange(townRoot, time.Time{}, time.Time{}, collect); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "old,older-mid,new" {
+		t.Errorf("full range = %v", got)
+	}
+
+	got = nil
+	if err := ReadRange(townRoot, base.Add(30*time.Minute), base.Add(2*time.Hour), collect); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, ",") != "older-mid" {
+		t.Errorf("bounded range = %v, want [older-mid]", got)
+	}
+
+	got = nil
+	if err := ReadRange(townRoot, time.Time{}, time.Time{}, func(e Event) bool {
+		got = append(got, e.Type)
+		return false
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 {
+		t.Errorf("returning false should stop the read, got %v", got)
+	}
+}
+
+func TestReopen(t *testing.T) {
+	townRoot := t.TempDir()
+	eventsPath := filepath.Join(townRoot, EventsFile)
+	appendTestEvent(t, townRoot, time.Now(), "first")
+
+	f, err := os.Open(eventsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if nf, err := Reopen(townRoot, f); err != nil || nf != nil {
+		t.Fatalf("Reopen on current log = %v, %v; want nil", nf, err)
+	}
+
+	// Rotation: the new log is read from its start.
+	if err := rotateIfNeeded(townRoot, 1, 1, 5); err != nil {
+		t.Fatal(err)
+	}
+	appendTestEvent(t, townRoot, time.Now(), "after-rotate")
+	nf, err := Reopen(townRoot, f)
+	if err != nil || nf == nil {
+		t.Fatalf("Reopen after rotation = %v, %v", nf, err)
+	}
+	defer nf.Close()
+	if data, _ := io.ReadAll(nf); !strings.Contains(string(data), "after-rotate") {
+		t.Errorf("after rotation, reader should start at the new log's beginning; read %q", data)
+	}
+
+	// Rewrite in place (KRC prune): the new log is read from its end.
+	tmp := eventsPath + ".tmp"
+	if err := os.WriteFile(tmp, []byte(`{"type":"retained"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, eventsPath); err != nil {
+		t.Fatal(err)
+	}
+	rf, err := Reopen(townRoot, nf)
+	if err != nil || rf == nil {
+		t.Fatalf("Reopen after rewrite = %v, %v", rf, err)
+	}
+	defer rf.Close()
+	if data, _ := io.ReadAll(rf); len(data) != 0 {
+		t.Errorf("after a rewrite, reader should start at the end; read %q", data)
+	}
+}
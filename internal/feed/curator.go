@@ -128,7 +128,7 @@ func (c *Curator) Stop() {
 // ZFC: No in-memory state to clean up - state is derived from the events file.
 func (c *Curator) run(file *os.File) {
 	defer c.wg.Done()
-	defer file.Close()
+	defer func() { _ = file.Close() }()
 
 	reader := bufio.NewReader(file)
 	ticker := time.NewTicker(100 * time.Millisecond)
@@ -148,6 +148,15 @@ func (c *Curator) run(file *os.File) {
 				}
 				c.processLine(line)
 			}
+
+			// Follow the log across rotation and KRC rewrites.
+			if nf, err := events.Reopen(c.townRoot, file); err != nil {
+				log.Printf("warning: reopening events file: %v", err)
+			} else if nf != nil {
+				_ = file.Close()
+				file = nf
+				reader = bufio.NewReader(file)
+			}
 		}
 	}
 }
@@ -279,57 +288,17 @@ func (c *Curator) readRecentFeedEvents(window time.Duration) ([]FeedEvent, error
 	return result, nil
 }
 
-// readRecentEvents reads events from the events file within the given time window.
+// readRecentEvents reads events within the given time window from the events
+// log, and from rotated segments when the window reaches back past the last
+// rotation.
 // ZFC: This is the observable state that replaces in-memory caching.
-// Reads at most tailReadSize bytes from the end to bound memory usage.
 func (c *Curator) readRecentEvents(window time.Duration) ([]events.Event, error) {
-	eventsPath := filepath.Join(c.townRoot, events.EventsFile)
-	f, err := os.Open(eventsPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("opening events file: %w", err)
-	}
-	defer f.Close()
-
-	info, err := f.Stat()
-	if err != nil {
-		return nil, fmt.Errorf("stat events file: %w", err)
-	}
-	if info.Size() == 0 {
-		return nil, nil
-	}
-
-	seekTo := info.Size() - tailReadSize
-	if seekTo < 0 {
-		seekTo = 0
-	}
-	if _, err := f.Seek(seekTo, io.SeekStart); err != nil {
-		return nil, fmt.Errorf("seeking events file: %w", err)
-	}
-
-	scanner := bufio.NewScanner(f)
-	if seekTo > 0 {
-		scanner.Scan() // skip potential partial first line at cut point
-	}
-
-	cutoff := time.Now().Add(-window)
 	var result []events.Event
-	for scanner.Scan() {
-		var event events.Event
-		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
-			continue
-		}
-		ts, err := time.Parse(time.RFC3339, event.Timestamp)
-		if err != nil {
-			continue
-		}
-		if !ts.Before(cutoff) {
-			result = append(result, event)
-		}
-	}
-	if err := scanner.Err(); err != nil {
+	err := events.ReadRange(c.townRoot, time.Now().Add(-window), time.Time{}, func(e events.Event) bool {
+		result = append(result, e)
+		return true
+	})
+	if err != nil {
 		return result, fmt.Errorf("scanning events file: %w", err)
 	}
 	return result, nil
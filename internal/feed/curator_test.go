@@ -770,7 +770,7 @@ func TestCurator_ReadRecentEvents_ScannerError(t *testing.T) {
 	f.Write(append(data, '\n'))
 
 	// Oversized line triggers scanner.Err()
-	longLine := make([]byte, 70*1024)
+	longLine := make([]byte, 2*1024*1024)
 	for i := range longLine {
 		longLine[i] = 'y'
 	}
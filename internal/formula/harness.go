@@ -0,0 +1,317 @@
+package formula
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Test declares assertions gt formula test checks after cooking a formula
+// against a fixture bead. It lives in the formula's [test] table:
+//
+//	[test]
+//	files = ["go.mod", "docs/{{issue}}.md"]
+//
+//	[[test.prompts]]
+//	step = "implement"
+//	contains = ["{{issue}}", "go test ./..."]
+//	vars = ["issue", "test_command"]
+type Test struct {
+	// Files must exist in the throwaway worktree once the formula is
+	// cooked. Paths are relative to the worktree and may use {{vars}}.
+	Files []string `toml:"files"`
+	// Prompts are assertions on cooked step prompts.
+	Prompts []PromptAssertion `toml:"prompts"`
+}
+
+// PromptAssertion is an assertion on cooked step prompts.
+type PromptAssertion struct {
+	// Step is the step, leg, template or aspect ID. Empty means every step.
+	Step string `toml:"step"`
+	// Contains are strings the cooked prompt must contain, after {{vars}}
+	// in them are substituted.
+	Contains []string `toml:"contains"`
+	// Vars are variables the prompt must reference, and whose fixture value
+	// must appear in the cooked prompt.
+	Vars []string `toml:"vars"`
+}
+
+// Fixture is a bead to cook a formula against. It has the shape of
+// bd show --json, plus the vars gt sling would pass with --var.
+type Fixture struct {
+	ID          string            `json:"id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Labels      []string          `json:"labels,omitempty"`
+	Rig         string            `json:"rig,omitempty"` // Rig to take the worktree from
+	Vars        map[string]string `json:"vars,omitempty"`
+}
+
+// LoadFixture reads a fixture bead from a JSON file. A one-element array,
+// as bd show --json prints, is accepted too.
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is given by the formula author
+	if err != nil {
+		return nil, err
+	}
+	data = []byte(strings.TrimSpace(string(data)))
+	if strings.HasPrefix(string(data), "[") {
+		var list []Fixture
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, fmt.Errorf("parsing fixture: %w", err)
+		}
+		if len(list) != 1 {
+			return nil, fmt.Errorf("fixture holds %d beads, want 1", len(list))
+		}
+		return &list[0], nil
+	}
+	var fx Fixture
+	if err := json.Unmarshal(data, &fx); err != nil {
+		return nil, fmt.Errorf("parsing fixture: %w", err)
+	}
+	return &fx, nil
+}
+
+// FixtureVars returns the vars a formula is cooked with for a fixture, in
+// the order gt sling applies them: the formula's var defaults, then base
+// (rig command vars), then feature and issue from the bead, then the
+// fixture's own vars.
+func (f *Formula) FixtureVars(fx *Fixture, base map[string]string) map[string]string {
+	vars := make(map[string]string)
+	for name, v := range f.Vars {
+		vars[name] = v.Default
+	}
+	for name, in := range f.Inputs {
+		if in.Default != "" {
+			vars[name] = in.Default
+		}
+	}
+	for k, v := range base {
+		vars[k] = v
+	}
+	if fx.Title != "" {
+		vars["feature"] = fx.Title
+	}
+	if fx.ID != "" {
+		vars["issue"] = fx.ID
+	}
+	for k, v := range fx.Vars {
+		vars[k] = v
+	}
+	return vars
+}
+
+// CookedStep is a step with its {{vars}} substituted: the prompt an agent
+// would be given.
+type CookedStep struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Prompt string `json:"prompt"`
+	// Raw is the prompt before substitution.
+	Raw string `json:"-"`
+}
+
+// promptPart is the text of one step, leg, synthesis, template or aspect.
+type promptPart struct {
+	id, title, body string
+}
+
+// promptParts returns the formula's prompt texts in formula order.
+func (f *Formula) promptParts() []promptPart {
+	var parts []promptPart
+	for _, s := range f.Steps {
+		parts = append(parts, promptPart{s.ID, s.Title, s.Description})
+	}
+	for _, l := range f.Legs {
+		parts = append(parts, promptPart{l.ID, l.Title, strings.TrimSpace(l.Focus + "\n\n" + l.Description)})
+	}
+	if f.Synthesis != nil {
+		parts = append(parts, promptPart{"synthesis", f.Synthesis.Title, f.Synthesis.Description})
+	}
+	for _, t := range f.Template {
+		parts = append(parts, promptPart{t.ID, t.Title, t.Description})
+	}
+	for _, a := range f.Aspects {
+		parts = append(parts, promptPart{a.ID, a.Title, strings.TrimSpace(a.Focus + "\n\n" + a.Description)})
+	}
+	return parts
+}
+
+// CookedSteps pairs the steps of cooked, the formula as bd cook rendered it
+// with vars substituted, with their uncooked text in f. It returns them in
+// formula order along with the variables bd left unsubstituted.
+func (f *Formula) CookedSteps(cooked *Formula) (steps []CookedStep, missing []string) {
+	raw := make(map[string]string)
+	for _, p := range f.promptParts() {
+		raw[p.id] = p.title + "\n\n" + p.body
+	}
+	unset := make(map[string]bool)
+	for _, p := range cooked.promptParts() {
+		prompt := p.title + "\n\n" + p.body
+		for _, name := range ExtractTemplateVariables(prompt) {
+			unset[name] = true
+		}
+		steps = append(steps, CookedStep{ID: p.id, Title: p.title, Prompt: prompt, Raw: raw[p.id]})
+	}
+	for name := range unset {
+		missing = append(missing, name)
+	}
+	sort.Strings(missing)
+	return steps, missing
+}
+
+// cookedPart is a step, leg, synthesis, template or aspect in bd cook's
+// JSON output. Workflow steps may nest children.
+type cookedPart struct {
+	ID          string       `json:"id"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Focus       string       `json:"focus"`
+	Children    []cookedPart `json:"children"`
+}
+
+// ParseCooked reads the formula bd cook prints as JSON. Only what
+// CookedSteps needs is kept; nested workflow steps are flattened in order.
+func ParseCooked(data []byte) (*Formula, error) {
+	var c struct {
+		Name      string       `json:"formula"`
+		Steps     []cookedPart `json:"steps"`
+		Legs      []cookedPart `json:"legs"`
+		Synthesis *cookedPart  `json:"synthesis"`
+		Template  []cookedPart `json:"template"`
+		Aspects   []cookedPart `json:"aspects"`
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parsing bd cook output: %w", err)
+	}
+	f := &Formula{Name: c.Name}
+	var addSteps func(parts []cookedPart)
+	addSteps = func(parts []cookedPart) {
+		for _, p := range parts {
+			f.Steps = append(f.Steps, Step{ID: p.ID, Title: p.Title, Description: p.Description})
+			addSteps(p.Children)
+		}
+	}
+	addSteps(c.Steps)
+	for _, l := range c.Legs {
+		f.Legs = append(f.Legs, Leg{ID: l.ID, Title: l.Title, Focus: l.Focus, Description: l.Description})
+	}
+	if c.Synthesis != nil {
+		f.Synthesis = &Synthesis{Title: c.Synthesis.Title, Description: c.Synthesis.Description}
+	}
+	for _, t := range c.Template {
+		f.Template = append(f.Template, Template{ID: t.ID, Title: t.Title, Description: t.Description})
+	}
+	for _, a := range c.Aspects {
+		f.Aspects = append(f.Aspects, Aspect{ID: a.ID, Title: a.Title, Focus: a.Focus, Description: a.Description})
+	}
+	return f, nil
+}
+
+// substituteVars replaces {{name}} placeholders with their values,
+// recording names with no value in unset. Handlebars keywords are left
+// alone, as ExtractTemplateVariables does.
+func substituteVars(text string, vars map[string]string, unset map[string]bool) string {
+	return variablePattern.ReplaceAllStringFunc(text, func(m string) string {
+		name := variablePattern.FindStringSubmatch(m)[1]
+		if isHandlebarsKeyword(name) {
+			return m
+		}
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		unset[name] = true
+		return m
+	})
+}
+
+// TestResult is the outcome of one assertion.
+type TestResult struct {
+	Name   string `json:"name"`
+	Pass   bool   `json:"pass"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// RunTest checks the formula's [test] assertions, and that every variable
+// is set, against steps cooked with vars in the worktree at dir.
+func (f *Formula) RunTest(steps []CookedStep, missing []string, vars map[string]string, dir string) []TestResult {
+	var results []TestResult
+	add := func(name string, pass bool, detail string) {
+		results = append(results, TestResult{Name: name, Pass: pass, Detail: detail})
+	}
+
+	if len(missing) == 0 {
+		add("all variables set", true, "")
+	} else {
+		add("all variables set", false, "unset: "+strings.Join(missing, ", "))
+	}
+	if f.Test == nil {
+		return results
+	}
+
+	for _, p := range f.Test.Files {
+		rel := substituteVars(p, vars, map[string]bool{})
+		_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(rel)))
+		detail := ""
+		if err != nil {
+			detail = "not found in worktree"
+		}
+		add("file "+rel, err == nil, detail)
+	}
+
+	for _, a := range f.Test.Prompts {
+		targets := steps
+		label := "every step"
+		if a.Step != "" {
+			label = "step " + a.Step
+			targets = nil
+			for _, s := range steps {
+				if s.ID == a.Step {
+					targets = append(targets, s)
+				}
+			}
+			if len(targets) == 0 {
+				add(label+" exists", false, "no step, leg, template or aspect with this ID")
+				continue
+			}
+		}
+		for _, want := range a.Contains {
+			want = substituteVars(want, vars, map[string]bool{})
+			failed := failingSteps(targets, func(s CookedStep) bool { return strings.Contains(s.Prompt, want) })
+			add(fmt.Sprintf("%s contains %q", label, want), len(failed) == 0, failedDetail(failed))
+		}
+		for _, name := range a.Vars {
+			value, set := vars[name]
+			failed := failingSteps(targets, func(s CookedStep) bool {
+				return strings.Contains(s.Raw, "{{"+name+"}}") && set && strings.Contains(s.Prompt, value)
+			})
+			detail := failedDetail(failed)
+			if !set {
+				detail = "variable not set by the fixture or formula"
+			}
+			add(fmt.Sprintf("%s uses {{%s}}", label, name), len(failed) == 0 && set, detail)
+		}
+	}
+	return results
+}
+
+// failingSteps returns the IDs of steps for which ok is false.
+func failingSteps(steps []CookedStep, ok func(CookedStep) bool) []string {
+	var failed []string
+	for _, s := range steps {
+		if !ok(s) {
+			failed = append(failed, s.ID)
+		}
+	}
+	return failed
+}
+
+func failedDetail(failed []string) string {
+	if len(failed) == 0 {
+		return ""
+	}
+	return "fails in: " + strings.Join(failed, ", ")
+}
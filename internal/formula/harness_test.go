@@ -0,0 +1,143 @@
+package formula
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const harnessFormula = `
+formula = "harness-test"
+type = "workflow"
+
+[vars]
+base_branch = "main"
+[vars.test_command]
+description = "How to run tests"
+
+[[steps]]
+id = "load"
+title = "Load {{issue}}"
+description = "Read {{issue}}: {{feature}}. Base: {{base_branch}}. {{#if x}}kept{{/if}}"
+
+[[steps]]
+id = "verify"
+title = "Verify"
+description = "Run {{test_command}} before {{deadline}}"
+needs = ["load"]
+
+[test]
+files = ["go.mod", "docs/{{issue}}.md"]
+
+[[test.prompts]]
+step = "load"
+contains = ["Read {{issue}}", "Base: main"]
+vars = ["issue", "feature"]
+
+[[test.prompts]]
+step = "verify"
+vars = ["test_command", "issue"]
+
+[[test.prompts]]
+step = "missing"
+contains = ["x"]
+`
+
+func TestFormulaHarness(t *testing.T) {
+	f, err := Parse([]byte(harnessFormula))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if f.Test == nil || len(f.Test.Prompts) != 3 {
+		t.Fatalf("Test = %+v, want [test] with 3 prompt assertions", f.Test)
+	}
+
+	fx := &Fixture{ID: "gt-abc", Title: "Fix login", Vars: map[string]string{"test_command": "go test ./..."}}
+	vars := f.FixtureVars(fx, map[string]string{"base_branch": "develop", "test_command": "make test"})
+	if vars["issue"] != "gt-abc" || vars["feature"] != "Fix login" {
+		t.Errorf("bead vars = %v", vars)
+	}
+	if vars["base_branch"] != "develop" || vars["test_command"] != "go test ./..." {
+		t.Errorf("precedence wrong: %v", vars)
+	}
+	vars["base_branch"] = "main"
+
+	// What bd cook --mode=runtime prints for these vars: deadline has no
+	// value, so it stays a placeholder. Nested children are flattened.
+	cooked, err := ParseCooked([]byte(`{"formula": "harness-test", "steps": [
+		{"id": "load", "title": "Load gt-abc",
+		 "description": "Read gt-abc: Fix login. Base: main. {{#if x}}kept{{/if}}",
+		 "children": [{"id": "verify", "title": "Verify", "description": "Run go test ./... before {{deadline}}"}]}]}`))
+	if err != nil {
+		t.Fatalf("ParseCooked: %v", err)
+	}
+	steps, missing := f.CookedSteps(cooked)
+	if len(steps) != 2 || steps[0].Title != "Load gt-abc" || steps[1].ID != "verify" {
+		t.Fatalf("cooked steps = %+v", steps)
+	}
+	if !strings.Contains(steps[0].Raw, "{{issue}}") {
+		t.Errorf("Raw should be the uncooked prompt: %q", steps[0].Raw)
+	}
+	if strings.Join(missing, ",") != "deadline" {
+		t.Errorf("missing = %v, want [deadline]", missing)
+	}
+	if _, err := ParseCooked([]byte("not json")); err == nil {
+		t.Error("ParseCooked should reject non-JSON output")
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]TestResult)
+	for _, r := range f.RunTest(steps, missing, vars, dir) {
+		got[r.Name] = r
+	}
+	want := map[string]bool{
+		"all variables set":                 false,
+		"file go.mod":                       true,
+		"file docs/gt-abc.md":               false,
+		`step load contains "Read gt-abc"`:  true,
+		`step load contains "Base: main"`:   true,
+		"step load uses {{issue}}":          true,
+		"step load uses {{feature}}":        true,
+		"step verify uses {{test_command}}": true,
+		"step verify uses {{issue}}":        false,
+		"step missing exists":               false,
+	}
+	for name, pass := range want {
+		r, ok := got[name]
+		if !ok {
+			t.Errorf("no result %q (have %v)", name, got)
+			continue
+		}
+		if r.Pass != pass {
+			t.Errorf("%s: pass = %v (%s), want %v", name, r.Pass, r.Detail, pass)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d results, want %d", len(got), len(want))
+	}
+}
+
+func TestLoadFixture(t *testing.T) {
+	dir := t.TempDir()
+	single := filepath.Join(dir, "bead.json")
+	list := filepath.Join(dir, "show.json")
+	if err := os.WriteFile(single, []byte(`{"id":"gt-1","title":"T","vars":{"a":"b"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(list, []byte(`[{"id":"gt-2","title":"U"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fx, err := LoadFixture(single)
+	if err != nil || fx.ID != "gt-1" || fx.Vars["a"] != "b" {
+		t.Errorf("LoadFixture(object) = %+v, %v", fx, err)
+	}
+	fx, err = LoadFixture(list)
+	if err != nil || fx.ID != "gt-2" {
+		t.Errorf("LoadFixture(bd show array) = %+v, %v", fx, err)
+	}
+}
@@ -538,6 +538,8 @@ func resolveChain(formula *Formula, searchPaths []string, chain []string) (*Form
 		Agent:       formula.Agent,
 		Compose:     formula.Compose,
 		Vars:        make(map[string]Var),
+		Test:        formula.Test,
+		Paths:       formula.Paths,
 	}
 	if merged.Type == "" {
 		merged.Type = TypeWorkflow
@@ -901,6 +901,59 @@ func TestResolve_MonorepoTDD(t *testing.T) {
 	}
 }
 
+// TestResolve_MaintenanceFormulas verifies each maintenance workflow resolves
+// against mol-polecat-work with its implement step expanded.
+func TestResolve_MaintenanceFormulas(t *testing.T) {
+	tests := []struct {
+		formula string
+		first   string
+		last    string
+	}{
+		{"mol-polecat-dep-bump", "implement.survey", "implement.verify"},
+		{"mol-polecat-flaky-tests", "implement.reproduce", "implement.fix"},
+		{"mol-polecat-todo-triage", "implement.inventory", "implement.resolve"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			data, err := GetEmbeddedFormulaContent(tt.formula)
+			if err != nil {
+				t.Fatalf("GetEmbeddedFormulaContent: %v", err)
+			}
+			f, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			resolved, err := Resolve(f, nil)
+			if err != nil {
+				t.Fatalf("Resolve: %v", err)
+			}
+
+			if resolved.GetStep("implement") != nil {
+				t.Errorf("implement step was not expanded; got %v", stepIDs(resolved))
+			}
+			first := resolved.GetStep(tt.first)
+			if first == nil {
+				t.Fatalf("missing step %q; got %v", tt.first, stepIDs(resolved))
+			}
+			if len(first.Needs) != 1 || first.Needs[0] != "branch-setup" {
+				t.Errorf("%s.Needs = %v, want [branch-setup]", tt.first, first.Needs)
+			}
+			commit := resolved.GetStep("commit-changes")
+			if commit == nil {
+				t.Fatal("commit-changes step not found")
+			}
+			if len(commit.Needs) != 1 || commit.Needs[0] != tt.last {
+				t.Errorf("commit-changes.Needs = %v, want [%s]", commit.Needs, tt.last)
+			}
+			for _, s := range resolved.Steps {
+				if strings.HasPrefix(s.ID, "implement.") && s.Acceptance == "" {
+					t.Errorf("step %q has no acceptance criteria", s.ID)
+				}
+			}
+		})
+	}
+}
+
 // stepIDs returns the IDs of all steps in a formula for test diagnostics.
 func stepIDs(f *Formula) []string {
 	ids := make([]string, len(f.Steps))
@@ -55,6 +55,14 @@ type Formula struct {
 
 	// Aspect-specific (similar to convoy but for analysis)
 	Aspects []Aspect `toml:"aspects"`
+
+	// Test declares assertions for gt formula test (see Test).
+	Test *Test `toml:"test"`
+
+	// Paths are globs of the files work cooked from this formula is expected
+	// to touch. The scheduler uses them, when the bead has no predicted_paths
+	// metadata, to serialize dispatch of beads in the same conflict group.
+	Paths []string `toml:"paths"`
 }
 
 // ComposeRules defines how a formula can be composed with others.
@@ -82,6 +82,29 @@ func (g *Git) WorkDir() string {
 	return g.workDir
 }
 
+// RepoDir returns the repository this Git instance operates on: the git
+// directory for bare repos, otherwise the working directory.
+func (g *Git) RepoDir() string {
+	if g.gitDir != "" {
+		return g.gitDir
+	}
+	return g.workDir
+}
+
+// CommonDir returns the absolute path of the repository's common git
+// directory: the bare repo itself, or the main repo's .git for a worktree.
+// All linked worktrees of a repo share it.
+func (g *Git) CommonDir() (string, error) {
+	dir, err := g.run("rev-parse", "--git-common-dir")
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(g.RepoDir(), dir)
+	}
+	return filepath.Clean(dir), nil
+}
+
 // IsRepo returns true if the workDir is a git repository.
 func (g *Git) IsRepo() bool {
 	_, err := g.run("rev-parse", "--git-dir")
@@ -228,6 +251,7 @@ func (g *Git) wrapError(err error, stdout, stderr string, args []string) error {
 // cloneOptions configures a clone operation for cloneInternal.
 type cloneOptions struct {
 	bare         bool   // Pass --bare to git clone
+	mirror       bool   // Pass --mirror to git clone (implies bare; no Gas Town refspec)
 	reference    string // Pass --reference-if-able <path> to git clone
 	singleBranch bool   // Pass --single-branch to git clone (only fetch default branch)
 	depth        int    // Pass --depth N to git clone (shallow clone); 0 means full history
@@ -263,6 +287,9 @@ func (g *Git) cloneInternal(url, dest string, opts cloneOptions) error {
 	if opts.bare {
 		args = append(args, "--bare")
 	}
+	if opts.mirror {
+		args = append(args, "--mirror")
+	}
 	if opts.singleBranch {
 		args = append(args, "--single-branch")
 	}
@@ -297,6 +324,10 @@ func (g *Git) cloneInternal(url, dest string, opts cloneOptions) error {
 	}
 
 	// Post-clone configuration
+	if opts.mirror {
+		// A mirror keeps the remote's refs as-is; it is never a worktree base.
+		return nil
+	}
 	if opts.bare {
 		// Configure refspec so worktrees can fetch and see origin/* refs.
 		// For single-branch shallow clones, only set the config without
@@ -472,6 +503,12 @@ func configureRefspec(repoPath string, singleBranch bool) error {
 	return nil
 }
 
+// CloneMirror clones a full mirror (all refs, full history) of a repository.
+// Used for the town's shared object caches, which other clones borrow from.
+func (g *Git) CloneMirror(url, dest string) error {
+	return g.cloneInternal(url, dest, cloneOptions{mirror: true})
+}
+
 // CloneBareWithReference clones a bare repository using a local repo as an object reference.
 // Uses --single-branch --depth 1 for efficiency on repos with many branches.
 func (g *Git) CloneBareWithReference(url, dest, reference string) error {
@@ -509,6 +546,13 @@ func (g *Git) FetchPrune(remote string) error {
 	return err
 }
 
+// FetchRefspec fetches an explicit refspec (e.g. "+refs/heads/*:refs/remotes/origin/*")
+// from the remote.
+func (g *Git) FetchRefspec(remote, refspec string) error {
+	_, err := g.run("fetch", remote, refspec)
+	return err
+}
+
 // FetchBranch fetches a specific branch from the remote.
 func (g *Git) FetchBranch(remote, branch string) error {
 	_, err := g.run("fetch", remote, branch)
@@ -942,6 +986,19 @@ func (g *Git) GetBranchCommitMessage(branch string) (string, error) {
 	return g.run("log", "-1", "--format=%B", branch)
 }
 
+// CommitSubjects returns the subject lines of commits on branch that are not
+// on base, oldest first. Equivalent to: git log --reverse --format=%s base..branch
+func (g *Git) CommitSubjects(base, branch string) ([]string, error) {
+	out, err := g.run("log", "--reverse", "--format=%s", base+".."+branch)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(strings.TrimSpace(out), "\n"), nil
+}
+
 // RecentCommits returns the last n commits as one-line summaries (hash + subject).
 // Returns empty string if there are no commits or the repo is empty.
 func (g *Git) RecentCommits(n int) (string, error) {
@@ -1612,6 +1669,32 @@ func RemoveSparseCheckout(repoPath string) error {
 	return nil
 }
 
+// CloneShared clones this repository to dest without checking anything out,
+// borrowing its objects through alternates (git clone --shared). The clone
+// is cheap but depends on this repository's objects staying in place, so
+// gc is first stopped from deleting any here (see KeepObjects).
+func (g *Git) CloneShared(dest string) error {
+	if err := g.KeepObjects(); err != nil {
+		return fmt.Errorf("protecting shared objects: %w", err)
+	}
+	// Run outside this repo: --git-dir would otherwise apply to the new clone.
+	_, err := NewGit("").run("clone", "--shared", "--no-checkout", "--quiet", g.RepoDir(), dest)
+	return err
+}
+
+// KeepObjects stops gc from deleting objects in this repository, for one
+// that other repositories borrow objects from through alternates: an object
+// unreachable here after a fetch --prune may still be needed by a borrower,
+// and gc can't tell. Sets gc.auto=0 (no automatic gc after fetches) and
+// gc.pruneExpire=never (a manual gc still never prunes).
+func (g *Git) KeepObjects() error {
+	if _, err := g.run("config", "gc.auto", "0"); err != nil {
+		return err
+	}
+	_, err := g.run("config", "gc.pruneExpire", "never")
+	return err
+}
+
 // WorktreeRemove removes a worktree.
 func (g *Git) WorktreeRemove(path string, force bool) error {
 	args := []string{"worktree", "remove", path}
@@ -1724,6 +1807,11 @@ func (g *Git) DiffStat(rangeSpec string) (string, error) {
 	return g.run("diff", "--stat", rangeSpec)
 }
 
+// Diff returns the full patch for a diff range (e.g., "main...feature").
+func (g *Git) Diff(rangeSpec string) (string, error) {
+	return g.run("diff", rangeSpec)
+}
+
 // For example, CommitsAhead("main", "feature") returns how many commits
 // are on feature that are not on main.
 func (g *Git) CommitsAhead(base, branch string) (int, error) {
@@ -1787,6 +1875,14 @@ func (g *Git) CheckBranchContamination(baseRef string) (BranchContamination, err
 	return result, nil
 }
 
+// Stash saves uncommitted changes, including untracked files, to the stash
+// with the given message. Stashes live in the shared repository, so they
+// survive removal of the worktree they were made in.
+func (g *Git) Stash(message string) error {
+	_, err := g.run("stash", "push", "--include-untracked", "-m", message)
+	return err
+}
+
 // StashCount returns the number of stashes belonging to the current branch.
 // Git stashes are stored in the main repo (.git/refs/stash) and shared across
 // all worktrees. Counting all stashes is incorrect for worktree-based polecats:
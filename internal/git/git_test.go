@@ -1,6 +1,7 @@
 package git
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -1461,6 +1462,25 @@ func TestGetPushURL_NoPushURL(t *testing.T) {
 	}
 }
 
+func TestStash_IncludesUntracked(t *testing.T) {
+	t.Parallel()
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "untracked.txt"), []byte("wip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Stash("wip before kill"); err != nil {
+		t.Fatalf("Stash: %v", err)
+	}
+	if dirty, err := g.HasUncommittedChanges(); err != nil || dirty {
+		t.Errorf("worktree should be clean after Stash (dirty=%v, err=%v)", dirty, err)
+	}
+	if count, err := g.StashCount(); err != nil || count != 1 {
+		t.Errorf("StashCount = %d, %v; want 1", count, err)
+	}
+}
+
 // TestStashCount_FiltersByBranch verifies that StashCount only counts stashes
 // belonging to the current branch, not stashes from other worktrees/branches.
 // Git stashes are repo-wide (stored in .git/refs/stash), so without filtering
@@ -2141,3 +2161,48 @@ func TestBranchPushedToRemote_NoPushURL(t *testing.T) {
 		t.Errorf("BranchPushedToRemote unpushed = %d, want >= 1", unpushed)
 	}
 }
+
+func TestCommitSubjects(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	base, err := g.CurrentBranch()
+	if err != nil {
+		t.Fatalf("CurrentBranch: %v", err)
+	}
+
+	if err := g.CreateBranch("feature"); err != nil {
+		t.Fatalf("CreateBranch: %v", err)
+	}
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	for i, subject := range []string{"feat: add parser", "test: cover parser"} {
+		path := filepath.Join(dir, fmt.Sprintf("f%d.txt", i))
+		if err := os.WriteFile(path, []byte("x\n"), 0644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+		if err := g.Add(path); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		if err := g.Commit(subject); err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+	}
+
+	got, err := g.CommitSubjects(base, "feature")
+	if err != nil {
+		t.Fatalf("CommitSubjects: %v", err)
+	}
+	want := []string{"feat: add parser", "test: cover parser"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("CommitSubjects = %q, want %q", got, want)
+	}
+
+	got, err = g.CommitSubjects("feature", base)
+	if err != nil {
+		t.Fatalf("CommitSubjects (empty range): %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no commits, got %q", got)
+	}
+}
@@ -212,8 +212,24 @@ func DefaultOverrides() map[string]*HooksConfig {
 		// Catches the "idle polecat" problem: polecats that finish work but
 		// forget to call gt done before the session ends. The polecat-stop-check
 		// command is idempotent — it checks heartbeat state and branch commits
-		// before deciding whether to run gt done.
+		// before deciding whether to run gt done. gt quota record notes a stop
+		// at a usage limit so the daemon can wake the polecat once it resets.
+		//
+		// On PreCompact, polecats snapshot their bead, plan and next steps into
+		// the hooked bead before priming; gt prime restores the snapshot after
+		// compaction so long queue-dispatched work doesn't lose the thread.
 		"polecats": {
+			PreCompact: []HookEntry{
+				{
+					Matcher: "",
+					Hooks: []Hook{
+						{
+							Type:    "command",
+							Command: hookChain(pathSetup, "gt tap compact-snapshot", "gt prime --hook"),
+						},
+					},
+				},
+			},
 			Stop: []HookEntry{
 				{
 					Matcher: "",
@@ -222,6 +238,10 @@ func DefaultOverrides() map[string]*HooksConfig {
 							Type:    "command",
 							Command: hookChain(pathSetup, "gt tap polecat-stop-check"),
 						},
+						{
+							Type:    "command",
+							Command: hookChain(pathSetup, "gt quota record --hook-stdin"),
+						},
 					},
 				},
 			},
@@ -857,6 +877,26 @@ func DefaultBase() *HooksConfig {
 					Command: hookChain(pathSetup, "gt tap guard dangerous-command"),
 				}},
 			},
+			{
+				Matcher: "Write|Edit|MultiEdit|NotebookEdit",
+				Hooks: []Hook{{
+					Type:    "command",
+					Command: hookChain(pathSetup, "gt tap guard protected-paths"),
+				}},
+			},
+			{
+				Matcher: "Bash",
+				Hooks: []Hook{
+					{
+						Type:    "command",
+						Command: hookChain(pathSetup, "gt tap guard protected-paths"),
+					},
+					{
+						Type:    "command",
+						Command: hookChain(pathSetup, "gt tap guard command-policy"),
+					},
+				},
+			},
 		},
 		SessionStart: []HookEntry{
 			{
@@ -875,7 +915,7 @@ func DefaultBase() *HooksConfig {
 				Hooks: []Hook{
 					{
 						Type:    "command",
-						Command: hookChain(pathSetup, "gt prime --hook"),
+						Command: hookChain(pathSetup, "gt tap compact-snapshot", "gt prime --hook"),
 					},
 				},
 			},
@@ -899,6 +939,10 @@ func DefaultBase() *HooksConfig {
 						Type:    "command",
 						Command: hookChain(pathSetup, "gt costs record &"),
 					},
+					{
+						Type:    "command",
+						Command: hookChain(pathSetup, "gt quota record --hook-stdin"),
+					},
 				},
 			},
 		},
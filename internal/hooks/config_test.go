@@ -272,6 +272,58 @@ func TestDefaultBase(t *testing.T) {
 	}
 }
 
+func TestStopHooksRecordQuota(t *testing.T) {
+	hasQuotaRecord := func(entries []HookEntry) bool {
+		for _, e := range entries {
+			for _, h := range e.Hooks {
+				if strings.Contains(h.Command, "gt quota record --hook-stdin") {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if !hasQuotaRecord(DefaultBase().Stop) {
+		t.Error("DefaultBase Stop should run gt quota record --hook-stdin")
+	}
+	// The polecat override replaces the base Stop entry.
+	if !hasQuotaRecord(Merge(DefaultBase(), DefaultOverrides()["polecats"]).Stop) {
+		t.Error("polecat Stop should run gt quota record --hook-stdin")
+	}
+}
+
+func TestPreCompactHooksSnapshot(t *testing.T) {
+	hasSnapshot := func(entries []HookEntry) bool {
+		for _, e := range entries {
+			for _, h := range e.Hooks {
+				if strings.Contains(h.Command, "gt tap compact-snapshot") {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if !hasSnapshot(DefaultBase().PreCompact) {
+		t.Error("DefaultBase PreCompact should run gt tap compact-snapshot")
+	}
+	if !hasSnapshot(Merge(DefaultBase(), DefaultOverrides()["polecats"]).PreCompact) {
+		t.Error("polecat PreCompact should run gt tap compact-snapshot")
+	}
+	for _, name := range []string{
+		"templates/claude/settings-autonomous.json", "templates/claude/settings-interactive.json",
+		"templates/cursor/hooks-autonomous.json", "templates/cursor/hooks-interactive.json",
+		"templates/gemini/settings-autonomous.json", "templates/gemini/settings-interactive.json",
+	} {
+		data, err := templateFS.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), "{{GT_BIN}} tap compact-snapshot && ") {
+			t.Errorf("%s should snapshot before compaction", name)
+		}
+	}
+}
+
 func TestMerge(t *testing.T) {
 	base := &HooksConfig{
 		SessionStart: []HookEntry{
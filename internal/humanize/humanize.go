@@ -0,0 +1,128 @@
+// Package humanize formats durations, relative times and counts for CLI
+// output.
+//
+// Duration rounds to the nearest value of the smallest unit it shows, and
+// carries into the next unit when rounding fills one: Duration(59m59.6s) is
+// "1h", not "59m 60s". Short truncates instead, so an age never overstates:
+// Short(12h) is "12h", not "1d". Durations use d (days), h, m and s.
+package humanize
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Day is 24 hours; gt output doesn't deal in calendar days.
+const Day = 24 * time.Hour
+
+var units = []struct {
+	d    time.Duration
+	name string
+}{
+	{Day, "d"},
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+}
+
+// Duration formats d with at most two units, e.g. "45s", "5m 30s",
+// "2h 5m", "3d 4h". A zero second unit is dropped ("2h"). Durations under
+// half a second are "0s".
+func Duration(d time.Duration) string {
+	if d < 0 {
+		return "-" + Duration(-d)
+	}
+	i := len(units) - 1
+	for j, u := range units {
+		if d >= u.d {
+			i = j
+			break
+		}
+	}
+	r := d.Round(smallUnit(i))
+	if i > 0 && r >= units[i-1].d {
+		// Rounding filled the next unit up: 59.6s is 1m.
+		i--
+		r = d.Round(smallUnit(i))
+	}
+	u, small := units[i].d, smallUnit(i)
+	if r < u {
+		return "0s"
+	}
+	big, rest := r/u, (r%u)/small
+	if rest == 0 || u == small {
+		return fmt.Sprintf("%d%s", big, units[i].name)
+	}
+	return fmt.Sprintf("%d%s %d%s", big, units[i].name, rest, units[i+1].name)
+}
+
+// smallUnit is the second unit Duration shows alongside units[i].
+func smallUnit(i int) time.Duration {
+	if i+1 < len(units) {
+		return units[i+1].d
+	}
+	return units[i].d
+}
+
+// Short formats d with a single unit for tight columns: "<1m", "5m", "2h",
+// "3d". The unit is truncated, not rounded: 90m is "1h".
+func Short(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	for _, u := range units[:len(units)-1] {
+		if d >= u.d {
+			return fmt.Sprintf("%d%s", d/u.d, u.name)
+		}
+	}
+	return "<1m"
+}
+
+// Relative describes t as seen from now: "just now" within a minute,
+// otherwise "5m ago" or "in 2h".
+func Relative(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d > -time.Minute && d < time.Minute:
+		return "just now"
+	case d < 0:
+		return "in " + Short(-d)
+	default:
+		return Short(d) + " ago"
+	}
+}
+
+// Ago is Relative(t, time.Now()).
+func Ago(t time.Time) string {
+	return Relative(t, time.Now())
+}
+
+// Count formats n with noun, adding "s" unless n is 1: "1 bead",
+// "3 beads", "1,200 beads".
+func Count(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return Int(n) + " " + noun + "s"
+}
+
+// Int formats n with thousands separators: "1,234,567".
+func Int(n int) string {
+	s := strconv.Itoa(n)
+	neg := n < 0
+	if neg {
+		s = s[1:]
+	}
+	out := make([]byte, 0, len(s)+len(s)/3+1)
+	if neg {
+		out = append(out, '-')
+	}
+	for i := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			out = append(out, ',')
+		}
+		out = append(out, s[i])
+	}
+	return string(out)
+}
@@ -0,0 +1,77 @@
+package humanize
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                                     "0s",
+		400 * time.Millisecond:                "0s",
+		45 * time.Second:                      "45s",
+		59*time.Second + 600*time.Millisecond: "1m",
+		90 * time.Second:                      "1m 30s",
+		45 * time.Minute:                      "45m",
+		59*time.Minute + 50*time.Second:       "59m 50s",
+		90 * time.Minute:                      "1h 30m",
+		2*time.Hour + 29*time.Second:          "2h",
+		2*time.Hour + 31*time.Second:          "2h 1m",
+		23*time.Hour + 59*time.Minute + 40*time.Second: "1d",
+		26*time.Hour + 20*time.Minute:                  "1d 2h",
+		-5 * time.Minute:                               "-5m",
+	}
+	for d, want := range tests {
+		if got := Duration(d); got != want {
+			t.Errorf("Duration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestShort(t *testing.T) {
+	tests := map[time.Duration]string{
+		30 * time.Second:                "<1m",
+		90 * time.Second:                "1m",
+		30 * time.Minute:                "30m",
+		59*time.Minute + 50*time.Second: "59m",
+		90 * time.Minute:                "1h",
+		12 * time.Hour:                  "12h",
+		23*time.Hour + 40*time.Minute:   "23h",
+		80 * time.Hour:                  "3d",
+	}
+	for d, want := range tests {
+		if got := Short(d); got != want {
+			t.Errorf("Short(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestRelative(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := map[time.Duration]string{
+		-20 * time.Second: "just now",
+		20 * time.Second:  "just now",
+		-5 * time.Minute:  "5m ago",
+		-26 * time.Hour:   "1d ago",
+		2 * time.Hour:     "in 2h",
+	}
+	for offset, want := range tests {
+		if got := Relative(now.Add(offset), now); got != want {
+			t.Errorf("Relative(now%+v) = %q, want %q", offset, got, want)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	tests := map[int]string{0: "0 beads", 1: "1 bead", 2: "2 beads", 1200: "1,200 beads"}
+	for n, want := range tests {
+		if got := Count(n, "bead"); got != want {
+			t.Errorf("Count(%d) = %q, want %q", n, got, want)
+		}
+	}
+	for n, want := range map[int]string{0: "0", 999: "999", 1000: "1,000", 1234567: "1,234,567", -4500: "-4,500"} {
+		if got := Int(n); got != want {
+			t.Errorf("Int(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
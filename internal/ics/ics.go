@@ -0,0 +1,119 @@
+// Package ics writes iCalendar (RFC 5545) feeds.
+//
+// It covers what gt publishes: a calendar of VEVENTs with a summary,
+// description, start and optional end, optionally repeating daily. Times are
+// written in UTC unless an event is floating (wall-clock time in whatever
+// zone the reader is in), which suits local schedules like quiet hours.
+package ics
+
+import (
+	"strings"
+	"time"
+)
+
+// Event is a single VEVENT.
+type Event struct {
+	// UID identifies the event across feed refreshes, so calendars update
+	// it in place instead of adding a copy.
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	// End is optional; a zero End makes the event a point in time.
+	End time.Time
+	// Floating writes Start and End as local wall-clock times without a
+	// zone.
+	Floating bool
+	// Daily repeats the event every day from Start.
+	Daily bool
+}
+
+// Calendar is a VCALENDAR holding events.
+type Calendar struct {
+	Name   string
+	Events []Event
+	// Stamp is when the feed was generated (DTSTAMP on every event).
+	Stamp time.Time
+}
+
+// String renders the calendar with CRLF line endings and long lines folded,
+// as RFC 5545 requires.
+func (c *Calendar) String() string {
+	var sb strings.Builder
+	line := func(s string) {
+		sb.WriteString(fold(s))
+		sb.WriteString("\r\n")
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//gastown//gt//EN")
+	line("CALSCALE:GREGORIAN")
+	if c.Name != "" {
+		line("X-WR-CALNAME:" + escape(c.Name))
+	}
+	stamp := formatTime(c.Stamp, false)
+	for _, e := range c.Events {
+		line("BEGIN:VEVENT")
+		line("UID:" + escape(e.UID))
+		line("DTSTAMP:" + stamp)
+		line("DTSTART:" + formatTime(e.Start, e.Floating))
+		if !e.End.IsZero() {
+			line("DTEND:" + formatTime(e.End, e.Floating))
+		}
+		if e.Daily {
+			line("RRULE:FREQ=DAILY")
+		}
+		line("SUMMARY:" + escape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION:" + escape(e.Description))
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return sb.String()
+}
+
+// formatTime renders t as an iCalendar DATE-TIME: UTC with a Z suffix, or
+// floating local time.
+func formatTime(t time.Time, floating bool) string {
+	if floating {
+		return t.Format("20060102T150405")
+	}
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// foldWidth is the longest content line in octets before folding.
+const foldWidth = 75
+
+// fold splits a content line longer than foldWidth octets into continuation
+// lines starting with a space, never splitting a UTF-8 sequence.
+func fold(s string) string {
+	if len(s) <= foldWidth {
+		return s
+	}
+	var sb strings.Builder
+	width := foldWidth
+	n := 0
+	for _, r := range s {
+		size := len(string(r))
+		if n+size > width {
+			sb.WriteString("\r\n ")
+			n = 0
+			width = foldWidth - 1 // The leading space counts
+		}
+		sb.WriteRune(r)
+		n += size
+	}
+	return sb.String()
+}
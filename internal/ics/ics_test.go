@@ -0,0 +1,83 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestCalendarString(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skip("tzdata unavailable")
+	}
+	c := Calendar{
+		Name:  "Gas Town limits",
+		Stamp: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Events: []Event{
+			{
+				UID:         "reset-work-5h@gastown",
+				Summary:     "Limit resets: work (5h)",
+				Description: "Limited since 2pm; polecats resume, then dispatch continues",
+				Start:       time.Date(2026, 3, 1, 19, 0, 0, 0, la),
+			},
+			{
+				UID:      "quiet@gastown",
+				Summary:  "Quiet hours",
+				Start:    time.Date(2026, 3, 1, 2, 0, 0, 0, time.Local),
+				End:      time.Date(2026, 3, 1, 7, 0, 0, 0, time.Local),
+				Floating: true,
+				Daily:    true,
+			},
+		},
+	}
+	got := c.String()
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+		"X-WR-CALNAME:Gas Town limits\r\n",
+		"DTSTAMP:20260301T120000Z\r\n",
+		"DTSTART:20260302T030000Z\r\n", // 7pm PST in UTC
+		"DESCRIPTION:Limited since 2pm\\; polecats resume\\, then dispatch continues\r\n",
+		"DTSTART:20260301T020000\r\nDTEND:20260301T070000\r\nRRULE:FREQ=DAILY\r\n",
+		"END:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("calendar missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Count(got, "BEGIN:VEVENT") != 2 {
+		t.Errorf("want 2 events:\n%s", got)
+	}
+	if first, _, _ := strings.Cut(got, "END:VEVENT"); strings.Contains(first, "DTEND") {
+		t.Errorf("point event written with DTEND:\n%s", got)
+	}
+}
+
+func TestFold(t *testing.T) {
+	short := strings.Repeat("a", foldWidth)
+	if fold(short) != short {
+		t.Errorf("line of %d octets was folded", foldWidth)
+	}
+
+	long := "SUMMARY:" + strings.Repeat("é", 100)
+	folded := fold(long)
+	lines := strings.Split(folded, "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("long line not folded: %q", folded)
+	}
+	for i, l := range lines {
+		if len(l) > foldWidth {
+			t.Errorf("line %d is %d octets", i, len(l))
+		}
+		if i > 0 && !strings.HasPrefix(l, " ") {
+			t.Errorf("continuation line %d lacks leading space", i)
+		}
+		if !utf8.ValidString(l) {
+			t.Errorf("line %d splits a UTF-8 sequence", i)
+		}
+	}
+	if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != long {
+		t.Errorf("unfolding did not round-trip")
+	}
+}
@@ -0,0 +1,149 @@
+package ingest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrInvalidEnqueue is wrapped by EnqueueFunc errors the caller should fix
+// (unknown rig or bead, bad formula), so the handler answers 422 not 500.
+var ErrInvalidEnqueue = errors.New("invalid enqueue request")
+
+// ErrMalformedEnqueue is wrapped by EnqueueFunc errors for fields that are
+// malformed rather than unknown (a bead ID that isn't one), so the handler
+// answers 400.
+var ErrMalformedEnqueue = errors.New("malformed enqueue request")
+
+// EnqueueRequest asks for work to be scheduled: an existing bead, or a new
+// one filed from Title and Description.
+type EnqueueRequest struct {
+	Bead        string            `json:"bead,omitempty"`
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Rig         string            `json:"rig,omitempty"`
+	Formula     string            `json:"formula,omitempty"`
+	Vars        map[string]string `json:"vars,omitempty"`
+	Labels      []string          `json:"labels,omitempty"`
+	Priority    *int              `json:"priority,omitempty"`
+
+	// MaxRetries and RetryBackoff override the scheduler's circuit breaker
+	// for this bead, as gt sling --max-retries and --retry-backoff do.
+	MaxRetries   *int   `json:"max_retries,omitempty"`
+	RetryBackoff string `json:"retry_backoff,omitempty"`
+}
+
+// VarList returns Vars as sorted key=value pairs, the form --var takes.
+func (r *EnqueueRequest) VarList() []string {
+	vars := make([]string, 0, len(r.Vars))
+	for k, v := range r.Vars {
+		vars = append(vars, k+"="+v)
+	}
+	sort.Strings(vars)
+	return vars
+}
+
+// EnqueueResult is the response to an accepted EnqueueRequest.
+type EnqueueResult struct {
+	Bead    string `json:"bead"`
+	Rig     string `json:"rig"`
+	Created bool   `json:"created"` // A new bead was filed from the title
+}
+
+// EnqueueFunc schedules a validated request.
+type EnqueueFunc func(*EnqueueRequest) (*EnqueueResult, error)
+
+// EnqueueHandler is the endpoint external systems (CI failure handlers,
+// alerting pipelines) use to push work onto the scheduler. It takes a JSON
+// EnqueueRequest and authenticates the same way as Handler.
+type EnqueueHandler struct {
+	// Token is the shared secret; see Handler.Token.
+	Token string
+
+	// Enqueue is called for each valid request.
+	Enqueue EnqueueFunc
+}
+
+// ServeHTTP implements http.Handler.
+func (h *EnqueueHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "POST required"})
+		return
+	}
+	if !tokenAuthorized(r, h.Token) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid token"})
+		return
+	}
+
+	req, err := ParseEnqueueRequest(r)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrInvalidEnqueue) {
+			status = http.StatusUnprocessableEntity
+		}
+		writeJSON(w, status, map[string]string{"error": err.Error()})
+		return
+	}
+
+	result, err := h.Enqueue(req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, ErrMalformedEnqueue):
+			status = http.StatusBadRequest
+		case errors.Is(err, ErrInvalidEnqueue):
+			status = http.StatusUnprocessableEntity
+		}
+		writeJSON(w, status, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusAccepted, result)
+}
+
+// ParseEnqueueRequest decodes and validates a JSON EnqueueRequest.
+func ParseEnqueueRequest(r *http.Request) (*EnqueueRequest, error) {
+	r.Body = http.MaxBytesReader(nil, r.Body, maxBodyBytes)
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	var req EnqueueRequest
+	if err := dec.Decode(&req); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+
+	req.Bead = strings.TrimSpace(req.Bead)
+	req.Title = strings.Join(strings.Fields(req.Title), " ")
+	switch {
+	case req.Bead == "" && req.Title == "":
+		return nil, fmt.Errorf("%w: bead or title required", ErrInvalidEnqueue)
+	case req.Bead != "" && (req.Title != "" || req.Description != ""):
+		return nil, fmt.Errorf("%w: give either bead or title/description, not both", ErrInvalidEnqueue)
+	}
+	if t := []rune(req.Title); len(t) > maxTitleLen {
+		req.Title = string(t[:maxTitleLen])
+	}
+	for k, v := range req.Vars {
+		if k == "" || strings.ContainsAny(k, "=\n") {
+			return nil, fmt.Errorf("%w: invalid var name %q", ErrInvalidEnqueue, k)
+		}
+		if strings.Contains(v, "\n") {
+			return nil, fmt.Errorf("%w: var %s must be a single line", ErrInvalidEnqueue, k)
+		}
+	}
+	if req.Priority != nil && (*req.Priority < 0 || *req.Priority > 4) {
+		return nil, fmt.Errorf("%w: priority must be 0-4", ErrInvalidEnqueue)
+	}
+	if req.MaxRetries != nil && *req.MaxRetries < 0 {
+		return nil, fmt.Errorf("%w: max_retries must be 0 or more", ErrInvalidEnqueue)
+	}
+	if req.RetryBackoff != "" {
+		if d, err := time.ParseDuration(req.RetryBackoff); err != nil || d <= 0 {
+			return nil, fmt.Errorf("%w: retry_backoff must be a positive duration such as 10m", ErrInvalidEnqueue)
+		}
+	}
+	return &req, nil
+}
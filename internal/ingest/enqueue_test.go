@@ -0,0 +1,76 @@
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEnqueueHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		auth       string
+		body       string
+		wantStatus int
+	}{
+		{"missing token", "", `{"bead":"gt-1","rig":"gastown"}`, http.StatusUnauthorized},
+		{"existing bead", "Bearer s3cret", `{"bead":"gt-1","rig":"gastown"}`, http.StatusAccepted},
+		{"new bead", "Bearer s3cret", `{"title":"CI failed","description":"log","vars":{"base_branch":"main"}}`, http.StatusAccepted},
+		{"neither", "Bearer s3cret", `{"rig":"gastown"}`, http.StatusUnprocessableEntity},
+		{"both", "Bearer s3cret", `{"bead":"gt-1","title":"x"}`, http.StatusUnprocessableEntity},
+		{"bad var", "Bearer s3cret", `{"bead":"gt-1","vars":{"a=b":"c"}}`, http.StatusUnprocessableEntity},
+		{"bad priority", "Bearer s3cret", `{"bead":"gt-1","priority":9}`, http.StatusUnprocessableEntity},
+		{"retry policy", "Bearer s3cret", `{"bead":"gt-1","max_retries":0,"retry_backoff":"10m"}`, http.StatusAccepted},
+		{"bad max_retries", "Bearer s3cret", `{"bead":"gt-1","max_retries":-2}`, http.StatusUnprocessableEntity},
+		{"bad retry_backoff", "Bearer s3cret", `{"bead":"gt-1","retry_backoff":"soon"}`, http.StatusUnprocessableEntity},
+		{"unknown field", "Bearer s3cret", `{"bead":"gt-1","rgi":"gastown"}`, http.StatusBadRequest},
+		{"rejected by enqueue", "Bearer s3cret", `{"bead":"gt-1","rig":"nope"}`, http.StatusUnprocessableEntity},
+		{"malformed bead", "Bearer s3cret", `{"bead":"--help"}`, http.StatusBadRequest},
+		{"enqueue fails", "Bearer s3cret", `{"bead":"gt-boom"}`, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *EnqueueRequest
+			h := &EnqueueHandler{
+				Token: "s3cret",
+				Enqueue: func(req *EnqueueRequest) (*EnqueueResult, error) {
+					switch {
+					case strings.HasPrefix(req.Bead, "-"):
+						return nil, fmt.Errorf("%w: not a bead ID", ErrMalformedEnqueue)
+					case req.Rig == "nope":
+						return nil, fmt.Errorf("%w: unknown rig", ErrInvalidEnqueue)
+					case req.Bead == "gt-boom":
+						return nil, fmt.Errorf("bd unavailable")
+					}
+					got = req
+					return &EnqueueResult{Bead: "gt-new", Rig: "gastown", Created: req.Bead == ""}, nil
+				},
+			}
+			r := httptest.NewRequest(http.MethodPost, "/enqueue", strings.NewReader(tt.body))
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusAccepted {
+				return
+			}
+			var res EnqueueResult
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || res.Bead != "gt-new" {
+				t.Errorf("response = %s (%v)", w.Body.String(), err)
+			}
+			if tt.name == "new bead" {
+				if vars := got.VarList(); len(vars) != 1 || vars[0] != "base_branch=main" {
+					t.Errorf("VarList() = %v", vars)
+				}
+			}
+		})
+	}
+}
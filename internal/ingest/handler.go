@@ -0,0 +1,81 @@
+package ingest
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// CreateFunc turns an accepted message into a bead and returns its ID.
+type CreateFunc func(*Message) (string, error)
+
+// Handler is the webhook endpoint for inbound messages.
+type Handler struct {
+	// Token is the shared secret callers must present, either as
+	// "Authorization: Bearer <token>" or a "token" query parameter (for
+	// providers that cannot set headers). Required: an empty token rejects
+	// every request.
+	Token string
+
+	// AllowedSenders restricts which From addresses are accepted.
+	// See SenderAllowed.
+	AllowedSenders []string
+
+	// Create is called for each accepted message.
+	Create CreateFunc
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "POST required"})
+		return
+	}
+	if !tokenAuthorized(r, h.Token) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid token"})
+		return
+	}
+
+	msg, err := ParseRequest(r)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrNoSubject) {
+			status = http.StatusUnprocessableEntity
+		}
+		writeJSON(w, status, map[string]string{"error": err.Error()})
+		return
+	}
+	if !SenderAllowed(msg.From, h.AllowedSenders) {
+		writeJSON(w, http.StatusForbidden, map[string]string{"error": "sender not allowed"})
+		return
+	}
+
+	id, err := h.Create(msg)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]string{"id": id})
+}
+
+// tokenAuthorized reports whether r presents token as a bearer token or
+// "token" query parameter. An empty token authorizes nothing.
+func tokenAuthorized(r *http.Request, token string) bool {
+	if token == "" {
+		return false
+	}
+	got := r.URL.Query().Get("token")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		got = strings.TrimPrefix(auth, "Bearer ")
+	}
+	return subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,80 @@
+package ingest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestHandler(created *[]*Message) *Handler {
+	return &Handler{
+		Token:          "s3cret",
+		AllowedSenders: []string{"example.com"},
+		Create: func(m *Message) (string, error) {
+			if m.Subject == "boom" {
+				return "", errors.New("bd unavailable")
+			}
+			*created = append(*created, m)
+			return "hq-123", nil
+		},
+	}
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		auth       string
+		query      string
+		body       string
+		wantStatus int
+	}{
+		{"get rejected", http.MethodGet, "Bearer s3cret", "", "", http.StatusMethodNotAllowed},
+		{"missing token", http.MethodPost, "", "", `{"from":"a@example.com","subject":"x"}`, http.StatusUnauthorized},
+		{"wrong token", http.MethodPost, "Bearer nope", "", `{"from":"a@example.com","subject":"x"}`, http.StatusUnauthorized},
+		{"query token", http.MethodPost, "", "?token=s3cret", `{"from":"a@example.com","subject":"x"}`, http.StatusCreated},
+		{"bearer token", http.MethodPost, "Bearer s3cret", "", `{"from":"a@example.com","subject":"x"}`, http.StatusCreated},
+		{"sender not allowed", http.MethodPost, "Bearer s3cret", "", `{"from":"a@evil.com","subject":"x"}`, http.StatusForbidden},
+		{"no subject", http.MethodPost, "Bearer s3cret", "", `{"from":"a@example.com"}`, http.StatusUnprocessableEntity},
+		{"bad json", http.MethodPost, "Bearer s3cret", "", `{`, http.StatusBadRequest},
+		{"create fails", http.MethodPost, "Bearer s3cret", "", `{"from":"a@example.com","subject":"boom"}`, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created []*Message
+			h := newTestHandler(&created)
+
+			r := httptest.NewRequest(tt.method, "/"+tt.query, strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body: %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusCreated {
+				if len(created) != 1 || !strings.Contains(w.Body.String(), "hq-123") {
+					t.Errorf("expected one bead created, got %d (body: %s)", len(created), w.Body.String())
+				}
+			} else if len(created) != 0 {
+				t.Errorf("no bead should be created, got %d", len(created))
+			}
+		})
+	}
+}
+
+func TestHandler_EmptyTokenRejectsAll(t *testing.T) {
+	h := &Handler{Create: func(*Message) (string, error) { return "x", nil }}
+	r := httptest.NewRequest(http.MethodPost, "/?token=", strings.NewReader(`{"subject":"x"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", w.Code)
+	}
+}
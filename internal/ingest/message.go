@@ -0,0 +1,147 @@
+// Package ingest converts inbound emails and web forms into beads.
+//
+// Email providers (Mailgun, SendGrid, Postmark, CloudMailin, ...) and form
+// services can all forward messages as an HTTP POST. This package normalises
+// those payloads into a Message and serves an authenticated webhook endpoint;
+// the caller decides how a Message becomes a bead.
+package ingest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/mail"
+	"strings"
+)
+
+// maxBodyBytes caps the request body so a large attachment cannot exhaust memory.
+const maxBodyBytes = 1 << 20
+
+// maxTitleLen keeps bead titles to a readable length.
+const maxTitleLen = 200
+
+// ErrNoSubject is returned when a message has nothing usable as a title.
+var ErrNoSubject = errors.New("message has no subject")
+
+// Message is a normalised inbound email or form submission.
+type Message struct {
+	From    string `json:"from,omitempty"`
+	Subject string `json:"subject"`
+	Body    string `json:"body,omitempty"`
+}
+
+// Field aliases used by common providers, in order of preference.
+var (
+	fromFields    = []string{"from", "sender", "email", "From"}
+	subjectFields = []string{"subject", "title", "Subject"}
+	bodyFields    = []string{"stripped-text", "body-plain", "text", "TextBody", "body", "description", "message"}
+)
+
+// ParseRequest extracts a Message from a JSON, urlencoded or multipart POST.
+func ParseRequest(r *http.Request) (*Message, error) {
+	r.Body = http.MaxBytesReader(nil, r.Body, maxBodyBytes)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var get func(string) string
+	switch mediaType {
+	case "application/json":
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading body: %w", err)
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		get = func(k string) string {
+			s, _ := fields[k].(string)
+			return s
+		}
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(maxBodyBytes); err != nil {
+			return nil, fmt.Errorf("parsing form: %w", err)
+		}
+		get = r.PostForm.Get
+	default:
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("parsing form: %w", err)
+		}
+		get = r.PostForm.Get
+	}
+
+	msg := &Message{
+		From:    firstField(get, fromFields),
+		Subject: firstField(get, subjectFields),
+		Body:    firstField(get, bodyFields),
+	}
+	return msg.normalise()
+}
+
+func firstField(get func(string) string, names []string) string {
+	for _, name := range names {
+		if v := strings.TrimSpace(get(name)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// normalise trims the message, strips "Name <addr>" down to the address and
+// falls back to the first body line when the subject is empty.
+func (m *Message) normalise() (*Message, error) {
+	if addr, err := mail.ParseAddress(m.From); err == nil {
+		m.From = addr.Address
+	}
+	m.Subject = strings.Join(strings.Fields(m.Subject), " ")
+	m.Body = strings.TrimSpace(m.Body)
+	if m.Subject == "" && m.Body != "" {
+		m.Subject = strings.TrimSpace(strings.SplitN(m.Body, "\n", 2)[0])
+	}
+	if m.Subject == "" {
+		return nil, ErrNoSubject
+	}
+	if r := []rune(m.Subject); len(r) > maxTitleLen {
+		m.Subject = string(r[:maxTitleLen])
+	}
+	return m, nil
+}
+
+// Description renders the bead description for a message, recording the sender.
+func (m *Message) Description() string {
+	var b strings.Builder
+	if m.From != "" {
+		fmt.Fprintf(&b, "Submitted by: %s\n\n", m.From)
+	}
+	b.WriteString(m.Body)
+	return strings.TrimSpace(b.String())
+}
+
+// SenderAllowed reports whether from matches one of allowed. Entries are
+// full addresses ("pm@example.com") or domains ("example.com" / "@example.com").
+// An empty allow-list admits every sender.
+func SenderAllowed(from string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	from = strings.ToLower(from)
+	at := strings.LastIndex(from, "@")
+	for _, a := range allowed {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == "" {
+			continue
+		}
+		if strings.Contains(strings.TrimPrefix(a, "@"), "@") {
+			if from == a {
+				return true
+			}
+			continue
+		}
+		if at >= 0 && from[at+1:] == strings.TrimPrefix(a, "@") {
+			return true
+		}
+	}
+	return false
+}
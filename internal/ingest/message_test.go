@@ -0,0 +1,124 @@
+package ingest
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestParseRequest_JSON(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(
+		`{"from":"Pat PM <pat@example.com>","subject":"  Broken   link ","text":"The pricing page 404s\n"}`))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	msg, err := ParseRequest(r)
+	if err != nil {
+		t.Fatalf("ParseRequest: %v", err)
+	}
+	if msg.From != "pat@example.com" {
+		t.Errorf("From = %q", msg.From)
+	}
+	if msg.Subject != "Broken link" {
+		t.Errorf("Subject = %q", msg.Subject)
+	}
+	if msg.Body != "The pricing page 404s" {
+		t.Errorf("Body = %q", msg.Body)
+	}
+}
+
+func TestParseRequest_Urlencoded(t *testing.T) {
+	form := url.Values{
+		"sender":        {"ops@example.com"},
+		"subject":       {"Disk full"},
+		"body-plain":    {"full body with quoted reply"},
+		"stripped-text": {"just the new text"},
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	msg, err := ParseRequest(r)
+	if err != nil {
+		t.Fatalf("ParseRequest: %v", err)
+	}
+	if msg.From != "ops@example.com" || msg.Subject != "Disk full" {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+	if msg.Body != "just the new text" {
+		t.Errorf("Body = %q, want stripped-text preferred", msg.Body)
+	}
+}
+
+func TestParseRequest_Multipart(t *testing.T) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	_ = mw.WriteField("from", "a@b.io")
+	_ = mw.WriteField("title", "Form submission")
+	_ = mw.WriteField("description", "details")
+	_ = mw.Close()
+
+	r := httptest.NewRequest(http.MethodPost, "/", &buf)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+
+	msg, err := ParseRequest(r)
+	if err != nil {
+		t.Fatalf("ParseRequest: %v", err)
+	}
+	if msg.Subject != "Form submission" || msg.Body != "details" {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+}
+
+func TestParseRequest_SubjectFallback(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"body":"First line\nmore"}`))
+	r.Header.Set("Content-Type", "application/json")
+	msg, err := ParseRequest(r)
+	if err != nil {
+		t.Fatalf("ParseRequest: %v", err)
+	}
+	if msg.Subject != "First line" {
+		t.Errorf("Subject = %q, want first body line", msg.Subject)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"from":"x@y.z"}`))
+	r.Header.Set("Content-Type", "application/json")
+	if _, err := ParseRequest(r); err != ErrNoSubject {
+		t.Errorf("err = %v, want ErrNoSubject", err)
+	}
+}
+
+func TestMessageDescription(t *testing.T) {
+	m := &Message{From: "pat@example.com", Body: "hello"}
+	if got, want := m.Description(), "Submitted by: pat@example.com\n\nhello"; got != want {
+		t.Errorf("Description() = %q, want %q", got, want)
+	}
+	if got := (&Message{Body: "hello"}).Description(); got != "hello" {
+		t.Errorf("Description() without sender = %q", got)
+	}
+}
+
+func TestSenderAllowed(t *testing.T) {
+	allowed := []string{"example.com", "@partner.io", "PM@Other.org"}
+	tests := []struct {
+		from string
+		want bool
+	}{
+		{"pat@example.com", true},
+		{"pat@sub.example.com", false},
+		{"x@partner.io", true},
+		{"pm@other.org", true},
+		{"someone@other.org", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := SenderAllowed(tt.from, allowed); got != tt.want {
+			t.Errorf("SenderAllowed(%q) = %v, want %v", tt.from, got, tt.want)
+		}
+	}
+	if !SenderAllowed("anyone@anywhere", nil) {
+		t.Error("empty allow-list should admit everyone")
+	}
+}
@@ -0,0 +1,226 @@
+// Package jira provides a minimal Jira Cloud/Server REST client for mirroring
+// Jira issues into beads and pushing Gas Town status back as transitions.
+//
+// Authentication uses basic auth with JIRA_EMAIL and JIRA_API_TOKEN
+// (Jira Cloud API tokens), or a bearer personal access token when no email
+// is configured (Jira Server/Data Center).
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// searchPageSize is the number of issues requested per search page.
+const searchPageSize = 100
+
+// searchFields are the issue fields the sync needs.
+var searchFields = []string{"summary", "description", "status", "issuetype", "parent", "priority", "labels"}
+
+// Client wraps HTTP interactions with the Jira REST API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	email      string
+	token      string
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the underlying HTTP client (useful for testing).
+func WithHTTPClient(c *http.Client) Option {
+	return func(cl *Client) { cl.httpClient = c }
+}
+
+// WithEmail overrides the account email (default: JIRA_EMAIL env var).
+func WithEmail(e string) Option {
+	return func(cl *Client) { cl.email = e }
+}
+
+// WithToken overrides the API token (default: JIRA_API_TOKEN env var).
+func WithToken(t string) Option {
+	return func(cl *Client) { cl.token = t }
+}
+
+// NewClient creates a Jira client for the site at baseURL
+// (e.g. "https://example.atlassian.net").
+func NewClient(baseURL string, opts ...Option) (*Client, error) {
+	c := &Client{
+		httpClient: http.DefaultClient,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		email:      os.Getenv("JIRA_EMAIL"),
+		token:      os.Getenv("JIRA_API_TOKEN"),
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	if c.baseURL == "" {
+		return nil, fmt.Errorf("jira: base URL is required")
+	}
+	if c.token == "" {
+		return nil, fmt.Errorf("jira: JIRA_API_TOKEN is required (set env var or use WithToken)")
+	}
+	return c, nil
+}
+
+// Search returns every issue matching jql, following pagination.
+//
+// It uses Jira Cloud's /rest/api/3/search/jql, which pages by
+// nextPageToken; Cloud has removed the older offset-paged search. Jira
+// Server and Data Center only have the older endpoint, so a 404 on the
+// first page falls back to /rest/api/2/search.
+func (c *Client) Search(ctx context.Context, jql string) ([]Issue, error) {
+	var all []Issue
+	for token := ""; ; {
+		req := map[string]any{
+			"jql":        jql,
+			"maxResults": searchPageSize,
+			"fields":     searchFields,
+		}
+		if token != "" {
+			req["nextPageToken"] = token
+		}
+		var resp struct {
+			Issues        []Issue `json:"issues"`
+			NextPageToken string  `json:"nextPageToken"`
+			IsLast        bool    `json:"isLast"`
+		}
+		if err := c.request(ctx, http.MethodPost, "/rest/api/3/search/jql", req, &resp); err != nil {
+			var apiErr *APIError
+			if token == "" && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+				return c.searchByOffset(ctx, jql)
+			}
+			return nil, err
+		}
+		all = append(all, resp.Issues...)
+		if resp.IsLast || resp.NextPageToken == "" || len(resp.Issues) == 0 {
+			return all, nil
+		}
+		token = resp.NextPageToken
+	}
+}
+
+// searchByOffset is Search over the offset-paged /rest/api/2/search, for
+// Jira Server and Data Center.
+func (c *Client) searchByOffset(ctx context.Context, jql string) ([]Issue, error) {
+	var all []Issue
+	for startAt := 0; ; {
+		req := map[string]any{
+			"jql":        jql,
+			"startAt":    startAt,
+			"maxResults": searchPageSize,
+			"fields":     searchFields,
+		}
+		var resp struct {
+			Total  int     `json:"total"`
+			Issues []Issue `json:"issues"`
+		}
+		if err := c.request(ctx, http.MethodPost, "/rest/api/2/search", req, &resp); err != nil {
+			return nil, err
+		}
+		all = append(all, resp.Issues...)
+		startAt += len(resp.Issues)
+		if len(resp.Issues) == 0 || startAt >= resp.Total {
+			return all, nil
+		}
+	}
+}
+
+// Transitions lists the workflow transitions currently available for an issue.
+func (c *Client) Transitions(ctx context.Context, key string) ([]Transition, error) {
+	var resp struct {
+		Transitions []Transition `json:"transitions"`
+	}
+	path := "/rest/api/2/issue/" + url.PathEscape(key) + "/transitions"
+	if err := c.request(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Transitions, nil
+}
+
+// TransitionTo moves an issue through the transition whose name (or target
+// status name) matches name, case-insensitively. Returns false if the issue
+// is already in that status or no such transition is available.
+func (c *Client) TransitionTo(ctx context.Context, key, name string) (bool, error) {
+	transitions, err := c.Transitions(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	t := FindTransition(transitions, name)
+	if t == nil {
+		return false, nil
+	}
+	body := map[string]any{"transition": map[string]string{"id": t.ID}}
+	path := "/rest/api/2/issue/" + url.PathEscape(key) + "/transitions"
+	if err := c.request(ctx, http.MethodPost, path, body, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// request makes an authenticated REST request and decodes the JSON response.
+func (c *Client) request(ctx context.Context, method, path string, body any, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("jira: marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("jira: create request: %w", err)
+	}
+	if c.email != "" {
+		req.SetBasicAuth(c.email, c.token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("jira: %s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("jira: read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &APIError{Method: method, Path: path, StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("jira: decode response: %w", err)
+		}
+	}
+	return nil
+}
+
+// APIError represents a non-2xx response from the Jira API.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("jira: %s %s returned %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
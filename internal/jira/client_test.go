@@ -0,0 +1,165 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewClient_RequiresToken(t *testing.T) {
+	t.Setenv("JIRA_API_TOKEN", "")
+	if _, err := NewClient("https://example.atlassian.net"); err == nil {
+		t.Fatal("expected error without token")
+	}
+	if _, err := NewClient("", WithToken("t")); err == nil {
+		t.Fatal("expected error without base URL")
+	}
+}
+
+func TestSearch_Paginates(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/rest/api/3/search/jql" || r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "me@example.com" || pass != "tok" {
+			t.Errorf("basic auth = %q/%q/%v", user, pass, ok)
+		}
+		var body struct {
+			JQL           string `json:"jql"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.JQL != "project = WEB" {
+			t.Errorf("jql = %q", body.JQL)
+		}
+		if body.NextPageToken == "page2" {
+			_, _ = w.Write([]byte(`{"isLast":true,"issues":[{"key":"WEB-2","fields":{"summary":"s"}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"nextPageToken":"page2","issues":[{"key":"WEB-1","fields":{"summary":"s"}}]}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL, WithToken("tok"), WithEmail("me@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues, err := c.Search(context.Background(), "project = WEB")
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if calls != 2 || len(issues) != 2 || issues[1].Key != "WEB-2" {
+		t.Errorf("calls=%d issues=%+v", calls, issues)
+	}
+}
+
+func TestSearch_FallsBackToOffsetPaging(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/rest/api/3/search/jql" {
+			http.NotFound(w, r)
+			return
+		}
+		var body struct {
+			StartAt int `json:"startAt"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		key := "WEB-1"
+		if body.StartAt == 1 {
+			key = "WEB-2"
+		}
+		_, _ = w.Write([]byte(`{"total":2,"issues":[{"key":"` + key + `","fields":{"summary":"s"}}]}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL, WithToken("pat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues, err := c.Search(context.Background(), "project = WEB")
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	want := "/rest/api/3/search/jql,/rest/api/2/search,/rest/api/2/search"
+	if got := strings.Join(paths, ","); got != want || len(issues) != 2 {
+		t.Errorf("paths = %s, issues = %+v", got, issues)
+	}
+}
+
+func TestIssueFields_ADFDescription(t *testing.T) {
+	data := `{"summary":"s","labels":["a"],"description":{"type":"doc","content":[
+		{"type":"paragraph","content":[{"type":"text","text":"First "},{"type":"text","text":"line"}]},
+		{"type":"paragraph","content":[{"type":"text","text":"Second"},{"type":"hardBreak"},{"type":"text","text":"third"}]}]}}`
+	var f IssueFields
+	if err := json.Unmarshal([]byte(data), &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.Description != "First line\nSecond\nthird" || f.Summary != "s" || len(f.Labels) != 1 {
+		t.Errorf("fields = %+v", f)
+	}
+
+	if err := json.Unmarshal([]byte(`{"description":"plain"}`), &f); err != nil || f.Description != "plain" {
+		t.Errorf("plain description = %q, %v", f.Description, err)
+	}
+}
+
+func TestTransitionTo(t *testing.T) {
+	var posted string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer pat" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"transitions":[{"id":"11","name":"Start work","to":{"name":"In Progress"}},{"id":"31","name":"Finish","to":{"name":"Done"}}]}`))
+			return
+		}
+		var body struct {
+			Transition struct {
+				ID string `json:"id"`
+			} `json:"transition"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		posted = body.Transition.ID
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	t.Setenv("JIRA_EMAIL", "")
+	c, err := NewClient(srv.URL, WithToken("pat"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := c.TransitionTo(context.Background(), "WEB-1", "in progress")
+	if err != nil || !ok || posted != "11" {
+		t.Errorf("TransitionTo = %v, %v (posted %q)", ok, err, posted)
+	}
+	posted = ""
+	ok, err = c.TransitionTo(context.Background(), "WEB-1", "Won't Do")
+	if err != nil || ok || posted != "" {
+		t.Errorf("unknown transition: ok=%v err=%v posted=%q", ok, err, posted)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errorMessages":["bad jql"]}`, http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	c, _ := NewClient(srv.URL, WithToken("t"))
+	_, err := c.Search(context.Background(), "nonsense")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("err = %v, want APIError 400", err)
+	}
+	if !strings.Contains(err.Error(), "bad jql") {
+		t.Errorf("error should include body: %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+package jira
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/atomicfile"
+)
+
+// LabelPrefix marks a bead as the mirror of a Jira issue ("jira:WEB-123").
+const LabelPrefix = "jira:"
+
+// MirrorLabel is applied to every mirrored bead so they can be listed in one query.
+const MirrorLabel = "gt:jira"
+
+// Issue is the subset of a Jira issue used by the sync.
+type Issue struct {
+	Key    string      `json:"key"`
+	Fields IssueFields `json:"fields"`
+}
+
+// IssueFields holds the requested issue fields.
+type IssueFields struct {
+	Summary     string      `json:"summary"`
+	Description string      `json:"description"`
+	Status      Status      `json:"status"`
+	IssueType   NamedField  `json:"issuetype"`
+	Priority    *NamedField `json:"priority"`
+	Parent      *struct {
+		Key string `json:"key"`
+	} `json:"parent"`
+	Labels []string `json:"labels"`
+}
+
+// UnmarshalJSON accepts the description as plain text (REST v2) or as an
+// Atlassian Document Format tree (REST v3), flattened to text.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	type plain IssueFields
+	var raw struct {
+		plain
+		Description json.RawMessage `json:"description"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*f = IssueFields(raw.plain)
+
+	var text string
+	if err := json.Unmarshal(raw.Description, &text); err == nil {
+		f.Description = text
+		return nil
+	}
+	var doc adfNode
+	if err := json.Unmarshal(raw.Description, &doc); err == nil {
+		var b strings.Builder
+		doc.writeText(&b)
+		f.Description = strings.TrimSpace(b.String())
+	}
+	return nil
+}
+
+// adfNode is a node of an Atlassian Document Format tree.
+type adfNode struct {
+	Type    string    `json:"type"`
+	Text    string    `json:"text"`
+	Content []adfNode `json:"content"`
+}
+
+// writeText writes the node's text, ending block nodes with a newline.
+func (n adfNode) writeText(b *strings.Builder) {
+	switch n.Type {
+	case "text":
+		b.WriteString(n.Text)
+		return
+	case "hardBreak":
+		b.WriteString("\n")
+		return
+	}
+	for _, c := range n.Content {
+		c.writeText(b)
+	}
+	switch n.Type {
+	case "paragraph", "heading", "codeBlock", "listItem", "rule":
+		b.WriteString("\n")
+	}
+}
+
+// Status is a Jira workflow status.
+type Status struct {
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key string `json:"key"` // "new", "indeterminate", "done"
+	} `json:"statusCategory"`
+}
+
+// NamedField is any Jira field represented by a name (issue type, priority).
+type NamedField struct {
+	Name string `json:"name"`
+}
+
+// Transition is a workflow transition available on an issue.
+type Transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   Status `json:"to"`
+}
+
+// KeyLabel returns the bead label linking a bead to a Jira issue key.
+func KeyLabel(key string) string {
+	return LabelPrefix + key
+}
+
+// KeyFromLabels returns the Jira key recorded in a bead's labels, or "".
+func KeyFromLabels(labels []string) string {
+	for _, l := range labels {
+		if strings.HasPrefix(l, LabelPrefix) {
+			return strings.TrimPrefix(l, LabelPrefix)
+		}
+	}
+	return ""
+}
+
+// IsEpic reports whether the issue is a Jira epic.
+func (i *Issue) IsEpic() bool {
+	return strings.EqualFold(i.Fields.IssueType.Name, "epic")
+}
+
+// IsDone reports whether the issue is in a status of the "done" category.
+func (i *Issue) IsDone() bool {
+	return i.Fields.Status.StatusCategory.Key == "done"
+}
+
+// ParentKey returns the key of the issue's parent (epic), or "".
+func (i *Issue) ParentKey() string {
+	if i.Fields.Parent == nil {
+		return ""
+	}
+	return i.Fields.Parent.Key
+}
+
+// BeadType maps the Jira issue type to a bead issue type.
+func (i *Issue) BeadType() string {
+	switch strings.ToLower(i.Fields.IssueType.Name) {
+	case "epic":
+		return "epic"
+	case "bug":
+		return "bug"
+	case "story", "feature":
+		return "feature"
+	default:
+		return "task"
+	}
+}
+
+// BeadPriority maps the Jira priority name to a bead priority (0-4).
+// Unknown or missing priorities map to 2 (medium).
+func (i *Issue) BeadPriority() int {
+	if i.Fields.Priority == nil {
+		return 2
+	}
+	switch strings.ToLower(i.Fields.Priority.Name) {
+	case "highest", "blocker":
+		return 0
+	case "high", "critical":
+		return 1
+	case "low", "minor":
+		return 3
+	case "lowest", "trivial":
+		return 4
+	default:
+		return 2
+	}
+}
+
+// BeadTitle renders the bead title for an issue, prefixed with its key.
+func (i *Issue) BeadTitle() string {
+	return fmt.Sprintf("[%s] %s", i.Key, i.Fields.Summary)
+}
+
+// BeadDescription renders the bead description with a link back to Jira.
+func (i *Issue) BeadDescription(baseURL string) string {
+	link := fmt.Sprintf("Jira: %s/browse/%s", strings.TrimRight(baseURL, "/"), i.Key)
+	if d := strings.TrimSpace(i.Fields.Description); d != "" {
+		return link + "\n\n" + d
+	}
+	return link
+}
+
+// FindTransition returns the transition whose name or target status matches
+// name case-insensitively, preferring a transition-name match.
+func FindTransition(transitions []Transition, name string) *Transition {
+	for i := range transitions {
+		if strings.EqualFold(transitions[i].Name, name) {
+			return &transitions[i]
+		}
+	}
+	for i := range transitions {
+		if strings.EqualFold(transitions[i].To.Name, name) {
+			return &transitions[i]
+		}
+	}
+	return nil
+}
+
+// State records the last Gas Town status pushed to each Jira issue, so a
+// transition is only attempted when the bead's status changes.
+type State struct {
+	Pushed map[string]string `json:"pushed"`
+}
+
+// LoadState reads sync state from path. A missing file yields empty state.
+func LoadState(path string) (*State, error) {
+	s := &State{Pushed: map[string]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading jira sync state: %w", err)
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("parsing jira sync state: %w", err)
+	}
+	if s.Pushed == nil {
+		s.Pushed = map[string]string{}
+	}
+	return s, nil
+}
+
+// Save writes the state atomically, creating parent directories.
+func (s *State) Save(path string) error {
+	return atomicfile.EnsureDirAndWriteJSON(path, s)
+}
+
+// PendingTransition returns the Jira transition to apply for a bead whose
+// Gas Town status is status, or "" when no mapping exists or the status was
+// already pushed.
+func (s *State) PendingTransition(key, status string, transitions map[string]string) string {
+	target := transitions[status]
+	if target == "" || s.Pushed[key] == status {
+		return ""
+	}
+	return target
+}
@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestKeyLabelRoundTrip(t *testing.T) {
+	labels := []string{"gt:jira", KeyLabel("WEB-42"), "triage"}
+	if got := KeyFromLabels(labels); got != "WEB-42" {
+		t.Errorf("KeyFromLabels = %q", got)
+	}
+	if got := KeyFromLabels([]string{"triage"}); got != "" {
+		t.Errorf("KeyFromLabels without key = %q", got)
+	}
+}
+
+func TestIssueMapping(t *testing.T) {
+	epic := &Issue{Key: "WEB-1", Fields: IssueFields{Summary: "Checkout", IssueType: NamedField{Name: "Epic"}}}
+	if !epic.IsEpic() || epic.BeadType() != "epic" {
+		t.Errorf("epic mapping: IsEpic=%v BeadType=%q", epic.IsEpic(), epic.BeadType())
+	}
+	if epic.BeadPriority() != 2 {
+		t.Errorf("missing priority should map to 2, got %d", epic.BeadPriority())
+	}
+
+	bug := &Issue{Key: "WEB-2", Fields: IssueFields{
+		Summary:     "Cart total wrong",
+		Description: "Off by one cent\n",
+		IssueType:   NamedField{Name: "Bug"},
+		Priority:    &NamedField{Name: "High"},
+	}}
+	bug.Fields.Parent = &struct {
+		Key string `json:"key"`
+	}{Key: "WEB-1"}
+	bug.Fields.Status.StatusCategory.Key = "done"
+
+	if bug.BeadType() != "bug" || bug.BeadPriority() != 1 || bug.ParentKey() != "WEB-1" || !bug.IsDone() {
+		t.Errorf("bug mapping: type=%q prio=%d parent=%q done=%v",
+			bug.BeadType(), bug.BeadPriority(), bug.ParentKey(), bug.IsDone())
+	}
+	if got := bug.BeadTitle(); got != "[WEB-2] Cart total wrong" {
+		t.Errorf("BeadTitle = %q", got)
+	}
+	want := "Jira: https://x.atlassian.net/browse/WEB-2\n\nOff by one cent"
+	if got := bug.BeadDescription("https://x.atlassian.net/"); got != want {
+		t.Errorf("BeadDescription = %q, want %q", got, want)
+	}
+}
+
+func TestFindTransition(t *testing.T) {
+	ts := []Transition{
+		{ID: "1", Name: "Start", To: Status{Name: "In Progress"}},
+		{ID: "2", Name: "In Progress", To: Status{Name: "Doing"}},
+	}
+	if got := FindTransition(ts, "in progress"); got == nil || got.ID != "2" {
+		t.Errorf("transition name should win over target status, got %+v", got)
+	}
+	if got := FindTransition(ts, "doing"); got == nil || got.ID != "2" {
+		t.Errorf("target status match failed, got %+v", got)
+	}
+	if FindTransition(ts, "Done") != nil {
+		t.Error("expected no match")
+	}
+}
+
+func TestStatePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "state.json")
+	s, err := LoadState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transitions := map[string]string{"in_progress": "Start", "closed": "Done"}
+	if got := s.PendingTransition("WEB-1", "in_progress", transitions); got != "Start" {
+		t.Errorf("PendingTransition = %q", got)
+	}
+	if got := s.PendingTransition("WEB-1", "open", transitions); got != "" {
+		t.Errorf("unmapped status should be skipped, got %q", got)
+	}
+	s.Pushed["WEB-1"] = "in_progress"
+	if err := s.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.PendingTransition("WEB-1", "in_progress", transitions); got != "" {
+		t.Errorf("already-pushed status should be skipped, got %q", got)
+	}
+	if got := loaded.PendingTransition("WEB-1", "closed", transitions); got != "Done" {
+		t.Errorf("PendingTransition after change = %q", got)
+	}
+}
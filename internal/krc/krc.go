@@ -195,8 +195,14 @@ func (p *Pruner) Prune() (*PruneResult, error) {
 		PrunedByType: make(map[string]int),
 	}
 
-	// Prune events file
-	eventsResult, err := p.pruneFile(filepath.Join(p.townRoot, events.EventsFile))
+	// Prune events file. Held under the events lock: the rewrite replaces the
+	// file, and an event appended mid-rewrite would otherwise be lost.
+	var eventsResult *PruneResult
+	err := events.WithLock(p.townRoot, func() error {
+		var err error
+		eventsResult, err = p.pruneFile(filepath.Join(p.townRoot, events.EventsFile))
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("pruning events: %w", err)
 	}
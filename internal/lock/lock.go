@@ -262,11 +262,31 @@ func FindAllLocks(root string) (map[string]*LockInfo, error) {
 // doesn't exist. This prevents killing active workers whose spawning process
 // has exited (which is normal - Claude runs as a child in tmux).
 func CleanStaleLocks(root string) (int, error) {
-	locks, err := FindAllLocks(root)
+	stale, err := FindStaleLocks(root)
 	if err != nil {
 		return 0, err
 	}
 
+	cleaned := 0
+	for workerDir := range stale {
+		lock := New(workerDir)
+		if err := lock.Release(); err == nil {
+			cleaned++
+		}
+	}
+
+	return cleaned, nil
+}
+
+// FindStaleLocks returns the truly stale locks in a directory tree (dead PID
+// and no tmux session) without removing them.
+// Returns a map of worker directory -> LockInfo.
+func FindStaleLocks(root string) (map[string]*LockInfo, error) {
+	locks, err := FindAllLocks(root)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get active tmux sessions to verify locks
 	activeSessions := getActiveTmuxSessions()
 	sessionSet := make(map[string]bool)
@@ -274,23 +294,20 @@ func CleanStaleLocks(root string) (int, error) {
 		sessionSet[s] = true
 	}
 
-	cleaned := 0
+	stale := make(map[string]*LockInfo)
 	for workerDir, info := range locks {
 		if info.IsStale() {
 			// PID is dead, but check if session still exists
 			if info.SessionID != "" && sessionSet[info.SessionID] {
-				// Session exists - worker is alive, don't clean
+				// Session exists - worker is alive, not stale
 				continue
 			}
 			// Both PID dead AND no session = truly stale
-			lock := New(workerDir)
-			if err := lock.Release(); err == nil {
-				cleaned++
-			}
+			stale[workerDir] = info
 		}
 	}
 
-	return cleaned, nil
+	return stale, nil
 }
 
 // getActiveTmuxSessions returns a list of active tmux session identifiers.
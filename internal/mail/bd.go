@@ -3,7 +3,6 @@ package mail
 import (
 	"bytes"
 	"context"
-	"os/exec"
 	"strings"
 	"time"
 
@@ -69,9 +68,9 @@ func runBdCommand(ctx context.Context, args []string, workDir, beadsDir string,
 	// own injection. (GH#2746)
 	args = beads.InjectFlatForListJSON(args)
 
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = workDir
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 
 	env := append(cmd.Environ(), "BEADS_DIR="+beadsDir)
 	if dbEnv := beads.DatabaseEnv(beadsDir); dbEnv != "" {
@@ -98,9 +97,9 @@ func runBdCommand(ctx context.Context, args []string, workDir, beadsDir string,
 		}
 		stdout.Reset()
 		stderr.Reset()
-		retryCmd := exec.CommandContext(ctx, "bd", retryArgs...) //nolint:gosec // G204: bd is a trusted internal tool
+		retryCmd := beads.CommandContext(ctx, retryArgs...) //nolint:gosec // G204: bd is a trusted internal tool
 		retryCmd.Dir = workDir
-		util.SetDetachedProcessGroup(retryCmd)
+		util.SetDetachedProcessGroup(retryCmd.Cmd)
 		retryCmd.Env = env
 		retryCmd.Stdout = &stdout
 		retryCmd.Stderr = &stderr
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
@@ -80,7 +79,7 @@ func (r *Recorder) RecordRun(record PluginRunRecord) (string, error) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), constants.BdCommandTimeout)
 	defer cancel()
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = r.townRoot
 	// Set BEADS_DIR explicitly to prevent inherited env vars from causing
 	// prefix mismatches when redirects are in play.
@@ -106,7 +105,7 @@ func (r *Recorder) RecordRun(record PluginRunRecord) (string, error) {
 	// (which use --all to include closed beads) but should not stay open.
 	closeCtx, closeCancel := context.WithTimeout(context.Background(), constants.BdCommandTimeout)
 	defer closeCancel()
-	closeCmd := exec.CommandContext(closeCtx, "bd", "close", result.ID, "--reason", "plugin run recorded") //nolint:gosec // G204: bd is a trusted internal tool
+	closeCmd := beads.CommandContext(closeCtx, "close", result.ID, "--reason", "plugin run recorded") //nolint:gosec // G204: bd is a trusted internal tool
 	closeCmd.Dir = r.townRoot
 	closeCmd.Env = append(os.Environ(), "BEADS_DIR="+beads.ResolveBeadsDir(r.townRoot))
 	_ = closeCmd.Run() // Best-effort — reaper will catch it if this fails
@@ -160,7 +159,7 @@ func (r *Recorder) queryRuns(pluginName string, limit int, since string) ([]*Plu
 
 	ctx, cancel := context.WithTimeout(context.Background(), constants.BdCommandTimeout)
 	defer cancel()
-	cmd := exec.CommandContext(ctx, "bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := beads.CommandContext(ctx, args...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = r.townRoot
 	// Set BEADS_DIR explicitly to prevent inherited env vars from causing
 	// prefix mismatches when redirects are in play.
@@ -30,6 +30,8 @@ import (
 	"github.com/steveyegge/gastown/internal/templates"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/vcs"
+	"github.com/steveyegge/gastown/internal/worktree"
 )
 
 // Retry constants for Dolt operations (matching hook update pattern in sling.go).
@@ -175,6 +177,7 @@ func NewManager(r *rig.Rig, g *git.Git, t *tmux.Tmux) *Manager {
 			names,
 			settings.Namepool.MaxBeforeNumbering,
 		)
+		pool.SetNaming(settings.Namepool.Prefix, settings.Namepool.FromBead)
 	} else {
 		// Fallback: check rig-level config.json for polecat_names
 		// (pool-init and gt rig config write namepool config here).
@@ -466,6 +469,30 @@ func (m *Manager) repoBase() (*git.Git, error) {
 	return git.NewGit(mayorPath), nil
 }
 
+// vcsBackend returns the checkout backend selected by the rig's config.json
+// (git worktrees of repoGit by default).
+func (m *Manager) vcsBackend(repoGit *git.Git) (vcs.Backend, error) {
+	var cfg *vcs.Config
+	if rigCfg, err := rig.LoadRigConfig(m.rig.Path); err == nil {
+		cfg = rigCfg.VCS
+	}
+	checkouts, err := vcs.New(cfg, repoGit)
+	if err != nil {
+		return nil, fmt.Errorf("rig %s: %w", m.rig.Name, err)
+	}
+	return checkouts, nil
+}
+
+// removalBackend is vcsBackend for cleanup paths, which must not fail on a
+// misconfigured backend: it falls back to git worktrees.
+func (m *Manager) removalBackend(repoGit *git.Git) vcs.Backend {
+	if checkouts, err := m.vcsBackend(repoGit); err == nil {
+		return checkouts
+	}
+	checkouts, _ := vcs.New(nil, repoGit)
+	return checkouts
+}
+
 // polecatDir returns the parent directory for a polecat.
 // This is polecats/<name>/ - the polecat's home directory.
 func (m *Manager) polecatDir(name string) string {
@@ -653,7 +680,7 @@ func (m *Manager) AllocateAndAdd(opts AddOptions) (string, *Polecat, error) {
 
 	m.reconcilePoolInternal()
 
-	name, err := m.namePool.Allocate()
+	name, err := m.allocateNameFor(opts.HookBead)
 	if err != nil {
 		_ = poolLock.Unlock()
 		return "", nil, err
@@ -724,7 +751,7 @@ func (m *Manager) addWithOptionsLocked(name string, opts AddOptions, polecatDir
 
 		if worktreeCreated {
 			if rg, repoErr := m.repoBase(); repoErr == nil {
-				_ = rg.WorktreeRemove(clonePath, true)
+				_ = m.removalBackend(rg).RemoveWorkspace(clonePath, true)
 			}
 		}
 
@@ -769,7 +796,7 @@ func (m *Manager) addWithOptionsLocked(name string, opts AddOptions, polecatDir
 			startPoint, m.rig.Path, filepath.Join(m.rig.Path, ".repo.git"))
 	}
 
-	if err := repoGit.WorktreeAddFromRef(clonePath, branchName, startPoint); err != nil {
+	if err := m.addWorktree(repoGit, clonePath, branchName, startPoint); err != nil {
 		cleanupOnError()
 		return nil, fmt.Errorf("creating worktree from %s: %w", startPoint, err)
 	}
@@ -809,6 +836,11 @@ func (m *Manager) addWithOptionsLocked(name string, opts AddOptions, polecatDir
 		style.PrintWarning("could not run setup hooks: %v", err)
 	}
 
+	if err := rig.RunBootstrap(m.rig.Path, clonePath, rig.BootstrapLogPath(m.rig.Path, name)); err != nil {
+		cleanupOnError()
+		return nil, fmt.Errorf("preparing worktree: %w", err)
+	}
+
 	agentID := m.agentBeadID(name)
 	if err = m.createAgentBeadWithRetry(agentID, &beads.AgentFields{
 		RoleType:   "polecat",
@@ -893,7 +925,7 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (_ *Polecat, retE
 		// Must happen before directory removal so git can clean up properly.
 		if worktreeCreated {
 			if rg, repoErr := m.repoBase(); repoErr == nil {
-				_ = rg.WorktreeRemove(clonePath, true)
+				_ = m.removalBackend(rg).RemoveWorkspace(clonePath, true)
 			}
 		}
 
@@ -948,8 +980,9 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (_ *Polecat, retE
 
 	// Always create fresh branch - unique name guarantees no collision
 	// git worktree add -b polecat/<name>-<timestamp> <path> <startpoint>
-	// Worktree goes in polecats/<name>/<rigname>/ for LLM ergonomics
-	if err := repoGit.WorktreeAddFromRef(clonePath, branchName, startPoint); err != nil {
+	// Worktree goes in polecats/<name>/<rigname>/ for LLM ergonomics.
+	// A pre-warmed worktree from the rig's pool is used when available.
+	if err := m.addWorktree(repoGit, clonePath, branchName, startPoint); err != nil {
 		cleanupOnError()
 		return nil, fmt.Errorf("creating worktree from %s: %w", startPoint, err)
 	}
@@ -1011,6 +1044,14 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (_ *Polecat, retE
 		style.PrintWarning("could not run setup hooks: %v", err)
 	}
 
+	// Run the rig's bootstrap (bootstrap.sh or config.json bootstrap.command).
+	// Fatal: a polecat started in a broken environment only finds out
+	// mid-task, so fail the spawn with the bootstrap's output instead.
+	if err := rig.RunBootstrap(m.rig.Path, clonePath, rig.BootstrapLogPath(m.rig.Path, name)); err != nil {
+		cleanupOnError()
+		return nil, fmt.Errorf("preparing worktree: %w", err)
+	}
+
 	// NOTE: Slash commands (.claude/commands/) are provisioned at town level by gt install.
 	// All agents inherit them via Claude's directory traversal - no per-workspace copies needed.
 
@@ -1192,20 +1233,22 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear, selfNuke bool)
 		// This handles edge cases where the repo base is corrupted but worktree entries exist.
 		bareRepoPath := filepath.Join(m.rig.Path, ".repo.git")
 		if info, statErr := os.Stat(bareRepoPath); statErr == nil && info.IsDir() {
-			bareGit := git.NewGitWithDir(bareRepoPath, "")
-			_ = bareGit.WorktreePrune()
+			_ = worktree.NewManager(git.NewGitWithDir(bareRepoPath, "")).Prune()
 		}
 		mayorRigPath := filepath.Join(m.rig.Path, "mayor", "rig")
 		if info, statErr := os.Stat(mayorRigPath); statErr == nil && info.IsDir() {
-			mayorGit := git.NewGit(mayorRigPath)
-			_ = mayorGit.WorktreePrune()
+			_ = worktree.NewManager(git.NewGit(mayorRigPath)).Prune()
 		}
 		// Fall back to direct removal if repo base not found
 		return os.RemoveAll(polecatDir)
 	}
 
-	// Try to remove as a worktree first (use force flag for worktree removal too)
-	if err := repoGit.WorktreeRemove(clonePath, force); err != nil {
+	// Return the worktree to the rig's pool if it has room; otherwise try to
+	// remove as a worktree first (use force flag for worktree removal too)
+	checkouts := m.removalBackend(repoGit)
+	if checkouts.Name() == vcs.TypeGitWorktree && m.recyclePooledWorktree(repoGit, clonePath) {
+		// Moved into the pool; nothing left at clonePath.
+	} else if err := checkouts.RemoveWorkspace(clonePath, force); err != nil {
 		// Fall back to direct removal if worktree removal fails
 		// (e.g., if this is an old-style clone, not a worktree)
 		if removeErr := os.RemoveAll(clonePath); removeErr != nil {
@@ -1227,7 +1270,7 @@ func (m *Manager) RemoveWithOptions(name string, force, nuclear, selfNuke bool)
 	}
 
 	// Prune any stale worktree entries (non-fatal: cleanup only)
-	_ = repoGit.WorktreePrune()
+	_ = checkouts.Prune()
 
 	// Verify removal succeeded (fixes #618)
 	// The above removal attempts may fail silently on permissions, symlinks, or busy files
@@ -1300,6 +1343,37 @@ func forceRemoveDir(dir string) error {
 	return os.RemoveAll(dir)
 }
 
+// NamesFromBead reports whether new polecats in this rig are named after
+// the bead they are spawned for (namepool from_bead).
+func (m *Manager) NamesFromBead() bool {
+	return m.namePool.NamesFromBead()
+}
+
+// allocateNameFor picks the name for a new polecat spawned for beadID: the
+// bead-derived name when the rig names polecats from beads (with -2, -3, ...
+// appended if that bead already has a polecat), otherwise the next pool name.
+// Caller must hold the pool lock.
+func (m *Manager) allocateNameFor(beadID string) (string, error) {
+	base := m.namePool.BeadName(beadID, session.PrefixFor(m.rig.Name))
+	if base == "" {
+		return m.namePool.Allocate()
+	}
+	name := base
+	for n := 2; m.nameTaken(name); n++ {
+		name = fmt.Sprintf("%s-%d", base, n)
+	}
+	return name, nil
+}
+
+// nameTaken reports whether a polecat directory or reservation exists for name.
+func (m *Manager) nameTaken(name string) bool {
+	if _, err := os.Stat(m.polecatDir(name)); err == nil {
+		return true
+	}
+	_, err := os.Stat(m.pendingPath(name))
+	return err == nil
+}
+
 // AllocateName allocates a name from the name pool.
 // Returns a themed pooled name (furiosa, nux, etc.) if available,
 // otherwise returns an overflow name (just a number like "51").
@@ -1407,6 +1481,10 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 	if err != nil {
 		return nil, fmt.Errorf("finding repo base: %w", err)
 	}
+	checkouts, err := m.vcsBackend(repoGit)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check for uncommitted work unless forced
 	if !force {
@@ -1454,18 +1532,18 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 	branchName := m.buildBranchName(name, opts.HookBead)
 	tmpClonePath := newClonePath + ".repair-tmp"
 	_ = os.RemoveAll(tmpClonePath) // clean up any leftover temp dir
-	if err := repoGit.WorktreeAddFromRef(tmpClonePath, branchName, startPoint); err != nil {
+	if err := checkouts.AddWorkspace(tmpClonePath, branchName, startPoint); err != nil {
 		return nil, fmt.Errorf("creating fresh worktree from %s: %w", startPoint, err)
 	}
 
 	// New worktree created successfully — now safe to remove old worktree and reset bead.
 	// Remove old worktree BEFORE resetting bead to prevent name collision if a new
 	// spawn sees the clean bead while the old worktree still exists.
-	if err := repoGit.WorktreeRemove(oldClonePath, true); err != nil {
+	if err := checkouts.RemoveWorkspace(oldClonePath, true); err != nil {
 		// Fall back to direct removal
 		if removeErr := os.RemoveAll(oldClonePath); removeErr != nil {
 			// Clean up temp worktree before returning
-			_ = repoGit.WorktreeRemove(tmpClonePath, true)
+			_ = checkouts.RemoveWorkspace(tmpClonePath, true)
 			_ = os.RemoveAll(tmpClonePath)
 			return nil, fmt.Errorf("removing old clone path: %w", removeErr)
 		}
@@ -1482,14 +1560,15 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 	}
 
 	// Prune stale worktree entries (non-fatal: cleanup only)
-	_ = repoGit.WorktreePrune()
+	_ = checkouts.Prune()
 
-	// Move temp worktree to final location using git worktree move.
-	// os.Rename breaks worktrees: the .git file and registry gitdir still
-	// reference the old temp path, leaving a broken worktree. (GH#2056)
-	if err := repoGit.WorktreeMove(tmpClonePath, newClonePath); err != nil {
+	// Move temp worktree to final location through the VCS backend, which
+	// uses git worktree move for worktrees. os.Rename breaks worktrees: the
+	// .git file and registry gitdir still reference the old temp path,
+	// leaving a broken worktree. (GH#2056)
+	if err := checkouts.MoveWorkspace(tmpClonePath, newClonePath); err != nil {
 		// Clean up temp worktree if move fails
-		_ = repoGit.WorktreeRemove(tmpClonePath, true)
+		_ = checkouts.RemoveWorkspace(tmpClonePath, true)
 		_ = os.RemoveAll(tmpClonePath)
 		return nil, fmt.Errorf("moving repaired worktree to final path: %w", err)
 	}
@@ -1502,7 +1581,7 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 
 	// Set up shared beads — fatal during repair too, same reason as spawn.
 	if err := m.setupSharedBeads(newClonePath); err != nil {
-		_ = repoGit.WorktreeRemove(newClonePath, true)
+		_ = checkouts.RemoveWorkspace(newClonePath, true)
 		_ = os.RemoveAll(newClonePath)
 		return nil, fmt.Errorf("setting up shared beads after repair: %w (polecat cannot submit MRs without shared beads)", err)
 	}
@@ -1517,6 +1596,14 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 		style.PrintWarning("could not update local git excludes: %v", err)
 	}
 
+	// Repair creates a fresh worktree, so it needs the rig bootstrap too.
+	if err := rig.RunBootstrap(m.rig.Path, newClonePath, rig.BootstrapLogPath(m.rig.Path, name)); err != nil {
+		_ = checkouts.RemoveWorkspace(newClonePath, true)
+		_ = os.RemoveAll(newClonePath)
+		_ = os.RemoveAll(polecatDir)
+		return nil, fmt.Errorf("preparing worktree after repair: %w", err)
+	}
+
 	// NOTE: Slash commands inherited from town level - no per-workspace copies needed.
 
 	// Create or reopen agent bead for ZFC compliance
@@ -1530,7 +1617,7 @@ func (m *Manager) RepairWorktreeWithOptions(name string, force bool, opts AddOpt
 		HookBead:   opts.HookBead, // Set atomically at spawn time
 	}); err != nil {
 		// Hard fail — clean up the new worktree since we can't track this polecat
-		_ = repoGit.WorktreeRemove(newClonePath, true)
+		_ = checkouts.RemoveWorkspace(newClonePath, true)
 		_ = os.RemoveAll(newClonePath)
 		// Remove polecatDir to prevent limbo state where m.exists(name) returns true
 		// but no valid worktree exists. Matches AddWithOptions cleanupOnError behavior.
@@ -1762,7 +1849,7 @@ func (m *Manager) reconcilePoolInternal() {
 
 	// Prune any stale git worktree entries (handles manually deleted directories)
 	if repoGit, err := m.repoBase(); err == nil {
-		_ = repoGit.WorktreePrune()
+		_ = worktree.NewManager(repoGit).Prune()
 	}
 }
 
@@ -1976,7 +2063,8 @@ func (m *Manager) List() ([]*Polecat, error) {
 
 // FindIdlePolecat returns the first idle polecat in the rig, or nil if none.
 // Idle polecats have completed their work and have a preserved sandbox (worktree)
-// that can be reused by gt sling without creating a new worktree.
+// that can be reused by gt sling without creating a new worktree. Polecats with
+// a protected agent_state report StateStuck and are never returned.
 // Persistent polecat model (gt-4ac).
 func (m *Manager) FindIdlePolecat() (*Polecat, error) {
 	polecats, err := m.List()
@@ -1996,6 +2084,7 @@ func (m *Manager) FindIdlePolecat() (*Polecat, error) {
 // - If an issue is assigned to this polecat: StateWorking
 // - If no issue but tmux session is running: StateWorking (session alive = still working)
 // - If no issue and no tmux session: StateIdle (persistent, ready for reuse)
+// - Unless agent_state protects the worktree (stuck, paused, ...): StateStuck
 func (m *Manager) Get(name string) (*Polecat, error) {
 	if !m.exists(name) {
 		return nil, ErrPolecatNotFound
@@ -2162,7 +2251,7 @@ func (m *Manager) unassignWorkBeads(name string) {
 //     → working (compatibility fallback during migration)
 //  3. Issue assigned via beads assignee (open/in_progress/hooked) → working
 //  4. Live tmux session → working (session active even if assignment not yet recorded)
-//  5. agent_state=idle with no live session → idle
+//  5. Protected agent_state (stuck, paused, ...) with no live session → stuck
 //  6. None of the above → idle
 func (m *Manager) loadFromBeads(name string) (*Polecat, error) {
 	// Use clonePath which handles both new (polecats/<name>/<rigname>/)
@@ -2272,11 +2361,13 @@ func (m *Manager) loadFromBeads(name string) (*Polecat, error) {
 	// Persistent polecat model (gt-4ac): only trust agent_state=idle once the
 	// tmux session is gone. This prevents reusing a polecat that still has a live
 	// session when its bead state was cleared early.
+	// A protected agent_state (stuck, paused, ...) means the worktree holds work
+	// someone still needs, so the polecat must not be reset for reuse.
 	state := StateIdle
 	if issueID != "" {
 		state = StateWorking
-	} else if agentErr == nil && fields != nil && beads.AgentState(fields.AgentState) == beads.AgentStateIdle {
-		state = StateIdle
+	} else if agentErr == nil && fields != nil && beads.AgentState(fields.AgentState).ProtectsFromCleanup() {
+		state = StateStuck
 	}
 
 	return &Polecat{
@@ -2393,6 +2484,11 @@ type StalenessInfo struct {
 	AgentState         string // From agent bead (empty if no bead)
 	IsStale            bool   // Overall assessment: safe to clean up
 	Reason             string // Why it's considered stale (or not)
+
+	// Bead and SlungAt come from the spawn record: what the polecat was
+	// last slung and when. Empty when it has no record.
+	Bead    string
+	SlungAt time.Time
 }
 
 // DetectStalePolecats identifies polecats that are candidates for cleanup.
@@ -2446,6 +2542,10 @@ func (m *Manager) DetectStalePolecats(threshold int) ([]*StalenessInfo, error) {
 			info.AgentState = fields.AgentState
 		}
 
+		if rec, err := LoadSpawnRecord(m.rig.Path, p.Name); err == nil {
+			info.Bead, info.SlungAt = rec.Bead, rec.SlungAt
+		}
+
 		// Determine staleness
 		info.IsStale, info.Reason = assessStaleness(info, threshold)
 		results = append(results, info)
@@ -1,6 +1,7 @@
 package polecat
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -382,6 +383,42 @@ func TestNewManager_NamepoolFromRigConfig(t *testing.T) {
 	}
 }
 
+func TestNewManager_NamepoolFromBead(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "myrig")
+	if err := os.MkdirAll(filepath.Join(rigPath, "settings"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	settings := `{"type":"rig-settings","version":1,"namepool":{"style":"minerals","prefix":"api","from_bead":true}}`
+	if err := os.WriteFile(filepath.Join(rigPath, "settings", "config.json"), []byte(settings), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &rig.Rig{Name: "myrig", Path: rigPath}
+	m := NewManager(r, git.NewGit(rigPath), nil)
+	if !m.NamesFromBead() {
+		t.Fatal("NamesFromBead() = false with from_bead set")
+	}
+
+	// Foreign-prefix bead keeps its prefix; a second polecat for the same
+	// bead gets a numbered name.
+	name, err := m.allocateNameFor("zz-abc12")
+	if err != nil || name != "api-zz-abc12" {
+		t.Fatalf("allocateNameFor = %q, %v; want api-zz-abc12", name, err)
+	}
+	if err := os.MkdirAll(m.polecatDir(name), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := m.allocateNameFor("zz-abc12"); name != "api-zz-abc12-2" {
+		t.Errorf("allocateNameFor for a bead with a polecat = %q, want api-zz-abc12-2", name)
+	}
+
+	// No bead: the themed pool, prefixed.
+	if name, _ := m.allocateNameFor(""); name != "api-obsidian" {
+		t.Errorf("allocateNameFor without a bead = %q, want api-obsidian", name)
+	}
+}
+
 // Note: State persistence tests removed - state is now derived from beads assignee field.
 // Integration tests should verify beads-based state management.
 
@@ -2141,3 +2178,85 @@ func TestReuseIdlePolecat_NoSessionNoop(t *testing.T) {
 		t.Fatal("expected error from worktree operations")
 	}
 }
+
+// installAgentStateBd places a fake bd in PATH that reports no assigned work
+// and answers every show with an agent bead in the given agent_state.
+func installAgentStateBd(t *testing.T, agentState string) {
+	t.Helper()
+	binDir := t.TempDir()
+	desc := beads.FormatAgentDescription("Polecat toast", &beads.AgentFields{
+		RoleType:   "polecat",
+		Rig:        "test-rig",
+		AgentState: agentState,
+	})
+	issue, err := json.Marshal([]map[string]any{{
+		"id":          "agent-toast",
+		"title":       "Polecat toast",
+		"status":      "open",
+		"labels":      []string{"gt:agent"},
+		"description": desc,
+	}})
+	if err != nil {
+		t.Fatalf("marshal agent bead: %v", err)
+	}
+	showPath := filepath.Join(binDir, "show.json")
+	if err := os.WriteFile(showPath, issue, 0644); err != nil {
+		t.Fatalf("write show.json: %v", err)
+	}
+	script := `#!/bin/sh
+cmd=""
+for arg in "$@"; do
+  case "$arg" in
+    --*) ;;
+    *) cmd="$arg"; break ;;
+  esac
+done
+case "$cmd" in
+  list) echo '[]' ;;
+  show) cat "` + showPath + `" ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write mock bd: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// TestFindIdlePolecat_SkipsProtectedAgentState verifies that a polecat whose
+// worktree was kept (gt polecat kill with unsaved WIP, gt takeover) is never
+// handed to gt sling for reuse, which would hard-reset the kept branch.
+func TestFindIdlePolecat_SkipsProtectedAgentState(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd script requires sh")
+	}
+	tests := []struct {
+		agentState string
+		wantIdle   bool
+	}{
+		{string(beads.AgentStateStuck), false},  // gt polecat kill kept WIP
+		{string(beads.AgentStatePaused), false}, // gt takeover
+		{string(beads.AgentStateNuked), true},   // reset for reuse
+	}
+	for _, tt := range tests {
+		t.Run(tt.agentState, func(t *testing.T) {
+			installAgentStateBd(t, tt.agentState)
+			root := t.TempDir()
+			if err := os.MkdirAll(filepath.Join(root, "polecats", "toast"), 0755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+			if err := os.MkdirAll(filepath.Join(root, "mayor", "rig"), 0755); err != nil {
+				t.Fatalf("mkdir mayor/rig: %v", err)
+			}
+			m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root), nil)
+
+			idle, err := m.FindIdlePolecat()
+			if err != nil {
+				t.Fatalf("FindIdlePolecat: %v", err)
+			}
+			if gotIdle := idle != nil; gotIdle != tt.wantIdle {
+				t.Errorf("FindIdlePolecat() with agent_state=%s returned polecat = %v, want %v", tt.agentState, gotIdle, tt.wantIdle)
+			}
+		})
+	}
+}
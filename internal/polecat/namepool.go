@@ -101,6 +101,12 @@ type NamePool struct {
 	// CustomNames allows overriding the built-in theme names.
 	CustomNames []string `json:"custom_names,omitempty"`
 
+	// Prefix is prepended to every name the pool hands out ("api" → api-furiosa).
+	Prefix string `json:"prefix,omitempty"`
+
+	// FromBead makes BeadName derive names from bead IDs.
+	FromBead bool `json:"from_bead,omitempty"`
+
 	// InUse tracks which pool names are currently in use.
 	// Key is the name itself, value is true if in use.
 	// ZFC: This is transient state derived from filesystem via Reconcile().
@@ -158,6 +164,61 @@ func (p *NamePool) SetTownRoot(townRoot string) {
 	p.townRoot = townRoot
 }
 
+// SetNaming sets the name prefix and whether names are derived from beads.
+func (p *NamePool) SetNaming(prefix string, fromBead bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Prefix = prefix
+	p.FromBead = fromBead
+}
+
+// withPrefix applies the pool's name prefix to name.
+func (p *NamePool) withPrefix(name string) string {
+	if p.Prefix == "" {
+		return name
+	}
+	return p.Prefix + "-" + name
+}
+
+// NamesFromBead reports whether the pool derives names from bead IDs.
+func (p *NamePool) NamesFromBead() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.FromBead
+}
+
+// BeadName returns the name for a polecat spawned for beadID when the pool
+// names polecats from beads, or "" when it doesn't (or there is no bead).
+// The rig's own prefix is dropped, so bead gt-abc12 in rig prefix gt gives
+// abc12 and the session reads gt-abc12; beads from other rigs keep theirs.
+// Names that would read as an infrastructure agent (witness, crew-x, ...)
+// give "", so the caller falls back to the pool. The caller makes the name
+// unique if that bead already has a polecat.
+func (p *NamePool) BeadName(beadID, rigPrefix string) string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if !p.FromBead || beadID == "" {
+		return ""
+	}
+	id := strings.ToLower(beadID)
+	if rigPrefix != "" {
+		id = strings.TrimPrefix(id, strings.ToLower(rigPrefix)+"-")
+	}
+	// tmux rejects '.' and ':' in session names; keep names to [a-z0-9-].
+	name := strings.Trim(invalidBeadNameCharRe.ReplaceAllString(id, "-"), "-")
+	if name == "" {
+		return ""
+	}
+	name = p.withPrefix(name)
+	if first, _, _ := strings.Cut(name, "-"); ReservedInfraAgentNames[first] {
+		return ""
+	}
+	return name
+}
+
+// invalidBeadNameCharRe matches characters not allowed in bead-derived names.
+var invalidBeadNameCharRe = regexp.MustCompile(`[^a-z0-9-]+`)
+
 // getNames returns the list of names to use for the pool.
 // Reserved infrastructure agent names are filtered out.
 func (p *NamePool) getNames() []string {
@@ -183,7 +244,15 @@ func (p *NamePool) getNames() []string {
 	}
 
 	// Filter out reserved infrastructure agent names
-	return filterReservedNames(names)
+	names = filterReservedNames(names)
+	if p.Prefix != "" {
+		prefixed := make([]string, len(names))
+		for i, name := range names {
+			prefixed[i] = p.withPrefix(name)
+		}
+		names = prefixed
+	}
+	return names
 }
 
 // filterReservedNames removes reserved infrastructure agent names from a name list.
@@ -369,8 +438,9 @@ func (p *NamePool) Reconcile(existingPolecats []string) {
 // formatOverflowName formats an overflow sequence number as a name.
 // Returns just the number (e.g., "51") since SessionName will add the rig prefix.
 // This prevents double-prefix bugs like "gt-gastown_manager-gastown_manager-51".
+// A configured name prefix still applies ("api-51").
 func (p *NamePool) formatOverflowName(seq int) string {
-	return fmt.Sprintf("%d", seq)
+	return p.withPrefix(fmt.Sprintf("%d", seq))
 }
 
 // GetTheme returns the current theme name.
@@ -566,6 +636,19 @@ func ValidatePoolName(name string) error {
 	return nil
 }
 
+// ValidateNamePrefix validates a polecat name prefix. Prefixes follow the
+// pool name rules, except for length, and must not read as a session role
+// marker: a "crew" prefix would make every polecat session parse as crew.
+func ValidateNamePrefix(prefix string) error {
+	if !validPoolNameRe.MatchString(prefix) || strings.HasSuffix(prefix, "-") {
+		return fmt.Errorf("prefix %q invalid (must be lowercase alphanumeric with hyphens, starting with a letter)", prefix)
+	}
+	if ReservedInfraAgentNames[prefix] {
+		return fmt.Errorf("prefix %q is reserved for infrastructure agents", prefix)
+	}
+	return nil
+}
+
 // ParseThemeFile reads a custom theme file (one name per line).
 // Lines starting with # are comments. Blank lines are skipped.
 // Names are lowercased and deduplicated. Names <=3 chars are rejected.
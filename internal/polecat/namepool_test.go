@@ -582,6 +582,78 @@ func TestNamePool_ReservedNamesInCustomNames(t *testing.T) {
 
 // --- Custom theme tests ---
 
+func TestNamePool_Prefix(t *testing.T) {
+	pool := NewNamePoolWithConfig(t.TempDir(), "testrig", "mad-max", nil, 2)
+	pool.SetNaming("api", false)
+
+	for _, want := range []string{"api-furiosa", "api-nux", "api-3"} {
+		name, err := pool.Allocate()
+		if err != nil {
+			t.Fatalf("Allocate error: %v", err)
+		}
+		if name != want {
+			t.Errorf("Allocate() = %q, want %q", name, want)
+		}
+	}
+	if !pool.IsPoolName("api-furiosa") || pool.IsPoolName("furiosa") {
+		t.Error("IsPoolName should match prefixed names only")
+	}
+
+	pool.Release("api-furiosa")
+	if name, _ := pool.Allocate(); name != "api-furiosa" {
+		t.Errorf("released prefixed name not reused, got %q", name)
+	}
+}
+
+func TestNamePool_BeadName(t *testing.T) {
+	pool := NewNamePoolWithConfig(t.TempDir(), "gastown", "mad-max", nil, DefaultPoolSize)
+	if got := pool.BeadName("gt-abc12", "gt"); got != "" {
+		t.Errorf("BeadName with from_bead off = %q, want empty", got)
+	}
+
+	pool.SetNaming("", true)
+	tests := []struct {
+		beadID, rigPrefix, want string
+	}{
+		{"gt-abc12", "gt", "abc12"},
+		{"bd-xyz", "gt", "bd-xyz"},
+		{"gt-abc.1", "gt", "abc-1"},
+		{"GT-Mixed", "gt", "mixed"},
+		{"", "gt", ""},
+		{"gt-witness", "gt", ""},
+		{"gt-refinery", "gt", ""},
+		{"gt-crew-max", "gt", ""},
+		{"MAYOR", "gt", ""},
+		{"gt-witness2", "gt", "witness2"},
+	}
+	for _, tt := range tests {
+		if got := pool.BeadName(tt.beadID, tt.rigPrefix); got != tt.want {
+			t.Errorf("BeadName(%q, %q) = %q, want %q", tt.beadID, tt.rigPrefix, got, tt.want)
+		}
+	}
+
+	pool.SetNaming("api", true)
+	if got := pool.BeadName("gt-abc12", "gt"); got != "api-abc12" {
+		t.Errorf("BeadName with prefix = %q, want api-abc12", got)
+	}
+	if got := pool.BeadName("gt-witness", "gt"); got != "api-witness" {
+		t.Errorf("BeadName with prefix = %q, want api-witness", got)
+	}
+}
+
+func TestValidateNamePrefix(t *testing.T) {
+	for _, ok := range []string{"api", "a", "web-ui"} {
+		if err := ValidateNamePrefix(ok); err != nil {
+			t.Errorf("ValidateNamePrefix(%q) = %v, want nil", ok, err)
+		}
+	}
+	for _, bad := range []string{"", "API", "1api", "api-", "api_x", "crew", "witness"} {
+		if err := ValidateNamePrefix(bad); err == nil {
+			t.Errorf("ValidateNamePrefix(%q) = nil, want error", bad)
+		}
+	}
+}
+
 func TestParseThemeFile(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "namepool-theme-*")
 	if err != nil {
@@ -7,16 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/steveyegge/gastown/internal/artifacts"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
@@ -76,6 +77,19 @@ type SessionStartOptions struct {
 	// If set, GT_AGENT is written to the tmux session environment table so that
 	// IsAgentAlive and waitForPolecatReady read the correct process names.
 	Agent string
+
+	// CorrelationID ties the session's events to the dispatch that started
+	// it. If set, GT_CORRELATION_ID is injected so every gt event the
+	// polecat logs carries it.
+	CorrelationID string
+
+	// Bead, Formula, FormulaVars and BaseBranch describe the work the
+	// polecat was slung. They don't change how the session starts; they
+	// are kept in its spawn record (see SpawnRecord).
+	Bead        string
+	Formula     string
+	FormulaVars []string
+	BaseBranch  string
 }
 
 // SessionInfo contains information about a running polecat session.
@@ -475,6 +489,21 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	if polecatGitBranch != "" {
 		envVarsToInject["GT_BRANCH"] = polecatGitBranch
 	}
+	// Point package managers at the rig's shared caches (dep_cache).
+	depCacheEnv := rig.DepCacheEnv(m.rig.Path)
+	for k, v := range depCacheEnv {
+		envVarsToInject[k] = v
+	}
+	if opts.CorrelationID != "" {
+		envVarsToInject[events.EnvCorrelationID] = opts.CorrelationID
+	}
+	if opts.Issue != "" {
+		// Where formulas drop result artifacts for this bead (gt bead artifacts).
+		artifactDir := artifacts.Dir(townRoot, opts.Issue)
+		if err := os.MkdirAll(artifactDir, 0755); err == nil {
+			envVarsToInject[artifacts.EnvVar] = artifactDir
+		}
+	}
 	command = config.PrependEnv(command, envVarsToInject)
 
 	// Create session with command directly to avoid send-keys race condition.
@@ -519,6 +548,12 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	debugSession("SetEnvironment GT_TOWN_ROOT", m.tmux.SetEnvironment(sessionID, "GT_TOWN_ROOT", townRoot))
 	// Set GT_RUN in the session environment so respawned processes also inherit it.
 	debugSession("SetEnvironment GT_RUN", m.tmux.SetEnvironment(sessionID, "GT_RUN", runID))
+	for k, v := range depCacheEnv {
+		debugSession("SetEnvironment "+k, m.tmux.SetEnvironment(sessionID, k, v))
+	}
+	if opts.CorrelationID != "" {
+		debugSession("SetEnvironment "+events.EnvCorrelationID, m.tmux.SetEnvironment(sessionID, events.EnvCorrelationID, opts.CorrelationID))
+	}
 
 	// Disable Dolt auto-commit in tmux session environment (gt-5cc2p).
 	// This ensures respawned processes also inherit the setting.
@@ -530,6 +565,59 @@ func (m *SessionManager) Start(polecat string, opts SessionStartOptions) error {
 	processNames := config.ResolveProcessNames(runtimeConfig.ResolvedAgent, runtimeConfig.Command)
 	debugSession("SetEnvironment GT_PROCESS_NAMES", m.tmux.SetEnvironment(sessionID, "GT_PROCESS_NAMES", strings.Join(processNames, ",")))
 
+	// Record what the session was started with for gt polecat env (non-fatal).
+	spawnEnv := make(map[string]string)
+	for _, env := range []map[string]string{envVars, runtimeConfig.Env, envVarsToInject} {
+		for k, v := range env {
+			spawnEnv[k] = v
+		}
+	}
+	if _, ok := spawnEnv["GT_AGENT"]; !ok && runtimeConfig.ResolvedAgent != "" {
+		spawnEnv["GT_AGENT"] = runtimeConfig.ResolvedAgent
+	}
+	if runtimeConfig.Session != nil && runtimeConfig.Session.ConfigDirEnv != "" && opts.RuntimeConfigDir != "" {
+		spawnEnv[runtimeConfig.Session.ConfigDirEnv] = opts.RuntimeConfigDir
+	}
+	spawnEnv["BD_DOLT_AUTO_COMMIT"] = "off"
+	spawnEnv["GT_PROCESS_NAMES"] = strings.Join(processNames, ",")
+	// Fill in the session half of the spawn record gt sling wrote, or start
+	// one for a session started outside gt sling.
+	rec, err := LoadSpawnRecord(m.rig.Path, polecat)
+	if err != nil {
+		rec = &SpawnRecord{Rig: m.rig.Name, Polecat: polecat}
+	}
+	rec.Session = sessionID
+	rec.StartedAt = time.Now().UTC()
+	rec.Agent = runtimeConfig.ResolvedAgent
+	rec.AgentOverride = opts.Agent
+	rec.Command = runtimeConfig.Command
+	rec.Args = runtimeConfig.Args
+	rec.Account = opts.Account
+	rec.ConfigDir = opts.RuntimeConfigDir
+	rec.Branch = polecatGitBranch
+	rec.Worktree = workDir
+	rec.RunID = runID
+	rec.Env = spawnEnv
+	// Keep what gt sling recorded unless this start says otherwise.
+	if opts.Bead != "" {
+		rec.Bead = opts.Bead
+	} else if opts.Issue != "" {
+		rec.Bead = opts.Issue
+	}
+	if opts.Formula != "" {
+		rec.Formula = opts.Formula
+	}
+	if opts.BaseBranch != "" {
+		rec.BaseBranch = opts.BaseBranch
+	}
+	if opts.CorrelationID != "" {
+		rec.CorrelationID = opts.CorrelationID
+	}
+	if len(opts.FormulaVars) > 0 {
+		rec.FormulaVars = opts.FormulaVars
+	}
+	debugSession("WriteSpawnRecord", WriteSpawnRecord(m.rig.Path, rec))
+
 	// Record agent's pane_id for ZFC-compliant liveness checks (gt-qmsx).
 	// Declared pane identity replaces process-tree inference in IsRuntimeRunning
 	// and FindAgentPane. Legacy sessions without GT_PANE_ID fall back to scanning.
@@ -887,8 +975,8 @@ func (m *SessionManager) validateIssue(issueID, workDir string) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), constants.BdCommandTimeout)
 	defer cancel()
-	cmd := exec.CommandContext(ctx, "bd", "show", issueID, "--json") //nolint:gosec // G204: bd is a trusted internal tool
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, "show", issueID, "--json") //nolint:gosec // G204: bd is a trusted internal tool
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	cmd.Dir = bdWorkDir
 	output, err := cmd.Output()
 	if err != nil {
@@ -985,8 +1073,8 @@ func (m *SessionManager) hookIssue(issueID, agentID, workDir string) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), constants.BdCommandTimeout)
 	defer cancel()
-	cmd := exec.CommandContext(ctx, "bd", "update", issueID, "--status=hooked", "--assignee="+agentID) //nolint:gosec // G204: bd is a trusted internal tool
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, "update", issueID, "--status=hooked", "--assignee="+agentID) //nolint:gosec // G204: bd is a trusted internal tool
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	cmd.Dir = bdWorkDir
 	cmd.Stderr = os.Stderr
 	if err := cmd.Run(); err != nil {
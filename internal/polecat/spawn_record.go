@@ -0,0 +1,132 @@
+package polecat
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// SpawnRecord is a polecat's spawn manifest: how it was slung (the sling
+// options, bead, formula and vars), what its session was started with (the
+// account, agent and injected environment), where it works, and the base
+// commit its worktree started from. gt sling writes it when it spawns the
+// polecat, replacing the record of the polecat's previous work, and
+// SessionManager.Start fills in the session half, so gt polecat env,
+// status, stale and gt bead timeline can show what a polecat was given
+// after the sling output has scrolled away.
+type SpawnRecord struct {
+	Rig     string `json:"rig"`
+	Polecat string `json:"polecat"`
+	Session string `json:"session"`
+
+	// SlungAt is when gt sling spawned the polecat; zero for a session
+	// started outside gt sling. StartedAt is when its session last started.
+	SlungAt   time.Time `json:"slung_at"`
+	StartedAt time.Time `json:"started_at"`
+
+	// Agent is the agent that ran; AgentOverride is the --agent it was
+	// resolved from, empty when the role default was used.
+	Agent         string   `json:"agent,omitempty"`
+	AgentOverride string   `json:"agent_override,omitempty"`
+	Command       string   `json:"command,omitempty"`
+	Args          []string `json:"args,omitempty"`
+
+	Account   string `json:"account,omitempty"`
+	ConfigDir string `json:"config_dir,omitempty"`
+
+	Bead        string        `json:"bead,omitempty"`
+	Formula     string        `json:"formula,omitempty"`
+	FormulaVars []string      `json:"formula_vars,omitempty"`
+	Sling       *SlingOptions `json:"sling,omitempty"`
+
+	BaseBranch    string `json:"base_branch,omitempty"`
+	BaseSHA       string `json:"base_sha,omitempty"` // Worktree HEAD when spawned
+	Branch        string `json:"branch,omitempty"`
+	Worktree      string `json:"worktree"`
+	RunID         string `json:"run_id,omitempty"`
+	CorrelationID string `json:"correlation_id,omitempty"`
+
+	// Env is the environment injected into the session, from the startup
+	// command and the tmux session table. Values of keys that name a secret
+	// are redacted before the record is written.
+	Env map[string]string `json:"env"`
+}
+
+// SlingOptions are the gt sling options a polecat was dispatched with.
+type SlingOptions struct {
+	Args        string   `json:"args,omitempty"`
+	Vars        []string `json:"vars,omitempty"` // --var as given; see SpawnRecord.FormulaVars
+	Merge       string   `json:"merge,omitempty"`
+	Mode        string   `json:"mode,omitempty"`
+	NoMerge     bool     `json:"no_merge,omitempty"`
+	ReviewOnly  bool     `json:"review_only,omitempty"`
+	Owned       bool     `json:"owned,omitempty"`
+	NoConvoy    bool     `json:"no_convoy,omitempty"`
+	HookRawBead bool     `json:"hook_raw_bead,omitempty"`
+	Force       bool     `json:"force,omitempty"`
+	// Caller is what dispatched the sling: "sling", "batch-sling",
+	// "queue-dispatch", ...
+	Caller string `json:"caller,omitempty"`
+}
+
+// spawnRecordFile returns the path of a polecat's spawn record. Records
+// live under <rig>/.runtime/polecats/ so they outlive the polecat's
+// worktree.
+func spawnRecordFile(rigPath, polecat string) string {
+	return filepath.Join(rigPath, ".runtime", "polecats", polecat, "spawn.json")
+}
+
+// WriteSpawnRecord saves rec as the polecat's spawn record. The write is
+// atomic, so a status read racing SessionManager.Start never sees a
+// half-written record.
+func WriteSpawnRecord(rigPath string, rec *SpawnRecord) error {
+	redacted := *rec
+	redacted.Env = make(map[string]string, len(rec.Env))
+	for k, v := range rec.Env {
+		if v != "" && util.IsSensitiveKey(k) {
+			v = "[REDACTED]"
+		}
+		redacted.Env[k] = v
+	}
+	return atomicfile.EnsureDirAndWriteJSON(spawnRecordFile(rigPath, rec.Polecat), redacted)
+}
+
+// LoadSpawnRecord reads the polecat's spawn record.
+func LoadSpawnRecord(rigPath, polecat string) (*SpawnRecord, error) {
+	data, err := os.ReadFile(spawnRecordFile(rigPath, polecat))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no spawn record for %s (polecats spawned before spawn records existed have none)", polecat)
+		}
+		return nil, err
+	}
+	var rec SpawnRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("parsing spawn record for %s: %w", polecat, err)
+	}
+	return &rec, nil
+}
+
+// ListSpawnRecords returns the spawn records of every polecat in the rig,
+// skipping any that can't be read.
+func ListSpawnRecords(rigPath string) []*SpawnRecord {
+	entries, err := os.ReadDir(filepath.Join(rigPath, ".runtime", "polecats"))
+	if err != nil {
+		return nil
+	}
+	var recs []*SpawnRecord
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if rec, err := LoadSpawnRecord(rigPath, e.Name()); err == nil {
+			recs = append(recs, rec)
+		}
+	}
+	return recs
+}
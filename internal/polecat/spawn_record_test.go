@@ -0,0 +1,64 @@
+package polecat
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSpawnRecordRoundTrip(t *testing.T) {
+	rigPath := t.TempDir()
+	if _, err := LoadSpawnRecord(rigPath, "Toast"); err == nil || !strings.Contains(err.Error(), "no spawn record") {
+		t.Fatalf("missing record: err = %v", err)
+	}
+
+	rec := &SpawnRecord{
+		Rig:         "gastown",
+		Polecat:     "Toast",
+		Session:     "gt-gastown-p-Toast",
+		StartedAt:   time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		Agent:       "codex",
+		Account:     "work",
+		Formula:     "mol-polecat-work",
+		FormulaVars: []string{"base_branch=dev"},
+		Sling:       &SlingOptions{Merge: "local", NoMerge: true, Caller: "queue-dispatch"},
+		BaseSHA:     "0123456789abcdef",
+		Worktree:    "/town/gastown/polecats/Toast/gastown",
+		Env: map[string]string{
+			"GT_RIG":            "gastown",
+			"ANTHROPIC_API_KEY": "sk-secret",
+			"GITHUB_TOKEN":      "",
+		},
+	}
+	if err := WriteSpawnRecord(rigPath, rec); err != nil {
+		t.Fatalf("WriteSpawnRecord: %v", err)
+	}
+	if rec.Env["ANTHROPIC_API_KEY"] != "sk-secret" {
+		t.Error("WriteSpawnRecord modified the caller's env")
+	}
+
+	got, err := LoadSpawnRecord(rigPath, "Toast")
+	if err != nil {
+		t.Fatalf("LoadSpawnRecord: %v", err)
+	}
+	if got.Agent != "codex" || got.Formula != "mol-polecat-work" || got.FormulaVars[0] != "base_branch=dev" || !got.StartedAt.Equal(rec.StartedAt) {
+		t.Errorf("round trip = %+v", got)
+	}
+	if got.Env["GT_RIG"] != "gastown" || got.Env["GITHUB_TOKEN"] != "" {
+		t.Errorf("env = %v", got.Env)
+	}
+	if got.Env["ANTHROPIC_API_KEY"] != "[REDACTED]" {
+		t.Errorf("secret not redacted: %q", got.Env["ANTHROPIC_API_KEY"])
+	}
+	if got.Sling == nil || got.Sling.Merge != "local" || !got.Sling.NoMerge || got.BaseSHA != rec.BaseSHA {
+		t.Errorf("sling options = %+v, base %q", got.Sling, got.BaseSHA)
+	}
+
+	rec.Polecat = "Nux"
+	if err := WriteSpawnRecord(rigPath, rec); err != nil {
+		t.Fatal(err)
+	}
+	if recs := ListSpawnRecords(rigPath); len(recs) != 2 {
+		t.Errorf("ListSpawnRecords = %d records, want 2", len(recs))
+	}
+}
@@ -0,0 +1,285 @@
+package polecat
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/vcs"
+	"github.com/steveyegge/gastown/internal/worktree"
+)
+
+// worktreePoolDirName is the rig-level directory holding pre-warmed
+// worktrees. It is a dot-dir at the rig root so polecat scanners
+// (List, reconcile, daemon dogs) never mistake an entry for a polecat.
+const worktreePoolDirName = ".worktree-pool"
+
+// worktreePoolStagingPrefix marks an entry that is still being checked out.
+// Staging entries are invisible to leasing until they are moved into place.
+const worktreePoolStagingPrefix = ".warming-"
+
+// worktreePoolDir returns the rig's worktree pool directory.
+func (m *Manager) worktreePoolDir() string {
+	return filepath.Join(m.rig.Path, worktreePoolDirName)
+}
+
+// WorktreePoolSize returns the configured number of pre-warmed worktrees for
+// the rig (worktree_pool_size in the rig's config.json). Zero disables the pool.
+func (m *Manager) WorktreePoolSize() int {
+	rigCfg, err := rig.LoadRigConfig(m.rig.Path)
+	if err != nil || rigCfg.WorktreePoolSize < 0 || !rigCfg.VCS.IsGitWorktree() {
+		return 0 // Pool entries are git worktrees
+	}
+	return rigCfg.WorktreePoolSize
+}
+
+// WorktreePoolReady returns the number of pre-warmed worktrees ready to lease.
+func (m *Manager) WorktreePoolReady() int {
+	entries, _ := m.pooledWorktrees()
+	return len(entries)
+}
+
+// lockWorktreePool acquires an exclusive file lock for pool membership changes
+// (lease, return, trim). Checkouts happen outside the lock.
+// Caller must defer fl.Unlock().
+func (m *Manager) lockWorktreePool() (*flock.Flock, error) {
+	lockDir := filepath.Join(m.rig.Path, ".runtime", "locks")
+	if err := os.MkdirAll(lockDir, 0755); err != nil {
+		return nil, fmt.Errorf("creating lock dir: %w", err)
+	}
+	fl := flock.New(filepath.Join(lockDir, "worktree-pool.lock"))
+	if err := fl.Lock(); err != nil {
+		return nil, fmt.Errorf("acquiring worktree pool lock: %w", err)
+	}
+	return fl, nil
+}
+
+// pooledWorktrees returns the paths of ready pool entries, oldest first.
+func (m *Manager) pooledWorktrees() ([]string, error) {
+	entries, err := os.ReadDir(m.worktreePoolDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var paths []string
+	for _, e := range entries {
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		paths = append(paths, filepath.Join(m.worktreePoolDir(), e.Name()))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// newPoolEntryName returns a unique, time-ordered pool entry name.
+func newPoolEntryName() string {
+	return fmt.Sprintf("wt-%d", time.Now().UnixNano())
+}
+
+// defaultStartPoint returns origin/<default_branch> for the rig.
+func (m *Manager) defaultStartPoint() string {
+	defaultBranch := "main"
+	if rigCfg, err := rig.LoadRigConfig(m.rig.Path); err == nil && rigCfg.DefaultBranch != "" {
+		defaultBranch = rigCfg.DefaultBranch
+	}
+	return fmt.Sprintf("origin/%s", defaultBranch)
+}
+
+// addWorktree creates the polecat worktree at clonePath on a new branch from
+// startPoint, leasing a pre-warmed worktree from the rig's pool when one is
+// available and falling back to git worktree add otherwise.
+func (m *Manager) addWorktree(repoGit *git.Git, clonePath, branchName, startPoint string) error {
+	checkouts, err := m.vcsBackend(repoGit)
+	if err != nil {
+		return err
+	}
+	if checkouts.Name() == vcs.TypeGitWorktree && m.leasePooledWorktree(repoGit, clonePath, branchName, startPoint) {
+		return nil
+	}
+	return checkouts.AddWorkspace(clonePath, branchName, startPoint)
+}
+
+// leasePooledWorktree moves a pooled worktree to clonePath and checks out a
+// fresh branch from startPoint. Returns false (leaving nothing at clonePath)
+// if the pool is empty or the entry could not be reused.
+func (m *Manager) leasePooledWorktree(repoGit *git.Git, clonePath, branchName, startPoint string) bool {
+	fl, err := m.lockWorktreePool()
+	if err != nil {
+		return false
+	}
+	entries, _ := m.pooledWorktrees()
+	if len(entries) == 0 {
+		_ = fl.Unlock()
+		return false
+	}
+	entry := entries[0]
+	moveErr := worktree.NewManager(repoGit).Move(entry, clonePath)
+	_ = fl.Unlock()
+
+	if moveErr != nil {
+		style.PrintWarning("could not lease pooled worktree %s: %v", filepath.Base(entry), moveErr)
+		discardWorktree(repoGit, entry)
+		return false
+	}
+
+	if err := branchLeasedWorktree(git.NewGit(clonePath), branchName, startPoint); err != nil {
+		style.PrintWarning("could not reuse pooled worktree, creating a fresh one: %v", err)
+		discardWorktree(repoGit, clonePath)
+		return false
+	}
+	return true
+}
+
+// branchLeasedWorktree brings a leased worktree (which may be days old) to
+// startPoint and checks out branchName there.
+func branchLeasedWorktree(wt *git.Git, branchName, startPoint string) error {
+	if err := wt.ResetHard(startPoint); err != nil {
+		return err
+	}
+	if err := wt.CleanForce(); err != nil {
+		return err
+	}
+	return wt.CheckoutNewBranch(branchName, startPoint)
+}
+
+// recyclePooledWorktree returns a removed polecat's worktree to the rig's
+// pool instead of deleting it, if the pool has room. The worktree is reset,
+// cleaned (ignored build caches are kept, which is the point) and detached so
+// its branch is free. Returns false if the caller should remove it normally.
+func (m *Manager) recyclePooledWorktree(repoGit *git.Git, clonePath string) bool {
+	size := m.WorktreePoolSize()
+	if size <= 0 || m.WorktreePoolReady() >= size {
+		return false
+	}
+
+	wt := git.NewGit(clonePath)
+	if err := wt.ResetHard("HEAD"); err != nil {
+		return false
+	}
+	if err := wt.CleanForce(); err != nil {
+		return false
+	}
+	if err := wt.Checkout("--detach"); err != nil {
+		return false
+	}
+	// CleanForce keeps .runtime/; it holds the old polecat's session state.
+	_ = os.RemoveAll(filepath.Join(clonePath, ".runtime"))
+
+	fl, err := m.lockWorktreePool()
+	if err != nil {
+		return false
+	}
+	defer func() { _ = fl.Unlock() }()
+
+	if entries, _ := m.pooledWorktrees(); len(entries) >= size {
+		return false
+	}
+	if err := os.MkdirAll(m.worktreePoolDir(), 0755); err != nil {
+		return false
+	}
+	return worktree.NewManager(repoGit).Move(clonePath, filepath.Join(m.worktreePoolDir(), newPoolEntryName())) == nil
+}
+
+// FillWorktreePool brings the rig's pool to its configured size: it checks out
+// missing entries detached at origin/<default_branch> and removes entries
+// beyond the size (all of them if the pool is disabled). Meant to run during
+// idle time; spawns lease from the pool without waiting on it.
+// Returns the number of entries added and removed.
+func (m *Manager) FillWorktreePool() (added, removed int, err error) {
+	size := m.WorktreePoolSize()
+
+	repoGit, err := m.repoBase()
+	if err != nil {
+		return 0, 0, fmt.Errorf("finding repo base: %w", err)
+	}
+
+	removed = m.trimWorktreePool(repoGit, size)
+	if m.WorktreePoolReady() >= size {
+		return 0, removed, nil
+	}
+
+	if err := repoGit.Fetch("origin"); err != nil {
+		style.PrintWarning("could not fetch origin: %v", err)
+	}
+	startPoint := m.defaultStartPoint()
+	if err := os.MkdirAll(m.worktreePoolDir(), 0755); err != nil {
+		return 0, removed, fmt.Errorf("creating worktree pool dir: %w", err)
+	}
+
+	for m.WorktreePoolReady() < size {
+		name := newPoolEntryName()
+		staging := filepath.Join(m.worktreePoolDir(), worktreePoolStagingPrefix+name)
+		if err := worktree.NewManager(repoGit).AddDetached(staging, startPoint); err != nil {
+			discardWorktree(repoGit, staging)
+			return added, removed, fmt.Errorf("creating pooled worktree from %s: %w", startPoint, err)
+		}
+		// git worktree move refuses worktrees with submodules, so they can
+		// never be leased. Don't keep rebuilding entries nobody can use.
+		if _, err := os.Stat(filepath.Join(staging, ".gitmodules")); err == nil {
+			discardWorktree(repoGit, staging)
+			return added, removed, fmt.Errorf("worktree pool does not support repos with submodules")
+		}
+
+		fl, err := m.lockWorktreePool()
+		if err != nil {
+			discardWorktree(repoGit, staging)
+			return added, removed, err
+		}
+		err = worktree.NewManager(repoGit).Move(staging, filepath.Join(m.worktreePoolDir(), name))
+		_ = fl.Unlock()
+		if err != nil {
+			discardWorktree(repoGit, staging)
+			return added, removed, fmt.Errorf("adding worktree to pool: %w", err)
+		}
+		added++
+	}
+	return added, removed, nil
+}
+
+// trimWorktreePool removes pool entries beyond size, newest first, along with
+// staging entries left behind by an interrupted fill. Returns the number of
+// ready entries removed.
+func (m *Manager) trimWorktreePool(repoGit *git.Git, size int) int {
+	fl, err := m.lockWorktreePool()
+	if err != nil {
+		return 0
+	}
+	defer func() { _ = fl.Unlock() }()
+
+	if dirEntries, err := os.ReadDir(m.worktreePoolDir()); err == nil {
+		for _, e := range dirEntries {
+			// Staging entries older than a fill could take are abandoned.
+			if info, iErr := e.Info(); iErr == nil && strings.HasPrefix(e.Name(), worktreePoolStagingPrefix) &&
+				time.Since(info.ModTime()) > time.Hour {
+				discardWorktree(repoGit, filepath.Join(m.worktreePoolDir(), e.Name()))
+			}
+		}
+	}
+
+	entries, _ := m.pooledWorktrees()
+	removed := 0
+	for i := len(entries) - 1; i >= size && i >= 0; i-- {
+		discardWorktree(repoGit, entries[i])
+		removed++
+	}
+	return removed
+}
+
+// discardWorktree removes a worktree and its registration, best-effort.
+func discardWorktree(repoGit *git.Git, path string) {
+	worktrees := worktree.NewManager(repoGit)
+	_ = worktrees.Remove(path, true)
+	_ = os.RemoveAll(path)
+	_ = worktrees.Prune()
+}
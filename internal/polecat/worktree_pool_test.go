@@ -0,0 +1,145 @@
+package polecat
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// setupWorktreePoolTest creates a rig whose repo base is mayor/rig with an
+// origin/main ref, and a config.json with the given worktree_pool_size.
+func setupWorktreePoolTest(t *testing.T, size int) (*Manager, *git.Git) {
+	t.Helper()
+	root := t.TempDir()
+	mayorRig := filepath.Join(root, "mayor", "rig")
+	if err := os.MkdirAll(mayorRig, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "-b", "main"},
+		{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "init"},
+		{"remote", "add", "origin", mayorRig},
+		{"update-ref", "refs/remotes/origin/main", "HEAD"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = mayorRig
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	writeWorktreePoolSize(t, root, size)
+
+	m := NewManager(&rig.Rig{Name: "rig", Path: root}, git.NewGit(root), nil)
+	repoGit, err := m.repoBase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m, repoGit
+}
+
+func writeWorktreePoolSize(t *testing.T, rigPath string, size int) {
+	t.Helper()
+	data, _ := json.Marshal(rig.RigConfig{Type: "rig", Version: 1, Name: "rig", WorktreePoolSize: size})
+	if err := os.WriteFile(filepath.Join(rigPath, "config.json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWorktreePool_FillLeaseRecycle(t *testing.T) {
+	m, repoGit := setupWorktreePoolTest(t, 2)
+
+	added, removed, err := m.FillWorktreePool()
+	if err != nil {
+		t.Fatalf("FillWorktreePool: %v", err)
+	}
+	if added != 2 || removed != 0 || m.WorktreePoolReady() != 2 {
+		t.Fatalf("fill: added=%d removed=%d ready=%d, want 2/0/2", added, removed, m.WorktreePoolReady())
+	}
+
+	// Leasing hands out a pooled worktree on a fresh branch.
+	clonePath := filepath.Join(m.polecatDir("Toast"), "rig")
+	if err := os.MkdirAll(filepath.Dir(clonePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.addWorktree(repoGit, clonePath, "polecat/Toast", "origin/main"); err != nil {
+		t.Fatalf("addWorktree: %v", err)
+	}
+	if m.WorktreePoolReady() != 1 {
+		t.Errorf("ready after lease = %d, want 1", m.WorktreePoolReady())
+	}
+	if branch, err := git.NewGit(clonePath).CurrentBranch(); err != nil || branch != "polecat/Toast" {
+		t.Errorf("leased branch = %q (%v), want polecat/Toast", branch, err)
+	}
+
+	// Recycling cleans untracked work but keeps the polecat branch.
+	if err := os.WriteFile(filepath.Join(clonePath, "scratch.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !m.recyclePooledWorktree(repoGit, clonePath) {
+		t.Fatal("recyclePooledWorktree returned false with room in the pool")
+	}
+	if _, err := os.Stat(clonePath); !os.IsNotExist(err) {
+		t.Errorf("clone path still exists after recycle: %v", err)
+	}
+	entries, _ := m.pooledWorktrees()
+	if len(entries) != 2 {
+		t.Fatalf("ready after recycle = %d, want 2", len(entries))
+	}
+	for _, e := range entries {
+		if _, err := os.Stat(filepath.Join(e, "scratch.txt")); err == nil {
+			t.Errorf("recycled worktree %s kept untracked file", e)
+		}
+	}
+	if exists, _ := repoGit.RefExists("refs/heads/polecat/Toast"); !exists {
+		t.Error("recycling deleted the polecat branch")
+	}
+
+	// A full pool refuses returns; the caller removes the worktree instead.
+	if err := m.addWorktree(repoGit, clonePath, "polecat/Nux", "origin/main"); err != nil {
+		t.Fatalf("addWorktree: %v", err)
+	}
+	if _, _, err := m.FillWorktreePool(); err != nil {
+		t.Fatalf("refill: %v", err)
+	}
+	if m.recyclePooledWorktree(repoGit, clonePath) {
+		t.Error("recyclePooledWorktree accepted a worktree into a full pool")
+	}
+}
+
+func TestWorktreePool_TrimWhenDisabled(t *testing.T) {
+	m, _ := setupWorktreePoolTest(t, 2)
+	if _, _, err := m.FillWorktreePool(); err != nil {
+		t.Fatalf("FillWorktreePool: %v", err)
+	}
+
+	writeWorktreePoolSize(t, m.rig.Path, 0)
+	added, removed, err := m.FillWorktreePool()
+	if err != nil {
+		t.Fatalf("FillWorktreePool: %v", err)
+	}
+	if added != 0 || removed != 2 || m.WorktreePoolReady() != 0 {
+		t.Errorf("trim: added=%d removed=%d ready=%d, want 0/2/0", added, removed, m.WorktreePoolReady())
+	}
+}
+
+func TestWorktreePool_EmptyPoolFallsBack(t *testing.T) {
+	m, repoGit := setupWorktreePoolTest(t, 0)
+	clonePath := filepath.Join(m.polecatDir("Toast"), "rig")
+	if err := os.MkdirAll(filepath.Dir(clonePath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if m.leasePooledWorktree(repoGit, clonePath, "polecat/Toast", "origin/main") {
+		t.Fatal("leased from an empty pool")
+	}
+	if err := m.addWorktree(repoGit, clonePath, "polecat/Toast", "origin/main"); err != nil {
+		t.Fatalf("addWorktree fallback: %v", err)
+	}
+	if m.recyclePooledWorktree(repoGit, clonePath) {
+		t.Error("recycled into a disabled pool")
+	}
+}
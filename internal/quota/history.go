@@ -0,0 +1,89 @@
+package quota
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// HistoryEntry records one limit hit: an account becoming blocked in a window.
+// The history is what limit predictions are calibrated against.
+type HistoryEntry struct {
+	At       time.Time `json:"at"`
+	Account  string    `json:"account"`
+	Window   string    `json:"window"`
+	ResetsAt string    `json:"resets_at,omitempty"`
+	UsedUSD  float64   `json:"used_usd,omitempty"` // town spend in the window when the limit hit, if known
+
+	// Concurrent is the number of polecat sessions running when the limit
+	// hit, if known. Limit-aware dispatch (scheduler.limit_cap) learns its
+	// cap from it.
+	Concurrent int `json:"concurrent,omitempty"`
+
+	// Capped is the limit cap in force when the limit hit, if any. Hits at
+	// or below it don't count toward the cap.
+	Capped int `json:"capped,omitempty"`
+}
+
+// historyPath returns the path to the limit history log.
+func (m *Manager) historyPath() string {
+	return filepath.Join(m.townRoot, constants.DirMayor, constants.DirRuntime, "quota-history.jsonl")
+}
+
+// AppendHistory appends limit hits to the history log.
+func (m *Manager) AppendHistory(entries ...HistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(m.historyPath()), 0755); err != nil {
+		return fmt.Errorf("creating quota history dir: %w", err)
+	}
+	f, err := os.OpenFile(m.historyPath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644) //nolint:gosec // G302: history is not sensitive
+	if err != nil {
+		return fmt.Errorf("opening quota history: %w", err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			return fmt.Errorf("writing quota history: %w", err)
+		}
+	}
+	return nil
+}
+
+// LoadHistory reads limit hits recorded at or after since, oldest first.
+// A missing log yields no entries; malformed lines are skipped.
+func (m *Manager) LoadHistory(since time.Time) ([]HistoryEntry, error) {
+	f, err := os.Open(m.historyPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading quota history: %w", err)
+	}
+	defer f.Close()
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		if e.At.Before(since) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading quota history: %w", err)
+	}
+	return entries, nil
+}
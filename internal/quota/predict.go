@@ -0,0 +1,109 @@
+package quota
+
+import (
+	"sort"
+	"time"
+)
+
+// rateLookback is how far back spend is averaged to estimate the current
+// dispatch rate.
+const rateLookback = time.Hour
+
+// UsageSample is spend attributed to a point in time, e.g. the cost of a
+// session recorded when it ended.
+type UsageSample struct {
+	At      time.Time
+	CostUSD float64
+}
+
+// Prediction estimates when a limit window will next be hit at the current
+// dispatch rate. Spend is town-wide; capacity is calibrated from the spend
+// observed in the window each time a limit was hit before.
+type Prediction struct {
+	Window      string  `json:"window"`
+	UsedUSD     float64 `json:"used_usd"`               // spend in the trailing window
+	CapacityUSD float64 `json:"capacity_usd,omitempty"` // typical spend at past limit hits; 0 when unknown
+	Samples     int     `json:"samples"`                // past limit hits the capacity is based on
+	RatePerHour float64 `json:"rate_per_hour_usd"`      // spend over the last hour
+	RunwayUSD   float64 `json:"runway_usd,omitempty"`   // capacity left in the window
+	LimitAt     string  `json:"limit_at,omitempty"`     // RFC3339 estimate of the next limit hit
+
+	// Runway is the time left until LimitAt at the current rate; zero when
+	// no estimate is possible or the limit is already due.
+	Runway time.Duration `json:"-"`
+}
+
+// WindowDuration returns the rolling length of a limit window.
+func WindowDuration(window string) time.Duration {
+	if window == WindowWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 5 * time.Hour
+}
+
+// Predict estimates runway in window from the limit history and usage samples.
+// Without past limit hits there is no capacity to compare against, so only
+// current usage and rate are reported. Without recent spend the limit is not
+// approaching and LimitAt is left empty.
+func Predict(window string, history []HistoryEntry, usage []UsageSample, now time.Time) Prediction {
+	dur := WindowDuration(window)
+	p := Prediction{
+		Window:      window,
+		UsedUSD:     spendBetween(usage, now.Add(-dur), now),
+		RatePerHour: spendBetween(usage, now.Add(-rateLookback), now) / rateLookback.Hours(),
+	}
+
+	var capacities []float64
+	for _, h := range history {
+		if h.Window != window {
+			continue
+		}
+		used := h.UsedUSD
+		if used == 0 {
+			used = spendBetween(usage, h.At.Add(-dur), h.At)
+		}
+		if used > 0 {
+			capacities = append(capacities, used)
+		}
+	}
+	p.Samples = len(capacities)
+	if p.Samples == 0 {
+		return p
+	}
+	p.CapacityUSD = median(capacities)
+
+	p.RunwayUSD = p.CapacityUSD - p.UsedUSD
+	if p.RunwayUSD <= 0 {
+		p.RunwayUSD = 0
+		p.LimitAt = now.UTC().Format(time.RFC3339)
+		return p
+	}
+	if p.RatePerHour <= 0 {
+		return p
+	}
+	p.Runway = time.Duration(p.RunwayUSD / p.RatePerHour * float64(time.Hour))
+	p.LimitAt = now.Add(p.Runway).UTC().Format(time.RFC3339)
+	return p
+}
+
+// spendBetween sums samples in (from, to].
+func spendBetween(usage []UsageSample, from, to time.Time) float64 {
+	total := 0.0
+	for _, u := range usage {
+		if u.At.After(from) && !u.At.After(to) {
+			total += u.CostUSD
+		}
+	}
+	return total
+}
+
+// median returns the median of values, which must be non-empty.
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,116 @@
+package quota
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPredict_NoHistory(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	usage := []UsageSample{
+		{At: now.Add(-6 * time.Hour), CostUSD: 100}, // outside the 5h window
+		{At: now.Add(-2 * time.Hour), CostUSD: 4},
+		{At: now.Add(-30 * time.Minute), CostUSD: 3},
+	}
+	p := Predict(Window5Hour, nil, usage, now)
+	if p.UsedUSD != 7 || p.RatePerHour != 3 {
+		t.Errorf("used=%v rate=%v, want 7 and 3", p.UsedUSD, p.RatePerHour)
+	}
+	if p.CapacityUSD != 0 || p.LimitAt != "" {
+		t.Errorf("no history should give no capacity or estimate, got %+v", p)
+	}
+}
+
+func TestPredict_EstimatesRunway(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	history := []HistoryEntry{
+		{At: now.Add(-48 * time.Hour), Window: Window5Hour, UsedUSD: 20},
+		{At: now.Add(-24 * time.Hour), Window: Window5Hour, UsedUSD: 30},
+		{At: now.Add(-12 * time.Hour), Window: Window5Hour, UsedUSD: 25},
+		{At: now.Add(-12 * time.Hour), Window: WindowWeekly, UsedUSD: 500},
+	}
+	usage := []UsageSample{
+		{At: now.Add(-3 * time.Hour), CostUSD: 10},
+		{At: now.Add(-20 * time.Minute), CostUSD: 5},
+	}
+	p := Predict(Window5Hour, history, usage, now)
+	if p.Samples != 3 || p.CapacityUSD != 25 {
+		t.Fatalf("samples=%d capacity=%v, want 3 and the median 25", p.Samples, p.CapacityUSD)
+	}
+	if p.RunwayUSD != 10 {
+		t.Errorf("runway = %v, want 10", p.RunwayUSD)
+	}
+	if p.Runway != 2*time.Hour {
+		t.Errorf("runway duration = %v, want 2h at $5/h", p.Runway)
+	}
+	if want := now.Add(2 * time.Hour).Format(time.RFC3339); p.LimitAt != want {
+		t.Errorf("LimitAt = %q, want %q", p.LimitAt, want)
+	}
+}
+
+func TestPredict_CapacityFromUsage(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	hit := now.Add(-24 * time.Hour)
+	history := []HistoryEntry{{At: hit, Window: Window5Hour}}
+	usage := []UsageSample{
+		{At: hit.Add(-time.Hour), CostUSD: 12},
+		{At: now.Add(-10 * time.Minute), CostUSD: 15},
+	}
+	p := Predict(Window5Hour, history, usage, now)
+	if p.CapacityUSD != 12 {
+		t.Fatalf("capacity = %v, want spend before the hit", p.CapacityUSD)
+	}
+	if p.RunwayUSD != 0 || p.LimitAt != now.Format(time.RFC3339) {
+		t.Errorf("spend above capacity should predict the limit now, got %+v", p)
+	}
+}
+
+func TestPredict_IdleHasNoEstimate(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	history := []HistoryEntry{{At: now.Add(-24 * time.Hour), Window: Window5Hour, UsedUSD: 20}}
+	usage := []UsageSample{{At: now.Add(-3 * time.Hour), CostUSD: 5}}
+	p := Predict(Window5Hour, history, usage, now)
+	if math.Abs(p.RunwayUSD-15) > 1e-9 || p.LimitAt != "" {
+		t.Errorf("idle town should report runway without a limit time, got %+v", p)
+	}
+}
+
+func TestHistory_AppendAndLoad(t *testing.T) {
+	mgr := NewManager(setupTestTown(t))
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+
+	if entries, err := mgr.LoadHistory(time.Time{}); err != nil || len(entries) != 0 {
+		t.Fatalf("empty history = %v, %v", entries, err)
+	}
+	if err := mgr.AppendHistory(
+		HistoryEntry{At: now.Add(-48 * time.Hour), Account: "work", Window: Window5Hour},
+		HistoryEntry{At: now, Account: "work", Window: WindowWeekly, UsedUSD: 42},
+	); err != nil {
+		t.Fatalf("AppendHistory() error: %v", err)
+	}
+
+	entries, err := mgr.LoadHistory(now.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("LoadHistory() error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Window != WindowWeekly || entries[0].UsedUSD != 42 {
+		t.Errorf("LoadHistory(since) = %+v", entries)
+	}
+}
+
+func TestMarkWindowLimited_RecordsNewHitsOnly(t *testing.T) {
+	mgr := NewManager(setupTestTown(t))
+	for i := 0; i < 2; i++ {
+		if err := mgr.MarkWindowLimited("work", WindowWeekly, "Oct 20, 9am"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries, err := mgr.LoadHistory(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Account != "work" || entries[0].Window != WindowWeekly {
+		t.Errorf("history = %+v, want a single weekly hit", entries)
+	}
+}
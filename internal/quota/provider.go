@@ -0,0 +1,147 @@
+package quota
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// ProviderKeyPrefix marks provider-level limit state in handle lists, e.g.
+// "provider:gemini" in gt quota clear.
+const ProviderKeyPrefix = "provider:"
+
+// ProviderOf returns the provider of the account registered as handle, or
+// config.DefaultAccountProvider when it isn't registered.
+func ProviderOf(accounts *config.AccountsConfig, handle string) string {
+	if accounts != nil {
+		if acct, ok := accounts.Accounts[handle]; ok {
+			return acct.GetProvider()
+		}
+	}
+	return config.DefaultAccountProvider
+}
+
+// RecordProviderLimit marks provider as limited in window, like RecordLimit
+// does for an account. Used for sessions without a registered account.
+// The caller must hold the quota lock or call this within Update.
+func RecordProviderLimit(state *config.QuotaState, provider, window, resetsAt string, now time.Time) bool {
+	if state.Providers == nil {
+		state.Providers = make(map[string]config.AccountQuotaState)
+	}
+	next, hit := recordWindow(state.Providers[provider], window, resetsAt, now)
+	state.Providers[provider] = next
+	return hit
+}
+
+// ProviderLimited reports whether new work for provider should wait for a
+// limit to reset. A provider with tracked accounts is limited when none of
+// them is available; one without accounts follows its provider-level state.
+// Limits on other providers never count.
+func ProviderLimited(state *config.QuotaState, accounts *config.AccountsConfig, provider string, now time.Time) bool {
+	if state == nil {
+		return false
+	}
+	tracked, limited := 0, 0
+	for handle, acct := range state.Accounts {
+		if ProviderOf(accounts, handle) != provider {
+			continue
+		}
+		tracked++
+		if limitActive(acct, now) {
+			limited++
+		}
+	}
+	if tracked > 0 {
+		return limited == tracked
+	}
+	p, ok := state.Providers[provider]
+	return ok && limitActive(p, now)
+}
+
+// ProviderResetAt returns when new work for a limited provider can next
+// start: the earliest reset among its accounts, since one free account is
+// enough, or the provider-level reset. ok is false when no reset time is
+// known.
+func ProviderResetAt(state *config.QuotaState, accounts *config.AccountsConfig, provider string, now time.Time) (reset time.Time, ok bool) {
+	if state == nil {
+		return time.Time{}, false
+	}
+	candidates := make([]config.AccountQuotaState, 0, 1)
+	for handle, acct := range state.Accounts {
+		if ProviderOf(accounts, handle) == provider {
+			candidates = append(candidates, acct)
+		}
+	}
+	if len(candidates) == 0 {
+		if p, found := state.Providers[provider]; found {
+			candidates = append(candidates, p)
+		}
+	}
+	for _, acct := range candidates {
+		at, known := limitResetAt(acct, now)
+		if known && (!ok || at.Before(reset)) {
+			reset, ok = at, true
+		}
+	}
+	return reset, ok
+}
+
+// limitActive reports whether acct is limited or cooling down at now. A
+// limit whose windows have all reset no longer counts, even before
+// ClearExpired has marked it available.
+func limitActive(acct config.AccountQuotaState, now time.Time) bool {
+	switch acct.Status {
+	case config.QuotaStatusCooldown:
+		return true
+	case config.QuotaStatusLimited:
+		return !ShouldWake(acct, now)
+	}
+	return false
+}
+
+// limitResetAt returns when acct's last blocking window resets.
+func limitResetAt(acct config.AccountQuotaState, now time.Time) (time.Time, bool) {
+	if acct.Status != config.QuotaStatusLimited {
+		return time.Time{}, false
+	}
+	var latest time.Time
+	for _, w := range blockingWindows(acct) {
+		reset, err := windowReset(w, now)
+		if err != nil {
+			return time.Time{}, false
+		}
+		if reset.After(latest) {
+			latest = reset
+		}
+	}
+	return latest, !latest.IsZero()
+}
+
+// LimitedProviders returns the providers ProviderLimited reports, sorted.
+func LimitedProviders(state *config.QuotaState, accounts *config.AccountsConfig, now time.Time) []string {
+	if state == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for handle := range state.Accounts {
+		seen[ProviderOf(accounts, handle)] = true
+	}
+	for provider := range state.Providers {
+		seen[provider] = true
+	}
+	var limited []string
+	for provider := range seen {
+		if ProviderLimited(state, accounts, provider, now) {
+			limited = append(limited, provider)
+		}
+	}
+	sort.Strings(limited)
+	return limited
+}
+
+// providerKey splits a "provider:<name>" handle.
+func providerKey(handle string) (string, bool) {
+	return strings.CutPrefix(handle, ProviderKeyPrefix)
+}
@@ -0,0 +1,106 @@
+package quota
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestProviderLimited(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	accounts := &config.AccountsConfig{Accounts: map[string]config.Account{
+		"work":     {},
+		"personal": {},
+		"gem":      {Provider: "gemini"},
+	}}
+	state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+		"work":     {Status: config.QuotaStatusLimited},
+		"personal": {Status: config.QuotaStatusAvailable},
+		"gem":      {Status: config.QuotaStatusLimited},
+	}}
+
+	if ProviderLimited(state, accounts, "claude", now) {
+		t.Error("claude has an available account; should not be limited")
+	}
+	if !ProviderLimited(state, accounts, "gemini", now) {
+		t.Error("gemini's only account is limited; should be limited")
+	}
+
+	// A provider without accounts follows its provider-level state.
+	if RecordProviderLimit(state, "codex", "", "", now) != true {
+		t.Error("first provider limit should be a new hit")
+	}
+	if !ProviderLimited(state, accounts, "codex", now) {
+		t.Error("codex provider limit recorded; should be limited")
+	}
+	if got, want := LimitedProviders(state, accounts, now), []string{"codex", "gemini"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("LimitedProviders() = %v, want %v", got, want)
+	}
+
+	// Provider-level state doesn't override a provider with accounts.
+	RecordProviderLimit(state, "claude", "", "", now)
+	if ProviderLimited(state, accounts, "claude", now) {
+		t.Error("claude provider limit should not hide its available account")
+	}
+
+	markAvailable(state, ProviderKeyPrefix+"codex")
+	if ProviderLimited(state, accounts, "codex", now) {
+		t.Error("clearing provider:codex should lift its limit")
+	}
+}
+
+func TestProviderResetAt(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	limitedAt := now.Add(-time.Hour).Format(time.RFC3339)
+	accounts := &config.AccountsConfig{Accounts: map[string]config.Account{
+		"work":     {},
+		"personal": {},
+	}}
+	state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+		"work":     {Status: config.QuotaStatusLimited, LimitedAt: limitedAt, ResetsAt: "5pm (UTC)"},
+		"personal": {Status: config.QuotaStatusLimited, LimitedAt: limitedAt, ResetsAt: "2pm (UTC)"},
+	}}
+
+	reset, ok := ProviderResetAt(state, accounts, "claude", now)
+	if want := time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC); !ok || !reset.Equal(want) {
+		t.Errorf("ProviderResetAt(claude) = %v, %v; want %v (the first account back)", reset, ok, want)
+	}
+	if _, ok := ProviderResetAt(state, accounts, "gemini", now); ok {
+		t.Error("gemini has no limit state; reset should be unknown")
+	}
+
+	// Once the earlier reset passes, the provider dispatches again even
+	// before ClearExpired marks the account available.
+	later := time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC)
+	if ProviderLimited(state, accounts, "claude", later) {
+		t.Error("personal's limit has reset; claude should not be limited")
+	}
+}
+
+func TestScanResultProvider(t *testing.T) {
+	setupTestRegistry(t)
+	accounts := &config.AccountsConfig{Accounts: map[string]config.Account{
+		"gem": {ConfigDir: "/accounts/gem", Provider: "gemini"},
+	}}
+	mock := &mockTmux{
+		sessions:    []string{"gt-a", "gt-b", "gt-c"},
+		paneContent: map[string]string{"gt-a": "", "gt-b": "", "gt-c": ""},
+		envVars: map[string]map[string]string{
+			"gt-a": {"CLAUDE_CONFIG_DIR": "/accounts/gem"},
+			"gt-b": {"GT_AGENT": "codex"},
+			"gt-c": {},
+		},
+	}
+	scanner, err := NewScanner(mock, nil, accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"gt-a": "gemini", "gt-b": "codex", "gt-c": config.DefaultAccountProvider}
+	for session, provider := range want {
+		if got := scanner.ScanSession(session).Provider; got != provider {
+			t.Errorf("%s provider = %q, want %q", session, got, provider)
+		}
+	}
+}
@@ -111,6 +111,7 @@ func PlanRotation(scanner *Scanner, mgr *Manager, acctCfg *config.AccountsConfig
 	//
 	// The caller persists confirmed rate-limit state after execution.
 	available := mgr.AvailableAccounts(state)
+	sortByPriority(available, acctCfg.Accounts)
 
 	// Validate tokens for available accounts — skip accounts with expired or
 	// revoked tokens. This prevents swapping a bad token into the target's
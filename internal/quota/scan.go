@@ -17,12 +17,55 @@ type ScanResult struct {
 	Session       string    `json:"session"`                  // tmux session name
 	AccountHandle string    `json:"account_handle,omitempty"` // resolved account handle
 	ConfigDir     string    `json:"config_dir,omitempty"`     // CLAUDE_CONFIG_DIR (even if account unknown)
-	RateLimited   bool      `json:"rate_limited"`             // whether hard rate-limit was detected
+	RateLimited   bool      `json:"rate_limited"`             // whether a hard rate-limit was detected with confidence
 	NearLimit     bool      `json:"near_limit"`               // whether approaching-limit signal was detected
 	MatchedLine   string    `json:"matched_line,omitempty"`   // the line that matched (hard or warning)
 	ResetsAt      string    `json:"resets_at,omitempty"`      // parsed reset time if available
+	Window        string    `json:"window,omitempty"`         // limit window hit (Window5Hour, WindowWeekly)
+	Agent         string    `json:"agent,omitempty"`          // GT_AGENT of the session, if set
+	Provider      string    `json:"provider,omitempty"`       // account's provider, else the agent's
+
+	// Source records where a hard limit was detected: SourcePane or
+	// SourceTranscript.
+	Source string `json:"source,omitempty"`
+
+	// Confidence scores a hard limit from 0 to 1 (see ConfidenceHigh and
+	// friends). MatchedEntry holds the raw transcript entry that matched or
+	// confirmed it, when there is one.
+	Confidence   float64 `json:"confidence,omitempty"`
+	MatchedEntry string  `json:"matched_entry,omitempty"`
 }
 
+// Where a rate limit was detected.
+const (
+	SourcePane       = "pane"       // the session's tmux pane
+	SourceTranscript = "transcript" // the last entry of the session's Claude transcript
+)
+
+// Confidence levels for a detected hard limit.
+const (
+	// ConfidenceHigh: a structured API error entry in the transcript, either
+	// on its own or confirming a pane match.
+	ConfidenceHigh = 1.0
+	// ConfidenceMedium: a pane line that leads with the limit message, as
+	// Claude Code renders it.
+	ConfidenceMedium = 0.7
+	// ConfidenceLow: the limit message appears mid-line in the pane, as it
+	// would in code or prose an agent is writing.
+	ConfidenceLow = 0.4
+)
+
+// MinLimitConfidence is the lowest confidence at which a match counts as a
+// hard limit. A weaker match is still reported (Source, Confidence and
+// MatchedLine are set) but leaves RateLimited false, so it never records a
+// limit, rotates an account or wakes a session.
+const MinLimitConfidence = ConfidenceMedium
+
+// paneLinePrefix holds the TUI decorations Claude Code draws before a
+// message in the pane: bullets, tree connectors, and the numbering of
+// prompt options.
+const paneLinePrefix = " \t⎿⏺●│└├╰❯>*•·-.0123456789"
+
 // TmuxClient is the interface for tmux operations needed by the scanner.
 // This allows testing without a real tmux server.
 type TmuxClient interface {
@@ -37,6 +80,7 @@ type Scanner struct {
 	patterns        []*regexp.Regexp // hard rate-limit patterns
 	warningPatterns []*regexp.Regexp // near-limit warning patterns
 	accounts        *config.AccountsConfig
+	townRoot        string // for resolving custom agents to providers
 }
 
 // NewScanner creates a scanner with the given tmux client and rate-limit patterns.
@@ -116,6 +160,11 @@ func (s *Scanner) ScanAll() ([]ScanResult, error) {
 	return results, nil
 }
 
+// ScanSession scans one tmux session for rate-limit and near-limit indicators.
+func (s *Scanner) ScanSession(session string) ScanResult {
+	return s.scanSession(session)
+}
+
 // scanSession examines a single tmux session for rate-limit and near-limit indicators.
 func (s *Scanner) scanSession(session string) ScanResult {
 	result := ScanResult{Session: session}
@@ -134,6 +183,7 @@ func (s *Scanner) scanSession(session string) ScanResult {
 
 	// Derive account from CLAUDE_CONFIG_DIR
 	result.AccountHandle = s.resolveAccountHandle(session)
+	result.Agent, result.Provider = s.resolveProvider(session, result.AccountHandle)
 
 	// Capture pane content
 	content, err := s.tmux.CapturePane(session, scanLines)
@@ -153,20 +203,50 @@ func (s *Scanner) scanSession(session string) ScanResult {
 	bottomLines := allLines[start:]
 
 	// Check hard rate-limit patterns first
+	var suspect *ScanResult
 	for _, line := range bottomLines {
 		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
 		}
 		for _, re := range s.patterns {
-			if re.MatchString(line) {
-				result.RateLimited = true
-				result.MatchedLine = line
-				result.ResetsAt = parseResetTime(line)
-				return result
+			if !re.MatchString(line) {
+				continue
+			}
+			match := result
+			match.MatchedLine = line
+			match.ResetsAt = parseResetTime(line)
+			match.Window = DetectWindow(line)
+			match.Source = SourcePane
+			match.Confidence = s.paneConfidence(line)
+			// A matching API error in the transcript confirms the pane.
+			confirm := ScanResult{ConfigDir: result.ConfigDir}
+			if s.checkTranscript(session, &confirm) {
+				match.Confidence = ConfidenceHigh
+				match.MatchedEntry = confirm.MatchedEntry
 			}
+			if match.Confidence >= MinLimitConfidence {
+				match.RateLimited = true
+				return match
+			}
+			// Most likely the message quoted in code or prose: keep the
+			// first one for reporting and look on for a real limit.
+			if suspect == nil {
+				suspect = &match
+			}
+			break
 		}
 	}
+	if suspect != nil {
+		// The transcript was already checked and holds no limit.
+		return *suspect
+	}
+
+	// The pane may have scrolled past the limit message or redrawn; the
+	// transcript under the session's config dir still records it.
+	if s.checkTranscript(session, &result) {
+		return result
+	}
 
 	// No hard limit detected — check near-limit warning patterns
 	if len(s.warningPatterns) > 0 {
@@ -188,6 +268,59 @@ func (s *Scanner) scanSession(session string) ScanResult {
 	return result
 }
 
+// Suspect reports whether r matched a hard limit pattern with too little
+// confidence to count as rate-limited (see MinLimitConfidence).
+func (r ScanResult) Suspect() bool {
+	return !r.RateLimited && r.Source != ""
+}
+
+// paneConfidence scores a pane line that matched a hard pattern: a line
+// that starts with the limit message (after TUI decorations) reads like
+// Claude Code's own output; one that merely contains it reads like prose.
+func (s *Scanner) paneConfidence(line string) float64 {
+	start := -1
+	for _, re := range s.patterns {
+		if loc := re.FindStringIndex(line); loc != nil && (start < 0 || loc[0] < start) {
+			start = loc[0]
+		}
+	}
+	if start >= 0 && strings.Trim(line[:start], paneLinePrefix) == "" {
+		return ConfidenceMedium
+	}
+	return ConfidenceLow
+}
+
+// WithTownRoot resolves custom agent names (GT_AGENT) through the town's
+// agent config when assigning sessions to providers.
+func (s *Scanner) WithTownRoot(townRoot string) *Scanner {
+	s.townRoot = townRoot
+	return s
+}
+
+// resolveProvider returns the session's agent (GT_AGENT) and its provider.
+func (s *Scanner) resolveProvider(session, handle string) (agent, provider string) {
+	if a, err := s.tmux.GetEnvironment(session, "GT_AGENT"); err == nil {
+		agent = strings.TrimSpace(a)
+	}
+	return agent, s.Provider(agent, handle)
+}
+
+// Provider returns the provider a session's limits count against: the
+// account's provider when handle is a registered account, otherwise the
+// agent's (claude when the agent is unknown).
+func (s *Scanner) Provider(agent, handle string) string {
+	if handle != "" {
+		return ProviderOf(s.accounts, handle)
+	}
+	switch {
+	case agent == "":
+		return config.DefaultAccountProvider
+	case s.townRoot != "":
+		return config.ResolveAgentProvider(agent, s.townRoot, "")
+	}
+	return agent // built-in presets are named after their provider
+}
+
 // resolveAccountHandle maps a session's active account back to a handle.
 // Checks GT_QUOTA_ACCOUNT first (set by keychain swap rotation), then
 // falls back to matching CLAUDE_CONFIG_DIR against registered accounts.
@@ -212,14 +345,21 @@ func (s *Scanner) resolveAccountHandle(session string) string {
 		return "" // No CLAUDE_CONFIG_DIR = using default config
 	}
 
-	configDir = strings.TrimSpace(configDir)
-	for handle, acct := range s.accounts.Accounts {
+	return AccountForConfigDir(s.accounts, strings.TrimSpace(configDir))
+}
+
+// AccountForConfigDir returns the handle of the registered account whose
+// config dir is configDir, or "" if none matches.
+func AccountForConfigDir(accounts *config.AccountsConfig, configDir string) string {
+	if accounts == nil || configDir == "" {
+		return ""
+	}
+	for handle, acct := range accounts.Accounts {
 		// Compare normalized paths (accounts may use ~/... while tmux has expanded)
 		if acct.ConfigDir == configDir || util.ExpandHome(acct.ConfigDir) == configDir {
 			return handle
 		}
 	}
-
 	return "" // CLAUDE_CONFIG_DIR doesn't match any registered account
 }
 
@@ -137,6 +137,9 @@ func TestScanAll_DetectsRateLimited(t *testing.T) {
 	if mayor.ResetsAt != "7pm (America/Los_Angeles)" {
 		t.Errorf("expected resets at '7pm (America/Los_Angeles)', got %q", mayor.ResetsAt)
 	}
+	if mayor.Window != Window5Hour {
+		t.Errorf("expected window %q, got %q", Window5Hour, mayor.Window)
+	}
 
 	// gt-crew-bear should NOT be rate-limited
 	crew := resultMap["gt-crew-bear"]
@@ -0,0 +1,53 @@
+package quota
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Snooze suppresses limit wakes and scheduler dispatch for d, replacing any
+// existing snooze. Returns when the snooze expires.
+func (m *Manager) Snooze(d time.Duration, reason, by string) (time.Time, error) {
+	if d <= 0 {
+		return time.Time{}, fmt.Errorf("snooze duration must be positive, got %s", d)
+	}
+	until := time.Now().Add(d).UTC().Truncate(time.Second)
+	_, err := m.Update(func(state *config.QuotaState) (bool, error) {
+		state.Snooze = &config.QuotaSnooze{
+			Until:  until.Format(time.RFC3339),
+			Reason: reason,
+			By:     by,
+		}
+		return true, nil
+	})
+	return until, err
+}
+
+// Unsnooze clears the snooze. Returns false if none was active.
+func (m *Manager) Unsnooze() (bool, error) {
+	active := false
+	_, err := m.Update(func(state *config.QuotaState) (bool, error) {
+		if state.Snooze == nil {
+			return false, nil
+		}
+		_, active = SnoozedUntil(state, time.Now())
+		state.Snooze = nil
+		return true, nil
+	})
+	return active, err
+}
+
+// SnoozedUntil reports whether state is snoozed at now, and until when.
+// An expired or unparseable snooze is not active.
+func SnoozedUntil(state *config.QuotaState, now time.Time) (time.Time, bool) {
+	if state == nil || state.Snooze == nil {
+		return time.Time{}, false
+	}
+	until, err := time.Parse(time.RFC3339, state.Snooze.Until)
+	if err != nil || !now.Before(until) {
+		return time.Time{}, false
+	}
+	return until, true
+}
@@ -0,0 +1,62 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestSnoozeAndUnsnooze(t *testing.T) {
+	townRoot := setupTestTown(t)
+	mgr := NewManager(townRoot)
+
+	until, err := mgr.Snooze(30*time.Minute, "demo", "overseer")
+	if err != nil {
+		t.Fatalf("Snooze() error: %v", err)
+	}
+
+	state, err := mgr.Load()
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	got, ok := SnoozedUntil(state, time.Now())
+	if !ok || !got.Equal(until) {
+		t.Errorf("SnoozedUntil = %v, %v; want %v, true", got, ok, until)
+	}
+	if state.Snooze.Reason != "demo" || state.Snooze.By != "overseer" {
+		t.Errorf("snooze = %+v", state.Snooze)
+	}
+	if _, ok := SnoozedUntil(state, until); ok {
+		t.Error("snooze still active at its expiry")
+	}
+
+	cleared, err := mgr.Unsnooze()
+	if err != nil || !cleared {
+		t.Fatalf("Unsnooze() = %v, %v; want true, nil", cleared, err)
+	}
+	if state, _ := mgr.Load(); state.Snooze != nil {
+		t.Errorf("snooze not cleared: %+v", state.Snooze)
+	}
+	if cleared, _ := mgr.Unsnooze(); cleared {
+		t.Error("Unsnooze() with no snooze reported true")
+	}
+
+	if _, err := mgr.Snooze(0, "", ""); err == nil {
+		t.Error("Snooze(0) succeeded")
+	}
+}
+
+func TestSnoozedUntil_Invalid(t *testing.T) {
+	now := time.Now()
+	for _, s := range []*config.QuotaState{
+		nil,
+		{},
+		{Snooze: &config.QuotaSnooze{Until: "soon"}},
+		{Snooze: &config.QuotaSnooze{Until: now.Add(-time.Minute).Format(time.RFC3339)}},
+	} {
+		if _, ok := SnoozedUntil(s, now); ok {
+			t.Errorf("SnoozedUntil(%+v) active", s)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package quota
+
+import (
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// RecordStall notes that r's session stopped at a usage limit, keeping the
+// time it was first recorded across repeated hits. Results without a session
+// are ignored. The caller must hold the quota lock or call this within Update.
+func RecordStall(state *config.QuotaState, r ScanResult, now time.Time) {
+	if r.Session == "" || !r.RateLimited {
+		return
+	}
+	if state.Stalled == nil {
+		state.Stalled = make(map[string]config.StalledSession)
+	}
+	since := now.UTC().Format(time.RFC3339)
+	if prev, ok := state.Stalled[r.Session]; ok && prev.Since != "" {
+		since = prev.Since
+	}
+	state.Stalled[r.Session] = config.StalledSession{
+		Account:  r.AccountHandle,
+		Provider: r.Provider,
+		Window:   r.Window,
+		ResetsAt: r.ResetsAt,
+		Since:    since,
+	}
+}
+
+// ClearStalls forgets the recorded stalls of sessions. Returns whether any
+// were recorded. The caller must hold the quota lock or call this within
+// Update.
+func ClearStalls(state *config.QuotaState, sessions ...string) bool {
+	cleared := false
+	for _, s := range sessions {
+		if _, ok := state.Stalled[s]; ok {
+			delete(state.Stalled, s)
+			cleared = true
+		}
+	}
+	if len(state.Stalled) == 0 {
+		state.Stalled = nil
+	}
+	return cleared
+}
+
+// StalledResults returns the recorded stalls as rate-limited scan results,
+// sorted by session, for sessions that can't be scanned directly.
+func StalledResults(state *config.QuotaState) []ScanResult {
+	if state == nil {
+		return nil
+	}
+	results := make([]ScanResult, 0, len(state.Stalled))
+	for session, s := range state.Stalled {
+		results = append(results, ScanResult{
+			Session:       session,
+			AccountHandle: s.Account,
+			Provider:      s.Provider,
+			Window:        s.Window,
+			ResetsAt:      s.ResetsAt,
+			RateLimited:   true,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Session < results[j].Session })
+	return results
+}
+
+// StalledSince returns when session's stall was first recorded.
+func StalledSince(state *config.QuotaState, session string) (time.Time, bool) {
+	if state == nil {
+		return time.Time{}, false
+	}
+	s, ok := state.Stalled[session]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s.Since)
+	return t, err == nil
+}
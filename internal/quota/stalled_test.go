@@ -0,0 +1,36 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestRecordStall(t *testing.T) {
+	state := &config.QuotaState{}
+	first := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	r := ScanResult{Session: "gt-gastown-Toast", AccountHandle: "work", Window: Window5Hour, ResetsAt: "7pm", RateLimited: true}
+	RecordStall(state, r, first)
+	RecordStall(state, r, first.Add(time.Hour)) // Repeated hit keeps the first time
+	RecordStall(state, ScanResult{Session: "gt-gastown-Nux"}, first)
+	RecordStall(state, ScanResult{RateLimited: true}, first)
+
+	if len(state.Stalled) != 1 {
+		t.Fatalf("Stalled = %v, want only the limited session", state.Stalled)
+	}
+	if since, ok := StalledSince(state, "gt-gastown-Toast"); !ok || !since.Equal(first) {
+		t.Errorf("StalledSince = %v, %v; want %v", since, ok, first)
+	}
+	got := StalledResults(state)
+	if len(got) != 1 || got[0].AccountHandle != "work" || got[0].ResetsAt != "7pm" || !got[0].RateLimited {
+		t.Errorf("StalledResults = %+v", got)
+	}
+
+	if ClearStalls(state, "gt-gastown-Nux") {
+		t.Error("ClearStalls reported an unrecorded session")
+	}
+	if !ClearStalls(state, "gt-gastown-Toast") || state.Stalled != nil {
+		t.Errorf("ClearStalls left %v", state.Stalled)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -111,50 +112,99 @@ func (m *Manager) SaveUnlocked(state *config.QuotaState) error {
 	return atomicfile.EnsureDirAndWriteJSON(m.statePath(), state)
 }
 
-// MarkLimited marks an account as rate-limited with an optional reset time.
+// Update performs an atomic read-modify-write of quota state. The lock is
+// held while fn mutates a freshly loaded state; the state is written only
+// when fn reports a change. Concurrent sessions (e.g. two Stop hooks
+// recording limits) therefore apply their changes in turn instead of
+// overwriting each other with stale snapshots. Returns the resulting state.
+func (m *Manager) Update(fn func(state *config.QuotaState) (changed bool, err error)) (*config.QuotaState, error) {
+	var result *config.QuotaState
+	err := m.WithLock(func() error {
+		state, err := m.Load()
+		if err != nil {
+			return err
+		}
+		changed, err := fn(state)
+		if err != nil {
+			return err
+		}
+		result = state
+		if !changed {
+			return nil
+		}
+		return m.SaveUnlocked(state)
+	})
+	return result, err
+}
+
+// MarkLimited marks an account as rate-limited in the 5-hour window with an
+// optional reset time.
 func (m *Manager) MarkLimited(handle string, resetsAt string) error {
-	unlock, err := m.lock()
-	if err != nil {
-		return err
-	}
-	defer unlock()
+	return m.MarkWindowLimited(handle, Window5Hour, resetsAt)
+}
 
-	state, err := m.Load()
-	if err != nil {
+// MarkWindowLimited marks an account as rate-limited in the named window
+// (Window5Hour, WindowWeekly). Other windows already blocking the account
+// are kept, so it stays limited until all of them have reset. New limit
+// hits are appended to the limit history.
+func (m *Manager) MarkWindowLimited(handle, window, resetsAt string) error {
+	now := time.Now()
+	hit := false
+	_, err := m.Update(func(state *config.QuotaState) (bool, error) {
+		hit = RecordLimit(state, handle, window, resetsAt, now)
+		return true, nil
+	})
+	if err != nil || !hit {
 		return err
 	}
-
-	now := time.Now().UTC().Format(time.RFC3339)
-	state.Accounts[handle] = config.AccountQuotaState{
-		Status:    config.QuotaStatusLimited,
-		LimitedAt: now,
-		ResetsAt:  resetsAt,
-		LastUsed:  state.Accounts[handle].LastUsed,
+	if window == "" {
+		window = Window5Hour
 	}
-
-	return atomicfile.EnsureDirAndWriteJSON(m.statePath(), state)
+	return m.AppendHistory(HistoryEntry{At: now.UTC(), Account: handle, Window: window, ResetsAt: resetsAt})
 }
 
-// MarkAvailable marks an account as available (not rate-limited).
+// MarkAvailable marks an account as available (not rate-limited). A
+// "provider:<name>" handle clears that provider's limit instead.
 func (m *Manager) MarkAvailable(handle string) error {
-	unlock, err := m.lock()
-	if err != nil {
-		return err
-	}
-	defer unlock()
+	_, err := m.Update(func(state *config.QuotaState) (bool, error) {
+		markAvailable(state, handle)
+		return true, nil
+	})
+	return err
+}
 
-	state, err := m.Load()
-	if err != nil {
-		return err
-	}
+// ClearLimited marks every limited or cooling-down account available, and
+// drops provider-level limits, in a single locked update. Returns the handles
+// that were cleared (providers as "provider:<name>").
+func (m *Manager) ClearLimited() ([]string, error) {
+	var cleared []string
+	_, err := m.Update(func(state *config.QuotaState) (bool, error) {
+		for handle, acctState := range state.Accounts {
+			if acctState.Status == config.QuotaStatusLimited || acctState.Status == config.QuotaStatusCooldown {
+				markAvailable(state, handle)
+				cleared = append(cleared, handle)
+			}
+		}
+		for provider := range state.Providers {
+			cleared = append(cleared, ProviderKeyPrefix+provider)
+		}
+		state.Providers = nil
+		return len(cleared) > 0, nil
+	})
+	sort.Strings(cleared)
+	return cleared, err
+}
 
-	existing := state.Accounts[handle]
+// markAvailable clears every limit window on handle, preserving LastUsed.
+func markAvailable(state *config.QuotaState, handle string) {
+	if provider, ok := providerKey(handle); ok {
+		delete(state.Providers, provider)
+		return
+	}
 	state.Accounts[handle] = config.AccountQuotaState{
 		Status:   config.QuotaStatusAvailable,
-		LastUsed: existing.LastUsed,
+		LastUsed: state.Accounts[handle].LastUsed,
 	}
-
-	return atomicfile.EnsureDirAndWriteJSON(m.statePath(), state)
 }
 
 // AvailableAccounts returns account handles that are not rate-limited,
@@ -196,16 +246,28 @@ func sortByLastUsed(handles []string, state *config.QuotaState) {
 	}
 }
 
+// sortByPriority stably sorts handles by account priority (lower first),
+// preserving the existing order among accounts of equal priority.
+func sortByPriority(handles []string, accounts map[string]config.Account) {
+	sort.SliceStable(handles, func(i, j int) bool {
+		return accounts[handles[i]].Priority < accounts[handles[j]].Priority
+	})
+}
+
 // EnsureAccountsTracked adds any registered accounts that are missing from
 // quota state. Called during scan to keep state in sync with accounts.json.
-func (m *Manager) EnsureAccountsTracked(state *config.QuotaState, accounts map[string]config.Account) {
+// Returns the number of accounts added.
+func (m *Manager) EnsureAccountsTracked(state *config.QuotaState, accounts map[string]config.Account) int {
+	added := 0
 	for handle := range accounts {
 		if _, exists := state.Accounts[handle]; !exists {
 			state.Accounts[handle] = config.AccountQuotaState{
 				Status: config.QuotaStatusAvailable,
 			}
+			added++
 		}
 	}
+	return added
 }
 
 // RecordSwap records a keychain swap mapping in quota state.
@@ -239,8 +301,9 @@ func ResolveSwapSourceDirs(activeSwaps map[string]string, accounts map[string]co
 	return resolved
 }
 
-// ClearExpired checks all limited accounts and marks them available if their
-// ResetsAt time has passed. Returns the number of accounts cleared.
+// ClearExpired checks all limited accounts and providers and marks them
+// available once every blocking limit window has reset (see ShouldWake).
+// Returns the number cleared.
 // The caller is responsible for persisting state if changes were made.
 func (m *Manager) ClearExpired(state *config.QuotaState) int {
 	return clearExpiredAt(m, state, time.Now())
@@ -250,21 +313,14 @@ func (m *Manager) ClearExpired(state *config.QuotaState) int {
 func clearExpiredAt(_ *Manager, state *config.QuotaState, now time.Time) int {
 	cleared := 0
 	for handle, acctState := range state.Accounts {
-		if acctState.Status != config.QuotaStatusLimited {
-			continue
-		}
-		if acctState.ResetsAt == "" {
-			continue
-		}
-		resetTime, err := ParseResetTime(acctState.ResetsAt, now)
-		if err != nil {
-			continue // can't parse — leave as-is
+		if ShouldWake(acctState, now) {
+			markAvailable(state, handle)
+			cleared++
 		}
-		if now.After(resetTime) {
-			state.Accounts[handle] = config.AccountQuotaState{
-				Status:   config.QuotaStatusAvailable,
-				LastUsed: acctState.LastUsed,
-			}
+	}
+	for provider, p := range state.Providers {
+		if ShouldWake(p, now) {
+			delete(state.Providers, provider)
 			cleared++
 		}
 	}
@@ -274,6 +330,10 @@ func clearExpiredAt(_ *Manager, state *config.QuotaState, now time.Time) int {
 // parseResetTimePattern matches formats like "7pm", "11am", "3:30pm", "7:00pm"
 var parseResetTimePattern = regexp.MustCompile(`(?i)^(\d{1,2})(?::(\d{2}))?\s*(am|pm)\b`)
 
+// parseResetDatePattern matches a leading calendar date on weekly resets,
+// like "Oct 20, " or "October 20 at ".
+var parseResetDatePattern = regexp.MustCompile(`(?i)^([a-z]{3,9})\s+(\d{1,2}),?\s+(?:at\s+)?`)
+
 // ParseResetTime parses a human-readable reset time string into a time.Time.
 // Supported formats:
 //
@@ -281,8 +341,10 @@ var parseResetTimePattern = regexp.MustCompile(`(?i)^(\d{1,2})(?::(\d{2}))?\s*(a
 //	"11am (America/Los_Angeles)" → today at 11am in that timezone
 //	"3:30pm (America/Los_Angeles)" → today at 3:30pm in that timezone
 //	"7pm" → today at 7pm in local timezone
+//	"Oct 20, 9am (America/Los_Angeles)" → Oct 20 at 9am in that timezone
 //
-// The reference time is used to determine "today".
+// The reference time is used to determine "today", and the year of dated
+// resets (the next Oct 20 on or after the reference day).
 func ParseResetTime(resetsAt string, reference time.Time) (time.Time, error) {
 	resetsAt = strings.TrimSpace(resetsAt)
 
@@ -300,6 +362,20 @@ func ParseResetTime(resetsAt string, reference time.Time) (time.Time, error) {
 		}
 	}
 
+	// Strip an optional date: "Oct 20, 9am"
+	var month time.Month
+	day := 0
+	if dm := parseResetDatePattern.FindStringSubmatch(resetsAt); dm != nil {
+		name := strings.ToUpper(dm[1][:1]) + strings.ToLower(dm[1][1:3])
+		parsed, err := time.Parse("Jan", name)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cannot parse reset date: %q", resetsAt)
+		}
+		month = parsed.Month()
+		fmt.Sscanf(dm[2], "%d", &day)
+		resetsAt = resetsAt[len(dm[0]):]
+	}
+
 	// Parse the time portion: "7pm", "11am", "3:30pm"
 	m := parseResetTimePattern.FindStringSubmatch(resetsAt)
 	if len(m) < 4 {
@@ -320,10 +396,17 @@ func ParseResetTime(resetsAt string, reference time.Time) (time.Time, error) {
 		hour = 0
 	}
 
-	// Build the reset time using today's date in the target timezone
+	// Build the reset time using today's date (or the given date) in the
+	// target timezone
 	refInLoc := reference.In(loc)
-	resetTime := time.Date(refInLoc.Year(), refInLoc.Month(), refInLoc.Day(),
-		hour, minute, 0, 0, loc)
-
+	if day == 0 {
+		return time.Date(refInLoc.Year(), refInLoc.Month(), refInLoc.Day(),
+			hour, minute, 0, 0, loc), nil
+	}
+	resetTime := time.Date(refInLoc.Year(), month, day, hour, minute, 0, 0, loc)
+	today := time.Date(refInLoc.Year(), refInLoc.Month(), refInLoc.Day(), 0, 0, 0, 0, loc)
+	if resetTime.Before(today) {
+		resetTime = resetTime.AddDate(1, 0, 0) // "Jan 2" seen in late December
+	}
 	return resetTime, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -197,6 +198,25 @@ func TestAvailableAccounts(t *testing.T) {
 	}
 }
 
+func TestSortByPriority(t *testing.T) {
+	accounts := map[string]config.Account{
+		"a": {Priority: 2},
+		"b": {},
+		"c": {Priority: 1},
+		"d": {},
+	}
+	// Already in LRU order; equal priorities must keep it.
+	handles := []string{"d", "a", "b", "c"}
+	sortByPriority(handles, accounts)
+
+	want := []string{"d", "b", "c", "a"}
+	for i := range want {
+		if handles[i] != want[i] {
+			t.Fatalf("sortByPriority = %v, want %v", handles, want)
+		}
+	}
+}
+
 func TestSortByLastUsed_EmptyStrings(t *testing.T) {
 	state := &config.QuotaState{
 		Accounts: map[string]config.AccountQuotaState{
@@ -534,6 +554,33 @@ func TestParseResetTime_NoTimezone(t *testing.T) {
 	}
 }
 
+func TestParseResetTime_WithDate(t *testing.T) {
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	ref := time.Date(2026, 10, 16, 15, 0, 0, 0, la)
+
+	got, err := ParseResetTime("Oct 20, 9am (America/Los_Angeles)", ref)
+	if err != nil {
+		t.Fatalf("ParseResetTime() error: %v", err)
+	}
+	if want := time.Date(2026, 10, 20, 9, 0, 0, 0, la); !got.Equal(want) {
+		t.Errorf("ParseResetTime() = %v, want %v", got, want)
+	}
+
+	// A January date seen in December resets next year.
+	dec := time.Date(2026, 12, 30, 15, 0, 0, 0, la)
+	got, err = ParseResetTime("January 2 at 9am (America/Los_Angeles)", dec)
+	if err != nil {
+		t.Fatalf("ParseResetTime() error: %v", err)
+	}
+	if want := time.Date(2027, 1, 2, 9, 0, 0, 0, la); !got.Equal(want) {
+		t.Errorf("ParseResetTime() = %v, want %v", got, want)
+	}
+
+	if _, err := ParseResetTime("Foo 2, 9am", ref); err == nil {
+		t.Error("expected error for unknown month")
+	}
+}
+
 func TestParseResetTime_InvalidInput(t *testing.T) {
 	ref := time.Now()
 	_, err := ParseResetTime("garbage", ref)
@@ -607,3 +654,108 @@ func TestClearExpired_NoResetsAt(t *testing.T) {
 		t.Errorf("expected no_reset to remain limited")
 	}
 }
+
+func TestUpdate_SavesOnlyWhenChanged(t *testing.T) {
+	townRoot := setupTestTown(t)
+	mgr := NewManager(townRoot)
+
+	state, err := mgr.Update(func(s *config.QuotaState) (bool, error) {
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("Update() should return the loaded state")
+	}
+	if _, err := os.Stat(mgr.statePath()); !os.IsNotExist(err) {
+		t.Errorf("unchanged update should not write state file (stat err: %v)", err)
+	}
+
+	if _, err := mgr.Update(func(s *config.QuotaState) (bool, error) {
+		s.Accounts["work"] = config.AccountQuotaState{Status: config.QuotaStatusLimited}
+		return true, nil
+	}); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	loaded, err := mgr.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Accounts["work"].Status != config.QuotaStatusLimited {
+		t.Errorf("expected change to persist, got %+v", loaded.Accounts["work"])
+	}
+}
+
+func TestUpdate_PropagatesError(t *testing.T) {
+	townRoot := setupTestTown(t)
+	mgr := NewManager(townRoot)
+
+	sentinel := fmt.Errorf("test error")
+	_, err := mgr.Update(func(s *config.QuotaState) (bool, error) {
+		s.Accounts["work"] = config.AccountQuotaState{Status: config.QuotaStatusLimited}
+		return true, sentinel
+	})
+	if err != sentinel {
+		t.Errorf("expected sentinel error, got %v", err)
+	}
+	if _, err := os.Stat(mgr.statePath()); !os.IsNotExist(err) {
+		t.Error("failed update should not write state")
+	}
+}
+
+func TestMarkLimited_Concurrent(t *testing.T) {
+	townRoot := setupTestTown(t)
+	mgr := NewManager(townRoot)
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Separate managers mimic separate sessions' hooks.
+			errs <- NewManager(townRoot).MarkLimited(fmt.Sprintf("acct-%d", i), "7pm")
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("MarkLimited() error: %v", err)
+		}
+	}
+
+	state, err := mgr.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Accounts) != n {
+		t.Errorf("expected %d accounts after concurrent updates, got %d (lost writes)", n, len(state.Accounts))
+	}
+}
+
+func TestClearLimited(t *testing.T) {
+	townRoot := setupTestTown(t)
+	mgr := NewManager(townRoot)
+	if err := mgr.Save(&config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+		"a": {Status: config.QuotaStatusLimited, LastUsed: "2025-01-01T00:00:00Z"},
+		"b": {Status: config.QuotaStatusCooldown},
+		"c": {Status: config.QuotaStatusAvailable},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	cleared, err := mgr.ClearLimited()
+	if err != nil {
+		t.Fatalf("ClearLimited() error: %v", err)
+	}
+	if len(cleared) != 2 || cleared[0] != "a" || cleared[1] != "b" {
+		t.Errorf("cleared = %v, want [a b]", cleared)
+	}
+	state, _ := mgr.Load()
+	if state.Accounts["a"].Status != config.QuotaStatusAvailable || state.Accounts["a"].LastUsed == "" {
+		t.Errorf("a = %+v, want available with LastUsed preserved", state.Accounts["a"])
+	}
+}
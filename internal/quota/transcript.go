@@ -0,0 +1,184 @@
+package quota
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// WorkDirClient is implemented by tmux clients that can report a session's
+// working directory. When the scanner's client implements it, the scanner
+// also checks each session's Claude transcript for rate limits, which catches
+// limits the pane no longer shows (scrolled away, or the TUI redrew).
+type WorkDirClient interface {
+	GetPaneWorkDir(session string) (string, error)
+}
+
+// transcriptTailBytes is how much of the end of a transcript is read to find
+// its last entry.
+const transcriptTailBytes = 64 * 1024
+
+// maxMatchedEntry caps the raw transcript entry kept in ScanResult.MatchedEntry.
+const maxMatchedEntry = 1024
+
+// TranscriptPath returns the most recently modified transcript for a session
+// started in workDir under configDir, or "" if there is none.
+func TranscriptPath(configDir, workDir string) string {
+	projectDir := config.ClaudeProjectDir(configDir, workDir)
+	entries, err := os.ReadDir(projectDir)
+	if err != nil {
+		return ""
+	}
+	var latest string
+	var latestTime time.Time
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".jsonl") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		if info.ModTime().After(latestTime) {
+			latest, latestTime = filepath.Join(projectDir, e.Name()), info.ModTime()
+		}
+	}
+	return latest
+}
+
+// ConfigDirForTranscript returns the Claude config dir a transcript lives
+// under (<configDir>/projects/<project>/<session>.jsonl), or "" if path is
+// not laid out that way.
+func ConfigDirForTranscript(path string) string {
+	projectsDir := filepath.Dir(filepath.Dir(path))
+	if filepath.Base(projectsDir) != "projects" {
+		return ""
+	}
+	return filepath.Dir(projectsDir)
+}
+
+// lastTranscriptEntry returns the raw last entry of a transcript.
+// Only the last entry matters: once the session makes progress after a
+// limit, newer entries follow the limit message and it no longer applies.
+func lastTranscriptEntry(path string) string {
+	f, err := os.Open(path) //nolint:gosec // G304: path is built from a registered config dir
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return ""
+	}
+	offset := max(info.Size()-transcriptTailBytes, 0)
+	data, err := io.ReadAll(io.NewSectionReader(f, offset, info.Size()-offset))
+	if err != nil {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
+
+// transcriptErrorText extracts the message text from a transcript entry that
+// records an API error, and "" for any other entry. Claude Code records API
+// errors, including usage limits, as assistant messages flagged
+// isApiErrorMessage (or carrying an error field) whose content is a list of
+// text blocks. Ordinary messages are ignored so that an agent discussing or
+// writing rate-limit handling does not look limited.
+func transcriptErrorText(line string) string {
+	var entry struct {
+		IsAPIErrorMessage bool            `json:"isApiErrorMessage"`
+		Error             json.RawMessage `json:"error"`
+		Message           struct {
+			Content json.RawMessage `json:"content"`
+		} `json:"message"`
+	}
+	if json.Unmarshal([]byte(line), &entry) != nil || len(entry.Message.Content) == 0 {
+		return ""
+	}
+	hasError := len(entry.Error) > 0 && string(entry.Error) != "null"
+	if !entry.IsAPIErrorMessage && !hasError {
+		return ""
+	}
+
+	var text string
+	if json.Unmarshal(entry.Message.Content, &text) == nil {
+		return text
+	}
+	var blocks []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if json.Unmarshal(entry.Message.Content, &blocks) != nil {
+		return ""
+	}
+	var parts []string
+	for _, b := range blocks {
+		if b.Type == "text" && b.Text != "" {
+			parts = append(parts, b.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// checkTranscript marks result rate-limited when the last entry of the
+// session's transcript matches a hard rate-limit pattern. Returns whether it
+// did.
+func (s *Scanner) checkTranscript(session string, result *ScanResult) bool {
+	wd, ok := s.tmux.(WorkDirClient)
+	if !ok || result.ConfigDir == "" {
+		return false
+	}
+	workDir, err := wd.GetPaneWorkDir(session)
+	if err != nil || strings.TrimSpace(workDir) == "" {
+		return false
+	}
+	path := TranscriptPath(util.ExpandHome(result.ConfigDir), strings.TrimSpace(workDir))
+	if path == "" {
+		return false
+	}
+	return s.CheckTranscript(path, result)
+}
+
+// CheckTranscript marks result rate-limited when the last entry of the
+// transcript at path is an API error matching a hard rate-limit pattern.
+// Returns whether it did. Used directly by hooks, which are handed the
+// transcript path.
+func (s *Scanner) CheckTranscript(path string, result *ScanResult) bool {
+	entry := lastTranscriptEntry(path)
+	for _, line := range strings.Split(transcriptErrorText(entry), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		for _, re := range s.patterns {
+			if re.MatchString(line) {
+				result.RateLimited = true
+				result.MatchedLine = line
+				result.ResetsAt = parseResetTime(line)
+				result.Window = DetectWindow(line)
+				result.Source = SourceTranscript
+				result.Confidence = ConfidenceHigh
+				result.MatchedEntry = truncateEntry(entry)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// truncateEntry shortens a raw transcript entry for display.
+func truncateEntry(entry string) string {
+	if len(entry) <= maxMatchedEntry {
+		return entry
+	}
+	return entry[:maxMatchedEntry] + "…"
+}
@@ -0,0 +1,199 @@
+package quota
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// workDirTmux adds GetPaneWorkDir to mockTmux, enabling transcript checks.
+type workDirTmux struct {
+	mockTmux
+	workDirs map[string]string
+}
+
+func (m *workDirTmux) GetPaneWorkDir(session string) (string, error) {
+	return m.workDirs[session], nil
+}
+
+func writeTranscript(t *testing.T, configDir, workDir string, lines ...string) {
+	t.Helper()
+	dir := config.ClaudeProjectDir(configDir, workDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	for _, l := range lines {
+		data = append(data, l+"\n"...)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "session.jsonl"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScanAll_DetectsLimitInTranscript(t *testing.T) {
+	setupTestRegistry(t)
+
+	workDir := filepath.Join(t.TempDir(), "gastown", "polecats", "nux")
+	limitedDir, busyDir := t.TempDir(), t.TempDir()
+	writeTranscript(t, limitedDir, workDir,
+		`{"type":"user","message":{"role":"user","content":"fix the bug"}}`,
+		`{"type":"assistant","isApiErrorMessage":true,"message":{"role":"assistant","content":[{"type":"text","text":"You've hit your limit · resets 7pm (America/Los_Angeles)"}]}}`)
+	// A limit followed by more work no longer applies.
+	writeTranscript(t, busyDir, workDir,
+		`{"type":"assistant","isApiErrorMessage":true,"message":{"role":"assistant","content":[{"type":"text","text":"You've hit your limit · resets 7pm"}]}}`,
+		`{"type":"assistant","message":{"role":"assistant","content":[{"type":"text","text":"Resuming work."}]}}`)
+
+	tmux := &workDirTmux{
+		mockTmux: mockTmux{
+			sessions:    []string{"gt-crew-bear", "gt-witness"},
+			paneContent: map[string]string{"gt-crew-bear": "⏺ Thinking…", "gt-witness": "⏺ Thinking…"},
+			envVars: map[string]map[string]string{
+				"gt-crew-bear": {"CLAUDE_CONFIG_DIR": limitedDir},
+				"gt-witness":   {"CLAUDE_CONFIG_DIR": busyDir},
+			},
+		},
+		workDirs: map[string]string{"gt-crew-bear": workDir, "gt-witness": workDir},
+	}
+
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byName := make(map[string]ScanResult)
+	for _, r := range results {
+		byName[r.Session] = r
+	}
+	limited := byName["gt-crew-bear"]
+	if !limited.RateLimited || limited.Source != SourceTranscript {
+		t.Errorf("gt-crew-bear: RateLimited=%v Source=%q, want limited via transcript", limited.RateLimited, limited.Source)
+	}
+	if limited.ResetsAt != "7pm (America/Los_Angeles)" {
+		t.Errorf("gt-crew-bear: ResetsAt = %q", limited.ResetsAt)
+	}
+	if byName["gt-witness"].RateLimited {
+		t.Error("gt-witness: stale transcript limit reported")
+	}
+}
+
+func TestConfigDirForTranscript(t *testing.T) {
+	tests := []struct {
+		path, want string
+	}{
+		{"/home/me/.claude-accounts/work/projects/-work-gastown/abc.jsonl", "/home/me/.claude-accounts/work"},
+		{"/tmp/transcripts/abc.jsonl", ""},
+	}
+	for _, tt := range tests {
+		if got := ConfigDirForTranscript(tt.path); got != tt.want {
+			t.Errorf("ConfigDirForTranscript(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCheckTranscript_IgnoresNonErrorEntries(t *testing.T) {
+	scanner, err := NewScanner(nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configDir, workDir := t.TempDir(), "/work/gastown"
+	entries := map[string]bool{
+		// An agent writing rate-limit handling code.
+		`{"type":"assistant","message":{"role":"assistant","content":[{"type":"text","text":"Added a check for \"You've hit your limit · resets 7pm\" to the scanner."}]}}`: false,
+		// A tool result echoing the patterns file.
+		`{"type":"user","message":{"role":"user","content":[{"type":"tool_result","content":"API Error: Rate limit reached"}]}}`: false,
+		// Claude Code's own API error.
+		`{"type":"assistant","isApiErrorMessage":true,"message":{"role":"assistant","content":[{"type":"text","text":"You've hit your limit · resets 7pm"}]}}`: true,
+		`{"type":"assistant","error":"rate_limit","message":{"role":"assistant","content":[{"type":"text","text":"API Error: Rate limit reached"}]}}`:          true,
+	}
+	for entry, want := range entries {
+		writeTranscript(t, configDir, workDir, entry)
+		var r ScanResult
+		got := scanner.CheckTranscript(TranscriptPath(configDir, workDir), &r)
+		if got != want {
+			t.Errorf("CheckTranscript(%s) = %v, want %v", entry, got, want)
+		}
+		if got && (r.Confidence != ConfidenceHigh || r.MatchedEntry != entry) {
+			t.Errorf("CheckTranscript(%s): Confidence=%v MatchedEntry=%q", entry, r.Confidence, r.MatchedEntry)
+		}
+	}
+}
+
+func TestScanAll_PaneConfidence(t *testing.T) {
+	setupTestRegistry(t)
+
+	workDir := filepath.Join(t.TempDir(), "gastown", "crew", "bear")
+	confirmedDir := t.TempDir()
+	writeTranscript(t, confirmedDir, workDir,
+		`{"type":"assistant","isApiErrorMessage":true,"message":{"role":"assistant","content":[{"type":"text","text":"You've hit your limit · resets 7pm"}]}}`)
+
+	tmux := &workDirTmux{
+		mockTmux: mockTmux{
+			sessions: []string{"gt-crew-bear", "gt-crew-max", "gt-witness"},
+			paneContent: map[string]string{
+				"gt-crew-bear": "  ⎿  You've hit your limit · resets 7pm",
+				"gt-crew-max":  "  ⎿  You've hit your limit · resets 7pm",
+				"gt-witness":   `+	if strings.Contains(line, "You've hit your limit") {`,
+			},
+			envVars: map[string]map[string]string{
+				"gt-crew-bear": {"CLAUDE_CONFIG_DIR": confirmedDir},
+				"gt-crew-max":  {"CLAUDE_CONFIG_DIR": t.TempDir()},
+				"gt-witness":   {"CLAUDE_CONFIG_DIR": t.TempDir()},
+			},
+		},
+		workDirs: map[string]string{"gt-crew-bear": workDir, "gt-crew-max": workDir, "gt-witness": workDir},
+	}
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]float64{"gt-crew-bear": ConfidenceHigh, "gt-crew-max": ConfidenceMedium, "gt-witness": ConfidenceLow}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d", len(results), len(want))
+	}
+	for _, r := range results {
+		if r.Source != SourcePane {
+			t.Errorf("%s: Source = %q, want pane", r.Session, r.Source)
+		}
+		// A low-confidence match is reported but doesn't count as a limit.
+		if limited := r.Confidence >= MinLimitConfidence; r.RateLimited != limited || r.Suspect() == limited {
+			t.Errorf("%s: RateLimited=%v Suspect=%v, want limited=%v", r.Session, r.RateLimited, r.Suspect(), limited)
+		}
+		if r.Confidence != want[r.Session] {
+			t.Errorf("%s: Confidence = %v, want %v", r.Session, r.Confidence, want[r.Session])
+		}
+	}
+}
+
+func TestScanSession_LowConfidenceDoesNotMaskLimit(t *testing.T) {
+	setupTestRegistry(t)
+
+	tmux := &mockTmux{
+		sessions: []string{"gt-crew-bear"},
+		paneContent: map[string]string{
+			"gt-crew-bear": "+	if strings.Contains(line, \"You've hit your limit\") {\n  ⎿  You've hit your limit · resets 7pm",
+		},
+		envVars: map[string]map[string]string{
+			"gt-crew-bear": {"CLAUDE_CONFIG_DIR": t.TempDir()},
+		},
+	}
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := scanner.ScanSession("gt-crew-bear")
+	if !r.RateLimited || r.Confidence != ConfidenceMedium || r.ResetsAt != "7pm" {
+		t.Errorf("RateLimited=%v Confidence=%v ResetsAt=%q, want the later pane limit", r.RateLimited, r.Confidence, r.ResetsAt)
+	}
+}
@@ -0,0 +1,175 @@
+package quota
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// Limit window names. Claude subscriptions enforce a rolling 5-hour cap and
+// a weekly cap at the same time; hitting either blocks the account.
+const (
+	Window5Hour  = "5h"
+	WindowWeekly = "weekly"
+)
+
+// weeklyLimitPattern recognizes rate-limit messages for the weekly cap
+// (e.g. "You've hit your weekly limit", "Opus week limit reached").
+var weeklyLimitPattern = regexp.MustCompile(`(?i)\bweek(ly)?\b`)
+
+// DetectWindow returns the limit window a rate-limit message refers to.
+// Messages that don't name a window are attributed to the 5-hour window.
+func DetectWindow(line string) string {
+	if weeklyLimitPattern.MatchString(line) {
+		return WindowWeekly
+	}
+	return Window5Hour
+}
+
+// RecordLimit marks handle as limited in window, keeping any other windows
+// that are still blocking it and preserving LastUsed. window defaults to the
+// 5-hour window when empty. Returns true when the window was not already
+// blocking the account, i.e. this is a new limit hit rather than a repeat
+// detection of the same one.
+// The caller must hold the quota lock or call this within Update.
+func RecordLimit(state *config.QuotaState, handle, window, resetsAt string, now time.Time) bool {
+	next, hit := recordWindow(state.Accounts[handle], window, resetsAt, now)
+	state.Accounts[handle] = next
+	return hit
+}
+
+// recordWindow returns existing with window marked limited, and whether
+// that window is a new hit. Shared by account and provider limit state.
+func recordWindow(existing config.AccountQuotaState, window, resetsAt string, now time.Time) (config.AccountQuotaState, bool) {
+	if window == "" {
+		window = Window5Hour
+	}
+	windows := make(map[string]config.QuotaWindow, len(existing.Windows)+1)
+	if existing.Status == config.QuotaStatusLimited {
+		for name, w := range blockingWindows(existing) {
+			windows[name] = w
+		}
+	}
+	prev, repeat := windows[window]
+	limitedAt := now.UTC().Format(time.RFC3339)
+	windowLimitedAt := limitedAt
+	if repeat && prev.ResetsAt == resetsAt && prev.LimitedAt != "" {
+		windowLimitedAt = prev.LimitedAt // same hit seen again; keep when it was first detected
+	}
+	windows[window] = config.QuotaWindow{LimitedAt: windowLimitedAt, ResetsAt: resetsAt}
+
+	return config.AccountQuotaState{
+		Status:    config.QuotaStatusLimited,
+		LimitedAt: limitedAt,
+		ResetsAt:  latestResetsAt(windows, window),
+		LastUsed:  existing.LastUsed,
+		Windows:   windows,
+	}, !repeat
+}
+
+// ShouldWake reports whether a limited account can be used again at now:
+// every blocking window must have a known reset time that has passed. A
+// window without a parseable reset keeps the account limited.
+func ShouldWake(acct config.AccountQuotaState, now time.Time) bool {
+	if acct.Status != config.QuotaStatusLimited {
+		return false
+	}
+	windows := blockingWindows(acct)
+	if len(windows) == 0 {
+		return false
+	}
+	for _, w := range windows {
+		reset, err := windowReset(w, now)
+		if err != nil || !now.After(reset) {
+			return false
+		}
+	}
+	return true
+}
+
+// PendingReset is a blocking limit window with its times resolved.
+type PendingReset struct {
+	Window    string
+	LimitedAt time.Time // Zero when the detection time is unknown
+	ResetsAt  time.Time
+}
+
+// PendingResets returns acct's limit windows that have not reset by now,
+// soonest first. Windows without a parseable reset time are left out.
+func PendingResets(acct config.AccountQuotaState, now time.Time) []PendingReset {
+	if acct.Status != config.QuotaStatusLimited {
+		return nil
+	}
+	var resets []PendingReset
+	for name, w := range blockingWindows(acct) {
+		reset, err := windowReset(w, now)
+		if err != nil || !reset.After(now) {
+			continue
+		}
+		limitedAt, _ := time.Parse(time.RFC3339, w.LimitedAt)
+		resets = append(resets, PendingReset{Window: name, LimitedAt: limitedAt, ResetsAt: reset})
+	}
+	sort.Slice(resets, func(i, j int) bool {
+		if !resets[i].ResetsAt.Equal(resets[j].ResetsAt) {
+			return resets[i].ResetsAt.Before(resets[j].ResetsAt)
+		}
+		return resets[i].Window < resets[j].Window
+	})
+	return resets
+}
+
+// blockingWindows returns the account's limit windows. States written
+// before windows existed are treated as a single window built from the
+// account-level LimitedAt/ResetsAt.
+func blockingWindows(acct config.AccountQuotaState) map[string]config.QuotaWindow {
+	if len(acct.Windows) > 0 {
+		return acct.Windows
+	}
+	if acct.LimitedAt == "" && acct.ResetsAt == "" {
+		return nil
+	}
+	return map[string]config.QuotaWindow{
+		Window5Hour: {LimitedAt: acct.LimitedAt, ResetsAt: acct.ResetsAt},
+	}
+}
+
+// windowReset resolves a window's human-readable reset time to the first
+// matching instant after the limit was detected, so a "7pm" reset recorded
+// yesterday afternoon is not mistaken for today's 7pm. Windows without a
+// detection time resolve relative to now.
+func windowReset(w config.QuotaWindow, now time.Time) (time.Time, error) {
+	ref := now
+	detected := false
+	if t, err := time.Parse(time.RFC3339, w.LimitedAt); err == nil {
+		ref = t
+		detected = true
+	}
+	reset, err := ParseResetTime(w.ResetsAt, ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if detected && reset.Before(ref) {
+		reset = reset.AddDate(0, 0, 1)
+	}
+	return reset, nil
+}
+
+// latestResetsAt returns the ResetsAt of the window that resets last, falling
+// back to the window named fallback when reset times can't be compared.
+func latestResetsAt(windows map[string]config.QuotaWindow, fallback string) string {
+	var latest time.Time
+	result := windows[fallback].ResetsAt
+	for _, w := range windows {
+		reset, err := windowReset(w, time.Now())
+		if err != nil {
+			continue
+		}
+		if reset.After(latest) {
+			latest = reset
+			result = w.ResetsAt
+		}
+	}
+	return result
+}
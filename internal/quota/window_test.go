@@ -0,0 +1,153 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestDetectWindow(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"You've hit your limit · resets 7pm (America/Los_Angeles)", Window5Hour},
+		{"5-hour limit reached ∙ resets 2am", Window5Hour},
+		{"You've hit your weekly limit · resets Oct 20, 9am", WindowWeekly},
+		{"Opus week limit reached", WindowWeekly},
+		{"API Error: Rate limit reached", Window5Hour},
+	}
+	for _, tt := range tests {
+		if got := DetectWindow(tt.line); got != tt.want {
+			t.Errorf("DetectWindow(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestRecordLimit_KeepsOtherWindows(t *testing.T) {
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, la)
+	state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+		"work": {Status: config.QuotaStatusAvailable, LastUsed: "2026-10-16T10:00:00Z"},
+	}}
+
+	RecordLimit(state, "work", WindowWeekly, "Oct 20, 9am (America/Los_Angeles)", now)
+	RecordLimit(state, "work", "", "7pm (America/Los_Angeles)", now.Add(time.Hour))
+
+	acct := state.Accounts["work"]
+	if acct.Status != config.QuotaStatusLimited {
+		t.Fatalf("status = %s, want limited", acct.Status)
+	}
+	if len(acct.Windows) != 2 {
+		t.Fatalf("windows = %+v, want 5h and weekly", acct.Windows)
+	}
+	if acct.Windows[Window5Hour].ResetsAt != "7pm (America/Los_Angeles)" {
+		t.Errorf("5h window = %+v", acct.Windows[Window5Hour])
+	}
+	if acct.ResetsAt != "Oct 20, 9am (America/Los_Angeles)" {
+		t.Errorf("ResetsAt = %q, want the later weekly reset", acct.ResetsAt)
+	}
+	if acct.LastUsed != "2026-10-16T10:00:00Z" {
+		t.Errorf("LastUsed not preserved: %q", acct.LastUsed)
+	}
+}
+
+func TestShouldWake_RequiresAllWindows(t *testing.T) {
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	limitedAt := time.Date(2026, 10, 16, 15, 0, 0, 0, la)
+	state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{}}
+	RecordLimit(state, "work", Window5Hour, "7pm (America/Los_Angeles)", limitedAt)
+	RecordLimit(state, "work", WindowWeekly, "Oct 20, 9am (America/Los_Angeles)", limitedAt)
+	acct := state.Accounts["work"]
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"both blocking", limitedAt.Add(time.Hour), false},
+		{"5h reset, weekly exhausted", time.Date(2026, 10, 17, 8, 0, 0, 0, la), false},
+		{"all reset", time.Date(2026, 10, 20, 9, 30, 0, 0, la), true},
+	}
+	for _, tt := range tests {
+		if got := ShouldWake(acct, tt.now); got != tt.want {
+			t.Errorf("%s: ShouldWake() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	cleared := clearExpiredAt(nil, state, time.Date(2026, 10, 17, 8, 0, 0, 0, la))
+	if cleared != 0 || state.Accounts["work"].Status != config.QuotaStatusLimited {
+		t.Errorf("account cleared while weekly window still blocking")
+	}
+}
+
+func TestShouldWake_ResetAfterDetection(t *testing.T) {
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	// Limited at 10pm with a 1am reset: the reset is tomorrow, not earlier today.
+	limitedAt := time.Date(2026, 10, 16, 22, 0, 0, 0, la)
+	acct := config.AccountQuotaState{
+		Status: config.QuotaStatusLimited,
+		Windows: map[string]config.QuotaWindow{
+			Window5Hour: {LimitedAt: limitedAt.UTC().Format(time.RFC3339), ResetsAt: "1am (America/Los_Angeles)"},
+		},
+	}
+	if ShouldWake(acct, limitedAt.Add(time.Minute)) {
+		t.Error("should not wake before the 1am reset")
+	}
+	if !ShouldWake(acct, time.Date(2026, 10, 17, 1, 5, 0, 0, la)) {
+		t.Error("should wake after the 1am reset")
+	}
+}
+
+func TestShouldWake_UnknownReset(t *testing.T) {
+	now := time.Now()
+	acct := config.AccountQuotaState{
+		Status: config.QuotaStatusLimited,
+		Windows: map[string]config.QuotaWindow{
+			Window5Hour:  {ResetsAt: "1am"},
+			WindowWeekly: {ResetsAt: ""},
+		},
+	}
+	if ShouldWake(acct, now.Add(48*time.Hour)) {
+		t.Error("a window without a reset time should keep the account limited")
+	}
+	if ShouldWake(config.AccountQuotaState{Status: config.QuotaStatusAvailable}, now) {
+		t.Error("available accounts have nothing to wake from")
+	}
+}
+
+func TestPendingResets(t *testing.T) {
+	la, _ := time.LoadLocation("America/Los_Angeles")
+	limitedAt := time.Date(2026, 10, 16, 14, 0, 0, 0, la)
+	acct := config.AccountQuotaState{
+		Status: config.QuotaStatusLimited,
+		Windows: map[string]config.QuotaWindow{
+			WindowWeekly: {LimitedAt: limitedAt.UTC().Format(time.RFC3339), ResetsAt: "Oct 20, 9am (America/Los_Angeles)"},
+			Window5Hour:  {LimitedAt: limitedAt.UTC().Format(time.RFC3339), ResetsAt: "7pm (America/Los_Angeles)"},
+			"opus":       {ResetsAt: "sometime soon"},
+		},
+	}
+
+	resets := PendingResets(acct, limitedAt.Add(time.Hour))
+	if len(resets) != 2 {
+		t.Fatalf("resets = %+v, want the two parseable windows", resets)
+	}
+	if resets[0].Window != Window5Hour || !resets[0].ResetsAt.Equal(time.Date(2026, 10, 16, 19, 0, 0, 0, la)) {
+		t.Errorf("first reset = %+v, want 5h at 7pm", resets[0])
+	}
+	if !resets[0].LimitedAt.Equal(limitedAt) {
+		t.Errorf("LimitedAt = %v, want %v", resets[0].LimitedAt, limitedAt)
+	}
+	if resets[1].Window != WindowWeekly {
+		t.Errorf("second reset = %+v, want weekly", resets[1])
+	}
+
+	// After the 5h window resets only the weekly one is pending.
+	if resets := PendingResets(acct, time.Date(2026, 10, 16, 20, 0, 0, 0, la)); len(resets) != 1 || resets[0].Window != WindowWeekly {
+		t.Errorf("resets after 7pm = %+v, want weekly only", resets)
+	}
+	if resets := PendingResets(config.AccountQuotaState{Status: config.QuotaStatusAvailable}, limitedAt); resets != nil {
+		t.Errorf("available account resets = %+v", resets)
+	}
+}
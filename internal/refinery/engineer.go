@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -188,6 +189,11 @@ type MergeQueueConfig struct {
 	// Batch holds configuration for the batch-then-bisect merge queue.
 	// When nil or MaxBatchSize <= 1, batching is disabled and MRs process sequentially.
 	Batch *BatchConfig `json:"batch,omitempty"`
+
+	// Changelog is a repo-relative path (e.g. "CHANGELOG.md") that receives a
+	// one-line entry per merge. Empty disables changelog updates. Ignored when
+	// MergeStrategy="pr", since the refinery does not push to the target there.
+	Changelog string `json:"changelog,omitempty"`
 }
 
 // DefaultMergeQueueConfig returns sensible defaults for merge queue configuration.
@@ -227,6 +233,7 @@ type MRInfo struct {
 	ConvoyCreatedAt *time.Time // Convoy creation time
 	CreatedAt       time.Time  // MR creation time
 	BlockedBy       string     // Task ID blocking this MR
+	CorrelationID   string     // Ties merge events to the source issue's other events
 
 	// Pre-verification fields (Phase 3: polecat-owned rebasing)
 	// When set, the refinery can skip gates if VerifiedBase matches target HEAD.
@@ -365,6 +372,7 @@ func (e *Engineer) LoadConfig() error {
 		MergeStrategy        *string                    `json:"merge_strategy"`
 		VCSProvider          *string                    `json:"vcs_provider"`
 		RequireReview        *bool                      `json:"require_review"`
+		Changelog            *string                    `json:"changelog"`
 	}
 
 	if err := json.Unmarshal(rawConfig.MergeQueue, &mqRaw); err != nil {
@@ -452,6 +460,12 @@ func (e *Engineer) LoadConfig() error {
 	if mqRaw.RequireReview != nil {
 		e.config.RequireReview = mqRaw.RequireReview
 	}
+	if mqRaw.Changelog != nil {
+		if filepath.IsAbs(*mqRaw.Changelog) || strings.HasPrefix(filepath.Clean(*mqRaw.Changelog), "..") {
+			return fmt.Errorf("changelog %q must be a path inside the repository", *mqRaw.Changelog)
+		}
+		e.config.Changelog = *mqRaw.Changelog
+	}
 
 	// Initialize the PR provider when merge_strategy=pr.
 	if e.config.MergeStrategy == "pr" {
@@ -505,6 +519,9 @@ type ProcessResult struct {
 	BranchNotFound bool // Source branch no longer exists (e.g. cleaned up after cherry-pick)
 	NoMerge        bool // Source issue has no_merge flag — intentionally blocked, not a failure
 	NeedsApproval  bool // PR exists but lacks required approving review (merge_strategy=pr)
+
+	// Summary describes what landed (files, commits, test outcome). Set on success.
+	Summary *MergeSummary
 }
 
 // doMerge performs the actual git merge operation.
@@ -607,14 +624,17 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 	// Phase 3 fast-path: if skipGates is true (pre-verified MR with matching base),
 	// skip all gate execution — the polecat already ran gates after rebasing.
 	shouldSkipGates := len(skipGates) > 0 && skipGates[0]
+	testsOutcome := "none configured"
 	if shouldSkipGates {
 		_, _ = fmt.Fprintln(e.output, "[Engineer] Skipping gates (pre-verified by polecat)")
+		testsOutcome = "pre-verified by polecat"
 	} else if len(e.config.Gates) > 0 {
 		// New gates system: run configured quality gates
 		gateResult := e.runGates(ctx)
 		if !gateResult.Success {
 			return gateResult
 		}
+		testsOutcome = "quality gates passed"
 	} else if e.config.RunTests && e.config.TestCommand != "" {
 		// Legacy test command path (backward compatible)
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Running tests: %s\n", e.config.TestCommand)
@@ -627,14 +647,23 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 			}
 		}
 		_, _ = fmt.Fprintln(e.output, "[Engineer] Tests passed")
+		testsOutcome = fmt.Sprintf("passed (%s)", e.config.TestCommand)
 	}
 
+	// Capture what is about to land while the branch is still ahead of target.
+	summary := e.collectMergeSummary(branch, target, testsOutcome)
+
 	// PR merge path: when merge_strategy=pr, use the VCS provider's merge API
 	// instead of local squash merge + direct push. This respects branch
 	// protection/restriction rules and preserves the PR audit trail.
 	// The VCS provider (GitHub, Bitbucket) is selected via vcs_provider config.
 	if e.config.MergeStrategy == "pr" {
-		return e.doMergePR(ctx, branch, target)
+		result := e.doMergePR(ctx, branch, target)
+		if result.Success {
+			summary.Commit = result.MergeCommit
+			result.Summary = summary
+		}
+		return result
 	}
 
 	// Step 5: Perform the actual merge using squash merge
@@ -649,6 +678,7 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 		}
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: could not get original commit message: %v\n", err)
 	}
+	summary.Subject = strings.TrimSpace(strings.SplitN(strings.TrimSpace(originalMsg), "\n", 2)[0])
 	_, _ = fmt.Fprintf(e.output, "[Engineer] Squash merging with message: %s\n", strings.TrimSpace(originalMsg))
 	if err := e.git.MergeSquash(branch, originalMsg); err != nil {
 		// ZFC: Use git's porcelain output to detect conflicts instead of parsing stderr.
@@ -691,6 +721,14 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 			Error:   fmt.Sprintf("failed to get merge commit SHA: %v", err),
 		}
 	}
+	summary.Commit = mergeCommit
+
+	// Step 6.5: Record the merge in the rig's changelog (merge_queue.changelog).
+	// Committed separately so the squash commit keeps the polecat's message;
+	// both commits are pushed together below. Failures never block the merge.
+	if e.config.Changelog != "" {
+		e.commitChangelogEntry(summary, sourceIssue)
+	}
 
 	// Step 7-8: Push to origin (when auto_push is enabled).
 	if e.config.AutoPush {
@@ -739,6 +777,9 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 				Error:   fmt.Sprintf("failed to push to origin: %v", err),
 			}
 		}
+		// The base branch moved; bring the shared object cache along so new
+		// polecat worktrees and clones don't fetch the merge again.
+		rig.StartObjectCacheRefresh(e.rig.Path)
 	} else {
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Auto-push disabled, skipping push to origin/%s\n", target)
 	}
@@ -747,6 +788,7 @@ func (e *Engineer) doMerge(ctx context.Context, branch, target, sourceIssue stri
 	return ProcessResult{
 		Success:     true,
 		MergeCommit: mergeCommit,
+		Summary:     summary,
 	}
 }
 
@@ -824,6 +866,7 @@ func (e *Engineer) doMergePR(ctx context.Context, branch, target string) Process
 	} else if err := e.git.Pull("origin", target); err != nil {
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to pull %s after PR merge: %v\n", target, err)
 	}
+	rig.StartObjectCacheRefresh(e.rig.Path)
 
 	if mergeCommit == "" {
 		if sha, err := e.git.Rev("HEAD"); err == nil {
@@ -1137,6 +1180,7 @@ func (e *Engineer) ProcessMRInfo(ctx context.Context, mr *MRInfo) ProcessResult
 	_, _ = fmt.Fprintf(e.output, "  Target: %s\n", mr.Target)
 	_, _ = fmt.Fprintf(e.output, "  Worker: %s\n", mr.Worker)
 	_, _ = fmt.Fprintf(e.output, "  Source: %s\n", mr.SourceIssue)
+	e.logMergeEvent(events.TypeMergeStarted, mr, "")
 
 	// Phase 3: Check pre-verification fast-path.
 	// If the polecat already rebased onto the target and ran gates, and the target
@@ -1176,22 +1220,17 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 
 	// Update and close the MR bead
 	if mr.ID != "" {
-		// Fetch the MR bead to update its fields
-		mrBead, err := e.beads.Show(mr.ID)
-		if err != nil {
-			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to fetch MR bead %s: %v\n", mr.ID, err)
-		} else {
-			// Update MR with merge_commit SHA and close_reason
+		// Update MR with merge_commit SHA and close_reason
+		if err := e.beads.UpdateDescription(mr.ID, func(mrBead *beads.Issue) (string, error) {
 			mrFields := beads.ParseMRFields(mrBead)
 			if mrFields == nil {
 				mrFields = &beads.MRFields{}
 			}
 			mrFields.MergeCommit = result.MergeCommit
 			mrFields.CloseReason = "merged"
-			newDesc := beads.SetMRFields(mrBead, mrFields)
-			if err := e.beads.Update(mr.ID, beads.UpdateOptions{Description: &newDesc}); err != nil {
-				_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to update MR %s with merge commit: %v\n", mr.ID, err)
-			}
+			return beads.SetMRFields(mrBead, mrFields), nil
+		}); err != nil {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to update MR %s with merge commit: %v\n", mr.ID, err)
 		}
 
 		// Close MR bead with reason 'merged'
@@ -1218,6 +1257,15 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 		} else {
 			_, _ = fmt.Fprintf(e.output, "[Engineer] Closed source issue: %s\n", mr.SourceIssue)
 		}
+		e.closeBatchMembers(mr)
+	}
+
+	// 1.1. Record the merge for the activity feed and lifecycle webhooks.
+	e.logMergeEvent(events.TypeMerged, mr, "")
+
+	// 1.2. Post a summary of what landed to the source issue and convoy.
+	if result.Summary != nil {
+		e.postMergeSummary(mr, result.Summary)
 	}
 
 	// 1.5. Clear agent bead's active_mr reference (traceability cleanup)
@@ -1276,6 +1324,70 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 	_, _ = fmt.Fprintf(e.output, "[Engineer] ✓ Merged: %s (commit: %s)\n", mr.ID, result.MergeCommit)
 }
 
+// closeBatchMembers closes the beads a polecat worked in the same batch as
+// the MR's source issue (batch_beads on the source issue). Only members the
+// polecat marked in_progress are closed. Members it never reached are still
+// assigned to it: they are unassigned so the scheduler dispatches them on
+// their own, as it does members the polecat released.
+func (e *Engineer) closeBatchMembers(mr *MRInfo) {
+	issue, err := e.beads.Show(mr.SourceIssue)
+	if err != nil {
+		return
+	}
+	fields := beads.ParseAttachmentFields(issue)
+	if fields == nil {
+		return
+	}
+	for _, id := range fields.BatchBeads {
+		member, err := e.beads.Show(id)
+		if err != nil {
+			continue
+		}
+		if member.Status == "open" && heldByBatchPolecat(member.Assignee, issue.Assignee, mr.Worker) {
+			unassigned := ""
+			if err := e.beads.Update(id, beads.UpdateOptions{Assignee: &unassigned}); err != nil {
+				_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to release batched issue %s: %v\n", id, err)
+				continue
+			}
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Released unworked batched issue: %s\n", id)
+			continue
+		}
+		if member.Status != "in_progress" {
+			continue
+		}
+		reason := fmt.Sprintf("Merged in %s (batched with %s)", mr.ID, mr.SourceIssue)
+		if err := e.beads.ForceCloseWithReason(reason, id); err != nil {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to close batched issue %s: %v\n", id, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Closed batched issue: %s\n", id)
+	}
+}
+
+// heldByBatchPolecat reports whether a batch member's assignee is the
+// polecat that worked the batch: the leader's assignee, or the MR's worker.
+func heldByBatchPolecat(assignee, leaderAssignee, worker string) bool {
+	if assignee == "" {
+		return false
+	}
+	if assignee == leaderAssignee {
+		return true
+	}
+	return worker != "" && (assignee == worker || strings.HasSuffix(assignee, "/polecats/"+worker))
+}
+
+// logMergeEvent records a merge lifecycle event (merge_started, merged,
+// merge_failed, merge_skipped) for the activity feed, lifecycle webhooks and
+// gt sling --watch.
+func (e *Engineer) logMergeEvent(eventType string, mr *MRInfo, reason string) {
+	payload := events.MergePayload(mr.ID, mr.Worker, mr.Branch, reason)
+	if mr.SourceIssue != "" {
+		payload["bead"] = mr.SourceIssue
+	}
+	payload = events.WithCorrelation(payload, mr.CorrelationID)
+	_ = events.LogFeed(eventType, e.rig.Name+"/refinery", payload)
+}
+
 // HandleMRInfoFailure handles a failed merge from MRInfo.
 // For conflicts, creates a resolution task and blocks the MR until resolved.
 // For slot timeouts, the MR stays in queue for automatic retry without notifying polecats.
@@ -1294,6 +1406,7 @@ func (e *Engineer) HandleMRInfoFailure(mr *MRInfo, result ProcessResult) {
 	// No polecat or mayor notification needed; the MR is simply dequeued.
 	if result.NoMerge {
 		_, _ = fmt.Fprintf(e.output, "[Engineer] MR %s: no_merge flag set on source issue, dequeued\n", mr.ID)
+		e.logMergeEvent(events.TypeMergeSkipped, mr, "no_merge set on source issue")
 		return
 	}
 
@@ -1311,6 +1424,7 @@ func (e *Engineer) HandleMRInfoFailure(mr *MRInfo, result ProcessResult) {
 	// Escalate to mayor so lost work can be re-dispatched (gas-556).
 	if result.BranchNotFound {
 		_, _ = fmt.Fprintf(e.output, "[Engineer] MR %s: branch %s not found on remote — escalating to mayor (possible work loss)\n", mr.ID, mr.Branch)
+		e.logMergeEvent(events.TypeMergeFailed, mr, "branch "+mr.Branch+" not found on remote")
 		mayorMsg := fmt.Sprintf("BRANCH_MISSING: MR %s branch=%s issue=%s worker=%s — branch not on origin, work may be lost; re-dispatch if needed",
 			mr.ID, mr.Branch, mr.SourceIssue, mr.Worker)
 		mayorCmd := exec.Command("gt", "nudge", "mayor/", mayorMsg)
@@ -1331,6 +1445,7 @@ func (e *Engineer) HandleMRInfoFailure(mr *MRInfo, result ProcessResult) {
 	} else if result.TestsFailed {
 		failureType = "tests"
 	}
+	e.logMergeEvent(events.TypeMergeFailed, mr, failureType+": "+result.Error)
 	polecatName := strings.TrimPrefix(mr.Worker, "polecats/")
 	nudgeTarget := fmt.Sprintf("%s/%s", e.rig.Name, polecatName)
 	nudgeMsg := fmt.Sprintf("MERGE_FAILED: branch=%s issue=%s type=%s error=%s — fix and resubmit with 'gt done'",
@@ -1564,6 +1679,7 @@ func issueToMRInfo(issue *beads.Issue, fields *beads.MRFields) *MRInfo {
 		Title:           issue.Title,
 		Priority:        issue.Priority,
 		AgentBead:       fields.AgentBead,
+		CorrelationID:   fields.CorrelationID,
 		RetryCount:      fields.RetryCount,
 		ConvoyID:        fields.ConvoyID,
 		ConvoyCreatedAt: convoyCreatedAt,
@@ -1588,6 +1704,56 @@ func (e *Engineer) firstOpenBlocker(issue *beads.Issue) string {
 	return ""
 }
 
+// convoyMergeWait returns the tracked issue an MR must wait behind when its
+// convoy merges sequentially (merge_sequence), or "" when it may merge now.
+// An earlier issue has landed once it is closed and no MR for it is open
+// (openSources). sequences caches convoy lookups across one listing.
+func (e *Engineer) convoyMergeWait(mr *MRInfo, openSources map[string]bool, sequences map[string][]string) string {
+	if mr.ConvoyID == "" || mr.SourceIssue == "" {
+		return ""
+	}
+	seq, ok := sequences[mr.ConvoyID]
+	if !ok {
+		if convoy, err := e.beads.Show(mr.ConvoyID); err == nil {
+			seq = beads.ParseConvoyFields(convoy).MergeSequenceIDs()
+		}
+		sequences[mr.ConvoyID] = seq
+	}
+	return sequencePredecessor(seq, mr.SourceIssue, func(id string) bool {
+		if openSources[id] {
+			return false
+		}
+		open, err := e.IsBeadOpen(id)
+		return err == nil && !open
+	})
+}
+
+// sequencePredecessor returns the first issue ordered before source in seq
+// that has not landed, or "" if all have (or source is not in seq).
+func sequencePredecessor(seq []string, source string, landed func(id string) bool) string {
+	idx := slices.Index(seq, source)
+	for _, id := range seq[:max(idx, 0)] {
+		if !landed(id) {
+			return id
+		}
+	}
+	return ""
+}
+
+// openMRSources returns the source issues of the open MRs among issues.
+func openMRSources(issues []*beads.Issue) map[string]bool {
+	sources := make(map[string]bool)
+	for _, issue := range issues {
+		if issue.Status != "open" {
+			continue
+		}
+		if fields := beads.ParseMRFields(issue); fields != nil && fields.SourceIssue != "" {
+			sources[fields.SourceIssue] = true
+		}
+	}
+	return sources
+}
+
 // ListReadyMRs returns MRs that are ready for processing:
 // - Not claimed by another worker (checked via assignee field)
 // - Not blocked by an open task (checked via firstOpenBlocker)
@@ -1610,6 +1776,8 @@ func (e *Engineer) ListReadyMRs() ([]*MRInfo, error) {
 	}
 
 	// Convert beads issues to MRInfo
+	openSources := openMRSources(issues)
+	sequences := make(map[string][]string)
 	var mrs []*MRInfo
 	for _, issue := range issues {
 		// Skip closed MRs (workaround for bd list not respecting --status filter)
@@ -1656,7 +1824,12 @@ func (e *Engineer) ListReadyMRs() ([]*MRInfo, error) {
 				issue.ID, issue.Assignee, issue.UpdatedAt)
 		}
 
-		mrs = append(mrs, issueToMRInfo(issue, fields))
+		mr := issueToMRInfo(issue, fields)
+		if waitOn := e.convoyMergeWait(mr, openSources, sequences); waitOn != "" {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Holding MR %s: convoy %s merges %s first\n", issue.ID, mr.ConvoyID, waitOn)
+			continue
+		}
+		mrs = append(mrs, mr)
 	}
 
 	return mrs, nil
@@ -1677,20 +1850,12 @@ func (e *Engineer) ListBlockedMRs() ([]*MRInfo, error) {
 		return nil, fmt.Errorf("querying beads for merge-requests: %w", err)
 	}
 
-	// Filter for blocked issues (those with open blockers)
+	// Filter for blocked issues (those with open blockers, or waiting on
+	// an earlier issue of a sequential convoy)
+	openSources := openMRSources(issues)
+	sequences := make(map[string][]string)
 	var mrs []*MRInfo
 	for _, issue := range issues {
-		// Skip if not blocked
-		if len(issue.BlockedBy) == 0 {
-			continue
-		}
-
-		// Check if any blocker is still open
-		blockedBy := e.firstOpenBlocker(issue)
-		if blockedBy == "" {
-			continue // All blockers are closed, not blocked
-		}
-
 		fields := beads.ParseMRFields(issue)
 		if fields == nil {
 			continue
@@ -1702,6 +1867,15 @@ func (e *Engineer) ListBlockedMRs() ([]*MRInfo, error) {
 		}
 
 		mr := issueToMRInfo(issue, fields)
+		// Check if any blocker is still open
+		blockedBy := e.firstOpenBlocker(issue)
+		if blockedBy == "" {
+			blockedBy = e.convoyMergeWait(mr, openSources, sequences)
+		}
+		if blockedBy == "" {
+			continue // Not blocked
+		}
+
 		mr.BlockedBy = blockedBy
 		mrs = append(mrs, mr)
 	}
@@ -1940,8 +2114,8 @@ func (e *Engineer) checkAndCloseCompletedConvoys(townRoot, townBeads string) []c
 
 	// List all open convoys
 	listArgs := beads.MaybePrependAllowStaleWithEnv(bdEnv, []string{"list", "--type=convoy", "--status=open", "--json"})
-	listCmd := exec.Command("bd", listArgs...)
-	util.SetDetachedProcessGroup(listCmd)
+	listCmd := beads.Command(listArgs...)
+	util.SetDetachedProcessGroup(listCmd.Cmd)
 	listCmd.Dir = townBeads
 	listCmd.Env = bdEnv
 	var stdout bytes.Buffer
@@ -1968,8 +2142,8 @@ func (e *Engineer) checkAndCloseCompletedConvoys(townRoot, townBeads string) []c
 	for _, convoy := range convoys {
 		// Get tracked issues for this convoy via bd dep list
 		depArgs := beads.MaybePrependAllowStaleWithEnv(bdEnv, []string{"dep", "list", convoy.ID, "--direction=down", "--type=tracks", "--json"})
-		depCmd := exec.Command("bd", depArgs...)
-		util.SetDetachedProcessGroup(depCmd)
+		depCmd := beads.Command(depArgs...)
+		util.SetDetachedProcessGroup(depCmd.Cmd)
 		depCmd.Dir = townRoot
 		depCmd.Env = bdEnv
 		var depOut bytes.Buffer
@@ -2001,8 +2175,8 @@ func (e *Engineer) checkAndCloseCompletedConvoys(townRoot, townBeads string) []c
 
 			// Get fresh status from home rig via bd show with routing
 			showArgs := beads.MaybePrependAllowStaleWithEnv(bdEnv, []string{"show", depID, "--json"})
-			showCmd := exec.Command("bd", showArgs...)
-			util.SetDetachedProcessGroup(showCmd)
+			showCmd := beads.Command(showArgs...)
+			util.SetDetachedProcessGroup(showCmd.Cmd)
 			showCmd.Dir = townRoot
 			showCmd.Env = bdEnv
 			var showOut bytes.Buffer
@@ -2039,8 +2213,8 @@ func (e *Engineer) checkAndCloseCompletedConvoys(townRoot, townBeads string) []c
 		}
 
 		closeArgs := beads.MaybePrependAllowStaleWithEnv(bdEnv, []string{"close", convoy.ID, "-r", reason})
-		closeCmd := exec.Command("bd", closeArgs...)
-		util.SetDetachedProcessGroup(closeCmd)
+		closeCmd := beads.Command(closeArgs...)
+		util.SetDetachedProcessGroup(closeCmd.Cmd)
 		closeCmd.Dir = townBeads
 		closeCmd.Env = bdEnv
 
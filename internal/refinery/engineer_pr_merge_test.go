@@ -4,14 +4,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/events"
 	gitpkg "github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 func TestEngineer_LoadConfig_MergeStrategyPR(t *testing.T) {
@@ -194,6 +197,33 @@ func TestHandleMRInfoFailure_NeedsApproval_StaysInQueue(t *testing.T) {
 	}
 }
 
+func TestHandleMRInfoFailure_LogsMergeEvents(t *testing.T) {
+	townRoot := t.TempDir()
+	prev := workspace.TownOverride()
+	workspace.SetTownOverride(townRoot)
+	t.Cleanup(func() { workspace.SetTownOverride(prev) })
+
+	e := NewEngineer(&rig.Rig{Name: "test-rig", Path: townRoot})
+	e.output = io.Discard
+	e.workDir = townRoot
+	mr := &MRInfo{ID: "gt-mr", Branch: "polecat/test/gt-src", SourceIssue: "gt-src", Worker: "polecats/test"}
+
+	e.HandleMRInfoFailure(mr, ProcessResult{NoMerge: true, Error: "no_merge flag set on source issue"})
+	e.HandleMRInfoFailure(mr, ProcessResult{SlotTimeout: true}) // Retried: no event
+
+	data, err := os.ReadFile(filepath.Join(townRoot, events.EventsFile))
+	if err != nil {
+		t.Fatalf("reading events: %v", err)
+	}
+	var ev events.Event
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 1 || json.Unmarshal([]byte(lines[0]), &ev) != nil {
+		t.Fatalf("events = %s, want one merge_skipped", data)
+	}
+	if ev.Type != events.TypeMergeSkipped || ev.Payload["bead"] != "gt-src" || ev.Payload["reason"] == nil {
+		t.Errorf("event = %+v", ev)
+	}
+}
+
 func TestDoMergePR_RequireReview_NoApproval(t *testing.T) {
 	// When require_review is true and the PR is not approved,
 	// doMergePR should return NeedsApproval=true.
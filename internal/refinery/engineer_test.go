@@ -1063,3 +1063,55 @@ func TestIsClaimStale(t *testing.T) {
 		})
 	}
 }
+
+func TestSequencePredecessor(t *testing.T) {
+	seq := []string{"gt-a", "gt-b", "gt-c"}
+	landed := map[string]bool{"gt-a": true}
+	isLanded := func(id string) bool { return landed[id] }
+
+	if got := sequencePredecessor(seq, "gt-a", isLanded); got != "" {
+		t.Errorf("first issue waits on %q, want nothing", got)
+	}
+	if got := sequencePredecessor(seq, "gt-b", isLanded); got != "" {
+		t.Errorf("gt-b waits on %q, but gt-a has landed", got)
+	}
+	if got := sequencePredecessor(seq, "gt-c", isLanded); got != "gt-b" {
+		t.Errorf("gt-c waits on %q, want gt-b", got)
+	}
+	if got := sequencePredecessor(seq, "gt-z", isLanded); got != "" {
+		t.Errorf("issue outside the sequence waits on %q", got)
+	}
+	if got := sequencePredecessor(nil, "gt-c", isLanded); got != "" {
+		t.Errorf("convoy without a sequence waits on %q", got)
+	}
+}
+
+func TestOpenMRSources(t *testing.T) {
+	issues := []*beads.Issue{
+		{ID: "mr-1", Status: "open", Description: "branch: polecat/a\nsource_issue: gt-a"},
+		{ID: "mr-2", Status: "closed", Description: "branch: polecat/b\nsource_issue: gt-b"},
+		{ID: "mr-3", Status: "open", Description: "no fields here"},
+	}
+	got := openMRSources(issues)
+	if !got["gt-a"] || got["gt-b"] || len(got) != 1 {
+		t.Errorf("openMRSources() = %v, want only gt-a", got)
+	}
+}
+
+func TestHeldByBatchPolecat(t *testing.T) {
+	tests := []struct {
+		assignee, leader, worker string
+		want                     bool
+	}{
+		{"gastown/polecats/Toast", "gastown/polecats/Toast", "", true},
+		{"gastown/polecats/Toast", "", "Toast", true},
+		{"gastown/polecats/Toast", "", "gastown/polecats/Toast", true},
+		{"gastown/crew/max", "gastown/polecats/Toast", "Toast", false}, // Someone else took it
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		if got := heldByBatchPolecat(tt.assignee, tt.leader, tt.worker); got != tt.want {
+			t.Errorf("heldByBatchPolecat(%q, %q, %q) = %v, want %v", tt.assignee, tt.leader, tt.worker, got, tt.want)
+		}
+	}
+}
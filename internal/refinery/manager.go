@@ -18,6 +18,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/nudge"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
@@ -25,6 +26,7 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/worktree"
 )
 
 // Common errors
@@ -298,12 +300,12 @@ func (m *Manager) repairRefineryWorktree(refineryRigDir string) error {
 	}
 
 	// Prune stale worktree entries so git doesn't reject the add
-	bareGit := git.NewGitWithDir(bareRepoPath, "")
-	_ = bareGit.WorktreePrune()
+	worktrees := worktree.NewManager(git.NewGitWithDir(bareRepoPath, ""))
+	_ = worktrees.Prune()
 
 	// Create worktree on the rig's default branch
 	defaultBranch := m.rig.DefaultBranch()
-	if err := bareGit.WorktreeAddExisting(refineryRigDir, defaultBranch); err != nil {
+	if err := worktrees.AddExisting(refineryRigDir, defaultBranch); err != nil {
 		return fmt.Errorf("git worktree add: %w", err)
 	}
 
@@ -382,7 +384,7 @@ func (m *Manager) Queue() ([]QueueItem, error) {
 			items = append(items, QueueItem{
 				Position: pos,
 				MR:       mr,
-				Age:      formatAge(mr.CreatedAt),
+				Age:      humanize.Ago(mr.CreatedAt),
 			})
 			pos++
 		}
@@ -483,22 +485,6 @@ func parseTime(s string) time.Time {
 	return t
 }
 
-// formatAge formats a duration since the given time.
-func formatAge(t time.Time) string {
-	d := time.Since(t)
-
-	if d < time.Minute {
-		return fmt.Sprintf("%ds ago", int(d.Seconds()))
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm ago", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh ago", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd ago", int(d.Hours()/24))
-}
-
 // Common errors for MR operations
 var (
 	ErrMRNotFound  = errors.New("merge request not found")
@@ -0,0 +1,171 @@
+package refinery
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// maxSummaryFiles caps the file list in merge summary comments.
+const maxSummaryFiles = 20
+
+// MergeSummary describes what a merge landed. It is posted as a comment on
+// the source issue and its convoy, and optionally appended to a changelog.
+type MergeSummary struct {
+	Branch  string
+	Target  string
+	Commit  string   // Merge commit SHA (empty until the merge lands)
+	Subject string   // Squash commit subject
+	Files   []string // Files touched by the branch
+	Commits []string // Commit subjects on the branch, oldest first
+	Tests   string   // Human-readable gate/test outcome
+}
+
+// collectMergeSummary gathers files and commit messages for branch relative
+// to target. Failures are non-fatal: the summary simply omits that section.
+func (e *Engineer) collectMergeSummary(branch, target, tests string) *MergeSummary {
+	s := &MergeSummary{Branch: branch, Target: target, Tests: tests}
+	if files, err := e.git.DiffNameOnly(target, branch); err == nil {
+		s.Files = files
+	} else {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: could not list changed files for summary: %v\n", err)
+	}
+	if commits, err := e.git.CommitSubjects(target, branch); err == nil {
+		s.Commits = commits
+	} else {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: could not list commits for summary: %v\n", err)
+	}
+	return s
+}
+
+// Comment renders the summary as a bead comment.
+func (s *MergeSummary) Comment() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Merged %s into %s", s.Branch, s.Target)
+	if s.Commit != "" {
+		fmt.Fprintf(&b, " (%s)", shortSHA(s.Commit))
+	}
+	b.WriteString("\n")
+
+	if len(s.Commits) > 0 {
+		b.WriteString("\nCommits:\n")
+		for _, c := range s.Commits {
+			fmt.Fprintf(&b, "- %s\n", c)
+		}
+	}
+	if len(s.Files) > 0 {
+		fmt.Fprintf(&b, "\nFiles (%d):\n", len(s.Files))
+		for i, f := range s.Files {
+			if i == maxSummaryFiles {
+				fmt.Fprintf(&b, "- … and %d more\n", len(s.Files)-maxSummaryFiles)
+				break
+			}
+			fmt.Fprintf(&b, "- %s\n", f)
+		}
+	}
+	if s.Tests != "" {
+		fmt.Fprintf(&b, "\nTests: %s\n", s.Tests)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// ChangelogEntry renders a one-line changelog bullet for the merge.
+func (s *MergeSummary) ChangelogEntry(sourceIssue string, now time.Time) string {
+	subject := s.Subject
+	if subject == "" && len(s.Commits) > 0 {
+		subject = s.Commits[len(s.Commits)-1]
+	}
+	if subject == "" {
+		subject = "Merge " + s.Branch
+	}
+	var refs []string
+	if sourceIssue != "" {
+		refs = append(refs, sourceIssue)
+	}
+	if s.Commit != "" {
+		refs = append(refs, shortSHA(s.Commit))
+	}
+	entry := fmt.Sprintf("- %s: %s", now.Format("2006-01-02"), subject)
+	if len(refs) > 0 {
+		entry += " (" + strings.Join(refs, ", ") + ")"
+	}
+	return entry
+}
+
+// insertChangelogEntry adds entry to a changelog, newest first: directly
+// below the top-level heading when there is one, otherwise at the top.
+func insertChangelogEntry(content, entry string) string {
+	if strings.TrimSpace(content) == "" {
+		return "# Changelog\n\n" + entry + "\n"
+	}
+	lines := strings.SplitAfter(content, "\n")
+	if strings.HasPrefix(lines[0], "# ") {
+		rest := strings.Join(lines[1:], "")
+		return lines[0] + "\n" + entry + "\n" + strings.TrimLeft(rest, "\n")
+	}
+	return entry + "\n" + content
+}
+
+// appendChangelog writes the merge entry into path (created if missing).
+func appendChangelog(path, entry string) error {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is from rig config inside the refinery worktree
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("reading changelog: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating changelog directory: %w", err)
+	}
+	//nolint:gosec // G306: changelog is a tracked repository file
+	if err := os.WriteFile(path, []byte(insertChangelogEntry(string(data), entry)), 0644); err != nil {
+		return fmt.Errorf("writing changelog: %w", err)
+	}
+	return nil
+}
+
+// commitChangelogEntry appends the merge to the configured changelog and
+// commits it on the checked-out target branch.
+func (e *Engineer) commitChangelogEntry(s *MergeSummary, sourceIssue string) {
+	path := filepath.Join(e.git.WorkDir(), e.config.Changelog)
+	if err := appendChangelog(path, s.ChangelogEntry(sourceIssue, time.Now())); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: changelog not updated: %v\n", err)
+		return
+	}
+	msg := fmt.Sprintf("chore(changelog): record %s", shortSHA(s.Commit))
+	if err := e.git.Add(e.config.Changelog); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: could not stage changelog: %v\n", err)
+		return
+	}
+	if err := e.git.Commit(msg); err != nil {
+		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: could not commit changelog: %v\n", err)
+		_ = e.git.ResetFiles(e.config.Changelog)
+		_ = e.git.CheckoutFileFromRef("HEAD", e.config.Changelog)
+		return
+	}
+	_, _ = fmt.Fprintf(e.output, "[Engineer] Updated %s\n", e.config.Changelog)
+}
+
+// postMergeSummary comments the merge summary on the source issue and, when
+// the MR belongs to a convoy, on the convoy bead in town beads.
+func (e *Engineer) postMergeSummary(mr *MRInfo, s *MergeSummary) {
+	comment := s.Comment()
+	if mr.SourceIssue != "" {
+		if _, err := e.beads.Run("comments", "add", mr.SourceIssue, comment); err != nil {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: could not post merge summary to %s: %v\n", mr.SourceIssue, err)
+		}
+	}
+	if mr.ConvoyID != "" {
+		townRoot := filepath.Dir(e.rig.Path)
+		convoyComment := comment
+		if mr.SourceIssue != "" {
+			convoyComment = mr.SourceIssue + ": " + comment
+		}
+		if _, err := beads.New(townRoot).Run("comments", "add", mr.ConvoyID, convoyComment); err != nil {
+			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: could not post merge summary to convoy %s: %v\n", mr.ConvoyID, err)
+		}
+	}
+}
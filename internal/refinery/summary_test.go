@@ -0,0 +1,131 @@
+package refinery
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestMergeSummary_Comment(t *testing.T) {
+	s := &MergeSummary{
+		Branch:  "polecat/nux",
+		Target:  "main",
+		Commit:  "0123456789abcdef",
+		Commits: []string{"feat: add parser", "test: cover parser"},
+		Files:   []string{"parser.go", "parser_test.go"},
+		Tests:   "quality gates passed",
+	}
+	want := `Merged polecat/nux into main (01234567)
+
+Commits:
+- feat: add parser
+- test: cover parser
+
+Files (2):
+- parser.go
+- parser_test.go
+
+Tests: quality gates passed`
+	if got := s.Comment(); got != want {
+		t.Errorf("Comment() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMergeSummary_CommentTruncatesFiles(t *testing.T) {
+	s := &MergeSummary{Branch: "b", Target: "main"}
+	for i := 0; i < maxSummaryFiles+5; i++ {
+		s.Files = append(s.Files, fmt.Sprintf("f%d.go", i))
+	}
+	got := s.Comment()
+	if !strings.Contains(got, fmt.Sprintf("Files (%d):", maxSummaryFiles+5)) || !strings.Contains(got, "… and 5 more") {
+		t.Errorf("expected truncated file list, got:\n%s", got)
+	}
+	if strings.Contains(got, fmt.Sprintf("f%d.go", maxSummaryFiles)) {
+		t.Errorf("file beyond cap should be omitted:\n%s", got)
+	}
+}
+
+func TestMergeSummary_ChangelogEntry(t *testing.T) {
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	s := &MergeSummary{Branch: "polecat/nux", Commit: "abcdef0123456789", Subject: "fix: handle empty config"}
+	if got, want := s.ChangelogEntry("gt-abc", now), "- 2026-03-04: fix: handle empty config (gt-abc, abcdef01)"; got != want {
+		t.Errorf("ChangelogEntry = %q, want %q", got, want)
+	}
+
+	s = &MergeSummary{Branch: "polecat/nux", Commits: []string{"wip", "feat: final"}}
+	if got, want := s.ChangelogEntry("", now), "- 2026-03-04: feat: final"; got != want {
+		t.Errorf("ChangelogEntry fallback = %q, want %q", got, want)
+	}
+}
+
+func TestInsertChangelogEntry(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"empty", "", "# Changelog\n\n- new\n"},
+		{"heading", "# Changelog\n\n- old\n", "# Changelog\n\n- new\n- old\n"},
+		{"no heading", "- old\n", "- new\n- old\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := insertChangelogEntry(tt.content, "- new"); got != tt.want {
+				t.Errorf("insertChangelogEntry = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendChangelog_CreatesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "docs", "CHANGELOG.md")
+	if err := appendChangelog(path, "- first"); err != nil {
+		t.Fatalf("appendChangelog: %v", err)
+	}
+	if err := appendChangelog(path, "- second"); err != nil {
+		t.Fatalf("appendChangelog: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), "# Changelog\n\n- second\n- first\n"; got != want {
+		t.Errorf("changelog = %q, want %q", got, want)
+	}
+}
+
+func TestEngineer_LoadConfig_Changelog(t *testing.T) {
+	for _, tt := range []struct {
+		path    string
+		wantErr bool
+	}{
+		{"CHANGELOG.md", false},
+		{"docs/CHANGES.md", false},
+		{"../outside.md", true},
+		{"/etc/changelog", true},
+	} {
+		t.Run(tt.path, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			data, _ := json.Marshal(map[string]interface{}{
+				"merge_queue": map[string]interface{}{"changelog": tt.path},
+			})
+			if err := os.WriteFile(filepath.Join(tmpDir, "config.json"), data, 0644); err != nil {
+				t.Fatal(err)
+			}
+			e := NewEngineer(&rig.Rig{Name: "test-rig", Path: tmpDir})
+			err := e.LoadConfig()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && e.config.Changelog != tt.path {
+				t.Errorf("Changelog = %q, want %q", e.config.Changelog, tt.path)
+			}
+		})
+	}
+}
@@ -5,10 +5,21 @@ import (
 	"testing"
 
 	"github.com/steveyegge/gastown/internal/testutil"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 func TestMain(m *testing.M) {
+	// Merges log feed events to the town found from the CWD, which for this
+	// package is the source tree (internal/mayor looks like a town). Send
+	// them to a throwaway town instead.
+	town, err := os.MkdirTemp("", "refinery-test-town-")
+	if err == nil {
+		workspace.SetTownOverride(town)
+	}
 	code := m.Run()
 	testutil.TerminateDoltContainer()
+	if town != "" {
+		_ = os.RemoveAll(town)
+	}
 	os.Exit(code)
 }
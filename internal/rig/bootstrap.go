@@ -0,0 +1,165 @@
+package rig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/humanize"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// BootstrapScript is the rig-level script run in every new polecat worktree
+// to make it ready for work: installing dependencies, copying .env
+// templates, warming build caches.
+const BootstrapScript = "bootstrap.sh"
+
+// DefaultBootstrapTimeout bounds a bootstrap run. Dependency installs on a
+// cold cache are slow, so this is much longer than the setup hook timeout.
+const DefaultBootstrapTimeout = 10 * time.Minute
+
+// bootstrapWaitDelay is how long a timed-out bootstrap's output is drained
+// after its process group is killed, in case something outside the group
+// still holds the pipe.
+const bootstrapWaitDelay = 5 * time.Second
+
+// bootstrapTailLines is how much of a failed bootstrap's output the error
+// carries; the full output stays in the log.
+const bootstrapTailLines = 15
+
+// BootstrapConfig is the config.json alternative to bootstrap.sh, for rigs
+// whose setup is a single command.
+type BootstrapConfig struct {
+	// Command is run with sh -c in the worktree. It takes precedence over
+	// bootstrap.sh.
+	Command string `json:"command,omitempty"`
+
+	// Timeout is a Go duration ("15m"). Empty means DefaultBootstrapTimeout.
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// BootstrapError is a failed bootstrap run. Spawning stops on it: a polecat
+// started in a broken environment only burns a session finding that out.
+type BootstrapError struct {
+	Command string // What ran, e.g. "bootstrap.sh" or the configured command
+	LogPath string // Full output
+	Tail    string // Last lines of output
+	Err     error
+}
+
+func (e *BootstrapError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "rig bootstrap failed (%s): %v", e.Command, e.Err)
+	if e.Tail != "" {
+		for _, line := range strings.Split(e.Tail, "\n") {
+			sb.WriteString("\n  | " + line)
+		}
+	}
+	if e.LogPath != "" {
+		sb.WriteString("\nFull output: " + e.LogPath)
+	}
+	return sb.String()
+}
+
+func (e *BootstrapError) Unwrap() error { return e.Err }
+
+// BootstrapLogPath returns where the bootstrap output for the named polecat
+// is kept. It lives under the rig's .runtime/ so it survives the cleanup of
+// a polecat whose bootstrap failed.
+func BootstrapLogPath(rigPath, name string) string {
+	return filepath.Join(rigPath, ".runtime", "bootstrap", name+".log")
+}
+
+// RunBootstrap prepares a new worktree with the rig's bootstrap: the
+// bootstrap.command from the rig's config.json, or <rigPath>/bootstrap.sh.
+// It runs in the worktree with GT_WORKTREE_PATH, GT_RIG_PATH and the rig's
+// shared dependency caches (DepCacheEnv) set, and its output goes to logPath.
+//
+// Unlike setup hooks, a failure is returned as a *BootstrapError so the
+// caller can abort the spawn. Returns nil when the rig has no bootstrap.
+func RunBootstrap(rigPath, worktreePath, logPath string) error {
+	var cfg *BootstrapConfig
+	if rigCfg, err := LoadRigConfig(rigPath); err == nil {
+		cfg = rigCfg.Bootstrap
+	}
+
+	var name string
+	var args []string
+	scriptPath := filepath.Join(rigPath, BootstrapScript)
+	if cfg != nil && cfg.Command != "" {
+		name, args = cfg.Command, []string{"sh", "-c", cfg.Command}
+	} else {
+		if _, err := os.Stat(scriptPath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil // No bootstrap configured
+			}
+			return &BootstrapError{Command: BootstrapScript, Err: err}
+		}
+		name, args = BootstrapScript, []string{"sh", scriptPath}
+	}
+
+	timeout := DefaultBootstrapTimeout
+	if cfg != nil && cfg.Timeout != "" {
+		d, err := time.ParseDuration(cfg.Timeout)
+		if err != nil || d <= 0 {
+			return &BootstrapError{Command: name, Err: fmt.Errorf("invalid bootstrap.timeout %q", cfg.Timeout)}
+		}
+		timeout = d
+	}
+
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return &BootstrapError{Command: name, Err: fmt.Errorf("creating log dir: %w", err)}
+	}
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return &BootstrapError{Command: name, Err: fmt.Errorf("creating log: %w", err)}
+	}
+	defer logFile.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Dir = worktreePath
+	// Kill the whole tree on timeout: a script's npm or make children would
+	// otherwise outlive it and keep Run waiting on their output.
+	util.SetProcessGroup(cmd)
+	cmd.WaitDelay = bootstrapWaitDelay
+	cmd.Stdout = io.MultiWriter(logFile, &output)
+	cmd.Stderr = cmd.Stdout
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("GT_WORKTREE_PATH=%s", worktreePath),
+		fmt.Sprintf("GT_RIG_PATH=%s", rigPath),
+	)
+	for k, v := range DepCacheEnv(rigPath) {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+
+	start := time.Now()
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %s", humanize.Duration(timeout))
+		}
+		return &BootstrapError{Command: name, LogPath: logPath, Tail: lastLines(output.String(), bootstrapTailLines), Err: err}
+	}
+
+	fmt.Printf("Ran rig bootstrap: %s (%s)\n", name, humanize.Duration(time.Since(start)))
+	return nil
+}
+
+// lastLines returns the last n lines of s, ignoring a trailing newline.
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
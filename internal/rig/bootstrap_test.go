@@ -0,0 +1,89 @@
+package rig
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunBootstrap(t *testing.T) {
+	t.Run("no bootstrap", func(t *testing.T) {
+		rigPath := t.TempDir()
+		if err := RunBootstrap(rigPath, t.TempDir(), BootstrapLogPath(rigPath, "toast")); err != nil {
+			t.Fatalf("RunBootstrap() = %v, want nil without a bootstrap", err)
+		}
+	})
+
+	t.Run("script runs in worktree", func(t *testing.T) {
+		rigPath, worktree := t.TempDir(), t.TempDir()
+		writeBootstrap(t, rigPath, "cp \"$GT_RIG_PATH/.env.example\" .env\necho installed\n")
+		if err := os.WriteFile(filepath.Join(rigPath, ".env.example"), []byte("PORT=1\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		logPath := BootstrapLogPath(rigPath, "toast")
+		if err := RunBootstrap(rigPath, worktree, logPath); err != nil {
+			t.Fatalf("RunBootstrap() = %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(worktree, ".env")); err != nil {
+			t.Errorf(".env not copied into worktree: %v", err)
+		}
+		if log, _ := os.ReadFile(logPath); !strings.Contains(string(log), "installed") {
+			t.Errorf("log = %q, want bootstrap output", log)
+		}
+	})
+
+	t.Run("failure carries output", func(t *testing.T) {
+		rigPath := t.TempDir()
+		writeBootstrap(t, rigPath, "echo 'npm ERR! missing lockfile' >&2\nexit 3\n")
+		logPath := BootstrapLogPath(rigPath, "toast")
+		err := RunBootstrap(rigPath, t.TempDir(), logPath)
+		var bootErr *BootstrapError
+		if !errors.As(err, &bootErr) {
+			t.Fatalf("RunBootstrap() = %v, want *BootstrapError", err)
+		}
+		if !strings.Contains(err.Error(), "npm ERR! missing lockfile") || !strings.Contains(err.Error(), logPath) {
+			t.Errorf("error should show the output tail and log path, got:\n%s", err)
+		}
+	})
+
+	t.Run("timeout kills children", func(t *testing.T) {
+		rigPath := t.TempDir()
+		cfg := `{"type":"rig","name":"r","bootstrap":{"command":"sleep 30 & sleep 30","timeout":"200ms"}}`
+		if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+			t.Fatal(err)
+		}
+		start := time.Now()
+		err := RunBootstrap(rigPath, t.TempDir(), BootstrapLogPath(rigPath, "toast"))
+		if err == nil || !strings.Contains(err.Error(), "timed out") {
+			t.Fatalf("RunBootstrap() = %v, want timeout", err)
+		}
+		if elapsed := time.Since(start); elapsed > 10*time.Second {
+			t.Errorf("RunBootstrap took %s; background child kept it waiting", elapsed)
+		}
+	})
+
+	t.Run("config command overrides script", func(t *testing.T) {
+		rigPath, worktree := t.TempDir(), t.TempDir()
+		writeBootstrap(t, rigPath, "exit 1\n")
+		cfg := `{"type":"rig","name":"r","bootstrap":{"command":"touch configured","timeout":"1m"}}`
+		if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := RunBootstrap(rigPath, worktree, BootstrapLogPath(rigPath, "toast")); err != nil {
+			t.Fatalf("RunBootstrap() = %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(worktree, "configured")); err != nil {
+			t.Errorf("configured command did not run: %v", err)
+		}
+	})
+}
+
+func writeBootstrap(t *testing.T, rigPath, body string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(rigPath, BootstrapScript), []byte("set -e\n"+body), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
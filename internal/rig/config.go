@@ -39,6 +39,11 @@ var SystemDefaults = map[string]interface{}{
 	"dnd":                     false,
 	"polecat_branch_template": "", // Empty = use default behavior (polecat/{name}/...)
 	"default_formula":         "mol-polecat-work",
+	"rebase_nudge_behind":     0,       // Commits behind base before nudging a polecat to rebase; 0 = off
+	"rebase_nudge_mode":       "nudge", // "nudge" asks the agent to rebase; "auto" also rebases clean worktrees of down sessions
+	"queue_high_watermark":    0,       // Queued beads at which the overseer is alerted; 0 = off
+	"queue_low_watermark":     0,       // Queue depth below which queue_low_hook runs; 0 = off
+	"queue_low_hook":          "",      // Shell command run when the queue drops below the low watermark
 }
 
 // StackingKeys defines which keys use stacking semantics (values add up).
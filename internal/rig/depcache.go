@@ -0,0 +1,178 @@
+package rig
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// DepCacheKind is a package manager whose download cache a rig can share
+// between its polecat worktrees.
+type DepCacheKind struct {
+	Name string
+	// Env maps each environment variable the tool reads to the cache
+	// subdirectory it points at.
+	Env map[string]string
+}
+
+// DepCacheKinds are the caches dep_cache can enable, by name.
+var DepCacheKinds = []DepCacheKind{
+	{Name: "pnpm", Env: map[string]string{"npm_config_store_dir": "pnpm-store"}},
+	{Name: "npm", Env: map[string]string{"npm_config_cache": "npm"}},
+	{Name: "yarn", Env: map[string]string{"YARN_CACHE_FOLDER": "yarn"}},
+	{Name: "go", Env: map[string]string{"GOMODCACHE": "go-mod", "GOCACHE": "go-build"}},
+	{Name: "cargo", Env: map[string]string{"CARGO_HOME": "cargo"}},
+	{Name: "pip", Env: map[string]string{"PIP_CACHE_DIR": "pip"}},
+}
+
+// DepCacheKindNames returns the names of DepCacheKinds.
+func DepCacheKindNames() []string {
+	names := make([]string, len(DepCacheKinds))
+	for i, k := range DepCacheKinds {
+		names[i] = k.Name
+	}
+	return names
+}
+
+// depCacheKind looks up a DepCacheKinds entry by name.
+func depCacheKind(name string) (DepCacheKind, bool) {
+	for _, k := range DepCacheKinds {
+		if k.Name == name {
+			return k, true
+		}
+	}
+	return DepCacheKind{}, false
+}
+
+// DepCacheDir is where a rig keeps its shared dependency caches.
+func DepCacheDir(rigPath string) string {
+	return filepath.Join(rigPath, ".runtime", "dep-cache")
+}
+
+// EnabledDepCaches returns the caches enabled in the rig's config.json
+// (dep_cache), skipping unknown names.
+func EnabledDepCaches(rigPath string) []DepCacheKind {
+	cfg, err := LoadRigConfig(rigPath)
+	if err != nil {
+		return nil
+	}
+	var kinds []DepCacheKind
+	for _, name := range cfg.DepCache {
+		if k, ok := depCacheKind(name); ok {
+			kinds = append(kinds, k)
+		}
+	}
+	return kinds
+}
+
+// DepCacheEnv returns the environment that points a polecat's package
+// managers at the rig's shared caches, creating the cache directories.
+// Returns nil when the rig enables none.
+//
+// The caches are shared by every polecat in the rig at once; the package
+// managers listed in DepCacheKinds all lock their caches for concurrent use.
+func DepCacheEnv(rigPath string) map[string]string {
+	kinds := EnabledDepCaches(rigPath)
+	if len(kinds) == 0 {
+		return nil
+	}
+	root := DepCacheDir(rigPath)
+	env := make(map[string]string)
+	for _, k := range kinds {
+		for name, sub := range k.Env {
+			dir := filepath.Join(root, sub)
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				continue // Leave the tool on its default cache
+			}
+			env[name] = dir
+		}
+	}
+	return env
+}
+
+// DepCacheUsage is the size of one kind's cache in a rig.
+type DepCacheUsage struct {
+	Kind    string
+	Enabled bool
+	Paths   []string // Cache directories, sorted
+	Bytes   int64
+}
+
+// DepCacheStatus reports every known cache kind for the rig, enabled or
+// not, so caches left over from a previously enabled kind still show up.
+func DepCacheStatus(rigPath string) []DepCacheUsage {
+	enabled := make(map[string]bool)
+	for _, k := range EnabledDepCaches(rigPath) {
+		enabled[k.Name] = true
+	}
+	root := DepCacheDir(rigPath)
+	usage := make([]DepCacheUsage, 0, len(DepCacheKinds))
+	for _, k := range DepCacheKinds {
+		u := DepCacheUsage{Kind: k.Name, Enabled: enabled[k.Name]}
+		for _, sub := range k.Env {
+			dir := filepath.Join(root, sub)
+			u.Paths = append(u.Paths, dir)
+			u.Bytes += dirSize(dir)
+		}
+		slices.Sort(u.Paths)
+		usage = append(usage, u)
+	}
+	return usage
+}
+
+// CleanDepCache deletes the rig's caches for the named kinds, or all of them
+// when kinds is empty, and returns the bytes freed. Polecats re-download
+// what they need on their next install.
+func CleanDepCache(rigPath string, kinds []string) (int64, error) {
+	if len(kinds) == 0 {
+		kinds = DepCacheKindNames()
+	}
+	root := DepCacheDir(rigPath)
+	var freed int64
+	for _, name := range kinds {
+		k, ok := depCacheKind(name)
+		if !ok {
+			return freed, fmt.Errorf("unknown cache %q (known: %v)", name, DepCacheKindNames())
+		}
+		for _, sub := range k.Env {
+			dir := filepath.Join(root, sub)
+			size := dirSize(dir)
+			if err := removeAllWritable(dir); err != nil {
+				return freed, fmt.Errorf("removing %s: %w", dir, err)
+			}
+			freed += size
+		}
+	}
+	return freed, nil
+}
+
+// dirSize sums the sizes of regular files under dir; 0 if it doesn't exist.
+func dirSize(dir string) int64 {
+	var total int64
+	_ = filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // Skip unreadable entries
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				total += info.Size()
+			}
+		}
+		return nil
+	})
+	return total
+}
+
+// removeAllWritable removes dir, first making its directories writable: the
+// Go module cache is read-only, which os.RemoveAll alone can't delete.
+func removeAllWritable(dir string) error {
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() {
+			_ = os.Chmod(path, 0755)
+		}
+		return nil
+	})
+	return os.RemoveAll(dir)
+}
@@ -0,0 +1,87 @@
+package rig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDepCacheEnv(t *testing.T) {
+	rigPath := t.TempDir()
+	if env := DepCacheEnv(rigPath); env != nil {
+		t.Fatalf("DepCacheEnv() without config = %v, want nil", env)
+	}
+
+	cfg := `{"type":"rig","name":"r","dep_cache":["go","pnpm","bogus"]}`
+	if err := os.WriteFile(filepath.Join(rigPath, "config.json"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	env := DepCacheEnv(rigPath)
+	root := DepCacheDir(rigPath)
+	want := map[string]string{
+		"GOMODCACHE":           filepath.Join(root, "go-mod"),
+		"GOCACHE":              filepath.Join(root, "go-build"),
+		"npm_config_store_dir": filepath.Join(root, "pnpm-store"),
+	}
+	if len(env) != len(want) {
+		t.Errorf("DepCacheEnv() = %v, want %v", env, want)
+	}
+	for k, v := range want {
+		if env[k] != v {
+			t.Errorf("%s = %q, want %q", k, env[k], v)
+		}
+		if _, err := os.Stat(v); err != nil {
+			t.Errorf("cache dir %s not created: %v", v, err)
+		}
+	}
+}
+
+func TestCleanDepCache(t *testing.T) {
+	rigPath := t.TempDir()
+	root := DepCacheDir(rigPath)
+
+	// The Go module cache is read-only on disk.
+	modDir := filepath.Join(root, "go-mod", "example.com", "m@v1.0.0")
+	if err := os.MkdirAll(modDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(modDir, "go.mod"), []byte("module m\n"), 0444); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(modDir, 0555); err != nil {
+		t.Fatal(err)
+	}
+	npmDir := filepath.Join(root, "npm")
+	if err := os.MkdirAll(npmDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(npmDir, "index"), []byte("12345"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	usage := make(map[string]int64)
+	for _, u := range DepCacheStatus(rigPath) {
+		usage[u.Kind] = u.Bytes
+	}
+	if usage["go"] != 9 || usage["npm"] != 5 {
+		t.Errorf("DepCacheStatus sizes = %v, want go=9 npm=5", usage)
+	}
+
+	freed, err := CleanDepCache(rigPath, []string{"go"})
+	if err != nil {
+		t.Fatalf("CleanDepCache(go) = %v", err)
+	}
+	if freed != 9 {
+		t.Errorf("freed = %d, want 9", freed)
+	}
+	if _, err := os.Stat(filepath.Join(root, "go-mod")); !os.IsNotExist(err) {
+		t.Errorf("go module cache still present: %v", err)
+	}
+	if _, err := os.Stat(npmDir); err != nil {
+		t.Errorf("npm cache should be kept: %v", err)
+	}
+
+	if _, err := CleanDepCache(rigPath, []string{"maven"}); err == nil {
+		t.Error("unknown cache kind should be an error")
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/steveyegge/gastown/internal/hooks"
 	"github.com/steveyegge/gastown/internal/templates/commands"
 	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/vcs"
+	"github.com/steveyegge/gastown/internal/worktree"
 )
 
 // Common errors
@@ -109,8 +111,48 @@ type RigConfig struct {
 	// PolecatNames optionally specifies fixed names (overrides theme-based naming).
 	PolecatPoolSize int      `json:"polecat_pool_size,omitempty"`
 	PolecatNames    []string `json:"polecat_names,omitempty"`
+
+	// WorktreePoolSize is the number of pre-warmed worktrees the daemon keeps
+	// ready for polecat spawns (gt polecat worktree-pool). 0 disables the pool.
+	WorktreePoolSize int `json:"worktree_pool_size,omitempty"`
+
+	// ObjectCache is a shared bare mirror of the repo that the rig's clones
+	// borrow objects from (see gt rig warm-cache). Rigs with the same git URL
+	// share one cache under <town>/.git-cache/.
+	ObjectCache string `json:"object_cache,omitempty"`
+
+	// VCS selects how polecat working copies are checked out (see package
+	// vcs). Nil means git worktrees of .repo.git.
+	VCS *vcs.Config `json:"vcs,omitempty"`
+
+	// Layout is how the mayor's checkout is made: LayoutClones (default) or
+	// LayoutWorktrees. Set at rig add time.
+	Layout string `json:"layout,omitempty"`
+
+	// Bootstrap prepares each new polecat worktree (see RunBootstrap). Nil
+	// falls back to the rig's bootstrap.sh, if any.
+	Bootstrap *BootstrapConfig `json:"bootstrap,omitempty"`
+
+	// DepCache names the package manager caches ("go", "pnpm", ...) that the
+	// rig's polecats share (see DepCacheEnv), so each new worktree doesn't
+	// download every dependency again.
+	DepCache []string `json:"dep_cache,omitempty"`
 }
 
+// Rig layouts.
+const (
+	// LayoutClones gives the mayor its own clone of the repo (borrowing
+	// objects from .repo.git). This is the default.
+	LayoutClones = "clones"
+
+	// LayoutWorktrees checks out every rig-level working copy, the mayor's
+	// included, as a linked worktree of the single bare repo .repo.git. For
+	// huge repos, where a second clone costs too much disk and time. The
+	// mayor's worktree is detached at origin/<default_branch> because the
+	// refinery's worktree holds the default branch.
+	LayoutWorktrees = "worktrees"
+)
+
 // BeadsConfig represents beads configuration for the rig.
 type BeadsConfig struct {
 	Prefix string `json:"prefix"` // issue prefix (e.g., "gt")
@@ -269,6 +311,7 @@ type AddRigOptions struct {
 	SkipDoltCheck  bool     // Skip Dolt server availability check (for tests with mocked beads)
 	CloneFilter    string   // Git clone filter spec (e.g. "blob:none", "tree:0") for partial clones
 	SparseCheckout []string // Sparse checkout paths (cone mode); empty means no sparse checkout
+	Layout         string   // LayoutClones (default) or LayoutWorktrees
 }
 
 func resolveLocalRepo(path, gitURL string) (string, string) {
@@ -335,6 +378,14 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		}
 	}
 
+	switch opts.Layout {
+	case "", LayoutClones:
+		opts.Layout = ""
+	case LayoutWorktrees:
+	default:
+		return nil, fmt.Errorf("unknown rig layout %q (supported: %s, %s)", opts.Layout, LayoutClones, LayoutWorktrees)
+	}
+
 	// Dolt server is required — refuse to proceed without it.
 	// Check early to fail fast before expensive clone operations.
 	if !opts.SkipDoltCheck {
@@ -371,6 +422,17 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		fmt.Printf("  Warning: %s\n", warn)
 	}
 
+	// Without a local repo, borrow objects from the town's shared cache for
+	// this URL if one was warmed (gt rig warm-cache on another rig).
+	reference := localRepo
+	var objectCache string
+	if reference == "" {
+		if cache := ObjectCachePath(m.townRoot, opts.GitURL); isDir(cache) {
+			fmt.Printf("  Using shared object cache %s\n", cache)
+			reference, objectCache = cache, cache
+		}
+	}
+
 	// Create container directory
 	if err := os.MkdirAll(rigPath, 0755); err != nil {
 		return nil, fmt.Errorf("creating rig directory: %w", err)
@@ -394,6 +456,8 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		PushURL:     opts.PushURL,
 		UpstreamURL: opts.UpstreamURL,
 		LocalRepo:   localRepo,
+		ObjectCache: objectCache,
+		Layout:      opts.Layout,
 		CreatedAt:   time.Now(),
 		Beads: &BeadsConfig{
 			Prefix: opts.BeadsPrefix,
@@ -412,8 +476,8 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 	// When branch is non-empty, git clone --branch is passed so HEAD and the initial
 	// single-branch fetch both target the user-specified branch instead of the remote HEAD.
 	cloneBareWith := func(branch string) error {
-		if opts.CloneFilter != "" && localRepo != "" {
-			if err := m.git.CloneBarePartialWithReferenceAndBranch(opts.GitURL, bareRepoPath, opts.CloneFilter, localRepo, branch); err != nil {
+		if opts.CloneFilter != "" && reference != "" {
+			if err := m.git.CloneBarePartialWithReferenceAndBranch(opts.GitURL, bareRepoPath, opts.CloneFilter, reference, branch); err != nil {
 				fmt.Printf("  Warning: could not use local repo reference with filter: %v\n", err)
 				_ = os.RemoveAll(bareRepoPath)
 				return m.git.CloneBarePartialWithBranch(opts.GitURL, bareRepoPath, opts.CloneFilter, branch)
@@ -421,8 +485,8 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 			return nil
 		} else if opts.CloneFilter != "" {
 			return m.git.CloneBarePartialWithBranch(opts.GitURL, bareRepoPath, opts.CloneFilter, branch)
-		} else if localRepo != "" {
-			if err := m.git.CloneBareWithReferenceAndBranch(opts.GitURL, bareRepoPath, localRepo, branch); err != nil {
+		} else if reference != "" {
+			if err := m.git.CloneBareWithReferenceAndBranch(opts.GitURL, bareRepoPath, reference, branch); err != nil {
 				fmt.Printf("  Warning: could not use local repo reference: %v\n", err)
 				_ = os.RemoveAll(bareRepoPath)
 				return m.git.CloneBareWithBranch(opts.GitURL, bareRepoPath, branch)
@@ -496,55 +560,17 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		return nil, fmt.Errorf("updating rig config with default branch: %w", err)
 	}
 
-	// Create mayor as regular clone (separate from bare repo).
-	// Mayor doesn't need to see polecat branches - that's refinery's job.
-	// This also allows mayor to stay on the default branch without conflicting with refinery.
-	// Uses --reference to borrow objects from the bare repo we just created,
-	// avoiding a redundant download from the remote (GH#1059).
-	fmt.Printf("  Creating mayor clone...\n")
 	mayorRigPath := filepath.Join(rigPath, "mayor", "rig")
 	if err := os.MkdirAll(filepath.Dir(mayorRigPath), 0755); err != nil {
 		return nil, fmt.Errorf("creating mayor dir: %w", err)
 	}
-	if opts.CloneFilter != "" {
-		if err := m.git.CloneBranchPartialWithReference(opts.GitURL, mayorRigPath, defaultBranch, opts.CloneFilter, bareRepoPath); err != nil {
-			fmt.Printf("  Warning: could not use bare repo as reference with filter: %v\n", err)
-			_ = os.RemoveAll(mayorRigPath)
-			if err := m.git.CloneBranchPartial(opts.GitURL, mayorRigPath, defaultBranch, opts.CloneFilter); err != nil {
-				return nil, fmt.Errorf("cloning for mayor: %w", err)
-			}
-		}
-	} else if err := m.git.CloneBranchWithReference(opts.GitURL, mayorRigPath, defaultBranch, bareRepoPath); err != nil {
-		fmt.Printf("  Warning: could not use bare repo as reference: %v\n", err)
-		_ = os.RemoveAll(mayorRigPath)
-		if err := m.git.CloneBranch(opts.GitURL, mayorRigPath, defaultBranch); err != nil {
-			return nil, fmt.Errorf("cloning for mayor: %w", err)
-		}
-	}
-
-	// Set up sparse checkout on mayor clone if requested
-	if len(opts.SparseCheckout) > 0 {
-		if err := git.InitSparseCheckout(mayorRigPath, opts.SparseCheckout); err != nil {
-			return nil, fmt.Errorf("initializing sparse checkout for mayor: %w", err)
-		}
-		fmt.Printf("   ✓ Configured sparse checkout: %v\n", opts.SparseCheckout)
-	}
-
-	// No explicit checkout needed - --branch already checked out the default branch
-	mayorGit := git.NewGitWithDir("", mayorRigPath)
-	// Configure push URL on mayor clone (separate clone, doesn't inherit from bare repo)
-	if opts.PushURL != "" {
-		if err := mayorGit.ConfigurePushURL("origin", opts.PushURL); err != nil {
-			return nil, fmt.Errorf("configuring mayor push URL: %w", err)
-		}
-	}
-	// Configure upstream remote on mayor clone (separate clone, doesn't inherit from bare repo)
-	if opts.UpstreamURL != "" {
-		if err := mayorGit.AddUpstreamRemote(opts.UpstreamURL); err != nil {
-			return nil, fmt.Errorf("configuring mayor upstream remote: %w", err)
+	if opts.Layout == LayoutWorktrees {
+		if err := m.addMayorWorktree(bareGit, mayorRigPath, defaultBranch, opts.SparseCheckout); err != nil {
+			return nil, err
 		}
+	} else if err := m.addMayorClone(opts, bareRepoPath, mayorRigPath, defaultBranch); err != nil {
+		return nil, err
 	}
-	fmt.Printf("   ✓ Created mayor clone\n")
 
 	// Check if source repo has tracked .beads/ directory.
 	// If so, we need to initialize the database (it doesn't exist after clone since DB files are gitignored).
@@ -595,7 +621,7 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 			// port, causing "database not found" errors. (GH #2405)
 			doltCfg := doltserver.DefaultConfig(m.townRoot)
 			initArgs = append(initArgs, "--server-port", strconv.Itoa(doltCfg.Port))
-			cmd := exec.Command("bd", initArgs...)
+			cmd := beads.Command(initArgs...)
 			cmd.Dir = mayorRigPath
 			if output, err := cmd.CombinedOutput(); err != nil {
 				fmt.Printf("  Warning: Could not init bd database: %v (%s)\n", err, strings.TrimSpace(string(output)))
@@ -610,11 +636,11 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		// metadata.json was tracked in git (bdDatabaseExists returned true).
 		// The tracked metadata.json tells bd HOW to connect but doesn't guarantee
 		// the server-side database has issue_prefix set for this workspace.
-		configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+		configCmd := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
 		configCmd.Dir = mayorRigPath
 		_, _ = configCmd.CombinedOutput() // Ignore errors - older beads don't need this
 
-		prefixSetCmd := exec.Command("bd", "config", "set", "issue_prefix", opts.BeadsPrefix)
+		prefixSetCmd := beads.Command("config", "set", "issue_prefix", opts.BeadsPrefix)
 		prefixSetCmd.Dir = mayorRigPath
 		if prefixOutput, prefixErr := prefixSetCmd.CombinedOutput(); prefixErr != nil {
 			fmt.Printf("  Warning: Could not set issue_prefix: %v (%s)\n", prefixErr, strings.TrimSpace(string(prefixOutput)))
@@ -668,13 +694,13 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 	// Now that EnsureMetadata has corrected dolt_database, re-set it.
 	{
 		resolvedBeadsDir := beads.ResolveBeadsDir(rigPath)
-		prefixCmd := exec.Command("bd", "config", "set", "issue_prefix", opts.BeadsPrefix)
+		prefixCmd := beads.Command("config", "set", "issue_prefix", opts.BeadsPrefix)
 		prefixCmd.Dir = rigPath
 		prefixCmd.Env = append(os.Environ(), "BEADS_DIR="+resolvedBeadsDir)
 		if out, err := prefixCmd.CombinedOutput(); err != nil {
 			fmt.Printf("  Warning: Could not set issue_prefix on rig database: %v (%s)\n", err, strings.TrimSpace(string(out)))
 		}
-		typesCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+		typesCmd := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
 		typesCmd.Dir = rigPath
 		typesCmd.Env = append(os.Environ(), "BEADS_DIR="+resolvedBeadsDir)
 		_, _ = typesCmd.CombinedOutput()
@@ -715,7 +741,7 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 	if err := os.MkdirAll(filepath.Dir(refineryRigPath), 0755); err != nil {
 		return nil, fmt.Errorf("creating refinery dir: %w", err)
 	}
-	if err := bareGit.WorktreeAddExisting(refineryRigPath, defaultBranch); err != nil {
+	if err := worktree.NewManager(bareGit).AddExisting(refineryRigPath, defaultBranch); err != nil {
 		return nil, fmt.Errorf("creating refinery worktree: %w", err)
 	}
 	refineryGit := git.NewGit(refineryRigPath)
@@ -890,6 +916,75 @@ Use crew for your own workspace. Polecats are for batch work dispatch.
 	return m.loadRig(opts.Name, m.config.Rigs[opts.Name])
 }
 
+// addMayorClone creates mayor/rig as a regular clone (separate from the bare
+// repo), the default rig layout.
+func (m *Manager) addMayorClone(opts AddRigOptions, bareRepoPath, mayorRigPath, defaultBranch string) error {
+	// Mayor doesn't need to see polecat branches - that's refinery's job.
+	// This also allows mayor to stay on the default branch without conflicting with refinery.
+	// Uses --reference to borrow objects from the bare repo we just created,
+	// avoiding a redundant download from the remote (GH#1059).
+	fmt.Printf("  Creating mayor clone...\n")
+	if opts.CloneFilter != "" {
+		if err := m.git.CloneBranchPartialWithReference(opts.GitURL, mayorRigPath, defaultBranch, opts.CloneFilter, bareRepoPath); err != nil {
+			fmt.Printf("  Warning: could not use bare repo as reference with filter: %v\n", err)
+			_ = os.RemoveAll(mayorRigPath)
+			if err := m.git.CloneBranchPartial(opts.GitURL, mayorRigPath, defaultBranch, opts.CloneFilter); err != nil {
+				return fmt.Errorf("cloning for mayor: %w", err)
+			}
+		}
+	} else if err := m.git.CloneBranchWithReference(opts.GitURL, mayorRigPath, defaultBranch, bareRepoPath); err != nil {
+		fmt.Printf("  Warning: could not use bare repo as reference: %v\n", err)
+		_ = os.RemoveAll(mayorRigPath)
+		if err := m.git.CloneBranch(opts.GitURL, mayorRigPath, defaultBranch); err != nil {
+			return fmt.Errorf("cloning for mayor: %w", err)
+		}
+	}
+
+	// Set up sparse checkout on mayor clone if requested
+	if len(opts.SparseCheckout) > 0 {
+		if err := git.InitSparseCheckout(mayorRigPath, opts.SparseCheckout); err != nil {
+			return fmt.Errorf("initializing sparse checkout for mayor: %w", err)
+		}
+		fmt.Printf("   ✓ Configured sparse checkout: %v\n", opts.SparseCheckout)
+	}
+
+	// No explicit checkout needed - --branch already checked out the default branch
+	mayorGit := git.NewGitWithDir("", mayorRigPath)
+	// Configure push URL on mayor clone (separate clone, doesn't inherit from bare repo)
+	if opts.PushURL != "" {
+		if err := mayorGit.ConfigurePushURL("origin", opts.PushURL); err != nil {
+			return fmt.Errorf("configuring mayor push URL: %w", err)
+		}
+	}
+	// Configure upstream remote on mayor clone (separate clone, doesn't inherit from bare repo)
+	if opts.UpstreamURL != "" {
+		if err := mayorGit.AddUpstreamRemote(opts.UpstreamURL); err != nil {
+			return fmt.Errorf("configuring mayor upstream remote: %w", err)
+		}
+	}
+	fmt.Printf("   ✓ Created mayor clone\n")
+	return nil
+}
+
+// addMayorWorktree creates mayor/rig as a linked worktree of the bare repo
+// (LayoutWorktrees). The worktree is detached at origin/<defaultBranch>: the
+// refinery's worktree holds the default branch itself. Push URL and upstream
+// remote need no setup since the worktree shares the bare repo's config.
+func (m *Manager) addMayorWorktree(bareGit *git.Git, mayorRigPath, defaultBranch string, sparse []string) error {
+	fmt.Printf("  Creating mayor worktree...\n")
+	if err := worktree.NewManager(bareGit).AddDetached(mayorRigPath, "origin/"+defaultBranch); err != nil {
+		return fmt.Errorf("creating mayor worktree: %w", err)
+	}
+	if len(sparse) > 0 {
+		if err := git.InitSparseCheckout(mayorRigPath, sparse); err != nil {
+			return fmt.Errorf("initializing sparse checkout for mayor: %w", err)
+		}
+		fmt.Printf("   ✓ Configured sparse checkout: %v\n", sparse)
+	}
+	fmt.Printf("   ✓ Created mayor worktree (detached at origin/%s)\n", defaultBranch)
+	return nil
+}
+
 // verifyRigIdentity checks that metadata.json points to the correct Dolt database
 // for this rig. This catches identity mismatches early — before polecats are spawned
 // and get stuck in retry loops. (gas-tc4)
@@ -935,6 +1030,11 @@ func (m *Manager) verifyRigIdentity(rigPath, rigName string) error {
 
 // saveRigConfig writes the rig configuration to config.json.
 func (m *Manager) saveRigConfig(rigPath string, cfg *RigConfig) error {
+	return SaveRigConfig(rigPath, cfg)
+}
+
+// SaveRigConfig writes the rig configuration to config.json.
+func SaveRigConfig(rigPath string, cfg *RigConfig) error {
 	configPath := filepath.Join(rigPath, "config.json")
 	data, err := json.MarshalIndent(cfg, "", "  ")
 	if err != nil {
@@ -1062,7 +1162,7 @@ func (m *Manager) InitBeads(rigPath, prefix, rigName string) error {
 	// Without this, bd auto-starts its own server on a random port. (GH #2405)
 	doltCfg := doltserver.DefaultConfig(m.townRoot)
 	initArgs = append(initArgs, "--server-port", strconv.Itoa(doltCfg.Port))
-	cmd := exec.Command("bd", initArgs...)
+	cmd := beads.Command(initArgs...)
 	cmd.Dir = rigPath
 	cmd.Env = filteredEnv
 	_, bdInitErr := cmd.CombinedOutput()
@@ -1074,7 +1174,7 @@ func (m *Manager) InitBeads(rigPath, prefix, rigName string) error {
 
 		// Configure custom types for Gas Town (agent, role, rig, convoy).
 		// These were extracted from beads core in v0.46.0 and now require explicit config.
-		configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
+		configCmd := beads.Command("config", "set", "types.custom", constants.BeadsCustomTypes)
 		configCmd.Dir = rigPath
 		configCmd.Env = filteredEnv
 		// Ignore errors - older beads versions don't need this
@@ -1082,7 +1182,7 @@ func (m *Manager) InitBeads(rigPath, prefix, rigName string) error {
 
 		// Explicitly set issue_prefix config (bd init --prefix may not persist it in newer versions).
 		// Without this, bd create and gt sling fail with "issue_prefix config is missing".
-		prefixSetCmd := exec.Command("bd", "config", "set", "issue_prefix", prefix)
+		prefixSetCmd := beads.Command("config", "set", "issue_prefix", prefix)
 		prefixSetCmd.Dir = rigPath
 		prefixSetCmd.Env = filteredEnv
 		if prefixOutput, prefixErr := prefixSetCmd.CombinedOutput(); prefixErr != nil {
@@ -1108,7 +1208,7 @@ func (m *Manager) InitBeads(rigPath, prefix, rigName string) error {
 	// Ensure database has repository fingerprint (GH #25).
 	// This is idempotent - safe on both new and legacy (pre-0.17.5) databases.
 	// Without fingerprint, the bd daemon fails to start silently.
-	migrateCmd := exec.Command("bd", "migrate", "--update-repo-id")
+	migrateCmd := beads.Command("migrate", "--update-repo-id")
 	migrateCmd.Dir = rigPath
 	migrateCmd.Env = filteredEnv
 	// Ignore errors - fingerprint is optional for functionality
@@ -1691,7 +1791,7 @@ func (m *Manager) ListRigNames() []string {
 // These molecules define the work loops for Deacon, Witness, and Refinery roles.
 func (m *Manager) seedPatrolMolecules(rigPath string) error {
 	// Use bd command to seed molecules (more reliable than internal API)
-	cmd := exec.Command("bd", "mol", "seed", "--patrol")
+	cmd := beads.Command("mol", "seed", "--patrol")
 	cmd.Dir = rigPath
 	if err := cmd.Run(); err != nil {
 		// Fallback: bd mol seed might not support --patrol yet
@@ -1724,7 +1824,7 @@ func (m *Manager) seedPatrolMoleculesManually(rigPath string) error {
 
 	for _, mol := range patrolMols {
 		// Check if already exists by title
-		checkCmd := exec.Command("bd", "list", "--type=molecule", "--format=json")
+		checkCmd := beads.Command("list", "--type=molecule", "--format=json")
 		checkCmd.Dir = rigPath
 		output, _ := checkCmd.Output()
 		if strings.Contains(string(output), mol.title) {
@@ -1732,7 +1832,7 @@ func (m *Manager) seedPatrolMoleculesManually(rigPath string) error {
 		}
 
 		// Create the molecule
-		cmd := exec.Command("bd", "create", //nolint:gosec // G204: bd is a trusted internal tool
+		cmd := beads.Command("create", //nolint:gosec // G204: bd is a trusted internal tool
 			"--type=molecule",
 			"--title="+mol.title,
 			"--description="+mol.desc,
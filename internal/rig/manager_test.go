@@ -1600,3 +1600,75 @@ func TestBareCloneDefaultBranch(t *testing.T) {
 		t.Errorf("DefaultBranch() = %q, want %q", got, "master")
 	}
 }
+
+func TestAddRig_WorktreesLayout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell-based bd shim not reliable on Windows CI")
+	}
+
+	fakeBDForAddRig(t)
+
+	root, rigsConfig := setupTestTown(t)
+	repoURL := createTestGitRepoForRig(t, "mono")
+	upstreamURL := createTestGitRepoForRig(t, "upstream")
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	if _, err := manager.AddRig(AddRigOptions{
+		Name:          "badlayout",
+		GitURL:        repoURL,
+		Layout:        "svn",
+		SkipDoltCheck: true,
+	}); err == nil || !strings.Contains(err.Error(), "unknown rig layout") {
+		t.Fatalf("AddRig with unknown layout error = %v, want unknown rig layout", err)
+	}
+
+	if _, err := manager.AddRig(AddRigOptions{
+		Name:          "mono",
+		GitURL:        repoURL,
+		UpstreamURL:   upstreamURL,
+		BeadsPrefix:   "mo",
+		Layout:        LayoutWorktrees,
+		SkipDoltCheck: true,
+	}); err != nil {
+		t.Fatalf("AddRig: %v", err)
+	}
+
+	rigPath := filepath.Join(root, "mono")
+	mayorRigPath := filepath.Join(rigPath, "mayor", "rig")
+	mayorGit := git.NewGit(mayorRigPath)
+
+	info, err := os.Lstat(filepath.Join(mayorRigPath, ".git"))
+	if err != nil {
+		t.Fatalf("mayor/rig/.git: %v", err)
+	}
+	if !info.Mode().IsRegular() {
+		t.Error("mayor/rig is a clone, want a linked worktree")
+	}
+	common, err := mayorGit.CommonDir()
+	if err != nil {
+		t.Fatalf("CommonDir: %v", err)
+	}
+	got, _ := filepath.EvalSymlinks(common)
+	if want, _ := filepath.EvalSymlinks(filepath.Join(rigPath, ".repo.git")); got != want {
+		t.Errorf("mayor worktree git dir = %q, want %q", got, want)
+	}
+	if branch, _ := mayorGit.CurrentBranch(); branch != "HEAD" {
+		t.Errorf("mayor worktree branch = %q, want detached HEAD", branch)
+	}
+	// Remotes come from the shared bare repo config.
+	if got, _ := mayorGit.GetUpstreamURL(); got != upstreamURL {
+		t.Errorf("mayor upstream = %q, want %q", got, upstreamURL)
+	}
+	// The refinery still holds the default branch.
+	if branch, _ := git.NewGit(filepath.Join(rigPath, "refinery", "rig")).CurrentBranch(); branch != "main" {
+		t.Errorf("refinery branch = %q, want main", branch)
+	}
+
+	cfg, err := LoadRigConfig(rigPath)
+	if err != nil {
+		t.Fatalf("LoadRigConfig: %v", err)
+	}
+	if cfg.Layout != LayoutWorktrees {
+		t.Errorf("config.json layout = %q, want %q", cfg.Layout, LayoutWorktrees)
+	}
+}
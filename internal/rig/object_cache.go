@@ -0,0 +1,138 @@
+package rig
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// ObjectCacheDir is the town-level directory holding shared object caches.
+const ObjectCacheDir = ".git-cache"
+
+// ObjectCachePath returns the default shared object cache for a git URL:
+// <town>/.git-cache/<repo>-<hash>.git. Rigs cloned from the same URL map to
+// the same cache.
+func ObjectCachePath(townRoot, gitURL string) string {
+	name := strings.TrimSuffix(path.Base(strings.TrimSuffix(gitURL, "/")), ".git")
+	name = strings.Map(func(r rune) rune {
+		if r == '/' || r == ':' || r == '\\' || r == ' ' {
+			return '_'
+		}
+		return r
+	}, name)
+	sum := sha256.Sum256([]byte(gitURL))
+	return filepath.Join(townRoot, ObjectCacheDir, fmt.Sprintf("%s-%s.git", name, hex.EncodeToString(sum[:4])))
+}
+
+// ObjectCacheFor returns the rig's configured object cache if it exists on
+// disk, or "" if the rig has none.
+func ObjectCacheFor(rigPath string) string {
+	cfg, err := LoadRigConfig(rigPath)
+	if err != nil || cfg.ObjectCache == "" {
+		return ""
+	}
+	if !isDir(cfg.ObjectCache) {
+		return ""
+	}
+	return cfg.ObjectCache
+}
+
+func isDir(p string) bool {
+	info, err := os.Stat(p)
+	return err == nil && info.IsDir()
+}
+
+// WarmObjectCache creates the rig's shared object cache (a full mirror of
+// git_url) or fetches into it if it already exists, then links the rig's bare
+// repo to it so polecat worktrees and fetches reuse its objects. gc in the
+// cache is configured never to delete objects (git.KeepObjects). Records the
+// cache in the rig's config.json and returns its path.
+func WarmObjectCache(townRoot, rigPath string) (string, error) {
+	cfg, err := LoadRigConfig(rigPath)
+	if err != nil {
+		return "", fmt.Errorf("loading rig config: %w", err)
+	}
+	if cfg.GitURL == "" {
+		return "", fmt.Errorf("rig has no git_url")
+	}
+
+	cache := cfg.ObjectCache
+	if cache == "" {
+		cache = ObjectCachePath(townRoot, cfg.GitURL)
+	}
+
+	if _, err := os.Stat(cache); os.IsNotExist(err) {
+		if err := git.NewGit(townRoot).CloneMirror(cfg.GitURL, cache); err != nil {
+			return "", fmt.Errorf("creating object cache: %w", err)
+		}
+	} else if err := git.NewGitWithDir(cache, "").FetchPrune("origin"); err != nil {
+		return "", fmt.Errorf("refreshing object cache: %w", err)
+	}
+	// Rig repos borrow the cache's objects: gc there must never drop one.
+	if err := git.NewGitWithDir(cache, "").KeepObjects(); err != nil {
+		return "", fmt.Errorf("configuring object cache: %w", err)
+	}
+
+	bareRepo := filepath.Join(rigPath, ".repo.git")
+	if isDir(bareRepo) {
+		if err := addAlternate(bareRepo, filepath.Join(cache, "objects")); err != nil {
+			return "", fmt.Errorf("linking bare repo to object cache: %w", err)
+		}
+	}
+
+	if cfg.ObjectCache != cache {
+		cfg.ObjectCache = cache
+		if err := SaveRigConfig(rigPath, cfg); err != nil {
+			return "", fmt.Errorf("saving rig config: %w", err)
+		}
+	}
+	return cache, nil
+}
+
+// StartObjectCacheRefresh fetches into the rig's object cache in a detached
+// background process, so callers that just moved a base branch (the refinery
+// after a merge) don't wait on it. No-op if the rig has no cache.
+func StartObjectCacheRefresh(rigPath string) {
+	cache := ObjectCacheFor(rigPath)
+	if cache == "" {
+		return
+	}
+	// gc.auto=0 as well for caches warmed before KeepObjects was set.
+	cmd := exec.Command("git", "--git-dir", cache, "-c", "gc.auto=0", "fetch", "--prune", "--quiet", "origin")
+	util.SetDetachedProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return
+	}
+	go func() { _ = cmd.Wait() }()
+}
+
+// addAlternate adds objectsDir to a repo's objects/info/alternates, so git
+// reads objects from there instead of fetching them again.
+func addAlternate(gitDir, objectsDir string) error {
+	altPath := filepath.Join(gitDir, "objects", "info", "alternates")
+	data, err := os.ReadFile(altPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(line) == objectsDir {
+			return nil
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(altPath), 0755); err != nil {
+		return err
+	}
+	content := string(data)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return os.WriteFile(altPath, []byte(content+objectsDir+"\n"), 0644)
+}
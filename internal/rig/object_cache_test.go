@@ -0,0 +1,99 @@
+package rig
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestObjectCachePath(t *testing.T) {
+	a := ObjectCachePath("/town", "git@github.com:steveyegge/gastown.git")
+	b := ObjectCachePath("/town", "https://github.com/steveyegge/gastown")
+	if filepath.Dir(a) != filepath.Join("/town", ObjectCacheDir) {
+		t.Errorf("cache %q not under %s", a, ObjectCacheDir)
+	}
+	if !strings.HasPrefix(filepath.Base(a), "gastown-") || !strings.HasSuffix(a, ".git") {
+		t.Errorf("unexpected cache name %q", a)
+	}
+	if a == b {
+		t.Error("different URLs share a cache path")
+	}
+	if a != ObjectCachePath("/town", "git@github.com:steveyegge/gastown.git") {
+		t.Error("cache path is not deterministic")
+	}
+}
+
+func TestAddAlternate_Idempotent(t *testing.T) {
+	gitDir := t.TempDir()
+	for i := 0; i < 2; i++ {
+		if err := addAlternate(gitDir, "/cache/objects"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := addAlternate(gitDir, "/other/objects"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(gitDir, "objects", "info", "alternates"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data); got != "/cache/objects\n/other/objects\n" {
+		t.Errorf("alternates = %q", got)
+	}
+}
+
+func TestWarmObjectCache(t *testing.T) {
+	town := t.TempDir()
+	upstream := filepath.Join(town, "upstream")
+	rigPath := filepath.Join(town, "rig")
+	run := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	if err := os.MkdirAll(upstream, 0755); err != nil {
+		t.Fatal(err)
+	}
+	run(upstream, "init", "-b", "main")
+	run(upstream, "-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "init")
+	if err := os.MkdirAll(rigPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	run(rigPath, "init", "--bare", ".repo.git")
+	if err := SaveRigConfig(rigPath, &RigConfig{Type: "rig", Name: "rig", GitURL: upstream}); err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err := WarmObjectCache(town, rigPath)
+	if err != nil {
+		t.Fatalf("WarmObjectCache: %v", err)
+	}
+	if cache != ObjectCachePath(town, upstream) {
+		t.Errorf("cache = %q, want default path", cache)
+	}
+	if ObjectCacheFor(rigPath) != cache {
+		t.Errorf("object_cache not recorded in rig config")
+	}
+	alt, err := os.ReadFile(filepath.Join(rigPath, ".repo.git", "objects", "info", "alternates"))
+	if err != nil || !strings.Contains(string(alt), filepath.Join(cache, "objects")) {
+		t.Errorf("bare repo not linked to cache: %q (%v)", alt, err)
+	}
+	if out, err := exec.Command("git", "--git-dir", cache, "config", "gc.pruneExpire").Output(); err != nil || strings.TrimSpace(string(out)) != "never" {
+		t.Errorf("cache gc.pruneExpire = %q (%v), want never", out, err)
+	}
+
+	// Warming again refreshes the existing cache.
+	run(upstream, "-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "second")
+	if _, err := WarmObjectCache(town, rigPath); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	out, err := exec.Command("git", "--git-dir", cache, "rev-list", "--count", "main").Output()
+	if err != nil || strings.TrimSpace(string(out)) != "2" {
+		t.Errorf("cache not refreshed: %q (%v)", out, err)
+	}
+}
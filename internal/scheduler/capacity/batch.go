@@ -0,0 +1,95 @@
+package capacity
+
+import "strings"
+
+// LabelBatchable marks a work bead as small enough to share a polecat with
+// other batchable beads in the same rig.
+const LabelBatchable = "gt:batchable"
+
+// BatchFormula is the formula a batch leader is dispatched with. It works
+// the leader and its batch sequentially on one branch.
+const BatchFormula = "mol-polecat-batch"
+
+// DefaultMaxBatchedBeads is the default cap on beads per batch.
+const DefaultMaxBatchedBeads = 3
+
+// batchableFormulas are the formulas a batch can stand in for. Beads slung
+// with any other formula carry their own workflow and are never batched.
+var batchableFormulas = map[string]bool{
+	"":                 true,
+	"mol-polecat-work": true,
+}
+
+// HasBatchableLabel reports whether labels include LabelBatchable.
+func HasBatchableLabel(labels []string) bool {
+	for _, l := range labels {
+		if l == LabelBatchable {
+			return true
+		}
+	}
+	return false
+}
+
+// batchKey returns the key beads must share to be batched together, or ""
+// if the bead cannot be batched. Beads with the same key target the same
+// rig and would be dispatched with identical options, so one polecat can
+// work them on one branch without conflicting settings.
+func batchKey(b PendingBead) string {
+	f := b.Context
+	if f == nil || !f.Batchable || !batchableFormulas[f.Formula] {
+		return ""
+	}
+	// Per-bead instructions and special modes need their own polecat.
+	if f.Args != "" || f.ReviewOnly || f.HookRawBead || f.Mode != "" {
+		return ""
+	}
+	// The refinery closes members when the leader's MR merges, so work
+	// that lands any other way would leave them open.
+	if f.NoMerge || (f.Merge != "" && f.Merge != "mr") {
+		return ""
+	}
+	return strings.Join([]string{
+		f.TargetRig, f.Vars, f.BaseBranch, f.Account, f.Agent,
+	}, "\x00")
+}
+
+// GroupBatches folds compatible batchable beads into batches of at most
+// maxPerBatch, so each batch takes one dispatch slot. The first bead of a
+// batch (in the given priority order) leads it and holds the rest in Batch;
+// other beads pass through unchanged and keep their relative order.
+// maxPerBatch <= 1 disables batching.
+func GroupBatches(pending []PendingBead, maxPerBatch int) []PendingBead {
+	if maxPerBatch <= 1 {
+		return pending
+	}
+	result := make([]PendingBead, 0, len(pending))
+	open := make(map[string]int) // batch key → index of its leader in result
+	for _, b := range pending {
+		key := batchKey(b)
+		if key == "" {
+			result = append(result, b)
+			continue
+		}
+		if i, ok := open[key]; ok {
+			leader := &result[i]
+			leader.Batch = append(leader.Batch, b)
+			if len(leader.Batch)+1 >= maxPerBatch {
+				delete(open, key)
+			}
+			continue
+		}
+		b.Batch = nil
+		result = append(result, b)
+		open[key] = len(result) - 1
+	}
+	return result
+}
+
+// BatchWorkBeadIDs returns the work bead IDs of b and its batch, leader first.
+func BatchWorkBeadIDs(b PendingBead) []string {
+	ids := []string{b.WorkBeadID}
+	for _, m := range b.Batch {
+		ids = append(ids, m.WorkBeadID)
+	}
+	return ids
+}
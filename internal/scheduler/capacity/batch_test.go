@@ -0,0 +1,103 @@
+package capacity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func batchBead(id, rig string, batchable bool) PendingBead {
+	return PendingBead{
+		ID:         "ctx-" + id,
+		WorkBeadID: id,
+		TargetRig:  rig,
+		Context: &SlingContextFields{
+			WorkBeadID: id,
+			TargetRig:  rig,
+			Formula:    "mol-polecat-work",
+			Batchable:  batchable,
+		},
+	}
+}
+
+func leaderIDs(beads []PendingBead) [][]string {
+	var out [][]string
+	for _, b := range beads {
+		out = append(out, BatchWorkBeadIDs(b))
+	}
+	return out
+}
+
+func TestGroupBatches(t *testing.T) {
+	withArgs := batchBead("d", "gastown", true)
+	withArgs.Context.Args = "be careful"
+	otherFormula := batchBead("e", "gastown", true)
+	otherFormula.Context.Formula = "shiny"
+	otherBase := batchBead("f", "gastown", true)
+	otherBase.Context.BaseBranch = "release"
+	noMerge := batchBead("h", "gastown", true)
+	noMerge.Context.NoMerge = true
+	direct := batchBead("i", "gastown", true)
+	direct.Context.Merge = "direct"
+	viaMR := batchBead("c", "gastown", true)
+	viaMR.Context.Merge = "mr"
+
+	pending := []PendingBead{
+		batchBead("a", "gastown", true),
+		batchBead("x", "gastown", false),
+		batchBead("b", "gastown", true),
+		batchBead("w", "beads", true),
+		withArgs,
+		otherFormula,
+		otherBase,
+		noMerge,
+		direct,
+		viaMR,
+		batchBead("g", "gastown", true),
+	}
+
+	got := leaderIDs(GroupBatches(pending, 3))
+	want := [][]string{
+		{"a", "b", "c"}, // full at 3; g starts a new batch
+		{"x"},
+		{"w"},
+		{"d"},
+		{"e"},
+		{"f"},
+		{"h"}, // Lands without an MR: nothing would close members
+		{"i"},
+		{"g"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBatches() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupBatches_Disabled(t *testing.T) {
+	pending := []PendingBead{batchBead("a", "gastown", true), batchBead("b", "gastown", true)}
+	for _, max := range []int{0, 1} {
+		if got := GroupBatches(pending, max); len(got) != 2 || len(got[0].Batch) != 0 {
+			t.Errorf("GroupBatches(max=%d) batched: %v", max, leaderIDs(got))
+		}
+	}
+}
+
+func TestGroupBatches_CountsAsOneSlot(t *testing.T) {
+	pending := GroupBatches([]PendingBead{
+		batchBead("a", "gastown", true),
+		batchBead("b", "gastown", true),
+		batchBead("c", "beads", false),
+	}, 3)
+	plan := PlanDispatch(1, 5, pending)
+	if len(plan.ToDispatch) != 1 || !reflect.DeepEqual(BatchWorkBeadIDs(plan.ToDispatch[0]), []string{"a", "b"}) {
+		t.Errorf("plan = %v, want one batch [a b]", leaderIDs(plan.ToDispatch))
+	}
+}
+
+func TestHasBatchableLabel(t *testing.T) {
+	if !HasBatchableLabel([]string{"bug", LabelBatchable}) {
+		t.Error("expected label to be found")
+	}
+	if HasBatchableLabel([]string{"bug"}) {
+		t.Error("unexpected match")
+	}
+}
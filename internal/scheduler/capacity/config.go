@@ -28,6 +28,53 @@ type SchedulerConfig struct {
 	// SpawnDelay is the delay between spawns to prevent Dolt lock contention.
 	// Default: "0s".
 	SpawnDelay string `json:"spawn_delay,omitempty"`
+
+	// AutoEnqueue makes each dispatch cycle first schedule new unassigned
+	// beads that match one of Rules. Default: false (manual scheduling only).
+	AutoEnqueue bool `json:"auto_enqueue,omitempty"`
+
+	// Rules route beads to a rig/formula/priority for auto-enqueue.
+	// Evaluated in order; the first matching rule wins.
+	Rules []RoutingRule `json:"rules,omitempty"`
+
+	// MaxBatchedBeads caps how many gt:batchable beads one polecat is given
+	// per dispatch (see GroupBatches). nil/absent = default (3). 1 disables
+	// batching.
+	MaxBatchedBeads *int `json:"max_batched_beads,omitempty"`
+
+	// Adaptive tunes batch size and spawn delay each cycle from recent sling
+	// latency and failures (see AdjustThrottle). BatchSize becomes the
+	// ceiling and SpawnDelay the floor. Default: false (fixed values).
+	Adaptive bool `json:"adaptive,omitempty"`
+
+	// MaxAge is how long a bead may sit in the queue before it is flagged
+	// gt:queue-stale: days ("14d") or a Go duration ("336h"). Empty = no
+	// maximum.
+	MaxAge string `json:"max_age,omitempty"`
+
+	// CancelStale removes beads queued longer than MaxAge from the queue,
+	// notifying the overseer, instead of only flagging them. Default: false.
+	CancelStale bool `json:"cancel_stale,omitempty"`
+
+	// QueueNotes posts each queued bead's position, dispatch estimate and
+	// target rig as a comment on the bead when it is enqueued, and again
+	// when they change significantly. Default: false.
+	QueueNotes bool `json:"queue_notes,omitempty"`
+
+	// StarvationCycles is how many dispatch cycles a ready bead may be
+	// passed over for other work before it is boosted to the front of the
+	// queue. nil/absent = default (20). 0 disables starvation detection.
+	StarvationCycles *int `json:"starvation_cycles,omitempty"`
+
+	// LimitCap keeps concurrent polecats (and the batch size) below the
+	// concurrency at which usage limits have recently been hitting (see
+	// ComputeLimitCap): "off" (default), "low", "medium" or "high".
+	LimitCap string `json:"limit_cap,omitempty"`
+
+	// ConflictGroups are named sets of path globs (e.g. "migrations":
+	// ["migrations/**"]). Beads whose predicted paths fall in the same group
+	// are dispatched one at a time (see SerializeConflicts).
+	ConflictGroups map[string][]string `json:"conflict_groups,omitempty"`
 }
 
 // DefaultSchedulerConfig returns a SchedulerConfig with sensible defaults.
@@ -58,6 +105,30 @@ func (c *SchedulerConfig) GetBatchSize() int {
 	return *c.BatchSize
 }
 
+// GetMaxBatchedBeads returns MaxBatchedBeads or the default (3) if unset.
+func (c *SchedulerConfig) GetMaxBatchedBeads() int {
+	if c == nil || c.MaxBatchedBeads == nil {
+		return DefaultMaxBatchedBeads
+	}
+	return *c.MaxBatchedBeads
+}
+
+// GetStarvationCycles returns StarvationCycles or the default (20) if unset.
+func (c *SchedulerConfig) GetStarvationCycles() int {
+	if c == nil || c.StarvationCycles == nil {
+		return DefaultStarvationCycles
+	}
+	return *c.StarvationCycles
+}
+
+// GetLimitCap returns LimitCap, defaulting to "off".
+func (c *SchedulerConfig) GetLimitCap() string {
+	if c == nil || c.LimitCap == "" {
+		return LimitCapOff
+	}
+	return c.LimitCap
+}
+
 // GetSpawnDelay returns SpawnDelay as a duration, defaulting to 0s.
 func (c *SchedulerConfig) GetSpawnDelay() time.Duration {
 	if c == nil || c.SpawnDelay == "" {
@@ -66,6 +137,19 @@ func (c *SchedulerConfig) GetSpawnDelay() time.Duration {
 	return ParseDurationOrDefault(c.SpawnDelay, 0)
 }
 
+// GetMaxAge returns MaxAge as a duration, or 0 (no maximum) if unset or
+// invalid.
+func (c *SchedulerConfig) GetMaxAge() time.Duration {
+	if c == nil {
+		return 0
+	}
+	d, err := ParseMaxAge(c.MaxAge)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
 // IsDeferred returns true when the scheduler is configured for deferred dispatch
 // (max_polecats > 0). Returns false for direct dispatch (-1) and disabled (0).
 func (c *SchedulerConfig) IsDeferred() bool {
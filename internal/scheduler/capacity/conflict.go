@@ -0,0 +1,192 @@
+package capacity
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ConflictHold records the work bead holding a conflict group: it was
+// dispatched and has not finished, so other beads in the group wait.
+type ConflictHold struct {
+	Bead  string `json:"bead"`
+	Since string `json:"since"` // RFC 3339
+}
+
+// ConflictKey returns the ConflictHolds key for group in rig. Conflict
+// groups are configured town-wide but paths are per repo, so each rig
+// serializes its own beads: rig "a" holding "migrations" doesn't hold up
+// rig "b".
+func ConflictKey(rig, group string) string {
+	return rig + "/" + group
+}
+
+// conflictKeyGroup returns the group name of a ConflictHolds key. Keys
+// written before holds were per rig are the bare group name.
+func conflictKeyGroup(key string) string {
+	if _, g, ok := strings.Cut(key, "/"); ok {
+		return g
+	}
+	return key
+}
+
+// ConflictGroupsFor returns the names of the conflict groups (name → path
+// globs, scheduler.conflict_groups) that paths fall in, sorted. paths may
+// themselves be globs, as formulas declare them.
+func ConflictGroupsFor(paths []string, groups map[string][]string) []string {
+	var names []string
+	for _, name := range slices.Sorted(maps.Keys(groups)) {
+		if anyGlobsOverlap(paths, groups[name]) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// BeadConflictGroups returns the conflict groups of a scheduled bead's
+// predicted paths. Beads without predicted paths are in no group.
+func BeadConflictGroups(b PendingBead, groups map[string][]string) []string {
+	if b.Context == nil || len(b.Context.Paths) == 0 {
+		return nil
+	}
+	return ConflictGroupsFor(b.Context.Paths, groups)
+}
+
+// SerializeConflicts keeps beads of one rig in the same conflict group from
+// being dispatched concurrently. A bead is left queued when one of its groups is
+// held by in-flight work (holds) or taken by an earlier bead in pending, so
+// within a group beads dispatch one at a time in queue order. It returns the
+// beads that may dispatch and the ones left queued.
+func SerializeConflicts(pending []PendingBead, groups map[string][]string, holds map[string]ConflictHold) ([]PendingBead, []SkippedBead) {
+	if len(groups) == 0 {
+		return pending, nil
+	}
+	taken := make(map[string]string, len(holds)) // ConflictKey → work bead holding it
+	for g, h := range holds {
+		taken[g] = h.Bead
+	}
+	kept := make([]PendingBead, 0, len(pending))
+	var skipped []SkippedBead
+	for _, b := range pending {
+		bg := BeadConflictGroups(b, groups)
+		blocked := ""
+		for _, g := range bg {
+			if holder, ok := taken[ConflictKey(b.TargetRig, g)]; ok && holder != b.WorkBeadID {
+				blocked = g + " held by " + holder
+				break
+			}
+		}
+		if blocked != "" {
+			skipped = append(skipped, SkipBead(b, SkipConflict, blocked))
+			continue
+		}
+		for _, g := range bg {
+			taken[ConflictKey(b.TargetRig, g)] = b.WorkBeadID
+		}
+		kept = append(kept, b)
+	}
+	return kept, skipped
+}
+
+// HoldConflicts records the dispatched bead b as holding its conflict
+// groups in its target rig until ReleaseConflicts finds it finished.
+func (s *SchedulerState) HoldConflicts(b PendingBead, groups map[string][]string, now time.Time) {
+	for _, g := range BeadConflictGroups(b, groups) {
+		if s.ConflictHolds == nil {
+			s.ConflictHolds = make(map[string]ConflictHold)
+		}
+		s.ConflictHolds[ConflictKey(b.TargetRig, g)] = ConflictHold{Bead: b.WorkBeadID, Since: now.UTC().Format(time.RFC3339)}
+	}
+}
+
+// ReleaseConflicts drops the holds of groups no longer configured and of
+// work beads that are no longer in flight. It returns the released keys
+// (see ConflictKey).
+func (s *SchedulerState) ReleaseConflicts(groups map[string][]string, inFlight func(bead string) bool) []string {
+	var released []string
+	for _, k := range slices.Sorted(maps.Keys(s.ConflictHolds)) {
+		if _, ok := groups[conflictKeyGroup(k)]; ok && inFlight(s.ConflictHolds[k].Bead) {
+			continue
+		}
+		delete(s.ConflictHolds, k)
+		released = append(released, k)
+	}
+	if len(s.ConflictHolds) == 0 {
+		s.ConflictHolds = nil
+	}
+	return released
+}
+
+// ConflictHoldBeads returns the work beads holding conflict groups.
+func (s *SchedulerState) ConflictHoldBeads() []string {
+	var ids []string
+	for _, h := range s.ConflictHolds {
+		if !slices.Contains(ids, h.Bead) {
+			ids = append(ids, h.Bead)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// anyGlobsOverlap reports whether any of paths overlaps any of globs.
+func anyGlobsOverlap(paths, globs []string) bool {
+	for _, p := range paths {
+		for _, g := range globs {
+			if globsOverlap(g, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// globsOverlap reports whether two slash-separated path globs can name the
+// same file. A glob ending in "/" or "/**" covers the whole tree under it;
+// otherwise globs are path.Match patterns, tried in both directions so a
+// literal path matches a pattern either way round.
+func globsOverlap(a, b string) bool {
+	a, aTree := normalizeGlob(a)
+	b, bTree := normalizeGlob(b)
+	if (aTree && underTree(a, b)) || (bTree && underTree(b, a)) {
+		return true
+	}
+	if ok, _ := path.Match(a, b); ok {
+		return true
+	}
+	ok, _ := path.Match(b, a)
+	return ok
+}
+
+// normalizeGlob strips a leading "./" and a trailing "/" or "/**",
+// reporting whether the glob covers a tree.
+func normalizeGlob(g string) (string, bool) {
+	g = strings.TrimPrefix(strings.TrimSpace(g), "./")
+	if g == "**" {
+		return "", true
+	}
+	if base, ok := strings.CutSuffix(g, "/**"); ok {
+		return base, true
+	}
+	if base, ok := strings.CutSuffix(g, "/"); ok {
+		return base, true
+	}
+	return g, false
+}
+
+// underTree reports whether p lies in the tree rooted at base, which may
+// itself contain glob characters (e.g. "services/*/migrations").
+func underTree(base, p string) bool {
+	if base == "" {
+		return true
+	}
+	n := strings.Count(base, "/") + 1
+	segs := strings.Split(p, "/")
+	if len(segs) < n {
+		return false
+	}
+	ok, _ := path.Match(base, strings.Join(segs[:n], "/"))
+	return ok
+}
@@ -0,0 +1,112 @@
+package capacity
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGlobsOverlap(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"migrations/**", "migrations/001_init.sql", true},
+		{"migrations/", "migrations/sub/002.sql", true},
+		{"migrations/**", "migrations", true},
+		{"migrations/**", "db/migrations/001.sql", false},
+		{"services/*/migrations/**", "services/api/migrations/003.sql", true},
+		{"db/**", "db/migrations/**", true},
+		{"db/migrations/**", "db/**", true},
+		{"go.mod", "./go.mod", true},
+		{"*.proto", "api.proto", true},
+		{"api.proto", "*.proto", true},
+		{"*.proto", "proto/api.proto", false},
+		{"**", "anything/at/all", true},
+		{"docs/**", "internal/cmd/sling.go", false},
+	}
+	for _, tt := range tests {
+		if got := globsOverlap(tt.a, tt.b); got != tt.want {
+			t.Errorf("globsOverlap(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSerializeConflicts(t *testing.T) {
+	groups := map[string][]string{
+		"migrations": {"migrations/**"},
+		"schema":     {"internal/schema/**", "*.proto"},
+	}
+	bead := func(id string, paths ...string) PendingBead {
+		return PendingBead{ID: "ctx-" + id, WorkBeadID: id, TargetRig: "gastown", Context: &SlingContextFields{Paths: paths}}
+	}
+	pending := []PendingBead{
+		bead("gt-a", "migrations/001.sql"),
+		bead("gt-b", "migrations/002.sql", "README.md"),
+		bead("gt-c", "api.proto"),
+		bead("gt-d"),
+		bead("gt-e", "internal/schema/types.go"),
+		{ID: "ctx-f", WorkBeadID: "gt-f", TargetRig: "gastown"}, // No context: never held
+	}
+
+	kept, skipped := SerializeConflicts(pending, groups, nil)
+	var ids []string
+	for _, b := range kept {
+		ids = append(ids, b.WorkBeadID)
+	}
+	if want := []string{"gt-a", "gt-c", "gt-d", "gt-f"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("kept = %v, want %v", ids, want)
+	}
+	if len(skipped) != 2 || skipped[0].Reason != SkipConflict || skipped[0].Detail != "migrations held by gt-a" ||
+		skipped[1].Detail != "schema held by gt-c" {
+		t.Errorf("skipped = %+v", skipped)
+	}
+
+	// In-flight work holds its group across cycles; the holder itself passes.
+	holds := map[string]ConflictHold{"gastown/migrations": {Bead: "gt-z"}, "gastown/schema": {Bead: "gt-c"}}
+	kept, skipped = SerializeConflicts(pending, groups, holds)
+	if len(kept) != 3 || kept[0].WorkBeadID != "gt-c" || len(skipped) != 3 || skipped[0].Detail != "migrations held by gt-z" {
+		t.Errorf("with holds: kept = %+v, skipped = %+v", kept, skipped)
+	}
+
+	if kept, skipped := SerializeConflicts(pending, nil, holds); len(kept) != len(pending) || skipped != nil {
+		t.Errorf("no groups configured should keep everything: %d kept, %+v", len(kept), skipped)
+	}
+
+	// Each rig is its own repo: holds in one rig don't serialize another.
+	other := bead("bd-a", "migrations/001.sql")
+	other.TargetRig = "beads"
+	if kept, skipped := SerializeConflicts([]PendingBead{pending[0], other}, groups, holds); len(kept) != 1 || kept[0].WorkBeadID != "bd-a" || len(skipped) != 1 {
+		t.Errorf("other rig: kept = %+v, skipped = %+v", kept, skipped)
+	}
+	if kept, _ := SerializeConflicts([]PendingBead{pending[0], other}, groups, nil); len(kept) != 2 {
+		t.Errorf("same group in two rigs should both dispatch: kept = %+v", kept)
+	}
+}
+
+func TestConflictHolds(t *testing.T) {
+	groups := map[string][]string{"migrations": {"migrations/**"}, "schema": {"*.proto"}}
+	s := &SchedulerState{}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.HoldConflicts(PendingBead{WorkBeadID: "gt-x"}, groups, now)
+	if s.ConflictHolds != nil {
+		t.Fatalf("bead without paths took holds: %v", s.ConflictHolds)
+	}
+	s.HoldConflicts(PendingBead{WorkBeadID: "gt-a", TargetRig: "gastown", Context: &SlingContextFields{Paths: []string{"migrations/1.sql", "a.proto"}}}, groups, now)
+	if h := s.ConflictHolds["gastown/migrations"]; h.Bead != "gt-a" || h.Since != "2026-03-01T12:00:00Z" || len(s.ConflictHolds) != 2 {
+		t.Fatalf("ConflictHolds = %v", s.ConflictHolds)
+	}
+	if got := s.ConflictHoldBeads(); !reflect.DeepEqual(got, []string{"gt-a"}) {
+		t.Errorf("ConflictHoldBeads = %v", got)
+	}
+
+	// Still in flight, but schema is no longer a configured group.
+	released := s.ReleaseConflicts(map[string][]string{"migrations": {"migrations/**"}}, func(string) bool { return true })
+	if !reflect.DeepEqual(released, []string{"gastown/schema"}) || len(s.ConflictHolds) != 1 {
+		t.Errorf("released = %v, holds = %v", released, s.ConflictHolds)
+	}
+	released = s.ReleaseConflicts(groups, func(string) bool { return false })
+	if !reflect.DeepEqual(released, []string{"gastown/migrations"}) || s.ConflictHolds != nil {
+		t.Errorf("finished bead: released = %v, holds = %v", released, s.ConflictHolds)
+	}
+}
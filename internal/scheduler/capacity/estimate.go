@@ -0,0 +1,103 @@
+package capacity
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/humanize"
+)
+
+// MinEstimateSamples is the fewest historical runs an estimate is based on.
+// A match tier with fewer samples falls through to the next, broader tier.
+const MinEstimateSamples = 3
+
+// DurationSample is one historical run: how long a bead with the given
+// formula and labels took from dispatch to gt done.
+type DurationSample struct {
+	Formula  string
+	Labels   []string
+	Duration time.Duration
+}
+
+// Estimate is a duration estimate for a bead, derived from similar runs.
+type Estimate struct {
+	Median  time.Duration `json:"median"`
+	P90     time.Duration `json:"p90"`
+	Samples int           `json:"samples"`
+	Basis   string        `json:"basis"` // "formula+labels" | "formula" | "all"
+}
+
+// String renders the estimate compactly, e.g. "~45m".
+func (e Estimate) String() string {
+	return "~" + FormatEstimate(e.Median)
+}
+
+// FormatEstimate rounds d to whole minutes for display, e.g. "45m",
+// "1h 30m", "1d 2h".
+func FormatEstimate(d time.Duration) string {
+	if d < time.Minute {
+		return "<1m"
+	}
+	return humanize.Duration(d.Round(time.Minute))
+}
+
+// EstimateDuration estimates how long a bead with formula and labels will
+// take, from the median of similar historical runs. It prefers runs with the
+// same formula and label set, then the same formula, then all runs, using
+// the first tier with at least MinEstimateSamples. Reports false when even
+// the full history is too small.
+//
+// Internal gt: labels are ignored when comparing label sets, since they
+// describe scheduling state rather than the work.
+func EstimateDuration(history []DurationSample, formula string, labels []string) (Estimate, bool) {
+	key := labelKey(labels)
+	tiers := []struct {
+		basis string
+		match func(DurationSample) bool
+	}{
+		{"formula+labels", func(s DurationSample) bool { return s.Formula == formula && labelKey(s.Labels) == key }},
+		{"formula", func(s DurationSample) bool { return s.Formula == formula }},
+		{"all", func(DurationSample) bool { return true }},
+	}
+	for _, tier := range tiers {
+		var durations []time.Duration
+		for _, s := range history {
+			if tier.match(s) {
+				durations = append(durations, s.Duration)
+			}
+		}
+		if len(durations) < MinEstimateSamples {
+			continue
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		return Estimate{
+			Median:  percentile(durations, 50),
+			P90:     percentile(durations, 90),
+			Samples: len(durations),
+			Basis:   tier.basis,
+		}, true
+	}
+	return Estimate{}, false
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// labelKey returns a canonical key for the non-internal labels in labels.
+func labelKey(labels []string) string {
+	var kept []string
+	for _, l := range labels {
+		if !strings.HasPrefix(l, "gt:") {
+			kept = append(kept, l)
+		}
+	}
+	sort.Strings(kept)
+	return strings.Join(kept, ",")
+}
@@ -0,0 +1,78 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+)
+
+func samples(formula string, labels []string, minutes ...int) []DurationSample {
+	var out []DurationSample
+	for _, m := range minutes {
+		out = append(out, DurationSample{Formula: formula, Labels: labels, Duration: time.Duration(m) * time.Minute})
+	}
+	return out
+}
+
+func TestEstimateDuration_Tiers(t *testing.T) {
+	var history []DurationSample
+	history = append(history, samples("mol-polecat-work", []string{"docs"}, 10, 20, 30)...)
+	history = append(history, samples("mol-polecat-work", []string{"backend"}, 60, 90)...)
+	history = append(history, samples("shiny", nil, 120, 240, 360)...)
+
+	tests := []struct {
+		name    string
+		formula string
+		labels  []string
+		median  time.Duration
+		basis   string
+	}{
+		// Internal gt: labels don't change the label set.
+		{"exact", "mol-polecat-work", []string{"gt:batchable", "docs"}, 20 * time.Minute, "formula+labels"},
+		{"formula", "mol-polecat-work", []string{"backend"}, 30 * time.Minute, "formula"},
+		{"all", "unknown", nil, 60 * time.Minute, "all"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			est, ok := EstimateDuration(history, tt.formula, tt.labels)
+			if !ok {
+				t.Fatal("no estimate")
+			}
+			if est.Median != tt.median || est.Basis != tt.basis {
+				t.Errorf("got median %v basis %q, want %v %q", est.Median, est.Basis, tt.median, tt.basis)
+			}
+		})
+	}
+}
+
+func TestEstimateDuration_TooFewSamples(t *testing.T) {
+	if _, ok := EstimateDuration(samples("mol-polecat-work", nil, 10, 20), "mol-polecat-work", nil); ok {
+		t.Error("estimated from fewer than MinEstimateSamples runs")
+	}
+}
+
+func TestEstimateDuration_P90(t *testing.T) {
+	est, ok := EstimateDuration(samples("f", nil, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10), "f", nil)
+	if !ok {
+		t.Fatal("no estimate")
+	}
+	if est.Median != 5*time.Minute || est.P90 != 9*time.Minute || est.Samples != 10 {
+		t.Errorf("got %+v", est)
+	}
+}
+
+func TestFormatEstimate(t *testing.T) {
+	tests := map[time.Duration]string{
+		30 * time.Second:               "<1m",
+		45 * time.Minute:               "45m",
+		90 * time.Minute:               "1h 30m",
+		2 * time.Hour:                  "2h",
+		26*time.Hour + 20*time.Minute:  "1d 2h",
+		2*time.Hour + 29*time.Second:   "2h",
+		3*time.Minute + 40*time.Second: "4m",
+	}
+	for d, want := range tests {
+		if got := FormatEstimate(d); got != want {
+			t.Errorf("FormatEstimate(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package capacity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LabelQueueStale marks a work bead that has been queued longer than
+// scheduler.max_age.
+const LabelQueueStale = "gt:queue-stale"
+
+// ParseMaxAge parses a scheduler.max_age value: a whole number of days
+// ("14d") or a Go duration ("336h"). Empty means no maximum and returns 0.
+func ParseMaxAge(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid max age %q: want a positive number of days, e.g. 14d", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max age %q: want days (14d) or a Go duration (336h)", s)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid max age %q: must be positive", s)
+	}
+	return d, nil
+}
+
+// QueueAge returns how long a bead enqueued at enqueuedAt (RFC 3339) has
+// been queued. Reports false when enqueuedAt doesn't parse.
+func QueueAge(enqueuedAt string, now time.Time) (time.Duration, bool) {
+	t, err := time.Parse(time.RFC3339, enqueuedAt)
+	if err != nil {
+		return 0, false
+	}
+	return now.Sub(t), true
+}
+
+// IsStale reports whether a bead enqueued at enqueuedAt has been queued
+// longer than maxAge. Always false when maxAge is zero (no maximum) or the
+// enqueue time is unknown.
+func IsStale(enqueuedAt string, maxAge time.Duration, now time.Time) bool {
+	if maxAge <= 0 {
+		return false
+	}
+	age, ok := QueueAge(enqueuedAt, now)
+	return ok && age > maxAge
+}
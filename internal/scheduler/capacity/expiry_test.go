@@ -0,0 +1,47 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseMaxAge(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"14d", 14 * 24 * time.Hour, false},
+		{"336h", 336 * time.Hour, false},
+		{" 1d ", 24 * time.Hour, false},
+		{"0d", 0, true},
+		{"-3d", 0, true},
+		{"xd", 0, true},
+		{"-1h", 0, true},
+		{"fortnight", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseMaxAge(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseMaxAge(%q) = %v, %v; want %v, err=%v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	if !IsStale("2026-03-01T12:00:00Z", week, now) {
+		t.Error("queued 14 days with a 7-day max age: want stale")
+	}
+	if IsStale("2026-03-10T12:00:00Z", week, now) {
+		t.Error("queued 5 days with a 7-day max age: want fresh")
+	}
+	if IsStale("2026-01-01T00:00:00Z", 0, now) {
+		t.Error("no max age: never stale")
+	}
+	if IsStale("not a time", week, now) {
+		t.Error("unparseable enqueue time: want not stale")
+	}
+}
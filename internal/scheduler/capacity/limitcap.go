@@ -0,0 +1,92 @@
+package capacity
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Limit cap aggressiveness (scheduler.limit_cap): how far below the
+// concurrency at which usage limits hit the scheduler keeps polecats.
+const (
+	LimitCapOff    = "off"    // No cap (default)
+	LimitCapLow    = "low"    // One below the limit concurrency
+	LimitCapMedium = "medium" // Three quarters of it
+	LimitCapHigh   = "high"   // Half of it
+)
+
+// LimitCapLevels lists the valid scheduler.limit_cap values.
+var LimitCapLevels = []string{LimitCapOff, LimitCapLow, LimitCapMedium, LimitCapHigh}
+
+// Limit cap inputs: the window of limit history considered, and how many
+// hits with a known concurrency it takes before a cap is trusted.
+const (
+	LimitCapLookbackDays = 7
+	minLimitCapHits      = 3
+)
+
+// LimitCap is a ceiling on concurrent polecats learned from when usage
+// limits have been hitting.
+type LimitCap struct {
+	Cap       int    `json:"cap"`       // Effective max concurrent polecats
+	Threshold int    `json:"threshold"` // Typical concurrency when limits hit (median)
+	Hits      int    `json:"hits"`      // Limit hits the threshold is based on
+	Level     string `json:"level"`     // scheduler.limit_cap
+	Reason    string `json:"reason"`
+}
+
+// LimitHit is one recent usage limit hit as the limit cap sees it.
+type LimitHit struct {
+	Concurrent int // Polecats running when the limit hit (0 = unknown)
+	Capped     int // Limit cap in force at the time (0 = none)
+}
+
+// ComputeLimitCap derives a concurrency cap from the number of polecats
+// running at each recent limit hit. The threshold is the median, so one hit
+// during a burst doesn't set it; the cap sits below it by level. Reports
+// false when the level is off or there are too few hits to go on.
+//
+// Hits taken at or below the cap in force say nothing about where limits
+// hit uncapped and would only ratchet the cap lower, so they are ignored;
+// the cap lifts as the hits that set it age out of the lookback window.
+func ComputeLimitCap(hits []LimitHit, level string) (LimitCap, bool) {
+	if level == "" || level == LimitCapOff {
+		return LimitCap{}, false
+	}
+	var samples []int
+	for _, h := range hits {
+		if h.Concurrent > 0 && (h.Capped <= 0 || h.Concurrent > h.Capped) {
+			samples = append(samples, h.Concurrent)
+		}
+	}
+	if len(samples) < minLimitCapHits {
+		return LimitCap{}, false
+	}
+	slices.Sort(samples)
+	k := samples[(len(samples)-1)/2]
+
+	limit := k - 1
+	switch level {
+	case LimitCapMedium:
+		limit = min(limit, (k*3+3)/4) // ceil(3k/4)
+	case LimitCapHigh:
+		limit = min(limit, (k+1)/2) // ceil(k/2)
+	}
+	limit = max(limit, 1)
+	return LimitCap{
+		Cap:       limit,
+		Threshold: k,
+		Hits:      len(samples),
+		Level:     level,
+		Reason: fmt.Sprintf("limits hit at ~%d concurrent polecats (median of %d hits in %dd), capped %s",
+			k, len(samples), LimitCapLookbackDays, level),
+	}, true
+}
+
+// Apply caps maxPolecats and batchSize to the limit cap. A nil cap leaves
+// them as they are.
+func (c *LimitCap) Apply(maxPolecats, batchSize int) (int, int) {
+	if c == nil || c.Cap <= 0 {
+		return maxPolecats, batchSize
+	}
+	return min(maxPolecats, c.Cap), min(batchSize, c.Cap)
+}
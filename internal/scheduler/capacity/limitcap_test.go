@@ -0,0 +1,69 @@
+package capacity
+
+import "testing"
+
+// limitHits makes uncapped limit hits at the given concurrencies.
+func limitHits(concurrency ...int) []LimitHit {
+	hits := make([]LimitHit, len(concurrency))
+	for i, n := range concurrency {
+		hits[i] = LimitHit{Concurrent: n}
+	}
+	return hits
+}
+
+func TestComputeLimitCap(t *testing.T) {
+	hits := limitHits(8, 0, 7, 12, 8, 9) // 0 = concurrency unknown
+	tests := []struct {
+		level string
+		want  int
+	}{
+		{LimitCapLow, 7},
+		{LimitCapMedium, 6},
+		{LimitCapHigh, 4},
+	}
+	for _, tt := range tests {
+		c, ok := ComputeLimitCap(hits, tt.level)
+		if !ok {
+			t.Fatalf("%s: no cap", tt.level)
+		}
+		if c.Threshold != 8 || c.Hits != 5 || c.Cap != tt.want {
+			t.Errorf("%s: got cap %d threshold %d hits %d, want cap %d threshold 8 hits 5",
+				tt.level, c.Cap, c.Threshold, c.Hits, tt.want)
+		}
+	}
+
+	if _, ok := ComputeLimitCap(hits, LimitCapOff); ok {
+		t.Error("off should not cap")
+	}
+	if _, ok := ComputeLimitCap(limitHits(3, 0, 4), LimitCapLow); ok {
+		t.Error("two known hits should not be enough to cap")
+	}
+	if c, _ := ComputeLimitCap(limitHits(1, 1, 1), LimitCapHigh); c.Cap != 1 {
+		t.Errorf("cap = %d, want floor of 1", c.Cap)
+	}
+
+	// Hits taken under a cap of 5 at 5 or fewer polecats don't pull the
+	// threshold down; one over the cap still counts.
+	capped := append(limitHits(8, 8, 9), LimitHit{4, 5}, LimitHit{5, 5}, LimitHit{3, 5}, LimitHit{7, 5})
+	c, ok := ComputeLimitCap(capped, LimitCapLow)
+	if !ok || c.Threshold != 8 || c.Hits != 4 || c.Cap != 7 {
+		t.Errorf("capped hits: got %+v, want threshold 8 from 4 hits, cap 7", c)
+	}
+	if _, ok := ComputeLimitCap([]LimitHit{{4, 5}, {5, 5}, {3, 5}}, LimitCapLow); ok {
+		t.Error("hits at or below the cap in force should not cap on their own")
+	}
+}
+
+func TestLimitCapApply(t *testing.T) {
+	c := &LimitCap{Cap: 3}
+	if m, b := c.Apply(10, 5); m != 3 || b != 3 {
+		t.Errorf("Apply(10, 5) = %d, %d; want 3, 3", m, b)
+	}
+	if m, b := c.Apply(2, 1); m != 2 || b != 1 {
+		t.Errorf("Apply(2, 1) = %d, %d; want 2, 1", m, b)
+	}
+	var none *LimitCap
+	if m, b := none.Apply(10, 5); m != 10 || b != 5 {
+		t.Errorf("nil Apply = %d, %d", m, b)
+	}
+}
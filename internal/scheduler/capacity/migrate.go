@@ -0,0 +1,31 @@
+package capacity
+
+// SlingContextVersion is the schema version written to new sling contexts.
+//
+//	1: initial schema
+//	2: records the work bead's labels and gt:batchable flag at schedule time
+//	   (used for batching and duration estimates)
+const SlingContextVersion = 2
+
+// NeedsMigration reports whether the context predates SlingContextVersion.
+func (f *SlingContextFields) NeedsMigration() bool {
+	return f.Version < SlingContextVersion
+}
+
+// MigrateSlingContext upgrades f in place to SlingContextVersion, filling
+// fields added since its version from the work bead's current labels.
+// Fields already present are kept. Returns false if f was already current.
+func MigrateSlingContext(f *SlingContextFields, workLabels []string) bool {
+	if !f.NeedsMigration() {
+		return false
+	}
+	// v1 → v2
+	if f.Labels == nil && len(workLabels) > 0 {
+		f.Labels = append([]string(nil), workLabels...)
+	}
+	if HasBatchableLabel(f.Labels) {
+		f.Batchable = true
+	}
+	f.Version = SlingContextVersion
+	return true
+}
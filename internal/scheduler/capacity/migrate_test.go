@@ -0,0 +1,26 @@
+package capacity
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMigrateSlingContext(t *testing.T) {
+	f := &SlingContextFields{Version: 1, WorkBeadID: "gt-abc"}
+	if !MigrateSlingContext(f, []string{"area:cli", LabelBatchable}) {
+		t.Fatal("expected v1 context to migrate")
+	}
+	if f.Version != SlingContextVersion || !f.Batchable || !slices.Equal(f.Labels, []string{"area:cli", LabelBatchable}) {
+		t.Errorf("migrated fields = %+v", f)
+	}
+	if MigrateSlingContext(f, nil) {
+		t.Error("expected current context to be left alone")
+	}
+
+	// Labels recorded at schedule time win over the work bead's current ones.
+	kept := &SlingContextFields{WorkBeadID: "gt-def", Labels: []string{"area:docs"}}
+	MigrateSlingContext(kept, []string{LabelBatchable})
+	if kept.Batchable || !slices.Equal(kept.Labels, []string{"area:docs"}) || kept.Version != SlingContextVersion {
+		t.Errorf("migrated fields = %+v", kept)
+	}
+}
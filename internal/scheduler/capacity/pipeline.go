@@ -1,6 +1,9 @@
 package capacity
 
-import "strings"
+import (
+	"strings"
+	"time"
+)
 
 // PendingBead represents a bead that is scheduled and ready for dispatch evaluation.
 type PendingBead struct {
@@ -11,6 +14,10 @@ type PendingBead struct {
 	Description string
 	Labels      []string
 	Context     *SlingContextFields // Parsed sling params from context bead
+
+	// Batch holds beads dispatched to the same polecat after this one.
+	// Set by GroupBatches; empty for ordinary dispatch.
+	Batch []PendingBead
 }
 
 // SlingContextFields holds scheduling parameters stored on a sling context bead.
@@ -33,8 +40,70 @@ type SlingContextFields struct {
 	HookRawBead      bool   `json:"hook_raw_bead,omitempty"`
 	Owned            bool   `json:"owned,omitempty"`
 	Mode             string `json:"mode,omitempty"`
+	Priority         *int   `json:"priority,omitempty"`
+	Batchable        bool   `json:"batchable,omitempty"` // Work bead had gt:batchable when scheduled
 	DispatchFailures int    `json:"dispatch_failures,omitempty"`
 	LastFailure      string `json:"last_failure,omitempty"`
+	LastFailureAt    string `json:"last_failure_at,omitempty"` // RFC 3339
+
+	// MaxRetries overrides the scheduler's circuit breaker for this bead:
+	// the bead is retried this many times after its first dispatch failure.
+	// nil = the scheduler default; 0 = fail fast.
+	MaxRetries *int `json:"max_retries,omitempty"`
+
+	// RetryBackoff is how long to wait after a dispatch failure before trying
+	// again (a Go duration, e.g. "10m"). Empty = retry on the next cycle.
+	RetryBackoff string `json:"retry_backoff,omitempty"`
+
+	// Labels are the work bead's labels when scheduled, used to estimate
+	// its duration from similar past runs.
+	Labels []string `json:"labels,omitempty"`
+
+	// Paths are the files the work is predicted to touch, from the work
+	// bead's predicted_paths metadata or else the formula's paths, used to
+	// place it in conflict groups.
+	Paths []string `json:"paths,omitempty"`
+
+	// BatchedWith is the batch leader whose polecat claimed this bead. The
+	// bead is not dispatched on its own while it stays assigned; once the
+	// polecat releases it (or the refinery does, if it was never reached)
+	// it dispatches normally.
+	BatchedWith string `json:"batched_with,omitempty"`
+
+	// CorrelationID is generated at enqueue and carried by every event about
+	// this work through dispatch, spawn, done and merge.
+	CorrelationID string `json:"correlation_id,omitempty"`
+}
+
+// FailureLimit returns how many dispatch failures circuit-break the bead:
+// MaxRetries+1 when set, otherwise def.
+func (f *SlingContextFields) FailureLimit(def int) int {
+	if f.MaxRetries != nil && *f.MaxRetries >= 0 {
+		return *f.MaxRetries + 1
+	}
+	return def
+}
+
+// CircuitBroken reports whether the bead has used up its dispatch attempts.
+func (f *SlingContextFields) CircuitBroken(def int) bool {
+	return f.DispatchFailures >= f.FailureLimit(def)
+}
+
+// RetryAt returns when a bead that failed dispatch may be tried again, or
+// the zero time if it may be tried now.
+func (f *SlingContextFields) RetryAt() time.Time {
+	if f.DispatchFailures == 0 || f.RetryBackoff == "" || f.LastFailureAt == "" {
+		return time.Time{}
+	}
+	backoff, err := time.ParseDuration(f.RetryBackoff)
+	if err != nil || backoff <= 0 {
+		return time.Time{}
+	}
+	failed, err := time.Parse(time.RFC3339, f.LastFailureAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return failed.Add(backoff)
 }
 
 // LabelSlingContext is the label used to identify sling context beads.
@@ -141,12 +210,13 @@ func CircuitBreakerPolicy(maxFailures int) FailurePolicy {
 }
 
 // FilterCircuitBroken removes beads that have exceeded the maximum dispatch
-// failures threshold. Returns the filtered list and the count of removed beads.
+// failures threshold (a bead's own MaxRetries overrides maxFailures).
+// Returns the filtered list and the count of removed beads.
 func FilterCircuitBroken(beads []PendingBead, maxFailures int) ([]PendingBead, int) {
 	var result []PendingBead
 	removed := 0
 	for _, b := range beads {
-		if b.Context != nil && b.Context.DispatchFailures >= maxFailures {
+		if b.Context != nil && b.Context.CircuitBroken(maxFailures) {
 			removed++
 			continue
 		}
@@ -155,6 +225,63 @@ func FilterCircuitBroken(beads []PendingBead, maxFailures int) ([]PendingBead, i
 	return result, removed
 }
 
+// FilterHeldRigs removes beads targeting a rig that is on hold.
+// Returns the filtered list and the count of removed beads.
+func FilterHeldRigs(beads []PendingBead, held map[string]string) ([]PendingBead, int) {
+	if len(held) == 0 {
+		return beads, 0
+	}
+	var result []PendingBead
+	removed := 0
+	for _, b := range beads {
+		if _, ok := held[b.TargetRig]; ok {
+			removed++
+			continue
+		}
+		result = append(result, b)
+	}
+	return result, removed
+}
+
+// FilterGatedWork removes beads whose work bead is in gated, which maps work
+// bead IDs to the convoy they wait on (gt convoy depend).
+// Returns the filtered list and the count of removed beads.
+func FilterGatedWork(beads []PendingBead, gated map[string]string) ([]PendingBead, int) {
+	if len(gated) == 0 {
+		return beads, 0
+	}
+	var result []PendingBead
+	removed := 0
+	for _, b := range beads {
+		if _, ok := gated[b.WorkBeadID]; ok {
+			removed++
+			continue
+		}
+		result = append(result, b)
+	}
+	return result, removed
+}
+
+// FilterLimitedProviders removes beads whose provider (as reported by
+// providerOf) is in limited, so a rate limit on one provider doesn't hold up
+// work for the others. Returns the filtered list and how many beads were held
+// per provider.
+func FilterLimitedProviders(beads []PendingBead, providerOf func(PendingBead) string, limited map[string]bool) ([]PendingBead, map[string]int) {
+	if len(limited) == 0 {
+		return beads, nil
+	}
+	var result []PendingBead
+	held := make(map[string]int)
+	for _, b := range beads {
+		if p := providerOf(b); limited[p] {
+			held[p]++
+			continue
+		}
+		result = append(result, b)
+	}
+	return result, held
+}
+
 // DispatchParams captures what the scheduler needs to tell the dispatcher.
 // Mirrors the relevant fields from cmd.SlingParams but is scheduler-owned.
 type DispatchParams struct {
@@ -2,6 +2,7 @@ package capacity
 
 import (
 	"testing"
+	"time"
 )
 
 func TestPlanDispatch(t *testing.T) {
@@ -89,6 +90,110 @@ func TestFilterCircuitBroken(t *testing.T) {
 	}
 }
 
+func TestFilterCircuitBroken_MaxRetriesOverride(t *testing.T) {
+	zero, five := 0, 5
+	beads := []PendingBead{
+		{ID: "fail-fast", Context: &SlingContextFields{DispatchFailures: 1, MaxRetries: &zero}},
+		{ID: "patient", Context: &SlingContextFields{DispatchFailures: 4, MaxRetries: &five}},
+		{ID: "default", Context: &SlingContextFields{DispatchFailures: 3}},
+	}
+	kept, removed := FilterCircuitBroken(beads, 3)
+	if removed != 2 || len(kept) != 1 || kept[0].ID != "patient" {
+		t.Errorf("got kept=%v removed=%d, want [patient] removed=2", kept, removed)
+	}
+}
+
+func TestSlingContextFields_RetryAt(t *testing.T) {
+	failed := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		fields SlingContextFields
+		want   time.Time
+	}{
+		{"no backoff", SlingContextFields{DispatchFailures: 1, LastFailureAt: failed.Format(time.RFC3339)}, time.Time{}},
+		{"no failures", SlingContextFields{RetryBackoff: "10m"}, time.Time{}},
+		{"backoff", SlingContextFields{DispatchFailures: 2, RetryBackoff: "10m", LastFailureAt: failed.Format(time.RFC3339)}, failed.Add(10 * time.Minute)},
+		{"unparseable backoff", SlingContextFields{DispatchFailures: 1, RetryBackoff: "soon", LastFailureAt: failed.Format(time.RFC3339)}, time.Time{}},
+		{"missing failure time", SlingContextFields{DispatchFailures: 1, RetryBackoff: "10m"}, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fields.RetryAt(); !got.Equal(tt.want) {
+				t.Errorf("RetryAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterHeldRigs(t *testing.T) {
+	beads := []PendingBead{
+		{ID: "a", TargetRig: "gastown"},
+		{ID: "b", TargetRig: "beads"},
+		{ID: "c", TargetRig: "gastown"},
+	}
+
+	kept, removed := FilterHeldRigs(beads, map[string]string{"gastown": "mayor"})
+	if removed != 2 {
+		t.Errorf("removed: got %d, want 2", removed)
+	}
+	if len(kept) != 1 || kept[0].ID != "b" {
+		t.Errorf("kept: got %v, want [b]", kept)
+	}
+
+	kept, removed = FilterHeldRigs(beads, nil)
+	if removed != 0 || len(kept) != 3 {
+		t.Errorf("nil held set: got kept=%d removed=%d, want kept=3 removed=0", len(kept), removed)
+	}
+}
+
+func TestFilterGatedWork(t *testing.T) {
+	beads := []PendingBead{
+		{ID: "ctx-a", WorkBeadID: "gt-a"},
+		{ID: "ctx-b", WorkBeadID: "gt-b"},
+		{ID: "ctx-c", WorkBeadID: "gt-c"},
+	}
+
+	kept, removed := FilterGatedWork(beads, map[string]string{"gt-a": "hq-cv-1", "gt-c": "hq-cv-1"})
+	if removed != 2 {
+		t.Errorf("removed: got %d, want 2", removed)
+	}
+	if len(kept) != 1 || kept[0].ID != "ctx-b" {
+		t.Errorf("kept: got %v, want [ctx-b]", kept)
+	}
+
+	kept, removed = FilterGatedWork(beads, nil)
+	if removed != 0 || len(kept) != 3 {
+		t.Errorf("nil gated set: got kept=%d removed=%d, want kept=3 removed=0", len(kept), removed)
+	}
+}
+
+func TestFilterLimitedProviders(t *testing.T) {
+	beads := []PendingBead{
+		{ID: "a", Context: &SlingContextFields{Agent: "gemini"}},
+		{ID: "b", Context: &SlingContextFields{}},
+		{ID: "c", Context: &SlingContextFields{Agent: "gemini"}},
+	}
+	providerOf := func(b PendingBead) string {
+		if b.Context.Agent != "" {
+			return b.Context.Agent
+		}
+		return "claude"
+	}
+
+	kept, held := FilterLimitedProviders(beads, providerOf, map[string]bool{"gemini": true})
+	if held["gemini"] != 2 || len(held) != 1 {
+		t.Errorf("held: got %v, want gemini:2", held)
+	}
+	if len(kept) != 1 || kept[0].ID != "b" {
+		t.Errorf("kept: got %v, want [b]", kept)
+	}
+
+	kept, held = FilterLimitedProviders(beads, providerOf, nil)
+	if len(held) != 0 || len(kept) != 3 {
+		t.Errorf("no limits: got kept=%d held=%v, want kept=3 held=none", len(kept), held)
+	}
+}
+
 func TestAllReady(t *testing.T) {
 	beads := []PendingBead{
 		{ID: "a"},
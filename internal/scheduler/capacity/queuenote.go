@@ -0,0 +1,86 @@
+package capacity
+
+import (
+	"fmt"
+	"time"
+)
+
+// QueueNote is what the scheduler plans for a queued bead, posted on the
+// bead as a comment (scheduler.queue_notes) so people looking at it in bd
+// see its place in line without asking gt.
+type QueueNote struct {
+	Rig      string        `json:"rig"`
+	Position int           `json:"position,omitempty"` // 1-based dispatch order; 0 while waiting
+	Total    int           `json:"total,omitempty"`    // Beads ready to dispatch
+	ETA      time.Duration `json:"eta,omitempty"`      // Until dispatch; 0 = no estimate
+	Waiting  string        `json:"waiting,omitempty"`  // Why it isn't in line, e.g. a skip reason and its detail
+	Reason   string        `json:"reason,omitempty"`   // Waiting without time-relative detail, e.g. the skip reason
+}
+
+// String renders the note, e.g. "Queued for gastown: position 3 of 8,
+// estimated dispatch in ~2h".
+func (n QueueNote) String() string {
+	if n.Waiting != "" {
+		return fmt.Sprintf("Queued for %s, not dispatching yet: %s", n.Rig, n.Waiting)
+	}
+	s := fmt.Sprintf("Queued for %s: position %d of %d", n.Rig, n.Position, n.Total)
+	if n.ETA > 0 {
+		return s + ", estimated dispatch in ~" + FormatEstimate(n.ETA)
+	}
+	return s + " (no dispatch estimate yet)"
+}
+
+// Significant reports whether n differs enough from the note last posted to
+// be worth posting again: a new rig or reason to wait (compared on Reason,
+// so detail such as a backoff's "retry in 4m" ticking down is not news),
+// reaching the front of
+// the line, moving at least max(3, half its old position) places, or an ETA
+// that halved or doubled by at least half an hour. Small shuffles as the
+// queue drains are not news.
+func (n QueueNote) Significant(prev QueueNote) bool {
+	if n.Rig != prev.Rig || n.waitReason() != prev.waitReason() {
+		return true
+	}
+	if n.Waiting != "" {
+		return false
+	}
+	if n.Position == 1 && prev.Position != 1 {
+		return true
+	}
+	if moved := n.Position - prev.Position; max(moved, -moved) >= max(3, prev.Position/2) {
+		return true
+	}
+	if (n.ETA == 0) != (prev.ETA == 0) {
+		return true
+	}
+	shift := n.ETA - prev.ETA
+	return (n.ETA > 2*prev.ETA || 2*n.ETA < prev.ETA) && max(shift, -shift) >= 30*time.Minute
+}
+
+// waitReason is what Significant compares for a waiting bead: Reason, or
+// Waiting for notes recorded before Reason was.
+func (n QueueNote) waitReason() string {
+	if n.Reason != "" {
+		return n.Reason
+	}
+	return n.Waiting
+}
+
+// DispatchETA estimates how long until the bead behind ahead others in the
+// dispatch order is dispatched. Each dispatch cycle (every cycle) sends at
+// most batch beads, and once the free polecat slots are used the bead also
+// waits for running work to finish: durations are the estimates of the
+// beads ahead that have one, spread over parallel polecats (see QueueETA).
+// parallel <= 0 means no polecat cap. Reports false when the bead must wait
+// for capacity and nothing ahead has an estimate.
+func DispatchETA(durations []time.Duration, ahead, free, parallel, batch int, cycle time.Duration) (time.Duration, bool) {
+	wait := time.Duration(ahead/max(batch, 1)+1) * cycle
+	if parallel > 0 && ahead >= free {
+		capWait, ok := QueueETA(durations, ahead-max(free, 0)+1, parallel)
+		if !ok {
+			return 0, false
+		}
+		wait = max(wait, capWait)
+	}
+	return wait, true
+}
@@ -0,0 +1,87 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQueueNoteString(t *testing.T) {
+	tests := []struct {
+		note QueueNote
+		want string
+	}{
+		{QueueNote{Rig: "gastown", Position: 3, Total: 8, ETA: 2 * time.Hour},
+			"Queued for gastown: position 3 of 8, estimated dispatch in ~2h"},
+		{QueueNote{Rig: "gastown", Position: 3, Total: 8},
+			"Queued for gastown: position 3 of 8 (no dispatch estimate yet)"},
+		{QueueNote{Rig: "beads", Waiting: "held-rig (held by mayor)"},
+			"Queued for beads, not dispatching yet: held-rig (held by mayor)"},
+	}
+	for _, tt := range tests {
+		if got := tt.note.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestQueueNoteSignificant(t *testing.T) {
+	base := QueueNote{Rig: "gastown", Position: 10, Total: 20, ETA: 4 * time.Hour}
+	with := func(f func(*QueueNote)) QueueNote {
+		n := base
+		f(&n)
+		return n
+	}
+	front := QueueNote{Rig: "gastown", Position: 2, Total: 3, ETA: 3 * time.Minute}
+	tests := []struct {
+		name string
+		prev QueueNote
+		note QueueNote
+		want bool
+	}{
+		{"unchanged", base, base, false},
+		{"moved two places", base, with(func(n *QueueNote) { n.Position = 8 }), false},
+		{"moved half its position", base, with(func(n *QueueNote) { n.Position = 5 }), true},
+		{"front of the line", front, QueueNote{Rig: "gastown", Position: 1, Total: 3, ETA: 3 * time.Minute}, true},
+		{"rig changed", base, with(func(n *QueueNote) { n.Rig = "beads" }), true},
+		{"now waiting", base, with(func(n *QueueNote) { n.Waiting, n.Reason = "blocked", "blocked" }), true},
+		{"backoff ticking down",
+			QueueNote{Rig: "gastown", Waiting: "backoff (retry in 5m)", Reason: "backoff"},
+			QueueNote{Rig: "gastown", Waiting: "backoff (retry in 2m)", Reason: "backoff"}, false},
+		{"new reason to wait",
+			QueueNote{Rig: "gastown", Waiting: "backoff (retry in 2m)", Reason: "backoff"},
+			QueueNote{Rig: "gastown", Waiting: "held-rig (held by mayor)", Reason: "held-rig"}, true},
+		{"ETA up 30m", base, with(func(n *QueueNote) { n.ETA = 4*time.Hour + 30*time.Minute }), false},
+		{"ETA doubled", base, with(func(n *QueueNote) { n.ETA = 9 * time.Hour }), true},
+		{"ETA lost", base, with(func(n *QueueNote) { n.ETA = 0 }), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.note.Significant(tt.prev); got != tt.want {
+				t.Errorf("Significant = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDispatchETA(t *testing.T) {
+	cycle := 3 * time.Minute
+	hour := time.Hour
+
+	// Free capacity: only the batch size holds the bead back.
+	if eta, ok := DispatchETA(nil, 4, 10, 10, 2, cycle); !ok || eta != 3*cycle {
+		t.Errorf("free capacity: got %v, %v; want %v", eta, ok, 3*cycle)
+	}
+	// No polecat cap behaves the same.
+	if eta, ok := DispatchETA(nil, 0, 0, 0, 1, cycle); !ok || eta != cycle {
+		t.Errorf("uncapped: got %v, %v; want %v", eta, ok, cycle)
+	}
+	// Full: 3 beads ahead, 1 free slot, 2 polecats, 1h each → 3 beads must
+	// clear a slot: 3h / 2 polecats.
+	if eta, ok := DispatchETA([]time.Duration{hour, hour, hour}, 3, 1, 2, 5, cycle); !ok || eta != 90*time.Minute {
+		t.Errorf("capacity bound: got %v, %v; want 1h30m", eta, ok)
+	}
+	// Full with no estimates: unknown.
+	if _, ok := DispatchETA(nil, 3, 0, 2, 1, cycle); ok {
+		t.Error("no estimates: want unknown")
+	}
+}
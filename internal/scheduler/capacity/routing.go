@@ -0,0 +1,132 @@
+package capacity
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// LabelNoAutoEnqueue marks a work bead whose sling context was closed for
+// good (circuit-broken, cleared or expired), so routing rules don't put it
+// straight back in the queue. Remove the label to let them schedule it again.
+const LabelNoAutoEnqueue = "gt:no-auto-enqueue"
+
+// DefaultPriority is the dispatch priority for scheduled beads without an
+// explicit one. Matches the beads convention (0 = highest, 4 = lowest).
+const DefaultPriority = 2
+
+// RoutingRule maps beads to scheduling parameters for auto-enqueue.
+// A rule matches when the bead carries every label in Labels and its title
+// matches TitlePattern (when set). A rule with neither never matches, so an
+// empty rule cannot accidentally enqueue every bead in the town.
+type RoutingRule struct {
+	// Name identifies the rule in logs and dry-run output.
+	Name string `json:"name,omitempty"`
+
+	// Labels that must all be present on the bead.
+	Labels []string `json:"labels,omitempty"`
+
+	// TitlePattern is a Go regular expression matched against the bead title.
+	TitlePattern string `json:"title_pattern,omitempty"`
+
+	// Rig is the target rig. Empty = resolve from the bead's prefix.
+	Rig string `json:"rig,omitempty"`
+
+	// Formula to apply at dispatch. Empty = the rig/town default formula.
+	Formula string `json:"formula,omitempty"`
+
+	// Priority orders dispatch (0 = highest). nil = DefaultPriority.
+	Priority *int `json:"priority,omitempty"`
+
+	titleRE  *regexp.Regexp // TitlePattern, compiled on first match
+	compiled bool           // compile ran; titleRE is nil if the pattern is invalid
+}
+
+// compile caches the compiled TitlePattern on the rule.
+func (r *RoutingRule) compile() error {
+	r.titleRE, r.compiled = nil, true
+	if r.TitlePattern == "" {
+		return nil
+	}
+	re, err := regexp.Compile(r.TitlePattern)
+	if err != nil {
+		return fmt.Errorf("invalid title_pattern: %w", err)
+	}
+	r.titleRE = re
+	return nil
+}
+
+// Matches reports whether the rule applies to a bead with the given title and labels.
+// TitlePattern is compiled on first use and cached on the rule. An invalid
+// pattern never matches (ValidateRoutingRules reports it).
+func (r *RoutingRule) Matches(title string, labels []string) bool {
+	if len(r.Labels) == 0 && r.TitlePattern == "" {
+		return false
+	}
+	have := make(map[string]bool, len(labels))
+	for _, l := range labels {
+		have[l] = true
+	}
+	for _, l := range r.Labels {
+		if !have[l] {
+			return false
+		}
+	}
+	if r.TitlePattern != "" {
+		if !r.compiled {
+			_ = r.compile()
+		}
+		if r.titleRE == nil || !r.titleRE.MatchString(title) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchRoutingRule returns the first rule that matches, or nil.
+func MatchRoutingRule(rules []RoutingRule, title string, labels []string) *RoutingRule {
+	for i := range rules {
+		if rules[i].Matches(title, labels) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// ValidateRoutingRules checks that every rule has a matcher and a valid
+// pattern, caching each compiled pattern on its rule.
+func ValidateRoutingRules(rules []RoutingRule) error {
+	for i := range rules {
+		r := &rules[i]
+		label := r.Name
+		if label == "" {
+			label = fmt.Sprintf("#%d", i+1)
+		}
+		if len(r.Labels) == 0 && r.TitlePattern == "" {
+			return fmt.Errorf("routing rule %s: needs labels or title_pattern", label)
+		}
+		if err := r.compile(); err != nil {
+			return fmt.Errorf("routing rule %s: %w", label, err)
+		}
+		if r.Priority != nil && (*r.Priority < 0 || *r.Priority > 4) {
+			return fmt.Errorf("routing rule %s: priority must be 0-4", label)
+		}
+	}
+	return nil
+}
+
+// EffectivePriority returns the context's dispatch priority, or DefaultPriority.
+func (f *SlingContextFields) EffectivePriority() int {
+	if f == nil || f.Priority == nil {
+		return DefaultPriority
+	}
+	return *f.Priority
+}
+
+// SortByPriority orders beads by dispatch priority (0 first). The sort is
+// stable, so beads of equal priority keep their enqueue order.
+func SortByPriority(beads []PendingBead) {
+	sort.SliceStable(beads, func(i, j int) bool {
+		return beads[i].Context.EffectivePriority() < beads[j].Context.EffectivePriority()
+	})
+}
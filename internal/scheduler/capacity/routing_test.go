@@ -0,0 +1,99 @@
+package capacity
+
+import (
+	"testing"
+)
+
+func TestRoutingRuleMatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   RoutingRule
+		title  string
+		labels []string
+		want   bool
+	}{
+		{"empty rule never matches", RoutingRule{}, "anything", []string{"docs"}, false},
+		{"single label", RoutingRule{Labels: []string{"docs"}}, "x", []string{"bug", "docs"}, true},
+		{"all labels required", RoutingRule{Labels: []string{"docs", "easy"}}, "x", []string{"docs"}, false},
+		{"title pattern", RoutingRule{TitlePattern: `^(?i)hotfix`}, "HOTFIX: login", nil, true},
+		{"title pattern miss", RoutingRule{TitlePattern: `^hotfix`}, "fix hotfix", nil, false},
+		{"labels and title", RoutingRule{Labels: []string{"ui"}, TitlePattern: "button"}, "fix button", []string{"ui"}, true},
+		{"labels ok title miss", RoutingRule{Labels: []string{"ui"}, TitlePattern: "button"}, "fix menu", []string{"ui"}, false},
+		{"invalid pattern", RoutingRule{TitlePattern: "("}, "(", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Matches(tt.title, tt.labels); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchRoutingRule_FirstMatchWins(t *testing.T) {
+	rules := []RoutingRule{
+		{Name: "docs", Labels: []string{"docs"}, Rig: "site"},
+		{Name: "any-fix", TitlePattern: "fix", Rig: "gastown"},
+	}
+	if r := MatchRoutingRule(rules, "fix typo", []string{"docs"}); r == nil || r.Name != "docs" {
+		t.Errorf("expected docs rule, got %+v", r)
+	}
+	if r := MatchRoutingRule(rules, "fix crash", nil); r == nil || r.Name != "any-fix" {
+		t.Errorf("expected any-fix rule, got %+v", r)
+	}
+	if r := MatchRoutingRule(rules, "add feature", nil); r != nil {
+		t.Errorf("expected no match, got %+v", r)
+	}
+}
+
+func TestRoutingRuleMatches_CachesPattern(t *testing.T) {
+	rules := []RoutingRule{{Name: "hotfix", TitlePattern: `^(?i)hotfix`}}
+	if MatchRoutingRule(rules, "HOTFIX: login", nil) == nil {
+		t.Fatal("expected hotfix rule to match")
+	}
+	re := rules[0].titleRE
+	if re == nil {
+		t.Fatal("title_pattern not cached after first match")
+	}
+	MatchRoutingRule(rules, "hotfix: again", nil)
+	if rules[0].titleRE != re {
+		t.Error("title_pattern recompiled on second match")
+	}
+}
+
+func TestValidateRoutingRules(t *testing.T) {
+	p := func(n int) *int { return &n }
+	if err := ValidateRoutingRules([]RoutingRule{{Labels: []string{"a"}, Priority: p(0)}}); err != nil {
+		t.Errorf("valid rule rejected: %v", err)
+	}
+	bad := [][]RoutingRule{
+		{{Name: "empty"}},
+		{{TitlePattern: "("}},
+		{{Labels: []string{"a"}, Priority: p(5)}},
+	}
+	for _, rules := range bad {
+		if err := ValidateRoutingRules(rules); err == nil {
+			t.Errorf("expected error for %+v", rules)
+		}
+	}
+}
+
+func TestSortByPriority(t *testing.T) {
+	p := func(n int) *int { return &n }
+	beads := []PendingBead{
+		{ID: "a", Context: &SlingContextFields{}},               // default (2)
+		{ID: "b", Context: &SlingContextFields{Priority: p(0)}}, // highest
+		{ID: "c", Context: &SlingContextFields{Priority: p(3)}},
+		{ID: "d", Context: &SlingContextFields{Priority: p(2)}}, // ties with a, stays after
+		{ID: "e", Context: nil},
+	}
+	SortByPriority(beads)
+
+	var got string
+	for _, b := range beads {
+		got += b.ID
+	}
+	if want := "badec"; got != want {
+		t.Errorf("order = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,53 @@
+package capacity
+
+import "slices"
+
+// Selector narrows a dispatch cycle to matching beads, for targeted manual
+// runs (gt scheduler run --rig/--label/--bead/--limit). The zero Selector
+// matches everything.
+type Selector struct {
+	Rigs   []string // Target rig is one of these
+	Labels []string // Work bead has all of these
+	Beads  []string // Work bead is one of these
+	Limit  int      // At most this many beads (0 = no limit)
+}
+
+// Empty reports whether s matches every bead.
+func (s Selector) Empty() bool {
+	return len(s.Rigs) == 0 && len(s.Labels) == 0 && len(s.Beads) == 0 && s.Limit <= 0
+}
+
+// Matches reports whether b passes the rig, label and bead filters. Labels
+// are the work bead's labels recorded at schedule time.
+func (s Selector) Matches(b PendingBead) bool {
+	if len(s.Rigs) > 0 && !slices.Contains(s.Rigs, b.TargetRig) {
+		return false
+	}
+	if len(s.Beads) > 0 && !slices.Contains(s.Beads, b.WorkBeadID) {
+		return false
+	}
+	for _, label := range s.Labels {
+		if b.Context == nil || !slices.Contains(b.Context.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply returns the pending beads s selects, in order, keeping at most
+// Limit of them.
+func (s Selector) Apply(pending []PendingBead) []PendingBead {
+	if s.Empty() {
+		return pending
+	}
+	var selected []PendingBead
+	for _, b := range pending {
+		if s.Limit > 0 && len(selected) >= s.Limit {
+			break
+		}
+		if s.Matches(b) {
+			selected = append(selected, b)
+		}
+	}
+	return selected
+}
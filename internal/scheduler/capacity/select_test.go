@@ -0,0 +1,43 @@
+package capacity
+
+import "testing"
+
+func TestSelectorApply(t *testing.T) {
+	pending := []PendingBead{
+		{ID: "c1", WorkBeadID: "gt-1", TargetRig: "gastown", Context: &SlingContextFields{Labels: []string{"docs"}}},
+		{ID: "c2", WorkBeadID: "gt-2", TargetRig: "gastown", Context: &SlingContextFields{Labels: []string{"docs", "small"}}},
+		{ID: "c3", WorkBeadID: "bd-3", TargetRig: "beads", Context: &SlingContextFields{Labels: []string{"docs"}}},
+		{ID: "c4", WorkBeadID: "gt-4", TargetRig: "gastown"},
+	}
+	tests := []struct {
+		name string
+		sel  Selector
+		want []string
+	}{
+		{"empty selects all", Selector{}, []string{"c1", "c2", "c3", "c4"}},
+		{"rig", Selector{Rigs: []string{"beads"}}, []string{"c3"}},
+		{"label", Selector{Labels: []string{"docs"}}, []string{"c1", "c2", "c3"}},
+		{"all labels required", Selector{Labels: []string{"docs", "small"}}, []string{"c2"}},
+		{"bead", Selector{Beads: []string{"gt-4", "gt-1"}}, []string{"c1", "c4"}},
+		{"rig and label", Selector{Rigs: []string{"gastown"}, Labels: []string{"docs"}}, []string{"c1", "c2"}},
+		{"limit after filter", Selector{Labels: []string{"docs"}, Limit: 2}, []string{"c1", "c2"}},
+		{"limit alone", Selector{Limit: 1}, []string{"c1"}},
+		{"no match", Selector{Rigs: []string{"nowhere"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, b := range tt.sel.Apply(pending) {
+				got = append(got, b.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Apply() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("Apply() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
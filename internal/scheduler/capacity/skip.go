@@ -0,0 +1,110 @@
+package capacity
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Reasons a scheduled bead is left in the queue by a dispatch cycle.
+const (
+	SkipInvalidContext = "invalid-context" // Sling context missing or unparseable fields
+	SkipCircuitBroken  = "circuit-broken"  // Used up its dispatch attempts
+	SkipBackoff        = "backoff"         // Waiting out --retry-backoff after a failure
+	SkipBlocked        = "blocked"         // Work bead has unresolved blockers
+	SkipDuplicate      = "duplicate"       // An older context schedules the same work bead
+	SkipHeldRig        = "held-rig"        // Target rig is on hold (gt scheduler hold)
+	SkipConvoyGate     = "convoy-gate"     // Convoy waits on another convoy (gt convoy depend)
+	SkipUsageLimit     = "usage-limit"     // Bead's provider is rate-limited
+	SkipConflict       = "conflict"        // Shares a conflict group with in-flight work
+	SkipBatched        = "batched"         // Claimed by another bead's batch polecat
+	SkipCapacity       = "capacity"        // Ready, but no free slot or batch room this cycle
+)
+
+// skipReasonOrder is the order reasons are listed in summaries: roughly
+// from "needs an operator" to "will dispatch on its own".
+var skipReasonOrder = []string{
+	SkipInvalidContext, SkipCircuitBroken, SkipHeldRig, SkipUsageLimit,
+	SkipConvoyGate, SkipBlocked, SkipConflict, SkipBackoff, SkipBatched, SkipDuplicate, SkipCapacity,
+}
+
+// SkippedBead is a scheduled bead a dispatch cycle did not dispatch, and why.
+type SkippedBead struct {
+	ID         string `json:"id"` // Context bead ID
+	WorkBeadID string `json:"work_bead_id,omitempty"`
+	TargetRig  string `json:"target_rig,omitempty"`
+	Reason     string `json:"reason"`           // One of the Skip* constants
+	Detail     string `json:"detail,omitempty"` // e.g. "retry in 4m", "waits on hq-cv-abc"
+}
+
+// String describes the skip for dispatch output, e.g.
+// "gt-abc → gastown: held-rig (held by mayor)".
+func (s SkippedBead) String() string {
+	id := s.WorkBeadID
+	if id == "" {
+		id = s.ID
+	}
+	if s.TargetRig != "" {
+		id += " → " + s.TargetRig
+	}
+	if s.Detail != "" {
+		return fmt.Sprintf("%s: %s (%s)", id, s.Reason, s.Detail)
+	}
+	return fmt.Sprintf("%s: %s", id, s.Reason)
+}
+
+// SkipBead records b as skipped for reason.
+func SkipBead(b PendingBead, reason, detail string) SkippedBead {
+	return SkippedBead{ID: b.ID, WorkBeadID: b.WorkBeadID, TargetRig: b.TargetRig, Reason: reason, Detail: detail}
+}
+
+// SkippedBy returns the beads in before that a filter dropped from after,
+// each skipped for reason. detail, if non-nil, describes each bead's skip.
+func SkippedBy(before, after []PendingBead, reason string, detail func(PendingBead) string) []SkippedBead {
+	if len(before) == len(after) {
+		return nil
+	}
+	kept := make(map[string]bool, len(after))
+	for _, b := range after {
+		kept[b.ID] = true
+	}
+	var skipped []SkippedBead
+	for _, b := range before {
+		if kept[b.ID] {
+			continue
+		}
+		var d string
+		if detail != nil {
+			d = detail(b)
+		}
+		skipped = append(skipped, SkipBead(b, reason, d))
+	}
+	return skipped
+}
+
+// SkipCounts counts skipped beads by reason.
+func SkipCounts(skipped []SkippedBead) map[string]int {
+	counts := make(map[string]int)
+	for _, s := range skipped {
+		counts[s.Reason]++
+	}
+	return counts
+}
+
+// SummarizeSkips describes skipped beads by reason, e.g.
+// "3 blocked, 1 held-rig". Empty when nothing was skipped.
+func SummarizeSkips(skipped []SkippedBead) string {
+	counts := SkipCounts(skipped)
+	var parts []string
+	for _, reason := range skipReasonOrder {
+		if n := counts[reason]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, reason))
+			delete(counts, reason)
+		}
+	}
+	for _, reason := range slices.Sorted(maps.Keys(counts)) { // Not in skipReasonOrder
+		parts = append(parts, fmt.Sprintf("%d %s", counts[reason], reason))
+	}
+	return strings.Join(parts, ", ")
+}
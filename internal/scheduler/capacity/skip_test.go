@@ -0,0 +1,46 @@
+package capacity
+
+import "testing"
+
+func TestSkippedBy(t *testing.T) {
+	before := []PendingBead{
+		{ID: "ctx-1", WorkBeadID: "gt-a", TargetRig: "gastown"},
+		{ID: "ctx-2", WorkBeadID: "gt-b", TargetRig: "beads"},
+		{ID: "ctx-3", WorkBeadID: "gt-c", TargetRig: "gastown"},
+	}
+	after, _ := FilterHeldRigs(before, map[string]string{"beads": "mayor"})
+
+	skipped := SkippedBy(before, after, SkipHeldRig, func(b PendingBead) string {
+		return "held by mayor"
+	})
+	if len(skipped) != 1 {
+		t.Fatalf("got %d skips, want 1: %+v", len(skipped), skipped)
+	}
+	want := SkippedBead{ID: "ctx-2", WorkBeadID: "gt-b", TargetRig: "beads", Reason: SkipHeldRig, Detail: "held by mayor"}
+	if skipped[0] != want {
+		t.Errorf("got %+v, want %+v", skipped[0], want)
+	}
+	if got := skipped[0].String(); got != "gt-b → beads: held-rig (held by mayor)" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if s := SkippedBy(before, before, SkipHeldRig, nil); s != nil {
+		t.Errorf("nothing filtered, got %+v", s)
+	}
+}
+
+func TestSummarizeSkips(t *testing.T) {
+	skipped := []SkippedBead{
+		{ID: "a", Reason: SkipBlocked},
+		{ID: "b", Reason: SkipCapacity},
+		{ID: "c", Reason: SkipBlocked},
+		{ID: "d", Reason: SkipCircuitBroken},
+		{ID: "e", Reason: "custom"},
+	}
+	if got, want := SummarizeSkips(skipped), "1 circuit-broken, 2 blocked, 1 capacity, 1 custom"; got != want {
+		t.Errorf("SummarizeSkips = %q, want %q", got, want)
+	}
+	if got := SummarizeSkips(nil); got != "" {
+		t.Errorf("SummarizeSkips(nil) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,89 @@
+package capacity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Usage is the raw capacity picture gathered from the town: configuration,
+// polecat sessions, the scheduled queue, and anything holding dispatch back.
+type Usage struct {
+	MaxPolecats   int `json:"max_polecats"`   // scheduler.max_polecats; <= 0 means direct dispatch
+	Active        int `json:"active"`         // Polecats with hooked work that count toward the cap
+	Paused        int `json:"paused"`         // Polecats paused by gt polecat pause
+	PausedHolding int `json:"paused_holding"` // Paused polecats still holding their slot (included in Active)
+	NeedsInput    int `json:"needs_input"`    // Polecats waiting in gt ask (not in Active)
+	Queued        int `json:"queued"`         // Scheduled beads that are unblocked and not on a held rig
+
+	SchedulerPaused bool       `json:"scheduler_paused"`
+	PausedBy        string     `json:"paused_by,omitempty"`
+	HeldRigs        []string   `json:"held_rigs,omitempty"`
+	SnoozedUntil    *time.Time `json:"snoozed_until,omitempty"`
+	Accounts        int        `json:"accounts"`         // Accounts with recorded quota state
+	LimitedAccounts int        `json:"limited_accounts"` // Of those, rate-limited or cooling down
+
+	// LimitedProviders are providers whose limits hold their beads back
+	// (every account limited, or a provider-wide limit). Beads for other
+	// providers still dispatch.
+	LimitedProviders []string `json:"limited_providers,omitempty"`
+
+	// LimitCap is the scheduler.limit_cap ceiling already applied to
+	// MaxPolecats, if any.
+	LimitCap *LimitCap `json:"limit_cap,omitempty"`
+}
+
+// Snapshot is the computed capacity of the town at one moment, for deciding
+// whether to push more work into the scheduler queue.
+type Snapshot struct {
+	Usage
+
+	Mode      string `json:"mode"`      // "deferred" or "direct"
+	Free      int    `json:"free"`      // Slots not held by active polecats (deferred mode)
+	Reserved  int    `json:"reserved"`  // Free slots already claimed by queued beads
+	Available int    `json:"available"` // Free slots left after the queue drains
+
+	// Accepting reports whether new work would be dispatched soon. Reasons
+	// explains each condition holding it back when it is false.
+	Accepting bool     `json:"accepting"`
+	Reasons   []string `json:"reasons,omitempty"`
+}
+
+// Calculate derives the capacity snapshot from usage at now.
+//
+// In direct dispatch there is no cap: gt sling spawns immediately, so only
+// limits (snooze, exhausted accounts) stop new work. In deferred dispatch,
+// queued beads claim free slots first; new work is accepted only when slots
+// remain after them and the scheduler is not paused.
+func Calculate(u Usage, now time.Time) Snapshot {
+	s := Snapshot{Usage: u, Mode: "direct"}
+	if u.SnoozedUntil != nil && !u.SnoozedUntil.After(now) {
+		s.SnoozedUntil = nil
+	}
+
+	if u.MaxPolecats > 0 {
+		s.Mode = "deferred"
+		s.Free = max(u.MaxPolecats-u.Active, 0)
+		s.Reserved = min(u.Queued, s.Free)
+		s.Available = s.Free - s.Reserved
+
+		if u.SchedulerPaused {
+			s.Reasons = append(s.Reasons, fmt.Sprintf("scheduler paused by %s", u.PausedBy))
+		}
+		switch {
+		case s.Free == 0:
+			s.Reasons = append(s.Reasons, fmt.Sprintf("no free slots (%d/%d active)", u.Active, u.MaxPolecats))
+		case s.Available == 0:
+			s.Reasons = append(s.Reasons, fmt.Sprintf("queue already fills the %d free slot(s)", s.Free))
+		}
+	}
+
+	if s.SnoozedUntil != nil {
+		s.Reasons = append(s.Reasons, "limits snoozed until "+s.SnoozedUntil.Local().Format("Jan 2 15:04"))
+	}
+	if u.Accounts > 0 && u.LimitedAccounts >= u.Accounts {
+		s.Reasons = append(s.Reasons, fmt.Sprintf("all %d account(s) rate-limited", u.Accounts))
+	}
+
+	s.Accepting = len(s.Reasons) == 0
+	return s
+}
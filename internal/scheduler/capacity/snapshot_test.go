@@ -0,0 +1,51 @@
+package capacity
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalculate(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	later, earlier := now.Add(time.Hour), now.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		usage     Usage
+		free      int
+		reserved  int
+		available int
+		accepting bool
+		reason    string
+	}{
+		{"direct dispatch", Usage{MaxPolecats: -1, Active: 7}, 0, 0, 0, true, ""},
+		{"room after queue", Usage{MaxPolecats: 5, Active: 2, Queued: 1}, 3, 1, 2, true, ""},
+		{"queue fills slots", Usage{MaxPolecats: 5, Active: 2, Queued: 4}, 3, 3, 0, false, "queue already fills"},
+		{"at capacity", Usage{MaxPolecats: 3, Active: 4}, 0, 0, 0, false, "no free slots (4/3 active)"},
+		{"scheduler paused", Usage{MaxPolecats: 5, SchedulerPaused: true, PausedBy: "mayor"}, 5, 0, 5, false, "paused by mayor"},
+		{"snoozed", Usage{MaxPolecats: -1, SnoozedUntil: &later}, 0, 0, 0, false, "limits snoozed"},
+		{"snooze expired", Usage{MaxPolecats: 5, SnoozedUntil: &earlier}, 5, 0, 5, true, ""},
+		{"accounts exhausted", Usage{MaxPolecats: 5, Accounts: 2, LimitedAccounts: 2}, 5, 0, 5, false, "all 2 account(s)"},
+		{"some accounts limited", Usage{MaxPolecats: 5, Accounts: 2, LimitedAccounts: 1}, 5, 0, 5, true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Calculate(tt.usage, now)
+			if s.Free != tt.free || s.Reserved != tt.reserved || s.Available != tt.available {
+				t.Errorf("free/reserved/available = %d/%d/%d, want %d/%d/%d",
+					s.Free, s.Reserved, s.Available, tt.free, tt.reserved, tt.available)
+			}
+			if s.Accepting != tt.accepting {
+				t.Errorf("Accepting = %v, want %v (reasons %v)", s.Accepting, tt.accepting, s.Reasons)
+			}
+			if tt.reason != "" && !strings.Contains(strings.Join(s.Reasons, "; "), tt.reason) {
+				t.Errorf("Reasons = %v, want one containing %q", s.Reasons, tt.reason)
+			}
+		})
+	}
+
+	if s := Calculate(Usage{SnoozedUntil: &earlier}, now); s.SnoozedUntil != nil {
+		t.Errorf("expired snooze reported: %v", s.SnoozedUntil)
+	}
+}
@@ -0,0 +1,70 @@
+package capacity
+
+import (
+	"slices"
+	"sort"
+)
+
+// DefaultStarvationCycles is how many dispatch cycles a ready bead may be
+// passed over before it is boosted (scheduler.starvation_cycles).
+const DefaultStarvationCycles = 20
+
+// RecordPassedOver updates the starvation counts after a dispatch cycle.
+// waiting are the ready beads the cycle left queued for want of capacity;
+// each is counted as passed over when othersDispatched, i.e. other beads
+// took the slots it was waiting for. A cycle with nothing dispatched leaves
+// counts as they are: everyone waited alike. Beads no longer waiting are
+// forgotten, so only consecutive eligibility counts.
+//
+// Beads passed over threshold times or more are Starved and dispatch ahead
+// of everything else (see BoostStarved). RecordPassedOver returns the beads
+// that became starved in this cycle. threshold <= 0 turns detection off.
+func (s *SchedulerState) RecordPassedOver(waiting []PendingBead, othersDispatched bool, threshold int) []PendingBead {
+	if threshold <= 0 {
+		s.PassedOver, s.Starved = nil, nil
+		return nil
+	}
+	counts := make(map[string]int)
+	var starved []string
+	var newly []PendingBead
+	for _, b := range waiting {
+		n := s.PassedOver[b.ID]
+		if othersDispatched {
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+		counts[b.ID] = n
+		if n >= threshold {
+			starved = append(starved, b.ID)
+			if !slices.Contains(s.Starved, b.ID) {
+				newly = append(newly, b)
+			}
+		}
+	}
+	if len(counts) == 0 {
+		counts = nil
+	}
+	sort.Strings(starved)
+	s.PassedOver, s.Starved = counts, starved
+	return newly
+}
+
+// IsStarved reports whether the bead with context ID id has been boosted
+// for starvation.
+func (s *SchedulerState) IsStarved(id string) bool {
+	return slices.Contains(s.Starved, id)
+}
+
+// BoostStarved moves starved beads (by context ID) to the front of the
+// dispatch order. The move is stable: starved beads keep their relative
+// order, as do the rest.
+func BoostStarved(beads []PendingBead, starved []string) {
+	if len(starved) == 0 {
+		return
+	}
+	sort.SliceStable(beads, func(i, j int) bool {
+		return slices.Contains(starved, beads[i].ID) && !slices.Contains(starved, beads[j].ID)
+	})
+}
@@ -0,0 +1,55 @@
+package capacity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRecordPassedOver(t *testing.T) {
+	low := PendingBead{ID: "ctx-low", WorkBeadID: "gt-low"}
+	other := PendingBead{ID: "ctx-other", WorkBeadID: "gt-other"}
+	s := &SchedulerState{}
+
+	// Passed over twice while others dispatch: not starved yet at 3.
+	for i := 0; i < 2; i++ {
+		if newly := s.RecordPassedOver([]PendingBead{low}, true, 3); newly != nil {
+			t.Fatalf("cycle %d: newly starved %+v", i, newly)
+		}
+	}
+	// A cycle with nothing dispatched doesn't count either way.
+	s.RecordPassedOver([]PendingBead{low, other}, false, 3)
+	if s.PassedOver["ctx-low"] != 2 || s.PassedOver["ctx-other"] != 0 {
+		t.Errorf("PassedOver = %v, want ctx-low 2 and no ctx-other", s.PassedOver)
+	}
+
+	newly := s.RecordPassedOver([]PendingBead{low, other}, true, 3)
+	if len(newly) != 1 || newly[0].WorkBeadID != "gt-low" || !s.IsStarved("ctx-low") {
+		t.Fatalf("third pass: newly = %+v, Starved = %v", newly, s.Starved)
+	}
+	if newly := s.RecordPassedOver([]PendingBead{low}, true, 3); newly != nil {
+		t.Errorf("already starved bead reported again: %+v", newly)
+	}
+
+	// Leaving the wait (dispatched, blocked, held) forgets the count.
+	s.RecordPassedOver([]PendingBead{other}, true, 3)
+	if s.IsStarved("ctx-low") || s.PassedOver["ctx-low"] != 0 {
+		t.Errorf("ctx-low left the queue but is still tracked: %v %v", s.PassedOver, s.Starved)
+	}
+
+	s.RecordPassedOver(nil, true, 0)
+	if s.PassedOver != nil || s.Starved != nil {
+		t.Errorf("threshold 0 should clear tracking: %v %v", s.PassedOver, s.Starved)
+	}
+}
+
+func TestBoostStarved(t *testing.T) {
+	beads := []PendingBead{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}}
+	BoostStarved(beads, []string{"d", "b"})
+	var got []string
+	for _, b := range beads {
+		got = append(got, b.ID)
+	}
+	if want := []string{"b", "d", "a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+}
@@ -2,9 +2,13 @@ package capacity
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
+
+	"github.com/gofrs/flock"
 )
 
 // SchedulerState represents the runtime operational state of the capacity scheduler.
@@ -16,6 +20,47 @@ type SchedulerState struct {
 	PausedAt          string `json:"paused_at,omitempty"`
 	LastDispatchAt    string `json:"last_dispatch_at,omitempty"`
 	LastDispatchCount int    `json:"last_dispatch_count,omitempty"`
+
+	// HeldRigs is the set of rigs whose scheduled beads are not dispatched,
+	// keyed by rig name with the actor who placed the hold as the value.
+	// Other rigs keep dispatching normally (e.g., one rig's CI is down).
+	HeldRigs map[string]string `json:"held_rigs,omitempty"`
+
+	// Throttle holds the adaptive throttle's current batch size and spawn
+	// delay (scheduler.adaptive). Nil until the first adaptive cycle.
+	Throttle *ThrottleState `json:"throttle,omitempty"`
+
+	// QueueLevels records each rig's queue level against its watermarks
+	// (see Watermarks.Level), so an alert fires once per crossing. Rigs at
+	// the normal level are absent.
+	QueueLevels map[string]string `json:"queue_levels,omitempty"`
+
+	// SkipSummary is the last dispatch cycle's SummarizeSkips, so a
+	// scheduler_skipped event is logged when it changes, not every cycle.
+	SkipSummary string `json:"skip_summary,omitempty"`
+
+	// StaleBeads are the work beads labeled gt:queue-stale for sitting in
+	// the queue longer than scheduler.max_age, so each is labeled once and
+	// unlabeled when it leaves the queue.
+	StaleBeads []string `json:"stale_beads,omitempty"`
+
+	// QueueNotes is the queue note last posted on each queued work bead
+	// (scheduler.queue_notes), so a new one is posted only on a significant
+	// change.
+	QueueNotes map[string]QueueNote `json:"queue_notes,omitempty"`
+
+	// PassedOver counts, per sling context bead, the consecutive dispatch
+	// cycles a ready bead was left waiting while others were dispatched.
+	// Starved are the contexts that reached scheduler.starvation_cycles and
+	// now dispatch first (see RecordPassedOver).
+	PassedOver map[string]int `json:"passed_over,omitempty"`
+	Starved    []string       `json:"starved,omitempty"`
+
+	// ConflictHolds maps each rig's conflict group (scheduler.conflict_groups,
+	// keyed by ConflictKey) to the dispatched work bead holding it, so other
+	// beads for that rig in the group wait until it finishes (see
+	// SerializeConflicts).
+	ConflictHolds map[string]ConflictHold `json:"conflict_holds,omitempty"`
 }
 
 // stateFile returns the path to the scheduler state file.
@@ -23,6 +68,12 @@ func stateFile(townRoot string) string {
 	return filepath.Join(townRoot, ".runtime", "scheduler-state.json")
 }
 
+// stateLockFile returns the path to the flock file guarding the scheduler
+// state file.
+func stateLockFile(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "scheduler-state.lock")
+}
+
 // legacyStateFile returns the path to the old queue state file for migration.
 func legacyStateFile(townRoot string) string {
 	return filepath.Join(townRoot, ".runtime", "queue-state.json")
@@ -58,6 +109,39 @@ func LoadState(townRoot string) (*SchedulerState, error) {
 	return &state, nil
 }
 
+// UpdateState performs a locked read-modify-write of the scheduler state.
+// fn mutates a freshly loaded state and reports whether it changed it; the
+// state is written only on a change. Writers that go through UpdateState
+// apply their changes in turn, so a pause from the CLI or daemon is never
+// overwritten by a dispatch cycle saving an older snapshot. Returns the
+// resulting state.
+func UpdateState(townRoot string, fn func(state *SchedulerState) (changed bool, err error)) (*SchedulerState, error) {
+	lockPath := stateLockFile(townRoot)
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0755); err != nil {
+		return nil, fmt.Errorf("creating scheduler state lock dir: %w", err)
+	}
+	fl := flock.New(lockPath)
+	if err := fl.Lock(); err != nil {
+		return nil, fmt.Errorf("acquiring scheduler state lock: %w", err)
+	}
+	defer func() { _ = fl.Unlock() }()
+
+	state, err := LoadState(townRoot)
+	if err != nil {
+		return nil, err
+	}
+	changed, err := fn(state)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		if err := SaveState(townRoot, state); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
 // SaveState writes the scheduler runtime state to disk atomically.
 // Uses write-to-temp + rename to prevent corruption from concurrent writers
 // (e.g., dispatch RecordDispatch racing with gt scheduler pause).
@@ -116,3 +200,40 @@ func (s *SchedulerState) RecordDispatch(count int) {
 	s.LastDispatchAt = time.Now().UTC().Format(time.RFC3339)
 	s.LastDispatchCount = count
 }
+
+// HoldRig stops dispatch to the given rig until ReleaseRig is called.
+func (s *SchedulerState) HoldRig(rig, by string) {
+	if s.HeldRigs == nil {
+		s.HeldRigs = make(map[string]string)
+	}
+	s.HeldRigs[rig] = by
+}
+
+// ReleaseRig resumes dispatch to the given rig.
+// Returns false if the rig was not held.
+func (s *SchedulerState) ReleaseRig(rig string) bool {
+	if _, ok := s.HeldRigs[rig]; !ok {
+		return false
+	}
+	delete(s.HeldRigs, rig)
+	if len(s.HeldRigs) == 0 {
+		s.HeldRigs = nil
+	}
+	return true
+}
+
+// IsRigHeld returns true if dispatch to the given rig is on hold.
+func (s *SchedulerState) IsRigHeld(rig string) bool {
+	_, ok := s.HeldRigs[rig]
+	return ok
+}
+
+// HeldRigNames returns the held rig names in sorted order.
+func (s *SchedulerState) HeldRigNames() []string {
+	names := make([]string, 0, len(s.HeldRigs))
+	for rig := range s.HeldRigs {
+		names = append(names, rig)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -3,6 +3,7 @@ package capacity
 import (
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 )
@@ -183,3 +184,95 @@ func TestLoadState_LegacyFallback(t *testing.T) {
 		t.Errorf("PausedBy: got %q, want %q", state.PausedBy, "legacy-user")
 	}
 }
+
+func TestUpdateState_ConcurrentWriters(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// Each writer holds a different rig; unlocked load/save would lose some.
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := UpdateState(tmpDir, func(s *SchedulerState) (bool, error) {
+				s.HoldRig(string(rune('a'+i)), "test")
+				return true, nil
+			}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	state, err := LoadState(tmpDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.HeldRigs) != 20 {
+		t.Errorf("HeldRigs = %d, want 20", len(state.HeldRigs))
+	}
+
+	// No change, no write.
+	if err := os.Remove(stateFile(tmpDir)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := UpdateState(tmpDir, func(*SchedulerState) (bool, error) { return false, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(stateFile(tmpDir)); !os.IsNotExist(err) {
+		t.Errorf("unchanged state was written: %v", err)
+	}
+}
+
+func TestHoldAndReleaseRig(t *testing.T) {
+	state := &SchedulerState{}
+
+	if state.IsRigHeld("gastown") {
+		t.Fatal("expected gastown not held on zero state")
+	}
+
+	state.HoldRig("gastown", "admin")
+	state.HoldRig("beads", "admin")
+	if !state.IsRigHeld("gastown") {
+		t.Error("expected gastown held after HoldRig")
+	}
+	if got := state.HeldRigNames(); len(got) != 2 || got[0] != "beads" || got[1] != "gastown" {
+		t.Errorf("HeldRigNames: got %v, want [beads gastown]", got)
+	}
+
+	if !state.ReleaseRig("gastown") {
+		t.Error("ReleaseRig(gastown) should report it was held")
+	}
+	if state.ReleaseRig("gastown") {
+		t.Error("second ReleaseRig(gastown) should report not held")
+	}
+	if state.IsRigHeld("gastown") {
+		t.Error("expected gastown not held after ReleaseRig")
+	}
+
+	state.ReleaseRig("beads")
+	if state.HeldRigs != nil {
+		t.Errorf("expected HeldRigs reset to nil when empty, got %v", state.HeldRigs)
+	}
+}
+
+func TestSaveAndLoadState_HeldRigs(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	state := &SchedulerState{}
+	state.HoldRig("gastown", "test-user")
+	if err := SaveState(tmpDir, state); err != nil {
+		t.Fatalf("SaveState: %v", err)
+	}
+
+	loaded, err := LoadState(tmpDir)
+	if err != nil {
+		t.Fatalf("LoadState: %v", err)
+	}
+	if loaded.HeldRigs["gastown"] != "test-user" {
+		t.Errorf("HeldRigs[gastown]: got %q, want %q", loaded.HeldRigs["gastown"], "test-user")
+	}
+	if loaded.Paused {
+		t.Error("holding a rig should not pause the scheduler")
+	}
+}
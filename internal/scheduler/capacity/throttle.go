@@ -0,0 +1,97 @@
+package capacity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Adaptive throttle thresholds. A cycle whose successful slings average
+// above SlowSling backs off; one averaging below FastSling with no failures
+// speeds back up.
+const (
+	SlowSling = 90 * time.Second
+	FastSling = 30 * time.Second
+
+	// throttleDelayStep is the spawn delay used when first backing off from
+	// no delay; the delay doubles from there up to maxThrottleDelay.
+	throttleDelayStep = 5 * time.Second
+	maxThrottleDelay  = 2 * time.Minute
+)
+
+// ThrottleState is the adaptive throttle's current setting, persisted in
+// SchedulerState between dispatch cycles.
+type ThrottleState struct {
+	BatchSize  int    `json:"batch_size"`
+	SpawnDelay string `json:"spawn_delay"`
+	Reason     string `json:"reason,omitempty"`     // Why it last changed
+	UpdatedAt  string `json:"updated_at,omitempty"` // When it last changed
+}
+
+// CycleStats summarizes one dispatch cycle for the adaptive throttle.
+type CycleStats struct {
+	Dispatched int
+	// Failed counts failures caused by the system (spawn, session, hook,
+	// lock contention), not by the bead itself; Contention is the subset
+	// that were Dolt lock contention.
+	Failed     int
+	Contention int
+	// Latency is the mean duration of successful slings.
+	Latency time.Duration
+}
+
+// Effective returns the batch size and spawn delay to use this cycle: the
+// throttle's values clamped to the configured ceiling and floor, or the
+// configured values when there is no throttle state yet.
+func (t *ThrottleState) Effective(maxBatch int, minDelay time.Duration) (int, time.Duration) {
+	if t == nil {
+		return maxBatch, minDelay
+	}
+	batch := min(max(t.BatchSize, 1), max(maxBatch, 1))
+	delay := max(ParseDurationOrDefault(t.SpawnDelay, minDelay), minDelay)
+	return batch, delay
+}
+
+// AdjustThrottle returns the throttle for the next cycle given this cycle's
+// stats, halving the batch and doubling the delay when slings are failing,
+// contending for Dolt locks, or slow, and stepping back toward the
+// configured values when they are healthy. A cycle that dispatched nothing
+// carries no signal and leaves the throttle as it was.
+func AdjustThrottle(t *ThrottleState, s CycleStats, maxBatch int, minDelay time.Duration, now time.Time) *ThrottleState {
+	batch, delay := t.Effective(maxBatch, minDelay)
+	if s.Dispatched+s.Failed == 0 {
+		return t
+	}
+
+	var reason string
+	switch {
+	case s.Contention > 0:
+		reason = fmt.Sprintf("backed off: %d Dolt lock contention failure(s)", s.Contention)
+	case s.Failed > 0 && s.Failed >= s.Dispatched:
+		reason = fmt.Sprintf("backed off: %d of %d sling(s) failed", s.Failed, s.Failed+s.Dispatched)
+	case s.Dispatched > 0 && s.Latency > SlowSling:
+		reason = fmt.Sprintf("backed off: slow slings (avg %s)", s.Latency.Round(time.Second))
+	case s.Failed == 0 && s.Latency < FastSling:
+		next := &ThrottleState{
+			BatchSize:  min(batch+1, max(maxBatch, 1)),
+			SpawnDelay: max(delay/2, minDelay).String(),
+		}
+		if delay/2 < time.Second {
+			next.SpawnDelay = minDelay.String()
+		}
+		if t != nil && next.BatchSize == t.BatchSize && next.SpawnDelay == t.SpawnDelay {
+			return t // Already at the configured values
+		}
+		next.Reason = fmt.Sprintf("sped up: healthy slings (avg %s)", s.Latency.Round(time.Second))
+		next.UpdatedAt = now.UTC().Format(time.RFC3339)
+		return next
+	default:
+		return t // Mixed signal: hold
+	}
+
+	return &ThrottleState{
+		BatchSize:  max(batch/2, 1),
+		SpawnDelay: min(max(delay*2, throttleDelayStep, minDelay), max(maxThrottleDelay, minDelay)).String(),
+		Reason:     reason,
+		UpdatedAt:  now.UTC().Format(time.RFC3339),
+	}
+}
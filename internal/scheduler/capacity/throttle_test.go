@@ -0,0 +1,68 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThrottleEffective(t *testing.T) {
+	var none *ThrottleState
+	if b, d := none.Effective(4, time.Second); b != 4 || d != time.Second {
+		t.Errorf("nil throttle = %d, %s; want config values", b, d)
+	}
+
+	// Clamped to the configured ceiling and floor.
+	th := &ThrottleState{BatchSize: 10, SpawnDelay: "100ms"}
+	if b, d := th.Effective(4, time.Second); b != 4 || d != time.Second {
+		t.Errorf("Effective = %d, %s; want 4, 1s", b, d)
+	}
+	th = &ThrottleState{BatchSize: 2, SpawnDelay: "20s"}
+	if b, d := th.Effective(4, time.Second); b != 2 || d != 20*time.Second {
+		t.Errorf("Effective = %d, %s; want 2, 20s", b, d)
+	}
+}
+
+func TestAdjustThrottle(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name      string
+		cur       *ThrottleState
+		stats     CycleStats
+		wantBatch int
+		wantDelay string
+	}{
+		{"contention backs off", nil, CycleStats{Dispatched: 3, Failed: 1, Contention: 1, Latency: time.Second}, 2, "5s"},
+		{"mostly failing backs off", &ThrottleState{BatchSize: 4, SpawnDelay: "10s"}, CycleStats{Dispatched: 1, Failed: 2}, 2, "20s"},
+		{"slow slings back off", nil, CycleStats{Dispatched: 4, Latency: 2 * time.Minute}, 2, "5s"},
+		{"delay capped", &ThrottleState{BatchSize: 1, SpawnDelay: "90s"}, CycleStats{Failed: 1, Contention: 1}, 1, "2m0s"},
+		{"healthy grows", &ThrottleState{BatchSize: 2, SpawnDelay: "20s"}, CycleStats{Dispatched: 2, Latency: 10 * time.Second}, 3, "10s"},
+		{"healthy returns to floor", &ThrottleState{BatchSize: 3, SpawnDelay: "1s"}, CycleStats{Dispatched: 3, Latency: 10 * time.Second}, 4, "0s"},
+		{"middling latency holds", &ThrottleState{BatchSize: 2, SpawnDelay: "20s"}, CycleStats{Dispatched: 2, Latency: time.Minute}, 2, "20s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AdjustThrottle(tt.cur, tt.stats, 4, 0, now)
+			if got == nil {
+				t.Fatal("AdjustThrottle returned nil")
+			}
+			if got.BatchSize != tt.wantBatch || got.SpawnDelay != tt.wantDelay {
+				t.Errorf("AdjustThrottle = batch %d, delay %s; want %d, %s", got.BatchSize, got.SpawnDelay, tt.wantBatch, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func TestAdjustThrottle_NoSignal(t *testing.T) {
+	now := time.Now()
+	if got := AdjustThrottle(nil, CycleStats{}, 4, 0, now); got != nil {
+		t.Errorf("empty cycle with no state = %+v, want nil", got)
+	}
+	// Healthy at the configured values: nothing to change.
+	if got := AdjustThrottle(nil, CycleStats{Dispatched: 4, Latency: time.Second}, 4, 0, now); got == nil || got.BatchSize != 4 {
+		t.Errorf("healthy at ceiling = %+v", got)
+	}
+	cur := &ThrottleState{BatchSize: 4, SpawnDelay: "0s", Reason: "sped up"}
+	if got := AdjustThrottle(cur, CycleStats{Dispatched: 4, Latency: time.Second}, 4, 0, now); got != cur {
+		t.Errorf("healthy at ceiling replaced state: %+v", got)
+	}
+}
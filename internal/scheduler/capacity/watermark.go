@@ -0,0 +1,73 @@
+package capacity
+
+import "time"
+
+// Queue levels relative to a rig's watermarks, persisted per rig in
+// SchedulerState.QueueLevels.
+const (
+	QueueLevelNormal = ""
+	QueueLevelHigh   = "high"
+	QueueLevelLow    = "low"
+)
+
+// Watermarks are a rig's queue depth thresholds. The queue is backing up at
+// High beads or more and running dry below Low. Zero disables a watermark.
+type Watermarks struct {
+	High int
+	Low  int
+}
+
+// Enabled reports whether either watermark is set.
+func (w Watermarks) Enabled() bool {
+	return w.High > 0 || w.Low > 0
+}
+
+// Level returns the queue level for depth, given the level last recorded.
+// A level holds until the queue recovers to halfway between the watermarks
+// (or half the high watermark, or twice the low one, when only one is set),
+// so a queue hovering at a watermark doesn't flap and alert every heartbeat.
+func (w Watermarks) Level(depth int, prev string) string {
+	switch {
+	case w.High > 0 && depth >= w.High:
+		return QueueLevelHigh
+	case w.Low > 0 && depth < w.Low:
+		return QueueLevelLow
+	case prev == QueueLevelHigh && w.High > 0 && depth > w.highClear():
+		return QueueLevelHigh
+	case prev == QueueLevelLow && w.Low > 0 && depth < w.lowClear():
+		return QueueLevelLow
+	}
+	return QueueLevelNormal
+}
+
+// highClear is the depth at or below which a backed-up queue has recovered.
+func (w Watermarks) highClear() int {
+	if w.Low > 0 {
+		return (w.High + w.Low) / 2
+	}
+	return w.High / 2
+}
+
+// lowClear is the depth at or above which a dry queue has recovered.
+func (w Watermarks) lowClear() int {
+	if w.High > 0 {
+		return (w.High + w.Low + 1) / 2
+	}
+	return w.Low * 2
+}
+
+// QueueETA estimates how long a rig takes to drain depth queued beads,
+// worked parallel at a time. durations holds the estimates of the beads that
+// have one; the rest are assumed to take their average. Reports false when
+// no bead has an estimate.
+func QueueETA(durations []time.Duration, depth, parallel int) (time.Duration, bool) {
+	if len(durations) == 0 || depth == 0 {
+		return 0, false
+	}
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	total = total / time.Duration(len(durations)) * time.Duration(depth)
+	return total / time.Duration(max(parallel, 1)), true
+}
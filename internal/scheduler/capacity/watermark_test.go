@@ -0,0 +1,74 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWatermarksLevel(t *testing.T) {
+	w := Watermarks{High: 40, Low: 4}
+	// Depths seen on successive heartbeats, and the level after each.
+	steps := []struct {
+		depth int
+		want  string
+	}{
+		{10, QueueLevelNormal},
+		{40, QueueLevelHigh},
+		{39, QueueLevelHigh}, // Hovering below the watermark doesn't re-arm
+		{23, QueueLevelHigh},
+		{22, QueueLevelNormal}, // Halfway between the watermarks
+		{45, QueueLevelHigh},
+		{3, QueueLevelLow},
+		{4, QueueLevelLow},
+		{22, QueueLevelNormal},
+		{0, QueueLevelLow},
+	}
+	level := QueueLevelNormal
+	for i, s := range steps {
+		level = w.Level(s.depth, level)
+		if level != s.want {
+			t.Fatalf("step %d: depth %d level = %q, want %q", i, s.depth, level, s.want)
+		}
+	}
+}
+
+func TestWatermarksLevel_OneSided(t *testing.T) {
+	high := Watermarks{High: 10}
+	if got := high.Level(6, QueueLevelHigh); got != QueueLevelHigh {
+		t.Errorf("high-only at 6 after high = %q, want high until half the watermark", got)
+	}
+	if got := high.Level(5, QueueLevelHigh); got != QueueLevelNormal {
+		t.Errorf("high-only at 5 after high = %q, want normal", got)
+	}
+	if got := high.Level(0, QueueLevelNormal); got != QueueLevelNormal {
+		t.Errorf("high-only empty queue = %q, want normal", got)
+	}
+
+	empty := Watermarks{Low: 1} // Alert when the queue runs dry
+	if got := empty.Level(0, QueueLevelNormal); got != QueueLevelLow {
+		t.Errorf("low=1 at 0 = %q, want low", got)
+	}
+	if got := empty.Level(1, QueueLevelLow); got != QueueLevelLow {
+		t.Errorf("low=1 at 1 after low = %q, want low until twice the watermark", got)
+	}
+	if got := empty.Level(2, QueueLevelLow); got != QueueLevelNormal {
+		t.Errorf("low=1 at 2 after low = %q, want normal", got)
+	}
+	if (Watermarks{}).Enabled() || !empty.Enabled() {
+		t.Error("Enabled wrong")
+	}
+}
+
+func TestQueueETA(t *testing.T) {
+	durations := []time.Duration{time.Hour, 3 * time.Hour}
+	// 40 beads at an average of 2h, 5 at a time.
+	if eta, ok := QueueETA(durations, 40, 5); !ok || eta != 16*time.Hour {
+		t.Errorf("ETA = %v, %v; want 16h", eta, ok)
+	}
+	if _, ok := QueueETA(nil, 40, 5); ok {
+		t.Error("ETA known without any estimates")
+	}
+	if eta, _ := QueueETA(durations, 2, 0); eta != 4*time.Hour {
+		t.Errorf("ETA with no parallelism = %v, want serial 4h", eta)
+	}
+}
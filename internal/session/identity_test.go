@@ -97,6 +97,33 @@ func TestParseSessionName(t *testing.T) {
 			wantPrefix: "hq",
 		},
 
+		// Polecat naming options: a per-rig name prefix, or names derived
+		// from bead IDs (the rig's own prefix dropped, others kept).
+		{
+			name:       "polecat with name prefix",
+			session:    "gt-api-furiosa",
+			wantRole:   RolePolecat,
+			wantRig:    "gastown",
+			wantName:   "api-furiosa",
+			wantPrefix: "gt",
+		},
+		{
+			name:       "polecat named from own bead",
+			session:    "gt-abc12",
+			wantRole:   RolePolecat,
+			wantRig:    "gastown",
+			wantName:   "abc12",
+			wantPrefix: "gt",
+		},
+		{
+			name:       "polecat named from other rig's bead",
+			session:    "gt-bd-xyz-1",
+			wantRole:   RolePolecat,
+			wantRig:    "gastown",
+			wantName:   "bd-xyz-1",
+			wantPrefix: "gt",
+		},
+
 		// Witness (new format: <prefix>-witness)
 		{
 			name:       "witness gastown",
@@ -380,6 +407,8 @@ func TestParseSessionName_RoundTrip(t *testing.T) {
 		"hq-refinery",
 		"hq-jasper",
 		"hq-crew-rushd",
+		"gt-api-furiosa",
+		"gt-bd-xyz-1",
 	}
 
 	for _, sess := range sessions {
@@ -0,0 +1,78 @@
+package session
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// WakeBackend names how a wake message reached an agent.
+type WakeBackend string
+
+const (
+	// WakeTmux means the message was typed into the session's tmux pane.
+	WakeTmux WakeBackend = "tmux"
+	// WakeQueue means the message was written to the session's nudge queue
+	// (a prompt file under .runtime/nudge_queue). Headless agents pick it up
+	// from there: the ACP propeller watches the queue and sends it on the
+	// agent's stdin, and hook-driven agents drain it at their next turn.
+	WakeQueue WakeBackend = "queue"
+)
+
+// Waker delivers a wake message to an agent session through whichever
+// backend runs it.
+type Waker interface {
+	Wake(session, message string) (WakeBackend, error)
+}
+
+// wakeTmux is the subset of tmux operations the waker needs.
+type wakeTmux interface {
+	HasSession(name string) (bool, error)
+	NudgeSession(session, message string) error
+}
+
+// BackendWaker wakes sessions that have a tmux pane by typing into it, and
+// sessions without one (headless or process-based agents) through their
+// nudge queue. Tmux is preferred when both exist: an idle tmux agent never
+// drains its queue, so a queued wake would sit there unseen.
+type BackendWaker struct {
+	TownRoot string // Required for queue delivery
+	Sender   string // Recorded on queued wakes
+	Tmux     wakeTmux
+}
+
+// NewWaker returns a Waker for the town's sessions. t may be nil on hosts
+// without tmux, in which case every wake is queued.
+func NewWaker(townRoot, sender string, t *tmux.Tmux) *BackendWaker {
+	w := &BackendWaker{TownRoot: townRoot, Sender: sender}
+	if t != nil {
+		w.Tmux = t
+	}
+	return w
+}
+
+// Wake delivers message to session and reports the backend used.
+func (w *BackendWaker) Wake(session, message string) (WakeBackend, error) {
+	if w.Tmux != nil {
+		if has, err := w.Tmux.HasSession(session); err == nil && has {
+			if err := w.Tmux.NudgeSession(session, message); err != nil {
+				return WakeTmux, err
+			}
+			return WakeTmux, nil
+		}
+	}
+
+	if w.TownRoot == "" {
+		return "", fmt.Errorf("session %s has no tmux pane and no town root to queue a wake", session)
+	}
+	if err := nudge.Enqueue(w.TownRoot, session, nudge.QueuedNudge{
+		Sender:   w.Sender,
+		Message:  message,
+		Priority: nudge.PriorityUrgent,
+		Kind:     "wake",
+	}); err != nil {
+		return WakeQueue, err
+	}
+	return WakeQueue, nil
+}
@@ -0,0 +1,67 @@
+package session
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/nudge"
+)
+
+type fakeWakeTmux struct {
+	sessions map[string]bool
+	nudged   []string
+	err      error
+}
+
+func (f *fakeWakeTmux) HasSession(name string) (bool, error) {
+	return f.sessions[name], nil
+}
+
+func (f *fakeWakeTmux) NudgeSession(session, message string) error {
+	f.nudged = append(f.nudged, session)
+	return f.err
+}
+
+func TestBackendWaker_Wake(t *testing.T) {
+	townRoot := t.TempDir()
+	ft := &fakeWakeTmux{sessions: map[string]bool{"gt-nux": true}}
+	w := &BackendWaker{TownRoot: townRoot, Sender: "daemon", Tmux: ft}
+
+	// A tmux session is woken in its pane, not queued.
+	if via, err := w.Wake("gt-nux", "resume"); err != nil || via != WakeTmux {
+		t.Fatalf("Wake(tmux) = %q, %v; want tmux", via, err)
+	}
+	if n, _ := nudge.Pending(townRoot, "gt-nux"); n != 0 || len(ft.nudged) != 1 {
+		t.Errorf("tmux wake: pending=%d nudged=%v", n, ft.nudged)
+	}
+
+	// A headless session is woken through its queue.
+	if via, err := w.Wake("gt-furiosa", "resume"); err != nil || via != WakeQueue {
+		t.Fatalf("Wake(headless) = %q, %v; want queue", via, err)
+	}
+	queued, err := nudge.Drain(townRoot, "gt-furiosa")
+	if err != nil || len(queued) != 1 {
+		t.Fatalf("Drain = %v, %v; want one nudge", queued, err)
+	}
+	if q := queued[0]; q.Message != "resume" || q.Sender != "daemon" || q.Priority != nudge.PriorityUrgent {
+		t.Errorf("queued wake = %+v", q)
+	}
+
+	// Pane delivery errors are reported, not silently queued.
+	ft.err = errors.New("pane busy")
+	if _, err := w.Wake("gt-nux", "resume"); err == nil {
+		t.Error("Wake() swallowed a tmux error")
+	}
+}
+
+func TestBackendWaker_NoTmux(t *testing.T) {
+	townRoot := t.TempDir()
+	w := NewWaker(townRoot, "daemon", nil)
+	if via, err := w.Wake("gt-nux", "resume"); err != nil || via != WakeQueue {
+		t.Fatalf("Wake() = %q, %v; want queue", via, err)
+	}
+
+	if _, err := NewWaker("", "daemon", nil).Wake("gt-nux", "resume"); err == nil {
+		t.Error("Wake() without tmux or town root succeeded")
+	}
+}
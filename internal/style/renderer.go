@@ -0,0 +1,110 @@
+package style
+
+import (
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// Renderer turns semantic output (outcomes, emphasis, icons) into text.
+//
+// The styled renderer uses lipgloss and Unicode icons for terminals. The plain
+// renderer applies no styling and swaps icons for ASCII, so output captured by
+// hooks or written to logs can be grepped and diffed. Current picks one
+// automatically (see ui.ShouldUsePlainOutput).
+type Renderer interface {
+	// Name returns "styled" or "plain".
+	Name() string
+
+	Success(s string) string
+	Warning(s string) string
+	Error(s string) string
+	Info(s string) string
+	Dim(s string) string
+	Bold(s string) string
+
+	// Icon returns what to print for a decorative glyph such as ui.IconPass
+	// or the "─" used for table rules.
+	Icon(glyph string) string
+}
+
+// Renderer names.
+const (
+	RendererStyled = "styled"
+	RendererPlain  = "plain"
+)
+
+// Styled renders with lipgloss styles and Unicode icons.
+type Styled struct{}
+
+func (Styled) Name() string             { return RendererStyled }
+func (Styled) Success(s string) string  { return Success.Style.Render(s) }
+func (Styled) Warning(s string) string  { return Warning.Style.Render(s) }
+func (Styled) Error(s string) string    { return Error.Style.Render(s) }
+func (Styled) Info(s string) string     { return Info.Style.Render(s) }
+func (Styled) Dim(s string) string      { return Dim.Style.Render(s) }
+func (Styled) Bold(s string) string     { return Bold.Style.Render(s) }
+func (Styled) Icon(glyph string) string { return glyph }
+
+// Plain renders text unchanged and icons as ASCII.
+type Plain struct{}
+
+func (Plain) Name() string             { return RendererPlain }
+func (Plain) Success(s string) string  { return s }
+func (Plain) Warning(s string) string  { return s }
+func (Plain) Error(s string) string    { return s }
+func (Plain) Info(s string) string     { return s }
+func (Plain) Dim(s string) string      { return s }
+func (Plain) Bold(s string) string     { return s }
+func (Plain) Icon(glyph string) string { return ASCII(glyph) }
+
+// asciiIcons maps the decorative glyphs gt prints to ASCII stand-ins of the
+// same width, so plain columns line up the way styled ones do.
+var asciiIcons = strings.NewReplacer(
+	ui.IconPass, "+",
+	ui.IconWarn, "!",
+	ui.IconFail, "x",
+	"✗", "x",
+	ui.IconInfo, "i",
+	ui.StatusIconOpen, "o",
+	ui.StatusIconInProgress, "~",
+	ui.StatusIconBlocked, "*",
+	"•", "*",
+	"→", "->",
+	"─", "-",
+	"…", "...",
+)
+
+// ASCII replaces the decorative glyphs in s with their ASCII stand-ins.
+// Other text, including non-ASCII bead titles, is left alone.
+func ASCII(s string) string {
+	return asciiIcons.Replace(s)
+}
+
+// current is the renderer for this process, chosen once at startup.
+var current = selectRenderer()
+
+func selectRenderer() Renderer {
+	if ui.ShouldUsePlainOutput() {
+		return Plain{}
+	}
+	return Styled{}
+}
+
+// Current returns the renderer for this process: plain when stdout is not a
+// TTY or GT_OUTPUT=plain, styled otherwise.
+func Current() Renderer {
+	return current
+}
+
+// SetRenderer replaces the current renderer and refreshes the prefix
+// variables. Intended for tests and for commands that always write to a log.
+func SetRenderer(r Renderer) {
+	current = r
+	setPrefixes()
+}
+
+// Icon renders a decorative glyph with the current renderer.
+func Icon(glyph string) string {
+	return current.Icon(glyph)
+}
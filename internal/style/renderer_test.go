@@ -0,0 +1,94 @@
+package style
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+func withRenderer(t *testing.T, r Renderer) {
+	t.Helper()
+	prev := Current()
+	SetRenderer(r)
+	t.Cleanup(func() { SetRenderer(prev) })
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPlainRenderer(t *testing.T) {
+	withRenderer(t, Plain{})
+
+	for name, prefix := range map[string]string{
+		"SuccessPrefix": SuccessPrefix,
+		"WarningPrefix": WarningPrefix,
+		"ErrorPrefix":   ErrorPrefix,
+		"ArrowPrefix":   ArrowPrefix,
+	} {
+		if !isASCII(prefix) || strings.Contains(prefix, "\x1b") {
+			t.Errorf("%s = %q, want plain ASCII", name, prefix)
+		}
+	}
+	if got := Icon(ui.IconPass); got != "+" {
+		t.Errorf("Icon(IconPass) = %q, want +", got)
+	}
+	if got := ASCII("○ nux → café"); got != "o nux -> café" {
+		t.Errorf("ASCII() = %q, want only glyphs replaced", got)
+	}
+}
+
+func TestPlainStyleRender(t *testing.T) {
+	withRenderer(t, Plain{})
+	if got := Success.Render(ui.IconPass); got != "+" {
+		t.Errorf("Success.Render(IconPass) = %q, want +", got)
+	}
+	if got := Error.Render("✗", "failed"); got != "x failed" {
+		t.Errorf("Error.Render = %q, want %q", got, "x failed")
+	}
+}
+
+func TestStyledRendererKeepsIcons(t *testing.T) {
+	withRenderer(t, Styled{})
+	if got := Icon(ui.IconPass); got != ui.IconPass {
+		t.Errorf("Icon(IconPass) = %q, want %q", got, ui.IconPass)
+	}
+	if got := Warning.Render(ui.IconWarn); !strings.Contains(got, ui.IconWarn) {
+		t.Errorf("Warning.Render(IconWarn) = %q, want %q", got, ui.IconWarn)
+	}
+	if !strings.Contains(SuccessPrefix, ui.IconPass) {
+		t.Errorf("SuccessPrefix = %q, want %q", SuccessPrefix, ui.IconPass)
+	}
+}
+
+func TestPlainTable(t *testing.T) {
+	withRenderer(t, Plain{})
+	out := NewTable(
+		Column{Name: "NAME", Width: 6},
+		Column{Name: "STATE", Width: 8, Style: Success.Style},
+	).AddRow("nux", Error.Render("stuck")).Render()
+
+	want := "  NAME   STATE   \n" +
+		"  ---------------\n" +
+		"  nux    stuck   \n"
+	if out != want {
+		t.Errorf("plain table:\n%q\nwant:\n%q", out, want)
+	}
+}
+
+func TestShouldUsePlainOutputEnv(t *testing.T) {
+	t.Setenv("GT_OUTPUT", "plain")
+	if !ui.ShouldUsePlainOutput() {
+		t.Error("GT_OUTPUT=plain should select plain output")
+	}
+	t.Setenv("GT_OUTPUT", "styled")
+	if ui.ShouldUsePlainOutput() {
+		t.Error("GT_OUTPUT=styled should select styled output")
+	}
+}
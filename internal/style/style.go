@@ -5,56 +5,88 @@ package style
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/steveyegge/gastown/internal/ui"
 )
 
+// Style is a lipgloss style that honors the current renderer. Under the
+// plain renderer Render drops the styling and swaps icons for ASCII, so call
+// sites like style.Success.Render("✓") print "+" when stdout isn't a TTY.
+type Style struct {
+	lipgloss.Style
+}
+
+// Render renders strs like lipgloss.Style.Render, or as plain ASCII under
+// the plain renderer.
+func (s Style) Render(strs ...string) string {
+	if current.Name() == RendererPlain {
+		return current.Icon(strings.Join(strs, " "))
+	}
+	return s.Style.Render(strs...)
+}
+
 var (
 	// Success style for positive outcomes (green)
-	Success = lipgloss.NewStyle().
+	Success = Style{lipgloss.NewStyle().
 		Foreground(ui.ColorPass).
-		Bold(true)
+		Bold(true)}
 
 	// Warning style for cautionary messages (yellow)
-	Warning = lipgloss.NewStyle().
+	Warning = Style{lipgloss.NewStyle().
 		Foreground(ui.ColorWarn).
-		Bold(true)
+		Bold(true)}
 
 	// Error style for failures (red)
-	Error = lipgloss.NewStyle().
+	Error = Style{lipgloss.NewStyle().
 		Foreground(ui.ColorFail).
-		Bold(true)
+		Bold(true)}
 
 	// Info style for informational messages (blue)
-	Info = lipgloss.NewStyle().
-		Foreground(ui.ColorAccent)
+	Info = Style{lipgloss.NewStyle().
+		Foreground(ui.ColorAccent)}
 
 	// Dim style for secondary information (gray)
-	Dim = lipgloss.NewStyle().
-		Foreground(ui.ColorMuted)
+	Dim = Style{lipgloss.NewStyle().
+		Foreground(ui.ColorMuted)}
 
 	// Bold style for emphasis
-	Bold = lipgloss.NewStyle().
-		Bold(true)
+	Bold = Style{lipgloss.NewStyle().
+		Bold(true)}
+)
 
+// Prefixes for one-line status messages, rendered with the current renderer
+// (see SetRenderer).
+var (
 	// SuccessPrefix is the checkmark prefix for success messages
-	SuccessPrefix = Success.Render(ui.IconPass)
+	SuccessPrefix string
 
 	// WarningPrefix is the warning prefix
-	WarningPrefix = Warning.Render(ui.IconWarn)
+	WarningPrefix string
 
 	// ErrorPrefix is the error prefix
-	ErrorPrefix = Error.Render(ui.IconFail)
+	ErrorPrefix string
 
 	// ArrowPrefix for action indicators
-	ArrowPrefix = Info.Render("→")
+	ArrowPrefix string
 )
 
+func init() {
+	setPrefixes()
+}
+
+func setPrefixes() {
+	SuccessPrefix = current.Success(current.Icon(ui.IconPass))
+	WarningPrefix = current.Warning(current.Icon(ui.IconWarn))
+	ErrorPrefix = current.Error(current.Icon(ui.IconFail))
+	ArrowPrefix = current.Info(current.Icon("→"))
+}
+
 // PrintWarning prints a warning message to stderr with consistent formatting.
 // The format and args work like fmt.Printf.
 // Writes to stderr so warnings never contaminate structured (JSON) output on stdout.
 func PrintWarning(format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	fmt.Fprintf(os.Stderr, "%s %s\n", Warning.Render(ui.IconWarn+" Warning:"), msg)
+	fmt.Fprintf(os.Stderr, "%s %s\n", current.Warning(current.Icon(ui.IconWarn)+" Warning:"), msg)
 }
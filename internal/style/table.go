@@ -40,7 +40,7 @@ func NewTable(columns ...Column) *Table {
 		columns:    columns,
 		headerSep:  true,
 		indent:     "  ",
-		headerStyle: Bold,
+		headerStyle: Bold.Style,
 	}
 }
 
@@ -77,7 +77,10 @@ func (t *Table) Render() string {
 	// Render header
 	sb.WriteString(t.indent)
 	for i, col := range t.columns {
-		text := t.headerStyle.Render(col.Name)
+		text := col.Name
+		if current.Name() == RendererStyled {
+			text = t.headerStyle.Render(col.Name)
+		}
 		sb.WriteString(t.pad(text, col.Name, col.Width, col.Align))
 		if i < len(t.columns)-1 {
 			sb.WriteString(" ")
@@ -95,7 +98,7 @@ func (t *Table) Render() string {
 				totalWidth++ // space between columns
 			}
 		}
-		sb.WriteString(Dim.Render(strings.Repeat("─", totalWidth)))
+		sb.WriteString(current.Dim(strings.Repeat(current.Icon("─"), totalWidth)))
 		sb.WriteString("\n")
 	}
 
@@ -111,9 +114,11 @@ func (t *Table) Render() string {
 			plainVal := stripAnsi(val)
 			if len(plainVal) > col.Width {
 				val = plainVal[:col.Width-3] + "..."
+			} else if current.Name() == RendererPlain {
+				val = plainVal
 			}
-			// Apply column style if set
-			if col.Style.Value() != "" {
+			// Apply column style if set (plain output stays unstyled)
+			if col.Style.Value() != "" && current.Name() == RendererStyled {
 				val = col.Style.Render(val)
 			}
 			sb.WriteString(t.pad(val, plainVal, col.Width, col.Align))
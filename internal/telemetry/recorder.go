@@ -104,7 +104,8 @@ type recorderInstruments struct {
 	beadCreateTotal       metric.Int64Counter
 
 	// Histograms
-	bdDurationHist metric.Float64Histogram
+	bdDurationHist  metric.Float64Histogram
+	bdQueueWaitHist metric.Float64Histogram
 }
 
 var (
@@ -222,6 +223,10 @@ func initInstruments() {
 			metric.WithDescription("bd CLI call round-trip latency in milliseconds"),
 			metric.WithUnit("ms"),
 		)
+		inst.bdQueueWaitHist, _ = m.Float64Histogram("gastown.bd.queue_wait_ms",
+			metric.WithDescription("Time bd calls waited for a town-wide concurrency slot in milliseconds"),
+			metric.WithUnit("ms"),
+		)
 	})
 }
 
@@ -325,6 +330,14 @@ func RecordBDCall(ctx context.Context, args []string, durationMs float64, err er
 	emit(ctx, "bd.call", severity(err), kvs...)
 }
 
+// RecordBDQueueWait records how long a bd call waited for a slot from the
+// town's bd concurrency limit (metric only). outcome is "acquired", or
+// "timeout" when the call gave up waiting and ran anyway.
+func RecordBDQueueWait(ctx context.Context, waitMs float64, outcome string) {
+	initInstruments()
+	inst.bdQueueWaitHist.Record(ctx, waitMs, metric.WithAttributes(attribute.String("outcome", outcome)))
+}
+
 // RecordSessionStart records an agent session start (metrics + log event).
 func RecordSessionStart(ctx context.Context, sessionID, role string, err error) {
 	initInstruments()
@@ -31,6 +31,11 @@ func buildGTResourceAttrs() string {
 	if v := os.Getenv("GT_RUN"); v != "" {
 		attrs = append(attrs, "gt.run_id="+v)
 	}
+	// Correlation ID — set on polecat sessions by sling; matches the
+	// correlation_id on the bead's events (gt activity trace).
+	if v := os.Getenv("GT_CORRELATION_ID"); v != "" {
+		attrs = append(attrs, "gt.correlation_id="+v)
+	}
 	// Work context — set by gt prime via injectWorkContext; identifies the rig,
 	// bead, and molecule the agent is currently processing.
 	if v := os.Getenv("GT_WORK_RIG"); v != "" {
@@ -17,6 +17,7 @@ func TestBuildGTResourceAttrs_Empty(t *testing.T) {
 	t.Setenv("GT_WORK_RIG", "")
 	t.Setenv("GT_WORK_BEAD", "")
 	t.Setenv("GT_WORK_MOL", "")
+	t.Setenv("GT_CORRELATION_ID", "")
 
 	result := buildGTResourceAttrs()
 	if result != "" {
@@ -123,6 +124,15 @@ func TestBuildGTResourceAttrs_WorkContextPartial(t *testing.T) {
 	}
 }
 
+func TestBuildGTResourceAttrs_CorrelationID(t *testing.T) {
+	t.Setenv("GT_CORRELATION_ID", "cor-3f9a1c02b7de")
+
+	result := buildGTResourceAttrs()
+	if !strings.Contains(result, "gt.correlation_id=cor-3f9a1c02b7de") {
+		t.Errorf("expected gt.correlation_id in result, got %q", result)
+	}
+}
+
 func TestBuildGTResourceAttrs_Comma(t *testing.T) {
 	t.Setenv("GT_ROLE", "a")
 	t.Setenv("GT_RIG", "b")
@@ -2353,6 +2353,43 @@ func (t *Tmux) SelectWindow(session string, index int) error {
 	return err
 }
 
+// SplitWindow splits the pane at target, runs command in workDir in the new
+// pane, and returns the new pane's ID. The new pane is not selected.
+func (t *Tmux) SplitWindow(target, workDir, command string) (string, error) {
+	args := []string{"split-window", "-d", "-P", "-F", "#{pane_id}", "-t", target}
+	if workDir != "" {
+		args = append(args, "-c", workDir)
+	}
+	return t.run(append(args, command)...)
+}
+
+// SelectLayout arranges the panes of the window containing target using a
+// tmux layout name (tiled, even-horizontal, main-vertical, ...) or a layout
+// string.
+func (t *Tmux) SelectLayout(target, layout string) error {
+	_, err := t.run("select-layout", "-t", target, layout)
+	return err
+}
+
+// RenameWindow renames the window containing target.
+func (t *Tmux) RenameWindow(target, name string) error {
+	_, err := t.run("rename-window", "-t", target, name)
+	return err
+}
+
+// SetPaneTitle sets the title of the pane at target, shown in pane borders
+// once ShowPaneTitles is on.
+func (t *Tmux) SetPaneTitle(target, title string) error {
+	_, err := t.run("select-pane", "-t", target, "-T", title)
+	return err
+}
+
+// ShowPaneTitles turns on pane border titles for the window containing target.
+func (t *Tmux) ShowPaneTitles(target string) error {
+	_, err := t.run("set-option", "-w", "-t", target, "pane-border-status", "top")
+	return err
+}
+
 // ResolveCurrentSession returns the session name for the tmux pane that is an
 // ancestor of the calling process. Works even when $TMUX and $TMUX_PANE are
 // not in the process environment (e.g., Claude Code hook subprocesses).
@@ -0,0 +1,384 @@
+// Package towntemplate captures a town's configuration as a portable tar
+// archive and applies it to a new town.
+//
+// A template holds what a town is configured to do, not what it is doing:
+// town and rig settings, messaging config, daemon patrol schedules, formulas,
+// plugins and the rig registry. Runtime state, beads, accounts (which hold
+// machine-local credential paths) and rig clones are never captured; the new
+// town re-clones each rig from its git URL.
+//
+// Archive layout:
+//
+//	template.json          Manifest
+//	town/<path>            Town files, relative to the town root
+//	rigs/<rig>/<path>      Rig files, relative to the rig directory
+package towntemplate
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// Version is the template format version written by Export. Read rejects
+// templates from a newer format.
+const Version = 1
+
+const manifestName = "template.json"
+
+// Limits on what a template may hold, so a hostile or corrupt archive can't
+// exhaust memory.
+const (
+	maxFileSize  = 1 << 20  // 1 MiB per file
+	maxTotalSize = 64 << 20 // 64 MiB per template
+)
+
+// townPaths are the town-relative files and directories a template captures.
+var townPaths = []string{
+	"settings",              // Town settings, scheduler rules, escalation, layouts
+	"config/messaging.json", // Mailing lists, queues, announce channels
+	"mayor/daemon.json",     // Patrol schedules, energy saver window
+	".beads/formulas",       // Formulas, including local edits
+	"plugins",               // Town plugins and their gates
+}
+
+// rigPaths are the rig-relative files and directories a template captures.
+var rigPaths = []string{
+	"settings", // Rig settings (agent, merge queue, ...)
+	"plugins",  // Rig plugins
+}
+
+// Rig is a rig definition in a template: enough to gt rig add it again.
+type Rig struct {
+	Name          string `json:"name"`
+	GitURL        string `json:"git_url"`
+	PushURL       string `json:"push_url,omitempty"`
+	UpstreamURL   string `json:"upstream_url,omitempty"`
+	Prefix        string `json:"prefix,omitempty"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+}
+
+// Manifest describes a template.
+type Manifest struct {
+	Version   int       `json:"version"`
+	Town      string    `json:"town"` // Name of the town it was exported from
+	CreatedAt time.Time `json:"created_at"`
+	Rigs      []Rig     `json:"rigs,omitempty"`
+	// Files lists the archived files as archive paths (town/..., rigs/...).
+	Files []string `json:"files"`
+}
+
+// Template is a template read into memory.
+type Template struct {
+	Manifest Manifest
+	files    map[string]file // keyed by archive path
+}
+
+type file struct {
+	data []byte
+	mode fs.FileMode
+}
+
+// Export writes townRoot's template to w, returning its manifest.
+func Export(townRoot string, w io.Writer, now time.Time) (*Manifest, error) {
+	m := &Manifest{Version: Version, Town: filepath.Base(townRoot), CreatedAt: now.UTC()}
+	files := make(map[string]file)
+
+	collect := func(root, prefix string, rel []string) error {
+		for _, p := range rel {
+			if err := collectFiles(root, p, prefix, files); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := collect(townRoot, "town", townPaths); err != nil {
+		return nil, err
+	}
+
+	rigsCfg, err := config.LoadRigsConfig(constants.MayorRigsPath(townRoot))
+	if err != nil && !errors.Is(err, config.ErrNotFound) {
+		return nil, fmt.Errorf("loading rigs.json: %w", err)
+	}
+	if rigsCfg != nil {
+		for name, entry := range rigsCfg.Rigs {
+			r := Rig{Name: name, GitURL: entry.GitURL, PushURL: entry.PushURL, UpstreamURL: entry.UpstreamURL}
+			if entry.BeadsConfig != nil {
+				r.Prefix = entry.BeadsConfig.Prefix
+			}
+			rigPath := filepath.Join(townRoot, name)
+			if rc, err := rig.LoadRigConfig(rigPath); err == nil {
+				r.DefaultBranch = rc.DefaultBranch
+			}
+			m.Rigs = append(m.Rigs, r)
+			if err := collect(rigPath, "rigs/"+name, rigPaths); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Slice(m.Rigs, func(i, j int) bool { return m.Rigs[i].Name < m.Rigs[j].Name })
+
+	for name := range files {
+		m.Files = append(m.Files, name)
+	}
+	sort.Strings(m.Files)
+
+	tw := tar.NewWriter(w)
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeEntry(tw, manifestName, manifest, 0644, now); err != nil {
+		return nil, err
+	}
+	for _, name := range m.Files {
+		if err := writeEntry(tw, name, files[name].data, files[name].mode, now); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// collectFiles adds the regular file at root/rel, or the regular files under
+// it, to files under archive prefix. Missing paths are skipped, as are hidden
+// files (runtime records such as .beads/formulas/.installed.json).
+func collectFiles(root, rel, prefix string, files map[string]file) error {
+	start := filepath.Join(root, filepath.FromSlash(rel))
+	err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if p != start && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Size() > maxFileSize {
+			return fmt.Errorf("%s is larger than %d bytes; templates hold configuration only", p, maxFileSize)
+		}
+		data, err := os.ReadFile(p) //nolint:gosec // G304: walking the town's own config dirs
+		if err != nil {
+			return err
+		}
+		r, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		files[prefix+"/"+filepath.ToSlash(r)] = file{data: data, mode: info.Mode().Perm()}
+		return nil
+	})
+	return err
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte, mode fs.FileMode, now time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    int64(mode),
+		Size:    int64(len(data)),
+		ModTime: now,
+		Format:  tar.FormatPAX,
+	}); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
+
+// Read reads and validates a template from r, which may be gzip-compressed.
+// Nothing is written to disk.
+func Read(r io.Reader) (*Template, error) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("reading gzip: %w", err)
+		}
+		defer zr.Close()
+		r = zr
+	} else {
+		r = br
+	}
+
+	t := &Template{files: make(map[string]file)}
+	var manifest []byte
+	var total int64
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading template: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue // Only regular files; links and devices are never exported
+		}
+		if hdr.Size > maxFileSize {
+			return nil, fmt.Errorf("template entry %s is too large (%d bytes)", hdr.Name, hdr.Size)
+		}
+		if total += hdr.Size; total > maxTotalSize {
+			return nil, fmt.Errorf("template is larger than %d bytes", maxTotalSize)
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxFileSize))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", hdr.Name, err)
+		}
+		if hdr.Name == manifestName {
+			manifest = data
+			continue
+		}
+		t.files[hdr.Name] = file{data: data, mode: fs.FileMode(hdr.Mode).Perm()}
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("not a town template: no %s", manifestName)
+	}
+	if err := json.Unmarshal(manifest, &t.Manifest); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", manifestName, err)
+	}
+	if t.Manifest.Version > Version {
+		return nil, fmt.Errorf("template format %d is newer than this gt supports (%d); upgrade gt", t.Manifest.Version, Version)
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// validate checks every archived file lands inside a captured path of the
+// town or of a rig the template defines.
+func (t *Template) validate() error {
+	rigs := make(map[string]bool)
+	for _, r := range t.Manifest.Rigs {
+		if r.Name == "" || r.Name != path.Base(r.Name) || strings.HasPrefix(r.Name, ".") {
+			return fmt.Errorf("template defines invalid rig name %q", r.Name)
+		}
+		if r.GitURL == "" {
+			return fmt.Errorf("template rig %s has no git URL", r.Name)
+		}
+		rigs[r.Name] = true
+	}
+	for name := range t.files {
+		if path.Clean(name) != name || path.IsAbs(name) {
+			return fmt.Errorf("template entry %q has an unsafe path", name)
+		}
+		var rel string
+		var allowed []string
+		if r, ok := strings.CutPrefix(name, "town/"); ok {
+			rel, allowed = r, townPaths
+		} else if r, ok := strings.CutPrefix(name, "rigs/"); ok {
+			rigName, r, _ := strings.Cut(r, "/")
+			if !rigs[rigName] {
+				return fmt.Errorf("template entry %s belongs to undefined rig %q", name, rigName)
+			}
+			rel, allowed = r, rigPaths
+		}
+		if !underAny(rel, allowed) {
+			return fmt.Errorf("template entry %s is outside the captured configuration", name)
+		}
+	}
+	return nil
+}
+
+// underAny reports whether rel is one of paths or inside one of them.
+func underAny(rel string, paths []string) bool {
+	for _, p := range paths {
+		if rel == p || strings.HasPrefix(rel, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyTown writes the template's town files into townRoot, replacing any
+// that exist. Returns how many files were written.
+func (t *Template) ApplyTown(townRoot string) (int, error) {
+	return t.apply("town/", townRoot)
+}
+
+// ApplyRig writes the template's files for rigName into the rig directory
+// under townRoot. Returns how many files were written.
+func (t *Template) ApplyRig(townRoot, rigName string) (int, error) {
+	return t.apply("rigs/"+rigName+"/", filepath.Join(townRoot, rigName))
+}
+
+func (t *Template) apply(prefix, root string) (int, error) {
+	names := make([]string, 0, len(t.files))
+	for name := range t.files {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		dest := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(name, prefix)))
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return 0, err
+		}
+		f := t.files[name]
+		mode := f.mode
+		if mode == 0 {
+			mode = 0644
+		}
+		if err := os.WriteFile(dest, f.data, mode); err != nil {
+			return 0, fmt.Errorf("writing %s: %w", dest, err)
+		}
+	}
+	return len(names), nil
+}
+
+// Summary describes the template's contents, e.g.
+// "12 files, 2 rigs (gastown, beads)".
+func (m *Manifest) Summary() string {
+	s := fmt.Sprintf("%d files, %d rigs", len(m.Files), len(m.Rigs))
+	if len(m.Rigs) > 0 {
+		names := make([]string, len(m.Rigs))
+		for i, r := range m.Rigs {
+			names[i] = r.Name
+		}
+		s += " (" + strings.Join(names, ", ") + ")"
+	}
+	return s
+}
+
+// Bytes is Export into memory.
+func Bytes(townRoot string, now time.Time) ([]byte, *Manifest, error) {
+	var buf bytes.Buffer
+	m, err := Export(townRoot, &buf, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buf.Bytes(), m, nil
+}
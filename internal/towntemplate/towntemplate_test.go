@@ -0,0 +1,152 @@
+package towntemplate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExportReadApply(t *testing.T) {
+	town := t.TempDir()
+	writeFile(t, filepath.Join(town, "settings", "config.json"), `{"type":"town-settings"}`)
+	writeFile(t, filepath.Join(town, "mayor", "daemon.json"), `{"type":"daemon-patrol-config"}`)
+	writeFile(t, filepath.Join(town, "mayor", "accounts.json"), `{"accounts":{}}`)
+	writeFile(t, filepath.Join(town, ".beads", "formulas", "mol-ship.formula.toml"), `formula = "mol-ship"`)
+	writeFile(t, filepath.Join(town, ".beads", "formulas", ".installed.json"), `{}`)
+	writeFile(t, filepath.Join(town, ".runtime", "scheduler-state.json"), `{}`)
+	writeFile(t, filepath.Join(town, "mayor", "rigs.json"),
+		`{"version":1,"rigs":{"gastown":{"git_url":"https://example.com/gastown.git","added_at":"2026-01-01T00:00:00Z","beads":{"repo":"local","prefix":"gt"}}}}`)
+	writeFile(t, filepath.Join(town, "gastown", "config.json"), `{"type":"rig","name":"gastown","default_branch":"trunk"}`)
+	writeFile(t, filepath.Join(town, "gastown", "settings", "config.json"), `{"type":"rig-settings"}`)
+	writeFile(t, filepath.Join(town, "gastown", "polecats", "nux", "README.md"), "clone")
+
+	data, m, err := Bytes(town, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Bytes: %v", err)
+	}
+	wantFiles := []string{
+		"rigs/gastown/settings/config.json",
+		"town/.beads/formulas/mol-ship.formula.toml",
+		"town/mayor/daemon.json",
+		"town/settings/config.json",
+	}
+	if strings.Join(m.Files, ",") != strings.Join(wantFiles, ",") {
+		t.Errorf("Files = %v, want %v", m.Files, wantFiles)
+	}
+	if len(m.Rigs) != 1 {
+		t.Fatalf("Rigs = %+v, want gastown", m.Rigs)
+	}
+	if r := m.Rigs[0]; r.Name != "gastown" || r.Prefix != "gt" || r.DefaultBranch != "trunk" || r.GitURL != "https://example.com/gastown.git" {
+		t.Errorf("rig = %+v", r)
+	}
+
+	tmpl, err := Read(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if tmpl.Manifest.Town != filepath.Base(town) {
+		t.Errorf("Town = %q", tmpl.Manifest.Town)
+	}
+
+	dest := t.TempDir()
+	if n, err := tmpl.ApplyTown(dest); err != nil || n != 3 {
+		t.Fatalf("ApplyTown = %d, %v; want 3 files", n, err)
+	}
+	if n, err := tmpl.ApplyRig(dest, "gastown"); err != nil || n != 1 {
+		t.Fatalf("ApplyRig = %d, %v; want 1 file", n, err)
+	}
+	got, err := os.ReadFile(filepath.Join(dest, "mayor", "daemon.json"))
+	if err != nil || string(got) != `{"type":"daemon-patrol-config"}` {
+		t.Errorf("daemon.json = %q, %v", got, err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "gastown", "settings", "config.json")); err != nil {
+		t.Errorf("rig settings not applied: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "mayor", "accounts.json")); !os.IsNotExist(err) {
+		t.Errorf("accounts.json should not be in a template")
+	}
+}
+
+// archive builds a template archive from a manifest and entries.
+func archive(t *testing.T, manifest string, entries map[string]string, gz bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	add := func(name, content string) {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add(manifestName, manifest)
+	for name, content := range entries {
+		add(name, content)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !gz {
+		return buf.Bytes()
+	}
+	var zbuf bytes.Buffer
+	zw := gzip.NewWriter(&zbuf)
+	_, _ = zw.Write(buf.Bytes())
+	_ = zw.Close()
+	return zbuf.Bytes()
+}
+
+func TestReadGzip(t *testing.T) {
+	data := archive(t, `{"version":1,"town":"hq"}`, map[string]string{"town/settings/config.json": "{}"}, true)
+	tmpl, err := Read(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if tmpl.Manifest.Town != "hq" {
+		t.Errorf("Town = %q", tmpl.Manifest.Town)
+	}
+}
+
+func TestReadRejects(t *testing.T) {
+	const rigs = `{"version":1,"rigs":[{"name":"gastown","git_url":"https://example.com/g.git"}]}`
+	tests := []struct {
+		name     string
+		manifest string
+		entries  map[string]string
+		want     string
+	}{
+		{"traversal", rigs, map[string]string{"town/settings/../../etc/passwd": "x"}, "unsafe path"},
+		{"outside captured paths", rigs, map[string]string{"town/mayor/accounts.json": "{}"}, "outside"},
+		{"undefined rig", rigs, map[string]string{"rigs/other/settings/config.json": "{}"}, "undefined rig"},
+		{"bad rig name", `{"version":1,"rigs":[{"name":"../x","git_url":"u"}]}`, nil, "invalid rig name"},
+		{"newer format", `{"version":99}`, nil, "newer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(bytes.NewReader(archive(t, tt.manifest, tt.entries, false)))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Read error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := Read(strings.NewReader("")); err == nil {
+		t.Error("empty input: want error")
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os/exec"
 	"regexp"
 	"sort"
 	"sync"
@@ -93,8 +92,8 @@ func loadConvoys(townBeads string) ([]ConvoyItem, error) {
 
 	// Get list of open convoys
 	listArgs := []string{"list", "--type=convoy", "--json"}
-	listCmd := exec.CommandContext(ctx, "bd", listArgs...)
-	util.SetDetachedProcessGroup(listCmd)
+	listCmd := beads.CommandContext(ctx, listArgs...)
+	util.SetDetachedProcessGroup(listCmd.Cmd)
 	listCmd.Dir = townBeads
 	var stdout bytes.Buffer
 	listCmd.Stdout = &stdout
@@ -140,8 +139,8 @@ func loadTrackedIssues(townBeads, convoyID string) ([]IssueItem, int, int) {
 	defer cancel()
 
 	// Query tracked issues using bd dep list (returns full issue details)
-	cmd := exec.CommandContext(ctx, "bd", "dep", "list", convoyID, "-t", "tracks", "--json")
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, "dep", "list", convoyID, "-t", "tracks", "--json")
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	cmd.Dir = townBeads
 	var stdout bytes.Buffer
 	cmd.Stdout = &stdout
@@ -212,8 +211,8 @@ func refreshIssueStatus(ctx context.Context, tracked []struct {
 	}
 	args = append(args, "--json")
 
-	cmd := exec.CommandContext(ctx, "bd", args...)
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, args...)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	var stdout bytes.Buffer
 	cmd.Stdout = &stdout
 
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -16,8 +15,10 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/humanize"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -108,8 +109,8 @@ func listConvoys(beadsDir, status string) ([]convoyListItem, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.BdSubprocessTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", listArgs...) //nolint:gosec // G204: args are constructed internally
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, listArgs...) //nolint:gosec // G204: args are constructed internally
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	cmd.Dir = beadsDir
 	var stdout bytes.Buffer
 	cmd.Stdout = &stdout
@@ -271,8 +272,7 @@ func renderConvoyLine(c Convoy, landed bool) string {
 
 	if landed {
 		// Show checkmark and time since landing
-		age := formatAge(time.Since(c.ClosedAt))
-		status := ConvoyLandedStyle.Render("✓") + " " + ConvoyAgeStyle.Render(age+" ago")
+		status := ConvoyLandedStyle.Render("✓") + " " + ConvoyAgeStyle.Render(humanize.Ago(c.ClosedAt))
 		return fmt.Sprintf("  %s  %-20s  %s", id, title, status)
 	}
 
@@ -403,12 +403,12 @@ func listMQBeads(rigPath, status string) []mqListItem {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.BdSubprocessTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "bd", "list",
+	cmd := beads.CommandContext(ctx, "list",
 		"--label=gt:merge-request",
 		"--status="+status,
 		"--json",
 	)
-	util.SetDetachedProcessGroup(cmd)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	cmd.Dir = rigPath
 	var stdout bytes.Buffer
 	cmd.Stdout = &stdout
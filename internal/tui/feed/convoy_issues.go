@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"os/exec"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
@@ -27,8 +26,8 @@ func getTrackedIssueStatus(beadsDir, convoyID string) []trackedStatus {
 	defer cancel()
 
 	// Query tracked issues using bd dep list (returns full issue details)
-	cmd := exec.CommandContext(ctx, "bd", "dep", "list", convoyID, "-t", "tracks", "--json")
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, "dep", "list", convoyID, "-t", "tracks", "--json")
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	cmd.Dir = beadsDir
 	var stdout bytes.Buffer
 	cmd.Stdout = &stdout
@@ -81,8 +80,8 @@ func refreshTrackedStatus(ctx context.Context, deps []struct {
 	}
 	args = append(args, "--json")
 
-	cmd := exec.CommandContext(ctx, "bd", args...)
-	util.SetDetachedProcessGroup(cmd)
+	cmd := beads.CommandContext(ctx, args...)
+	util.SetDetachedProcessGroup(cmd.Cmd)
 	var stdout bytes.Buffer
 	cmd.Stdout = &stdout
 
@@ -10,10 +10,12 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/util"
 )
 
@@ -35,6 +37,8 @@ type BdActivitySource struct {
 func NewBdActivitySource(workDir string) (*BdActivitySource, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
+	// Not a beads.Cmd: a follower runs for the life of the feed, and holding
+	// a bd slot that long would starve the town's short bd calls.
 	cmd := exec.CommandContext(ctx, "bd", "activity", "--follow")
 	util.SetDetachedProcessGroup(cmd)
 	cmd.Dir = workDir
@@ -217,9 +221,11 @@ func parseBeadContext(beadID string) (actor, rig, role string) {
 
 // GtEventsSource reads events from ~/gt/.events.jsonl (gt activity log)
 type GtEventsSource struct {
-	file   *os.File
-	events chan Event
-	cancel context.CancelFunc
+	townRoot string
+	mu       sync.Mutex // guards file, which tail swaps when the log rotates
+	file     *os.File
+	events   chan Event
+	cancel   context.CancelFunc
 }
 
 // GtEvent is the structure of events in .events.jsonl
@@ -243,9 +249,10 @@ func NewGtEventsSource(townRoot string) (*GtEventsSource, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	source := &GtEventsSource{
-		file:   file,
-		events: make(chan Event, 200),
-		cancel: cancel,
+		townRoot: townRoot,
+		file:     file,
+		events:   make(chan Event, 200),
+		cancel:   cancel,
 	}
 
 	go source.tail(ctx)
@@ -283,6 +290,15 @@ func (s *GtEventsSource) tail(ctx context.Context) {
 					}
 				}
 			}
+
+			// Follow the log across rotation and KRC rewrites.
+			if nf, err := events.Reopen(s.townRoot, s.file); err == nil && nf != nil {
+				s.mu.Lock()
+				_ = s.file.Close()
+				s.file = nf
+				s.mu.Unlock()
+				scanner = bufio.NewScanner(nf)
+			}
 		}
 	}
 }
@@ -339,6 +355,8 @@ func (s *GtEventsSource) Events() <-chan Event {
 // Close stops the source
 func (s *GtEventsSource) Close() error {
 	s.cancel()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	return s.file.Close()
 }
 
@@ -352,7 +370,24 @@ func parseGtEventLine(line string) *Event {
 	if err := json.Unmarshal([]byte(line), &ge); err != nil {
 		return nil
 	}
+	return parseGtEvent(ge, line)
+}
+
+// parseLogEvent converts an event read with events.ReadRange.
+func parseLogEvent(e events.Event) *Event {
+	raw, _ := json.Marshal(e)
+	return parseGtEvent(GtEvent{
+		Timestamp:  e.Timestamp,
+		Source:     e.Source,
+		Type:       e.Type,
+		Actor:      e.Actor,
+		Payload:    e.Payload,
+		Visibility: e.Visibility,
+	}, string(raw))
+}
 
+// parseGtEvent builds a feed event from a parsed log line (raw).
+func parseGtEvent(ge GtEvent, line string) *Event {
 	// Only show feed-visible events
 	if ge.Visibility != "feed" && ge.Visibility != "both" {
 		return nil
@@ -4,17 +4,21 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/events"
 )
 
 // PrintOptions controls filtering and behavior for PrintGtEvents.
 type PrintOptions struct {
 	Limit  int
+	Page   func([]Event) ([]Event, error) // optional: picks the initial batch from all matches, newest first; replaces Limit
 	Follow bool
 	Since  string // duration string like "5m", "1h"
 	Mol    string // molecule/issue ID prefix filter
@@ -23,7 +27,8 @@ type PrintOptions struct {
 	Ctx    context.Context // optional: controls follow-mode lifecycle; nil uses signal.NotifyContext
 }
 
-// PrintGtEvents reads .events.jsonl and prints events to stdout.
+// PrintGtEvents reads .events.jsonl (and its rotated segments) and prints
+// events to stdout.
 // When opts.Follow is true, it tails the file for new events after printing
 // the initial batch, polling every 200ms. Canceled via opts.Ctx or SIGINT.
 func PrintGtEvents(townRoot string, opts PrintOptions) error {
@@ -32,7 +37,7 @@ func PrintGtEvents(townRoot string, opts PrintOptions) error {
 	if err != nil {
 		return fmt.Errorf("no events file found at %s: %w", eventsPath, err)
 	}
-	defer file.Close()
+	defer func() { _ = file.Close() }()
 
 	// Parse --since into a cutoff time
 	var sinceTime time.Time
@@ -44,44 +49,50 @@ func PrintGtEvents(townRoot string, opts PrintOptions) error {
 		sinceTime = time.Now().Add(-dur)
 	}
 
-	var events []Event
-	scanner := bufio.NewScanner(file)
-	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
-
-	for scanner.Scan() {
-		line := scanner.Text()
-		if event := parseGtEventLine(line); event != nil {
+	// Read rotated segments too, so --since reaches past the last rotation.
+	var batch []Event
+	err = events.ReadRange(townRoot, sinceTime, time.Time{}, func(e events.Event) bool {
+		if event := parseLogEvent(e); event != nil {
 			if matchesFilters(event, sinceTime, opts.Mol, opts.Type, opts.Rig) {
-				events = append(events, *event)
+				batch = append(batch, *event)
 			}
 		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("reading events: %w", err)
 	}
-
-	if err := scanner.Err(); err != nil {
+	// Follow from the end of what was just read.
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
 		return fmt.Errorf("reading events: %w", err)
 	}
 
 	// Sort by time descending (most recent first)
-	sort.Slice(events, func(i, j int) bool {
-		return events[i].Time.After(events[j].Time)
+	sort.Slice(batch, func(i, j int) bool {
+		return batch[i].Time.After(batch[j].Time)
 	})
 
 	// Apply limit
-	if opts.Limit > 0 && len(events) > opts.Limit {
-		events = events[:opts.Limit]
+	if opts.Page != nil {
+		batch, err = opts.Page(batch)
+		if err != nil {
+			return err
+		}
+	} else if opts.Limit > 0 && len(batch) > opts.Limit {
+		batch = batch[:opts.Limit]
 	}
 
 	// Reverse to show oldest first (chronological)
-	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
-		events[i], events[j] = events[j], events[i]
+	for i, j := 0, len(batch)-1; i < j; i, j = i+1, j-1 {
+		batch[i], batch[j] = batch[j], batch[i]
 	}
 
-	if len(events) == 0 && !opts.Follow {
+	if len(batch) == 0 && !opts.Follow {
 		fmt.Println("No events found in .events.jsonl")
 		return nil
 	}
 
-	for _, event := range events {
+	for _, event := range batch {
 		printEvent(event)
 	}
 
@@ -118,6 +129,12 @@ func PrintGtEvents(townRoot string, opts PrintOptions) error {
 					}
 				}
 			}
+
+			// Follow the log across rotation and KRC rewrites.
+			if nf, err := events.Reopen(townRoot, file); err == nil && nf != nil {
+				_ = file.Close()
+				file = nf
+			}
 		}
 	}
 }
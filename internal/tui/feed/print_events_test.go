@@ -111,6 +111,52 @@ func TestPrintGtEvents_LimitApplied(t *testing.T) {
 	}
 }
 
+func TestPrintGtEvents_PageReplacesLimit(t *testing.T) {
+	now := time.Now()
+	var events []GtEvent
+	for i := 0; i < 20; i++ {
+		events = append(events, GtEvent{
+			Timestamp:  now.Add(time.Duration(-20+i) * time.Minute).Format(time.RFC3339),
+			Source:     "test",
+			Type:       "create",
+			Actor:      "test",
+			Visibility: "feed",
+			Payload:    map[string]interface{}{"message": "event"},
+		})
+	}
+	townRoot := writeTestEvents(t, events)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	var seen int
+	err := PrintGtEvents(townRoot, PrintOptions{
+		Limit: 5,
+		Page: func(batch []Event) ([]Event, error) {
+			seen = len(batch)
+			return batch[2:5], nil
+		},
+	})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("PrintGtEvents returned error: %v", err)
+	}
+	if seen != 20 {
+		t.Errorf("Page saw %d events, want all 20", seen)
+	}
+
+	buf := make([]byte, 4096)
+	n, _ := r.Read(buf)
+	lines := strings.Split(strings.TrimSpace(string(buf[:n])), "\n")
+	if len(lines) != 3 {
+		t.Errorf("expected 3 output lines (page), got %d", len(lines))
+	}
+}
+
 func TestPrintGtEvents_SinceFilter(t *testing.T) {
 	now := time.Now()
 	townRoot := writeTestEvents(t, []GtEvent{
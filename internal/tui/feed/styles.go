@@ -193,5 +193,8 @@ var (
 		"nudge":   "⚡",
 		"boot":    "🔌",
 		"halt":    "⏹",
+		// Human takeover
+		"takeover": "✋",
+		"handback": "🔁",
 	}
 )
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/steveyegge/gastown/internal/humanize"
 )
 
 // render produces the full TUI output
@@ -378,7 +380,7 @@ func (m *Model) renderAgent(icon string, agent *Agent, indent int) string {
 	// Last activity
 	activity := ""
 	if agent.LastEvent != nil {
-		age := formatAge(time.Since(agent.LastEvent.Time))
+		age := humanize.Short(time.Since(agent.LastEvent.Time))
 		msg := agent.LastEvent.Message
 		if len(msg) > 40 {
 			msg = msg[:37] + "..."
@@ -542,17 +544,3 @@ func (m *Model) renderShortHelp() string {
 	}
 	return strings.Join(hints, "  ")
 }
-
-// formatAge formats a duration as a short age string
-func formatAge(d time.Duration) string {
-	if d < time.Minute {
-		return "just now"
-	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd", int(d.Hours()/24))
-}
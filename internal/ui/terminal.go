@@ -131,6 +131,27 @@ func ShouldUseEmoji() bool {
 	return IsTerminal()
 }
 
+// ShouldUsePlainOutput determines if output should use the plain renderer
+// (no styling, ASCII-only decorations) instead of the styled one.
+// GT_OUTPUT=plain or GT_OUTPUT=styled forces a choice; otherwise plain output
+// is used whenever stdout is not a TTY, so hook and log output stays grep-able.
+func ShouldUsePlainOutput() bool {
+	switch strings.ToLower(os.Getenv("GT_OUTPUT")) {
+	case "plain":
+		return true
+	case "styled":
+		return false
+	}
+
+	// CLICOLOR_FORCE asks for terminal output even when piped
+	if _, exists := os.LookupEnv("CLICOLOR_FORCE"); exists {
+		return false
+	}
+
+	// default: plain whenever stdout is not a TTY
+	return !IsTerminal()
+}
+
 // IsAgentMode returns true if the CLI is running in agent-optimized mode.
 // This is triggered by:
 //   - GT_AGENT_MODE=1 environment variable (explicit)
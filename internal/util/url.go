@@ -25,3 +25,24 @@ func RedactURL(rawURL string) string {
 	u.User = nil
 	return u.String()
 }
+
+// sensitiveKeyParts mark a JSON or environment key whose value is a secret.
+// Keys are compared lowercased with - and _ removed, so API_KEY, api-key and
+// apiKey all match "apikey". Webhook, URL and DSN values count too: a Slack
+// webhook's path or a DSN's password is a credential in the address itself.
+var sensitiveKeyParts = []string{
+	"token", "secret", "password", "passwd", "credential",
+	"apikey", "privatekey", "accesskey", "authorization", "cookie",
+	"webhook", "url", "dsn",
+}
+
+// IsSensitiveKey reports whether a JSON or environment key names a secret.
+func IsSensitiveKey(key string) bool {
+	k := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(k, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -26,3 +26,22 @@ func TestRedactURL(t *testing.T) {
 		})
 	}
 }
+
+func TestIsSensitiveKey(t *testing.T) {
+	for key, want := range map[string]bool{
+		"ANTHROPIC_API_KEY": true,
+		"apiKey":            true,
+		"webhook-secret":    true,
+		"Authorization":     true,
+		"slack_webhook":     true,
+		"url":               true,
+		"DATABASE_DSN":      true,
+		"author":            false,
+		"agent":             false,
+		"max_age":           false,
+	} {
+		if got := IsSensitiveKey(key); got != want {
+			t.Errorf("IsSensitiveKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
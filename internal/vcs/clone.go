@@ -0,0 +1,81 @@
+package vcs
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// cloneBackend makes a shared clone of the rig's repo per working copy,
+// optionally colocated with jujutsu.
+type cloneBackend struct {
+	repo *git.Git
+	jj   bool
+}
+
+func (b *cloneBackend) Name() string {
+	if b.jj {
+		return TypeJJ
+	}
+	return TypeGitClone
+}
+
+func (b *cloneBackend) AddWorkspace(path, branch, startPoint string) (retErr error) {
+	// Resolve in the rig's repo: the clone has no remote-tracking refs yet.
+	sha, err := b.repo.Rev(startPoint)
+	if err != nil {
+		return fmt.Errorf("resolving %s: %w", startPoint, err)
+	}
+	if err := b.repo.CloneShared(path); err != nil {
+		return fmt.Errorf("cloning: %w", err)
+	}
+	defer func() {
+		if retErr != nil {
+			_ = os.RemoveAll(path)
+		}
+	}()
+
+	wc := git.NewGit(path)
+	// Copy the rig repo's view of the remote, then point origin at the
+	// remote itself so pushes go where the refinery merges from.
+	_ = wc.FetchRefspec("origin", "+refs/remotes/origin/*:refs/remotes/origin/*")
+	if url, err := b.repo.RemoteURL("origin"); err == nil && url != "" {
+		if _, err := wc.SetRemoteURL("origin", url); err != nil {
+			return fmt.Errorf("setting origin: %w", err)
+		}
+	}
+	if err := wc.CheckoutNewBranch(branch, sha); err != nil {
+		return fmt.Errorf("checking out %s: %w", branch, err)
+	}
+
+	if b.jj {
+		cmd := exec.Command("jj", "git", "init", "--colocate")
+		cmd.Dir = path
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("jj git init --colocate: %s", strings.TrimSpace(string(out)))
+		}
+	}
+	return nil
+}
+
+func (b *cloneBackend) RemoveWorkspace(path string, force bool) error {
+	if !force {
+		status, err := git.NewGit(path).CheckUncommittedWork()
+		if err == nil && !status.Clean() {
+			return fmt.Errorf("%s has uncommitted changes", path)
+		}
+	}
+	return os.RemoveAll(path)
+}
+
+func (b *cloneBackend) MoveWorkspace(from, to string) error {
+	return os.Rename(from, to)
+}
+
+// Prune is a no-op: clones are not registered anywhere.
+func (b *cloneBackend) Prune() error {
+	return nil
+}
@@ -0,0 +1,69 @@
+package vcs
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// commandBackend runs the rig's own shell commands to add and remove working
+// copies.
+type commandBackend struct {
+	repo   *git.Git
+	add    string
+	remove string
+}
+
+func (b *commandBackend) Name() string { return TypeCommand }
+
+func (b *commandBackend) AddWorkspace(path, branch, startPoint string) error {
+	if err := b.run(b.add, path, branch, startPoint, false); err != nil {
+		_ = os.RemoveAll(path)
+		return err
+	}
+	if !git.NewGit(path).IsRepo() {
+		_ = os.RemoveAll(path)
+		return fmt.Errorf("vcs add command did not create a git working copy at %s", path)
+	}
+	return nil
+}
+
+func (b *commandBackend) RemoveWorkspace(path string, force bool) error {
+	if b.remove == "" {
+		return os.RemoveAll(path)
+	}
+	return b.run(b.remove, path, "", "", force)
+}
+
+func (b *commandBackend) MoveWorkspace(from, to string) error {
+	return os.Rename(from, to)
+}
+
+// Prune is a no-op: the commands own any registration they do.
+func (b *commandBackend) Prune() error {
+	return nil
+}
+
+// run executes script with sh, passing the working copy details in the
+// environment.
+func (b *commandBackend) run(script, path, branch, startPoint string, force bool) error {
+	forceVal := "0"
+	if force {
+		forceVal = "1"
+	}
+	cmd := exec.Command("sh", "-c", script)
+	cmd.Env = append(os.Environ(),
+		"GT_VCS_REPO="+b.repo.RepoDir(),
+		"GT_VCS_PATH="+path,
+		"GT_VCS_BRANCH="+branch,
+		"GT_VCS_START_POINT="+startPoint,
+		"GT_VCS_FORCE="+forceVal,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("vcs command %q: %w: %s", script, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Package vcs abstracts how a rig checks out working copies for its agents.
+//
+// Polecats get one working copy each, on a fresh branch from the rig's
+// default branch. By default that is a git worktree of the rig's shared bare
+// repo (.repo.git). A rig can opt into a different checkout strategy with the
+// "vcs" block of its config.json:
+//
+//	{"vcs": {"type": "git-clone"}}
+//
+// Every backend must leave a git working copy whose origin is the rig's
+// remote: polecats commit and push with git, and the refinery merges the
+// pushed branches with git. The jj backend meets this by colocating jujutsu
+// with git.
+package vcs
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/worktree"
+)
+
+// Backend types.
+const (
+	// TypeGitWorktree checks out git worktrees of the rig's repo (default).
+	TypeGitWorktree = "git-worktree"
+
+	// TypeGitClone makes an independent clone per working copy, sharing
+	// objects with the rig's repo. Suits tools that don't understand
+	// worktrees.
+	TypeGitClone = "git-clone"
+
+	// TypeJJ makes a git-clone working copy and colocates a jujutsu repo in
+	// it, so agents can use jj alongside git.
+	TypeJJ = "jj"
+
+	// TypeCommand runs user-supplied shell commands (see Config).
+	TypeCommand = "command"
+)
+
+// Config is the "vcs" block of a rig's config.json.
+type Config struct {
+	// Type selects the backend. Empty means git-worktree.
+	Type string `json:"type,omitempty"`
+
+	// Add and Remove are the shell commands for the command backend. They
+	// run with GT_VCS_REPO (the rig's repo), GT_VCS_PATH, GT_VCS_BRANCH,
+	// GT_VCS_START_POINT and, for Remove, GT_VCS_FORCE ("1" or "0") set.
+	// Add must leave a git working copy at GT_VCS_PATH. Remove defaults to
+	// deleting the directory.
+	Add    string `json:"add,omitempty"`
+	Remove string `json:"remove,omitempty"`
+}
+
+// IsGitWorktree reports whether cfg selects the default git-worktree backend.
+// Worktree pooling only applies to that backend.
+func (c *Config) IsGitWorktree() bool {
+	return c == nil || c.Type == "" || c.Type == TypeGitWorktree || c.Type == "git"
+}
+
+// Backend creates and removes a rig's working copies.
+type Backend interface {
+	// Name returns the backend type.
+	Name() string
+
+	// AddWorkspace checks out a working copy at path on a new branch
+	// created from startPoint (a ref in the rig's repo, e.g. origin/main).
+	AddWorkspace(path, branch, startPoint string) error
+
+	// RemoveWorkspace deletes the working copy at path. Without force it
+	// refuses when the working copy has uncommitted changes.
+	RemoveWorkspace(path string, force bool) error
+
+	// MoveWorkspace relocates a working copy.
+	MoveWorkspace(from, to string) error
+
+	// Prune forgets working copies whose directories have been deleted.
+	Prune() error
+}
+
+// New returns the backend cfg selects for a rig whose repo is repo. A nil
+// cfg selects git-worktree.
+func New(cfg *Config, repo *git.Git) (Backend, error) {
+	if cfg.IsGitWorktree() {
+		return &worktreeBackend{worktrees: worktree.NewManager(repo)}, nil
+	}
+	switch cfg.Type {
+	case TypeGitClone:
+		return &cloneBackend{repo: repo}, nil
+	case TypeJJ:
+		return &cloneBackend{repo: repo, jj: true}, nil
+	case TypeCommand:
+		if cfg.Add == "" {
+			return nil, fmt.Errorf("vcs type %q requires an add command", TypeCommand)
+		}
+		return &commandBackend{repo: repo, add: cfg.Add, remove: cfg.Remove}, nil
+	default:
+		return nil, fmt.Errorf("unknown vcs type %q (supported: %s, %s, %s, %s)",
+			cfg.Type, TypeGitWorktree, TypeGitClone, TypeJJ, TypeCommand)
+	}
+}
+
+// worktreeBackend checks out git worktrees of the rig's repo, serialized
+// with other worktree changes by the repo's worktree manager.
+type worktreeBackend struct {
+	worktrees *worktree.Manager
+}
+
+func (b *worktreeBackend) Name() string { return TypeGitWorktree }
+
+func (b *worktreeBackend) AddWorkspace(path, branch, startPoint string) error {
+	return b.worktrees.AddFromRef(path, branch, startPoint)
+}
+
+func (b *worktreeBackend) RemoveWorkspace(path string, force bool) error {
+	return b.worktrees.Remove(path, force)
+}
+
+func (b *worktreeBackend) MoveWorkspace(from, to string) error {
+	// os.Rename would break the worktree's .git file and registry entry.
+	return b.worktrees.Move(from, to)
+}
+
+func (b *worktreeBackend) Prune() error {
+	return b.worktrees.Prune()
+}
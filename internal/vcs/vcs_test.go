@@ -0,0 +1,153 @@
+package vcs
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+		"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return string(out)
+}
+
+// setupRigRepo returns an upstream repo and a clone of it standing in for
+// the rig's repo base, with origin/main available.
+func setupRigRepo(t *testing.T) (upstream string, repo *git.Git) {
+	t.Helper()
+	tmp := t.TempDir()
+	upstream = filepath.Join(tmp, "upstream")
+	if err := os.MkdirAll(upstream, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, upstream, "init", "-q", "-b", "main")
+	if err := os.WriteFile(filepath.Join(upstream, "README.md"), []byte("# Test\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, upstream, "add", ".")
+	runGit(t, upstream, "commit", "-q", "-m", "initial")
+
+	base := filepath.Join(tmp, "base")
+	runGit(t, tmp, "clone", "-q", upstream, base)
+	return upstream, git.NewGit(base)
+}
+
+func TestNew(t *testing.T) {
+	repo := git.NewGit(t.TempDir())
+	for _, tt := range []struct {
+		cfg     *Config
+		want    string
+		wantErr bool
+	}{
+		{cfg: nil, want: TypeGitWorktree},
+		{cfg: &Config{Type: "git"}, want: TypeGitWorktree},
+		{cfg: &Config{Type: TypeGitClone}, want: TypeGitClone},
+		{cfg: &Config{Type: TypeJJ}, want: TypeJJ},
+		{cfg: &Config{Type: TypeCommand, Add: "true"}, want: TypeCommand},
+		{cfg: &Config{Type: TypeCommand}, wantErr: true},
+		{cfg: &Config{Type: "svn"}, wantErr: true},
+	} {
+		b, err := New(tt.cfg, repo)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("New(%+v) error = %v, wantErr %v", tt.cfg, err, tt.wantErr)
+			continue
+		}
+		if err == nil && b.Name() != tt.want {
+			t.Errorf("New(%+v) = %s, want %s", tt.cfg, b.Name(), tt.want)
+		}
+	}
+}
+
+func TestWorktreeBackend(t *testing.T) {
+	_, repo := setupRigRepo(t)
+	b, _ := New(nil, repo)
+	path := filepath.Join(t.TempDir(), "wt")
+
+	if err := b.AddWorkspace(path, "polecat/nux", "origin/main"); err != nil {
+		t.Fatalf("AddWorkspace: %v", err)
+	}
+	if branch, _ := git.NewGit(path).CurrentBranch(); branch != "polecat/nux" {
+		t.Errorf("branch = %q, want polecat/nux", branch)
+	}
+	if err := b.RemoveWorkspace(path, false); err != nil {
+		t.Fatalf("RemoveWorkspace: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("worktree still exists: %v", err)
+	}
+}
+
+func TestCloneBackend(t *testing.T) {
+	upstream, repo := setupRigRepo(t)
+	b, _ := New(&Config{Type: TypeGitClone}, repo)
+	path := filepath.Join(t.TempDir(), "clone")
+
+	if err := b.AddWorkspace(path, "polecat/nux", "origin/main"); err != nil {
+		t.Fatalf("AddWorkspace: %v", err)
+	}
+	wc := git.NewGit(path)
+	if branch, _ := wc.CurrentBranch(); branch != "polecat/nux" {
+		t.Errorf("branch = %q, want polecat/nux", branch)
+	}
+	if url, _ := wc.RemoteURL("origin"); url != upstream {
+		t.Errorf("origin = %q, want the rig's remote %q", url, upstream)
+	}
+	if ok, _ := wc.RefExists("origin/main"); !ok {
+		t.Error("origin/main missing from clone")
+	}
+	if v, _ := repo.ConfigGet("gc.auto"); v != "0" {
+		t.Errorf("rig repo gc.auto = %q, want 0 while clones borrow its objects", v)
+	}
+
+	if err := os.WriteFile(filepath.Join(path, "wip.txt"), []byte("wip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.RemoveWorkspace(path, false); err == nil {
+		t.Error("RemoveWorkspace without force removed a dirty clone")
+	}
+	if err := b.RemoveWorkspace(path, true); err != nil {
+		t.Fatalf("RemoveWorkspace(force): %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("clone still exists: %v", err)
+	}
+}
+
+func TestCommandBackend(t *testing.T) {
+	_, repo := setupRigRepo(t)
+	b, _ := New(&Config{
+		Type: TypeCommand,
+		Add:  `git clone -q "$GT_VCS_REPO" "$GT_VCS_PATH" && git -C "$GT_VCS_PATH" checkout -q -b "$GT_VCS_BRANCH" "$GT_VCS_START_POINT"`,
+	}, repo)
+	path := filepath.Join(t.TempDir(), "custom")
+
+	if err := b.AddWorkspace(path, "polecat/nux", "origin/main"); err != nil {
+		t.Fatalf("AddWorkspace: %v", err)
+	}
+	if branch, _ := git.NewGit(path).CurrentBranch(); branch != "polecat/nux" {
+		t.Errorf("branch = %q, want polecat/nux", branch)
+	}
+	if err := b.RemoveWorkspace(path, true); err != nil {
+		t.Fatalf("RemoveWorkspace: %v", err)
+	}
+
+	bad, _ := New(&Config{Type: TypeCommand, Add: `mkdir -p "$GT_VCS_PATH"`}, repo)
+	if err := bad.AddWorkspace(path, "polecat/nux", "origin/main"); err == nil {
+		t.Error("AddWorkspace accepted a directory that is not a git working copy")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("failed add left %s behind", path)
+	}
+}
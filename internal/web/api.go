@@ -141,6 +141,8 @@ func (h *APIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.handleSSE(w, r)
 	case path == "/session/preview" && r.Method == http.MethodGet:
 		h.handleSessionPreview(w, r)
+	case path == "/limits.ics" && r.Method == http.MethodGet:
+		h.handleLimitsCalendar(w, r)
 	default:
 		http.Error(w, "Not found", http.StatusNotFound)
 	}
@@ -1280,7 +1282,7 @@ func (h *APIHandler) runBdCommand(ctx context.Context, timeout time.Duration, ar
 		return "", fmt.Errorf("command slot unavailable: %w", ctx.Err())
 	}
 
-	cmd := exec.CommandContext(ctx, "bd", args...)
+	cmd := beads.CommandContext(ctx, args...)
 	if h.workDir != "" {
 		cmd.Dir = h.workDir
 	}
@@ -1700,6 +1702,21 @@ type ReadyResponse struct {
 	} `json:"summary"`
 }
 
+// handleLimitsCalendar serves the limits calendar feed (gt limits calendar),
+// so calendar apps can subscribe to the dashboard directly.
+func (h *APIHandler) handleLimitsCalendar(w http.ResponseWriter, r *http.Request) {
+	output, err := h.runGtCommand(r.Context(), 10*time.Second, []string{"limits", "calendar"})
+	// runGtCommand appends stderr to the output; serve only the feed itself.
+	start := strings.Index(output, "BEGIN:VCALENDAR")
+	end := strings.LastIndex(output, "END:VCALENDAR")
+	if err != nil || start == -1 || end < start {
+		http.Error(w, "Failed to build limits calendar", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	_, _ = w.Write([]byte(output[start:end+len("END:VCALENDAR")] + "\r\n"))
+}
+
 // handleCrew returns crew status across all rigs with proper state detection.
 func (h *APIHandler) handleCrew(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 15*time.Second)
@@ -18,6 +18,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -1766,47 +1767,33 @@ func (f *LiveConvoyFetcher) FetchIssues() ([]IssueRow, error) {
 
 // FetchActivity returns recent activity from the event log.
 func (f *LiveConvoyFetcher) FetchActivity() ([]ActivityRow, error) {
-	eventsPath := filepath.Join(f.townRoot, ".events.jsonl")
-
-	// Read events file
-	data, err := os.ReadFile(eventsPath)
-	if err != nil {
-		return nil, nil // No events file
-	}
-
-	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
-	if len(lines) == 0 {
-		return nil, nil
-	}
-
-	// Take last 50 events for richer timeline
-	start := 0
-	if len(lines) > 50 {
-		start = len(lines) - 50
+	// Read from the newest rotated segment on, so a log rotated moments ago
+	// still fills the timeline.
+	var since time.Time
+	if segments, err := events.Segments(f.townRoot); err == nil && len(segments) > 0 {
+		since = segments[len(segments)-1].FirstTS
 	}
 
-	var rows []ActivityRow
-	for i := len(lines) - 1; i >= start; i-- {
-		line := lines[i]
-		if line == "" {
-			continue
+	// Keep the last 50 feed events for richer timeline
+	const limit = 50
+	var recent []events.Event
+	if err := events.ReadRange(f.townRoot, since, time.Time{}, func(e events.Event) bool {
+		// Skip audit-only events
+		if e.Visibility == events.VisibilityAudit {
+			return true
 		}
-
-		var event struct {
-			Timestamp  string                 `json:"ts"`
-			Type       string                 `json:"type"`
-			Actor      string                 `json:"actor"`
-			Payload    map[string]interface{} `json:"payload"`
-			Visibility string                 `json:"visibility"`
-		}
-		if err := json.Unmarshal([]byte(line), &event); err != nil {
-			continue
+		recent = append(recent, e)
+		if len(recent) > limit {
+			recent = recent[1:]
 		}
+		return true
+	}); err != nil || len(recent) == 0 {
+		return nil, nil // No events file
+	}
 
-		// Skip audit-only events
-		if event.Visibility == "audit" {
-			continue
-		}
+	var rows []ActivityRow
+	for i := len(recent) - 1; i >= 0; i-- {
+		event := recent[i]
 
 		row := ActivityRow{
 			Type:         event.Type,
@@ -1836,7 +1823,7 @@ func eventCategory(eventType string) string {
 	switch eventType {
 	case "spawn", "kill", "session_start", "session_end", "session_death", "mass_death", "nudge", "handoff":
 		return "agent"
-	case "sling", "hook", "unhook", "done", "merge_started", "merged", "merge_failed":
+	case "sling", "hook", "unhook", "done", "merge_started", "merged", "merge_failed", "bead_note":
 		return "work"
 	case "mail", "escalation_sent", "escalation_acked", "escalation_closed":
 		return "comms"
@@ -1885,6 +1872,9 @@ func eventIcon(eventType string) string {
 		"merge_failed":      "❌",
 		"boot":              "🚀",
 		"halt":              "🛑",
+		"bead_note":         "📝",
+		"takeover":          "✋",
+		"handback":          "🔁",
 	}
 	if icon, ok := icons[eventType]; ok {
 		return icon
@@ -1921,6 +1911,14 @@ func eventSummary(eventType, actor string, payload map[string]interface{}) strin
 	case "unhook":
 		bead, _ := payload["bead"].(string)
 		return fmt.Sprintf("%s unhooked %s", shortActor, bead)
+	case "takeover":
+		bead, _ := payload["bead"].(string)
+		from, _ := payload["from"].(string)
+		return fmt.Sprintf("%s took over %s from %s", shortActor, bead, formatAgentAddress(from))
+	case "handback":
+		bead, _ := payload["bead"].(string)
+		to, _ := payload["to"].(string)
+		return fmt.Sprintf("%s handed back %s to %s", shortActor, bead, formatAgentAddress(to))
 	case "merged":
 		branch, _ := payload["branch"].(string)
 		return fmt.Sprintf("merged %s", branch)
@@ -1930,6 +1928,13 @@ func eventSummary(eventType, actor string, payload map[string]interface{}) strin
 			reason = reason[:27] + "..."
 		}
 		return fmt.Sprintf("merge failed: %s", reason)
+	case "bead_note":
+		bead, _ := payload["bead"].(string)
+		message, _ := payload["message"].(string)
+		if r := []rune(message); len(r) > 40 {
+			message = string(r[:37]) + "..."
+		}
+		return fmt.Sprintf("%s on %s: %s", shortActor, bead, message)
 	case "escalation_sent":
 		return "escalation created"
 	case "session_death":
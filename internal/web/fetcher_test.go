@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/steveyegge/gastown/internal/activity"
 	"github.com/steveyegge/gastown/internal/config"
@@ -343,6 +344,20 @@ func TestParseActivityTimestamp(t *testing.T) {
 	}
 }
 
+func TestEventSummary_BeadNoteTruncatesByRune(t *testing.T) {
+	message := strings.Repeat("é", 50)
+	got := eventSummary("bead_note", "gastown/polecats/nux", map[string]interface{}{
+		"bead":    "gt-1",
+		"message": message,
+	})
+	if !utf8.ValidString(got) {
+		t.Fatalf("eventSummary split a rune: %q", got)
+	}
+	if !strings.HasSuffix(got, strings.Repeat("é", 37)+"...") {
+		t.Errorf("eventSummary = %q, want the note cut to 37 runes", got)
+	}
+}
+
 // --- calculateWorkerWorkStatus with configurable thresholds ---
 
 func TestCalculateWorkerWorkStatus_DefaultThresholds(t *testing.T) {
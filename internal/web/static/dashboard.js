@@ -3205,10 +3205,16 @@
                 }
             }
 
+            // Latest progress note from gt bead note
+            var note = '';
+            if (issue.latest_note && issue.status !== 'closed') {
+                note = '<div class="tracked-issue-note">📝 ' + escapeHtml(issue.latest_note) + '</div>';
+            }
+
             html += '<tr class="tracked-issue-row tracked-issue-' + escapeHtml(issue.status) + '">' +
                 '<td>' + statusBadge + '</td>' +
                 '<td><span class="issue-id">' + escapeHtml(issue.id) + '</span></td>' +
-                '<td class="tracked-issue-title">' + escapeHtml(issue.title) + '</td>' +
+                '<td class="tracked-issue-title">' + escapeHtml(issue.title) + note + '</td>' +
                 '<td class="tracked-issue-assignee">' + escapeHtml(assignee) + '</td>' +
                 '<td class="tracked-issue-progress">' + progress + '</td>' +
                 '</tr>';
@@ -0,0 +1,361 @@
+// Package webhook posts bead lifecycle transitions to external HTTP endpoints.
+//
+// The dispatcher runs in the daemon. It tails ~/gt/.events.jsonl for the
+// transitions gt commands record (queued, dispatched, in_review, merged) and
+// receives closes from the daemon's beads event poll, then POSTs each
+// transition, signed with HMAC-SHA256, to every endpoint subscribed to its
+// state. Delivery is at-least-once within a daemon run: failed attempts are
+// retried, and receivers should dedupe on the X-Gastown-Delivery header.
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+// Bead lifecycle states, in lifecycle order (see config.WebhookStates).
+const (
+	StateQueued     = "queued"
+	StateDispatched = "dispatched"
+	StateInReview   = "in_review"
+	StateMerged     = "merged"
+	StateClosed     = "closed"
+)
+
+// Delivery headers.
+const (
+	HeaderEvent     = "X-Gastown-Event"     // "bead.<state>"
+	HeaderDelivery  = "X-Gastown-Delivery"  // Unique per transition; identical across retries
+	HeaderTimestamp = "X-Gastown-Timestamp" // Unix seconds, covered by the signature
+	HeaderSignature = "X-Gastown-Signature" // "sha256=" + hex HMAC of "<timestamp>.<body>"
+)
+
+// retryDelay is the wait before the first retry; it doubles per attempt.
+// A variable so tests can shorten it.
+var retryDelay = 2 * time.Second
+
+const (
+	maxAttempts = 3
+	queueSize   = 256
+
+	// dedupeWindow suppresses a repeat of a bead's last state: a scheduler
+	// dispatch runs gt sling, so one dispatch logs both scheduler_dispatch
+	// and sling.
+	dedupeWindow = 2 * time.Minute
+)
+
+// Transition is the JSON body of a webhook delivery.
+type Transition struct {
+	ID        string                 `json:"id"`
+	Bead      string                 `json:"bead"`
+	State     string                 `json:"state"`
+	Timestamp string                 `json:"ts"`
+	Actor     string                 `json:"actor,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// FromEvent maps a gt event to the bead transition it records, if any.
+func FromEvent(e events.Event) (Transition, bool) {
+	var state string
+	switch e.Type {
+	case events.TypeSchedulerEnqueue:
+		state = StateQueued
+	case events.TypeSling, events.TypeSchedulerDispatch:
+		state = StateDispatched
+	case events.TypeDone:
+		state = StateInReview
+	case events.TypeMerged:
+		state = StateMerged
+	default:
+		return Transition{}, false
+	}
+	bead, _ := e.Payload["bead"].(string)
+	if bead == "" {
+		return Transition{}, false
+	}
+
+	details := make(map[string]interface{}, len(e.Payload))
+	for k, v := range e.Payload {
+		if k != "bead" {
+			details[k] = v
+		}
+	}
+	if len(details) == 0 {
+		details = nil
+	}
+	return Transition{Bead: bead, State: state, Timestamp: e.Timestamp, Actor: e.Actor, Details: details}, true
+}
+
+// Sign returns the X-Gastown-Signature value for body sent at timestamp.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is valid for body sent at timestamp.
+func Verify(secret []byte, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Dispatcher delivers transitions to the configured endpoints.
+type Dispatcher struct {
+	townRoot  string
+	endpoints []config.WebhookEndpoint
+	client    *http.Client
+	logger    func(format string, args ...interface{})
+	queue     chan Transition
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	startOnce sync.Once
+	startErr  error
+
+	// last maps bead ID to its last queued state, for dedupe.
+	lastMu sync.Mutex
+	last   map[string]lastState
+}
+
+type lastState struct {
+	state string
+	at    time.Time
+}
+
+// NewDispatcher creates a dispatcher for cfg's endpoints. Returns nil when
+// no endpoints are configured.
+func NewDispatcher(townRoot string, cfg *config.WebhooksConfig, logger func(format string, args ...interface{})) *Dispatcher {
+	if cfg == nil || len(cfg.Endpoints) == 0 {
+		return nil
+	}
+	if logger == nil {
+		logger = func(string, ...interface{}) {}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Dispatcher{
+		townRoot:  townRoot,
+		endpoints: cfg.Endpoints,
+		client:    &http.Client{},
+		logger:    logger,
+		queue:     make(chan Transition, queueSize),
+		ctx:       ctx,
+		cancel:    cancel,
+		last:      make(map[string]lastState),
+	}
+}
+
+// Start begins tailing the events log and delivering transitions. Only
+// events written after Start are delivered. Safe to call more than once.
+func (d *Dispatcher) Start() error {
+	d.startOnce.Do(func() {
+		file, err := os.OpenFile(filepath.Join(d.townRoot, events.EventsFile), os.O_RDONLY|os.O_CREATE, 0600)
+		if err != nil {
+			d.startErr = fmt.Errorf("opening events file: %w", err)
+			return
+		}
+		if _, err := file.Seek(0, io.SeekEnd); err != nil {
+			_ = file.Close()
+			d.startErr = fmt.Errorf("seeking to end: %w", err)
+			return
+		}
+		d.wg.Add(2)
+		go d.tail(file)
+		go d.deliverLoop()
+	})
+	return d.startErr
+}
+
+// Stop stops tailing and delivery. Queued transitions not yet delivered are
+// dropped.
+func (d *Dispatcher) Stop() {
+	d.cancel()
+	d.wg.Wait()
+}
+
+// Notify queues a transition for delivery. It never blocks: when the queue
+// is full the transition is dropped and logged.
+func (d *Dispatcher) Notify(t Transition) {
+	if !d.wanted(t.State) || d.duplicate(t, time.Now()) {
+		return
+	}
+	if t.ID == "" {
+		t.ID = newDeliveryID()
+	}
+	if t.Timestamp == "" {
+		t.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+	select {
+	case d.queue <- t:
+	default:
+		d.logger("Webhook: queue full, dropping %s %s", t.Bead, t.State)
+	}
+}
+
+// NotifyClosed queues a closed transition for beadID.
+func (d *Dispatcher) NotifyClosed(beadID string) {
+	d.Notify(Transition{Bead: beadID, State: StateClosed})
+}
+
+func (d *Dispatcher) wanted(state string) bool {
+	for _, ep := range d.endpoints {
+		if ep.Wants(state) {
+			return true
+		}
+	}
+	return false
+}
+
+// duplicate records t as the bead's last state and reports whether it
+// repeats the previous state within dedupeWindow.
+func (d *Dispatcher) duplicate(t Transition, now time.Time) bool {
+	d.lastMu.Lock()
+	defer d.lastMu.Unlock()
+
+	prev, ok := d.last[t.Bead]
+	d.last[t.Bead] = lastState{state: t.State, at: now}
+	if len(d.last) > 4096 {
+		for id, l := range d.last {
+			if now.Sub(l.at) > dedupeWindow {
+				delete(d.last, id)
+			}
+		}
+	}
+	return ok && prev.state == t.State && now.Sub(prev.at) < dedupeWindow
+}
+
+func (d *Dispatcher) tail(file *os.File) {
+	defer d.wg.Done()
+	defer func() { _ = file.Close() }()
+
+	reader := bufio.NewReader(file)
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					break
+				}
+				var e events.Event
+				if json.Unmarshal([]byte(line), &e) != nil {
+					continue
+				}
+				if t, ok := FromEvent(e); ok {
+					d.Notify(t)
+				}
+			}
+
+			// Follow the log across rotation and KRC rewrites.
+			if nf, err := events.Reopen(d.townRoot, file); err != nil {
+				d.logger("Webhook: reopening events file: %v", err)
+			} else if nf != nil {
+				_ = file.Close()
+				file = nf
+				reader = bufio.NewReader(file)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) deliverLoop() {
+	defer d.wg.Done()
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case t := <-d.queue:
+			for _, ep := range d.endpoints {
+				if !ep.Wants(t.State) {
+					continue
+				}
+				if err := d.deliver(d.ctx, ep, t); err != nil {
+					d.logger("Webhook: %s %s → %s failed: %v", t.Bead, t.State, ep.URL, err)
+				}
+			}
+		}
+	}
+}
+
+// deliver POSTs t to ep, retrying failed attempts with backoff.
+func (d *Dispatcher) deliver(ctx context.Context, ep config.WebhookEndpoint, t Transition) error {
+	body, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	secret := []byte(os.Getenv(ep.GetSecretEnv()))
+
+	delay := retryDelay
+	for attempt := 1; ; attempt++ {
+		err = Post(ctx, d.client, ep, t, body, secret)
+		if err == nil || attempt == maxAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// Post makes one signed delivery attempt of body (the JSON encoding of t).
+// Any 2xx response is success. An empty secret sends no signature header.
+func Post(ctx context.Context, client *http.Client, ep config.WebhookEndpoint, t Transition, body, secret []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, ep.GetTimeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "gastown-webhook")
+	req.Header.Set(HeaderEvent, "bead."+t.State)
+	req.Header.Set(HeaderDelivery, t.ID)
+	req.Header.Set(HeaderTimestamp, ts)
+	if len(secret) > 0 {
+		req.Header.Set(HeaderSignature, Sign(secret, ts, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func newDeliveryID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/events"
+)
+
+func TestFromEvent(t *testing.T) {
+	tests := []struct {
+		typ  string
+		want string
+	}{
+		{events.TypeSchedulerEnqueue, StateQueued},
+		{events.TypeSling, StateDispatched},
+		{events.TypeSchedulerDispatch, StateDispatched},
+		{events.TypeDone, StateInReview},
+		{events.TypeMerged, StateMerged},
+		{events.TypeMail, ""},
+	}
+	for _, tt := range tests {
+		e := events.Event{Type: tt.typ, Actor: "gastown/polecats/nux", Timestamp: "2026-03-01T12:00:00Z",
+			Payload: map[string]interface{}{"bead": "gt-abc", "branch": "polecat/nux"}}
+		got, ok := FromEvent(e)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("%s: got transition %+v, want none", tt.typ, got)
+			}
+			continue
+		}
+		if !ok || got.State != tt.want || got.Bead != "gt-abc" || got.Actor != e.Actor {
+			t.Errorf("%s: got %+v, %v; want state %s", tt.typ, got, ok, tt.want)
+		}
+		if got.Details["branch"] != "polecat/nux" || got.Details["bead"] != nil {
+			t.Errorf("%s: details = %v", tt.typ, got.Details)
+		}
+	}
+
+	if _, ok := FromEvent(events.Event{Type: events.TypeMerged, Payload: map[string]interface{}{"mr": "gt-mr1"}}); ok {
+		t.Error("event without bead produced a transition")
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	secret, body := []byte("s3cret"), []byte(`{"bead":"gt-abc"}`)
+	sig := Sign(secret, "1700000000", body)
+	if !Verify(secret, "1700000000", body, sig) {
+		t.Error("valid signature rejected")
+	}
+	if Verify(secret, "1700000001", body, sig) {
+		t.Error("signature accepted for a different timestamp")
+	}
+	if Verify([]byte("other"), "1700000000", body, sig) {
+		t.Error("signature accepted for a different secret")
+	}
+}
+
+func TestDeliver_SignsAndRetries(t *testing.T) {
+	retryDelay = time.Millisecond
+	defer func() { retryDelay = 2 * time.Second }()
+	t.Setenv("TEST_WEBHOOK_SECRET", "s3cret")
+
+	var calls atomic.Int32
+	var got Transition
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !Verify([]byte("s3cret"), r.Header.Get(HeaderTimestamp), body, r.Header.Get(HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(HeaderEvent) != "bead.merged" || r.Header.Get(HeaderDelivery) != "d1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.Unmarshal(body, &got)
+	}))
+	defer srv.Close()
+
+	d := NewDispatcher(t.TempDir(), &config.WebhooksConfig{Endpoints: []config.WebhookEndpoint{
+		{URL: srv.URL, SecretEnv: "TEST_WEBHOOK_SECRET"},
+	}}, nil)
+	err := d.deliver(context.Background(), d.endpoints[0], Transition{ID: "d1", Bead: "gt-abc", State: StateMerged})
+	if err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("calls = %d, want 2 (one retry)", calls.Load())
+	}
+	if got.Bead != "gt-abc" || got.State != StateMerged {
+		t.Errorf("received %+v", got)
+	}
+}
+
+func TestNotify_FiltersAndDedupes(t *testing.T) {
+	d := NewDispatcher(t.TempDir(), &config.WebhooksConfig{Endpoints: []config.WebhookEndpoint{
+		{URL: "http://example.invalid", States: []string{StateDispatched, StateClosed}},
+	}}, nil)
+
+	d.Notify(Transition{Bead: "gt-a", State: StateQueued})     // not subscribed
+	d.Notify(Transition{Bead: "gt-a", State: StateDispatched}) // scheduler_dispatch
+	d.Notify(Transition{Bead: "gt-a", State: StateDispatched}) // the sling it ran
+	d.NotifyClosed("gt-a")
+
+	var states []string
+	for len(d.queue) > 0 {
+		tr := <-d.queue
+		if tr.ID == "" || tr.Timestamp == "" {
+			t.Errorf("transition missing id/ts: %+v", tr)
+		}
+		states = append(states, tr.State)
+	}
+	if len(states) != 2 || states[0] != StateDispatched || states[1] != StateClosed {
+		t.Errorf("queued states = %v, want [dispatched closed]", states)
+	}
+
+	if NewDispatcher("", &config.WebhooksConfig{}, nil) != nil {
+		t.Error("dispatcher created without endpoints")
+	}
+}
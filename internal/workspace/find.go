@@ -75,8 +75,27 @@ func FindOrError(startDir string) (string, error) {
 	return root, nil
 }
 
+// townOverride is the town named by gt --town. When set, it stands in for
+// the town found from the current working directory.
+var townOverride string
+
+// SetTownOverride makes the CWD lookups (FindFromCwd, FindFromCwdOrError,
+// FindFromCwdWithFallback and Discover) return townRoot regardless of the
+// working directory. An empty townRoot clears the override.
+func SetTownOverride(townRoot string) {
+	townOverride = townRoot
+}
+
+// TownOverride returns the town set by SetTownOverride, or "".
+func TownOverride() string {
+	return townOverride
+}
+
 // FindFromCwd locates the town root from the current working directory.
 func FindFromCwd() (string, error) {
+	if townOverride != "" {
+		return townOverride, nil
+	}
 	cwd, err := os.Getwd()
 	if err != nil {
 		return "", fmt.Errorf("getting current directory: %w", err)
@@ -88,6 +107,9 @@ func FindFromCwd() (string, error) {
 // It searches for a workspace starting from the CWD. If none is found, it
 // falls back to the GT_TOWN_ROOT or GT_ROOT environment variables.
 func FindFromCwdOrError() (string, error) {
+	if townOverride != "" {
+		return townOverride, nil
+	}
 	cwd, err := os.Getwd()
 	if err == nil {
 		root, err := Find(cwd)
@@ -97,19 +119,48 @@ func FindFromCwdOrError() (string, error) {
 	}
 
 	// Fallback: try GT_TOWN_ROOT or GT_ROOT env vars (set by shell integration or session manager)
+	if townRoot := findFromEnv(); townRoot != "" {
+		return townRoot, nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("getting current directory: %w", err)
+	}
+	return "", ErrNotFound
+}
+
+// Discover locates the town root for commands that may run outside the
+// town, such as agent hooks whose CWD is wherever the agent was launched.
+// GT_TOWN_ROOT (or GT_ROOT) overrides the CWD; failing both, the most
+// recently used town in the machine registry is returned.
+func Discover() (string, error) {
+	if townOverride != "" {
+		return townOverride, nil
+	}
+	if townRoot := findFromEnv(); townRoot != "" {
+		return townRoot, nil
+	}
+	if townRoot, err := FindFromCwd(); err == nil && townRoot != "" {
+		return townRoot, nil
+	}
+	if townRoot := RecentTown(); townRoot != "" {
+		return townRoot, nil
+	}
+	return "", ErrNotFound
+}
+
+// findFromEnv returns the town named by GT_TOWN_ROOT or GT_ROOT, or "" if
+// neither is set to a workspace.
+func findFromEnv() string {
 	for _, envName := range []string{"GT_TOWN_ROOT", "GT_ROOT"} {
 		if townRoot := os.Getenv(envName); townRoot != "" {
 			// Verify it's actually a workspace
 			if ok, _ := IsWorkspace(townRoot); ok {
-				return townRoot, nil
+				return townRoot
 			}
 		}
 	}
-
-	if err != nil {
-		return "", fmt.Errorf("getting current directory: %w", err)
-	}
-	return "", ErrNotFound
+	return ""
 }
 
 // FindFromCwdWithFallback is like FindFromCwdOrError but returns (townRoot, cwd, error).
@@ -118,6 +169,12 @@ func FindFromCwdOrError() (string, error) {
 // working directory is deleted (e.g., polecat worktree nuked by Witness).
 func FindFromCwdWithFallback() (townRoot string, cwd string, err error) {
 	cwd, err = os.Getwd()
+	if townOverride != "" {
+		if err != nil {
+			cwd = ""
+		}
+		return townOverride, cwd, nil
+	}
 	if err != nil {
 		// Fallback: try GT_TOWN_ROOT env var
 		if townRoot = os.Getenv("GT_TOWN_ROOT"); townRoot != "" {
@@ -0,0 +1,164 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/atomicfile"
+	"github.com/steveyegge/gastown/internal/state"
+)
+
+// registryTouchInterval limits how often a town's last-used time is
+// rewritten, so running gt in a loop doesn't rewrite the registry each time.
+const registryTouchInterval = time.Minute
+
+// townRegistry is the machine-level list of towns gt has run in, used to
+// find a town when neither the CWD nor the environment names one.
+type townRegistry struct {
+	Towns []RegisteredTown `json:"towns"`
+}
+
+// RegisteredTown is one town in the machine registry.
+type RegisteredTown struct {
+	Root     string    `json:"root"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// RegistryPath returns the path of the machine-level town registry
+// (~/.local/state/gastown/towns.json).
+func RegistryPath() string {
+	return filepath.Join(state.StateDir(), "towns.json")
+}
+
+// RegisteredTowns returns the towns in the machine registry, most recently
+// used first. A missing registry is not an error.
+func RegisteredTowns() ([]RegisteredTown, error) {
+	data, err := os.ReadFile(RegistryPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var reg townRegistry
+	if err := json.Unmarshal(data, &reg); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(reg.Towns, func(i, j int) bool {
+		return reg.Towns[i].LastUsed.After(reg.Towns[j].LastUsed)
+	})
+	return reg.Towns, nil
+}
+
+// TouchRegistry records townRoot as used now in the machine registry.
+// Towns that no longer exist are dropped while the file is rewritten.
+func TouchRegistry(townRoot string) error {
+	if townRoot == "" {
+		return nil
+	}
+	towns, err := RegisteredTowns()
+	if err != nil {
+		towns = nil // Unreadable registry: start over
+	}
+	now := time.Now()
+	reg := townRegistry{Towns: []RegisteredTown{{Root: townRoot, LastUsed: now}}}
+	for _, t := range towns {
+		if t.Root == townRoot {
+			if now.Sub(t.LastUsed) < registryTouchInterval {
+				return nil
+			}
+			continue
+		}
+		if t.Root == "" {
+			continue
+		}
+		if ok, _ := IsWorkspace(t.Root); ok {
+			reg.Towns = append(reg.Towns, t)
+		}
+	}
+	if err := os.MkdirAll(state.StateDir(), 0755); err != nil {
+		return err
+	}
+	return atomicfile.WriteJSON(RegistryPath(), reg)
+}
+
+// RecentTown returns the most recently used registered town that is still a
+// workspace, or "" if there is none.
+func RecentTown() string {
+	towns, err := RegisteredTowns()
+	if err != nil {
+		return ""
+	}
+	for _, t := range towns {
+		if t.Root == "" {
+			continue
+		}
+		if ok, _ := IsWorkspace(t.Root); ok {
+			return t.Root
+		}
+	}
+	return ""
+}
+
+// ResolveTown resolves gt --town: a path to a town root, or the name of a
+// registered town, matched against its mayor/town.json name and then the
+// base name of its root. A leading ~ in a path is expanded.
+func ResolveTown(pathOrName string) (string, error) {
+	arg := strings.TrimSpace(pathOrName)
+	if arg == "" {
+		return "", fmt.Errorf("empty town")
+	}
+	path := arg
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[1:])
+		}
+	}
+	if ok, _ := IsWorkspace(path); ok {
+		return filepath.Abs(path)
+	}
+	if strings.ContainsRune(arg, filepath.Separator) || strings.HasPrefix(arg, ".") {
+		return "", fmt.Errorf("%s is not a Gas Town workspace", arg)
+	}
+
+	towns, err := RegisteredTowns()
+	if err != nil {
+		return "", fmt.Errorf("reading town registry: %w", err)
+	}
+	var known, byBase []string
+	for _, t := range towns {
+		if t.Root == "" {
+			continue
+		}
+		if ok, _ := IsWorkspace(t.Root); !ok {
+			continue
+		}
+		name, _ := GetTownName(t.Root)
+		if name == arg {
+			return t.Root, nil
+		}
+		if filepath.Base(t.Root) == arg {
+			byBase = append(byBase, t.Root)
+		}
+		if name == "" {
+			name = filepath.Base(t.Root)
+		}
+		known = append(known, fmt.Sprintf("%s (%s)", name, t.Root))
+	}
+	switch len(byBase) {
+	case 1:
+		return byBase[0], nil
+	case 0:
+	default:
+		return "", fmt.Errorf("town %q is ambiguous: %s; pass the path instead", arg, strings.Join(byBase, ", "))
+	}
+	if len(known) == 0 {
+		return "", fmt.Errorf("no town named %q: no towns are registered in %s yet; pass the path instead", arg, RegistryPath())
+	}
+	return "", fmt.Errorf("no town named %q; registered towns: %s", arg, strings.Join(known, ", "))
+}
@@ -0,0 +1,159 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func makeTown(t *testing.T) string {
+	t.Helper()
+	root := realPath(t, t.TempDir())
+	if err := os.MkdirAll(filepath.Join(root, "mayor"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, PrimaryMarker), []byte(`{"type":"town"}`), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	return root
+}
+
+func TestTouchRegistryAndRecentTown(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	if got := RecentTown(); got != "" {
+		t.Fatalf("RecentTown() with no registry = %q, want empty", got)
+	}
+
+	older, newer := makeTown(t), makeTown(t)
+	if err := TouchRegistry(older); err != nil {
+		t.Fatalf("TouchRegistry: %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if err := TouchRegistry(newer); err != nil {
+		t.Fatalf("TouchRegistry: %v", err)
+	}
+	if got := RecentTown(); got != newer {
+		t.Errorf("RecentTown() = %q, want %q", got, newer)
+	}
+
+	// A town that's gone is skipped, and dropped on the next write.
+	if err := os.RemoveAll(newer); err != nil {
+		t.Fatal(err)
+	}
+	if got := RecentTown(); got != older {
+		t.Errorf("RecentTown() after removing newest = %q, want %q", got, older)
+	}
+	if err := TouchRegistry(makeTown(t)); err != nil {
+		t.Fatalf("TouchRegistry: %v", err)
+	}
+	towns, err := RegisteredTowns()
+	if err != nil {
+		t.Fatalf("RegisteredTowns: %v", err)
+	}
+	for _, town := range towns {
+		if town.Root == newer {
+			t.Errorf("removed town %s still registered", newer)
+		}
+	}
+	if len(towns) != 2 {
+		t.Errorf("registered %d towns, want 2", len(towns))
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	t.Setenv("GT_TOWN_ROOT", "")
+	t.Setenv("GT_ROOT", "")
+	outside := realPath(t, t.TempDir())
+	t.Chdir(outside)
+
+	if _, err := Discover(); err != ErrNotFound {
+		t.Errorf("Discover() with nothing to find: err = %v, want ErrNotFound", err)
+	}
+
+	registered := makeTown(t)
+	if err := TouchRegistry(registered); err != nil {
+		t.Fatalf("TouchRegistry: %v", err)
+	}
+	if got, err := Discover(); err != nil || got != registered {
+		t.Errorf("Discover() = %q, %v; want registry town %q", got, err, registered)
+	}
+
+	cwdTown := makeTown(t)
+	t.Chdir(cwdTown)
+	if got, _ := Discover(); got != cwdTown {
+		t.Errorf("Discover() = %q, want CWD town %q over the registry", got, cwdTown)
+	}
+
+	envTown := makeTown(t)
+	t.Setenv("GT_TOWN_ROOT", envTown)
+	if got, _ := Discover(); got != envTown {
+		t.Errorf("Discover() = %q, want GT_TOWN_ROOT %q over the CWD", got, envTown)
+	}
+}
+
+func TestResolveTown(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	named := makeTown(t)
+	if err := os.WriteFile(filepath.Join(named, PrimaryMarker), []byte(`{"type":"town","name":"prod"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	unnamed := makeTown(t)
+	if _, err := ResolveTown("prod"); err == nil {
+		t.Error("ResolveTown by name with an empty registry should fail")
+	}
+	for _, root := range []string{named, unnamed} {
+		if err := TouchRegistry(root); err != nil {
+			t.Fatalf("TouchRegistry: %v", err)
+		}
+	}
+
+	tests := []struct {
+		arg  string
+		want string
+	}{
+		{named, named},
+		{"prod", named},
+		{filepath.Base(unnamed), unnamed},
+	}
+	for _, tt := range tests {
+		if got, err := ResolveTown(tt.arg); err != nil || got != tt.want {
+			t.Errorf("ResolveTown(%q) = %q, %v; want %q", tt.arg, got, err, tt.want)
+		}
+	}
+	for _, arg := range []string{"staging", t.TempDir(), ""} {
+		if _, err := ResolveTown(arg); err == nil {
+			t.Errorf("ResolveTown(%q) should fail", arg)
+		}
+	}
+}
+
+func TestTownOverride(t *testing.T) {
+	t.Setenv("GT_TOWN_ROOT", "")
+	t.Setenv("GT_ROOT", "")
+	cwdTown, other := makeTown(t), makeTown(t)
+	t.Chdir(cwdTown)
+
+	SetTownOverride(other)
+	t.Cleanup(func() { SetTownOverride("") })
+	for name, find := range map[string]func() (string, error){
+		"FindFromCwd":        FindFromCwd,
+		"FindFromCwdOrError": FindFromCwdOrError,
+		"Discover":           Discover,
+	} {
+		if got, err := find(); err != nil || got != other {
+			t.Errorf("%s() = %q, %v; want override %q", name, got, err, other)
+		}
+	}
+	if got, cwd, err := FindFromCwdWithFallback(); err != nil || got != other || cwd != cwdTown {
+		t.Errorf("FindFromCwdWithFallback() = %q, %q, %v", got, cwd, err)
+	}
+
+	SetTownOverride("")
+	if got, _ := FindFromCwd(); got != cwdTown {
+		t.Errorf("FindFromCwd() after clearing = %q, want %q", got, cwdTown)
+	}
+}
@@ -0,0 +1,108 @@
+// Package worktree manages the linked worktrees of a rig's shared repo.
+//
+// Polecats, dogs, the refinery and (in the worktrees layout) the mayor all
+// check out linked worktrees of the rig's bare repo (.repo.git). Git takes
+// short-lived lock files (config.lock, worktrees/<name>/locked, index.lock)
+// while adding, moving or removing a worktree and fails outright instead of
+// waiting when another process holds one, so concurrent spawns on a huge repo
+// would fail at random. Manager serializes those operations across processes
+// with a file lock in the repo's common git directory.
+package worktree
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gofrs/flock"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// LockFileName is the lock file Manager takes in the repo's common git dir.
+const LockFileName = "gt-worktree.lock"
+
+// Manager adds and removes linked worktrees of one repo, holding an
+// exclusive lock for each operation so concurrent gt processes don't race
+// on the repo's worktree registry.
+type Manager struct {
+	repo *git.Git
+}
+
+// NewManager returns a Manager for repo (typically the rig's .repo.git).
+func NewManager(repo *git.Git) *Manager {
+	return &Manager{repo: repo}
+}
+
+// Repo returns the repo whose worktrees m manages.
+func (m *Manager) Repo() *git.Git {
+	return m.repo
+}
+
+// AddFromRef creates a worktree at path on a new branch from startPoint.
+func (m *Manager) AddFromRef(path, branch, startPoint string) error {
+	return m.withLock(func() error {
+		return m.repo.WorktreeAddFromRef(path, branch, startPoint)
+	})
+}
+
+// AddDetached creates a worktree at path with HEAD detached at ref.
+func (m *Manager) AddDetached(path, ref string) error {
+	return m.withLock(func() error {
+		return m.repo.WorktreeAddDetached(path, ref)
+	})
+}
+
+// AddExisting creates a worktree at path checking out an existing branch.
+func (m *Manager) AddExisting(path, branch string) error {
+	return m.withLock(func() error {
+		return m.repo.WorktreeAddExisting(path, branch)
+	})
+}
+
+// AddExistingForce is AddExisting for a branch that may already be checked
+// out in another worktree.
+func (m *Manager) AddExistingForce(path, branch string) error {
+	return m.withLock(func() error {
+		return m.repo.WorktreeAddExistingForce(path, branch)
+	})
+}
+
+// Remove removes the worktree at path. With force it discards uncommitted
+// changes.
+func (m *Manager) Remove(path string, force bool) error {
+	return m.withLock(func() error {
+		return m.repo.WorktreeRemove(path, force)
+	})
+}
+
+// Move relocates the worktree at from to to.
+func (m *Manager) Move(from, to string) error {
+	return m.withLock(func() error {
+		return m.repo.WorktreeMove(from, to)
+	})
+}
+
+// Prune forgets worktrees whose directories have been deleted.
+func (m *Manager) Prune() error {
+	return m.withLock(m.repo.WorktreePrune)
+}
+
+// List returns the repo's worktrees. Listing only reads the registry, so it
+// does not take the lock.
+func (m *Manager) List() ([]git.Worktree, error) {
+	return m.repo.WorktreeList()
+}
+
+// withLock runs fn holding the repo's worktree lock.
+func (m *Manager) withLock(fn func() error) error {
+	commonDir, err := m.repo.CommonDir()
+	if err != nil {
+		return fmt.Errorf("finding git dir of %s: %w", m.repo.RepoDir(), err)
+	}
+	fl := flock.New(filepath.Join(commonDir, LockFileName))
+	if err := fl.Lock(); err != nil {
+		return fmt.Errorf("acquiring worktree lock: %w", err)
+	}
+	defer func() { _ = fl.Unlock() }()
+	return fn()
+}
@@ -0,0 +1,151 @@
+package worktree
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+		"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+}
+
+// setupBareRepo returns a bare repo with one commit on main, standing in for
+// a rig's .repo.git.
+func setupBareRepo(t *testing.T) *git.Git {
+	t.Helper()
+	tmp := t.TempDir()
+	src := filepath.Join(tmp, "src")
+	if err := os.MkdirAll(src, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, src, "init", "-q", "-b", "main")
+	if err := os.WriteFile(filepath.Join(src, "README.md"), []byte("# Test\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, src, "add", ".")
+	runGit(t, src, "commit", "-q", "-m", "initial")
+
+	bare := filepath.Join(tmp, ".repo.git")
+	runGit(t, tmp, "clone", "-q", "--bare", src, bare)
+	return git.NewGitWithDir(bare, "")
+}
+
+func TestManager_ConcurrentAddRemove(t *testing.T) {
+	repo := setupBareRepo(t)
+	m := NewManager(repo)
+	root := t.TempDir()
+
+	const n = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			path := filepath.Join(root, fmt.Sprintf("wt%d", i))
+			if err := m.AddFromRef(path, fmt.Sprintf("polecat/p%d", i), "main"); err != nil {
+				errs <- fmt.Errorf("add %d: %w", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	list, err := m.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list) != n+1 { // n worktrees plus the bare repo itself
+		t.Fatalf("List returned %d entries, want %d", len(list), n+1)
+	}
+
+	errs = make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := m.Remove(filepath.Join(root, fmt.Sprintf("wt%d", i)), true); err != nil {
+				errs <- fmt.Errorf("remove %d: %w", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+	if list, _ := m.List(); len(list) != 1 {
+		t.Errorf("List after remove returned %d entries, want 1", len(list))
+	}
+}
+
+func TestManager_AddDetachedAndMove(t *testing.T) {
+	repo := setupBareRepo(t)
+	m := NewManager(repo)
+	root := t.TempDir()
+	from := filepath.Join(root, "a")
+	to := filepath.Join(root, "b")
+
+	if err := m.AddDetached(from, "main"); err != nil {
+		t.Fatalf("AddDetached: %v", err)
+	}
+	if branch, _ := git.NewGit(from).CurrentBranch(); branch != "HEAD" {
+		t.Errorf("branch = %q, want detached HEAD", branch)
+	}
+	if err := m.Move(from, to); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(to, "README.md")); err != nil {
+		t.Errorf("moved worktree missing README.md: %v", err)
+	}
+
+	// The lock lives in the shared git dir, whichever checkout m came from.
+	common, err := git.NewGit(to).CommonDir()
+	if err != nil {
+		t.Fatalf("CommonDir: %v", err)
+	}
+	if common != repo.RepoDir() {
+		t.Errorf("CommonDir = %q, want %q", common, repo.RepoDir())
+	}
+	if _, err := os.Stat(filepath.Join(common, LockFileName)); err != nil {
+		t.Errorf("lock file not created: %v", err)
+	}
+}
+
+func TestManager_AddExistingForce(t *testing.T) {
+	repo := setupBareRepo(t)
+	m := NewManager(repo)
+	root := t.TempDir()
+	first := filepath.Join(root, "a")
+	second := filepath.Join(root, "b")
+
+	if err := m.AddExisting(first, "main"); err != nil {
+		t.Fatalf("AddExisting: %v", err)
+	}
+	if err := m.AddExisting(second, "main"); err == nil {
+		t.Fatal("AddExisting of a checked-out branch should fail")
+	}
+	if err := m.AddExistingForce(second, "main"); err != nil {
+		t.Fatalf("AddExistingForce: %v", err)
+	}
+	if branch, _ := git.NewGit(second).CurrentBranch(); branch != "main" {
+		t.Errorf("branch = %q, want main", branch)
+	}
+}